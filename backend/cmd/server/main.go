@@ -7,8 +7,11 @@ import (
 	"backend/internal/application/usecase"
 	"backend/internal/config"
 	"backend/internal/infrastructure/ent"
+	_ "backend/internal/infrastructure/ent/runtime" // Registers schema defaults, hooks and privacy policies
 	"backend/internal/infrastructure/http/handler"
+	"backend/internal/infrastructure/http/middleware"
 	"backend/internal/infrastructure/http/router"
+	"backend/internal/infrastructure/mail"
 	"backend/internal/infrastructure/repositories"
 	"backend/internal/infrastructure/session"
 
@@ -35,23 +38,233 @@ func main() {
 	log.Println("Database connection established")
 
 	// 2. Session initialization
-	session.InitStore(cfg.Session.Secret)
+	session.InitStore(cfg.Session.Secret, client)
 	log.Println("Session store initialized")
 
-	// 3. Repository layer
+	// 3. Mailer initialization
+	var mailer mail.Mailer
+	switch cfg.Mail.Driver {
+	case "smtp":
+		mailer = mail.NewSMTPMailer(cfg.Mail.SMTPHost, cfg.Mail.SMTPPort, cfg.Mail.SMTPUsername, cfg.Mail.SMTPPassword, cfg.Mail.From)
+	case "outbox":
+		mailer = mail.NewOutboxMailer(cfg.Mail.OutboxDir, cfg.Mail.From)
+	default:
+		log.Fatalf("Unknown MAIL_DRIVER: %s", cfg.Mail.Driver)
+	}
+	log.Printf("Mailer initialized (%s)", cfg.Mail.Driver)
+
+	// 4. Repository layer
 	userRepo := repositories.NewUserRepository(client)
 	workspaceRepo := repositories.NewWorkspaceRepository(client)
+	sessionRepo := repositories.NewSessionRepository(client)
+	resetTokenRepo := repositories.NewPasswordResetTokenRepository(client)
+	verificationTokenRepo := repositories.NewEmailVerificationTokenRepository(client)
+	recoveryCodeRepo := repositories.NewRecoveryCodeRepository(client)
+	membershipRepo := repositories.NewMembershipRepository(client)
+	invitationRepo := repositories.NewWorkspaceInvitationRepository(client)
+	accountRepo := repositories.NewAccountRepository(client)
+	transactionRepo := repositories.NewTransactionRepository(client)
+	journalRepo := repositories.NewJournalRepository(client)
+	categoryRepo := repositories.NewCategoryRepository(client)
+	transferRepo := repositories.NewTransferRepository(client)
+	tagRepo := repositories.NewTagRepository(client)
+	payeeRepo := repositories.NewPayeeRepository(client)
+	recurrenceRepo := repositories.NewRecurrenceRepository(client)
+	importRepo := repositories.NewImportRepository(client)
 
-	// 4. Use case layer
+	// 5. Use case layer
 	signupUseCase := usecase.NewSignupUseCase(userRepo, workspaceRepo, client)
+	loginUseCase := usecase.NewLoginUseCase(userRepo, workspaceRepo)
+	listSessionsUseCase := usecase.NewListSessionsUseCase(sessionRepo)
+	revokeSessionUseCase := usecase.NewRevokeSessionUseCase(sessionRepo)
+	requestPasswordResetUseCase := usecase.NewRequestPasswordResetUseCase(userRepo, resetTokenRepo, mailer, cfg.Client.BaseURL)
+	resetPasswordUseCase := usecase.NewResetPasswordUseCase(client)
+	sendEmailVerificationUseCase := usecase.NewSendEmailVerificationUseCase(userRepo, verificationTokenRepo, mailer, cfg.Client.BaseURL)
+	verifyEmailUseCase := usecase.NewVerifyEmailUseCase(client)
+	listWorkspacesUseCase := usecase.NewListWorkspacesUseCase(membershipRepo)
+	createWorkspaceUseCase := usecase.NewCreateWorkspaceUseCase(client)
+	switchWorkspaceUseCase := usecase.NewSwitchWorkspaceUseCase(workspaceRepo, membershipRepo)
+	updateWorkspaceSettingsUseCase := usecase.NewUpdateWorkspaceSettingsUseCase(workspaceRepo, membershipRepo)
+	deleteWorkspaceUseCase := usecase.NewDeleteWorkspaceUseCase(workspaceRepo, membershipRepo)
+	listMembersUseCase := usecase.NewListMembersUseCase(membershipRepo)
+	setupTwoFactorUseCase := usecase.NewSetupTwoFactorUseCase(userRepo)
+	enableTwoFactorUseCase := usecase.NewEnableTwoFactorUseCase(userRepo, client)
+	disableTwoFactorUseCase := usecase.NewDisableTwoFactorUseCase(userRepo, recoveryCodeRepo, client)
+	verifyTwoFactorLoginUseCase := usecase.NewVerifyTwoFactorLoginUseCase(userRepo, workspaceRepo, recoveryCodeRepo)
+	signupWithInvitationUseCase := usecase.NewSignupWithInvitationUseCase(userRepo, client)
+	createInvitationUseCase := usecase.NewCreateInvitationUseCase(invitationRepo, membershipRepo, workspaceRepo, userRepo, mailer, cfg.Client.BaseURL)
+	listInvitationsUseCase := usecase.NewListInvitationsUseCase(invitationRepo, membershipRepo)
+	revokeInvitationUseCase := usecase.NewRevokeInvitationUseCase(invitationRepo, membershipRepo)
+	acceptInvitationUseCase := usecase.NewAcceptInvitationUseCase(client)
+	listAccountsUseCase := usecase.NewListAccountsUseCase(accountRepo, membershipRepo)
+	getAccountUseCase := usecase.NewGetAccountUseCase(accountRepo, membershipRepo)
+	createAccountUseCase := usecase.NewCreateAccountUseCase(accountRepo, membershipRepo)
+	updateAccountUseCase := usecase.NewUpdateAccountUseCase(accountRepo, membershipRepo)
+	deleteAccountUseCase := usecase.NewDeleteAccountUseCase(accountRepo, journalRepo, membershipRepo)
+	listTransactionsUseCase := usecase.NewListTransactionsUseCase(transactionRepo, categoryRepo, membershipRepo)
+	createTransactionUseCase := usecase.NewCreateTransactionUseCase(accountRepo, categoryRepo, tagRepo, payeeRepo, membershipRepo, client)
+	updateTransactionUseCase := usecase.NewUpdateTransactionUseCase(transactionRepo, accountRepo, categoryRepo, tagRepo, payeeRepo, membershipRepo, client)
+	deleteTransactionUseCase := usecase.NewDeleteTransactionUseCase(transactionRepo, membershipRepo)
+	listCategoriesUseCase := usecase.NewListCategoriesUseCase(categoryRepo, membershipRepo)
+	createCategoryUseCase := usecase.NewCreateCategoryUseCase(categoryRepo, membershipRepo)
+	updateCategoryUseCase := usecase.NewUpdateCategoryUseCase(categoryRepo, membershipRepo)
+	mergeCategoriesUseCase := usecase.NewMergeCategoriesUseCase(categoryRepo, membershipRepo, client)
+	categoryReportUseCase := usecase.NewCategoryReportUseCase(transactionRepo, membershipRepo)
+	tagReportUseCase := usecase.NewTagReportUseCase(transactionRepo, membershipRepo)
+	listTagsUseCase := usecase.NewListTagsUseCase(tagRepo, membershipRepo)
+	createTagUseCase := usecase.NewCreateTagUseCase(tagRepo, membershipRepo)
+	updateTagUseCase := usecase.NewUpdateTagUseCase(tagRepo, membershipRepo)
+	deleteTagUseCase := usecase.NewDeleteTagUseCase(tagRepo, membershipRepo)
+	listPayeesUseCase := usecase.NewListPayeesUseCase(payeeRepo, membershipRepo)
+	createPayeeUseCase := usecase.NewCreatePayeeUseCase(payeeRepo, categoryRepo, membershipRepo)
+	updatePayeeUseCase := usecase.NewUpdatePayeeUseCase(payeeRepo, categoryRepo, membershipRepo)
+	deletePayeeUseCase := usecase.NewDeletePayeeUseCase(payeeRepo, membershipRepo)
+	mergePayeesUseCase := usecase.NewMergePayeesUseCase(payeeRepo, membershipRepo, client)
+	resolvePayeeUseCase := usecase.NewResolvePayeeUseCase(payeeRepo, membershipRepo)
+	listTransfersUseCase := usecase.NewListTransfersUseCase(transferRepo, membershipRepo)
+	getTransferUseCase := usecase.NewGetTransferUseCase(transferRepo, membershipRepo)
+	createTransferUseCase := usecase.NewCreateTransferUseCase(accountRepo, membershipRepo, client)
+	updateTransferUseCase := usecase.NewUpdateTransferUseCase(transferRepo, accountRepo, membershipRepo, client)
+	linkTransferUseCase := usecase.NewLinkTransferUseCase(transactionRepo, membershipRepo, client)
+	matchTransfersUseCase := usecase.NewMatchTransfersUseCase(transactionRepo, membershipRepo, client)
+	unlinkTransferUseCase := usecase.NewUnlinkTransferUseCase(transferRepo, membershipRepo, client)
+	deleteTransferUseCase := usecase.NewDeleteTransferUseCase(transferRepo, membershipRepo, client)
+	listRecurrenceRulesUseCase := usecase.NewListRecurrenceRulesUseCase(recurrenceRepo, membershipRepo)
+	getRecurrenceRuleUseCase := usecase.NewGetRecurrenceRuleUseCase(recurrenceRepo, membershipRepo)
+	createRecurrenceRuleUseCase := usecase.NewCreateRecurrenceRuleUseCase(recurrenceRepo, accountRepo, categoryRepo, payeeRepo, membershipRepo)
+	updateRecurrenceRuleUseCase := usecase.NewUpdateRecurrenceRuleUseCase(recurrenceRepo, accountRepo, categoryRepo, payeeRepo, membershipRepo, client)
+	deleteRecurrenceRuleUseCase := usecase.NewDeleteRecurrenceRuleUseCase(recurrenceRepo, membershipRepo)
+	listUpcomingOccurrencesUseCase := usecase.NewListUpcomingOccurrencesUseCase(recurrenceRepo, membershipRepo)
+	skipOccurrenceUseCase := usecase.NewSkipOccurrenceUseCase(recurrenceRepo, membershipRepo)
+	postOccurrenceUseCase := usecase.NewPostOccurrenceUseCase(recurrenceRepo, membershipRepo, client)
+	runRecurrencesUseCase := usecase.NewRunRecurrencesUseCase(recurrenceRepo, client)
+	uploadStatementUseCase := usecase.NewUploadStatementUseCase(importRepo, accountRepo, membershipRepo)
+	listStatementImportsUseCase := usecase.NewListStatementImportsUseCase(importRepo, membershipRepo)
+	deleteStatementImportUseCase := usecase.NewDeleteStatementImportUseCase(importRepo, membershipRepo)
+	previewStatementImportUseCase := usecase.NewPreviewStatementImportUseCase(importRepo, accountRepo, transactionRepo, membershipRepo)
+	commitStatementImportUseCase := usecase.NewCommitStatementImportUseCase(importRepo, accountRepo, payeeRepo, membershipRepo, client)
+	listImportProfilesUseCase := usecase.NewListImportProfilesUseCase(importRepo, membershipRepo)
+	createImportProfileUseCase := usecase.NewCreateImportProfileUseCase(importRepo, accountRepo, membershipRepo)
+	updateImportProfileUseCase := usecase.NewUpdateImportProfileUseCase(importRepo, membershipRepo)
+	deleteImportProfileUseCase := usecase.NewDeleteImportProfileUseCase(importRepo, membershipRepo)
+	importQIFUseCase := usecase.NewImportQIFUseCase(accountRepo, categoryRepo, payeeRepo, membershipRepo, client)
+	exportQIFUseCase := usecase.NewExportQIFUseCase(accountRepo, categoryRepo, transactionRepo, transferRepo, membershipRepo)
+
+	// 6. Handler layer
+	signupHandler := handler.NewSignupHandler(signupUseCase, signupWithInvitationUseCase, sendEmailVerificationUseCase)
+	loginHandler := handler.NewLoginHandler(loginUseCase, verifyTwoFactorLoginUseCase)
+	sessionHandler := handler.NewSessionHandler(listSessionsUseCase, revokeSessionUseCase)
+	passwordResetHandler := handler.NewPasswordResetHandler(requestPasswordResetUseCase, resetPasswordUseCase)
+	emailVerificationHandler := handler.NewEmailVerificationHandler(sendEmailVerificationUseCase, verifyEmailUseCase)
+	workspaceHandler := handler.NewWorkspaceHandler(
+		listWorkspacesUseCase,
+		createWorkspaceUseCase,
+		switchWorkspaceUseCase,
+		updateWorkspaceSettingsUseCase,
+		deleteWorkspaceUseCase,
+		listMembersUseCase,
+	)
+	twoFactorHandler := handler.NewTwoFactorHandler(setupTwoFactorUseCase, enableTwoFactorUseCase, disableTwoFactorUseCase)
+	invitationHandler := handler.NewInvitationHandler(createInvitationUseCase, listInvitationsUseCase, revokeInvitationUseCase, acceptInvitationUseCase)
+	accountHandler := handler.NewAccountHandler(
+		listAccountsUseCase,
+		getAccountUseCase,
+		createAccountUseCase,
+		updateAccountUseCase,
+		deleteAccountUseCase,
+	)
+	transactionHandler := handler.NewTransactionHandler(
+		listTransactionsUseCase,
+		createTransactionUseCase,
+		updateTransactionUseCase,
+		deleteTransactionUseCase,
+	)
+	categoryHandler := handler.NewCategoryHandler(
+		listCategoriesUseCase,
+		createCategoryUseCase,
+		updateCategoryUseCase,
+		mergeCategoriesUseCase,
+	)
+	tagHandler := handler.NewTagHandler(
+		listTagsUseCase,
+		createTagUseCase,
+		updateTagUseCase,
+		deleteTagUseCase,
+	)
+	payeeHandler := handler.NewPayeeHandler(
+		listPayeesUseCase,
+		createPayeeUseCase,
+		updatePayeeUseCase,
+		deletePayeeUseCase,
+		mergePayeesUseCase,
+		resolvePayeeUseCase,
+	)
+	reportHandler := handler.NewReportHandler(categoryReportUseCase, tagReportUseCase)
+	transferHandler := handler.NewTransferHandler(
+		listTransfersUseCase,
+		getTransferUseCase,
+		createTransferUseCase,
+		updateTransferUseCase,
+		linkTransferUseCase,
+		matchTransfersUseCase,
+		unlinkTransferUseCase,
+		deleteTransferUseCase,
+	)
+	recurrenceHandler := handler.NewRecurrenceHandler(
+		listRecurrenceRulesUseCase,
+		getRecurrenceRuleUseCase,
+		createRecurrenceRuleUseCase,
+		updateRecurrenceRuleUseCase,
+		deleteRecurrenceRuleUseCase,
+		listUpcomingOccurrencesUseCase,
+		skipOccurrenceUseCase,
+		postOccurrenceUseCase,
+	)
+	importHandler := handler.NewImportHandler(
+		uploadStatementUseCase,
+		listStatementImportsUseCase,
+		deleteStatementImportUseCase,
+		previewStatementImportUseCase,
+		commitStatementImportUseCase,
+		listImportProfilesUseCase,
+		createImportProfileUseCase,
+		updateImportProfileUseCase,
+		deleteImportProfileUseCase,
+	)
+	qifHandler := handler.NewQIFHandler(importQIFUseCase, exportQIFUseCase)
+
+	// 7. Middleware setup
+	requireAuth := middleware.RequireAuth(userRepo, workspaceRepo, membershipRepo)
+	requireWorkspaceMember := middleware.RequireWorkspaceMember(workspaceRepo, membershipRepo)
 
-	// 5. Handler layer
-	signupHandler := handler.NewSignupHandler(signupUseCase)
+	// 8. Router setup
+	r := router.SetupRouter(
+		signupHandler,
+		loginHandler,
+		sessionHandler,
+		passwordResetHandler,
+		emailVerificationHandler,
+		workspaceHandler,
+		twoFactorHandler,
+		invitationHandler,
+		accountHandler,
+		transactionHandler,
+		categoryHandler,
+		tagHandler,
+		payeeHandler,
+		reportHandler,
+		transferHandler,
+		recurrenceHandler,
+		importHandler,
+		qifHandler,
+		requireAuth,
+		requireWorkspaceMember,
+	)
 
-	// 6. Router setup
-	r := router.SetupRouter(signupHandler)
+	// 9. Background jobs
+	startRecurrenceScheduler(cfg.Scheduler, runRecurrencesUseCase)
 
-	// 7. Server startup
+	// 10. Server startup
 	log.Printf("Server starting on port %s", cfg.Server.Port)
 	if err := r.Run(":" + cfg.Server.Port); err != nil {
 		log.Fatalf("Failed to start server: %v", err)
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"backend/internal/application/usecase"
+	"backend/internal/config"
+)
+
+// startRecurrenceScheduler posts due recurring transactions and schedules upcoming ones in the background,
+// once at startup and then on every tick. It does nothing when the interval is zero.
+func startRecurrenceScheduler(cfg config.SchedulerConfig, runRecurrencesUseCase *usecase.RunRecurrencesUseCase) {
+	interval, err := time.ParseDuration(cfg.RecurrenceInterval)
+	if err != nil || interval < 0 {
+		log.Fatalf("Invalid RECURRENCE_SCHEDULER_INTERVAL: %s", cfg.RecurrenceInterval)
+	}
+	horizonDays, err := strconv.Atoi(cfg.RecurrenceHorizonDays)
+	if err != nil || horizonDays < 0 {
+		log.Fatalf("Invalid RECURRENCE_HORIZON_DAYS: %s", cfg.RecurrenceHorizonDays)
+	}
+	if interval == 0 {
+		log.Println("Recurrence scheduler disabled")
+		return
+	}
+
+	run := func() {
+		result, err := runRecurrencesUseCase.Execute(context.Background(), time.Now(), horizonDays)
+		if result.Materialized > 0 || result.Posted > 0 {
+			log.Printf("Recurrence scheduler scheduled %d and posted %d occurrences", result.Materialized, result.Posted)
+		}
+		if err != nil {
+			log.Printf("Recurrence scheduler run failed: %v", err)
+		}
+	}
+
+	go func() {
+		run()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			run()
+		}
+	}()
+	log.Printf("Recurrence scheduler started (every %s)", interval)
+}
@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+
+	"backend/internal/domain/model"
+)
+
+// Principal is the authenticated user together with the workspace the session is acting in.
+// Workspace and Membership are nil when the user no longer belongs to any workspace.
+type Principal struct {
+	User       *model.User
+	Workspace  *model.Workspace
+	Membership *model.Membership // The user's membership (and role) in Workspace
+}
+
+// UserID returns the ID of the authenticated user
+func (p *Principal) UserID() int {
+	return p.User.ID
+}
+
+// HasWorkspace reports whether the session has an active workspace
+func (p *Principal) HasWorkspace() bool {
+	return p.Workspace != nil
+}
+
+// WorkspaceID returns the ID of the active workspace, or 0 without one
+func (p *Principal) WorkspaceID() int {
+	if !p.HasWorkspace() {
+		return 0
+	}
+	return p.Workspace.ID
+}
+
+// Role returns the user's role in the active workspace, or an empty role without one
+func (p *Principal) Role() model.Role {
+	if p.Membership == nil {
+		return ""
+	}
+	return p.Membership.Role
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the principal
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal stored in ctx, if any
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
@@ -0,0 +1,252 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/repositories"
+	"backend/internal/infrastructure/tenant"
+)
+
+var (
+	// ErrAccountNotFound is returned when an account does not exist in the workspace
+	ErrAccountNotFound = errors.New("account not found")
+	// ErrAccountHasTransactions is returned when deleting an account that still has transactions
+	ErrAccountHasTransactions = errors.New("account has transactions")
+)
+
+// AccountInput holds the user-editable fields of an account
+type AccountInput struct {
+	Name           string
+	Type           model.AccountType
+	Institution    string
+	Currency       string        // Only used on creation; the currency of an account cannot change
+	OpeningBalance model.Decimal // In major units of the account currency
+	OpenedOn       *time.Time
+	ClosedOn       *time.Time
+	Archived       bool
+}
+
+// apply copies the input onto the account, normalizing text fields.
+// The account currency has to be set beforehand since the opening balance is expressed in it.
+func (in AccountInput) apply(a *model.Account) error {
+	if err := service.ValidateCurrencyCode(a.Currency); err != nil {
+		return err
+	}
+	openingBalance, err := model.MoneyFromDecimal(in.OpeningBalance, a.Currency)
+	if err != nil {
+		return err
+	}
+
+	a.Name = strings.TrimSpace(in.Name)
+	a.Type = in.Type
+	a.Institution = strings.TrimSpace(in.Institution)
+	a.OpeningBalance = openingBalance
+	a.OpenedOn = in.OpenedOn
+	a.ClosedOn = in.ClosedOn
+	a.Archived = in.Archived
+	return nil
+}
+
+type ListAccountsUseCase struct {
+	accountRepo    *repositories.AccountRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewListAccountsUseCase(
+	accountRepo *repositories.AccountRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *ListAccountsUseCase {
+	return &ListAccountsUseCase{
+		accountRepo:    accountRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute returns the accounts of the workspace, optionally including archived ones
+func (uc *ListAccountsUseCase) Execute(ctx context.Context, userID int, workspaceID int, includeArchived bool) ([]*model.Account, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsRead); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	accounts, err := uc.accountRepo.ListAccounts(ctx, workspaceID, includeArchived)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts: %w", err)
+	}
+	return accounts, nil
+}
+
+type GetAccountUseCase struct {
+	accountRepo    *repositories.AccountRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewGetAccountUseCase(
+	accountRepo *repositories.AccountRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *GetAccountUseCase {
+	return &GetAccountUseCase{
+		accountRepo:    accountRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute returns a single account of the workspace
+func (uc *GetAccountUseCase) Execute(ctx context.Context, userID int, workspaceID int, accountID int) (*model.Account, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsRead); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	account, err := uc.accountRepo.GetAccount(ctx, workspaceID, accountID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+	return account, nil
+}
+
+type CreateAccountUseCase struct {
+	accountRepo    *repositories.AccountRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewCreateAccountUseCase(
+	accountRepo *repositories.AccountRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *CreateAccountUseCase {
+	return &CreateAccountUseCase{
+		accountRepo:    accountRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute creates an account in the workspace
+func (uc *CreateAccountUseCase) Execute(ctx context.Context, userID int, workspaceID int, input AccountInput) (*model.Account, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionAccountsManage); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	account := &model.Account{
+		WorkspaceID: workspaceID,
+		Currency:    strings.ToUpper(strings.TrimSpace(input.Currency)),
+	}
+	if err := input.apply(account); err != nil {
+		return nil, err
+	}
+	if err := service.ValidateAccount(account); err != nil {
+		return nil, err
+	}
+
+	account, err := uc.accountRepo.CreateAccount(ctx, account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create account: %w", err)
+	}
+	return account, nil
+}
+
+type UpdateAccountUseCase struct {
+	accountRepo    *repositories.AccountRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewUpdateAccountUseCase(
+	accountRepo *repositories.AccountRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *UpdateAccountUseCase {
+	return &UpdateAccountUseCase{
+		accountRepo:    accountRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute replaces the editable fields of an account; input.Currency is ignored
+func (uc *UpdateAccountUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	accountID int,
+	input AccountInput,
+) (*model.Account, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionAccountsManage); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	account, err := uc.accountRepo.GetAccount(ctx, workspaceID, accountID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	if err := input.apply(account); err != nil {
+		return nil, err
+	}
+	if err := service.ValidateAccount(account); err != nil {
+		return nil, err
+	}
+
+	account, err = uc.accountRepo.UpdateAccount(ctx, account)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("failed to update account: %w", err)
+	}
+	return account, nil
+}
+
+type DeleteAccountUseCase struct {
+	accountRepo    *repositories.AccountRepository
+	journalRepo    *repositories.JournalRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewDeleteAccountUseCase(
+	accountRepo *repositories.AccountRepository,
+	journalRepo *repositories.JournalRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *DeleteAccountUseCase {
+	return &DeleteAccountUseCase{
+		accountRepo:    accountRepo,
+		journalRepo:    journalRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute deletes an account of the workspace; accounts with history have to be archived instead
+func (uc *DeleteAccountUseCase) Execute(ctx context.Context, userID int, workspaceID int, accountID int) error {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionAccountsManage); err != nil {
+		return err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	hasPostings, err := uc.journalRepo.AccountHasPostings(ctx, workspaceID, accountID)
+	if err != nil {
+		return fmt.Errorf("failed to check account postings: %w", err)
+	}
+	if hasPostings {
+		return ErrAccountHasTransactions
+	}
+
+	deleted, err := uc.accountRepo.DeleteAccount(ctx, workspaceID, accountID)
+	if err != nil {
+		return fmt.Errorf("failed to delete account: %w", err)
+	}
+	if !deleted {
+		return ErrAccountNotFound
+	}
+	return nil
+}
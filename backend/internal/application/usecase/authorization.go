@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/repositories"
+)
+
+// authorize loads the user's membership in the workspace and checks that its role grants the permission.
+// Non-members get ErrWorkspaceNotFound so that the workspace's existence is not revealed;
+// members without the permission get service.ErrPermissionDenied.
+func authorize(
+	ctx context.Context,
+	membershipRepo *repositories.MembershipRepository,
+	workspaceID int,
+	userID int,
+	permission service.Permission,
+) (*model.Membership, error) {
+	membership, err := membershipRepo.GetMembership(ctx, workspaceID, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrWorkspaceNotFound
+		}
+		return nil, fmt.Errorf("failed to get membership: %w", err)
+	}
+
+	if err := service.Authorize(membership, permission); err != nil {
+		return nil, err
+	}
+	return membership, nil
+}
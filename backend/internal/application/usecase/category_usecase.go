@@ -0,0 +1,321 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/repositories"
+	"backend/internal/infrastructure/tenant"
+)
+
+var (
+	// ErrCategoryNotFound is returned when a category does not exist in the workspace
+	ErrCategoryNotFound = errors.New("category not found")
+	// ErrParentCategoryNotFound is returned when the parent of a category does not exist in the workspace
+	ErrParentCategoryNotFound = errors.New("parent category not found")
+)
+
+// CategoryInput holds the user-editable fields of a category
+type CategoryInput struct {
+	ParentID *int // Nil for a top-level category
+	Name     string
+	Kind     model.CategoryKind // Only used on creation; the kind of a category cannot change
+	Color    string
+	Icon     string
+	Archived bool
+}
+
+// apply copies the input onto the category, normalizing text fields
+func (in CategoryInput) apply(c *model.Category) {
+	c.ParentID = in.ParentID
+	c.Name = strings.TrimSpace(in.Name)
+	c.Color = strings.ToUpper(strings.TrimSpace(in.Color))
+	c.Icon = strings.TrimSpace(in.Icon)
+	c.Archived = in.Archived
+}
+
+type ListCategoriesUseCase struct {
+	categoryRepo   *repositories.CategoryRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewListCategoriesUseCase(
+	categoryRepo *repositories.CategoryRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *ListCategoriesUseCase {
+	return &ListCategoriesUseCase{
+		categoryRepo:   categoryRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute returns the categories of the workspace, optionally including archived ones
+func (uc *ListCategoriesUseCase) Execute(ctx context.Context, userID int, workspaceID int, includeArchived bool) ([]*model.Category, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsRead); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	categories, err := uc.categoryRepo.ListCategories(ctx, workspaceID, includeArchived)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	return categories, nil
+}
+
+type CreateCategoryUseCase struct {
+	categoryRepo   *repositories.CategoryRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewCreateCategoryUseCase(
+	categoryRepo *repositories.CategoryRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *CreateCategoryUseCase {
+	return &CreateCategoryUseCase{
+		categoryRepo:   categoryRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute creates a category in the workspace
+func (uc *CreateCategoryUseCase) Execute(ctx context.Context, userID int, workspaceID int, input CategoryInput) (*model.Category, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionCategoriesManage); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	category := &model.Category{
+		WorkspaceID: workspaceID,
+		Kind:        input.Kind,
+	}
+	input.apply(category)
+	if err := service.ValidateCategory(category); err != nil {
+		return nil, err
+	}
+
+	if category.ParentID != nil {
+		parent, err := uc.categoryRepo.GetCategory(ctx, workspaceID, *category.ParentID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, ErrParentCategoryNotFound
+			}
+			return nil, fmt.Errorf("failed to get parent category: %w", err)
+		}
+		if err := service.ValidateCategoryParent(category, parent, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	category, err := uc.categoryRepo.CreateCategory(ctx, category)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create category: %w", err)
+	}
+	return category, nil
+}
+
+type UpdateCategoryUseCase struct {
+	categoryRepo   *repositories.CategoryRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewUpdateCategoryUseCase(
+	categoryRepo *repositories.CategoryRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *UpdateCategoryUseCase {
+	return &UpdateCategoryUseCase{
+		categoryRepo:   categoryRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute replaces the editable fields of a category; changing the parent moves the whole subtree.
+// input.Kind is ignored.
+func (uc *UpdateCategoryUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	categoryID int,
+	input CategoryInput,
+) (*model.Category, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionCategoriesManage); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	categories, err := uc.categoryRepo.ListCategories(ctx, workspaceID, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	category := findCategory(categories, categoryID)
+	if category == nil {
+		return nil, ErrCategoryNotFound
+	}
+
+	input.apply(category)
+	if err := service.ValidateCategory(category); err != nil {
+		return nil, err
+	}
+	if category.ParentID != nil {
+		parent := findCategory(categories, *category.ParentID)
+		if parent == nil {
+			return nil, ErrParentCategoryNotFound
+		}
+		if err := service.ValidateCategoryParent(category, parent, categories); err != nil {
+			return nil, err
+		}
+	}
+
+	category, err = uc.categoryRepo.UpdateCategory(ctx, category)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+	return category, nil
+}
+
+type MergeCategoriesUseCase struct {
+	categoryRepo   *repositories.CategoryRepository
+	membershipRepo *repositories.MembershipRepository
+	client         *ent.Client
+}
+
+func NewMergeCategoriesUseCase(
+	categoryRepo *repositories.CategoryRepository,
+	membershipRepo *repositories.MembershipRepository,
+	client *ent.Client,
+) *MergeCategoriesUseCase {
+	return &MergeCategoriesUseCase{
+		categoryRepo:   categoryRepo,
+		membershipRepo: membershipRepo,
+		client:         client,
+	}
+}
+
+// Execute merges the source category into the target: the source's transactions, subcategories and
+// payees defaulting to it move to the target and the source is deleted. The target is returned.
+func (uc *MergeCategoriesUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	sourceID int,
+	targetID int,
+) (*model.Category, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionCategoriesManage); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	categories, err := uc.categoryRepo.ListCategories(ctx, workspaceID, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	source := findCategory(categories, sourceID)
+	if source == nil {
+		return nil, ErrCategoryNotFound
+	}
+	target := findCategory(categories, targetID)
+	if target == nil {
+		return nil, ErrCategoryNotFound
+	}
+	if source.Kind != target.Kind {
+		return nil, service.ErrCategoryKindMismatch
+	}
+	// The source's subcategories end up under the target, so the target must not be one of them
+	if service.IsCategoryInSubtree(categories, source.ID, target.ID) {
+		return nil, service.ErrCategoryCycle
+	}
+
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	err = repositories.NewTransactionRepository(tx.Client()).ReassignCategory(ctx, workspaceID, source.ID, target.ID)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to reassign transactions: %w", err))
+	}
+	err = repositories.NewPayeeRepository(tx.Client()).ReassignDefaultCategory(ctx, workspaceID, source.ID, target.ID)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to reassign payee default categories: %w", err))
+	}
+	err = repositories.NewRecurrenceRepository(tx.Client()).ReassignCategory(ctx, workspaceID, source.ID, target.ID)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to reassign recurrence rules: %w", err))
+	}
+	categoryRepo := repositories.NewCategoryRepository(tx.Client())
+	if err := categoryRepo.ReparentChildren(ctx, workspaceID, source.ID, target.ID); err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to move subcategories: %w", err))
+	}
+	if _, err := categoryRepo.DeleteCategory(ctx, workspaceID, source.ID); err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to delete category: %w", err))
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return target, nil
+}
+
+// findCategory returns the category with the ID, or nil
+func findCategory(categories []*model.Category, id int) *model.Category {
+	for _, c := range categories {
+		if c.ID == id {
+			return c
+		}
+	}
+	return nil
+}
+
+// seedDefaultCategories creates the default category tree in a new workspace.
+// client may belong to the ent transaction that creates the workspace.
+func seedDefaultCategories(ctx context.Context, client *ent.Client, workspaceID int) error {
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+	categoryRepo := repositories.NewCategoryRepository(client)
+
+	var seed func(templates []service.CategoryTemplate, parent *model.Category) error
+	seed = func(templates []service.CategoryTemplate, parent *model.Category) error {
+		for _, template := range templates {
+			category := &model.Category{
+				WorkspaceID: workspaceID,
+				Name:        template.Name,
+				Kind:        template.Kind,
+				Color:       template.Color,
+				Icon:        template.Icon,
+			}
+			if parent != nil {
+				category.ParentID = &parent.ID
+				category.Kind = parent.Kind
+				category.Color = parent.Color
+				category.Icon = parent.Icon
+			}
+
+			created, err := categoryRepo.CreateCategory(ctx, category)
+			if err != nil {
+				return fmt.Errorf("failed to create category %q: %w", template.Name, err)
+			}
+			if err := seed(template.Children, created); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return seed(service.DefaultCategoryTemplates, nil)
+}
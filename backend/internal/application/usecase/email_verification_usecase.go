@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/emailverificationtoken"
+	"backend/internal/infrastructure/mail"
+	"backend/internal/infrastructure/repositories"
+)
+
+// emailVerificationTokenTTL is how long an emailed verification link stays valid
+const emailVerificationTokenTTL = 24 * time.Hour
+
+var (
+	// ErrInvalidVerificationToken is returned when a verification token is unknown, expired or already used
+	ErrInvalidVerificationToken = errors.New("invalid or expired verification token")
+	// ErrEmailAlreadyVerified is returned when asking to verify an address that is already verified
+	ErrEmailAlreadyVerified = errors.New("email already verified")
+)
+
+type SendEmailVerificationUseCase struct {
+	userRepo              *repositories.UserRepository
+	verificationTokenRepo *repositories.EmailVerificationTokenRepository
+	mailer                mail.Mailer
+	clientBaseURL         string
+}
+
+func NewSendEmailVerificationUseCase(
+	userRepo *repositories.UserRepository,
+	verificationTokenRepo *repositories.EmailVerificationTokenRepository,
+	mailer mail.Mailer,
+	clientBaseURL string,
+) *SendEmailVerificationUseCase {
+	return &SendEmailVerificationUseCase{
+		userRepo:              userRepo,
+		verificationTokenRepo: verificationTokenRepo,
+		mailer:                mailer,
+		clientBaseURL:         clientBaseURL,
+	}
+}
+
+// Execute emails a fresh verification link to the user, invalidating earlier links
+func (uc *SendEmailVerificationUseCase) Execute(ctx context.Context, userID int) error {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.IsEmailVerified() {
+		return ErrEmailAlreadyVerified
+	}
+
+	if err := uc.verificationTokenRepo.InvalidateUnusedTokens(ctx, user.ID); err != nil {
+		return fmt.Errorf("failed to invalidate verification tokens: %w", err)
+	}
+
+	token, err := service.GenerateToken()
+	if err != nil {
+		return err
+	}
+	expiresAt := time.Now().Add(emailVerificationTokenTTL)
+	if err := uc.verificationTokenRepo.CreateToken(ctx, user.ID, service.HashToken(token), expiresAt); err != nil {
+		return fmt.Errorf("failed to create verification token: %w", err)
+	}
+
+	link := uc.clientBaseURL + "/verify-email?token=" + url.QueryEscape(token)
+	err = uc.mailer.Send(ctx, mail.Message{
+		To:      user.Email,
+		Subject: "Confirm your FinSight email address",
+		Body: "Welcome to FinSight!\n\n" +
+			"Open the link below within 24 hours to confirm your email address:\n\n" +
+			link + "\n\n" +
+			"If you did not create an account, you can ignore this email.\n",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send verification email: %w", err)
+	}
+
+	return nil
+}
+
+type VerifyEmailUseCase struct {
+	client *ent.Client
+}
+
+func NewVerifyEmailUseCase(client *ent.Client) *VerifyEmailUseCase {
+	return &VerifyEmailUseCase{client: client}
+}
+
+// Execute consumes the verification token and marks the user's email as verified
+func (uc *VerifyEmailUseCase) Execute(ctx context.Context, token string) error {
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	// Claim the token; the used_at condition makes concurrent requests with the same token fail
+	now := time.Now()
+	verificationToken, err := tx.EmailVerificationToken.
+		Query().
+		Where(
+			emailverificationtoken.TokenHash(service.HashToken(token)),
+			emailverificationtoken.UsedAtIsNil(),
+			emailverificationtoken.ExpiresAtGT(now),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return rollback(tx, ErrInvalidVerificationToken)
+		}
+		return rollback(tx, fmt.Errorf("failed to get verification token: %w", err))
+	}
+	claimed, err := tx.EmailVerificationToken.
+		Update().
+		Where(
+			emailverificationtoken.ID(verificationToken.ID),
+			emailverificationtoken.UsedAtIsNil(),
+		).
+		SetUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to mark verification token as used: %w", err))
+	}
+	if claimed == 0 {
+		return rollback(tx, ErrInvalidVerificationToken)
+	}
+
+	// Mark email as verified
+	if err := tx.User.UpdateOneID(verificationToken.UserID).SetEmailVerifiedAt(now).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to verify email: %w", err))
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,1081 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/repositories"
+	"backend/internal/infrastructure/tenant"
+)
+
+const (
+	// MaxStatementSize is the largest statement file accepted for import
+	MaxStatementSize = 5 << 20
+	// previewRowLimit is how many rows a statement preview shows
+	previewRowLimit = 20
+)
+
+var (
+	// ErrStatementImportNotFound is returned when a statement import does not exist in the workspace
+	ErrStatementImportNotFound = errors.New("statement import not found")
+	// ErrImportAlreadyCommitted is returned when previewing or committing an import whose transactions were already created
+	ErrImportAlreadyCommitted = errors.New("statement import has already been committed")
+	// ErrStatementTooLarge is returned for statement files larger than MaxStatementSize
+	ErrStatementTooLarge = errors.New("statement file is too large")
+	// ErrImportProfileNotFound is returned when an import profile does not exist in the workspace
+	ErrImportProfileNotFound = errors.New("import profile not found")
+	// ErrImportProfileNameTaken is returned when an account already has an import profile of the same name
+	ErrImportProfileNameTaken = errors.New("import profile name already exists")
+	// ErrStatementCurrencyMismatch is returned for statements stating a currency other than that of their account
+	ErrStatementCurrencyMismatch = errors.New("statement currency does not match the account currency")
+	// ErrInvalidRowMerge is returned when a confirmed merge names a row without possible duplicates, a transaction
+	// that is not one of them, or a transaction another row is merged into
+	ErrInvalidRowMerge = errors.New("a row can only be merged into one of its possible duplicates, and each transaction into one row")
+)
+
+// CSVMappingSelection picks the mapping a CSV statement is read with: the given mapping, else the given
+// profile, else the most recently used profile of the statement's account, else a guess from the file.
+// Statements in other formats describe their own layout and ignore it.
+type CSVMappingSelection struct {
+	ProfileID *int
+	Mapping   *model.CSVMapping
+}
+
+// StatementPreview shows how a statement would be imported. The mapping fields are only set for CSV statements.
+type StatementPreview struct {
+	Import     *model.StatementImport
+	Mapping    *model.CSVMapping
+	ProfileID  *int         // Profile the mapping was taken from, if any
+	Guessed    bool         // Whether the mapping was guessed from the file
+	MappingErr error        // Why a guessed mapping cannot be used as is; Rows is empty then
+	Columns    []string     // Header row of the statement; empty without one
+	Cells      [][]string   // Fields of the first rows, as in the file
+	Rows       []PreviewRow // First rows as read with the mapping
+	TotalRows  int
+	Duplicates []PreviewRow                 // Every row with possible duplicates, including those past the first rows
+	Balance    *model.StatementBalanceCheck // Closing balance against the account balance after the import; nil when the statement has none
+}
+
+// PreviewRow is a statement row as it would be imported
+type PreviewRow struct {
+	model.StatementRow
+	DuplicateOf *int                   // Transaction the row was imported as or merged into before; the row will be skipped
+	Matches     []model.DuplicateMatch // Transactions of the account the row may duplicate, best first; merged only when confirmed
+}
+
+// ImportedRow reports what became of one statement row on commit
+type ImportedRow struct {
+	Line          int
+	TransactionID *int  // Set when a transaction was created
+	DuplicateOf   *int  // Set when the row was skipped because it was imported before as this transaction
+	MergedInto    *int  // Set when the row was merged into this transaction as confirmed
+	Err           error // Set when the row was rejected
+}
+
+// RowMerge confirms that a statement row duplicates an existing transaction of its account, so that the row is
+// merged into the transaction instead of being imported as a new one
+type RowMerge struct {
+	Line          int
+	TransactionID int
+}
+
+// StatementImportResult is the outcome of committing a statement import
+type StatementImportResult struct {
+	Import  *model.StatementImport
+	Profile *model.ImportProfile         // Set when the mapping was saved as a profile
+	Balance *model.StatementBalanceCheck // Set when the statement states its closing balance
+	Rows    []ImportedRow
+}
+
+// ImportProfileInput holds the user-editable fields of an import profile; the account cannot be changed
+type ImportProfileInput struct {
+	AccountID int
+	Name      string
+	Mapping   model.CSVMapping
+}
+
+type UploadStatementUseCase struct {
+	importRepo     *repositories.ImportRepository
+	accountRepo    *repositories.AccountRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewUploadStatementUseCase(
+	importRepo *repositories.ImportRepository,
+	accountRepo *repositories.AccountRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *UploadStatementUseCase {
+	return &UploadStatementUseCase{
+		importRepo:     importRepo,
+		accountRepo:    accountRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute stores a statement file for import into an account. Statements in formats other than CSV are
+// checked to be readable right away. No transactions are created until the import is committed.
+func (uc *UploadStatementUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	accountID int,
+	filename string,
+	content []byte,
+) (*model.StatementImport, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	if len(content) > MaxStatementSize {
+		return nil, ErrStatementTooLarge
+	}
+	account, err := getWorkspaceAccount(ctx, uc.accountRepo, workspaceID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	format, err := service.DetectStatementFormat(filename, content)
+	if err != nil {
+		return nil, err
+	}
+	if format != model.StatementFormatCSV {
+		statement, err := service.ParseStatement(format, content)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkStatementCurrency(statement, account); err != nil {
+			return nil, err
+		}
+	}
+
+	statementImport, err := uc.importRepo.CreateImport(ctx, &model.StatementImport{
+		WorkspaceID: workspaceID,
+		AccountID:   accountID,
+		Format:      format,
+		Filename:    filename,
+		Content:     content,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create statement import: %w", err)
+	}
+	return statementImport, nil
+}
+
+type ListStatementImportsUseCase struct {
+	importRepo     *repositories.ImportRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewListStatementImportsUseCase(
+	importRepo *repositories.ImportRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *ListStatementImportsUseCase {
+	return &ListStatementImportsUseCase{
+		importRepo:     importRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute returns the statement imports of the workspace, newest first
+func (uc *ListStatementImportsUseCase) Execute(ctx context.Context, userID int, workspaceID int) ([]*model.StatementImport, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsRead); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	imports, err := uc.importRepo.ListImports(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list statement imports: %w", err)
+	}
+	return imports, nil
+}
+
+type DeleteStatementImportUseCase struct {
+	importRepo     *repositories.ImportRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewDeleteStatementImportUseCase(
+	importRepo *repositories.ImportRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *DeleteStatementImportUseCase {
+	return &DeleteStatementImportUseCase{
+		importRepo:     importRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute deletes a statement import and its file; transactions it created are kept
+func (uc *DeleteStatementImportUseCase) Execute(ctx context.Context, userID int, workspaceID int, importID int) error {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	deleted, err := uc.importRepo.DeleteImport(ctx, workspaceID, importID)
+	if err != nil {
+		return fmt.Errorf("failed to delete statement import: %w", err)
+	}
+	if !deleted {
+		return ErrStatementImportNotFound
+	}
+	return nil
+}
+
+type PreviewStatementImportUseCase struct {
+	importRepo      *repositories.ImportRepository
+	accountRepo     *repositories.AccountRepository
+	transactionRepo *repositories.TransactionRepository
+	membershipRepo  *repositories.MembershipRepository
+}
+
+func NewPreviewStatementImportUseCase(
+	importRepo *repositories.ImportRepository,
+	accountRepo *repositories.AccountRepository,
+	transactionRepo *repositories.TransactionRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *PreviewStatementImportUseCase {
+	return &PreviewStatementImportUseCase{
+		importRepo:      importRepo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		membershipRepo:  membershipRepo,
+	}
+}
+
+// Execute reads the first rows of a pending statement, CSV statements with the selected mapping, so that
+// the import can be checked and corrected before committing. Rows imported before are marked, as are the
+// existing transactions other rows may duplicate, and a stated closing balance is compared with the balance
+// the account would have after the import.
+// A guessed mapping that cannot read the statement is returned with MappingErr set rather than as an error,
+// together with the raw fields to fix it from.
+func (uc *PreviewStatementImportUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	importID int,
+	selection CSVMappingSelection,
+) (*StatementPreview, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsRead); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	statementImport, err := getPendingStatementImport(ctx, uc.importRepo, workspaceID, importID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := getWorkspaceAccount(ctx, uc.accountRepo, workspaceID, statementImport.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	preview := &StatementPreview{Import: statementImport}
+
+	var statement *model.Statement
+	if statementImport.Format == model.StatementFormatCSV {
+		statement, err = uc.previewCSVStatement(ctx, preview, selection)
+	} else {
+		statement, err = service.ParseStatement(statementImport.Format, statementImport.Content)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if statement == nil {
+		return preview, nil
+	}
+
+	duplicates, err := findStatementDuplicates(ctx, uc.importRepo, uc.transactionRepo, account, statement.Rows)
+	if err != nil {
+		return nil, err
+	}
+	var pending []model.StatementRow
+	pendingExternalIDs := make(map[string]bool)
+	preview.Rows = make([]PreviewRow, 0, min(len(statement.Rows), previewRowLimit))
+	for i, row := range statement.Rows {
+		if row.Err == nil && duplicates.duplicateOf[i] == nil && !pendingExternalIDs[row.ExternalID] {
+			pending = append(pending, row)
+			if row.ExternalID != "" {
+				pendingExternalIDs[row.ExternalID] = true
+			}
+		}
+		previewRow := PreviewRow{
+			StatementRow: row,
+			DuplicateOf:  duplicates.duplicateOf[i],
+			Matches:      duplicates.matches[i],
+		}
+		if len(preview.Rows) < previewRowLimit {
+			preview.Rows = append(preview.Rows, previewRow)
+		}
+		if len(previewRow.Matches) > 0 {
+			preview.Duplicates = append(preview.Duplicates, previewRow)
+		}
+	}
+	preview.TotalRows = len(statement.Rows)
+	if statement.ClosingBalance != nil {
+		preview.Balance, err = checkStatementBalance(ctx, uc.accountRepo, account, *statement.ClosingBalance, pending)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return preview, nil
+}
+
+// previewCSVStatement fills in the mapping and raw fields of a CSV statement preview and reads its rows.
+// It returns no statement when a guessed mapping cannot read it.
+func (uc *PreviewStatementImportUseCase) previewCSVStatement(
+	ctx context.Context,
+	preview *StatementPreview,
+	selection CSVMappingSelection,
+) (*model.Statement, error) {
+	mapping, profileID, guessed, err := selectCSVMapping(ctx, uc.importRepo, preview.Import, selection)
+	if err != nil {
+		return nil, err
+	}
+	preview.Mapping, preview.ProfileID, preview.Guessed = &mapping, profileID, guessed
+	if err := service.ValidateCSVMapping(preview.Mapping); err != nil {
+		if !guessed {
+			return nil, err
+		}
+		preview.MappingErr = err
+	}
+
+	statement, err := service.ReadCSVStatement(preview.Import.Content, mapping)
+	if err != nil {
+		return nil, err
+	}
+	preview.Columns = statement.Header
+	preview.Cells = statement.Records[:min(len(statement.Records), previewRowLimit)]
+	preview.TotalRows = len(statement.Records)
+	if preview.MappingErr != nil {
+		return nil, nil
+	}
+	return &model.Statement{Rows: statement.Rows(mapping)}, nil
+}
+
+type CommitStatementImportUseCase struct {
+	importRepo     *repositories.ImportRepository
+	accountRepo    *repositories.AccountRepository
+	payeeRepo      *repositories.PayeeRepository
+	membershipRepo *repositories.MembershipRepository
+	client         *ent.Client
+}
+
+func NewCommitStatementImportUseCase(
+	importRepo *repositories.ImportRepository,
+	accountRepo *repositories.AccountRepository,
+	payeeRepo *repositories.PayeeRepository,
+	membershipRepo *repositories.MembershipRepository,
+	client *ent.Client,
+) *CommitStatementImportUseCase {
+	return &CommitStatementImportUseCase{
+		importRepo:     importRepo,
+		accountRepo:    accountRepo,
+		payeeRepo:      payeeRepo,
+		membershipRepo: membershipRepo,
+		client:         client,
+	}
+}
+
+// Execute creates the transactions of a pending statement as cleared transactions of its account,
+// all in one database transaction. Rows that cannot be read or do not make a valid transaction are
+// skipped and reported, as are rows committed before and rows whose external ID the account already has
+// a transaction for, so that importing the same transactions again creates nothing; any other failure
+// creates nothing. Rows the user confirmed as duplicates of existing transactions are merged into them
+// rather than created; other possible duplicates are created. What became of each row is recorded.
+// A stated closing balance is compared with the balance of the account afterwards. With saveProfileAs set
+// the mapping of a CSV statement is saved as an import profile of the account, replacing a profile of that name.
+func (uc *CommitStatementImportUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	importID int,
+	selection CSVMappingSelection,
+	merges []RowMerge,
+	saveProfileAs string,
+) (*StatementImportResult, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	statementImport, err := getPendingStatementImport(ctx, uc.importRepo, workspaceID, importID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := getWorkspaceAccount(ctx, uc.accountRepo, workspaceID, statementImport.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var statement *model.Statement
+	var profileID *int
+	var profile *model.ImportProfile
+	if statementImport.Format == model.StatementFormatCSV {
+		var mapping model.CSVMapping
+		mapping, profileID, _, err = selectCSVMapping(ctx, uc.importRepo, statementImport, selection)
+		if err != nil {
+			return nil, err
+		}
+		if err := service.ValidateCSVMapping(&mapping); err != nil {
+			return nil, err
+		}
+		if saveProfileAs = strings.TrimSpace(saveProfileAs); saveProfileAs != "" {
+			profile, err = findAccountImportProfile(ctx, uc.importRepo, workspaceID, account.ID, saveProfileAs)
+			if err != nil {
+				return nil, err
+			}
+			if profile == nil {
+				profile = &model.ImportProfile{WorkspaceID: workspaceID, AccountID: account.ID, Name: saveProfileAs}
+			}
+			profile.Mapping = mapping
+		}
+
+		rows, err := service.ParseCSVStatement(statementImport.Content, mapping)
+		if err != nil {
+			return nil, err
+		}
+		statement = &model.Statement{Rows: rows}
+	} else {
+		statement, err = service.ParseStatement(statementImport.Format, statementImport.Content)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := checkStatementCurrency(statement, account); err != nil {
+		return nil, err
+	}
+	payees, err := uc.payeeRepo.ListPayees(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payees: %w", err)
+	}
+
+	result := &StatementImportResult{Rows: make([]ImportedRow, 0, len(statement.Rows))}
+	transactions := make([]*model.Transaction, len(statement.Rows))
+	for i, row := range statement.Rows {
+		transaction, err := statementRowTransaction(row, account, payees)
+		transactions[i] = transaction
+		result.Rows = append(result.Rows, ImportedRow{Line: row.Line, Err: err})
+	}
+
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	transactionRepo := repositories.NewTransactionRepository(tx.Client())
+	importRepo := repositories.NewImportRepository(tx.Client())
+	duplicates, err := findStatementDuplicates(ctx, importRepo, transactionRepo, account, statement.Rows)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	mergeInto, err := selectRowMerges(statement.Rows, duplicates, merges)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	imported := make(map[string]int) // Transactions of this commit by external ID, for rows repeated in the file
+	var decisions []*model.ImportDecision
+	decide := func(i int, action model.ImportDecisionAction, transactionID int) {
+		decisions = append(decisions, &model.ImportDecision{
+			WorkspaceID:   workspaceID,
+			AccountID:     account.ID,
+			ImportID:      &statementImport.ID,
+			Fingerprint:   duplicates.fingerprints[i],
+			Action:        action,
+			TransactionID: transactionID,
+		})
+	}
+	for i, transaction := range transactions {
+		if transaction == nil {
+			continue
+		}
+		if duplicateOf := duplicates.duplicateOf[i]; duplicateOf != nil {
+			result.Rows[i].DuplicateOf = duplicateOf
+			statementImport.SkippedCount++
+			continue
+		}
+		if transaction.ExternalID != nil {
+			if id, ok := imported[*transaction.ExternalID]; ok {
+				result.Rows[i].DuplicateOf = &id
+				statementImport.SkippedCount++
+				continue
+			}
+		}
+		if targetID, ok := mergeInto[i]; ok {
+			if err := mergeStatementRow(ctx, transactionRepo, workspaceID, targetID, statement.Rows[i]); err != nil {
+				return nil, rollback(tx, fmt.Errorf("failed to merge line %d: %w", result.Rows[i].Line, err))
+			}
+			if transaction.ExternalID != nil {
+				imported[*transaction.ExternalID] = targetID
+			}
+			decide(i, model.ImportDecisionActionMerged, targetID)
+			result.Rows[i].MergedInto = &targetID
+			statementImport.MergedCount++
+			continue
+		}
+		created, err := transactionRepo.CreateTransaction(ctx, transaction)
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("failed to create transaction for line %d: %w", result.Rows[i].Line, err))
+		}
+		if err := postTransaction(ctx, tx.Client(), created); err != nil {
+			return nil, rollback(tx, err)
+		}
+		if created.ExternalID != nil {
+			imported[*created.ExternalID] = created.ID
+		}
+		decide(i, model.ImportDecisionActionImported, created.ID)
+		result.Rows[i].TransactionID = &created.ID
+		statementImport.CreatedCount++
+	}
+	if err := importRepo.CreateDecisions(ctx, decisions); err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to record import decisions: %w", err))
+	}
+
+	committedAt := time.Now()
+	statementImport.Status = model.StatementImportStatusCommitted
+	statementImport.FailedCount = len(statement.Rows) - statementImport.CreatedCount - statementImport.SkippedCount - statementImport.MergedCount
+	statementImport.CommittedAt = &committedAt
+	committed, err := importRepo.CommitImport(ctx, statementImport)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to commit statement import: %w", err))
+	}
+	if !committed {
+		return nil, rollback(tx, ErrImportAlreadyCommitted)
+	}
+	if profileID != nil {
+		if err := importRepo.TouchProfile(ctx, workspaceID, *profileID); err != nil {
+			return nil, rollback(tx, fmt.Errorf("failed to update import profile: %w", err))
+		}
+	}
+	if profile != nil {
+		if profile.ID == 0 {
+			profile, err = importRepo.CreateProfile(ctx, profile)
+		} else {
+			profile, err = importRepo.UpdateProfile(ctx, profile)
+		}
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("failed to save import profile: %w", err))
+		}
+	}
+	if statement.ClosingBalance != nil {
+		result.Balance, err = checkStatementBalance(ctx, repositories.NewAccountRepository(tx.Client()), account, *statement.ClosingBalance, nil)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	result.Import = statementImport
+	result.Profile = profile
+	return result, nil
+}
+
+type ListImportProfilesUseCase struct {
+	importRepo     *repositories.ImportRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewListImportProfilesUseCase(
+	importRepo *repositories.ImportRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *ListImportProfilesUseCase {
+	return &ListImportProfilesUseCase{
+		importRepo:     importRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute returns the import profiles of the workspace, or of one account when accountID is set,
+// most recently used first
+func (uc *ListImportProfilesUseCase) Execute(ctx context.Context, userID int, workspaceID int, accountID *int) ([]*model.ImportProfile, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsRead); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	profiles, err := uc.importRepo.ListProfiles(ctx, workspaceID, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list import profiles: %w", err)
+	}
+	return profiles, nil
+}
+
+type CreateImportProfileUseCase struct {
+	importRepo     *repositories.ImportRepository
+	accountRepo    *repositories.AccountRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewCreateImportProfileUseCase(
+	importRepo *repositories.ImportRepository,
+	accountRepo *repositories.AccountRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *CreateImportProfileUseCase {
+	return &CreateImportProfileUseCase{
+		importRepo:     importRepo,
+		accountRepo:    accountRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute saves a CSV mapping as an import profile of an account
+func (uc *CreateImportProfileUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	input ImportProfileInput,
+) (*model.ImportProfile, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	if _, err := getWorkspaceAccount(ctx, uc.accountRepo, workspaceID, input.AccountID); err != nil {
+		return nil, err
+	}
+	profile := &model.ImportProfile{
+		WorkspaceID: workspaceID,
+		AccountID:   input.AccountID,
+		Name:        input.Name,
+		Mapping:     input.Mapping,
+	}
+	if err := service.ValidateImportProfile(profile); err != nil {
+		return nil, err
+	}
+
+	profile, err := uc.importRepo.CreateProfile(ctx, profile)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrImportProfileNameTaken
+		}
+		return nil, fmt.Errorf("failed to create import profile: %w", err)
+	}
+	return profile, nil
+}
+
+type UpdateImportProfileUseCase struct {
+	importRepo     *repositories.ImportRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewUpdateImportProfileUseCase(
+	importRepo *repositories.ImportRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *UpdateImportProfileUseCase {
+	return &UpdateImportProfileUseCase{
+		importRepo:     importRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute replaces the name and mapping of an import profile
+func (uc *UpdateImportProfileUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	profileID int,
+	input ImportProfileInput,
+) (*model.ImportProfile, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	profile, err := getWorkspaceImportProfile(ctx, uc.importRepo, workspaceID, profileID)
+	if err != nil {
+		return nil, err
+	}
+	profile.Name = input.Name
+	profile.Mapping = input.Mapping
+	if err := service.ValidateImportProfile(profile); err != nil {
+		return nil, err
+	}
+
+	profile, err = uc.importRepo.UpdateProfile(ctx, profile)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrImportProfileNotFound
+		}
+		if ent.IsConstraintError(err) {
+			return nil, ErrImportProfileNameTaken
+		}
+		return nil, fmt.Errorf("failed to update import profile: %w", err)
+	}
+	return profile, nil
+}
+
+type DeleteImportProfileUseCase struct {
+	importRepo     *repositories.ImportRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewDeleteImportProfileUseCase(
+	importRepo *repositories.ImportRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *DeleteImportProfileUseCase {
+	return &DeleteImportProfileUseCase{
+		importRepo:     importRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute deletes an import profile
+func (uc *DeleteImportProfileUseCase) Execute(ctx context.Context, userID int, workspaceID int, profileID int) error {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	deleted, err := uc.importRepo.DeleteProfile(ctx, workspaceID, profileID)
+	if err != nil {
+		return fmt.Errorf("failed to delete import profile: %w", err)
+	}
+	if !deleted {
+		return ErrImportProfileNotFound
+	}
+	return nil
+}
+
+// selectCSVMapping returns the mapping chosen by the selection, the profile it came from and whether it was guessed
+func selectCSVMapping(
+	ctx context.Context,
+	importRepo *repositories.ImportRepository,
+	statementImport *model.StatementImport,
+	selection CSVMappingSelection,
+) (model.CSVMapping, *int, bool, error) {
+	if selection.Mapping != nil {
+		return *selection.Mapping, nil, false, nil
+	}
+	if selection.ProfileID != nil {
+		profile, err := getWorkspaceImportProfile(ctx, importRepo, statementImport.WorkspaceID, *selection.ProfileID)
+		if err != nil {
+			return model.CSVMapping{}, nil, false, err
+		}
+		return profile.Mapping, &profile.ID, false, nil
+	}
+
+	profiles, err := importRepo.ListProfiles(ctx, statementImport.WorkspaceID, &statementImport.AccountID)
+	if err != nil {
+		return model.CSVMapping{}, nil, false, fmt.Errorf("failed to list import profiles: %w", err)
+	}
+	if len(profiles) > 0 {
+		return profiles[0].Mapping, &profiles[0].ID, false, nil
+	}
+
+	mapping, err := service.GuessCSVMapping(statementImport.Content)
+	if err != nil {
+		return model.CSVMapping{}, nil, false, err
+	}
+	return mapping, nil, true, nil
+}
+
+// statementRowTransaction builds the transaction of a statement row, linked to the payee its description
+// resolves to. It returns the row's error instead when the row cannot be imported.
+func statementRowTransaction(row model.StatementRow, account *model.Account, payees []*model.Payee) (*model.Transaction, error) {
+	if row.Err != nil {
+		return nil, row.Err
+	}
+	amount, err := model.MoneyFromDecimal(row.Amount, account.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction := &model.Transaction{
+		WorkspaceID: account.WorkspaceID,
+		AccountID:   account.ID,
+		PostedOn:    row.PostedOn,
+		Amount:      amount,
+		Payee:       row.Payee,
+		Memo:        row.Memo,
+		Status:      model.TransactionStatusCleared,
+	}
+	if row.ExternalID != "" {
+		externalID := row.ExternalID
+		transaction.ExternalID = &externalID
+	}
+	transaction.ValueDate = row.ValueDate
+	transaction.CounterpartyIBAN = row.CounterpartyIBAN
+	transaction.EndToEndID = row.EndToEndID
+	if payee := service.MatchPayee(payees, row.Payee); payee != nil {
+		linkTransactionPayee(transaction, payee)
+	}
+	if err := service.ValidateTransaction(transaction); err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
+
+// checkStatementCurrency returns ErrStatementCurrencyMismatch when the statement states a currency other than the account's
+func checkStatementCurrency(statement *model.Statement, account *model.Account) error {
+	if statement.Currency != "" && statement.Currency != account.Currency {
+		return ErrStatementCurrencyMismatch
+	}
+	return nil
+}
+
+// findImportedTransactions returns the account's transactions carrying the external IDs of the rows, by external ID
+func findImportedTransactions(
+	ctx context.Context,
+	transactionRepo *repositories.TransactionRepository,
+	account *model.Account,
+	rows []model.StatementRow,
+) (map[string]int, error) {
+	var externalIDs []string
+	for _, row := range rows {
+		if row.ExternalID != "" {
+			externalIDs = append(externalIDs, row.ExternalID)
+		}
+	}
+	imported, err := transactionRepo.FindExternalIDs(ctx, account.WorkspaceID, account.ID, externalIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find imported transactions: %w", err)
+	}
+	return imported, nil
+}
+
+// statementDuplicates tells which rows of a statement were committed before and which may duplicate
+// existing transactions of the account, by row
+type statementDuplicates struct {
+	fingerprints []string
+	duplicateOf  []*int                   // Transaction each row was imported as or merged into before
+	matches      [][]model.DuplicateMatch // Possible duplicates of the rows not committed before
+}
+
+// findStatementDuplicates looks up the rows of a statement among the decisions recorded for the account and the
+// external IDs of its transactions, and scores the account's other transactions around the statement's dates
+// as possible duplicates of the remaining rows. Transactions rows of the statement were committed as before
+// are left out, so that the second of two identical rows is not taken for the first.
+func findStatementDuplicates(
+	ctx context.Context,
+	importRepo *repositories.ImportRepository,
+	transactionRepo *repositories.TransactionRepository,
+	account *model.Account,
+	rows []model.StatementRow,
+) (*statementDuplicates, error) {
+	duplicates := &statementDuplicates{
+		fingerprints: service.StatementRowFingerprints(rows),
+		duplicateOf:  make([]*int, len(rows)),
+		matches:      make([][]model.DuplicateMatch, len(rows)),
+	}
+	var fingerprints []string
+	for _, fingerprint := range duplicates.fingerprints {
+		if fingerprint != "" {
+			fingerprints = append(fingerprints, fingerprint)
+		}
+	}
+	decisions, err := importRepo.FindDecisions(ctx, account.WorkspaceID, account.ID, fingerprints)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find import decisions: %w", err)
+	}
+	imported, err := findImportedTransactions(ctx, transactionRepo, account, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := make(map[int]bool)
+	var unmatched []int
+	var from, to time.Time
+	for i, row := range rows {
+		if row.Err != nil {
+			continue
+		}
+		if decision, ok := decisions[duplicates.fingerprints[i]]; ok {
+			duplicates.duplicateOf[i] = &decision.TransactionID
+			claimed[decision.TransactionID] = true
+			continue
+		}
+		if id, ok := imported[row.ExternalID]; ok {
+			duplicates.duplicateOf[i] = &id
+			claimed[id] = true
+			continue
+		}
+		if len(unmatched) == 0 || row.PostedOn.Before(from) {
+			from = row.PostedOn
+		}
+		if len(unmatched) == 0 || row.PostedOn.After(to) {
+			to = row.PostedOn
+		}
+		unmatched = append(unmatched, i)
+	}
+	if len(unmatched) == 0 {
+		return duplicates, nil
+	}
+
+	from = from.AddDate(0, 0, -service.DuplicateMatchWindowDays)
+	to = to.AddDate(0, 0, service.DuplicateMatchWindowDays)
+	transactions, err := transactionRepo.ListTransactions(ctx, account.WorkspaceID, model.TransactionFilter{
+		AccountID: &account.ID,
+		From:      &from,
+		To:        &to,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transactions: %w", err)
+	}
+	candidates := make([]*model.Transaction, 0, len(transactions))
+	for _, transaction := range transactions {
+		if !claimed[transaction.ID] {
+			candidates = append(candidates, transaction)
+		}
+	}
+	unmatchedRows := make([]model.StatementRow, len(unmatched))
+	for j, i := range unmatched {
+		unmatchedRows[j] = rows[i]
+	}
+	for j, matches := range service.MatchDuplicates(unmatchedRows, candidates) {
+		duplicates.matches[unmatched[j]] = matches
+	}
+	return duplicates, nil
+}
+
+// selectRowMerges checks the merges the user confirmed and returns the transaction to merge each row into, by row index
+func selectRowMerges(rows []model.StatementRow, duplicates *statementDuplicates, merges []RowMerge) (map[int]int, error) {
+	rowIndexes := make(map[int]int, len(rows)) // By line
+	for i, row := range rows {
+		rowIndexes[row.Line] = i
+	}
+
+	mergeInto := make(map[int]int, len(merges))
+	merged := make(map[int]bool, len(merges))
+	for _, merge := range merges {
+		i, ok := rowIndexes[merge.Line]
+		if !ok {
+			return nil, fmt.Errorf("%w: the statement has no row at line %d", ErrInvalidRowMerge, merge.Line)
+		}
+		if _, ok := mergeInto[i]; ok || merged[merge.TransactionID] {
+			return nil, fmt.Errorf("%w: line %d or transaction %d is merged twice", ErrInvalidRowMerge, merge.Line, merge.TransactionID)
+		}
+		isMatch := false
+		for _, match := range duplicates.matches[i] {
+			isMatch = isMatch || match.TransactionID == merge.TransactionID
+		}
+		if !isMatch {
+			return nil, fmt.Errorf("%w: transaction %d is not a possible duplicate of line %d", ErrInvalidRowMerge, merge.TransactionID, merge.Line)
+		}
+		mergeInto[i] = merge.TransactionID
+		merged[merge.TransactionID] = true
+	}
+	return mergeInto, nil
+}
+
+// mergeStatementRow fills in a transaction from a statement row confirmed as its duplicate
+func mergeStatementRow(
+	ctx context.Context,
+	transactionRepo *repositories.TransactionRepository,
+	workspaceID int,
+	transactionID int,
+	row model.StatementRow,
+) error {
+	transaction, err := transactionRepo.GetTransaction(ctx, workspaceID, transactionID)
+	if err != nil {
+		return fmt.Errorf("failed to get transaction: %w", err)
+	}
+	service.MergeStatementRow(transaction, row)
+	if err := transactionRepo.UpdateStatementDetails(ctx, transaction); err != nil {
+		return fmt.Errorf("failed to update transaction: %w", err)
+	}
+	return nil
+}
+
+// checkStatementBalance compares the closing balance of a statement with the balance of the account on the same day,
+// counting the pending rows as if they were imported already
+func checkStatementBalance(
+	ctx context.Context,
+	accountRepo *repositories.AccountRepository,
+	account *model.Account,
+	balance model.StatementBalance,
+	pending []model.StatementRow,
+) (*model.StatementBalanceCheck, error) {
+	accountBalance, err := accountRepo.BalanceAsOf(ctx, account.WorkspaceID, account.ID, balance.AsOf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account balance: %w", err)
+	}
+	for _, row := range pending {
+		if row.PostedOn.After(balance.AsOf) {
+			continue
+		}
+		amount, err := model.MoneyFromDecimal(row.Amount, account.Currency)
+		if err != nil {
+			// The row will be rejected on commit
+			continue
+		}
+		if accountBalance, err = accountBalance.Add(amount); err != nil {
+			return nil, err
+		}
+	}
+	return service.CheckStatementBalance(balance, accountBalance)
+}
+
+// getPendingStatementImport returns ErrStatementImportNotFound unless the import belongs to the workspace,
+// and ErrImportAlreadyCommitted unless it is still pending
+func getPendingStatementImport(
+	ctx context.Context,
+	importRepo *repositories.ImportRepository,
+	workspaceID int,
+	importID int,
+) (*model.StatementImport, error) {
+	statementImport, err := importRepo.GetImport(ctx, workspaceID, importID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrStatementImportNotFound
+		}
+		return nil, fmt.Errorf("failed to get statement import: %w", err)
+	}
+	if statementImport.Status != model.StatementImportStatusPending {
+		return nil, ErrImportAlreadyCommitted
+	}
+	return statementImport, nil
+}
+
+// getWorkspaceImportProfile returns ErrImportProfileNotFound unless the profile belongs to the workspace
+func getWorkspaceImportProfile(
+	ctx context.Context,
+	importRepo *repositories.ImportRepository,
+	workspaceID int,
+	profileID int,
+) (*model.ImportProfile, error) {
+	profile, err := importRepo.GetProfile(ctx, workspaceID, profileID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrImportProfileNotFound
+		}
+		return nil, fmt.Errorf("failed to get import profile: %w", err)
+	}
+	return profile, nil
+}
+
+// findAccountImportProfile returns the account's import profile of the given name, or nil when it has none
+func findAccountImportProfile(
+	ctx context.Context,
+	importRepo *repositories.ImportRepository,
+	workspaceID int,
+	accountID int,
+	name string,
+) (*model.ImportProfile, error) {
+	profiles, err := importRepo.ListProfiles(ctx, workspaceID, &accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list import profiles: %w", err)
+	}
+	for _, profile := range profiles {
+		if profile.Name == name {
+			return profile, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,428 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/membership"
+	"backend/internal/infrastructure/ent/workspaceinvitation"
+	"backend/internal/infrastructure/mail"
+	"backend/internal/infrastructure/repositories"
+	"backend/internal/infrastructure/tenant"
+)
+
+// invitationTTL is how long an emailed invitation stays valid
+const invitationTTL = 7 * 24 * time.Hour
+
+var (
+	// ErrInvitationNotFound is returned when an invitation is unknown, expired, revoked or already accepted
+	ErrInvitationNotFound = errors.New("invitation not found")
+	// ErrInvitationEmailMismatch is returned when an invitation is used by an account with a different email
+	ErrInvitationEmailMismatch = errors.New("invitation was sent to a different email address")
+	// ErrAlreadyMember is returned when inviting or adding a user who already belongs to the workspace
+	ErrAlreadyMember = errors.New("user is already a member of the workspace")
+	// ErrInvalidRole is returned for roles that do not exist or cannot be granted by invitation
+	ErrInvalidRole = errors.New("invalid role")
+)
+
+type CreateInvitationUseCase struct {
+	invitationRepo *repositories.WorkspaceInvitationRepository
+	membershipRepo *repositories.MembershipRepository
+	workspaceRepo  *repositories.WorkspaceRepository
+	userRepo       *repositories.UserRepository
+	mailer         mail.Mailer
+	clientBaseURL  string
+}
+
+func NewCreateInvitationUseCase(
+	invitationRepo *repositories.WorkspaceInvitationRepository,
+	membershipRepo *repositories.MembershipRepository,
+	workspaceRepo *repositories.WorkspaceRepository,
+	userRepo *repositories.UserRepository,
+	mailer mail.Mailer,
+	clientBaseURL string,
+) *CreateInvitationUseCase {
+	return &CreateInvitationUseCase{
+		invitationRepo: invitationRepo,
+		membershipRepo: membershipRepo,
+		workspaceRepo:  workspaceRepo,
+		userRepo:       userRepo,
+		mailer:         mailer,
+		clientBaseURL:  clientBaseURL,
+	}
+}
+
+// Execute invites an email address to the workspace, replacing earlier pending invitations to the same address
+func (uc *CreateInvitationUseCase) Execute(
+	ctx context.Context,
+	inviterID int,
+	workspaceID int,
+	email string,
+	role model.Role,
+) (*model.WorkspaceInvitation, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, inviterID, service.PermissionMembersManage); err != nil {
+		return nil, err
+	}
+
+	// The managed workspace may differ from the session's active one
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	// Validate input
+	email = service.NormalizeEmail(email)
+	if err := service.ValidateEmail(email); err != nil {
+		return nil, fmt.Errorf("email validation failed: %w", err)
+	}
+	if !role.IsValid() || role == model.RoleOwner {
+		return nil, ErrInvalidRole
+	}
+
+	// Reject inviting existing members
+	if existing, err := uc.userRepo.GetUserByEmail(ctx, email); err == nil {
+		if _, err := uc.membershipRepo.GetMembership(ctx, workspaceID, existing.ID); err == nil {
+			return nil, ErrAlreadyMember
+		} else if !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get membership: %w", err)
+		}
+	} else if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	workspace, err := uc.workspaceRepo.GetWorkspaceByID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace: %w", err)
+	}
+	inviter, err := uc.userRepo.GetUserByID(ctx, inviterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inviter: %w", err)
+	}
+
+	// Only the latest invitation to an address stays usable
+	if err := uc.invitationRepo.RevokePendingInvitationsForEmail(ctx, workspaceID, email); err != nil {
+		return nil, fmt.Errorf("failed to revoke previous invitations: %w", err)
+	}
+
+	token, err := service.GenerateToken()
+	if err != nil {
+		return nil, err
+	}
+	invitation, err := uc.invitationRepo.CreateInvitation(
+		ctx,
+		workspaceID,
+		email,
+		role,
+		service.HashToken(token),
+		time.Now().Add(invitationTTL),
+		inviterID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invitation: %w", err)
+	}
+
+	link := uc.clientBaseURL + "/invitations/accept?token=" + url.QueryEscape(token)
+	err = uc.mailer.Send(ctx, mail.Message{
+		To:      email,
+		Subject: fmt.Sprintf("You have been invited to %s on FinSight", workspace.Name),
+		Body: fmt.Sprintf("%s invited you to join the workspace \"%s\" on FinSight as %s.\n\n", inviter.Email, workspace.Name, role) +
+			"Open the link below within 7 days to accept. If you do not have an account yet, you can create one from the same page:\n\n" +
+			link + "\n",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send invitation email: %w", err)
+	}
+
+	return invitation, nil
+}
+
+type ListInvitationsUseCase struct {
+	invitationRepo *repositories.WorkspaceInvitationRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewListInvitationsUseCase(
+	invitationRepo *repositories.WorkspaceInvitationRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *ListInvitationsUseCase {
+	return &ListInvitationsUseCase{
+		invitationRepo: invitationRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute returns all invitations of the workspace
+func (uc *ListInvitationsUseCase) Execute(ctx context.Context, userID int, workspaceID int) ([]*model.WorkspaceInvitation, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionMembersManage); err != nil {
+		return nil, err
+	}
+
+	// The managed workspace may differ from the session's active one
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	invitations, err := uc.invitationRepo.ListInvitationsByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invitations: %w", err)
+	}
+	return invitations, nil
+}
+
+type RevokeInvitationUseCase struct {
+	invitationRepo *repositories.WorkspaceInvitationRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewRevokeInvitationUseCase(
+	invitationRepo *repositories.WorkspaceInvitationRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *RevokeInvitationUseCase {
+	return &RevokeInvitationUseCase{
+		invitationRepo: invitationRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute revokes a pending invitation of the workspace
+func (uc *RevokeInvitationUseCase) Execute(ctx context.Context, userID int, workspaceID int, invitationID int) error {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionMembersManage); err != nil {
+		return err
+	}
+
+	// The managed workspace may differ from the session's active one
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	revoked, err := uc.invitationRepo.RevokeInvitation(ctx, workspaceID, invitationID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke invitation: %w", err)
+	}
+	if !revoked {
+		return ErrInvitationNotFound
+	}
+	return nil
+}
+
+type AcceptInvitationUseCase struct {
+	client *ent.Client
+}
+
+func NewAcceptInvitationUseCase(client *ent.Client) *AcceptInvitationUseCase {
+	return &AcceptInvitationUseCase{client: client}
+}
+
+// Execute adds the signed-in user to the invited workspace and returns that workspace
+func (uc *AcceptInvitationUseCase) Execute(ctx context.Context, userID int, token string) (*model.Workspace, error) {
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	entUser, err := tx.User.Get(ctx, userID)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to get user: %w", err))
+	}
+
+	entWorkspace, err := acceptInvitation(ctx, tx, token, entUser)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &model.Workspace{
+		ID:                   entWorkspace.ID,
+		Name:                 entWorkspace.Name,
+		RequireVerifiedEmail: entWorkspace.RequireVerifiedEmail,
+		CreatedAt:            entWorkspace.CreatedAt,
+		UpdatedAt:            entWorkspace.UpdatedAt,
+	}, nil
+}
+
+type SignupWithInvitationUseCase struct {
+	userRepo *repositories.UserRepository
+	client   *ent.Client
+}
+
+func NewSignupWithInvitationUseCase(userRepo *repositories.UserRepository, client *ent.Client) *SignupWithInvitationUseCase {
+	return &SignupWithInvitationUseCase{
+		userRepo: userRepo,
+		client:   client,
+	}
+}
+
+// Execute creates an account that joins the invited workspace instead of creating a new one.
+// The invitation proves control of the address, so the email is marked as verified.
+func (uc *SignupWithInvitationUseCase) Execute(
+	ctx context.Context,
+	email string,
+	password string,
+	token string,
+) (*model.User, *model.Workspace, error) {
+	email = service.NormalizeEmail(email)
+
+	// Validate email format
+	if err := service.ValidateEmail(email); err != nil {
+		return nil, nil, fmt.Errorf("email validation failed: %w", err)
+	}
+
+	// Validate password length
+	if err := service.ValidatePassword(password); err != nil {
+		return nil, nil, err
+	}
+
+	// Check if email already exists
+	exists, err := uc.userRepo.EmailExists(ctx, email)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to check email existence: %w", err)
+	}
+	if exists {
+		return nil, nil, ErrEmailAlreadyRegistered
+	}
+
+	// Hash password
+	passwordHash, err := service.HashPassword(password)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	// Create user and join workspace in a transaction
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	// Create user
+	entUser, err := tx.User.
+		Create().
+		SetEmail(email).
+		SetPasswordHash(passwordHash).
+		SetEmailVerifiedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, rollback(tx, fmt.Errorf("failed to create user: %w", err))
+	}
+
+	entWorkspace, err := acceptInvitation(ctx, tx, token, entUser)
+	if err != nil {
+		return nil, nil, rollback(tx, err)
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Convert to domain models
+	user := &model.User{
+		ID:              entUser.ID,
+		Email:           entUser.Email,
+		PasswordHash:    entUser.PasswordHash,
+		EmailVerifiedAt: entUser.EmailVerifiedAt,
+		CreatedAt:       entUser.CreatedAt,
+		UpdatedAt:       entUser.UpdatedAt,
+	}
+
+	workspace := &model.Workspace{
+		ID:                   entWorkspace.ID,
+		Name:                 entWorkspace.Name,
+		RequireVerifiedEmail: entWorkspace.RequireVerifiedEmail,
+		CreatedAt:            entWorkspace.CreatedAt,
+		UpdatedAt:            entWorkspace.UpdatedAt,
+	}
+
+	return user, workspace, nil
+}
+
+// acceptInvitation claims the pending invitation for the user and creates the membership within tx
+func acceptInvitation(ctx context.Context, tx *ent.Tx, token string, entUser *ent.User) (*ent.Workspace, error) {
+	now := time.Now()
+
+	// The token is the only credential here, so the lookup cannot be scoped to a workspace yet
+	invitation, err := tx.WorkspaceInvitation.
+		Query().
+		Where(
+			workspaceinvitation.TokenHash(service.HashToken(token)),
+			workspaceinvitation.StatusEQ(workspaceinvitation.StatusPending),
+			workspaceinvitation.ExpiresAtGT(now),
+		).
+		WithWorkspace().
+		Only(tenant.Bypass(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+	if service.NormalizeEmail(invitation.Email) != service.NormalizeEmail(entUser.Email) {
+		return nil, ErrInvitationEmailMismatch
+	}
+	ctx = tenant.WithWorkspace(ctx, invitation.WorkspaceID)
+
+	// Check existing membership
+	isMember, err := tx.Membership.
+		Query().
+		Where(
+			membership.WorkspaceID(invitation.WorkspaceID),
+			membership.UserID(entUser.ID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check membership: %w", err)
+	}
+	if isMember {
+		return nil, ErrAlreadyMember
+	}
+
+	// Mark invitation as accepted; the status condition guards against concurrent acceptance
+	claimed, err := tx.WorkspaceInvitation.
+		Update().
+		Where(
+			workspaceinvitation.ID(invitation.ID),
+			workspaceinvitation.StatusEQ(workspaceinvitation.StatusPending),
+		).
+		SetStatus(workspaceinvitation.StatusAccepted).
+		SetAcceptedByID(entUser.ID).
+		SetRespondedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to accept invitation: %w", err)
+	}
+	if claimed == 0 {
+		return nil, ErrInvitationNotFound
+	}
+
+	// Create membership
+	err = tx.Membership.
+		Create().
+		SetWorkspaceID(invitation.WorkspaceID).
+		SetUserID(entUser.ID).
+		SetRole(membership.Role(invitation.Role)).
+		SetNillableInvitedByID(invitation.InvitedByID).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create membership: %w", err)
+	}
+
+	return invitation.Edges.Workspace, nil
+}
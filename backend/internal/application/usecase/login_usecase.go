@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/repositories"
+)
+
+// ErrInvalidCredentials is returned when the email is unknown or the password does not match.
+// Both cases share one error so the response does not reveal which emails are registered.
+var ErrInvalidCredentials = errors.New("invalid email or password")
+
+type LoginUseCase struct {
+	userRepo      *repositories.UserRepository
+	workspaceRepo *repositories.WorkspaceRepository
+}
+
+func NewLoginUseCase(
+	userRepo *repositories.UserRepository,
+	workspaceRepo *repositories.WorkspaceRepository,
+) *LoginUseCase {
+	return &LoginUseCase{
+		userRepo:      userRepo,
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+// Execute verifies the credentials and returns the user with the workspace to open the session in.
+// The workspace is nil when the user no longer belongs to any; they can still log in and create one.
+func (uc *LoginUseCase) Execute(
+	ctx context.Context,
+	email string,
+	password string,
+) (*model.User, *model.Workspace, error) {
+	// Look up user by email
+	user, err := uc.userRepo.GetUserByEmail(ctx, service.NormalizeEmail(email))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			service.CompareDummyPassword(password)
+			return nil, nil, ErrInvalidCredentials
+		}
+		return nil, nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Verify password
+	if err := service.ComparePassword(user.PasswordHash, password); err != nil {
+		return nil, nil, ErrInvalidCredentials
+	}
+
+	workspace, err := loginWorkspace(ctx, uc.workspaceRepo, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, workspace, nil
+}
+
+// loginWorkspace returns the workspace a new session opens in: the first one the user joined,
+// or nil when the user belongs to none, e.g. after their last workspace was deleted
+func loginWorkspace(
+	ctx context.Context,
+	workspaceRepo *repositories.WorkspaceRepository,
+	userID int,
+) (*model.Workspace, error) {
+	workspaces, err := workspaceRepo.ListWorkspacesByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspaces: %w", err)
+	}
+	if len(workspaces) == 0 {
+		return nil, nil
+	}
+	return workspaces[0], nil
+}
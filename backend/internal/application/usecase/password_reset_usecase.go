@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/passwordresettoken"
+	entsession "backend/internal/infrastructure/ent/session"
+	"backend/internal/infrastructure/mail"
+	"backend/internal/infrastructure/repositories"
+)
+
+// passwordResetTokenTTL is how long an emailed reset link stays valid
+const passwordResetTokenTTL = time.Hour
+
+// ErrInvalidResetToken is returned when a reset token is unknown, expired or already used
+var ErrInvalidResetToken = errors.New("invalid or expired reset token")
+
+type RequestPasswordResetUseCase struct {
+	userRepo       *repositories.UserRepository
+	resetTokenRepo *repositories.PasswordResetTokenRepository
+	mailer         mail.Mailer
+	clientBaseURL  string
+}
+
+func NewRequestPasswordResetUseCase(
+	userRepo *repositories.UserRepository,
+	resetTokenRepo *repositories.PasswordResetTokenRepository,
+	mailer mail.Mailer,
+	clientBaseURL string,
+) *RequestPasswordResetUseCase {
+	return &RequestPasswordResetUseCase{
+		userRepo:       userRepo,
+		resetTokenRepo: resetTokenRepo,
+		mailer:         mailer,
+		clientBaseURL:  clientBaseURL,
+	}
+}
+
+// Execute emails a reset link if the address belongs to a user.
+// Unknown addresses succeed silently so the endpoint cannot be used to discover accounts.
+func (uc *RequestPasswordResetUseCase) Execute(ctx context.Context, email string) error {
+	user, err := uc.userRepo.GetUserByEmail(ctx, service.NormalizeEmail(email))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Only the most recently requested link stays usable
+	if err := uc.resetTokenRepo.InvalidateUnusedTokens(ctx, user.ID); err != nil {
+		return fmt.Errorf("failed to invalidate reset tokens: %w", err)
+	}
+
+	token, err := service.GenerateToken()
+	if err != nil {
+		return err
+	}
+	expiresAt := time.Now().Add(passwordResetTokenTTL)
+	if err := uc.resetTokenRepo.CreateToken(ctx, user.ID, service.HashToken(token), expiresAt); err != nil {
+		return fmt.Errorf("failed to create reset token: %w", err)
+	}
+
+	link := uc.clientBaseURL + "/reset-password?token=" + url.QueryEscape(token)
+	err = uc.mailer.Send(ctx, mail.Message{
+		To:      user.Email,
+		Subject: "Reset your FinSight password",
+		Body: "We received a request to reset the password for your FinSight account.\n\n" +
+			"Open the link below within one hour to choose a new password:\n\n" +
+			link + "\n\n" +
+			"If you did not request this, you can ignore this email.\n",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send reset email: %w", err)
+	}
+
+	return nil
+}
+
+type ResetPasswordUseCase struct {
+	client *ent.Client
+}
+
+func NewResetPasswordUseCase(client *ent.Client) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{client: client}
+}
+
+// Execute consumes the reset token, sets the new password and signs the user out everywhere
+func (uc *ResetPasswordUseCase) Execute(ctx context.Context, token string, newPassword string) error {
+	if err := service.ValidatePassword(newPassword); err != nil {
+		return err
+	}
+
+	passwordHash, err := service.HashPassword(newPassword)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	// Claim the token; the used_at condition makes concurrent resets with the same token fail
+	now := time.Now()
+	resetToken, err := tx.PasswordResetToken.
+		Query().
+		Where(
+			passwordresettoken.TokenHash(service.HashToken(token)),
+			passwordresettoken.UsedAtIsNil(),
+			passwordresettoken.ExpiresAtGT(now),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return rollback(tx, ErrInvalidResetToken)
+		}
+		return rollback(tx, fmt.Errorf("failed to get reset token: %w", err))
+	}
+	claimed, err := tx.PasswordResetToken.
+		Update().
+		Where(
+			passwordresettoken.ID(resetToken.ID),
+			passwordresettoken.UsedAtIsNil(),
+		).
+		SetUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to mark reset token as used: %w", err))
+	}
+	if claimed == 0 {
+		return rollback(tx, ErrInvalidResetToken)
+	}
+
+	// Update password
+	if err := tx.User.UpdateOneID(resetToken.UserID).SetPasswordHash(passwordHash).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to update password: %w", err))
+	}
+
+	// Invalidate all existing sessions for the user
+	if _, err := tx.Session.Delete().Where(entsession.UserID(resetToken.UserID)).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to invalidate sessions: %w", err))
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
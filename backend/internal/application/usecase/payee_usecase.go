@@ -0,0 +1,402 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/repositories"
+	"backend/internal/infrastructure/tenant"
+)
+
+var (
+	// ErrPayeeNotFound is returned when a payee does not exist in the workspace
+	ErrPayeeNotFound = errors.New("payee not found")
+	// ErrPayeeNameTaken is returned when the workspace already has a payee with the name
+	ErrPayeeNameTaken = errors.New("a payee with this name already exists")
+	// ErrDefaultCategoryNotFound is returned when the default category of a payee does not exist in the workspace
+	ErrDefaultCategoryNotFound = errors.New("default category not found")
+)
+
+// PayeeInput holds the user-editable fields of a payee
+type PayeeInput struct {
+	Name              string
+	Aliases           []string // Patterns matched against normalized descriptions; * matches any run of characters
+	DefaultCategoryID *int
+	LogoURL           string
+	Website           string
+}
+
+// apply copies the input onto the payee, normalizing text fields and alias patterns
+func (in PayeeInput) apply(p *model.Payee) {
+	p.Name = strings.TrimSpace(in.Name)
+	p.Aliases = service.NormalizePayeeAliases(in.Aliases)
+	p.DefaultCategoryID = in.DefaultCategoryID
+	p.LogoURL = strings.TrimSpace(in.LogoURL)
+	p.Website = strings.TrimSpace(in.Website)
+}
+
+type ListPayeesUseCase struct {
+	payeeRepo      *repositories.PayeeRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewListPayeesUseCase(
+	payeeRepo *repositories.PayeeRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *ListPayeesUseCase {
+	return &ListPayeesUseCase{
+		payeeRepo:      payeeRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute returns the payees of the workspace
+func (uc *ListPayeesUseCase) Execute(ctx context.Context, userID int, workspaceID int) ([]*model.Payee, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsRead); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	payees, err := uc.payeeRepo.ListPayees(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payees: %w", err)
+	}
+	return payees, nil
+}
+
+type CreatePayeeUseCase struct {
+	payeeRepo      *repositories.PayeeRepository
+	categoryRepo   *repositories.CategoryRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewCreatePayeeUseCase(
+	payeeRepo *repositories.PayeeRepository,
+	categoryRepo *repositories.CategoryRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *CreatePayeeUseCase {
+	return &CreatePayeeUseCase{
+		payeeRepo:      payeeRepo,
+		categoryRepo:   categoryRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute adds a payee to the workspace's directory. Anyone who may write transactions may add payees for them.
+func (uc *CreatePayeeUseCase) Execute(ctx context.Context, userID int, workspaceID int, input PayeeInput) (*model.Payee, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	payee := &model.Payee{WorkspaceID: workspaceID}
+	input.apply(payee)
+	if err := service.ValidatePayee(payee); err != nil {
+		return nil, err
+	}
+	if err := ensureDefaultCategoryExists(ctx, uc.categoryRepo, payee); err != nil {
+		return nil, err
+	}
+	payees, err := uc.payeeRepo.ListPayees(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payees: %w", err)
+	}
+	if err := service.ValidatePayeeAliases(payee, payees); err != nil {
+		return nil, err
+	}
+
+	payee, err = uc.payeeRepo.CreatePayee(ctx, payee)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrPayeeNameTaken
+		}
+		return nil, fmt.Errorf("failed to create payee: %w", err)
+	}
+	return payee, nil
+}
+
+type UpdatePayeeUseCase struct {
+	payeeRepo      *repositories.PayeeRepository
+	categoryRepo   *repositories.CategoryRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewUpdatePayeeUseCase(
+	payeeRepo *repositories.PayeeRepository,
+	categoryRepo *repositories.CategoryRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *UpdatePayeeUseCase {
+	return &UpdatePayeeUseCase{
+		payeeRepo:      payeeRepo,
+		categoryRepo:   categoryRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute replaces the editable fields of a payee; transactions already linked to it stay linked
+func (uc *UpdatePayeeUseCase) Execute(ctx context.Context, userID int, workspaceID int, payeeID int, input PayeeInput) (*model.Payee, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	payees, err := uc.payeeRepo.ListPayees(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payees: %w", err)
+	}
+	payee := findPayee(payees, payeeID)
+	if payee == nil {
+		return nil, ErrPayeeNotFound
+	}
+
+	input.apply(payee)
+	if err := service.ValidatePayee(payee); err != nil {
+		return nil, err
+	}
+	if err := ensureDefaultCategoryExists(ctx, uc.categoryRepo, payee); err != nil {
+		return nil, err
+	}
+	if err := service.ValidatePayeeAliases(payee, payees); err != nil {
+		return nil, err
+	}
+
+	payee, err = uc.payeeRepo.UpdatePayee(ctx, payee)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrPayeeNotFound
+		}
+		if ent.IsConstraintError(err) {
+			return nil, ErrPayeeNameTaken
+		}
+		return nil, fmt.Errorf("failed to update payee: %w", err)
+	}
+	return payee, nil
+}
+
+type DeletePayeeUseCase struct {
+	payeeRepo      *repositories.PayeeRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewDeletePayeeUseCase(
+	payeeRepo *repositories.PayeeRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *DeletePayeeUseCase {
+	return &DeletePayeeUseCase{
+		payeeRepo:      payeeRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute deletes a payee of the workspace; its transactions keep their descriptions
+func (uc *DeletePayeeUseCase) Execute(ctx context.Context, userID int, workspaceID int, payeeID int) error {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	deleted, err := uc.payeeRepo.DeletePayee(ctx, workspaceID, payeeID)
+	if err != nil {
+		return fmt.Errorf("failed to delete payee: %w", err)
+	}
+	if !deleted {
+		return ErrPayeeNotFound
+	}
+	return nil
+}
+
+type MergePayeesUseCase struct {
+	payeeRepo      *repositories.PayeeRepository
+	membershipRepo *repositories.MembershipRepository
+	client         *ent.Client
+}
+
+func NewMergePayeesUseCase(
+	payeeRepo *repositories.PayeeRepository,
+	membershipRepo *repositories.MembershipRepository,
+	client *ent.Client,
+) *MergePayeesUseCase {
+	return &MergePayeesUseCase{
+		payeeRepo:      payeeRepo,
+		membershipRepo: membershipRepo,
+		client:         client,
+	}
+}
+
+// Execute merges a duplicate payee into the target: the source's transactions move to the target,
+// the source's name and aliases become aliases of the target, and the source is deleted.
+// The target keeps its own default category when it has one. The target is returned.
+func (uc *MergePayeesUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	sourceID int,
+	targetID int,
+) (*model.Payee, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	if sourceID == targetID {
+		return nil, service.ErrPayeeMergeSelf
+	}
+	payees, err := uc.payeeRepo.ListPayees(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payees: %w", err)
+	}
+	source := findPayee(payees, sourceID)
+	if source == nil {
+		return nil, ErrPayeeNotFound
+	}
+	target := findPayee(payees, targetID)
+	if target == nil {
+		return nil, ErrPayeeNotFound
+	}
+
+	aliases := append(append([]string{}, target.Aliases...), service.NormalizePayeeAlias(source.Name))
+	target.Aliases = service.NormalizePayeeAliases(append(aliases, source.Aliases...))
+	if target.DefaultCategoryID == nil {
+		target.DefaultCategoryID = source.DefaultCategoryID
+	}
+
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	err = repositories.NewTransactionRepository(tx.Client()).ReassignPayee(ctx, workspaceID, source.ID, target.ID)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to reassign transactions: %w", err))
+	}
+	err = repositories.NewRecurrenceRepository(tx.Client()).ReassignPayee(ctx, workspaceID, source.ID, target.ID)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to reassign recurrence rules: %w", err))
+	}
+	payeeRepo := repositories.NewPayeeRepository(tx.Client())
+	if _, err := payeeRepo.DeletePayee(ctx, workspaceID, source.ID); err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to delete payee: %w", err))
+	}
+	target, err = payeeRepo.UpdatePayee(ctx, target)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to update payee: %w", err))
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return target, nil
+}
+
+type ResolvePayeeUseCase struct {
+	payeeRepo      *repositories.PayeeRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewResolvePayeeUseCase(
+	payeeRepo *repositories.PayeeRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *ResolvePayeeUseCase {
+	return &ResolvePayeeUseCase{
+		payeeRepo:      payeeRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute returns the payee of the workspace a raw description resolves to, or nil when none matches
+func (uc *ResolvePayeeUseCase) Execute(ctx context.Context, userID int, workspaceID int, description string) (*model.Payee, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsRead); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	payees, err := uc.payeeRepo.ListPayees(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payees: %w", err)
+	}
+	return service.MatchPayee(payees, description), nil
+}
+
+// findPayee returns the payee with the ID, or nil
+func findPayee(payees []*model.Payee, id int) *model.Payee {
+	for _, p := range payees {
+		if p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// ensureDefaultCategoryExists returns ErrDefaultCategoryNotFound unless the payee's default category,
+// if any, belongs to its workspace
+func ensureDefaultCategoryExists(ctx context.Context, categoryRepo *repositories.CategoryRepository, payee *model.Payee) error {
+	if payee.DefaultCategoryID == nil {
+		return nil
+	}
+	if _, err := categoryRepo.GetCategory(ctx, payee.WorkspaceID, *payee.DefaultCategoryID); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrDefaultCategoryNotFound
+		}
+		return fmt.Errorf("failed to get category: %w", err)
+	}
+	return nil
+}
+
+// resolveTransactionPayee links a new transaction to the payee named by the input or, failing that,
+// to the payee its description resolves to. An uncategorized, unsplit transaction takes the payee's
+// default category.
+func resolveTransactionPayee(ctx context.Context, payeeRepo *repositories.PayeeRepository, transaction *model.Transaction) error {
+	var payee *model.Payee
+	if transaction.PayeeID != nil {
+		var err error
+		if payee, err = getWorkspacePayee(ctx, payeeRepo, transaction.WorkspaceID, *transaction.PayeeID); err != nil {
+			return err
+		}
+	} else if transaction.Payee != "" {
+		payees, err := payeeRepo.ListPayees(ctx, transaction.WorkspaceID)
+		if err != nil {
+			return fmt.Errorf("failed to list payees: %w", err)
+		}
+		payee = service.MatchPayee(payees, transaction.Payee)
+	}
+	if payee != nil {
+		linkTransactionPayee(transaction, payee)
+	}
+	return nil
+}
+
+// linkTransactionPayee links a new transaction to the payee, giving it the payee's default category
+// when it is uncategorized and not split
+func linkTransactionPayee(transaction *model.Transaction, payee *model.Payee) {
+	transaction.PayeeID = &payee.ID
+	if transaction.CategoryID == nil && !transaction.IsSplit() {
+		transaction.CategoryID = payee.DefaultCategoryID
+	}
+}
+
+// getWorkspacePayee returns ErrPayeeNotFound unless the payee belongs to the workspace
+func getWorkspacePayee(ctx context.Context, payeeRepo *repositories.PayeeRepository, workspaceID, payeeID int) (*model.Payee, error) {
+	payee, err := payeeRepo.GetPayee(ctx, workspaceID, payeeID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrPayeeNotFound
+		}
+		return nil, fmt.Errorf("failed to get payee: %w", err)
+	}
+	return payee, nil
+}
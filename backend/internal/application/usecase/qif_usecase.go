@@ -0,0 +1,830 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/repositories"
+	"backend/internal/infrastructure/tenant"
+)
+
+// qifOpeningBalancePayee is the payee Quicken gives the transaction holding the opening balance of an account
+const qifOpeningBalancePayee = "Opening Balance"
+
+var (
+	// ErrQIFAccountRequired is returned for QIF files without account headers unless an account to import them into is given
+	ErrQIFAccountRequired = errors.New("the QIF file does not name its account; choose the account to import it into")
+	// ErrQIFCurrencyRequired is returned when a QIF import would create accounts but no currency is given for them
+	ErrQIFCurrencyRequired = errors.New("currency is required to create the accounts of the QIF file")
+	// ErrQIFOpeningBalanceConflict is reported for opening balances that differ from that of the existing account
+	ErrQIFOpeningBalanceConflict = errors.New("opening balance differs from that of the account")
+)
+
+// QIFImportInput holds the options of a QIF import
+type QIFImportInput struct {
+	DateFormat model.QIFDateFormat // Guessed from the file when empty
+	AccountID  *int                // Account the register of a file without account headers is imported into
+	Currency   string              // Currency of the accounts the import creates
+	DryRun     bool                // Reports what the import would do without saving anything
+}
+
+// QIFImportedRow reports what became of one transaction of a QIF register
+type QIFImportedRow struct {
+	ImportedRow
+	TransferID     *int // Set when the transaction was linked with its other side in another account
+	OpeningBalance bool // Set when the transaction holds the opening balance of its account rather than being imported
+}
+
+// QIFImportedAccount reports what became of one account of a QIF file
+type QIFImportedAccount struct {
+	Account *model.Account
+	Created bool
+	Rows    []QIFImportedRow
+}
+
+// QIFImportedCategory is a category a QIF import created
+type QIFImportedCategory struct {
+	Category *model.Category
+	Path     []string
+}
+
+// QIFImportResult is the outcome of a QIF import. IDs of created records are zero or nil in dry runs.
+type QIFImportResult struct {
+	Accounts   []QIFImportedAccount
+	Categories []QIFImportedCategory
+	DryRun     bool
+}
+
+// QIFExportInput holds the options of a QIF export
+type QIFExportInput struct {
+	DateFormat model.QIFDateFormat // Defaults to MM/DD/YYYY
+	AccountID  *int                // Exports every account when nil
+	From       *time.Time          // Inclusive; opening balances are only exported without it
+	To         *time.Time          // Inclusive
+}
+
+type ImportQIFUseCase struct {
+	accountRepo    *repositories.AccountRepository
+	categoryRepo   *repositories.CategoryRepository
+	payeeRepo      *repositories.PayeeRepository
+	membershipRepo *repositories.MembershipRepository
+	client         *ent.Client
+}
+
+func NewImportQIFUseCase(
+	accountRepo *repositories.AccountRepository,
+	categoryRepo *repositories.CategoryRepository,
+	payeeRepo *repositories.PayeeRepository,
+	membershipRepo *repositories.MembershipRepository,
+	client *ent.Client,
+) *ImportQIFUseCase {
+	return &ImportQIFUseCase{
+		accountRepo:    accountRepo,
+		categoryRepo:   categoryRepo,
+		payeeRepo:      payeeRepo,
+		membershipRepo: membershipRepo,
+		client:         client,
+	}
+}
+
+// Execute imports the accounts, categories and transactions of a QIF file, all in one database transaction.
+// Accounts and categories are matched by name, ignoring case, and created when the workspace has none of that
+// name; the opening balance transaction of a created account becomes its opening balance. Transactions are
+// imported with IDs derived from their fields, so that importing the same file again creates nothing, and
+// transactions that cannot be read are skipped and reported. Transfers between two accounts of the file are
+// linked when both sides are found; a side without its counterpart is imported uncategorized, to be matched
+// later. Split lines moving money to another account are imported uncategorized.
+func (uc *ImportQIFUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	content []byte,
+	input QIFImportInput,
+) (*QIFImportResult, error) {
+	membership, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite)
+	if err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	if len(content) > MaxStatementSize {
+		return nil, ErrStatementTooLarge
+	}
+	file, err := service.ParseQIF(content, input.DateFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := uc.accountRepo.ListAccounts(ctx, workspaceID, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts: %w", err)
+	}
+	categories, err := uc.categoryRepo.ListCategories(ctx, workspaceID, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	payees, err := uc.payeeRepo.ListPayees(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payees: %w", err)
+	}
+
+	// Match the accounts of the file; nil targets are created
+	targets := make([]*model.Account, len(file.Accounts))
+	creates := false
+	for i, qifAccount := range file.Accounts {
+		if qifAccount.Name == "" {
+			if input.AccountID == nil {
+				return nil, ErrQIFAccountRequired
+			}
+			if targets[i], err = getWorkspaceAccount(ctx, uc.accountRepo, workspaceID, *input.AccountID); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		targets[i] = findAccountByName(accounts, qifAccount.Name)
+		creates = creates || targets[i] == nil
+	}
+	currency := strings.ToUpper(strings.TrimSpace(input.Currency))
+	if creates {
+		if err := service.Authorize(membership, service.PermissionAccountsManage); err != nil {
+			return nil, err
+		}
+		if currency == "" {
+			return nil, ErrQIFCurrencyRequired
+		}
+		if err := service.ValidateCurrencyCode(currency); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	importer := newQIFImporter(tx.Client(), membership, workspaceID, categories, payees)
+	result := &QIFImportResult{DryRun: input.DryRun}
+	for i, qifAccount := range file.Accounts {
+		created := targets[i] == nil
+		if created {
+			if targets[i], err = importer.createAccount(ctx, qifAccount, currency); err != nil {
+				return nil, rollback(tx, err)
+			}
+			accounts = append(accounts, targets[i])
+		}
+		result.Accounts = append(result.Accounts, QIFImportedAccount{Account: targets[i], Created: created})
+	}
+	for _, category := range file.Categories {
+		if _, err := importer.categoryID(ctx, category.Path, category.Kind); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	var pending []*qifPendingTransaction
+	for i, qifAccount := range file.Accounts {
+		rows, transactions, err := importer.readRegister(ctx, qifAccount, targets[i], accounts)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		result.Accounts[i].Rows = rows
+		for _, transaction := range transactions {
+			transaction.account = i
+		}
+		pending = append(pending, transactions...)
+	}
+	pairQIFTransfers(pending)
+
+	for _, p := range pending {
+		if p.created {
+			continue
+		}
+		if p.counterpart == nil {
+			if err := importer.createTransaction(ctx, p); err != nil {
+				return nil, rollback(tx, err)
+			}
+		} else if err := importer.createTransfer(ctx, p, p.counterpart); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	for _, p := range pending {
+		row := &result.Accounts[p.account].Rows[p.row]
+		row.TransactionID = &p.transaction.ID
+		row.TransferID = p.transaction.TransferID
+	}
+	result.Categories = importer.created
+
+	if input.DryRun {
+		if err := tx.Rollback(); err != nil {
+			return nil, fmt.Errorf("failed to roll back transaction: %w", err)
+		}
+		clearQIFImportIDs(result)
+		return result, nil
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+type ExportQIFUseCase struct {
+	accountRepo     *repositories.AccountRepository
+	categoryRepo    *repositories.CategoryRepository
+	transactionRepo *repositories.TransactionRepository
+	transferRepo    *repositories.TransferRepository
+	membershipRepo  *repositories.MembershipRepository
+}
+
+func NewExportQIFUseCase(
+	accountRepo *repositories.AccountRepository,
+	categoryRepo *repositories.CategoryRepository,
+	transactionRepo *repositories.TransactionRepository,
+	transferRepo *repositories.TransferRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *ExportQIFUseCase {
+	return &ExportQIFUseCase{
+		accountRepo:     accountRepo,
+		categoryRepo:    categoryRepo,
+		transactionRepo: transactionRepo,
+		transferRepo:    transferRepo,
+		membershipRepo:  membershipRepo,
+	}
+}
+
+// Execute writes the ledger of the workspace, or of one of its accounts, as a QIF file: the category tree, then
+// the register of each account, archived ones included, oldest transaction first. Opening balances are written
+// as Quicken does, as a transaction transferring the balance from the account to itself, and the sides of
+// transfers name the other account instead of a category.
+func (uc *ExportQIFUseCase) Execute(ctx context.Context, userID int, workspaceID int, input QIFExportInput) ([]byte, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsRead); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	if input.DateFormat == "" {
+		input.DateFormat = model.QIFDateFormatMDY
+	}
+	if !input.DateFormat.IsValid() {
+		return nil, service.ErrInvalidQIFDateFormat
+	}
+	filter := model.TransactionFilter{AccountID: input.AccountID, From: input.From, To: input.To}
+	if err := service.ValidateTransactionFilter(filter); err != nil {
+		return nil, err
+	}
+	if input.AccountID != nil {
+		if _, err := getWorkspaceAccount(ctx, uc.accountRepo, workspaceID, *input.AccountID); err != nil {
+			return nil, err
+		}
+	}
+
+	accounts, err := uc.accountRepo.ListAccounts(ctx, workspaceID, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts: %w", err)
+	}
+	categories, err := uc.categoryRepo.ListCategories(ctx, workspaceID, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	transactions, err := uc.transactionRepo.ListTransactions(ctx, workspaceID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transactions: %w", err)
+	}
+	transfers, err := uc.transferRepo.ListTransfers(ctx, workspaceID, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transfers: %w", err)
+	}
+
+	accountNames := make(map[int]string, len(accounts))
+	for _, account := range accounts {
+		accountNames[account.ID] = account.Name
+	}
+	// The account on the other side of each transaction that is part of a transfer
+	counterparts := make(map[int]string, 2*len(transfers))
+	for _, transfer := range transfers {
+		counterparts[transfer.From.ID] = accountNames[transfer.To.AccountID]
+		counterparts[transfer.To.ID] = accountNames[transfer.From.AccountID]
+	}
+	paths := categoryPaths(categories)
+	categoryPath := func(id *int) []string {
+		if id == nil {
+			return nil
+		}
+		return paths[*id]
+	}
+
+	file := &model.QIFFile{}
+	for _, category := range categories {
+		file.Categories = append(file.Categories, model.QIFCategory{Path: paths[category.ID], Kind: category.Kind})
+	}
+	sort.Slice(file.Categories, func(i, j int) bool {
+		return strings.Join(file.Categories[i].Path, ":") < strings.Join(file.Categories[j].Path, ":")
+	})
+
+	// Registers list transactions oldest first
+	sort.SliceStable(transactions, func(i, j int) bool {
+		if !transactions[i].PostedOn.Equal(transactions[j].PostedOn) {
+			return transactions[i].PostedOn.Before(transactions[j].PostedOn)
+		}
+		return transactions[i].ID < transactions[j].ID
+	})
+	registers := make(map[int][]*model.Transaction)
+	for _, transaction := range transactions {
+		registers[transaction.AccountID] = append(registers[transaction.AccountID], transaction)
+	}
+
+	for _, account := range accounts {
+		if input.AccountID != nil && account.ID != *input.AccountID {
+			continue
+		}
+		qifAccount := model.QIFAccount{Name: account.Name, Type: account.Type, Description: account.Institution}
+		register := registers[account.ID]
+		if input.From == nil && !account.OpeningBalance.IsZero() {
+			openedOn := account.CreatedAt
+			if account.OpenedOn != nil {
+				openedOn = *account.OpenedOn
+			} else if len(register) > 0 {
+				openedOn = register[0].PostedOn
+			}
+			qifAccount.Transactions = append(qifAccount.Transactions, model.QIFTransaction{
+				PostedOn:        time.Date(openedOn.Year(), openedOn.Month(), openedOn.Day(), 0, 0, 0, 0, time.UTC),
+				Amount:          account.OpeningBalance.Decimal(),
+				Payee:           qifOpeningBalancePayee,
+				Status:          model.TransactionStatusReconciled,
+				TransferAccount: account.Name,
+			})
+		}
+		for _, transaction := range register {
+			qifTransaction := model.QIFTransaction{
+				PostedOn: transaction.PostedOn,
+				Amount:   transaction.Amount.Decimal(),
+				Payee:    transaction.Payee,
+				Memo:     transaction.Memo,
+				Status:   transaction.Status,
+				Category: categoryPath(transaction.CategoryID),
+			}
+			if transaction.IsTransfer() {
+				qifTransaction.TransferAccount = counterparts[transaction.ID]
+			}
+			for _, split := range transaction.Splits {
+				qifTransaction.Splits = append(qifTransaction.Splits, model.QIFSplit{
+					Category: categoryPath(split.CategoryID),
+					Amount:   split.Amount.Decimal(),
+					Memo:     split.Memo,
+				})
+			}
+			qifAccount.Transactions = append(qifAccount.Transactions, qifTransaction)
+		}
+		file.Accounts = append(file.Accounts, qifAccount)
+	}
+
+	return service.FormatQIF(file, input.DateFormat)
+}
+
+// qifPendingTransaction is a transaction of a QIF register waiting to be created
+type qifPendingTransaction struct {
+	account     int // Index of the account in the file
+	row         int // Index of the row in the account's register
+	line        int
+	transaction *model.Transaction
+	target      *model.Account         // Account the transaction transfers money to or from, if any
+	counterpart *qifPendingTransaction // Other side of the transfer, once found
+	created     bool
+}
+
+// qifImporter creates the records of a QIF import within one database transaction
+type qifImporter struct {
+	accountRepo     *repositories.AccountRepository
+	categoryRepo    *repositories.CategoryRepository
+	transactionRepo *repositories.TransactionRepository
+	client          *ent.Client
+	membership      *model.Membership
+	workspaceID     int
+	categories      map[string]*model.Category // By lowercased path, e.g. food:groceries
+	payees          []*model.Payee
+	created         []QIFImportedCategory
+}
+
+func newQIFImporter(
+	client *ent.Client,
+	membership *model.Membership,
+	workspaceID int,
+	categories []*model.Category,
+	payees []*model.Payee,
+) *qifImporter {
+	importer := &qifImporter{
+		accountRepo:     repositories.NewAccountRepository(client),
+		categoryRepo:    repositories.NewCategoryRepository(client),
+		transactionRepo: repositories.NewTransactionRepository(client),
+		client:          client,
+		membership:      membership,
+		workspaceID:     workspaceID,
+		categories:      make(map[string]*model.Category, len(categories)),
+		payees:          payees,
+	}
+	paths := categoryPaths(categories)
+	for _, category := range categories {
+		importer.categories[categoryPathKey(paths[category.ID])] = category
+	}
+	return importer
+}
+
+// createAccount creates the account of a QIF file, with the amount and date of its opening balance transaction
+func (im *qifImporter) createAccount(ctx context.Context, qifAccount model.QIFAccount, currency string) (*model.Account, error) {
+	account := &model.Account{
+		WorkspaceID: im.workspaceID,
+		Name:        qifAccount.Name,
+		Type:        qifAccount.Type,
+		Currency:    currency,
+	}
+	if account.Type == "" {
+		account.Type = model.AccountTypeBank
+	}
+	openingBalance := model.NewDecimal(0, 0)
+	for _, transaction := range qifAccount.Transactions {
+		if transaction.Err == nil && isQIFOpeningBalance(qifAccount, transaction) {
+			openingBalance = transaction.Amount
+			openedOn := transaction.PostedOn
+			account.OpenedOn = &openedOn
+			break
+		}
+	}
+	var err error
+	if account.OpeningBalance, err = model.MoneyFromDecimal(openingBalance, currency); err != nil {
+		return nil, err
+	}
+	if err := service.ValidateAccount(account); err != nil {
+		return nil, err
+	}
+
+	account, err = im.accountRepo.CreateAccount(ctx, account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create account %q: %w", qifAccount.Name, err)
+	}
+	return account, nil
+}
+
+// categoryID returns the ID of the category at the path, creating it and any missing parent of the given kind.
+// Categories created under an existing parent take its kind.
+func (im *qifImporter) categoryID(ctx context.Context, path []string, kind model.CategoryKind) (*int, error) {
+	var parent *model.Category
+	for i := range path {
+		key := categoryPathKey(path[:i+1])
+		if category, ok := im.categories[key]; ok {
+			parent = category
+			continue
+		}
+
+		if err := service.Authorize(im.membership, service.PermissionCategoriesManage); err != nil {
+			return nil, err
+		}
+		category := &model.Category{WorkspaceID: im.workspaceID, Name: path[i], Kind: kind}
+		if parent != nil {
+			category.ParentID = &parent.ID
+			category.Kind = parent.Kind
+		}
+		if err := service.ValidateCategory(category); err != nil {
+			return nil, err
+		}
+		category, err := im.categoryRepo.CreateCategory(ctx, category)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create category %q: %w", strings.Join(path[:i+1], ":"), err)
+		}
+		im.categories[key] = category
+		im.created = append(im.created, QIFImportedCategory{Category: category, Path: path[:i+1]})
+		parent = category
+	}
+	if parent == nil {
+		return nil, nil
+	}
+	return &parent.ID, nil
+}
+
+// readRegister reports the rows of the register of an account and returns the transactions to create for it.
+// Transactions imported before are reported as duplicates.
+func (im *qifImporter) readRegister(
+	ctx context.Context,
+	qifAccount model.QIFAccount,
+	account *model.Account,
+	accounts []*model.Account,
+) ([]QIFImportedRow, []*qifPendingTransaction, error) {
+	externalIDs := service.QIFTransactionIDs(qifAccount.Transactions)
+	imported, err := im.transactionRepo.FindExternalIDs(ctx, im.workspaceID, account.ID, externalIDs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find imported transactions: %w", err)
+	}
+
+	rows := make([]QIFImportedRow, 0, len(qifAccount.Transactions))
+	var pending []*qifPendingTransaction
+	for i, qifTransaction := range qifAccount.Transactions {
+		row := QIFImportedRow{ImportedRow: ImportedRow{Line: qifTransaction.Line, Err: qifTransaction.Err}}
+		switch {
+		case row.Err != nil:
+		case isQIFOpeningBalance(qifAccount, qifTransaction):
+			if qifTransaction.Amount.Cmp(account.OpeningBalance.Decimal()) != 0 {
+				row.Err = ErrQIFOpeningBalanceConflict
+			} else {
+				row.OpeningBalance = true
+			}
+		default:
+			if id, ok := imported[externalIDs[i]]; ok {
+				row.DuplicateOf = &id
+				break
+			}
+			if err := im.createCategories(ctx, qifTransaction); err != nil {
+				return nil, nil, err
+			}
+			transaction, target, err := im.transaction(qifTransaction, account, accounts, externalIDs[i])
+			if err != nil {
+				row.Err = err
+				break
+			}
+			pending = append(pending, &qifPendingTransaction{
+				row:         i,
+				line:        qifTransaction.Line,
+				transaction: transaction,
+				target:      target,
+			})
+		}
+		rows = append(rows, row)
+	}
+	return rows, pending, nil
+}
+
+// createCategories creates the categories a QIF register entry names that the workspace does not have yet
+func (im *qifImporter) createCategories(ctx context.Context, qifTransaction model.QIFTransaction) error {
+	if qifTransaction.TransferAccount == "" {
+		if _, err := im.categoryID(ctx, qifTransaction.Category, qifCategoryKind(qifTransaction.Amount)); err != nil {
+			return err
+		}
+	}
+	for _, split := range qifTransaction.Splits {
+		if split.TransferAccount != "" {
+			continue
+		}
+		if _, err := im.categoryID(ctx, split.Category, qifCategoryKind(split.Amount)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transaction builds the transaction of a QIF register entry whose categories exist. It returns the account
+// a transfer names, or an error when the entry does not make a valid transaction.
+func (im *qifImporter) transaction(
+	qifTransaction model.QIFTransaction,
+	account *model.Account,
+	accounts []*model.Account,
+	externalID string,
+) (*model.Transaction, *model.Account, error) {
+	amount, err := model.MoneyFromDecimal(qifTransaction.Amount, account.Currency)
+	if err != nil {
+		return nil, nil, err
+	}
+	transaction := &model.Transaction{
+		WorkspaceID: im.workspaceID,
+		AccountID:   account.ID,
+		PostedOn:    qifTransaction.PostedOn,
+		Amount:      amount,
+		Payee:       qifTransaction.Payee,
+		Memo:        qifTransaction.Memo,
+		ExternalID:  &externalID,
+		Status:      qifTransaction.Status,
+	}
+
+	var target *model.Account
+	if qifTransaction.TransferAccount != "" {
+		if target = findAccountByName(accounts, qifTransaction.TransferAccount); target != nil && target.ID == account.ID {
+			target = nil
+		}
+	} else {
+		transaction.CategoryID = im.findCategoryID(qifTransaction.Category)
+	}
+
+	for _, split := range qifTransaction.Splits {
+		splitAmount, err := model.MoneyFromDecimal(split.Amount, account.Currency)
+		if err != nil {
+			return nil, nil, err
+		}
+		var categoryID *int
+		if split.TransferAccount == "" {
+			categoryID = im.findCategoryID(split.Category)
+		}
+		transaction.Splits = append(transaction.Splits, &model.TransactionSplit{
+			CategoryID: categoryID,
+			Amount:     splitAmount,
+			Memo:       split.Memo,
+		})
+	}
+	if len(transaction.Splits) == 1 {
+		// A single split categorizes the whole transaction
+		transaction.CategoryID = transaction.Splits[0].CategoryID
+		transaction.Splits = nil
+	}
+
+	if payee := service.MatchPayee(im.payees, transaction.Payee); payee != nil {
+		if target != nil {
+			transaction.PayeeID = &payee.ID
+		} else {
+			linkTransactionPayee(transaction, payee)
+		}
+	}
+	if err := service.ValidateTransaction(transaction); err != nil {
+		return nil, nil, err
+	}
+	if transaction.IsSplit() {
+		target = nil
+	}
+	return transaction, target, nil
+}
+
+// findCategoryID returns the ID of the category at the path, or nil for an empty path
+func (im *qifImporter) findCategoryID(path []string) *int {
+	if category, ok := im.categories[categoryPathKey(path)]; ok && len(path) > 0 {
+		return &category.ID
+	}
+	return nil
+}
+
+// createTransaction creates and posts a pending transaction
+func (im *qifImporter) createTransaction(ctx context.Context, p *qifPendingTransaction) error {
+	created, err := im.transactionRepo.CreateTransaction(ctx, p.transaction)
+	if err != nil {
+		return fmt.Errorf("failed to create transaction for line %d: %w", p.line, err)
+	}
+	if err := postTransaction(ctx, im.client, created); err != nil {
+		return err
+	}
+	p.transaction = created
+	p.created = true
+	return nil
+}
+
+// createTransfer creates both sides of a transfer and links them, or creates them as plain transactions
+// when they do not make a valid transfer, e.g. because a fee makes their amounts differ
+func (im *qifImporter) createTransfer(ctx context.Context, p, counterpart *qifPendingTransaction) error {
+	transfer := &model.Transfer{
+		WorkspaceID: im.workspaceID,
+		From:        p.transaction,
+		To:          counterpart.transaction,
+	}
+	if p.transaction.Amount.IsPositive() {
+		transfer.From, transfer.To = counterpart.transaction, p.transaction
+	}
+	var err error
+	if transfer.FromFee, err = model.NewMoney(0, transfer.From.Amount.Currency()); err != nil {
+		return err
+	}
+	if transfer.ToFee, err = model.NewMoney(0, transfer.To.Amount.Currency()); err != nil {
+		return err
+	}
+	if service.ValidateTransfer(transfer) != nil {
+		if err := im.createTransaction(ctx, p); err != nil {
+			return err
+		}
+		return im.createTransaction(ctx, counterpart)
+	}
+
+	if transfer.From, err = im.transactionRepo.CreateTransaction(ctx, transfer.From); err != nil {
+		return fmt.Errorf("failed to create outgoing transaction: %w", err)
+	}
+	if transfer.To, err = im.transactionRepo.CreateTransaction(ctx, transfer.To); err != nil {
+		return fmt.Errorf("failed to create incoming transaction: %w", err)
+	}
+	if transfer, err = repositories.NewTransferRepository(im.client).CreateTransfer(ctx, transfer); err != nil {
+		return fmt.Errorf("failed to create transfer: %w", err)
+	}
+	if err := postTransfer(ctx, im.client, transfer); err != nil {
+		return err
+	}
+
+	p.created, counterpart.created = true, true
+	if p.transaction.Amount.IsNegative() {
+		p.transaction, counterpart.transaction = transfer.From, transfer.To
+	} else {
+		p.transaction, counterpart.transaction = transfer.To, transfer.From
+	}
+	return nil
+}
+
+// pairQIFTransfers links the two sides of each transfer between accounts of the file: a transaction of one
+// account naming the other, posted the same day and moving money the other way. When both accounts share a
+// currency the amounts must also match.
+func pairQIFTransfers(pending []*qifPendingTransaction) {
+	for i, p := range pending {
+		if p.target == nil || p.counterpart != nil {
+			continue
+		}
+		for _, q := range pending[i+1:] {
+			if q.target == nil || q.counterpart != nil ||
+				q.transaction.AccountID != p.target.ID || q.target.ID != p.transaction.AccountID ||
+				!q.transaction.PostedOn.Equal(p.transaction.PostedOn) ||
+				q.transaction.Amount.IsNegative() == p.transaction.Amount.IsNegative() {
+				continue
+			}
+			if p.transaction.Amount.SameCurrency(q.transaction.Amount) &&
+				p.transaction.Amount.MinorUnits() != -q.transaction.Amount.MinorUnits() {
+				continue
+			}
+			p.counterpart, q.counterpart = q, p
+			break
+		}
+	}
+}
+
+// isQIFOpeningBalance reports whether a transaction holds the opening balance of its account, which Quicken
+// writes as a transfer from the account to itself
+func isQIFOpeningBalance(account model.QIFAccount, transaction model.QIFTransaction) bool {
+	if transaction.TransferAccount == "" {
+		return false
+	}
+	if account.Name == "" {
+		return strings.EqualFold(transaction.Payee, qifOpeningBalancePayee)
+	}
+	return strings.EqualFold(transaction.TransferAccount, account.Name)
+}
+
+// qifCategoryKind returns the kind categories first seen on a transaction are created with: income for
+// money coming in and expense otherwise
+func qifCategoryKind(amount model.Decimal) model.CategoryKind {
+	if amount.Sign() > 0 {
+		return model.CategoryKindIncome
+	}
+	return model.CategoryKindExpense
+}
+
+// clearQIFImportIDs removes the IDs of the records a dry run created before it was rolled back
+func clearQIFImportIDs(result *QIFImportResult) {
+	for i := range result.Accounts {
+		if result.Accounts[i].Created {
+			result.Accounts[i].Account.ID = 0
+		}
+		for j := range result.Accounts[i].Rows {
+			result.Accounts[i].Rows[j].TransactionID = nil
+			result.Accounts[i].Rows[j].TransferID = nil
+		}
+	}
+	created := make(map[int]bool, len(result.Categories))
+	for _, category := range result.Categories {
+		created[category.Category.ID] = true
+	}
+	for _, category := range result.Categories {
+		category.Category.ID = 0
+		if category.Category.ParentID != nil && created[*category.Category.ParentID] {
+			category.Category.ParentID = nil
+		}
+	}
+}
+
+// findAccountByName returns the account of the given name, ignoring case, or nil
+func findAccountByName(accounts []*model.Account, name string) *model.Account {
+	for _, account := range accounts {
+		if strings.EqualFold(strings.TrimSpace(account.Name), strings.TrimSpace(name)) {
+			return account
+		}
+	}
+	return nil
+}
+
+// categoryPaths returns the names of the path from the top-level category down to each category, by ID
+func categoryPaths(categories []*model.Category) map[int][]string {
+	byID := make(map[int]*model.Category, len(categories))
+	for _, category := range categories {
+		byID[category.ID] = category
+	}
+	paths := make(map[int][]string, len(categories))
+	for _, category := range categories {
+		var path []string
+		// The depth bound guards against cycles
+		for c := category; c != nil && len(path) <= len(categories); {
+			path = append([]string{c.Name}, path...)
+			if c.ParentID == nil {
+				break
+			}
+			c = byID[*c.ParentID]
+		}
+		paths[category.ID] = path
+	}
+	return paths
+}
+
+// categoryPathKey returns the key categories are looked up by their path with, ignoring case
+func categoryPathKey(path []string) string {
+	return strings.ToLower(strings.Join(path, ":"))
+}
@@ -0,0 +1,712 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/repositories"
+	"backend/internal/infrastructure/tenant"
+)
+
+const (
+	// defaultUpcomingDays is how far ahead upcoming occurrences are listed when a request does not say
+	defaultUpcomingDays = 30
+	// maxUpcomingDays caps how far ahead upcoming occurrences are listed
+	maxUpcomingDays = 366
+)
+
+var (
+	// ErrRecurrenceRuleNotFound is returned when a recurrence rule does not exist in the workspace
+	ErrRecurrenceRuleNotFound = errors.New("recurrence rule not found")
+	// ErrOccurrenceNotFound is returned when an occurrence does not exist in the workspace
+	ErrOccurrenceNotFound = errors.New("occurrence not found")
+	// ErrOccurrenceNotScheduled is returned when skipping or posting an occurrence that was already posted or skipped
+	ErrOccurrenceNotScheduled = errors.New("occurrence has already been posted or skipped")
+	// ErrInvalidUpcomingDays is returned when upcoming occurrences are asked for outside 1 to 366 days ahead
+	ErrInvalidUpcomingDays = errors.New("days must be between 1 and 366")
+)
+
+// RecurrenceRuleInput holds the user-editable fields of a recurrence rule
+type RecurrenceRuleInput struct {
+	AccountID  int
+	Amount     model.Decimal // In major units of the account currency
+	Payee      string
+	PayeeID    *int // Resolved from Payee when each occurrence is posted when nil
+	Memo       string
+	CategoryID *int
+	Frequency  model.RecurrenceFrequency
+	Interval   int // Defaults to 1 when zero
+	ByDay      []string
+	StartsOn   time.Time
+	EndsOn     *time.Time
+}
+
+// apply copies the input onto the rule, normalizing text fields.
+// account is the account named by in.AccountID; the amount is expressed in its currency.
+func (in RecurrenceRuleInput) apply(rule *model.RecurrenceRule, account *model.Account) error {
+	amount, err := model.MoneyFromDecimal(in.Amount, account.Currency)
+	if err != nil {
+		return err
+	}
+	byDay := make([]string, 0, len(in.ByDay))
+	for _, value := range in.ByDay {
+		byDay = append(byDay, strings.ToUpper(strings.TrimSpace(value)))
+	}
+
+	rule.AccountID = account.ID
+	rule.Amount = amount
+	rule.Payee = strings.TrimSpace(in.Payee)
+	rule.PayeeID = in.PayeeID
+	rule.Memo = strings.TrimSpace(in.Memo)
+	rule.CategoryID = in.CategoryID
+	rule.Frequency = in.Frequency
+	rule.Interval = in.Interval
+	if rule.Interval == 0 {
+		rule.Interval = 1
+	}
+	rule.ByDay = byDay
+	rule.StartsOn = service.CalendarDate(in.StartsOn)
+	rule.EndsOn = nil
+	if in.EndsOn != nil {
+		endsOn := service.CalendarDate(*in.EndsOn)
+		rule.EndsOn = &endsOn
+	}
+	return nil
+}
+
+// UpcomingOccurrence is a scheduled occurrence together with the rule it belongs to
+type UpcomingOccurrence struct {
+	Occurrence *model.RecurrenceOccurrence
+	Rule       *model.RecurrenceRule
+}
+
+// RecurrenceRunResult counts what a scheduler run did
+type RecurrenceRunResult struct {
+	Materialized int // Occurrences newly scheduled
+	Posted       int // Occurrences posted as transactions
+}
+
+type ListRecurrenceRulesUseCase struct {
+	recurrenceRepo *repositories.RecurrenceRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewListRecurrenceRulesUseCase(
+	recurrenceRepo *repositories.RecurrenceRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *ListRecurrenceRulesUseCase {
+	return &ListRecurrenceRulesUseCase{
+		recurrenceRepo: recurrenceRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute returns the recurrence rules of the workspace
+func (uc *ListRecurrenceRulesUseCase) Execute(ctx context.Context, userID int, workspaceID int) ([]*model.RecurrenceRule, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsRead); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	rules, err := uc.recurrenceRepo.ListRules(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recurrence rules: %w", err)
+	}
+	return rules, nil
+}
+
+type GetRecurrenceRuleUseCase struct {
+	recurrenceRepo *repositories.RecurrenceRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewGetRecurrenceRuleUseCase(
+	recurrenceRepo *repositories.RecurrenceRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *GetRecurrenceRuleUseCase {
+	return &GetRecurrenceRuleUseCase{
+		recurrenceRepo: recurrenceRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute returns a single recurrence rule of the workspace
+func (uc *GetRecurrenceRuleUseCase) Execute(ctx context.Context, userID int, workspaceID int, ruleID int) (*model.RecurrenceRule, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsRead); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	return getWorkspaceRecurrenceRule(ctx, uc.recurrenceRepo, workspaceID, ruleID)
+}
+
+type CreateRecurrenceRuleUseCase struct {
+	recurrenceRepo *repositories.RecurrenceRepository
+	accountRepo    *repositories.AccountRepository
+	categoryRepo   *repositories.CategoryRepository
+	payeeRepo      *repositories.PayeeRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewCreateRecurrenceRuleUseCase(
+	recurrenceRepo *repositories.RecurrenceRepository,
+	accountRepo *repositories.AccountRepository,
+	categoryRepo *repositories.CategoryRepository,
+	payeeRepo *repositories.PayeeRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *CreateRecurrenceRuleUseCase {
+	return &CreateRecurrenceRuleUseCase{
+		recurrenceRepo: recurrenceRepo,
+		accountRepo:    accountRepo,
+		categoryRepo:   categoryRepo,
+		payeeRepo:      payeeRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute creates a recurrence rule in the workspace. Its occurrences are scheduled from the later of
+// its start and today; earlier ones are not backfilled.
+func (uc *CreateRecurrenceRuleUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	input RecurrenceRuleInput,
+) (*model.RecurrenceRule, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	account, err := getWorkspaceAccount(ctx, uc.accountRepo, workspaceID, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	rule := &model.RecurrenceRule{WorkspaceID: workspaceID, ScheduledFrom: service.CalendarDate(time.Now())}
+	if err := input.apply(rule, account); err != nil {
+		return nil, err
+	}
+	if err := service.ValidateRecurrenceRule(rule); err != nil {
+		return nil, err
+	}
+	if err := ensureRecurrenceRuleReferencesExist(ctx, uc.categoryRepo, uc.payeeRepo, rule); err != nil {
+		return nil, err
+	}
+
+	rule, err = uc.recurrenceRepo.CreateRule(ctx, rule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create recurrence rule: %w", err)
+	}
+	return rule, nil
+}
+
+type UpdateRecurrenceRuleUseCase struct {
+	recurrenceRepo *repositories.RecurrenceRepository
+	accountRepo    *repositories.AccountRepository
+	categoryRepo   *repositories.CategoryRepository
+	payeeRepo      *repositories.PayeeRepository
+	membershipRepo *repositories.MembershipRepository
+	client         *ent.Client
+}
+
+func NewUpdateRecurrenceRuleUseCase(
+	recurrenceRepo *repositories.RecurrenceRepository,
+	accountRepo *repositories.AccountRepository,
+	categoryRepo *repositories.CategoryRepository,
+	payeeRepo *repositories.PayeeRepository,
+	membershipRepo *repositories.MembershipRepository,
+	client *ent.Client,
+) *UpdateRecurrenceRuleUseCase {
+	return &UpdateRecurrenceRuleUseCase{
+		recurrenceRepo: recurrenceRepo,
+		accountRepo:    accountRepo,
+		categoryRepo:   categoryRepo,
+		payeeRepo:      payeeRepo,
+		membershipRepo: membershipRepo,
+		client:         client,
+	}
+}
+
+// Execute replaces the editable fields of a recurrence rule. Occurrences still to be posted are
+// dropped and scheduled again from the new schedule, starting today so that dates already past are
+// not backfilled; posted and skipped ones are kept.
+func (uc *UpdateRecurrenceRuleUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	ruleID int,
+	input RecurrenceRuleInput,
+) (*model.RecurrenceRule, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	rule, err := getWorkspaceRecurrenceRule(ctx, uc.recurrenceRepo, workspaceID, ruleID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := getWorkspaceAccount(ctx, uc.accountRepo, workspaceID, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	if err := input.apply(rule, account); err != nil {
+		return nil, err
+	}
+	rule.ScheduledFrom = service.CalendarDate(time.Now())
+	if err := service.ValidateRecurrenceRule(rule); err != nil {
+		return nil, err
+	}
+	if err := ensureRecurrenceRuleReferencesExist(ctx, uc.categoryRepo, uc.payeeRepo, rule); err != nil {
+		return nil, err
+	}
+
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	recurrenceRepo := repositories.NewRecurrenceRepository(tx.Client())
+	rule, err = recurrenceRepo.UpdateRule(ctx, rule)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, rollback(tx, ErrRecurrenceRuleNotFound)
+		}
+		return nil, rollback(tx, fmt.Errorf("failed to update recurrence rule: %w", err))
+	}
+	if err := recurrenceRepo.DeleteScheduledOccurrences(ctx, workspaceID, rule.ID); err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to delete scheduled occurrences: %w", err))
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return rule, nil
+}
+
+type DeleteRecurrenceRuleUseCase struct {
+	recurrenceRepo *repositories.RecurrenceRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewDeleteRecurrenceRuleUseCase(
+	recurrenceRepo *repositories.RecurrenceRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *DeleteRecurrenceRuleUseCase {
+	return &DeleteRecurrenceRuleUseCase{
+		recurrenceRepo: recurrenceRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute deletes a recurrence rule and its pending occurrences; transactions already posted are kept
+func (uc *DeleteRecurrenceRuleUseCase) Execute(ctx context.Context, userID int, workspaceID int, ruleID int) error {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	deleted, err := uc.recurrenceRepo.DeleteRule(ctx, workspaceID, ruleID)
+	if err != nil {
+		return fmt.Errorf("failed to delete recurrence rule: %w", err)
+	}
+	if !deleted {
+		return ErrRecurrenceRuleNotFound
+	}
+	return nil
+}
+
+type ListUpcomingOccurrencesUseCase struct {
+	recurrenceRepo *repositories.RecurrenceRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewListUpcomingOccurrencesUseCase(
+	recurrenceRepo *repositories.RecurrenceRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *ListUpcomingOccurrencesUseCase {
+	return &ListUpcomingOccurrencesUseCase{
+		recurrenceRepo: recurrenceRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute returns the workspace's occurrences still to be posted that are due within the given number
+// of days, overdue ones included, soonest first. Occurrences further ahead than the scheduler has
+// materialized are scheduled on the way, so the listing never depends on when it last ran.
+func (uc *ListUpcomingOccurrencesUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	days int,
+) ([]UpcomingOccurrence, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsRead); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	if days == 0 {
+		days = defaultUpcomingDays
+	}
+	if days < 1 || days > maxUpcomingDays {
+		return nil, ErrInvalidUpcomingDays
+	}
+	through := service.CalendarDate(time.Now()).AddDate(0, 0, days)
+
+	rules, err := uc.recurrenceRepo.ListRules(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recurrence rules: %w", err)
+	}
+	rulesByID := make(map[int]*model.RecurrenceRule, len(rules))
+	for _, rule := range rules {
+		rulesByID[rule.ID] = rule
+		if _, err := materializeOccurrences(ctx, uc.recurrenceRepo, rule, through); err != nil {
+			return nil, err
+		}
+	}
+
+	occurrences, err := uc.recurrenceRepo.ListScheduledOccurrences(ctx, workspaceID, through)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list occurrences: %w", err)
+	}
+	upcoming := make([]UpcomingOccurrence, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		if rule, ok := rulesByID[occurrence.RuleID]; ok {
+			upcoming = append(upcoming, UpcomingOccurrence{Occurrence: occurrence, Rule: rule})
+		}
+	}
+	return upcoming, nil
+}
+
+type SkipOccurrenceUseCase struct {
+	recurrenceRepo *repositories.RecurrenceRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewSkipOccurrenceUseCase(
+	recurrenceRepo *repositories.RecurrenceRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *SkipOccurrenceUseCase {
+	return &SkipOccurrenceUseCase{
+		recurrenceRepo: recurrenceRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute skips a scheduled occurrence so that it is never posted; the rule's other occurrences are unaffected
+func (uc *SkipOccurrenceUseCase) Execute(ctx context.Context, userID int, workspaceID int, occurrenceID int) (*model.RecurrenceOccurrence, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	occurrence, err := getWorkspaceOccurrence(ctx, uc.recurrenceRepo, workspaceID, occurrenceID)
+	if err != nil {
+		return nil, err
+	}
+	skipped, err := uc.recurrenceRepo.ResolveOccurrence(ctx, workspaceID, occurrence.ID, model.RecurrenceOccurrenceStatusSkipped, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to skip occurrence: %w", err)
+	}
+	if !skipped {
+		return nil, ErrOccurrenceNotScheduled
+	}
+
+	occurrence.Status = model.RecurrenceOccurrenceStatusSkipped
+	return occurrence, nil
+}
+
+type PostOccurrenceUseCase struct {
+	recurrenceRepo *repositories.RecurrenceRepository
+	membershipRepo *repositories.MembershipRepository
+	client         *ent.Client
+}
+
+func NewPostOccurrenceUseCase(
+	recurrenceRepo *repositories.RecurrenceRepository,
+	membershipRepo *repositories.MembershipRepository,
+	client *ent.Client,
+) *PostOccurrenceUseCase {
+	return &PostOccurrenceUseCase{
+		recurrenceRepo: recurrenceRepo,
+		membershipRepo: membershipRepo,
+		client:         client,
+	}
+}
+
+// Execute posts a scheduled occurrence now instead of waiting for its due date. The transaction is
+// dated today, or on the due date when the occurrence is already overdue.
+func (uc *PostOccurrenceUseCase) Execute(ctx context.Context, userID int, workspaceID int, occurrenceID int) (*model.Transaction, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	occurrence, err := getWorkspaceOccurrence(ctx, uc.recurrenceRepo, workspaceID, occurrenceID)
+	if err != nil {
+		return nil, err
+	}
+	if occurrence.Status != model.RecurrenceOccurrenceStatusScheduled {
+		return nil, ErrOccurrenceNotScheduled
+	}
+	rule, err := getWorkspaceRecurrenceRule(ctx, uc.recurrenceRepo, workspaceID, occurrence.RuleID)
+	if err != nil {
+		return nil, err
+	}
+
+	postedOn := service.CalendarDate(time.Now())
+	if occurrence.DueOn.Before(postedOn) {
+		postedOn = occurrence.DueOn
+	}
+	return postOccurrence(ctx, uc.client, rule, occurrence, postedOn)
+}
+
+type RunRecurrencesUseCase struct {
+	recurrenceRepo *repositories.RecurrenceRepository
+	client         *ent.Client
+}
+
+func NewRunRecurrencesUseCase(
+	recurrenceRepo *repositories.RecurrenceRepository,
+	client *ent.Client,
+) *RunRecurrencesUseCase {
+	return &RunRecurrencesUseCase{
+		recurrenceRepo: recurrenceRepo,
+		client:         client,
+	}
+}
+
+// Execute is the scheduler's pass over every workspace: it schedules the occurrences due within the
+// horizon and posts those due by today, each on its due date. Runs are idempotent, so overlapping or
+// repeated runs neither duplicate occurrences nor post one twice. A failing rule or occurrence does
+// not stop the others; their errors are returned together.
+func (uc *RunRecurrencesUseCase) Execute(ctx context.Context, today time.Time, horizonDays int) (RecurrenceRunResult, error) {
+	var result RecurrenceRunResult
+	var errs []error
+	today = service.CalendarDate(today)
+
+	rules, err := uc.recurrenceRepo.ListActiveRules(tenant.Bypass(ctx), today)
+	if err != nil {
+		return result, fmt.Errorf("failed to list recurrence rules: %w", err)
+	}
+	rulesByID := make(map[int]*model.RecurrenceRule, len(rules))
+	for _, rule := range rules {
+		rulesByID[rule.ID] = rule
+		n, err := materializeOccurrences(tenant.WithWorkspace(ctx, rule.WorkspaceID), uc.recurrenceRepo, rule, today.AddDate(0, 0, horizonDays))
+		result.Materialized += n
+		if err != nil {
+			errs = append(errs, fmt.Errorf("recurrence rule %d: %w", rule.ID, err))
+		}
+	}
+
+	occurrences, err := uc.recurrenceRepo.ListDueOccurrences(tenant.Bypass(ctx), today)
+	if err != nil {
+		return result, errors.Join(append(errs, fmt.Errorf("failed to list due occurrences: %w", err))...)
+	}
+	for _, occurrence := range occurrences {
+		workspaceCtx := tenant.WithWorkspace(ctx, occurrence.WorkspaceID)
+		rule, ok := rulesByID[occurrence.RuleID]
+		if !ok {
+			// The rule ended before today but still has overdue occurrences
+			if rule, err = getWorkspaceRecurrenceRule(workspaceCtx, uc.recurrenceRepo, occurrence.WorkspaceID, occurrence.RuleID); err != nil {
+				errs = append(errs, fmt.Errorf("occurrence %d: %w", occurrence.ID, err))
+				continue
+			}
+		}
+		_, err := postOccurrence(workspaceCtx, uc.client, rule, occurrence, occurrence.DueOn)
+		if errors.Is(err, ErrOccurrenceNotScheduled) {
+			// Posted or skipped since it was listed
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("occurrence %d: %w", occurrence.ID, err))
+			continue
+		}
+		result.Posted++
+	}
+	return result, errors.Join(errs...)
+}
+
+// materializeOccurrences schedules the rule's occurrences due through the date that are not stored yet
+// and returns how many it stored. Nothing before the day the rule was created or last edited is scheduled.
+func materializeOccurrences(
+	ctx context.Context,
+	recurrenceRepo *repositories.RecurrenceRepository,
+	rule *model.RecurrenceRule,
+	through time.Time,
+) (int, error) {
+	dates := service.ScheduledRecurrenceOccurrences(rule, through)
+	if len(dates) == 0 {
+		return 0, nil
+	}
+
+	existing, err := recurrenceRepo.ListOccurrenceDates(ctx, rule.WorkspaceID, rule.ID, dates[0])
+	if err != nil {
+		return 0, fmt.Errorf("failed to list occurrences: %w", err)
+	}
+	stored := make(map[time.Time]bool, len(existing))
+	for _, date := range existing {
+		stored[service.CalendarDate(date)] = true
+	}
+
+	created := 0
+	for _, date := range dates {
+		if stored[date] {
+			continue
+		}
+		_, err := recurrenceRepo.CreateOccurrence(ctx, &model.RecurrenceOccurrence{
+			WorkspaceID: rule.WorkspaceID,
+			RuleID:      rule.ID,
+			DueOn:       date,
+		})
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				// Scheduled concurrently by another run
+				continue
+			}
+			return created, fmt.Errorf("failed to create occurrence: %w", err)
+		}
+		created++
+	}
+	return created, nil
+}
+
+// postOccurrence records the transaction of a scheduled occurrence on the date, with its journal entry,
+// and marks the occurrence posted. It returns ErrOccurrenceNotScheduled when the occurrence was posted
+// or skipped in the meantime.
+func postOccurrence(
+	ctx context.Context,
+	client *ent.Client,
+	rule *model.RecurrenceRule,
+	occurrence *model.RecurrenceOccurrence,
+	postedOn time.Time,
+) (*model.Transaction, error) {
+	transaction := &model.Transaction{
+		WorkspaceID: rule.WorkspaceID,
+		AccountID:   rule.AccountID,
+		PostedOn:    postedOn,
+		Amount:      rule.Amount,
+		Payee:       rule.Payee,
+		PayeeID:     rule.PayeeID,
+		Memo:        rule.Memo,
+		CategoryID:  rule.CategoryID,
+		Status:      model.TransactionStatusUncleared,
+	}
+	if err := resolveTransactionPayee(ctx, repositories.NewPayeeRepository(client), transaction); err != nil {
+		return nil, err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	transaction, err = repositories.NewTransactionRepository(tx.Client()).CreateTransaction(ctx, transaction)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to create transaction: %w", err))
+	}
+	if err := postTransaction(ctx, tx.Client(), transaction); err != nil {
+		return nil, rollback(tx, err)
+	}
+	posted, err := repositories.NewRecurrenceRepository(tx.Client()).ResolveOccurrence(
+		ctx,
+		occurrence.WorkspaceID,
+		occurrence.ID,
+		model.RecurrenceOccurrenceStatusPosted,
+		&transaction.ID,
+	)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to mark occurrence posted: %w", err))
+	}
+	if !posted {
+		return nil, rollback(tx, ErrOccurrenceNotScheduled)
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return transaction, nil
+}
+
+// ensureRecurrenceRuleReferencesExist returns ErrCategoryNotFound or ErrPayeeNotFound unless the rule's
+// category and payee, if any, belong to its workspace
+func ensureRecurrenceRuleReferencesExist(
+	ctx context.Context,
+	categoryRepo *repositories.CategoryRepository,
+	payeeRepo *repositories.PayeeRepository,
+	rule *model.RecurrenceRule,
+) error {
+	if rule.CategoryID != nil {
+		if _, err := categoryRepo.GetCategory(ctx, rule.WorkspaceID, *rule.CategoryID); err != nil {
+			if ent.IsNotFound(err) {
+				return ErrCategoryNotFound
+			}
+			return fmt.Errorf("failed to get category: %w", err)
+		}
+	}
+	if rule.PayeeID != nil {
+		if _, err := getWorkspacePayee(ctx, payeeRepo, rule.WorkspaceID, *rule.PayeeID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getWorkspaceRecurrenceRule returns ErrRecurrenceRuleNotFound unless the rule belongs to the workspace
+func getWorkspaceRecurrenceRule(
+	ctx context.Context,
+	recurrenceRepo *repositories.RecurrenceRepository,
+	workspaceID int,
+	ruleID int,
+) (*model.RecurrenceRule, error) {
+	rule, err := recurrenceRepo.GetRule(ctx, workspaceID, ruleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrRecurrenceRuleNotFound
+		}
+		return nil, fmt.Errorf("failed to get recurrence rule: %w", err)
+	}
+	return rule, nil
+}
+
+// getWorkspaceOccurrence returns ErrOccurrenceNotFound unless the occurrence belongs to the workspace
+func getWorkspaceOccurrence(
+	ctx context.Context,
+	recurrenceRepo *repositories.RecurrenceRepository,
+	workspaceID int,
+	occurrenceID int,
+) (*model.RecurrenceOccurrence, error) {
+	occurrence, err := recurrenceRepo.GetOccurrence(ctx, workspaceID, occurrenceID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrOccurrenceNotFound
+		}
+		return nil, fmt.Errorf("failed to get occurrence: %w", err)
+	}
+	return occurrence, nil
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/repositories"
+	"backend/internal/infrastructure/tenant"
+)
+
+type CategoryReportUseCase struct {
+	transactionRepo *repositories.TransactionRepository
+	membershipRepo  *repositories.MembershipRepository
+}
+
+func NewCategoryReportUseCase(
+	transactionRepo *repositories.TransactionRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *CategoryReportUseCase {
+	return &CategoryReportUseCase{
+		transactionRepo: transactionRepo,
+		membershipRepo:  membershipRepo,
+	}
+}
+
+// Execute returns the workspace's totals per category and currency over the transactions matching the filter.
+// Split transactions count towards the categories of their splits.
+func (uc *CategoryReportUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	filter model.ReportFilter,
+) ([]model.CategoryTotal, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsRead); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	if err := service.ValidateReportFilter(filter); err != nil {
+		return nil, err
+	}
+
+	totals, err := uc.transactionRepo.CategoryTotals(ctx, workspaceID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum category totals: %w", err)
+	}
+	return totals, nil
+}
+
+type TagReportUseCase struct {
+	transactionRepo *repositories.TransactionRepository
+	membershipRepo  *repositories.MembershipRepository
+}
+
+func NewTagReportUseCase(
+	transactionRepo *repositories.TransactionRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *TagReportUseCase {
+	return &TagReportUseCase{
+		transactionRepo: transactionRepo,
+		membershipRepo:  membershipRepo,
+	}
+}
+
+// Execute returns the workspace's totals per tag and currency over the transactions matching the filter.
+// A transaction with several tags counts in full towards each of them; split transactions count per split.
+func (uc *TagReportUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	filter model.ReportFilter,
+) ([]model.TagTotal, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsRead); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	if err := service.ValidateReportFilter(filter); err != nil {
+		return nil, err
+	}
+
+	totals, err := uc.transactionRepo.TagTotals(ctx, workspaceID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum tag totals: %w", err)
+	}
+	return totals, nil
+}
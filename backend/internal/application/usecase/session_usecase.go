@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/repositories"
+)
+
+// ErrSessionNotFound is returned when a session does not exist or belongs to another user
+var ErrSessionNotFound = errors.New("session not found")
+
+type ListSessionsUseCase struct {
+	sessionRepo *repositories.SessionRepository
+}
+
+func NewListSessionsUseCase(sessionRepo *repositories.SessionRepository) *ListSessionsUseCase {
+	return &ListSessionsUseCase{sessionRepo: sessionRepo}
+}
+
+// Execute returns the active sessions (signed-in devices) of the user
+func (uc *ListSessionsUseCase) Execute(ctx context.Context, userID int) ([]*model.Session, error) {
+	sessions, err := uc.sessionRepo.ListActiveSessionsByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+type RevokeSessionUseCase struct {
+	sessionRepo *repositories.SessionRepository
+}
+
+func NewRevokeSessionUseCase(sessionRepo *repositories.SessionRepository) *RevokeSessionUseCase {
+	return &RevokeSessionUseCase{sessionRepo: sessionRepo}
+}
+
+// Execute signs out one of the user's sessions
+func (uc *RevokeSessionUseCase) Execute(ctx context.Context, userID int, sessionID int) error {
+	deleted, err := uc.sessionRepo.DeleteSessionByIDAndUserID(ctx, sessionID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	if !deleted {
+		return ErrSessionNotFound
+	}
+	return nil
+}
@@ -2,14 +2,19 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"backend/internal/domain/model"
 	"backend/internal/domain/service"
 	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/membership"
 	"backend/internal/infrastructure/repositories"
 )
 
+// ErrEmailAlreadyRegistered is returned when signing up with an email that already has an account
+var ErrEmailAlreadyRegistered = errors.New("email already registered")
+
 type SignupUseCase struct {
 	userRepo      *repositories.UserRepository
 	workspaceRepo *repositories.WorkspaceRepository
@@ -35,19 +40,21 @@ func (uc *SignupUseCase) Execute(
 	password string,
 	workspaceName string,
 ) (*model.User, *model.Workspace, error) {
+	email = service.NormalizeEmail(email)
+
 	// Validate email format
 	if err := service.ValidateEmail(email); err != nil {
 		return nil, nil, fmt.Errorf("email validation failed: %w", err)
 	}
 
 	// Validate password length
-	if len(password) < 8 {
-		return nil, nil, fmt.Errorf("password must be at least 8 characters")
+	if err := service.ValidatePassword(password); err != nil {
+		return nil, nil, err
 	}
 
 	// Validate workspace name
 	if workspaceName == "" {
-		return nil, nil, fmt.Errorf("workspace name is required")
+		return nil, nil, ErrWorkspaceNameRequired
 	}
 
 	// Check if email already exists
@@ -56,7 +63,7 @@ func (uc *SignupUseCase) Execute(
 		return nil, nil, fmt.Errorf("failed to check email existence: %w", err)
 	}
 	if exists {
-		return nil, nil, fmt.Errorf("email already registered")
+		return nil, nil, ErrEmailAlreadyRegistered
 	}
 
 	// Hash password
@@ -89,16 +96,31 @@ func (uc *SignupUseCase) Execute(
 		return nil, nil, rollback(tx, fmt.Errorf("failed to create user: %w", err))
 	}
 
-	// Create workspace and link to user
+	// Create workspace
 	entWorkspace, err := tx.Workspace.
 		Create().
 		SetName(workspaceName).
-		AddUsers(entUser).
 		Save(ctx)
 	if err != nil {
 		return nil, nil, rollback(tx, fmt.Errorf("failed to create workspace: %w", err))
 	}
 
+	// Make the user the owner of the workspace
+	err = tx.Membership.
+		Create().
+		SetWorkspaceID(entWorkspace.ID).
+		SetUserID(entUser.ID).
+		SetRole(membership.RoleOwner).
+		Exec(ctx)
+	if err != nil {
+		return nil, nil, rollback(tx, fmt.Errorf("failed to create membership: %w", err))
+	}
+
+	// Give the workspace a default category tree
+	if err := seedDefaultCategories(ctx, tx.Client(), entWorkspace.ID); err != nil {
+		return nil, nil, rollback(tx, err)
+	}
+
 	// Commit transaction
 	if err := tx.Commit(); err != nil {
 		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
@@ -106,18 +128,20 @@ func (uc *SignupUseCase) Execute(
 
 	// Convert to domain models
 	user := &model.User{
-		ID:           entUser.ID,
-		Email:        entUser.Email,
-		PasswordHash: entUser.PasswordHash,
-		CreatedAt:    entUser.CreatedAt,
-		UpdatedAt:    entUser.UpdatedAt,
+		ID:              entUser.ID,
+		Email:           entUser.Email,
+		PasswordHash:    entUser.PasswordHash,
+		EmailVerifiedAt: entUser.EmailVerifiedAt,
+		CreatedAt:       entUser.CreatedAt,
+		UpdatedAt:       entUser.UpdatedAt,
 	}
 
 	workspace := &model.Workspace{
-		ID:        entWorkspace.ID,
-		Name:      entWorkspace.Name,
-		CreatedAt: entWorkspace.CreatedAt,
-		UpdatedAt: entWorkspace.UpdatedAt,
+		ID:                   entWorkspace.ID,
+		Name:                 entWorkspace.Name,
+		RequireVerifiedEmail: entWorkspace.RequireVerifiedEmail,
+		CreatedAt:            entWorkspace.CreatedAt,
+		UpdatedAt:            entWorkspace.UpdatedAt,
 	}
 
 	return user, workspace, nil
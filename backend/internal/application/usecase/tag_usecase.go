@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/repositories"
+	"backend/internal/infrastructure/tenant"
+)
+
+var (
+	// ErrTagNotFound is returned when a tag does not exist in the workspace
+	ErrTagNotFound = errors.New("tag not found")
+	// ErrTagNameTaken is returned when the workspace already has a tag with the name
+	ErrTagNameTaken = errors.New("a tag with this name already exists")
+)
+
+// TagInput holds the user-editable fields of a tag
+type TagInput struct {
+	Name  string
+	Color string
+}
+
+// apply copies the input onto the tag, normalizing text fields
+func (in TagInput) apply(t *model.Tag) {
+	t.Name = strings.TrimSpace(in.Name)
+	t.Color = strings.ToUpper(strings.TrimSpace(in.Color))
+}
+
+type ListTagsUseCase struct {
+	tagRepo        *repositories.TagRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewListTagsUseCase(
+	tagRepo *repositories.TagRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *ListTagsUseCase {
+	return &ListTagsUseCase{
+		tagRepo:        tagRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute returns the tags of the workspace
+func (uc *ListTagsUseCase) Execute(ctx context.Context, userID int, workspaceID int) ([]*model.Tag, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsRead); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	tags, err := uc.tagRepo.ListTags(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	return tags, nil
+}
+
+type CreateTagUseCase struct {
+	tagRepo        *repositories.TagRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewCreateTagUseCase(
+	tagRepo *repositories.TagRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *CreateTagUseCase {
+	return &CreateTagUseCase{
+		tagRepo:        tagRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute creates a tag in the workspace. Anyone who may write transactions may create tags for them.
+func (uc *CreateTagUseCase) Execute(ctx context.Context, userID int, workspaceID int, input TagInput) (*model.Tag, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	tag := &model.Tag{WorkspaceID: workspaceID}
+	input.apply(tag)
+	if err := service.ValidateTag(tag); err != nil {
+		return nil, err
+	}
+
+	tag, err := uc.tagRepo.CreateTag(ctx, tag)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrTagNameTaken
+		}
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+	return tag, nil
+}
+
+type UpdateTagUseCase struct {
+	tagRepo        *repositories.TagRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewUpdateTagUseCase(
+	tagRepo *repositories.TagRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *UpdateTagUseCase {
+	return &UpdateTagUseCase{
+		tagRepo:        tagRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute renames or recolors a tag; its transactions keep it
+func (uc *UpdateTagUseCase) Execute(ctx context.Context, userID int, workspaceID int, tagID int, input TagInput) (*model.Tag, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	tag := &model.Tag{ID: tagID, WorkspaceID: workspaceID}
+	input.apply(tag)
+	if err := service.ValidateTag(tag); err != nil {
+		return nil, err
+	}
+
+	tag, err := uc.tagRepo.UpdateTag(ctx, tag)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTagNotFound
+		}
+		if ent.IsConstraintError(err) {
+			return nil, ErrTagNameTaken
+		}
+		return nil, fmt.Errorf("failed to update tag: %w", err)
+	}
+	return tag, nil
+}
+
+type DeleteTagUseCase struct {
+	tagRepo        *repositories.TagRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewDeleteTagUseCase(
+	tagRepo *repositories.TagRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *DeleteTagUseCase {
+	return &DeleteTagUseCase{
+		tagRepo:        tagRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute deletes a tag of the workspace and removes it from its transactions
+func (uc *DeleteTagUseCase) Execute(ctx context.Context, userID int, workspaceID int, tagID int) error {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	deleted, err := uc.tagRepo.DeleteTag(ctx, workspaceID, tagID)
+	if err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+	if !deleted {
+		return ErrTagNotFound
+	}
+	return nil
+}
+
+// ensureTransactionTagsExist returns ErrTagNotFound unless every tag of the transaction and of its splits
+// belongs to its workspace
+func ensureTransactionTagsExist(ctx context.Context, tagRepo *repositories.TagRepository, transaction *model.Transaction) error {
+	tagIDs := append([]int{}, transaction.TagIDs...)
+	for _, split := range transaction.Splits {
+		tagIDs = append(tagIDs, split.TagIDs...)
+	}
+	tagIDs = uniqueSortedIDs(tagIDs)
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	n, err := tagRepo.CountTags(ctx, transaction.WorkspaceID, tagIDs)
+	if err != nil {
+		return fmt.Errorf("failed to count tags: %w", err)
+	}
+	if n != len(tagIDs) {
+		return ErrTagNotFound
+	}
+	return nil
+}
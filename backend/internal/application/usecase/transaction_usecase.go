@@ -0,0 +1,498 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/repositories"
+	"backend/internal/infrastructure/tenant"
+)
+
+const (
+	// defaultTransactionLimit is the page size when a listing does not ask for one
+	defaultTransactionLimit = 100
+	// maxTransactionLimit caps the page size of transaction listings
+	maxTransactionLimit = 500
+)
+
+// ErrTransactionNotFound is returned when a transaction does not exist in the workspace
+var ErrTransactionNotFound = errors.New("transaction not found")
+
+// TransactionInput holds the user-editable fields of a transaction
+type TransactionInput struct {
+	AccountID  int
+	PostedOn   time.Time
+	Amount     model.Decimal // In major units of the account currency
+	Payee      string
+	PayeeID    *int // Resolved from Payee on creation when nil
+	Memo       string
+	CategoryID *int
+	Splits     []TransactionSplitInput // Divides the amount across categories; CategoryID must then be nil
+	TagIDs     []int
+	Status     model.TransactionStatus // Defaults to uncleared when empty
+}
+
+// TransactionSplitInput holds the user-editable fields of a transaction split
+type TransactionSplitInput struct {
+	CategoryID *int
+	Amount     model.Decimal // In major units of the account currency
+	Memo       string
+	TagIDs     []int
+}
+
+// apply copies the input onto the transaction, normalizing text fields.
+// account is the account named by in.AccountID; the amount is expressed in its currency.
+func (in TransactionInput) apply(t *model.Transaction, account *model.Account) error {
+	amount, err := model.MoneyFromDecimal(in.Amount, account.Currency)
+	if err != nil {
+		return err
+	}
+	splits := make([]*model.TransactionSplit, 0, len(in.Splits))
+	for _, split := range in.Splits {
+		splitAmount, err := model.MoneyFromDecimal(split.Amount, account.Currency)
+		if err != nil {
+			return err
+		}
+		splits = append(splits, &model.TransactionSplit{
+			CategoryID: split.CategoryID,
+			Amount:     splitAmount,
+			Memo:       strings.TrimSpace(split.Memo),
+			TagIDs:     uniqueSortedIDs(split.TagIDs),
+		})
+	}
+
+	t.AccountID = account.ID
+	t.PostedOn = in.PostedOn
+	t.Amount = amount
+	t.Payee = strings.TrimSpace(in.Payee)
+	t.PayeeID = in.PayeeID
+	t.Memo = strings.TrimSpace(in.Memo)
+	t.CategoryID = in.CategoryID
+	t.Splits = splits
+	t.TagIDs = uniqueSortedIDs(in.TagIDs)
+	t.Status = in.Status
+	if t.Status == "" {
+		t.Status = model.TransactionStatusUncleared
+	}
+	return nil
+}
+
+type ListTransactionsUseCase struct {
+	transactionRepo *repositories.TransactionRepository
+	categoryRepo    *repositories.CategoryRepository
+	membershipRepo  *repositories.MembershipRepository
+}
+
+func NewListTransactionsUseCase(
+	transactionRepo *repositories.TransactionRepository,
+	categoryRepo *repositories.CategoryRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *ListTransactionsUseCase {
+	return &ListTransactionsUseCase{
+		transactionRepo: transactionRepo,
+		categoryRepo:    categoryRepo,
+		membershipRepo:  membershipRepo,
+	}
+}
+
+// Execute returns the workspace's transactions matching the filter, newest first.
+// Filtering by a category includes the transactions of its subcategories.
+func (uc *ListTransactionsUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	filter model.TransactionFilter,
+) ([]*model.Transaction, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsRead); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	if err := service.ValidateTransactionFilter(filter); err != nil {
+		return nil, err
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultTransactionLimit
+	}
+	if filter.Limit > maxTransactionLimit {
+		filter.Limit = maxTransactionLimit
+	}
+	if len(filter.CategoryIDs) > 0 {
+		categories, err := uc.categoryRepo.ListCategories(ctx, workspaceID, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list categories: %w", err)
+		}
+		var categoryIDs []int
+		for _, id := range filter.CategoryIDs {
+			categoryIDs = append(categoryIDs, service.CategorySubtree(categories, id)...)
+		}
+		filter.CategoryIDs = categoryIDs
+	}
+
+	transactions, err := uc.transactionRepo.ListTransactions(ctx, workspaceID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transactions: %w", err)
+	}
+	return transactions, nil
+}
+
+type CreateTransactionUseCase struct {
+	accountRepo    *repositories.AccountRepository
+	categoryRepo   *repositories.CategoryRepository
+	tagRepo        *repositories.TagRepository
+	payeeRepo      *repositories.PayeeRepository
+	membershipRepo *repositories.MembershipRepository
+	client         *ent.Client
+}
+
+func NewCreateTransactionUseCase(
+	accountRepo *repositories.AccountRepository,
+	categoryRepo *repositories.CategoryRepository,
+	tagRepo *repositories.TagRepository,
+	payeeRepo *repositories.PayeeRepository,
+	membershipRepo *repositories.MembershipRepository,
+	client *ent.Client,
+) *CreateTransactionUseCase {
+	return &CreateTransactionUseCase{
+		accountRepo:    accountRepo,
+		categoryRepo:   categoryRepo,
+		tagRepo:        tagRepo,
+		payeeRepo:      payeeRepo,
+		membershipRepo: membershipRepo,
+		client:         client,
+	}
+}
+
+// Execute records a transaction on an account of the workspace together with its journal entry.
+// Unless the input names a payee, the transaction is linked to the payee its description resolves to.
+func (uc *CreateTransactionUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	input TransactionInput,
+) (*model.Transaction, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	account, err := getWorkspaceAccount(ctx, uc.accountRepo, workspaceID, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction := &model.Transaction{WorkspaceID: workspaceID}
+	if err := input.apply(transaction, account); err != nil {
+		return nil, err
+	}
+	if err := resolveTransactionPayee(ctx, uc.payeeRepo, transaction); err != nil {
+		return nil, err
+	}
+	if err := service.ValidateTransaction(transaction); err != nil {
+		return nil, err
+	}
+	if err := ensureTransactionCategoriesExist(ctx, uc.categoryRepo, transaction); err != nil {
+		return nil, err
+	}
+	if err := ensureTransactionTagsExist(ctx, uc.tagRepo, transaction); err != nil {
+		return nil, err
+	}
+
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	transaction, err = repositories.NewTransactionRepository(tx.Client()).CreateTransaction(ctx, transaction)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to create transaction: %w", err))
+	}
+	if err := postTransaction(ctx, tx.Client(), transaction); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return transaction, nil
+}
+
+type UpdateTransactionUseCase struct {
+	transactionRepo *repositories.TransactionRepository
+	accountRepo     *repositories.AccountRepository
+	categoryRepo    *repositories.CategoryRepository
+	tagRepo         *repositories.TagRepository
+	payeeRepo       *repositories.PayeeRepository
+	membershipRepo  *repositories.MembershipRepository
+	client          *ent.Client
+}
+
+func NewUpdateTransactionUseCase(
+	transactionRepo *repositories.TransactionRepository,
+	accountRepo *repositories.AccountRepository,
+	categoryRepo *repositories.CategoryRepository,
+	tagRepo *repositories.TagRepository,
+	payeeRepo *repositories.PayeeRepository,
+	membershipRepo *repositories.MembershipRepository,
+	client *ent.Client,
+) *UpdateTransactionUseCase {
+	return &UpdateTransactionUseCase{
+		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
+		categoryRepo:    categoryRepo,
+		tagRepo:         tagRepo,
+		payeeRepo:       payeeRepo,
+		membershipRepo:  membershipRepo,
+		client:          client,
+	}
+}
+
+// Execute replaces the editable fields of a transaction and reposts its journal entry.
+// The sides of a transfer are edited through the transfer instead.
+func (uc *UpdateTransactionUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	transactionID int,
+	input TransactionInput,
+) (*model.Transaction, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	transaction, err := uc.transactionRepo.GetTransaction(ctx, workspaceID, transactionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if transaction.IsTransfer() {
+		return nil, ErrTransactionInTransfer
+	}
+
+	account, err := getWorkspaceAccount(ctx, uc.accountRepo, workspaceID, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	if err := input.apply(transaction, account); err != nil {
+		return nil, err
+	}
+	if err := service.ValidateTransaction(transaction); err != nil {
+		return nil, err
+	}
+	if err := ensureTransactionCategoriesExist(ctx, uc.categoryRepo, transaction); err != nil {
+		return nil, err
+	}
+	if err := ensureTransactionTagsExist(ctx, uc.tagRepo, transaction); err != nil {
+		return nil, err
+	}
+	if transaction.PayeeID != nil {
+		if _, err := getWorkspacePayee(ctx, uc.payeeRepo, workspaceID, *transaction.PayeeID); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	transaction, err = repositories.NewTransactionRepository(tx.Client()).UpdateTransaction(ctx, transaction)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, rollback(tx, ErrTransactionNotFound)
+		}
+		return nil, rollback(tx, fmt.Errorf("failed to update transaction: %w", err))
+	}
+	if err := postTransaction(ctx, tx.Client(), transaction); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return transaction, nil
+}
+
+type DeleteTransactionUseCase struct {
+	transactionRepo *repositories.TransactionRepository
+	membershipRepo  *repositories.MembershipRepository
+}
+
+func NewDeleteTransactionUseCase(
+	transactionRepo *repositories.TransactionRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *DeleteTransactionUseCase {
+	return &DeleteTransactionUseCase{
+		transactionRepo: transactionRepo,
+		membershipRepo:  membershipRepo,
+	}
+}
+
+// Execute deletes a transaction of the workspace; its journal entry is deleted along with it.
+// The sides of a transfer are deleted or unlinked through the transfer instead.
+func (uc *DeleteTransactionUseCase) Execute(ctx context.Context, userID int, workspaceID int, transactionID int) error {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	transaction, err := uc.transactionRepo.GetTransaction(ctx, workspaceID, transactionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrTransactionNotFound
+		}
+		return fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if transaction.IsTransfer() {
+		return ErrTransactionInTransfer
+	}
+
+	deleted, err := uc.transactionRepo.DeleteTransaction(ctx, workspaceID, transactionID)
+	if err != nil {
+		return fmt.Errorf("failed to delete transaction: %w", err)
+	}
+	if !deleted {
+		return ErrTransactionNotFound
+	}
+	return nil
+}
+
+// postTransaction records the journal entry of a transaction, replacing the previous one on updates.
+// client must belong to the ent transaction that writes the transaction itself.
+func postTransaction(ctx context.Context, client *ent.Client, transaction *model.Transaction) error {
+	journalRepo := repositories.NewJournalRepository(client)
+	if err := journalRepo.DeleteTransactionJournalEntry(ctx, transaction.WorkspaceID, transaction.ID); err != nil {
+		return fmt.Errorf("failed to delete journal entry: %w", err)
+	}
+
+	var accountTypes []model.AccountType
+	for _, allocation := range service.TransactionAllocations(transaction) {
+		accountTypes = append(accountTypes, service.BalancingAccountType(allocation.Amount))
+	}
+	balancingAccountIDs, err := getBalancingAccountIDs(
+		ctx,
+		repositories.NewAccountRepository(client),
+		transaction.WorkspaceID,
+		transaction.Amount.Currency(),
+		accountTypes,
+	)
+	if err != nil {
+		return err
+	}
+
+	entry := service.TransactionJournalEntry(transaction, balancingAccountIDs)
+	if err := service.ValidateJournalEntry(entry); err != nil {
+		return err
+	}
+	if _, err := journalRepo.CreateJournalEntry(ctx, entry); err != nil {
+		return fmt.Errorf("failed to create journal entry: %w", err)
+	}
+	return nil
+}
+
+// getBalancingAccountIDs returns the IDs of the workspace's nominal accounts of the given types in the currency,
+// creating the accounts on first use
+func getBalancingAccountIDs(
+	ctx context.Context,
+	accountRepo *repositories.AccountRepository,
+	workspaceID int,
+	currency string,
+	accountTypes []model.AccountType,
+) (map[model.AccountType]int, error) {
+	balancingAccountIDs := make(map[model.AccountType]int)
+	for _, accountType := range accountTypes {
+		if _, ok := balancingAccountIDs[accountType]; ok {
+			continue
+		}
+		balancingAccount, err := accountRepo.GetOrCreateNominalAccount(ctx, workspaceID, accountType, currency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get balancing account: %w", err)
+		}
+		balancingAccountIDs[accountType] = balancingAccount.ID
+	}
+	return balancingAccountIDs, nil
+}
+
+// ensureTransactionCategoriesExist returns ErrCategoryNotFound unless the categories of the transaction
+// and of its splits belong to the transaction's workspace
+func ensureTransactionCategoriesExist(ctx context.Context, categoryRepo *repositories.CategoryRepository, transaction *model.Transaction) error {
+	categoryIDs := make([]*int, 0, len(transaction.Splits)+1)
+	categoryIDs = append(categoryIDs, transaction.CategoryID)
+	for _, split := range transaction.Splits {
+		categoryIDs = append(categoryIDs, split.CategoryID)
+	}
+
+	for _, categoryID := range categoryIDs {
+		if categoryID == nil {
+			continue
+		}
+		if _, err := categoryRepo.GetCategory(ctx, transaction.WorkspaceID, *categoryID); err != nil {
+			if ent.IsNotFound(err) {
+				return ErrCategoryNotFound
+			}
+			return fmt.Errorf("failed to get category: %w", err)
+		}
+	}
+	return nil
+}
+
+// uniqueSortedIDs returns the distinct IDs in ascending order
+func uniqueSortedIDs(ids []int) []int {
+	unique := make([]int, 0, len(ids))
+	seen := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	sort.Ints(unique)
+	return unique
+}
+
+// getWorkspaceAccount returns ErrAccountNotFound unless the account belongs to the workspace
+func getWorkspaceAccount(
+	ctx context.Context,
+	accountRepo *repositories.AccountRepository,
+	workspaceID int,
+	accountID int,
+) (*model.Account, error) {
+	account, err := accountRepo.GetAccount(ctx, workspaceID, accountID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+	return account, nil
+}
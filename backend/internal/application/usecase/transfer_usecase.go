@@ -0,0 +1,729 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/repositories"
+	"backend/internal/infrastructure/tenant"
+)
+
+const (
+	// defaultTransferMatchWindowDays is how far apart the two sides of a matched transfer may be posted
+	// when the request does not say
+	defaultTransferMatchWindowDays = 3
+	// maxTransferMatchWindowDays caps the matching window; wider windows mostly find coincidences
+	maxTransferMatchWindowDays = 31
+)
+
+var (
+	// ErrTransferNotFound is returned when a transfer does not exist in the workspace
+	ErrTransferNotFound = errors.New("transfer not found")
+	// ErrTransactionInTransfer is returned when a transaction that is one side of a transfer is edited
+	// or linked on its own
+	ErrTransactionInTransfer = errors.New("transaction is part of a transfer")
+	// ErrReceivedAmountRequired is returned for transfers between currencies without the amount received
+	ErrReceivedAmountRequired = errors.New("received amount is required when the accounts have different currencies")
+	// ErrInvalidTransferMatchWindow is returned for matching windows outside 0 to 31 days
+	ErrInvalidTransferMatchWindow = errors.New("matching window must be between 0 and 31 days")
+)
+
+// TransferInput holds the user-editable fields of a transfer
+type TransferInput struct {
+	FromAccountID  int
+	ToAccountID    int
+	PostedOn       time.Time
+	ReceivedOn     *time.Time     // Defaults to PostedOn
+	Amount         model.Decimal  // Leaving the sending account, including FromFee, in its currency
+	ReceivedAmount *model.Decimal // Reaching the receiving account after ToFee, in its currency; defaults to Amount less both fees when the currencies match
+	FromFee        model.Decimal  // In the sending account currency
+	ToFee          model.Decimal  // In the receiving account currency
+	Memo           string
+	Status         model.TransactionStatus // Of both sides; defaults to uncleared when empty
+}
+
+// apply copies the input onto both sides of the transfer, creating them on first use.
+// from and to are the accounts named by in.FromAccountID and in.ToAccountID.
+func (in TransferInput) apply(t *model.Transfer, from, to *model.Account) error {
+	amount, err := model.MoneyFromDecimal(in.Amount, from.Currency)
+	if err != nil {
+		return err
+	}
+	if t.FromFee, err = model.MoneyFromDecimal(in.FromFee, from.Currency); err != nil {
+		return err
+	}
+	if t.ToFee, err = model.MoneyFromDecimal(in.ToFee, to.Currency); err != nil {
+		return err
+	}
+
+	var received model.Money
+	switch {
+	case in.ReceivedAmount != nil:
+		if received, err = model.MoneyFromDecimal(*in.ReceivedAmount, to.Currency); err != nil {
+			return err
+		}
+	case from.Currency == to.Currency:
+		if received, err = amount.Sub(t.FromFee); err != nil {
+			return err
+		}
+		if received, err = received.Sub(t.ToFee); err != nil {
+			return err
+		}
+	default:
+		return ErrReceivedAmountRequired
+	}
+
+	status := in.Status
+	if status == "" {
+		status = model.TransactionStatusUncleared
+	}
+	receivedOn := in.PostedOn
+	if in.ReceivedOn != nil {
+		receivedOn = *in.ReceivedOn
+	}
+	memo := strings.TrimSpace(in.Memo)
+
+	if t.From == nil {
+		t.From = &model.Transaction{WorkspaceID: t.WorkspaceID}
+	}
+	t.From.AccountID = from.ID
+	t.From.PostedOn = in.PostedOn
+	t.From.Amount = amount.Neg()
+	t.From.Payee = "Transfer to " + to.Name
+	t.From.Memo = memo
+	t.From.Status = status
+
+	if t.To == nil {
+		t.To = &model.Transaction{WorkspaceID: t.WorkspaceID}
+	}
+	t.To.AccountID = to.ID
+	t.To.PostedOn = receivedOn
+	t.To.Amount = received
+	t.To.Payee = "Transfer from " + from.Name
+	t.To.Memo = memo
+	t.To.Status = status
+	return nil
+}
+
+// LinkTransferInput names two existing transactions to link as a transfer
+type LinkTransferInput struct {
+	FromTransactionID int
+	ToTransactionID   int
+	FromFee           model.Decimal // In the currency of the outgoing transaction
+	ToFee             model.Decimal // In the currency of the incoming transaction
+}
+
+// MatchTransfersInput narrows the transactions considered when matching transfers
+type MatchTransfersInput struct {
+	From       *time.Time // Inclusive
+	To         *time.Time // Inclusive
+	WindowDays *int       // Maximum days between the two sides; defaults to 3
+}
+
+type ListTransfersUseCase struct {
+	transferRepo   *repositories.TransferRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewListTransfersUseCase(
+	transferRepo *repositories.TransferRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *ListTransfersUseCase {
+	return &ListTransfersUseCase{
+		transferRepo:   transferRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute returns the workspace's transfers sent within the optional date range, newest first
+func (uc *ListTransfersUseCase) Execute(ctx context.Context, userID int, workspaceID int, from, to *time.Time) ([]*model.Transfer, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsRead); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	if err := service.ValidateTransactionFilter(model.TransactionFilter{From: from, To: to}); err != nil {
+		return nil, err
+	}
+
+	transfers, err := uc.transferRepo.ListTransfers(ctx, workspaceID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transfers: %w", err)
+	}
+	return transfers, nil
+}
+
+type GetTransferUseCase struct {
+	transferRepo   *repositories.TransferRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewGetTransferUseCase(
+	transferRepo *repositories.TransferRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *GetTransferUseCase {
+	return &GetTransferUseCase{
+		transferRepo:   transferRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute returns a transfer of the workspace with both of its transactions
+func (uc *GetTransferUseCase) Execute(ctx context.Context, userID int, workspaceID int, transferID int) (*model.Transfer, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsRead); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	return getWorkspaceTransfer(ctx, uc.transferRepo, workspaceID, transferID)
+}
+
+type CreateTransferUseCase struct {
+	accountRepo    *repositories.AccountRepository
+	membershipRepo *repositories.MembershipRepository
+	client         *ent.Client
+}
+
+func NewCreateTransferUseCase(
+	accountRepo *repositories.AccountRepository,
+	membershipRepo *repositories.MembershipRepository,
+	client *ent.Client,
+) *CreateTransferUseCase {
+	return &CreateTransferUseCase{
+		accountRepo:    accountRepo,
+		membershipRepo: membershipRepo,
+		client:         client,
+	}
+}
+
+// Execute records both sides of a transfer between two accounts of the workspace and posts them
+func (uc *CreateTransferUseCase) Execute(ctx context.Context, userID int, workspaceID int, input TransferInput) (*model.Transfer, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	from, err := getWorkspaceAccount(ctx, uc.accountRepo, workspaceID, input.FromAccountID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := getWorkspaceAccount(ctx, uc.accountRepo, workspaceID, input.ToAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer := &model.Transfer{WorkspaceID: workspaceID}
+	if err := input.apply(transfer, from, to); err != nil {
+		return nil, err
+	}
+	if err := service.ValidateTransfer(transfer); err != nil {
+		return nil, err
+	}
+
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	transactionRepo := repositories.NewTransactionRepository(tx.Client())
+	if transfer.From, err = transactionRepo.CreateTransaction(ctx, transfer.From); err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to create outgoing transaction: %w", err))
+	}
+	if transfer.To, err = transactionRepo.CreateTransaction(ctx, transfer.To); err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to create incoming transaction: %w", err))
+	}
+	transfer, err = repositories.NewTransferRepository(tx.Client()).CreateTransfer(ctx, transfer)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to create transfer: %w", err))
+	}
+	if err := postTransfer(ctx, tx.Client(), transfer); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return transfer, nil
+}
+
+type UpdateTransferUseCase struct {
+	transferRepo   *repositories.TransferRepository
+	accountRepo    *repositories.AccountRepository
+	membershipRepo *repositories.MembershipRepository
+	client         *ent.Client
+}
+
+func NewUpdateTransferUseCase(
+	transferRepo *repositories.TransferRepository,
+	accountRepo *repositories.AccountRepository,
+	membershipRepo *repositories.MembershipRepository,
+	client *ent.Client,
+) *UpdateTransferUseCase {
+	return &UpdateTransferUseCase{
+		transferRepo:   transferRepo,
+		accountRepo:    accountRepo,
+		membershipRepo: membershipRepo,
+		client:         client,
+	}
+}
+
+// Execute replaces the editable fields of both sides of a transfer and reposts them
+func (uc *UpdateTransferUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	transferID int,
+	input TransferInput,
+) (*model.Transfer, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	transfer, err := getWorkspaceTransfer(ctx, uc.transferRepo, workspaceID, transferID)
+	if err != nil {
+		return nil, err
+	}
+	from, err := getWorkspaceAccount(ctx, uc.accountRepo, workspaceID, input.FromAccountID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := getWorkspaceAccount(ctx, uc.accountRepo, workspaceID, input.ToAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if err := input.apply(transfer, from, to); err != nil {
+		return nil, err
+	}
+	if err := service.ValidateTransfer(transfer); err != nil {
+		return nil, err
+	}
+
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	transactionRepo := repositories.NewTransactionRepository(tx.Client())
+	if transfer.From, err = transactionRepo.UpdateTransaction(ctx, transfer.From); err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to update outgoing transaction: %w", err))
+	}
+	if transfer.To, err = transactionRepo.UpdateTransaction(ctx, transfer.To); err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to update incoming transaction: %w", err))
+	}
+	transfer.From.TransferID = &transfer.ID
+	transfer.To.TransferID = &transfer.ID
+	if err := repositories.NewTransferRepository(tx.Client()).UpdateTransferFees(ctx, transfer); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, rollback(tx, ErrTransferNotFound)
+		}
+		return nil, rollback(tx, fmt.Errorf("failed to update transfer: %w", err))
+	}
+	if err := postTransfer(ctx, tx.Client(), transfer); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return transfer, nil
+}
+
+type LinkTransferUseCase struct {
+	transactionRepo *repositories.TransactionRepository
+	membershipRepo  *repositories.MembershipRepository
+	client          *ent.Client
+}
+
+func NewLinkTransferUseCase(
+	transactionRepo *repositories.TransactionRepository,
+	membershipRepo *repositories.MembershipRepository,
+	client *ent.Client,
+) *LinkTransferUseCase {
+	return &LinkTransferUseCase{
+		transactionRepo: transactionRepo,
+		membershipRepo:  membershipRepo,
+		client:          client,
+	}
+}
+
+// Execute links two existing transactions of the workspace as a transfer and reposts them,
+// so that they no longer count as an expense and an income
+func (uc *LinkTransferUseCase) Execute(ctx context.Context, userID int, workspaceID int, input LinkTransferInput) (*model.Transfer, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	from, err := uc.getLinkableTransaction(ctx, workspaceID, input.FromTransactionID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := uc.getLinkableTransaction(ctx, workspaceID, input.ToTransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer := &model.Transfer{WorkspaceID: workspaceID, From: from, To: to}
+	if transfer.FromFee, err = model.MoneyFromDecimal(input.FromFee, from.Amount.Currency()); err != nil {
+		return nil, err
+	}
+	if transfer.ToFee, err = model.MoneyFromDecimal(input.ToFee, to.Amount.Currency()); err != nil {
+		return nil, err
+	}
+	if err := service.ValidateTransfer(transfer); err != nil {
+		return nil, err
+	}
+
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	transfer, err = repositories.NewTransferRepository(tx.Client()).CreateTransfer(ctx, transfer)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, rollback(tx, ErrTransactionInTransfer)
+		}
+		return nil, rollback(tx, fmt.Errorf("failed to create transfer: %w", err))
+	}
+	if err := postTransfer(ctx, tx.Client(), transfer); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return transfer, nil
+}
+
+// getLinkableTransaction returns a transaction of the workspace that is not yet part of a transfer
+func (uc *LinkTransferUseCase) getLinkableTransaction(ctx context.Context, workspaceID, transactionID int) (*model.Transaction, error) {
+	transaction, err := uc.transactionRepo.GetTransaction(ctx, workspaceID, transactionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if transaction.IsTransfer() {
+		return nil, ErrTransactionInTransfer
+	}
+	return transaction, nil
+}
+
+type MatchTransfersUseCase struct {
+	transactionRepo *repositories.TransactionRepository
+	membershipRepo  *repositories.MembershipRepository
+	client          *ent.Client
+}
+
+func NewMatchTransfersUseCase(
+	transactionRepo *repositories.TransactionRepository,
+	membershipRepo *repositories.MembershipRepository,
+	client *ent.Client,
+) *MatchTransfersUseCase {
+	return &MatchTransfersUseCase{
+		transactionRepo: transactionRepo,
+		membershipRepo:  membershipRepo,
+		client:          client,
+	}
+}
+
+// Execute links the unambiguous pairs of unlinked transactions that look like transfers:
+// the same amount leaving one account and reaching another within the matching window.
+// Split transactions and transactions in income or expense categories are left alone.
+// It returns the transfers it created.
+func (uc *MatchTransfersUseCase) Execute(ctx context.Context, userID int, workspaceID int, input MatchTransfersInput) ([]*model.Transfer, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	if err := service.ValidateTransactionFilter(model.TransactionFilter{From: input.From, To: input.To}); err != nil {
+		return nil, err
+	}
+	windowDays := defaultTransferMatchWindowDays
+	if input.WindowDays != nil {
+		windowDays = *input.WindowDays
+	}
+	if windowDays < 0 || windowDays > maxTransferMatchWindowDays {
+		return nil, ErrInvalidTransferMatchWindow
+	}
+
+	candidates, err := uc.transactionRepo.ListTransferCandidates(ctx, workspaceID, input.From, input.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transactions: %w", err)
+	}
+	matches := service.MatchTransfers(candidates, time.Duration(windowDays)*24*time.Hour)
+	if len(matches) == 0 {
+		return []*model.Transfer{}, nil
+	}
+
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	transferRepo := repositories.NewTransferRepository(tx.Client())
+	transfers := make([]*model.Transfer, 0, len(matches))
+	for _, match := range matches {
+		transfer := &model.Transfer{WorkspaceID: workspaceID, From: match.From, To: match.To}
+		if transfer.FromFee, err = model.NewMoney(0, match.From.Amount.Currency()); err != nil {
+			return nil, rollback(tx, err)
+		}
+		if transfer.ToFee, err = model.NewMoney(0, match.To.Amount.Currency()); err != nil {
+			return nil, rollback(tx, err)
+		}
+		if err := service.ValidateTransfer(transfer); err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		if transfer, err = transferRepo.CreateTransfer(ctx, transfer); err != nil {
+			return nil, rollback(tx, fmt.Errorf("failed to create transfer: %w", err))
+		}
+		if err := postTransfer(ctx, tx.Client(), transfer); err != nil {
+			return nil, rollback(tx, err)
+		}
+		transfers = append(transfers, transfer)
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return transfers, nil
+}
+
+type UnlinkTransferUseCase struct {
+	transferRepo   *repositories.TransferRepository
+	membershipRepo *repositories.MembershipRepository
+	client         *ent.Client
+}
+
+func NewUnlinkTransferUseCase(
+	transferRepo *repositories.TransferRepository,
+	membershipRepo *repositories.MembershipRepository,
+	client *ent.Client,
+) *UnlinkTransferUseCase {
+	return &UnlinkTransferUseCase{
+		transferRepo:   transferRepo,
+		membershipRepo: membershipRepo,
+		client:         client,
+	}
+}
+
+// Execute removes the link between the two transactions of a transfer and reposts them as an
+// ordinary expense and income. It returns the two transactions, outgoing side first.
+func (uc *UnlinkTransferUseCase) Execute(ctx context.Context, userID int, workspaceID int, transferID int) ([]*model.Transaction, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return nil, err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	transfer, err := getWorkspaceTransfer(ctx, uc.transferRepo, workspaceID, transferID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	deleted, err := repositories.NewTransferRepository(tx.Client()).DeleteTransfer(ctx, workspaceID, transferID)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to delete transfer: %w", err))
+	}
+	if !deleted {
+		return nil, rollback(tx, ErrTransferNotFound)
+	}
+	transactions := []*model.Transaction{transfer.From, transfer.To}
+	for _, transaction := range transactions {
+		transaction.TransferID = nil
+		if err := postTransaction(ctx, tx.Client(), transaction); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return transactions, nil
+}
+
+type DeleteTransferUseCase struct {
+	transferRepo   *repositories.TransferRepository
+	membershipRepo *repositories.MembershipRepository
+	client         *ent.Client
+}
+
+func NewDeleteTransferUseCase(
+	transferRepo *repositories.TransferRepository,
+	membershipRepo *repositories.MembershipRepository,
+	client *ent.Client,
+) *DeleteTransferUseCase {
+	return &DeleteTransferUseCase{
+		transferRepo:   transferRepo,
+		membershipRepo: membershipRepo,
+		client:         client,
+	}
+}
+
+// Execute deletes a transfer together with both of its transactions and their journal entries
+func (uc *DeleteTransferUseCase) Execute(ctx context.Context, userID int, workspaceID int, transferID int) error {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionTransactionsWrite); err != nil {
+		return err
+	}
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	transfer, err := getWorkspaceTransfer(ctx, uc.transferRepo, workspaceID, transferID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	// Deleting either transaction deletes the transfer row with it
+	transactionRepo := repositories.NewTransactionRepository(tx.Client())
+	for _, transaction := range []*model.Transaction{transfer.From, transfer.To} {
+		if _, err := transactionRepo.DeleteTransaction(ctx, workspaceID, transaction.ID); err != nil {
+			return rollback(tx, fmt.Errorf("failed to delete transaction: %w", err))
+		}
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// postTransfer records the journal entries of both sides of a transfer, replacing the previous ones.
+// client must belong to the ent transaction that writes the transfer itself.
+func postTransfer(ctx context.Context, client *ent.Client, transfer *model.Transfer) error {
+	journalRepo := repositories.NewJournalRepository(client)
+	accountRepo := repositories.NewAccountRepository(client)
+	for _, side := range []struct {
+		transaction *model.Transaction
+		fee         model.Money
+	}{
+		{transfer.From, transfer.FromFee},
+		{transfer.To, transfer.ToFee},
+	} {
+		if err := journalRepo.DeleteTransactionJournalEntry(ctx, transfer.WorkspaceID, side.transaction.ID); err != nil {
+			return fmt.Errorf("failed to delete journal entry: %w", err)
+		}
+
+		accountTypes := []model.AccountType{model.AccountTypeTransfer}
+		if !side.fee.IsZero() {
+			accountTypes = append(accountTypes, model.AccountTypeExpense)
+		}
+		balancingAccountIDs, err := getBalancingAccountIDs(
+			ctx,
+			accountRepo,
+			transfer.WorkspaceID,
+			side.transaction.Amount.Currency(),
+			accountTypes,
+		)
+		if err != nil {
+			return err
+		}
+
+		entry, err := service.TransferJournalEntry(side.transaction, side.fee, balancingAccountIDs)
+		if err != nil {
+			return err
+		}
+		if err := service.ValidateJournalEntry(entry); err != nil {
+			return err
+		}
+		if _, err := journalRepo.CreateJournalEntry(ctx, entry); err != nil {
+			return fmt.Errorf("failed to create journal entry: %w", err)
+		}
+	}
+	return nil
+}
+
+// getWorkspaceTransfer returns ErrTransferNotFound unless the transfer belongs to the workspace
+func getWorkspaceTransfer(
+	ctx context.Context,
+	transferRepo *repositories.TransferRepository,
+	workspaceID int,
+	transferID int,
+) (*model.Transfer, error) {
+	transfer, err := transferRepo.GetTransfer(ctx, workspaceID, transferID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTransferNotFound
+		}
+		return nil, fmt.Errorf("failed to get transfer: %w", err)
+	}
+	return transfer, nil
+}
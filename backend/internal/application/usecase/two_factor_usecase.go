@@ -0,0 +1,341 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/recoverycode"
+	"backend/internal/infrastructure/repositories"
+)
+
+// totpIssuer is the account issuer shown in authenticator apps
+const totpIssuer = "FinSight"
+
+const (
+	// maxTwoFactorFailures is how many wrong second-factor codes in a row lock a user's second factor,
+	// whichever logins they were entered in
+	maxTwoFactorFailures = 10
+	// twoFactorLockout is how long second-factor codes are then refused
+	twoFactorLockout = 15 * time.Minute
+)
+
+var (
+	// ErrTwoFactorAlreadyEnabled is returned when enrolling a user who already has two-factor authentication
+	ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	// ErrTwoFactorNotSetUp is returned when confirming enrollment before a secret was generated
+	ErrTwoFactorNotSetUp = errors.New("two-factor authentication has not been set up")
+	// ErrTwoFactorNotEnabled is returned when an operation requires two-factor authentication to be enabled
+	ErrTwoFactorNotEnabled = errors.New("two-factor authentication is not enabled")
+	// ErrInvalidTwoFactorCode is returned when a TOTP or recovery code is wrong, expired or already used
+	ErrInvalidTwoFactorCode = errors.New("invalid two-factor code")
+	// ErrTwoFactorLocked is returned when too many wrong second-factor codes were entered recently
+	ErrTwoFactorLocked = errors.New("two-factor authentication is temporarily locked")
+)
+
+// twoFactorVerifier checks second-factor codes shared by login and account settings
+type twoFactorVerifier struct {
+	userRepo         *repositories.UserRepository
+	recoveryCodeRepo *repositories.RecoveryCodeRepository
+}
+
+// verify accepts either a current TOTP code or an unused recovery code; both are single-use.
+// Wrong codes are counted per user, and after maxTwoFactorFailures of them in a row every code is
+// refused with ErrTwoFactorLocked for twoFactorLockout. A correct code resets the count.
+func (v *twoFactorVerifier) verify(ctx context.Context, user *model.User, code string) error {
+	now := time.Now()
+	if user.IsTwoFactorLocked(now) {
+		return ErrTwoFactorLocked
+	}
+
+	err := v.check(ctx, user, code, now)
+	if errors.Is(err, ErrInvalidTwoFactorCode) {
+		locked, recordErr := v.userRepo.RecordFailedTwoFactorAttempt(ctx, user.ID, maxTwoFactorFailures, now.Add(twoFactorLockout))
+		if recordErr != nil {
+			return fmt.Errorf("failed to record failed two-factor attempt: %w", recordErr)
+		}
+		if locked {
+			return ErrTwoFactorLocked
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if user.TwoFactorFailedAttempts > 0 || user.TwoFactorLockedUntil != nil {
+		if err := v.userRepo.ResetTwoFactorAttempts(ctx, user.ID); err != nil {
+			return fmt.Errorf("failed to reset failed two-factor attempts: %w", err)
+		}
+	}
+	return nil
+}
+
+// check accepts either a TOTP code valid at the given time or an unused recovery code, consuming it
+func (v *twoFactorVerifier) check(ctx context.Context, user *model.User, code string, now time.Time) error {
+	if step, ok := service.ValidateTOTP(user.TOTPSecret, code, now); ok {
+		claimed, err := v.userRepo.ClaimTOTPStep(ctx, user.ID, step)
+		if err != nil {
+			return fmt.Errorf("failed to record TOTP step: %w", err)
+		}
+		if !claimed {
+			return ErrInvalidTwoFactorCode
+		}
+		return nil
+	}
+
+	codeHash := service.HashToken(service.NormalizeRecoveryCode(code))
+	consumed, err := v.recoveryCodeRepo.ConsumeRecoveryCode(ctx, user.ID, codeHash)
+	if err != nil {
+		return fmt.Errorf("failed to consume recovery code: %w", err)
+	}
+	if !consumed {
+		return ErrInvalidTwoFactorCode
+	}
+	return nil
+}
+
+// TwoFactorSetup is what the user needs to add FinSight to an authenticator app
+type TwoFactorSetup struct {
+	Secret string
+	URI    string
+}
+
+type SetupTwoFactorUseCase struct {
+	userRepo *repositories.UserRepository
+}
+
+func NewSetupTwoFactorUseCase(userRepo *repositories.UserRepository) *SetupTwoFactorUseCase {
+	return &SetupTwoFactorUseCase{userRepo: userRepo}
+}
+
+// Execute starts enrollment by generating a new secret; it takes effect once confirmed with EnableTwoFactorUseCase
+func (uc *SetupTwoFactorUseCase) Execute(ctx context.Context, userID int) (*TwoFactorSetup, error) {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.IsTwoFactorEnabled() {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := service.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.userRepo.SetPendingTOTPSecret(ctx, user.ID, secret); err != nil {
+		return nil, fmt.Errorf("failed to store TOTP secret: %w", err)
+	}
+
+	return &TwoFactorSetup{
+		Secret: secret,
+		URI:    service.TOTPURI(totpIssuer, user.Email, secret),
+	}, nil
+}
+
+type EnableTwoFactorUseCase struct {
+	userRepo *repositories.UserRepository
+	client   *ent.Client
+}
+
+func NewEnableTwoFactorUseCase(userRepo *repositories.UserRepository, client *ent.Client) *EnableTwoFactorUseCase {
+	return &EnableTwoFactorUseCase{
+		userRepo: userRepo,
+		client:   client,
+	}
+}
+
+// Execute confirms enrollment with a code from the authenticator app and returns the recovery codes.
+// The plain recovery codes are only available here; just their hashes are stored.
+func (uc *EnableTwoFactorUseCase) Execute(ctx context.Context, userID int, code string) ([]string, error) {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.IsTwoFactorEnabled() {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+	if user.TOTPSecret == "" {
+		return nil, ErrTwoFactorNotSetUp
+	}
+
+	step, ok := service.ValidateTOTP(user.TOTPSecret, code, time.Now())
+	if !ok {
+		return nil, ErrInvalidTwoFactorCode
+	}
+
+	recoveryCodes, err := service.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	// Enable two-factor authentication
+	err = tx.User.
+		UpdateOneID(user.ID).
+		SetTwoFactorEnabledAt(time.Now()).
+		SetTotpLastUsedStep(step).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to enable two-factor authentication: %w", err))
+	}
+
+	// Replace recovery codes
+	if _, err := tx.RecoveryCode.Delete().Where(recoverycode.UserID(user.ID)).Exec(ctx); err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to delete recovery codes: %w", err))
+	}
+	builders := make([]*ent.RecoveryCodeCreate, 0, len(recoveryCodes))
+	for _, rc := range recoveryCodes {
+		builders = append(builders, tx.RecoveryCode.
+			Create().
+			SetUserID(user.ID).
+			SetCodeHash(service.HashToken(service.NormalizeRecoveryCode(rc))))
+	}
+	if err := tx.RecoveryCode.CreateBulk(builders...).Exec(ctx); err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to create recovery codes: %w", err))
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return recoveryCodes, nil
+}
+
+type DisableTwoFactorUseCase struct {
+	verifier *twoFactorVerifier
+	userRepo *repositories.UserRepository
+	client   *ent.Client
+}
+
+func NewDisableTwoFactorUseCase(
+	userRepo *repositories.UserRepository,
+	recoveryCodeRepo *repositories.RecoveryCodeRepository,
+	client *ent.Client,
+) *DisableTwoFactorUseCase {
+	return &DisableTwoFactorUseCase{
+		verifier: &twoFactorVerifier{userRepo: userRepo, recoveryCodeRepo: recoveryCodeRepo},
+		userRepo: userRepo,
+		client:   client,
+	}
+}
+
+// Execute turns two-factor authentication off after re-checking the password and a second-factor code
+func (uc *DisableTwoFactorUseCase) Execute(ctx context.Context, userID int, password string, code string) error {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if !user.IsTwoFactorEnabled() {
+		return ErrTwoFactorNotEnabled
+	}
+	if err := service.ComparePassword(user.PasswordHash, password); err != nil {
+		return ErrInvalidCredentials
+	}
+	if err := uc.verifier.verify(ctx, user, code); err != nil {
+		return err
+	}
+
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	err = tx.User.
+		UpdateOneID(user.ID).
+		ClearTotpSecret().
+		ClearTotpLastUsedStep().
+		ClearTwoFactorEnabledAt().
+		SetTwoFactorFailedAttempts(0).
+		ClearTwoFactorLockedUntil().
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to disable two-factor authentication: %w", err))
+	}
+	if _, err := tx.RecoveryCode.Delete().Where(recoverycode.UserID(user.ID)).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete recovery codes: %w", err))
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+type VerifyTwoFactorLoginUseCase struct {
+	verifier      *twoFactorVerifier
+	userRepo      *repositories.UserRepository
+	workspaceRepo *repositories.WorkspaceRepository
+}
+
+func NewVerifyTwoFactorLoginUseCase(
+	userRepo *repositories.UserRepository,
+	workspaceRepo *repositories.WorkspaceRepository,
+	recoveryCodeRepo *repositories.RecoveryCodeRepository,
+) *VerifyTwoFactorLoginUseCase {
+	return &VerifyTwoFactorLoginUseCase{
+		verifier:      &twoFactorVerifier{userRepo: userRepo, recoveryCodeRepo: recoveryCodeRepo},
+		userRepo:      userRepo,
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+// Execute completes a login that passed the password step by checking the second factor. The session
+// opens in the workspace chosen at the password step, or in another one if the user has lost access
+// to it in the meantime; the workspace is nil when the user belongs to none.
+func (uc *VerifyTwoFactorLoginUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	code string,
+) (*model.User, *model.Workspace, error) {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if !user.IsTwoFactorEnabled() {
+		return nil, nil, ErrTwoFactorNotEnabled
+	}
+	if err := uc.verifier.verify(ctx, user, code); err != nil {
+		return nil, nil, err
+	}
+
+	workspace, err := uc.workspaceRepo.GetWorkspaceForUser(ctx, workspaceID, user.ID)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("failed to get workspace: %w", err)
+		}
+		if workspace, err = loginWorkspace(ctx, uc.workspaceRepo, user.ID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return user, workspace, nil
+}
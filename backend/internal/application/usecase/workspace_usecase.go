@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/membership"
+	"backend/internal/infrastructure/repositories"
+)
+
+var (
+	// ErrWorkspaceNotFound is returned when a workspace does not exist or the user is not a member of it
+	ErrWorkspaceNotFound = errors.New("workspace not found")
+	// ErrWorkspaceNameRequired is returned when creating a workspace without a name
+	ErrWorkspaceNameRequired = errors.New("workspace name is required")
+)
+
+type ListWorkspacesUseCase struct {
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewListWorkspacesUseCase(membershipRepo *repositories.MembershipRepository) *ListWorkspacesUseCase {
+	return &ListWorkspacesUseCase{membershipRepo: membershipRepo}
+}
+
+// Execute returns the user's memberships with their workspaces loaded
+func (uc *ListWorkspacesUseCase) Execute(ctx context.Context, userID int) ([]*model.Membership, error) {
+	memberships, err := uc.membershipRepo.ListMembershipsByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list memberships: %w", err)
+	}
+	return memberships, nil
+}
+
+type ListMembersUseCase struct {
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewListMembersUseCase(membershipRepo *repositories.MembershipRepository) *ListMembersUseCase {
+	return &ListMembersUseCase{membershipRepo: membershipRepo}
+}
+
+// Execute returns the memberships of a workspace with their users loaded; owners and admins only, as the
+// listing shows who has not enabled two-factor authentication
+func (uc *ListMembersUseCase) Execute(ctx context.Context, userID int, workspaceID int) ([]*model.Membership, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionMembersManage); err != nil {
+		return nil, err
+	}
+
+	memberships, err := uc.membershipRepo.ListMembershipsByWorkspaceID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members: %w", err)
+	}
+	return memberships, nil
+}
+
+type CreateWorkspaceUseCase struct {
+	client *ent.Client
+}
+
+func NewCreateWorkspaceUseCase(client *ent.Client) *CreateWorkspaceUseCase {
+	return &CreateWorkspaceUseCase{client: client}
+}
+
+// Execute creates a workspace owned by the user
+func (uc *CreateWorkspaceUseCase) Execute(ctx context.Context, userID int, name string) (*model.Workspace, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrWorkspaceNameRequired
+	}
+
+	tx, err := uc.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Rollback helper
+	rollback := func(tx *ent.Tx, err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	// Create workspace
+	entWorkspace, err := tx.Workspace.
+		Create().
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to create workspace: %w", err))
+	}
+
+	// Make the user the owner of the workspace
+	err = tx.Membership.
+		Create().
+		SetWorkspaceID(entWorkspace.ID).
+		SetUserID(userID).
+		SetRole(membership.RoleOwner).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to create membership: %w", err))
+	}
+
+	// Give the workspace a default category tree
+	if err := seedDefaultCategories(ctx, tx.Client(), entWorkspace.ID); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &model.Workspace{
+		ID:                   entWorkspace.ID,
+		Name:                 entWorkspace.Name,
+		RequireVerifiedEmail: entWorkspace.RequireVerifiedEmail,
+		CreatedAt:            entWorkspace.CreatedAt,
+		UpdatedAt:            entWorkspace.UpdatedAt,
+	}, nil
+}
+
+type SwitchWorkspaceUseCase struct {
+	workspaceRepo  *repositories.WorkspaceRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewSwitchWorkspaceUseCase(
+	workspaceRepo *repositories.WorkspaceRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *SwitchWorkspaceUseCase {
+	return &SwitchWorkspaceUseCase{
+		workspaceRepo:  workspaceRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute checks that the user may open the workspace and returns it with the user's membership
+func (uc *SwitchWorkspaceUseCase) Execute(ctx context.Context, userID int, workspaceID int) (*model.Workspace, *model.Membership, error) {
+	membership, err := uc.membershipRepo.GetMembership(ctx, workspaceID, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, ErrWorkspaceNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get membership: %w", err)
+	}
+
+	workspace, err := uc.workspaceRepo.GetWorkspaceByID(ctx, workspaceID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get workspace: %w", err)
+	}
+	return workspace, membership, nil
+}
+
+type UpdateWorkspaceSettingsUseCase struct {
+	workspaceRepo  *repositories.WorkspaceRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewUpdateWorkspaceSettingsUseCase(
+	workspaceRepo *repositories.WorkspaceRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *UpdateWorkspaceSettingsUseCase {
+	return &UpdateWorkspaceSettingsUseCase{
+		workspaceRepo:  workspaceRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute updates the settings of a workspace; owners and admins only
+func (uc *UpdateWorkspaceSettingsUseCase) Execute(
+	ctx context.Context,
+	userID int,
+	workspaceID int,
+	requireVerifiedEmail bool,
+) (*model.Workspace, error) {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionWorkspaceManage); err != nil {
+		return nil, err
+	}
+
+	workspace, err := uc.workspaceRepo.UpdateRequireVerifiedEmail(ctx, workspaceID, requireVerifiedEmail)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update workspace: %w", err)
+	}
+	return workspace, nil
+}
+
+type DeleteWorkspaceUseCase struct {
+	workspaceRepo  *repositories.WorkspaceRepository
+	membershipRepo *repositories.MembershipRepository
+}
+
+func NewDeleteWorkspaceUseCase(
+	workspaceRepo *repositories.WorkspaceRepository,
+	membershipRepo *repositories.MembershipRepository,
+) *DeleteWorkspaceUseCase {
+	return &DeleteWorkspaceUseCase{
+		workspaceRepo:  workspaceRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+// Execute deletes a workspace and all of its memberships; owners only
+func (uc *DeleteWorkspaceUseCase) Execute(ctx context.Context, userID int, workspaceID int) error {
+	if _, err := authorize(ctx, uc.membershipRepo, workspaceID, userID, service.PermissionWorkspaceDelete); err != nil {
+		return err
+	}
+
+	if err := uc.workspaceRepo.DeleteWorkspace(ctx, workspaceID); err != nil {
+		return fmt.Errorf("failed to delete workspace: %w", err)
+	}
+	return nil
+}
@@ -6,9 +6,12 @@ import (
 )
 
 type Config struct {
-	Database DatabaseConfig
-	Session  SessionConfig
-	Server   ServerConfig
+	Database  DatabaseConfig
+	Session   SessionConfig
+	Server    ServerConfig
+	Mail      MailConfig
+	Client    ClientConfig
+	Scheduler SchedulerConfig
 }
 
 type DatabaseConfig struct {
@@ -27,6 +30,25 @@ type ServerConfig struct {
 	Port string
 }
 
+type MailConfig struct {
+	Driver       string // "smtp" or "outbox"
+	From         string
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	OutboxDir    string
+}
+
+type ClientConfig struct {
+	BaseURL string // Used to build links in emails
+}
+
+type SchedulerConfig struct {
+	RecurrenceInterval    string // How often recurring transactions are posted, as a Go duration; "0" disables the scheduler
+	RecurrenceHorizonDays string // How many days ahead recurrence occurrences are scheduled
+}
+
 var AppConfig Config
 
 func init() {
@@ -44,6 +66,22 @@ func init() {
 		Server: ServerConfig{
 			Port: getEnvOrPanic("PORT"),
 		},
+		Mail: MailConfig{
+			Driver:       getEnvOrDefault("MAIL_DRIVER", "outbox"),
+			From:         getEnvOrDefault("MAIL_FROM", "FinSight <no-reply@finsight.local>"),
+			SMTPHost:     os.Getenv("SMTP_HOST"),
+			SMTPPort:     getEnvOrDefault("SMTP_PORT", "587"),
+			SMTPUsername: os.Getenv("SMTP_USERNAME"),
+			SMTPPassword: os.Getenv("SMTP_PASSWORD"),
+			OutboxDir:    getEnvOrDefault("MAIL_OUTBOX_DIR", "tmp/outbox"),
+		},
+		Client: ClientConfig{
+			BaseURL: getEnvOrDefault("CLIENT_BASE_URL", "http://localhost:3000"),
+		},
+		Scheduler: SchedulerConfig{
+			RecurrenceInterval:    getEnvOrDefault("RECURRENCE_SCHEDULER_INTERVAL", "1h"),
+			RecurrenceHorizonDays: getEnvOrDefault("RECURRENCE_HORIZON_DAYS", "30"),
+		},
 	}
 }
 
@@ -53,4 +91,11 @@ func getEnvOrPanic(key string) string {
 		panic(fmt.Sprintf("%s environment variable is required", key))
 	}
 	return value
-}
\ No newline at end of file
+}
+
+func getEnvOrDefault(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,56 @@
+package model
+
+import "time"
+
+// AccountType is the kind of a financial account
+type AccountType string
+
+const (
+	AccountTypeBank       AccountType = "bank"
+	AccountTypeCreditCard AccountType = "credit_card"
+	AccountTypeCash       AccountType = "cash"
+	AccountTypeLoan       AccountType = "loan"
+	AccountTypeInvestment AccountType = "investment"
+
+	// Income and expense accounts are maintained by the journal, one per workspace and currency,
+	// to balance the money coming into and leaving the other accounts. The transfer account holds
+	// money in transit between two accounts of the workspace and nets to zero once both sides are posted.
+	AccountTypeIncome   AccountType = "income"
+	AccountTypeExpense  AccountType = "expense"
+	AccountTypeTransfer AccountType = "transfer"
+)
+
+// IsValid reports whether t is one of the account types users can create
+func (t AccountType) IsValid() bool {
+	switch t {
+	case AccountTypeBank, AccountTypeCreditCard, AccountTypeCash, AccountTypeLoan, AccountTypeInvestment:
+		return true
+	}
+	return false
+}
+
+// IsNominal reports whether t is an income, expense or transfer account maintained by the journal
+func (t AccountType) IsNominal() bool {
+	return t == AccountTypeIncome || t == AccountTypeExpense || t == AccountTypeTransfer
+}
+
+type Account struct {
+	ID             int
+	WorkspaceID    int
+	Name           string
+	Type           AccountType
+	Institution    string
+	Currency       string // ISO 4217 code
+	OpeningBalance Money  // In Currency
+	Balance        Money  // OpeningBalance plus all postings on the account
+	OpenedOn       *time.Time
+	ClosedOn       *time.Time
+	Archived       bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// IsClosed reports whether the account has a closing date
+func (a *Account) IsClosed() bool {
+	return a.ClosedOn != nil
+}
@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// CategoryKind tells whether a category classifies income, expenses or transfers
+type CategoryKind string
+
+const (
+	CategoryKindIncome   CategoryKind = "income"
+	CategoryKindExpense  CategoryKind = "expense"
+	CategoryKindTransfer CategoryKind = "transfer"
+)
+
+// IsValid reports whether k is one of the known category kinds
+func (k CategoryKind) IsValid() bool {
+	switch k {
+	case CategoryKindIncome, CategoryKindExpense, CategoryKindTransfer:
+		return true
+	}
+	return false
+}
+
+// Category is a node of a workspace's category tree, e.g. Groceries under Food
+type Category struct {
+	ID          int
+	WorkspaceID int
+	ParentID    *int // Nil for top-level categories
+	Name        string
+	Kind        CategoryKind
+	Color       string // #RRGGBB, or empty
+	Icon        string
+	Archived    bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,218 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ErrInvalidDecimal is returned when parsing a string that is not a plain decimal number
+var ErrInvalidDecimal = errors.New("invalid decimal number")
+
+// Decimal is an exact base-10 number of arbitrary precision.
+// It is how amounts cross the boundaries of the domain: Postgres numeric columns and JSON strings.
+// The zero value is 0. Decimals are immutable, so copies are safe to share.
+type Decimal struct {
+	unscaled *big.Int // nil means zero
+	scale    int32    // Digits after the decimal point
+}
+
+// NewDecimal returns unscaled * 10^-scale
+func NewDecimal(unscaled int64, scale int32) Decimal {
+	return Decimal{unscaled: big.NewInt(unscaled), scale: scale}
+}
+
+// ParseDecimal parses strings like "12", "-0.50" or "+1234.5678"; exponents and separators are rejected
+func ParseDecimal(s string) (Decimal, error) {
+	digits := s
+	if strings.HasPrefix(digits, "-") || strings.HasPrefix(digits, "+") {
+		digits = digits[1:]
+	}
+	intPart, fracPart, hasPoint := strings.Cut(digits, ".")
+	if intPart == "" && fracPart == "" || hasPoint && fracPart == "" {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+	}
+	for _, r := range intPart + fracPart {
+		if r < '0' || r > '9' {
+			return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+		}
+	}
+
+	unscaled, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+	}
+	if strings.HasPrefix(s, "-") {
+		unscaled.Neg(unscaled)
+	}
+	return Decimal{unscaled: unscaled, scale: int32(len(fracPart))}, nil
+}
+
+// Scale returns the number of digits after the decimal point
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d
+func (d Decimal) Sign() int {
+	if d.unscaled == nil {
+		return 0
+	}
+	return d.unscaled.Sign()
+}
+
+// Cmp compares d and other, returning -1, 0 or +1
+func (d Decimal) Cmp(other Decimal) int {
+	scale := max(d.scale, other.scale)
+	a, _ := d.rescale(scale)
+	b, _ := other.rescale(scale)
+	return a.Cmp(b)
+}
+
+// Add returns d + other, at the larger of the two scales
+func (d Decimal) Add(other Decimal) Decimal {
+	scale := max(d.scale, other.scale)
+	a, _ := d.rescale(scale)
+	b, _ := other.rescale(scale)
+	return Decimal{unscaled: a.Add(a, b), scale: scale}
+}
+
+// Neg returns -d
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.bigInt()), scale: d.scale}
+}
+
+// Normalize returns d without trailing zeros after the decimal point, so that equal numbers such as 12.5 and
+// 12.50 are written the same way
+func (d Decimal) Normalize() Decimal {
+	unscaled, scale := new(big.Int).Set(d.bigInt()), d.scale
+	ten, remainder := big.NewInt(10), new(big.Int)
+	for scale > 0 {
+		quotient, _ := new(big.Int).QuoRem(unscaled, ten, remainder)
+		if remainder.Sign() != 0 {
+			break
+		}
+		unscaled, scale = quotient, scale-1
+	}
+	return Decimal{unscaled: unscaled, scale: scale}
+}
+
+// String formats d with exactly Scale digits after the decimal point
+func (d Decimal) String() string {
+	unscaled := d.bigInt()
+	digits := new(big.Int).Abs(unscaled).String()
+
+	sign := ""
+	if unscaled.Sign() < 0 {
+		sign = "-"
+	}
+	if d.scale <= 0 {
+		return sign + digits
+	}
+
+	scale := int(d.scale)
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+}
+
+// bigInt returns the unscaled value, never nil
+func (d Decimal) bigInt() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// rescale returns the unscaled value of d at another scale and whether the conversion was exact.
+// Digits beyond the target scale are truncated toward zero.
+func (d Decimal) rescale(scale int32) (*big.Int, bool) {
+	unscaled := new(big.Int).Set(d.bigInt())
+	if scale >= d.scale {
+		return unscaled.Mul(unscaled, pow10(scale-d.scale)), true
+	}
+	quotient, remainder := new(big.Int).QuoRem(unscaled, pow10(d.scale-scale), new(big.Int))
+	return quotient, remainder.Sign() == 0
+}
+
+// roundHalfEven returns the unscaled value of d at another scale, rounding half to even
+func (d Decimal) roundHalfEven(scale int32) *big.Int {
+	if scale >= d.scale {
+		unscaled, _ := d.rescale(scale)
+		return unscaled
+	}
+
+	divisor := pow10(d.scale - scale)
+	quotient, remainder := new(big.Int).QuoRem(d.bigInt(), divisor, new(big.Int))
+	// Compare twice the remainder with the divisor to decide the rounding direction
+	twice := new(big.Int).Abs(remainder)
+	twice.Lsh(twice, 1)
+	switch c := twice.Cmp(divisor); {
+	case c > 0, c == 0 && quotient.Bit(0) == 1:
+		if d.bigInt().Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return quotient
+}
+
+// pow10 returns 10^n
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// MarshalJSON encodes d as a JSON string so that no precision is lost in clients
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON accepts a JSON string or a plain JSON number
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+	parsed, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Value implements driver.Valuer; numeric columns receive the exact decimal text
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan implements sql.Scanner for numeric columns. Floats are rejected so that they never reach balances.
+func (d *Decimal) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return d.scanString(string(v))
+	case string:
+		return d.scanString(v)
+	case int64:
+		*d = NewDecimal(v, 0)
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into Decimal", src)
+	}
+}
+
+func (d *Decimal) scanString(s string) error {
+	parsed, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
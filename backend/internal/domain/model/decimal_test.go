@@ -0,0 +1,201 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func mustParseDecimal(t *testing.T, s string) Decimal {
+	t.Helper()
+	d, err := ParseDecimal(s)
+	if err != nil {
+		t.Fatalf("ParseDecimal(%q): %v", s, err)
+	}
+	return d
+}
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "12", want: "12"},
+		{in: "-0.50", want: "-0.50"},
+		{in: "+1234.5678", want: "1234.5678"},
+		{in: ".5", want: "0.5"},
+		{in: "0", want: "0"},
+		{in: "", wantErr: true},
+		{in: "-", wantErr: true},
+		{in: "1.", wantErr: true},
+		{in: "1e3", wantErr: true},
+		{in: "1,000", wantErr: true},
+		{in: "--1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseDecimal(tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidDecimal) {
+					t.Fatalf("ParseDecimal(%q) error = %v, want ErrInvalidDecimal", tt.in, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDecimal(%q): %v", tt.in, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseDecimal(%q) = %s, want %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalCmpAndAdd(t *testing.T) {
+	tests := []struct {
+		a, b    string
+		wantCmp int
+		wantSum string
+	}{
+		{a: "12.5", b: "12.50", wantCmp: 0, wantSum: "25.00"},
+		{a: "-1.01", b: "1", wantCmp: -1, wantSum: "-0.01"},
+		{a: "0.001", b: "0", wantCmp: 1, wantSum: "0.001"},
+		{a: "-3", b: "-2.9", wantCmp: -1, wantSum: "-5.9"},
+	}
+	for _, tt := range tests {
+		a, b := mustParseDecimal(t, tt.a), mustParseDecimal(t, tt.b)
+		if got := a.Cmp(b); got != tt.wantCmp {
+			t.Errorf("%s.Cmp(%s) = %d, want %d", tt.a, tt.b, got, tt.wantCmp)
+		}
+		if got := a.Add(b).String(); got != tt.wantSum {
+			t.Errorf("%s.Add(%s) = %s, want %s", tt.a, tt.b, got, tt.wantSum)
+		}
+	}
+}
+
+func TestDecimalNormalize(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{in: "12.50", want: "12.5"},
+		{in: "-12.500", want: "-12.5"},
+		{in: "100.00", want: "100"},
+		{in: "100", want: "100"},
+		{in: "0.000", want: "0"},
+		{in: "-0.0010", want: "-0.001"},
+	}
+	for _, tt := range tests {
+		if got := mustParseDecimal(t, tt.in).Normalize().String(); got != tt.want {
+			t.Errorf("%s.Normalize() = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+	if got := (Decimal{}).Normalize().String(); got != "0" {
+		t.Errorf("zero value normalized = %s, want 0", got)
+	}
+}
+
+func TestDecimalRoundHalfEven(t *testing.T) {
+	tests := []struct {
+		in    string
+		scale int32
+		want  int64
+	}{
+		{in: "0.125", scale: 2, want: 12},  // Tie rounds to the even neighbour
+		{in: "0.135", scale: 2, want: 14},  // Tie rounds to the even neighbour
+		{in: "0.1251", scale: 2, want: 13}, // Above the tie
+		{in: "0.1249", scale: 2, want: 12}, // Below the tie
+		{in: "-0.125", scale: 2, want: -12},
+		{in: "-0.135", scale: 2, want: -14},
+		{in: "-0.1251", scale: 2, want: -13},
+		{in: "2.5", scale: 0, want: 2},
+		{in: "3.5", scale: 0, want: 4},
+		{in: "-2.5", scale: 0, want: -2},
+		{in: "1.5", scale: 3, want: 1500}, // Widening is exact
+	}
+	for _, tt := range tests {
+		if got := mustParseDecimal(t, tt.in).roundHalfEven(tt.scale); got.Int64() != tt.want {
+			t.Errorf("roundHalfEven(%s, %d) = %s, want %d", tt.in, tt.scale, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	d := mustParseDecimal(t, "-12.50")
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `"-12.50"` {
+		t.Errorf("Marshal = %s, want \"-12.50\"", data)
+	}
+
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: `"-12.50"`, want: "-12.50"},
+		{in: `12.5`, want: "12.5"},
+		{in: `"abc"`, wantErr: true},
+		{in: `1e2`, wantErr: true},
+	}
+	for _, tt := range tests {
+		var got Decimal
+		err := json.Unmarshal([]byte(tt.in), &got)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("Unmarshal(%s) = %s, want an error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unmarshal(%s): %v", tt.in, err)
+		}
+		if got.String() != tt.want {
+			t.Errorf("Unmarshal(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalScanAndValue(t *testing.T) {
+	value, err := mustParseDecimal(t, "-0.0100").Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != "-0.0100" {
+		t.Errorf("Value = %v, want -0.0100", value)
+	}
+
+	tests := []struct {
+		name    string
+		src     any
+		want    string
+		wantErr bool
+	}{
+		{name: "bytes", src: []byte("1234.5600"), want: "1234.5600"},
+		{name: "string", src: "-7.5", want: "-7.5"},
+		{name: "int64", src: int64(-42), want: "-42"},
+		{name: "float64", src: float64(-12.5), wantErr: true},
+		{name: "nil", src: nil, wantErr: true},
+		{name: "malformed", src: "12,5", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Decimal
+			err := got.Scan(tt.src)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Scan(%v) = %s, want an error", tt.src, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Scan(%v): %v", tt.src, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("Scan(%v) = %s, want %s", tt.src, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// JournalEntry is a group of postings recorded together in the double-entry journal.
+// The postings of an entry always sum to zero in each currency.
+type JournalEntry struct {
+	ID            int
+	WorkspaceID   int
+	TransactionID *int // Transaction the entry was recorded for, if any
+	PostedOn      time.Time
+	Memo          string
+	Postings      []*Posting
+	CreatedAt     time.Time
+}
+
+// Posting moves an amount into or out of an account.
+// Positive amounts are debits and negative amounts are credits, so an account balance is the
+// sum of its postings and money leaving an account shows up as a negative posting.
+type Posting struct {
+	ID             int
+	JournalEntryID int
+	AccountID      int
+	Amount         Money // In the account currency
+}
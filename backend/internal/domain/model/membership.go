@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// Role is a member's role within a workspace
+type Role string
+
+const (
+	RoleOwner  Role = "owner"
+	RoleAdmin  Role = "admin"
+	RoleEditor Role = "editor"
+	RoleViewer Role = "viewer"
+)
+
+// IsValid reports whether r is one of the known roles
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleOwner, RoleAdmin, RoleEditor, RoleViewer:
+		return true
+	}
+	return false
+}
+
+type Membership struct {
+	ID          int
+	WorkspaceID int
+	UserID      int
+	Role        Role
+	JoinedAt    time.Time
+	InvitedByID *int
+	Workspace   *Workspace // Only set when loaded together with the membership
+	User        *User      // Only set when loaded together with the membership
+}
@@ -0,0 +1,253 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+var (
+	// ErrUnknownCurrency is returned for codes that are not active ISO 4217 currencies
+	ErrUnknownCurrency = errors.New("unknown currency")
+	// ErrCurrencyMismatch is returned when combining amounts in different currencies
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+	// ErrAmountPrecision is returned when an amount has more decimals than its currency allows
+	ErrAmountPrecision = errors.New("amount has more decimal places than the currency allows")
+	// ErrAmountOverflow is returned when an amount does not fit in the supported range
+	ErrAmountOverflow = errors.New("amount out of range")
+	// ErrInvalidAllocation is returned for allocation ratios that are negative or sum to zero
+	ErrInvalidAllocation = errors.New("invalid allocation ratios")
+)
+
+// Money is an exact amount in a currency, stored as an integer number of minor units (e.g. cents).
+// The zero value has no currency and is only useful as a placeholder.
+type Money struct {
+	minor    int64
+	currency Currency
+}
+
+// NewMoney returns minor units of the currency, e.g. NewMoney(1250, "USD") is 12.50 USD
+func NewMoney(minor int64, currencyCode string) (Money, error) {
+	currency, ok := LookupCurrency(currencyCode)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %q", ErrUnknownCurrency, currencyCode)
+	}
+	return Money{minor: minor, currency: currency}, nil
+}
+
+// MoneyFromDecimal converts an amount in major units, failing if it has more decimals than the currency allows
+func MoneyFromDecimal(amount Decimal, currencyCode string) (Money, error) {
+	currency, ok := LookupCurrency(currencyCode)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %q", ErrUnknownCurrency, currencyCode)
+	}
+	minor, exact := amount.rescale(int32(currency.MinorUnits))
+	if !exact {
+		return Money{}, fmt.Errorf("%w: %s %s", ErrAmountPrecision, amount, currencyCode)
+	}
+	return moneyFromBig(minor, currency)
+}
+
+// RoundMoney converts an amount in major units, rounding half to even to the currency's minor units
+func RoundMoney(amount Decimal, currencyCode string) (Money, error) {
+	currency, ok := LookupCurrency(currencyCode)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %q", ErrUnknownCurrency, currencyCode)
+	}
+	return moneyFromBig(amount.roundHalfEven(int32(currency.MinorUnits)), currency)
+}
+
+// ParseMoney parses an amount in major units such as "-12.50"
+func ParseMoney(amount string, currencyCode string) (Money, error) {
+	d, err := ParseDecimal(amount)
+	if err != nil {
+		return Money{}, err
+	}
+	return MoneyFromDecimal(d, currencyCode)
+}
+
+func moneyFromBig(minor *big.Int, currency Currency) (Money, error) {
+	if !minor.IsInt64() {
+		return Money{}, ErrAmountOverflow
+	}
+	return Money{minor: minor.Int64(), currency: currency}, nil
+}
+
+// MinorUnits returns the amount as an integer number of minor units
+func (m Money) MinorUnits() int64 {
+	return m.minor
+}
+
+// Currency returns the ISO 4217 code of the amount
+func (m Money) Currency() string {
+	return m.currency.Code
+}
+
+// Decimal returns the amount in major units, with as many decimals as the currency has minor units
+func (m Money) Decimal() Decimal {
+	return NewDecimal(m.minor, int32(m.currency.MinorUnits))
+}
+
+// Amount formats the amount in major units without the currency, e.g. "-12.50"
+func (m Money) Amount() string {
+	return m.Decimal().String()
+}
+
+// String formats the amount with its currency, e.g. "-12.50 USD"
+func (m Money) String() string {
+	return m.Amount() + " " + m.currency.Code
+}
+
+// IsZero reports whether the amount is zero
+func (m Money) IsZero() bool {
+	return m.minor == 0
+}
+
+// IsNegative reports whether the amount is below zero
+func (m Money) IsNegative() bool {
+	return m.minor < 0
+}
+
+// IsPositive reports whether the amount is above zero
+func (m Money) IsPositive() bool {
+	return m.minor > 0
+}
+
+// Neg returns the amount with its sign flipped
+func (m Money) Neg() Money {
+	return Money{minor: -m.minor, currency: m.currency}
+}
+
+// Abs returns the absolute amount
+func (m Money) Abs() Money {
+	if m.minor < 0 {
+		return m.Neg()
+	}
+	return m
+}
+
+// SameCurrency reports whether both amounts are in the same currency
+func (m Money) SameCurrency(other Money) bool {
+	return m.currency.Code == other.currency.Code
+}
+
+// Add returns m + other
+func (m Money) Add(other Money) (Money, error) {
+	if !m.SameCurrency(other) {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency.Code, other.currency.Code)
+	}
+	if (other.minor > 0 && m.minor > math.MaxInt64-other.minor) || (other.minor < 0 && m.minor < math.MinInt64-other.minor) {
+		return Money{}, ErrAmountOverflow
+	}
+	return Money{minor: m.minor + other.minor, currency: m.currency}, nil
+}
+
+// Sub returns m - other
+func (m Money) Sub(other Money) (Money, error) {
+	if other.minor == math.MinInt64 {
+		return Money{}, ErrAmountOverflow
+	}
+	return m.Add(other.Neg())
+}
+
+// Cmp compares two amounts in the same currency, returning -1, 0 or +1
+func (m Money) Cmp(other Money) (int, error) {
+	if !m.SameCurrency(other) {
+		return 0, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency.Code, other.currency.Code)
+	}
+	switch {
+	case m.minor < other.minor:
+		return -1, nil
+	case m.minor > other.minor:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// Multiply returns m * factor rounded half to even to the currency's minor units
+func (m Money) Multiply(factor Decimal) (Money, error) {
+	product := Decimal{
+		unscaled: new(big.Int).Mul(big.NewInt(m.minor), factor.bigInt()),
+		scale:    factor.scale,
+	}
+	return moneyFromBig(product.roundHalfEven(0), m.currency)
+}
+
+// Allocate splits the amount by ratios without losing minor units.
+// Leftover minor units go one each to the first shares, so Allocate(1, 1, 1) of 1.00 is 0.34, 0.33, 0.33.
+func (m Money) Allocate(ratios ...int64) ([]Money, error) {
+	total := new(big.Int)
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, ErrInvalidAllocation
+		}
+		total.Add(total, big.NewInt(ratio))
+	}
+	if total.Sign() == 0 {
+		return nil, ErrInvalidAllocation
+	}
+
+	// Work on the absolute amount so that negative amounts are split symmetrically
+	sign := int64(1)
+	amount := big.NewInt(m.minor)
+	if m.minor < 0 {
+		sign = -1
+		amount.Neg(amount)
+	}
+
+	shares := make([]Money, len(ratios))
+	remainder := new(big.Int).Set(amount)
+	for i, ratio := range ratios {
+		share := new(big.Int).Mul(amount, big.NewInt(ratio))
+		share.Quo(share, total)
+		remainder.Sub(remainder, share)
+		shares[i] = Money{minor: sign * share.Int64(), currency: m.currency}
+	}
+	for i := 0; remainder.Sign() > 0; i++ {
+		if ratios[i] == 0 {
+			continue
+		}
+		shares[i].minor += sign
+		remainder.Sub(remainder, big.NewInt(1))
+	}
+	return shares, nil
+}
+
+// Split divides the amount into n shares that differ by at most one minor unit
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, ErrInvalidAllocation
+	}
+	ratios := make([]int64, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return m.Allocate(ratios...)
+}
+
+// moneyJSON is the wire format of Money; the amount is a string so that clients never parse it as a float
+type moneyJSON struct {
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// MarshalJSON encodes the amount as {"amount": "-12.50", "currency": "USD"}
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(moneyJSON{Amount: m.Amount(), Currency: m.currency.Code})
+}
+
+// UnmarshalJSON decodes the format written by MarshalJSON
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var v moneyJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	parsed, err := ParseMoney(v.Amount, v.Currency)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
@@ -0,0 +1,236 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func mustMoney(t *testing.T, amount, currency string) Money {
+	t.Helper()
+	m, err := ParseMoney(amount, currency)
+	if err != nil {
+		t.Fatalf("ParseMoney(%q, %q): %v", amount, currency, err)
+	}
+	return m
+}
+
+func TestMoneyFromDecimal(t *testing.T) {
+	tests := []struct {
+		amount   string
+		currency string
+		want     int64
+		wantErr  error
+	}{
+		{amount: "12.50", currency: "USD", want: 1250},
+		{amount: "12.5", currency: "USD", want: 1250},
+		{amount: "-0.01", currency: "USD", want: -1},
+		{amount: "1200", currency: "JPY", want: 1200},
+		{amount: "1.234", currency: "KWD", want: 1234},
+		{amount: "12.501", currency: "USD", wantErr: ErrAmountPrecision},
+		{amount: "0.5", currency: "JPY", wantErr: ErrAmountPrecision},
+		{amount: "1", currency: "XXY", wantErr: ErrUnknownCurrency},
+		{amount: "100000000000000000", currency: "USD", wantErr: ErrAmountOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.amount+" "+tt.currency, func(t *testing.T) {
+			got, err := MoneyFromDecimal(mustParseDecimal(t, tt.amount), tt.currency)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.MinorUnits() != tt.want || got.Currency() != tt.currency {
+				t.Errorf("got %d %s, want %d %s", got.MinorUnits(), got.Currency(), tt.want, tt.currency)
+			}
+		})
+	}
+}
+
+func TestRoundMoney(t *testing.T) {
+	tests := []struct {
+		amount   string
+		currency string
+		want     string
+	}{
+		{amount: "0.125", currency: "USD", want: "0.12"},
+		{amount: "0.135", currency: "USD", want: "0.14"},
+		{amount: "-0.125", currency: "USD", want: "-0.12"},
+		{amount: "-0.135", currency: "USD", want: "-0.14"},
+		{amount: "0.12500001", currency: "USD", want: "0.13"},
+		{amount: "2.5", currency: "JPY", want: "2"},
+		{amount: "-3.5", currency: "JPY", want: "-4"},
+	}
+	for _, tt := range tests {
+		got, err := RoundMoney(mustParseDecimal(t, tt.amount), tt.currency)
+		if err != nil {
+			t.Fatalf("RoundMoney(%s, %s): %v", tt.amount, tt.currency, err)
+		}
+		if got.Amount() != tt.want {
+			t.Errorf("RoundMoney(%s, %s) = %s, want %s", tt.amount, tt.currency, got.Amount(), tt.want)
+		}
+	}
+}
+
+func TestMoneyMultiply(t *testing.T) {
+	tests := []struct {
+		amount string
+		factor string
+		want   string
+	}{
+		{amount: "10.00", factor: "0.5", want: "5.00"},
+		{amount: "0.25", factor: "0.5", want: "0.12"}, // 12.5 minor units, tie to even
+		{amount: "0.35", factor: "0.5", want: "0.18"}, // 17.5 minor units, tie to even
+		{amount: "-0.25", factor: "0.5", want: "-0.12"},
+		{amount: "-0.35", factor: "0.5", want: "-0.18"},
+		{amount: "19.99", factor: "1.0825", want: "21.64"}, // 2163.9175 minor units
+		{amount: "100.00", factor: "-1", want: "-100.00"},
+		{amount: "1.00", factor: "0", want: "0.00"},
+	}
+	for _, tt := range tests {
+		got, err := mustMoney(t, tt.amount, "USD").Multiply(mustParseDecimal(t, tt.factor))
+		if err != nil {
+			t.Fatalf("%s * %s: %v", tt.amount, tt.factor, err)
+		}
+		if got.Amount() != tt.want {
+			t.Errorf("%s * %s = %s, want %s", tt.amount, tt.factor, got.Amount(), tt.want)
+		}
+	}
+
+	huge, _ := NewMoney(math.MaxInt64, "USD")
+	if _, err := huge.Multiply(mustParseDecimal(t, "2")); !errors.Is(err, ErrAmountOverflow) {
+		t.Errorf("overflowing Multiply error = %v, want ErrAmountOverflow", err)
+	}
+}
+
+func TestMoneyAllocate(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount string
+		ratios []int64
+		want   []string
+	}{
+		{name: "thirds", amount: "1.00", ratios: []int64{1, 1, 1}, want: []string{"0.34", "0.33", "0.33"}},
+		{name: "negative thirds", amount: "-1.00", ratios: []int64{1, 1, 1}, want: []string{"-0.34", "-0.33", "-0.33"}},
+		{name: "weighted", amount: "100.00", ratios: []int64{70, 20, 10}, want: []string{"70.00", "20.00", "10.00"}},
+		{name: "weighted remainder", amount: "0.05", ratios: []int64{3, 7}, want: []string{"0.02", "0.03"}},
+		{name: "zero ratio gets nothing", amount: "0.03", ratios: []int64{0, 1, 1}, want: []string{"0.00", "0.02", "0.01"}},
+		{name: "zero amount", amount: "0.00", ratios: []int64{1, 2}, want: []string{"0.00", "0.00"}},
+		{name: "single share", amount: "-12.34", ratios: []int64{5}, want: []string{"-12.34"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mustMoney(t, tt.amount, "USD")
+			shares, err := m.Allocate(tt.ratios...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(shares) != len(tt.want) {
+				t.Fatalf("got %d shares, want %d", len(shares), len(tt.want))
+			}
+			sum, _ := NewMoney(0, "USD")
+			for i, share := range shares {
+				if share.Amount() != tt.want[i] {
+					t.Errorf("share %d = %s, want %s", i, share.Amount(), tt.want[i])
+				}
+				if sum, err = sum.Add(share); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if sum.MinorUnits() != m.MinorUnits() {
+				t.Errorf("shares sum to %s, want %s", sum.Amount(), m.Amount())
+			}
+		})
+	}
+
+	m := mustMoney(t, "1.00", "USD")
+	for _, ratios := range [][]int64{{}, {0, 0}, {1, -1}} {
+		if _, err := m.Allocate(ratios...); !errors.Is(err, ErrInvalidAllocation) {
+			t.Errorf("Allocate(%v) error = %v, want ErrInvalidAllocation", ratios, err)
+		}
+	}
+}
+
+func TestMoneySplit(t *testing.T) {
+	for _, amount := range []string{"10.00", "-10.00", "0.07", "-0.07"} {
+		m := mustMoney(t, amount, "USD")
+		shares, err := m.Split(3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var sum, lowest, highest int64 = 0, math.MaxInt64, math.MinInt64
+		for _, share := range shares {
+			sum += share.MinorUnits()
+			lowest = min(lowest, share.MinorUnits())
+			highest = max(highest, share.MinorUnits())
+		}
+		if sum != m.MinorUnits() {
+			t.Errorf("Split(%s) sums to %d, want %d", amount, sum, m.MinorUnits())
+		}
+		if highest-lowest > 1 {
+			t.Errorf("Split(%s) shares differ by %d minor units", amount, highest-lowest)
+		}
+	}
+	if _, err := mustMoney(t, "1.00", "USD").Split(0); !errors.Is(err, ErrInvalidAllocation) {
+		t.Errorf("Split(0) error = %v, want ErrInvalidAllocation", err)
+	}
+}
+
+func TestMoneyCurrencyMismatch(t *testing.T) {
+	usd, eur := mustMoney(t, "1.00", "USD"), mustMoney(t, "1.00", "EUR")
+	if _, err := usd.Add(eur); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Add error = %v, want ErrCurrencyMismatch", err)
+	}
+	if _, err := usd.Sub(eur); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Sub error = %v, want ErrCurrencyMismatch", err)
+	}
+	if _, err := usd.Cmp(eur); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Cmp error = %v, want ErrCurrencyMismatch", err)
+	}
+	if usd.SameCurrency(eur) {
+		t.Error("SameCurrency(USD, EUR) = true")
+	}
+}
+
+func TestMoneyAddOverflow(t *testing.T) {
+	maxMoney, _ := NewMoney(math.MaxInt64, "USD")
+	minMoney, _ := NewMoney(math.MinInt64, "USD")
+	one := mustMoney(t, "0.01", "USD")
+	if _, err := maxMoney.Add(one); !errors.Is(err, ErrAmountOverflow) {
+		t.Errorf("max + 1 error = %v, want ErrAmountOverflow", err)
+	}
+	if _, err := minMoney.Sub(one); !errors.Is(err, ErrAmountOverflow) {
+		t.Errorf("min - 1 error = %v, want ErrAmountOverflow", err)
+	}
+	if _, err := one.Sub(minMoney); !errors.Is(err, ErrAmountOverflow) {
+		t.Errorf("1 - min error = %v, want ErrAmountOverflow", err)
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	data, err := json.Marshal(mustMoney(t, "-12.5", "USD"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"amount":"-12.50","currency":"USD"}`; string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+
+	var m Money
+	if err := json.Unmarshal(data, &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.MinorUnits() != -1250 || m.Currency() != "USD" {
+		t.Errorf("Unmarshal = %s, want -12.50 USD", m)
+	}
+	for _, in := range []string{`{"amount":"1.001","currency":"USD"}`, `{"amount":"1","currency":"ABC"}`, `{"amount":1.5,"currency":"USD"}`} {
+		if err := json.Unmarshal([]byte(in), &m); err == nil {
+			t.Errorf("Unmarshal(%s) succeeded, want an error", in)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// Payee is a counterparty of transactions, e.g. Amazon. Its aliases resolve the many ways banks
+// describe the same counterparty, such as "AMZN MKTP US*2K3" and "Amazon.com", to one payee.
+type Payee struct {
+	ID                int
+	WorkspaceID       int
+	Name              string
+	Aliases           []string // Normalized patterns; * matches any run of characters
+	DefaultCategoryID *int     // Assigned to new uncategorized transactions of the payee
+	LogoURL           string
+	Website           string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
@@ -0,0 +1,70 @@
+package model
+
+import "time"
+
+// QIFDateFormat is the order of day, month and year in the dates of a QIF file. Imports accept any separator
+// and two-digit years, as Quicken writes 1/ 2'24; exports write the format as named.
+type QIFDateFormat string
+
+const (
+	QIFDateFormatMDY    QIFDateFormat = "MM/DD/YYYY" // Quicken and US tools
+	QIFDateFormatDMY    QIFDateFormat = "DD/MM/YYYY"
+	QIFDateFormatDMYDot QIFDateFormat = "DD.MM.YYYY"
+	QIFDateFormatYMD    QIFDateFormat = "YYYY-MM-DD"
+)
+
+// IsValid reports whether f is one of the supported QIF date formats
+func (f QIFDateFormat) IsValid() bool {
+	switch f {
+	case QIFDateFormatMDY, QIFDateFormatDMY, QIFDateFormatDMYDot, QIFDateFormatYMD:
+		return true
+	}
+	return false
+}
+
+// QIFFile is what a QIF file holds: a category list and the registers of one or more accounts
+type QIFFile struct {
+	Categories []QIFCategory
+	Accounts   []QIFAccount
+}
+
+// QIFCategory is an entry of the category list of a QIF file
+type QIFCategory struct {
+	Path        []string // Names from the top-level category down, e.g. Food, Groceries for Food:Groceries
+	Kind        CategoryKind
+	Description string
+}
+
+// QIFAccount is an account of a QIF file with its register. Registers of files exported for a single account
+// have no account header; their account is left unnamed.
+type QIFAccount struct {
+	Name         string
+	Type         AccountType
+	Description  string
+	Transactions []QIFTransaction
+}
+
+// QIFTransaction is one transaction of a QIF register. A transaction is either categorized, a transfer
+// to the account named by TransferAccount, or split. Err is set instead of the other fields when the
+// transaction cannot be read.
+type QIFTransaction struct {
+	Line            int // 1-based line of the file where the transaction starts
+	PostedOn        time.Time
+	Amount          Decimal // Signed; negative for outflows
+	Payee           string
+	Memo            string
+	Number          string // Check number or reference
+	Status          TransactionStatus
+	Category        []string // Category path; nil when uncategorized
+	TransferAccount string
+	Splits          []QIFSplit
+	Err             error
+}
+
+// QIFSplit is one line of a split QIF transaction
+type QIFSplit struct {
+	Category        []string // Category path; nil when uncategorized
+	TransferAccount string   // Set for split lines moving money to another account; imported uncategorized
+	Amount          Decimal
+	Memo            string
+}
@@ -0,0 +1,68 @@
+package model
+
+import "time"
+
+// RecurrenceFrequency is the base period of a recurrence rule, as in the RRULE FREQ part
+type RecurrenceFrequency string
+
+const (
+	RecurrenceFrequencyDaily   RecurrenceFrequency = "daily"
+	RecurrenceFrequencyWeekly  RecurrenceFrequency = "weekly"
+	RecurrenceFrequencyMonthly RecurrenceFrequency = "monthly"
+	RecurrenceFrequencyYearly  RecurrenceFrequency = "yearly"
+)
+
+// IsValid reports whether f is one of the known recurrence frequencies
+func (f RecurrenceFrequency) IsValid() bool {
+	switch f {
+	case RecurrenceFrequencyDaily, RecurrenceFrequencyWeekly, RecurrenceFrequencyMonthly, RecurrenceFrequencyYearly:
+		return true
+	}
+	return false
+}
+
+// RecurrenceRule is a transaction that repeats on a schedule, e.g. rent on the 1st of every month.
+// The schedule follows RRULE: every Interval periods of Frequency from StartsOn, on the ByDay days
+// when given. Monthly rules without ByDay fall on the day of month of StartsOn, or on the last day
+// of shorter months.
+type RecurrenceRule struct {
+	ID          int
+	WorkspaceID int
+	AccountID   int
+	Amount      Money // In the account currency; negative for outflows
+	Payee       string
+	PayeeID     *int
+	Memo        string
+	CategoryID  *int
+	Frequency   RecurrenceFrequency
+	Interval    int        // At least 1
+	ByDay       []string   // RRULE BYDAY values: MO..SU weekly, optionally prefixed by an ordinal monthly, e.g. -1FR
+	StartsOn    time.Time  // First possible occurrence
+	EndsOn      *time.Time // Last possible occurrence, inclusive; nil repeats forever
+	// ScheduledFrom is the day the schedule was set, when the rule was created or last edited;
+	// occurrences before it are never scheduled
+	ScheduledFrom time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// RecurrenceOccurrenceStatus tells whether an occurrence is still to be posted
+type RecurrenceOccurrenceStatus string
+
+const (
+	RecurrenceOccurrenceStatusScheduled RecurrenceOccurrenceStatus = "scheduled"
+	RecurrenceOccurrenceStatusPosted    RecurrenceOccurrenceStatus = "posted"
+	RecurrenceOccurrenceStatusSkipped   RecurrenceOccurrenceStatus = "skipped"
+)
+
+// RecurrenceOccurrence is one due date of a recurrence rule
+type RecurrenceOccurrence struct {
+	ID            int
+	WorkspaceID   int
+	RuleID        int
+	DueOn         time.Time
+	Status        RecurrenceOccurrenceStatus
+	TransactionID *int // Set once posted, unless the transaction was deleted since
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
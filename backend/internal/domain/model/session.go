@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+type Session struct {
+	ID         int
+	UserID     int
+	UserAgent  string
+	IPAddress  string
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+}
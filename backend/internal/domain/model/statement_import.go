@@ -0,0 +1,144 @@
+package model
+
+import "time"
+
+// StatementFormat is the file format of an uploaded bank statement
+type StatementFormat string
+
+const (
+	StatementFormatCSV     StatementFormat = "csv"
+	StatementFormatOFX     StatementFormat = "ofx"     // OFX 1.x and 2.x, including QFX
+	StatementFormatCAMT053 StatementFormat = "camt053" // ISO 20022 bank to customer statement
+	StatementFormatMT940   StatementFormat = "mt940"   // SWIFT customer statement message
+)
+
+// StatementImportStatus is whether the transactions of a statement import have been created yet
+type StatementImportStatus string
+
+const (
+	StatementImportStatusPending   StatementImportStatus = "pending"
+	StatementImportStatusCommitted StatementImportStatus = "committed"
+)
+
+// StatementImport is a bank statement file uploaded for an account
+type StatementImport struct {
+	ID           int
+	WorkspaceID  int
+	AccountID    int
+	Format       StatementFormat
+	Filename     string
+	Content      []byte // Nil when listed
+	Status       StatementImportStatus
+	CreatedCount int // Transactions created on commit
+	SkippedCount int // Rows skipped on commit because they were imported before
+	MergedCount  int // Rows merged on commit into transactions the account already had
+	FailedCount  int // Rows rejected on commit
+	CommittedAt  *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// CSVEncoding is the character encoding of a CSV statement
+type CSVEncoding string
+
+const (
+	CSVEncodingUTF8     CSVEncoding = "utf-8"
+	CSVEncodingShiftJIS CSVEncoding = "shift_jis"
+)
+
+// CSVMapping describes how to read transactions from the CSV dialect of a bank.
+// Column indexes are zero-based. Amounts come either from one signed amount column or from
+// separate debit and credit columns.
+type CSVMapping struct {
+	Encoding         CSVEncoding `json:"encoding"`
+	Delimiter        string      `json:"delimiter"` // One of , ; tab |
+	SkipRows         int         `json:"skipRows"`  // Lines before the header, e.g. account details
+	HasHeader        bool        `json:"hasHeader"`
+	DateColumn       int         `json:"dateColumn"`
+	DateFormat       string      `json:"dateFormat"` // e.g. YYYY-MM-DD or DD/MM/YYYY
+	AmountColumn     *int        `json:"amountColumn"`
+	DebitColumn      *int        `json:"debitColumn"`      // Outflows, as positive numbers
+	CreditColumn     *int        `json:"creditColumn"`     // Inflows
+	NegateAmounts    bool        `json:"negateAmounts"`    // For statements listing outflows as positive amounts, e.g. credit cards
+	DecimalSeparator string      `json:"decimalSeparator"` // "." or ","
+	PayeeColumn      *int        `json:"payeeColumn"`
+	MemoColumn       *int        `json:"memoColumn"`
+}
+
+// ImportProfile is a saved CSV mapping of an account, reused for its later statements
+type ImportProfile struct {
+	ID          int
+	WorkspaceID int
+	AccountID   int
+	Name        string
+	Mapping     CSVMapping
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Statement is what a statement file holds: its rows and, for formats that state them, its currency
+// and balances
+type Statement struct {
+	Currency       string            // Empty when the file does not state it
+	OpeningBalance *StatementBalance // Nil when the file does not state it
+	ClosingBalance *StatementBalance // Nil when the file does not state it
+	Rows           []StatementRow
+}
+
+// StatementBalance is a balance a bank reports for an account at the start or end of a statement
+type StatementBalance struct {
+	Amount Decimal
+	AsOf   time.Time
+}
+
+// StatementBalanceCheck compares the closing balance of a statement with the balance of the account on the same day
+type StatementBalanceCheck struct {
+	AsOf             time.Time
+	StatementBalance Money
+	AccountBalance   Money
+	Difference       Money // StatementBalance minus AccountBalance; zero when they agree
+}
+
+// StatementRow is one transaction read from a statement. Err is set instead of the other
+// fields when the row cannot be read.
+type StatementRow struct {
+	Line             int       // 1-based line of the file
+	PostedOn         time.Time // Booking date
+	ValueDate        *time.Time
+	Amount           Decimal // Signed; negative for outflows
+	Payee            string  // Counterparty name or description
+	Memo             string  // Remittance information
+	CounterpartyIBAN string
+	EndToEndID       string // Reference the payer gave a SEPA payment
+	ExternalID       string // Identifier the bank gave the transaction, e.g. the OFX FITID; empty when the format has none
+	Err              error
+}
+
+// DuplicateMatch is an existing transaction of an account that a statement row may duplicate,
+// e.g. one entered by hand or imported from an overlapping statement
+type DuplicateMatch struct {
+	TransactionID int
+	Score         int  // 0 to 100; how alike the row and the transaction are
+	Likely        bool // Whether the row is most likely this transaction again; each transaction is the likely match of one row at most
+}
+
+// ImportDecisionAction is what a statement row became on commit
+type ImportDecisionAction string
+
+const (
+	ImportDecisionActionImported ImportDecisionAction = "imported" // Created as a new transaction
+	ImportDecisionActionMerged   ImportDecisionAction = "merged"   // Merged into a transaction the user confirmed as its duplicate
+)
+
+// ImportDecision records what became of a statement row on commit, so that importing the same row again
+// creates nothing
+type ImportDecision struct {
+	ID            int
+	WorkspaceID   int
+	AccountID     int
+	ImportID      *int   // Nil once the statement import is deleted
+	Fingerprint   string // Identifies the row across files; see service.StatementRowFingerprints
+	Action        ImportDecisionAction
+	TransactionID int
+	CreatedAt     time.Time
+}
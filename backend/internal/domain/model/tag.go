@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// Tag is a free-form label that cuts across categories, e.g. "vacation-2026" or "reimbursable"
+type Tag struct {
+	ID          int
+	WorkspaceID int
+	Name        string
+	Color       string // #RRGGBB, or empty
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// TagMatch is how a set of tags selects transactions
+type TagMatch string
+
+const (
+	TagMatchAny TagMatch = "any" // Transactions carrying at least one of the tags
+	TagMatchAll TagMatch = "all" // Transactions carrying every one of the tags
+)
+
+// IsValid reports whether m is one of the known tag matching modes
+func (m TagMatch) IsValid() bool {
+	return m == TagMatchAny || m == TagMatchAll
+}
+
+// TagTotal is the amount of the transactions carrying a tag in one currency
+type TagTotal struct {
+	TagID  int
+	Amount Money
+}
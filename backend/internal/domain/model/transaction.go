@@ -0,0 +1,91 @@
+package model
+
+import "time"
+
+// TransactionStatus is how far a transaction has been matched against the bank statement
+type TransactionStatus string
+
+const (
+	TransactionStatusUncleared  TransactionStatus = "uncleared"
+	TransactionStatusCleared    TransactionStatus = "cleared"
+	TransactionStatusReconciled TransactionStatus = "reconciled"
+)
+
+// IsValid reports whether s is one of the known transaction statuses
+func (s TransactionStatus) IsValid() bool {
+	switch s {
+	case TransactionStatusUncleared, TransactionStatusCleared, TransactionStatusReconciled:
+		return true
+	}
+	return false
+}
+
+type Transaction struct {
+	ID               int
+	WorkspaceID      int
+	AccountID        int
+	PostedOn         time.Time
+	Amount           Money  // In the account currency; negative for outflows
+	Payee            string // Description as entered or imported
+	PayeeID          *int   // Payee of the directory the description resolves to, if any
+	Memo             string
+	CategoryID       *int                // Nil when the transaction is split; the splits carry the categories
+	Splits           []*TransactionSplit // Empty unless the transaction is divided across categories
+	TransferID       *int                // Set when the transaction is one side of a transfer
+	TagIDs           []int               // Ascending
+	ExternalID       *string             // Identifier the bank gave the transaction when it was imported from a statement
+	ValueDate        *time.Time          // Day the bank value-dated an imported transaction, when the statement states it
+	CounterpartyIBAN string              // Account of the other party of an imported transaction
+	EndToEndID       string              // Reference the payer gave an imported SEPA payment
+	Status           TransactionStatus
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// IsTransfer reports whether the transaction is one side of a transfer between two accounts
+func (t *Transaction) IsTransfer() bool {
+	return t.TransferID != nil
+}
+
+// IsSplit reports whether the transaction is divided across several categories
+func (t *Transaction) IsSplit() bool {
+	return len(t.Splits) > 0
+}
+
+// TransactionSplit is one line of a split transaction
+type TransactionSplit struct {
+	ID         int
+	CategoryID *int
+	Amount     Money // In the transaction currency; the splits sum to the transaction amount
+	Memo       string
+	TagIDs     []int // Ascending; the split's amount counts towards them besides the transaction's tags
+}
+
+// CategoryTotal is the amount attributed to a category in one currency
+type CategoryTotal struct {
+	CategoryID *int // Nil for uncategorized amounts
+	Amount     Money
+}
+
+// TransactionFilter narrows a transaction listing; zero values do not filter
+type TransactionFilter struct {
+	AccountID   *int
+	PayeeID     *int
+	CategoryIDs []int      // Matches any of the categories; subcategories are expanded by the usecase
+	TagIDs      []int      // Matches by TagMatch
+	TagMatch    TagMatch   // Defaults to any
+	From        *time.Time // Inclusive
+	To          *time.Time // Inclusive
+	MinAmount   *Decimal   // Inclusive, in the account currency
+	MaxAmount   *Decimal   // Inclusive, in the account currency
+	Limit       int
+	Offset      int
+}
+
+// ReportFilter narrows the transactions summed by a report; zero values do not filter
+type ReportFilter struct {
+	From     *time.Time // Inclusive
+	To       *time.Time // Inclusive
+	TagIDs   []int      // Matches by TagMatch
+	TagMatch TagMatch   // Defaults to any
+}
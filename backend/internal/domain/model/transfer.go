@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// Transfer links the transaction moving money out of one account of a workspace to the transaction
+// bringing it into another. The two sides may be in different currencies; the implied exchange rate
+// is whatever the amounts say.
+type Transfer struct {
+	ID          int
+	WorkspaceID int
+	From        *Transaction // Outgoing side; its amount is negative and includes FromFee
+	To          *Transaction // Incoming side; its amount is positive and already net of ToFee
+	FromFee     Money        // Charged by the sending account, in its currency
+	ToFee       Money        // Charged by the receiving account, in its currency
+	CreatedAt   time.Time
+}
+
+// Sent returns the amount that left the sending account towards the receiving one, excluding its fee
+func (t *Transfer) Sent() (Money, error) {
+	return t.From.Amount.Neg().Sub(t.FromFee)
+}
+
+// Received returns the amount that reached the receiving account before its fee was deducted
+func (t *Transfer) Received() (Money, error) {
+	return t.To.Amount.Add(t.ToFee)
+}
@@ -3,9 +3,30 @@ package model
 import "time"
 
 type User struct {
-	ID           int
-	Email        string
-	PasswordHash string
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-}
\ No newline at end of file
+	ID                      int
+	Email                   string
+	PasswordHash            string
+	EmailVerifiedAt         *time.Time
+	TOTPSecret              string // Empty until two-factor enrollment has started
+	TwoFactorEnabledAt      *time.Time
+	TwoFactorFailedAttempts int        // Wrong second-factor codes in a row, across logins
+	TwoFactorLockedUntil    *time.Time // Second-factor codes are refused until then
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
+}
+
+// IsEmailVerified reports whether the user has confirmed their email address
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// IsTwoFactorEnabled reports whether the user must pass a second factor at login
+func (u *User) IsTwoFactorEnabled() bool {
+	return u.TwoFactorEnabledAt != nil
+}
+
+// IsTwoFactorLocked reports whether second-factor codes are refused at the given time
+// because too many wrong ones were entered
+func (u *User) IsTwoFactorLocked(now time.Time) bool {
+	return u.TwoFactorLockedUntil != nil && now.Before(*u.TwoFactorLockedUntil)
+}
@@ -3,8 +3,14 @@ package model
 import "time"
 
 type Workspace struct {
-	ID        int
-	Name      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
-}
\ No newline at end of file
+	ID                   int
+	Name                 string
+	RequireVerifiedEmail bool
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+// CanAccessFinancialData reports whether the user may see this workspace's financial data
+func (w *Workspace) CanAccessFinancialData(u *User) bool {
+	return !w.RequireVerifiedEmail || u.IsEmailVerified()
+}
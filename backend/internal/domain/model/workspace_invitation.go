@@ -0,0 +1,34 @@
+package model
+
+import "time"
+
+// InvitationStatus is the lifecycle state of a workspace invitation
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusRevoked  InvitationStatus = "revoked"
+	InvitationStatusExpired  InvitationStatus = "expired" // Derived: pending past ExpiresAt
+)
+
+type WorkspaceInvitation struct {
+	ID           int
+	WorkspaceID  int
+	Email        string
+	Role         Role
+	Status       InvitationStatus
+	ExpiresAt    time.Time
+	InvitedByID  *int
+	AcceptedByID *int
+	RespondedAt  *time.Time
+	CreatedAt    time.Time
+}
+
+// EffectiveStatus returns the status as of now, reporting expired pending invitations as expired
+func (i *WorkspaceInvitation) EffectiveStatus(now time.Time) InvitationStatus {
+	if i.Status == InvitationStatusPending && !now.Before(i.ExpiresAt) {
+		return InvitationStatusExpired
+	}
+	return i.Status
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"errors"
+	"strings"
+
+	"backend/internal/domain/model"
+)
+
+var (
+	// ErrAccountNameRequired is returned for accounts without a name
+	ErrAccountNameRequired = errors.New("account name is required")
+	// ErrInvalidAccountType is returned for unknown account types
+	ErrInvalidAccountType = errors.New("invalid account type")
+	// ErrInvalidCurrency is returned for strings that are not ISO 4217 currency codes
+	ErrInvalidCurrency = errors.New("invalid currency code")
+	// ErrInvalidAccountDates is returned when an account is closed before it was opened
+	ErrInvalidAccountDates = errors.New("account cannot be closed before it was opened")
+)
+
+// ValidateCurrencyCode checks that code is an active ISO 4217 currency
+func ValidateCurrencyCode(code string) error {
+	if _, ok := model.LookupCurrency(code); !ok {
+		return ErrInvalidCurrency
+	}
+	return nil
+}
+
+// ValidateAccount checks the user-editable fields of an account
+func ValidateAccount(account *model.Account) error {
+	if strings.TrimSpace(account.Name) == "" {
+		return ErrAccountNameRequired
+	}
+	if !account.Type.IsValid() {
+		return ErrInvalidAccountType
+	}
+	if err := ValidateCurrencyCode(account.Currency); err != nil {
+		return err
+	}
+	if account.OpenedOn != nil && account.ClosedOn != nil && account.ClosedOn.Before(*account.OpenedOn) {
+		return ErrInvalidAccountDates
+	}
+	return nil
+}
@@ -1,13 +1,25 @@
 package service
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
+	"strings"
+	"sync"
 
 	"golang.org/x/crypto/bcrypt"
 )
 
-const bcryptCost = 10 // Minimum secure cost factor
+const (
+	bcryptCost        = 10 // Minimum secure cost factor
+	minPasswordLength = 8
+)
+
+// ErrPasswordTooShort is returned by ValidatePassword for passwords under the minimum length
+var ErrPasswordTooShort = fmt.Errorf("password must be at least %d characters", minPasswordLength)
+
+// ErrInvalidEmail is returned by ValidateEmail for malformed addresses
+var ErrInvalidEmail = errors.New("invalid email format")
 
 // HashPassword hashes a plain-text password using bcrypt
 func HashPassword(password string) (string, error) {
@@ -18,11 +30,24 @@ func HashPassword(password string) (string, error) {
 	return string(hashedBytes), nil
 }
 
+// NormalizeEmail returns the form emails are stored and compared in: trimmed and lowercased
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
 // ValidateEmail validates email format using regex
 func ValidateEmail(email string) error {
 	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
 	if !emailRegex.MatchString(email) {
-		return fmt.Errorf("invalid email format")
+		return ErrInvalidEmail
+	}
+	return nil
+}
+
+// ValidatePassword validates password strength requirements
+func ValidatePassword(password string) error {
+	if len(password) < minPasswordLength {
+		return ErrPasswordTooShort
 	}
 	return nil
 }
@@ -31,3 +56,18 @@ func ValidateEmail(email string) error {
 func ComparePassword(hashedPassword, password string) error {
 	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
 }
+
+// dummyPasswordHash is a hash no password is checked against successfully, generated on first use
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, err := bcrypt.GenerateFromPassword([]byte("dummy password"), bcryptCost)
+	if err != nil {
+		panic(fmt.Sprintf("failed to hash dummy password: %v", err))
+	}
+	return hash
+})
+
+// CompareDummyPassword spends as long as ComparePassword does for a user that does not exist,
+// so that response times do not reveal which emails are registered
+func CompareDummyPassword(password string) {
+	_ = bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(password))
+}
@@ -0,0 +1,321 @@
+package service
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/internal/domain/model"
+
+	"golang.org/x/text/encoding/ianaindex"
+)
+
+// camtAmount is an amount with its currency, always positive; the credit/debit indicator next to it gives the direction
+type camtAmount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+// camtDate is a date or date and time choice
+type camtDate struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+type camtAccount struct {
+	IBAN     string `xml:"Id>IBAN"`
+	Other    string `xml:"Id>Othr>Id"`
+	Currency string `xml:"Ccy"`
+}
+
+type camtBalance struct {
+	Code        string     `xml:"Tp>CdOrPrtry>Cd"` // OPBD, PRCD, CLBD, CLAV, ...
+	Amount      camtAmount `xml:"Amt"`
+	CreditDebit string     `xml:"CdtDbtInd"` // CRDT or DBIT
+	Date        camtDate   `xml:"Dt"`
+}
+
+// camtStatus is the status of an entry: a code in camt.053.001.02, and a Cd element from camt.053.001.08 on
+type camtStatus struct {
+	Value string `xml:",chardata"`
+	Code  string `xml:"Cd"`
+}
+
+// camtParty is a debtor or creditor; the name moved into Pty in camt.053.001.08
+type camtParty struct {
+	Name      string `xml:"Nm"`
+	PartyName string `xml:"Pty>Nm"`
+}
+
+type camtTransactionDetails struct {
+	EndToEndID         string     `xml:"Refs>EndToEndId"`
+	Reference          string     `xml:"Refs>AcctSvcrRef"`
+	Amount             camtAmount `xml:"Amt"`               // camt.053.001.08 and later
+	TransactionAmount  camtAmount `xml:"AmtDtls>TxAmt>Amt"` // camt.053.001.02
+	CreditDebit        string     `xml:"CdtDbtInd"`
+	Debtor             camtParty  `xml:"RltdPties>Dbtr"`
+	DebtorIBAN         string     `xml:"RltdPties>DbtrAcct>Id>IBAN"`
+	Creditor           camtParty  `xml:"RltdPties>Cdtr"`
+	CreditorIBAN       string     `xml:"RltdPties>CdtrAcct>Id>IBAN"`
+	Unstructured       []string   `xml:"RmtInf>Ustrd"`
+	CreditorReferences []string   `xml:"RmtInf>Strd>CdtrRefInf>Ref"`
+	AdditionalInfo     string     `xml:"AddtlTxInf"`
+}
+
+type camtEntry struct {
+	Amount         camtAmount               `xml:"Amt"`
+	CreditDebit    string                   `xml:"CdtDbtInd"`
+	Status         camtStatus               `xml:"Sts"`
+	BookingDate    camtDate                 `xml:"BookgDt"`
+	ValueDate      camtDate                 `xml:"ValDt"`
+	Reference      string                   `xml:"AcctSvcrRef"`
+	Details        []camtTransactionDetails `xml:"NtryDtls>TxDtls"`
+	AdditionalInfo string                   `xml:"AddtlNtryInf"`
+}
+
+// IsCAMT053Statement reports whether content looks like an ISO 20022 camt.053 statement
+func IsCAMT053Statement(content []byte) bool {
+	head := content[:min(len(content), 2048)]
+	return bytes.Contains(head, []byte("camt.053")) || bytes.Contains(head, []byte("BkToCstmrStmt"))
+}
+
+// ParseCAMT053Statement reads an ISO 20022 camt.053 bank to customer statement, any version. Booked entries
+// become rows dated by their booking date and identified by the bank's reference (AcctSvcrRef); the debtor of
+// a credit or the creditor of a debit is the counterparty. Batch entries whose transaction details carry their
+// own amounts are split into one row per transaction. Several statements of the same account, e.g. one per day,
+// are read as one, and the rows must add up from the opening (OPBD) to the closing (CLBD) balance.
+func ParseCAMT053Statement(content []byte) (*model.Statement, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	decoder.CharsetReader = xmlCharsetReader
+
+	var accounts []string
+	var statements []*model.Statement
+	var statement *model.Statement
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrMalformedStatement, err)
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Local == "Stmt" {
+			statement = &model.Statement{}
+			statements = append(statements, statement)
+			accounts = append(accounts, "")
+			continue
+		}
+		if statement == nil {
+			continue
+		}
+
+		line, _ := decoder.InputPos()
+		switch start.Name.Local {
+		case "Acct":
+			var account camtAccount
+			if err := decoder.DecodeElement(&account, &start); err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrMalformedStatement, err)
+			}
+			accounts[len(accounts)-1] = strings.TrimSpace(account.IBAN + account.Other)
+			if currency := strings.TrimSpace(account.Currency); currency != "" {
+				statement.Currency = strings.ToUpper(currency)
+			}
+		case "Bal":
+			var balance camtBalance
+			if err := decoder.DecodeElement(&balance, &start); err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrMalformedStatement, err)
+			}
+			if err := setCAMTBalance(statement, balance); err != nil {
+				return nil, fmt.Errorf("%w: invalid balance on line %d", ErrMalformedStatement, line)
+			}
+		case "Ntry":
+			var entry camtEntry
+			if err := decoder.DecodeElement(&entry, &start); err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrMalformedStatement, err)
+			}
+			statement.Rows = append(statement.Rows, camtStatementRows(entry, line)...)
+		}
+	}
+	if len(statements) == 0 {
+		return nil, fmt.Errorf("%w: no camt.053 statement", ErrMalformedStatement)
+	}
+	return joinStatements(accounts, statements)
+}
+
+// setCAMTBalance records an opening or closing balance of a statement; other balances, e.g. available ones, are ignored
+func setCAMTBalance(statement *model.Statement, balance camtBalance) error {
+	var target **model.StatementBalance
+	switch balance.Code {
+	case "OPBD", "PRCD":
+		// Prefer the opening balance over the closing balance of the previous statement
+		if statement.OpeningBalance != nil && balance.Code == "PRCD" {
+			return nil
+		}
+		target = &statement.OpeningBalance
+	case "CLBD":
+		target = &statement.ClosingBalance
+	default:
+		return nil
+	}
+
+	amount, err := camtSignedAmount(balance.Amount, balance.CreditDebit)
+	if err != nil {
+		return err
+	}
+	asOf, err := parseCAMTDate(balance.Date)
+	if err != nil {
+		return err
+	}
+	*target = &model.StatementBalance{Amount: amount, AsOf: asOf}
+	if statement.Currency == "" {
+		statement.Currency = strings.ToUpper(strings.TrimSpace(balance.Amount.Currency))
+	}
+	return nil
+}
+
+// camtStatementRows reads the rows of an entry. Entries that are not booked yet are left out, as the balances leave them out.
+func camtStatementRows(entry camtEntry, line int) []model.StatementRow {
+	if status := strings.TrimSpace(entry.Status.Code + entry.Status.Value); status != "" && status != "BOOK" {
+		return nil
+	}
+
+	row := model.StatementRow{
+		Line:       line,
+		Memo:       entry.AdditionalInfo,
+		ExternalID: strings.TrimSpace(entry.Reference),
+	}
+	postedOn, err := parseCAMTDate(entry.BookingDate)
+	if err != nil {
+		row.Err = err
+		return []model.StatementRow{row}
+	}
+	amount, err := camtSignedAmount(entry.Amount, entry.CreditDebit)
+	if err != nil {
+		row.Err = err
+		return []model.StatementRow{row}
+	}
+	row.PostedOn = postedOn
+	row.Amount = amount
+	if valueDate, err := parseCAMTDate(entry.ValueDate); err == nil {
+		row.ValueDate = &valueDate
+	}
+
+	switch {
+	case len(entry.Details) == 1:
+		applyCAMTDetails(&row, entry.Details[0], entry.CreditDebit)
+	case len(entry.Details) > 1:
+		if rows := splitCAMTEntry(row, entry); rows != nil {
+			return rows
+		}
+	}
+	return []model.StatementRow{finishStatementRow(row)}
+}
+
+// splitCAMTEntry splits a batch entry into one row per transaction. It returns nil unless every transaction
+// carries an amount and the amounts add up to that of the entry.
+func splitCAMTEntry(entryRow model.StatementRow, entry camtEntry) []model.StatementRow {
+	rows := make([]model.StatementRow, 0, len(entry.Details))
+	var total model.Decimal
+	for i, details := range entry.Details {
+		amount := details.Amount
+		if strings.TrimSpace(amount.Value) == "" {
+			amount = details.TransactionAmount
+		}
+		direction := details.CreditDebit
+		if direction == "" {
+			direction = entry.CreditDebit
+		}
+		signed, err := camtSignedAmount(amount, direction)
+		if err != nil {
+			return nil
+		}
+
+		row := entryRow
+		row.Amount = signed
+		row.Memo = ""
+		if row.ExternalID != "" {
+			row.ExternalID += "/" + strconv.Itoa(i+1)
+		}
+		applyCAMTDetails(&row, details, direction)
+		rows = append(rows, finishStatementRow(row))
+		total = total.Add(signed)
+	}
+	if total.Cmp(entryRow.Amount) != 0 {
+		return nil
+	}
+	return rows
+}
+
+// applyCAMTDetails fills in the counterparty, references and remittance information of a transaction.
+// The counterparty of a credit is its debtor, and that of a debit its creditor.
+func applyCAMTDetails(row *model.StatementRow, details camtTransactionDetails, direction string) {
+	party, iban := details.Creditor, details.CreditorIBAN
+	if direction == "CRDT" {
+		party, iban = details.Debtor, details.DebtorIBAN
+	}
+	row.Payee = strings.TrimSpace(party.Name + party.PartyName)
+	row.CounterpartyIBAN = strings.TrimSpace(iban)
+	if endToEndID := strings.TrimSpace(details.EndToEndID); endToEndID != "NOTPROVIDED" {
+		row.EndToEndID = endToEndID
+	}
+	if row.ExternalID == "" {
+		row.ExternalID = strings.TrimSpace(details.Reference)
+	}
+
+	switch {
+	case len(details.Unstructured) > 0:
+		row.Memo = strings.Join(details.Unstructured, " ")
+	case len(details.CreditorReferences) > 0:
+		row.Memo = strings.Join(details.CreditorReferences, " ")
+	case details.AdditionalInfo != "":
+		row.Memo = details.AdditionalInfo
+	}
+}
+
+// camtSignedAmount returns an amount negated for debits
+func camtSignedAmount(amount camtAmount, creditDebit string) (model.Decimal, error) {
+	value, err := model.ParseDecimal(strings.TrimSpace(amount.Value))
+	if err != nil {
+		return model.Decimal{}, ErrInvalidStatementAmount
+	}
+	switch creditDebit {
+	case "CRDT":
+		return value, nil
+	case "DBIT":
+		return value.Neg(), nil
+	}
+	return model.Decimal{}, ErrInvalidStatementAmount
+}
+
+// parseCAMTDate reads the day of a date or date and time choice
+func parseCAMTDate(date camtDate) (time.Time, error) {
+	value := strings.TrimSpace(date.Date)
+	if value == "" {
+		value = strings.TrimSpace(date.DateTime)
+	}
+	if len(value) < 10 {
+		return time.Time{}, ErrInvalidStatementDate
+	}
+	day, err := time.Parse("2006-01-02", value[:10])
+	if err != nil {
+		return time.Time{}, ErrInvalidStatementDate
+	}
+	return day, nil
+}
+
+// xmlCharsetReader decodes XML statements declaring an encoding other than UTF-8, e.g. ISO-8859-1
+func xmlCharsetReader(label string, input io.Reader) (io.Reader, error) {
+	encoding, err := ianaindex.IANA.Encoding(label)
+	if err != nil || encoding == nil {
+		return nil, fmt.Errorf("unsupported encoding %q", label)
+	}
+	return encoding.NewDecoder().Reader(input), nil
+}
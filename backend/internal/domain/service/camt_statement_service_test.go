@@ -0,0 +1,104 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"backend/internal/domain/model"
+)
+
+// checkBalances compares the opening and closing balances of a statement
+func checkBalances(t *testing.T, statement *model.Statement, opening, openingAsOf, closing, closingAsOf string) {
+	t.Helper()
+	for _, b := range []struct {
+		name   string
+		got    *model.StatementBalance
+		amount string
+		asOf   string
+	}{
+		{"opening", statement.OpeningBalance, opening, openingAsOf},
+		{"closing", statement.ClosingBalance, closing, closingAsOf},
+	} {
+		if b.got == nil {
+			t.Errorf("%s balance missing", b.name)
+			continue
+		}
+		if b.got.Amount.String() != b.amount || !b.got.AsOf.Equal(date(b.asOf)) {
+			t.Errorf("%s balance = %s on %s, want %s on %s", b.name, b.got.Amount, b.got.AsOf.Format("2006-01-02"), b.amount, b.asOf)
+		}
+	}
+}
+
+func TestParseCAMT053Statement(t *testing.T) {
+	content := readFixture(t, "statement.camt053.xml")
+	if !IsCAMT053Statement(content) {
+		t.Error("IsCAMT053Statement = false")
+	}
+	statement, err := ParseCAMT053Statement(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if statement.Currency != "EUR" {
+		t.Errorf("currency = %q, want EUR", statement.Currency)
+	}
+	checkBalances(t, statement, "1000.00", "2026-03-01", "2654.50", "2026-03-05")
+	// The pending entry is left out; the batch entry is split into its two transactions
+	checkRows(t, statement.Rows, []wantRow{
+		{
+			line: 32, postedOn: "2026-03-02", amount: "-45.50", payee: "Stadtwerke Musterstadt", memo: "Abschlag Strom Maerz 2026",
+			externalID: "REF-0001", iban: "DE02120300000000202051", endToEndID: "E2E-4711",
+		},
+		{postedOn: "2026-03-03", amount: "2000.00", payee: "ACME GmbH", memo: "Gehalt Maerz", externalID: "REF-0002", iban: "DE75512108001245126199"},
+		{postedOn: "2026-03-04", amount: "-100.00", payee: "Landlord A", memo: "Garage", externalID: "REF-0003/1"},
+		{postedOn: "2026-03-04", amount: "-200.00", payee: "Landlord B", memo: "Storage", externalID: "REF-0003/2"},
+	})
+	if v := statement.Rows[0].ValueDate; v == nil || !v.Equal(date("2026-03-01")) {
+		t.Errorf("value date = %v, want 2026-03-01", v)
+	}
+}
+
+func TestParseCAMT053StatementMalformed(t *testing.T) {
+	fixture := string(readFixture(t, "statement.camt053.xml"))
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{
+			name:    "unbalanced",
+			content: strings.Replace(fixture, "<Amt Ccy=\"EUR\">2654.50</Amt>", "<Amt Ccy=\"EUR\">2655.50</Amt>", 1),
+			wantErr: ErrUnbalancedStatement,
+		},
+		{
+			name:    "invalid balance",
+			content: strings.Replace(fixture, "<Amt Ccy=\"EUR\">1000.00</Amt>", "<Amt Ccy=\"EUR\">1.000,00</Amt>", 1),
+			wantErr: ErrMalformedStatement,
+		},
+		{
+			name:    "several accounts",
+			content: strings.Replace(fixture, "</Stmt>", "</Stmt><Stmt><Acct><Id><IBAN>DE44500105175407324931</IBAN></Id></Acct></Stmt>", 1),
+			wantErr: ErrMalformedStatement,
+		},
+		{name: "truncated", content: fixture[:len(fixture)/2], wantErr: ErrMalformedStatement},
+		{name: "no statement", content: `<Document><BkToCstmrStmt></BkToCstmrStmt></Document>`, wantErr: ErrMalformedStatement},
+		{name: "no entries", content: `<Document><BkToCstmrStmt><Stmt></Stmt></BkToCstmrStmt></Document>`, wantErr: ErrEmptyStatement},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseCAMT053Statement([]byte(tt.content)); !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// Unreadable entries are reported as rows, and the totals are then left unchecked
+	content := strings.Replace(fixture, "<BookgDt><Dt>2026-03-03</Dt></BookgDt>", "<BookgDt><Dt>03.03.2026</Dt></BookgDt>", 1)
+	statement, err := ParseCAMT053Statement([]byte(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := statement.Rows[1].Err; !errors.Is(err, ErrInvalidStatementDate) {
+		t.Errorf("row 1 error = %v, want ErrInvalidStatementDate", err)
+	}
+}
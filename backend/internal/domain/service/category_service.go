@@ -0,0 +1,162 @@
+package service
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"backend/internal/domain/model"
+)
+
+var (
+	// ErrCategoryNameRequired is returned for categories without a name
+	ErrCategoryNameRequired = errors.New("category name is required")
+	// ErrInvalidCategoryKind is returned for unknown category kinds
+	ErrInvalidCategoryKind = errors.New("invalid category kind")
+	// ErrInvalidCategoryColor is returned for colors that are not formatted as #RRGGBB
+	ErrInvalidCategoryColor = errors.New("category color must be formatted as #RRGGBB")
+	// ErrCategoryKindMismatch is returned when nesting or merging categories of different kinds
+	ErrCategoryKindMismatch = errors.New("categories must be of the same kind")
+	// ErrCategoryCycle is returned when a category would end up under itself or one of its subcategories
+	ErrCategoryCycle = errors.New("category cannot be placed under itself or one of its subcategories")
+)
+
+var colorRegex = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// ValidateCategory checks the user-editable fields of a category
+func ValidateCategory(category *model.Category) error {
+	if strings.TrimSpace(category.Name) == "" {
+		return ErrCategoryNameRequired
+	}
+	if !category.Kind.IsValid() {
+		return ErrInvalidCategoryKind
+	}
+	if category.Color != "" && !colorRegex.MatchString(category.Color) {
+		return ErrInvalidCategoryColor
+	}
+	return nil
+}
+
+// ValidateCategoryParent checks that category may be placed under parent, given all categories of the workspace.
+// A nil parent makes the category top-level, which is always allowed.
+func ValidateCategoryParent(category, parent *model.Category, categories []*model.Category) error {
+	if parent == nil {
+		return nil
+	}
+	if parent.Kind != category.Kind {
+		return ErrCategoryKindMismatch
+	}
+	// A new category has no subcategories yet
+	if category.ID != 0 && IsCategoryInSubtree(categories, category.ID, parent.ID) {
+		return ErrCategoryCycle
+	}
+	return nil
+}
+
+// IsCategoryInSubtree reports whether categoryID is rootID or one of its subcategories
+func IsCategoryInSubtree(categories []*model.Category, rootID, categoryID int) bool {
+	parents := make(map[int]*int, len(categories))
+	for _, c := range categories {
+		parents[c.ID] = c.ParentID
+	}
+
+	// Walk up from categoryID; the visited set guards against trees that are already corrupt
+	visited := make(map[int]bool)
+	for id := categoryID; !visited[id]; {
+		if id == rootID {
+			return true
+		}
+		visited[id] = true
+		parentID := parents[id]
+		if parentID == nil {
+			return false
+		}
+		id = *parentID
+	}
+	return false
+}
+
+// CategorySubtree returns the IDs of rootID and all of its subcategories
+func CategorySubtree(categories []*model.Category, rootID int) []int {
+	children := make(map[int][]int)
+	for _, c := range categories {
+		if c.ParentID != nil {
+			children[*c.ParentID] = append(children[*c.ParentID], c.ID)
+		}
+	}
+
+	subtree := []int{rootID}
+	visited := map[int]bool{rootID: true}
+	for i := 0; i < len(subtree); i++ {
+		for _, child := range children[subtree[i]] {
+			if !visited[child] {
+				visited[child] = true
+				subtree = append(subtree, child)
+			}
+		}
+	}
+	return subtree
+}
+
+// CategoryTemplate describes a category of the default tree seeded into new workspaces
+type CategoryTemplate struct {
+	Name     string
+	Kind     model.CategoryKind
+	Color    string
+	Icon     string
+	Children []CategoryTemplate // Inherit the kind, color and icon of their parent
+}
+
+// DefaultCategoryTemplates is the category tree every new workspace starts with
+var DefaultCategoryTemplates = []CategoryTemplate{
+	{Name: "Salary", Kind: model.CategoryKindIncome, Color: "#2E7D32", Icon: "briefcase"},
+	{Name: "Interest & Dividends", Kind: model.CategoryKindIncome, Color: "#388E3C", Icon: "trending-up"},
+	{Name: "Other Income", Kind: model.CategoryKindIncome, Color: "#43A047", Icon: "plus-circle", Children: []CategoryTemplate{
+		{Name: "Gifts Received"},
+		{Name: "Refunds"},
+	}},
+	{Name: "Food", Kind: model.CategoryKindExpense, Color: "#EF6C00", Icon: "utensils", Children: []CategoryTemplate{
+		{Name: "Groceries"},
+		{Name: "Restaurants"},
+		{Name: "Coffee & Snacks"},
+	}},
+	{Name: "Housing", Kind: model.CategoryKindExpense, Color: "#6D4C41", Icon: "home", Children: []CategoryTemplate{
+		{Name: "Rent & Mortgage"},
+		{Name: "Utilities"},
+		{Name: "Maintenance"},
+	}},
+	{Name: "Transportation", Kind: model.CategoryKindExpense, Color: "#1565C0", Icon: "car", Children: []CategoryTemplate{
+		{Name: "Fuel"},
+		{Name: "Public Transit"},
+		{Name: "Parking"},
+	}},
+	{Name: "Health", Kind: model.CategoryKindExpense, Color: "#C62828", Icon: "heart", Children: []CategoryTemplate{
+		{Name: "Pharmacy"},
+		{Name: "Doctor"},
+		{Name: "Insurance"},
+	}},
+	{Name: "Shopping", Kind: model.CategoryKindExpense, Color: "#AD1457", Icon: "shopping-bag", Children: []CategoryTemplate{
+		{Name: "Clothing"},
+		{Name: "Electronics"},
+		{Name: "Household"},
+	}},
+	{Name: "Entertainment", Kind: model.CategoryKindExpense, Color: "#6A1B9A", Icon: "film", Children: []CategoryTemplate{
+		{Name: "Subscriptions"},
+		{Name: "Hobbies"},
+		{Name: "Travel"},
+	}},
+	{Name: "Personal", Kind: model.CategoryKindExpense, Color: "#00838F", Icon: "user", Children: []CategoryTemplate{
+		{Name: "Education"},
+		{Name: "Gifts & Donations"},
+		{Name: "Personal Care"},
+	}},
+	{Name: "Fees & Charges", Kind: model.CategoryKindExpense, Color: "#546E7A", Icon: "receipt", Children: []CategoryTemplate{
+		{Name: "Bank Fees"},
+		{Name: "Interest Charges"},
+	}},
+	{Name: "Taxes", Kind: model.CategoryKindExpense, Color: "#37474F", Icon: "landmark"},
+	{Name: "Transfers", Kind: model.CategoryKindTransfer, Color: "#757575", Icon: "repeat", Children: []CategoryTemplate{
+		{Name: "Credit Card Payment"},
+		{Name: "Savings"},
+	}},
+}
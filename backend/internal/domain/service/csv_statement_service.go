@@ -0,0 +1,645 @@
+package service
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"backend/internal/domain/model"
+
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/width"
+)
+
+var (
+	// ErrInvalidCSVEncoding is returned for encodings other than UTF-8 and Shift_JIS
+	ErrInvalidCSVEncoding = errors.New("encoding must be utf-8 or shift_jis")
+	// ErrInvalidCSVDelimiter is returned for unsupported field delimiters
+	ErrInvalidCSVDelimiter = errors.New(`delimiter must be ",", ";", "|" or a tab`)
+	// ErrInvalidCSVDateFormat is returned for unsupported date formats
+	ErrInvalidCSVDateFormat = errors.New("unsupported date format")
+	// ErrInvalidCSVColumn is returned for negative column indexes or skipped row counts
+	ErrInvalidCSVColumn = errors.New("column indexes and skipped rows must not be negative")
+	// ErrCSVAmountColumns is returned unless a mapping reads amounts from either one column or debit and credit columns
+	ErrCSVAmountColumns = errors.New("map either an amount column or debit and credit columns")
+	// ErrInvalidDecimalSeparator is returned for decimal separators other than "." and ","
+	ErrInvalidDecimalSeparator = errors.New(`decimal separator must be "." or ","`)
+)
+
+// csvDateLayouts maps the supported date formats to Go layouts, which also accept single-digit days and months
+var csvDateLayouts = map[string]string{
+	"YYYY-MM-DD":  "2006-1-2",
+	"YYYY/MM/DD":  "2006/1/2",
+	"YYYY.MM.DD":  "2006.1.2",
+	"YYYYMMDD":    "20060102",
+	"YYYY年MM月DD日": "2006年1月2日",
+	"DD/MM/YYYY":  "2/1/2006",
+	"DD.MM.YYYY":  "2.1.2006",
+	"DD-MM-YYYY":  "2-1-2006",
+	"MM/DD/YYYY":  "1/2/2006",
+	"MM-DD-YYYY":  "1-2-2006",
+}
+
+// csvDateFormats is the order date formats are guessed in; day-first formats win over month-first
+// ones when both fit every date
+var csvDateFormats = []string{
+	"YYYY-MM-DD", "YYYY/MM/DD", "YYYY.MM.DD", "YYYYMMDD", "YYYY年MM月DD日",
+	"DD/MM/YYYY", "DD.MM.YYYY", "DD-MM-YYYY", "MM/DD/YYYY", "MM-DD-YYYY",
+}
+
+// csvDelimiters are the supported field delimiters in the order they are guessed in
+var csvDelimiters = []string{",", ";", "\t", "|"}
+
+// csvHeaderKeywords are the header words that identify the columns of a mapping, in the order
+// roles are matched; debit and credit come first as their headers often contain "amount" too.
+// Balance columns are recognized only so that they are not mistaken for amounts.
+var csvHeaderKeywords = []struct {
+	role     string
+	keywords []string
+}{
+	{"debit", []string{"debit", "withdrawal", "money out", "paid out", "出金", "引出", "支払", "soll"}},
+	{"credit", []string{"credit", "deposit", "money in", "paid in", "入金", "預入", "haben"}},
+	{"balance", []string{"balance", "残高", "saldo", "solde"}},
+	{"date", []string{"date", "日付", "取引日", "利用日", "年月日", "booking", "buchungstag", "datum", "fecha"}},
+	{"amount", []string{"amount", "金額", "betrag", "montant", "importe"}},
+	{"payee", []string{"payee", "description", "merchant", "name", "摘要", "内容", "店名", "beneficiary", "counterparty", "verwendungszweck"}},
+	{"memo", []string{"memo", "note", "reference", "メモ", "備考"}},
+}
+
+var (
+	commaDecimalPattern = regexp.MustCompile(`\d,\d{1,2}\)?-?$`)
+	pointDecimalPattern = regexp.MustCompile(`\d\.\d{1,2}\)?-?$`)
+)
+
+// guessSampleSize is how many data rows the mapping is guessed from
+const guessSampleSize = 50
+
+// CSVStatement is a CSV statement decoded and split into records
+type CSVStatement struct {
+	Header  []string   // Nil when the mapping has no header row
+	Records [][]string // Data rows in file order, blank rows dropped
+	Lines   []int      // 1-based line of each record
+}
+
+// ValidateCSVMapping checks a CSV mapping, filling in UTF-8, comma delimiters and "." decimal separators
+// when they are omitted
+func ValidateCSVMapping(mapping *model.CSVMapping) error {
+	if mapping.Encoding == "" {
+		mapping.Encoding = model.CSVEncodingUTF8
+	}
+	if mapping.Delimiter == "" {
+		mapping.Delimiter = ","
+	}
+	if mapping.DecimalSeparator == "" {
+		mapping.DecimalSeparator = "."
+	}
+
+	if mapping.Encoding != model.CSVEncodingUTF8 && mapping.Encoding != model.CSVEncodingShiftJIS {
+		return ErrInvalidCSVEncoding
+	}
+	if !slices.Contains(csvDelimiters, mapping.Delimiter) {
+		return ErrInvalidCSVDelimiter
+	}
+	if mapping.DecimalSeparator != "." && mapping.DecimalSeparator != "," {
+		return ErrInvalidDecimalSeparator
+	}
+	if _, ok := csvDateLayouts[mapping.DateFormat]; !ok {
+		return ErrInvalidCSVDateFormat
+	}
+	if mapping.SkipRows < 0 || mapping.DateColumn < 0 {
+		return ErrInvalidCSVColumn
+	}
+	for _, column := range []*int{mapping.AmountColumn, mapping.DebitColumn, mapping.CreditColumn, mapping.PayeeColumn, mapping.MemoColumn} {
+		if column != nil && *column < 0 {
+			return ErrInvalidCSVColumn
+		}
+	}
+	hasDebitOrCredit := mapping.DebitColumn != nil || mapping.CreditColumn != nil
+	if (mapping.AmountColumn != nil) == hasDebitOrCredit {
+		return ErrCSVAmountColumns
+	}
+	return nil
+}
+
+// ReadCSVStatement decodes a CSV statement and splits it into records following the dialect of the mapping
+func ReadCSVStatement(content []byte, mapping model.CSVMapping) (*CSVStatement, error) {
+	text, err := decodeCSVStatement(content, mapping.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	records, lines, err := readCSVRecords(text, mapping.Delimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	start := min(mapping.SkipRows, len(records))
+	statement := &CSVStatement{}
+	if mapping.HasHeader && start < len(records) {
+		statement.Header = records[start]
+		start++
+	}
+	for i := start; i < len(records); i++ {
+		if isBlankCSVRecord(records[i]) {
+			continue
+		}
+		statement.Records = append(statement.Records, records[i])
+		statement.Lines = append(statement.Lines, lines[i])
+	}
+	if len(statement.Records) == 0 {
+		return nil, ErrEmptyStatement
+	}
+	return statement, nil
+}
+
+// Rows reads the transactions of the statement with the mapping, which must be valid.
+// Rows that cannot be read are returned with Err set.
+func (s *CSVStatement) Rows(mapping model.CSVMapping) []model.StatementRow {
+	rows := make([]model.StatementRow, 0, len(s.Records))
+	for i, record := range s.Records {
+		row := readCSVRow(record, mapping)
+		row.Line = s.Lines[i]
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// ParseCSVStatement reads the transactions of a CSV statement with the mapping, which must be valid
+func ParseCSVStatement(content []byte, mapping model.CSVMapping) ([]model.StatementRow, error) {
+	statement, err := ReadCSVStatement(content, mapping)
+	if err != nil {
+		return nil, err
+	}
+	return statement.Rows(mapping), nil
+}
+
+// GuessCSVMapping infers the dialect and columns of a CSV statement: the encoding, the delimiter,
+// preamble lines and header, then each column from its header or, failing that, from its values.
+// The guess is a starting point for the user to correct; it may not pass ValidateCSVMapping.
+func GuessCSVMapping(content []byte) (model.CSVMapping, error) {
+	mapping := model.CSVMapping{Encoding: model.CSVEncodingUTF8, DecimalSeparator: "."}
+	if !utf8.Valid(content) {
+		mapping.Encoding = model.CSVEncodingShiftJIS
+	}
+	text, err := decodeCSVStatement(content, mapping.Encoding)
+	if err != nil {
+		return mapping, err
+	}
+	mapping.Delimiter = guessCSVDelimiter(text)
+	records, _, err := readCSVRecords(text, mapping.Delimiter)
+	if err != nil {
+		return mapping, err
+	}
+	if len(records) == 0 {
+		return mapping, ErrEmptyStatement
+	}
+
+	// Preamble lines, such as the account number, have fewer fields than the transactions
+	fieldCount := modalRecordWidth(records)
+	for mapping.SkipRows < len(records)-1 && len(records[mapping.SkipRows]) < fieldCount {
+		mapping.SkipRows++
+	}
+	data := records[mapping.SkipRows:]
+	if len(data) > 1 && !recordHasDate(data[0]) && recordHasDate(data[1]) {
+		mapping.HasHeader = true
+	}
+
+	columns := make(map[string]int)
+	if mapping.HasHeader {
+		columns = matchCSVHeader(data[0])
+		data = data[1:]
+	}
+	sample := data[:min(len(data), guessSampleSize)]
+	mapping.DecimalSeparator = guessDecimalSeparator(sample)
+
+	dateColumn, ok := columns["date"]
+	if !ok {
+		dateColumn = guessDateColumn(sample, fieldCount)
+	}
+	mapping.DateColumn = dateColumn
+	mapping.DateFormat = guessDateFormat(columnValues(sample, dateColumn))
+
+	debit, hasDebit := columns["debit"]
+	credit, hasCredit := columns["credit"]
+	amount, hasAmount := columns["amount"]
+	switch {
+	case hasDebit || hasCredit:
+		if hasDebit {
+			mapping.DebitColumn = &debit
+		}
+		if hasCredit {
+			mapping.CreditColumn = &credit
+		}
+	case hasAmount:
+		mapping.AmountColumn = &amount
+	default:
+		mapping.AmountColumn, mapping.DebitColumn, mapping.CreditColumn = guessAmountColumns(sample, fieldCount, mapping, columns)
+	}
+
+	if payee, ok := columns["payee"]; ok {
+		mapping.PayeeColumn = &payee
+	} else {
+		mapping.PayeeColumn = guessPayeeColumn(sample, fieldCount, mapping, columns)
+	}
+	if memo, ok := columns["memo"]; ok {
+		mapping.MemoColumn = &memo
+	}
+	return mapping, nil
+}
+
+// decodeCSVStatement converts a statement to UTF-8 text, dropping any byte order mark
+func decodeCSVStatement(content []byte, encoding model.CSVEncoding) (string, error) {
+	if encoding == model.CSVEncodingShiftJIS {
+		decoded, err := japanese.ShiftJIS.NewDecoder().Bytes(content)
+		if err != nil {
+			return "", fmt.Errorf("%w: %v", ErrMalformedStatement, err)
+		}
+		return string(decoded), nil
+	}
+	if !utf8.Valid(content) {
+		return "", fmt.Errorf("%w: not valid UTF-8, try shift_jis", ErrMalformedStatement)
+	}
+	return strings.TrimPrefix(string(content), "\uFEFF"), nil
+}
+
+// readCSVRecords splits text into records, tolerating stray quotes and rows of different lengths
+func readCSVRecords(text string, delimiter string) ([][]string, []int, error) {
+	reader := csv.NewReader(strings.NewReader(text))
+	reader.Comma, _ = utf8.DecodeRuneInString(delimiter)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	var records [][]string
+	var lines []int
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrMalformedStatement, err)
+		}
+		line, _ := reader.FieldPos(0)
+		records = append(records, record)
+		lines = append(lines, line)
+	}
+	return records, lines, nil
+}
+
+// readCSVRow reads one transaction from a record
+func readCSVRow(record []string, mapping model.CSVMapping) model.StatementRow {
+	var row model.StatementRow
+	if mapping.DateColumn >= len(record) {
+		row.Err = ErrMissingStatementColumn
+		return row
+	}
+	postedOn, err := parseCSVDate(record[mapping.DateColumn], mapping.DateFormat)
+	if err != nil {
+		row.Err = err
+		return row
+	}
+
+	var amount model.Decimal
+	if mapping.AmountColumn != nil {
+		value, ok, err := csvAmountCell(record, mapping.AmountColumn, mapping.DecimalSeparator)
+		if err != nil {
+			row.Err = err
+			return row
+		}
+		if !ok {
+			row.Err = ErrMissingStatementAmount
+			return row
+		}
+		amount = value
+	} else {
+		debit, hasDebit, err := csvAmountCell(record, mapping.DebitColumn, mapping.DecimalSeparator)
+		if err != nil {
+			row.Err = err
+			return row
+		}
+		credit, hasCredit, err := csvAmountCell(record, mapping.CreditColumn, mapping.DecimalSeparator)
+		if err != nil {
+			row.Err = err
+			return row
+		}
+		// Banks fill the unused column with zero as often as they leave it blank
+		hasDebit = hasDebit && debit.Sign() != 0
+		hasCredit = hasCredit && credit.Sign() != 0
+		switch {
+		case hasDebit && hasCredit:
+			row.Err = ErrDebitAndCredit
+			return row
+		case hasDebit:
+			amount = debit
+			if amount.Sign() > 0 {
+				amount = amount.Neg()
+			}
+		case hasCredit:
+			amount = credit
+		default:
+			row.Err = ErrMissingStatementAmount
+			return row
+		}
+	}
+	if mapping.NegateAmounts {
+		amount = amount.Neg()
+	}
+
+	row.PostedOn = postedOn
+	row.Amount = amount
+	row.Payee = csvTextCell(record, mapping.PayeeColumn)
+	row.Memo = csvTextCell(record, mapping.MemoColumn)
+	return row
+}
+
+// csvAmountCell parses the amount in an optional column; a nil column is blank
+func csvAmountCell(record []string, column *int, decimalSeparator string) (model.Decimal, bool, error) {
+	if column == nil {
+		return model.Decimal{}, false, nil
+	}
+	if *column >= len(record) {
+		return model.Decimal{}, false, ErrMissingStatementColumn
+	}
+	amount, ok, err := parseStatementAmount(record[*column], decimalSeparator)
+	if err != nil {
+		return model.Decimal{}, false, fmt.Errorf("%w: %q", err, record[*column])
+	}
+	return amount, ok, nil
+}
+
+// csvTextCell returns the text of an optional column, or "" when the row lacks it
+func csvTextCell(record []string, column *int) string {
+	if column == nil || *column >= len(record) {
+		return ""
+	}
+	return statementText(record[*column])
+}
+
+// parseCSVDate parses a date in one of the supported formats, ignoring any time of day after it
+func parseCSVDate(value string, format string) (time.Time, error) {
+	fields := strings.Fields(width.Fold.String(value))
+	if len(fields) == 0 {
+		return time.Time{}, ErrInvalidStatementDate
+	}
+	date, err := time.Parse(csvDateLayouts[format], fields[0])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidStatementDate, value)
+	}
+	return date, nil
+}
+
+// isBlankCSVRecord reports whether every field of the record is empty
+func isBlankCSVRecord(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// guessCSVDelimiter returns the delimiter splitting the first lines into the most fields consistently
+func guessCSVDelimiter(text string) string {
+	lines := strings.Split(text, "\n")
+	lines = lines[:min(len(lines), 20)]
+
+	best, bestScore := ",", 0
+	for _, delimiter := range csvDelimiters {
+		counts := make(map[int]int)
+		for _, line := range lines {
+			if n := strings.Count(line, delimiter); n > 0 {
+				counts[n]++
+			}
+		}
+		// Lines agreeing on the field count, weighted by the fields they have
+		for n, lineCount := range counts {
+			if score := n * lineCount * lineCount; score > bestScore {
+				best, bestScore = delimiter, score
+			}
+		}
+	}
+	return best
+}
+
+// modalRecordWidth returns the most common number of fields among the records, preferring wider records on ties
+func modalRecordWidth(records [][]string) int {
+	counts := make(map[int]int)
+	for _, record := range records {
+		counts[len(record)]++
+	}
+	width, widthCount := 0, 0
+	for n, count := range counts {
+		if count > widthCount || count == widthCount && n > width {
+			width, widthCount = n, count
+		}
+	}
+	return width
+}
+
+// recordHasDate reports whether any field of the record parses as a date
+func recordHasDate(record []string) bool {
+	for _, value := range record {
+		if guessDateFormat([]string{value}) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// matchCSVHeader maps roles to the first header column whose name contains one of their keywords
+func matchCSVHeader(header []string) map[string]int {
+	columns := make(map[string]int)
+	taken := make(map[int]bool)
+	for _, role := range csvHeaderKeywords {
+		for i, name := range header {
+			name = strings.ToLower(width.Fold.String(name))
+			if taken[i] || !containsAny(name, role.keywords) {
+				continue
+			}
+			columns[role.role] = i
+			taken[i] = true
+			break
+		}
+	}
+	return columns
+}
+
+// guessDecimalSeparator returns "," when amounts in the sample end in a comma and two digits rather than a point
+func guessDecimalSeparator(sample [][]string) string {
+	commas, points := 0, 0
+	for _, record := range sample {
+		for _, value := range record {
+			value = strings.TrimSpace(value)
+			if commaDecimalPattern.MatchString(value) {
+				commas++
+			}
+			if pointDecimalPattern.MatchString(value) {
+				points++
+			}
+		}
+	}
+	if commas > points {
+		return ","
+	}
+	return "."
+}
+
+// guessDateColumn returns the column holding the most dates, or 0 when none does
+func guessDateColumn(sample [][]string, fieldCount int) int {
+	best, bestCount := 0, 0
+	for column := range fieldCount {
+		count := 0
+		for _, value := range columnValues(sample, column) {
+			if guessDateFormat([]string{value}) != "" {
+				count++
+			}
+		}
+		if count > bestCount {
+			best, bestCount = column, count
+		}
+	}
+	return best
+}
+
+// guessDateFormat returns the first supported format that parses all the non-blank values,
+// or the one parsing the most of them; "" when none parses any
+func guessDateFormat(values []string) string {
+	best, bestCount := "", 0
+	for _, format := range csvDateFormats {
+		count, total := 0, 0
+		for _, value := range values {
+			if strings.TrimSpace(value) == "" {
+				continue
+			}
+			total++
+			if _, err := parseCSVDate(value, format); err == nil {
+				count++
+			}
+		}
+		if count > 0 && count == total {
+			return format
+		}
+		if count > bestCount {
+			best, bestCount = format, count
+		}
+	}
+	return best
+}
+
+// guessAmountColumns picks the amount columns among those whose values are all numbers. Two adjacent
+// numeric columns never filled on the same row are taken as debit and credit; otherwise the first
+// numeric column is the amount.
+func guessAmountColumns(sample [][]string, fieldCount int, mapping model.CSVMapping, columns map[string]int) (amount, debit, credit *int) {
+	var numeric []int
+	for column := range fieldCount {
+		if column == mapping.DateColumn || isMappedColumn(columns, column) {
+			continue
+		}
+		if isNumericColumn(columnValues(sample, column), mapping.DecimalSeparator) {
+			numeric = append(numeric, column)
+		}
+	}
+	if len(numeric) == 0 {
+		return nil, nil, nil
+	}
+
+	for i := 0; i+1 < len(numeric); i++ {
+		first, second := numeric[i], numeric[i+1]
+		if second == first+1 && isDebitCreditPair(sample, first, second, mapping.DecimalSeparator) {
+			return nil, &first, &second
+		}
+	}
+	return &numeric[0], nil, nil
+}
+
+// isNumericColumn reports whether the column has amounts and nothing but amounts
+func isNumericColumn(values []string, decimalSeparator string) bool {
+	found := false
+	for _, value := range values {
+		_, ok, err := parseStatementAmount(value, decimalSeparator)
+		if err != nil {
+			return false
+		}
+		found = found || ok
+	}
+	return found
+}
+
+// isDebitCreditPair reports whether every row fills exactly one of the two columns with a non-zero amount
+func isDebitCreditPair(sample [][]string, first, second int, decimalSeparator string) bool {
+	for _, record := range sample {
+		a, hasA, _ := csvAmountCell(record, &first, decimalSeparator)
+		b, hasB, _ := csvAmountCell(record, &second, decimalSeparator)
+		if (hasA && a.Sign() != 0) == (hasB && b.Sign() != 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// guessPayeeColumn returns the unmapped text column with the longest values on average
+func guessPayeeColumn(sample [][]string, fieldCount int, mapping model.CSVMapping, columns map[string]int) *int {
+	mapped := map[int]bool{mapping.DateColumn: true}
+	for _, column := range []*int{mapping.AmountColumn, mapping.DebitColumn, mapping.CreditColumn} {
+		if column != nil {
+			mapped[*column] = true
+		}
+	}
+
+	var best *int
+	bestLength := 0
+	for column := range fieldCount {
+		if mapped[column] || isMappedColumn(columns, column) {
+			continue
+		}
+		values := columnValues(sample, column)
+		if isNumericColumn(values, mapping.DecimalSeparator) {
+			continue
+		}
+		length := 0
+		for _, value := range values {
+			length += utf8.RuneCountInString(strings.TrimSpace(value))
+		}
+		if length > bestLength {
+			best, bestLength = &column, length
+		}
+	}
+	return best
+}
+
+// isMappedColumn reports whether a header already assigned the column a role
+func isMappedColumn(columns map[string]int, column int) bool {
+	for _, mapped := range columns {
+		if mapped == column {
+			return true
+		}
+	}
+	return false
+}
+
+// columnValues returns the values of a column, skipping records that lack it
+func columnValues(records [][]string, column int) []string {
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		if column < len(record) {
+			values = append(values, record[column])
+		}
+	}
+	return values
+}
+
+// containsAny reports whether s contains any of the substrings
+func containsAny(s string, substrings []string) bool {
+	for _, substring := range substrings {
+		if strings.Contains(s, substring) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,287 @@
+package service
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"backend/internal/domain/model"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	content, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return content
+}
+
+func column(i int) *int {
+	return &i
+}
+
+func date(s string) time.Time {
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// wantRow is the part of a statement row the parser tests compare
+type wantRow struct {
+	line       int
+	postedOn   string
+	amount     string
+	payee      string
+	memo       string
+	externalID string
+	iban       string
+	endToEndID string
+	err        error
+}
+
+func checkRows(t *testing.T, got []model.StatementRow, want []wantRow) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d rows, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		row := got[i]
+		if w.err != nil {
+			if !errors.Is(row.Err, w.err) {
+				t.Errorf("row %d error = %v, want %v", i, row.Err, w.err)
+			}
+			continue
+		}
+		if row.Err != nil {
+			t.Errorf("row %d: %v", i, row.Err)
+			continue
+		}
+		if w.line != 0 && row.Line != w.line {
+			t.Errorf("row %d line = %d, want %d", i, row.Line, w.line)
+		}
+		if !row.PostedOn.Equal(date(w.postedOn)) {
+			t.Errorf("row %d posted on %s, want %s", i, row.PostedOn.Format("2006-01-02"), w.postedOn)
+		}
+		if row.Amount.String() != w.amount {
+			t.Errorf("row %d amount = %s, want %s", i, row.Amount, w.amount)
+		}
+		if row.Payee != w.payee || row.Memo != w.memo {
+			t.Errorf("row %d payee, memo = %q, %q, want %q, %q", i, row.Payee, row.Memo, w.payee, w.memo)
+		}
+		if row.ExternalID != w.externalID || row.CounterpartyIBAN != w.iban || row.EndToEndID != w.endToEndID {
+			t.Errorf("row %d external ID, IBAN, end-to-end ID = %q, %q, %q, want %q, %q, %q", i,
+				row.ExternalID, row.CounterpartyIBAN, row.EndToEndID, w.externalID, w.iban, w.endToEndID)
+		}
+	}
+}
+
+func TestGuessCSVMapping(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    model.CSVMapping
+	}{
+		{
+			fixture: "bank_utf8.csv",
+			want: model.CSVMapping{
+				Encoding: model.CSVEncodingUTF8, Delimiter: ",", DecimalSeparator: ".", SkipRows: 1, HasHeader: true,
+				DateColumn: 0, DateFormat: "YYYY-MM-DD", AmountColumn: column(2), PayeeColumn: column(1), MemoColumn: column(4),
+			},
+		},
+		{
+			fixture: "bank_sjis.csv",
+			want: model.CSVMapping{
+				Encoding: model.CSVEncodingShiftJIS, Delimiter: ",", DecimalSeparator: ".", HasHeader: true,
+				DateColumn: 0, DateFormat: "YYYY/MM/DD", DebitColumn: column(2), CreditColumn: column(3), PayeeColumn: column(1),
+			},
+		},
+		{
+			fixture: "bank_semicolon.csv",
+			want: model.CSVMapping{
+				Encoding: model.CSVEncodingUTF8, Delimiter: ";", DecimalSeparator: ",", HasHeader: true,
+				DateColumn: 0, DateFormat: "DD.MM.YYYY", AmountColumn: column(2), PayeeColumn: column(1),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			got, err := GuessCSVMapping(readFixture(t, tt.fixture))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := ValidateCSVMapping(&got); err != nil {
+				t.Fatalf("guessed mapping is invalid: %v", err)
+			}
+			checkMapping(t, got, tt.want)
+		})
+	}
+}
+
+func checkMapping(t *testing.T, got, want model.CSVMapping) {
+	t.Helper()
+	if got.Encoding != want.Encoding || got.Delimiter != want.Delimiter || got.DecimalSeparator != want.DecimalSeparator {
+		t.Errorf("dialect = %s %q %q, want %s %q %q",
+			got.Encoding, got.Delimiter, got.DecimalSeparator, want.Encoding, want.Delimiter, want.DecimalSeparator)
+	}
+	if got.SkipRows != want.SkipRows || got.HasHeader != want.HasHeader {
+		t.Errorf("skip rows, header = %d, %t, want %d, %t", got.SkipRows, got.HasHeader, want.SkipRows, want.HasHeader)
+	}
+	if got.DateColumn != want.DateColumn || got.DateFormat != want.DateFormat {
+		t.Errorf("date = column %d %s, want column %d %s", got.DateColumn, got.DateFormat, want.DateColumn, want.DateFormat)
+	}
+	for _, c := range []struct {
+		name      string
+		got, want *int
+	}{
+		{"amount", got.AmountColumn, want.AmountColumn},
+		{"debit", got.DebitColumn, want.DebitColumn},
+		{"credit", got.CreditColumn, want.CreditColumn},
+		{"payee", got.PayeeColumn, want.PayeeColumn},
+		{"memo", got.MemoColumn, want.MemoColumn},
+	} {
+		if (c.got == nil) != (c.want == nil) || c.got != nil && *c.got != *c.want {
+			t.Errorf("%s column = %v, want %v", c.name, deref(c.got), deref(c.want))
+		}
+	}
+}
+
+func deref(column *int) any {
+	if column == nil {
+		return nil
+	}
+	return *column
+}
+
+func TestParseCSVStatement(t *testing.T) {
+	tests := []struct {
+		fixture string
+		mapping model.CSVMapping
+		want    []wantRow
+	}{
+		{
+			fixture: "bank_utf8.csv",
+			mapping: model.CSVMapping{
+				SkipRows: 1, HasHeader: true, DateFormat: "YYYY-MM-DD",
+				AmountColumn: column(2), PayeeColumn: column(1), MemoColumn: column(4),
+			},
+			want: []wantRow{
+				{line: 3, postedOn: "2026-03-01", amount: "-4.50", payee: "STARBUCKS STORE 1234", memo: "card 1234"},
+				{line: 4, postedOn: "2026-03-02", amount: "1250.00", payee: "ACME PAYROLL", memo: "March salary"},
+				{line: 6, postedOn: "2026-03-03", amount: "-32.10", payee: "CITY WATER"},
+			},
+		},
+		{
+			fixture: "bank_sjis.csv",
+			mapping: model.CSVMapping{
+				Encoding: model.CSVEncodingShiftJIS, HasHeader: true, DateFormat: "YYYY/MM/DD",
+				DebitColumn: column(2), CreditColumn: column(3), PayeeColumn: column(1),
+			},
+			want: []wantRow{
+				{postedOn: "2026-03-01", amount: "-1200", payee: "セブンイレブン"},
+				{postedOn: "2026-03-05", amount: "250000", payee: "給与"},
+				{postedOn: "2026-03-06", amount: "-8420", payee: "電気料金"},
+			},
+		},
+		{
+			fixture: "bank_semicolon.csv",
+			mapping: model.CSVMapping{
+				Delimiter: ";", DecimalSeparator: ",", HasHeader: true, DateFormat: "DD.MM.YYYY",
+				AmountColumn: column(2), PayeeColumn: column(1), NegateAmounts: true,
+			},
+			want: []wantRow{
+				{postedOn: "2026-03-01", amount: "23.45", payee: "REWE Markt"},
+				{postedOn: "2026-03-02", amount: "-2100.00", payee: "Gehalt"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			if err := ValidateCSVMapping(&tt.mapping); err != nil {
+				t.Fatal(err)
+			}
+			rows, err := ParseCSVStatement(readFixture(t, tt.fixture), tt.mapping)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkRows(t, rows, tt.want)
+		})
+	}
+}
+
+func TestParseCSVStatementMalformed(t *testing.T) {
+	mapping := model.CSVMapping{HasHeader: true, DateFormat: "YYYY-MM-DD", AmountColumn: column(1), PayeeColumn: column(2)}
+	if err := ValidateCSVMapping(&mapping); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{name: "empty", content: "", wantErr: ErrEmptyStatement},
+		{name: "header only", content: "Date,Amount,Payee\n", wantErr: ErrEmptyStatement},
+		{name: "not UTF-8", content: "Date,Amount,Payee\n2026-03-01,1,\xff\xfe\n", wantErr: ErrMalformedStatement},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseCSVStatement([]byte(tt.content), mapping); !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// Unreadable rows are reported one by one, without failing the statement
+	content := "Date,Amount,Payee\n" +
+		"2026-03-01,-4.50,Coffee\n" +
+		"03/02/2026,-1.00,Wrong date format\n" +
+		"2026-03-03,abc,Not a number\n" +
+		"2026-03-04,,No amount\n" +
+		"2026-03-05\n"
+	rows, err := ParseCSVStatement([]byte(content), mapping)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkRows(t, rows, []wantRow{
+		{postedOn: "2026-03-01", amount: "-4.50", payee: "Coffee"},
+		{err: ErrInvalidStatementDate},
+		{err: ErrInvalidStatementAmount},
+		{err: ErrMissingStatementAmount},
+		{err: ErrMissingStatementColumn},
+	})
+
+	debitCredit := model.CSVMapping{DateFormat: "YYYY-MM-DD", DebitColumn: column(1), CreditColumn: column(2)}
+	if err := ValidateCSVMapping(&debitCredit); err != nil {
+		t.Fatal(err)
+	}
+	rows, err = ParseCSVStatement([]byte("2026-03-01,10.00,5.00\n"), debitCredit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkRows(t, rows, []wantRow{{err: ErrDebitAndCredit}})
+}
+
+func TestValidateCSVMapping(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping model.CSVMapping
+		wantErr error
+	}{
+		{name: "no amount column", mapping: model.CSVMapping{DateFormat: "YYYY-MM-DD"}, wantErr: ErrCSVAmountColumns},
+		{name: "amount and debit", mapping: model.CSVMapping{DateFormat: "YYYY-MM-DD", AmountColumn: column(1), DebitColumn: column(2)}, wantErr: ErrCSVAmountColumns},
+		{name: "unknown date format", mapping: model.CSVMapping{DateFormat: "DD/MM/YY", AmountColumn: column(1)}, wantErr: ErrInvalidCSVDateFormat},
+		{name: "negative column", mapping: model.CSVMapping{DateFormat: "YYYY-MM-DD", AmountColumn: column(-1)}, wantErr: ErrInvalidCSVColumn},
+		{name: "delimiter", mapping: model.CSVMapping{Delimiter: ":", DateFormat: "YYYY-MM-DD", AmountColumn: column(1)}, wantErr: ErrInvalidCSVDelimiter},
+		{name: "encoding", mapping: model.CSVMapping{Encoding: "latin1", DateFormat: "YYYY-MM-DD", AmountColumn: column(1)}, wantErr: ErrInvalidCSVEncoding},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateCSVMapping(&tt.mapping); !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
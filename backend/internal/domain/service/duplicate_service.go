@@ -0,0 +1,217 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"backend/internal/domain/model"
+)
+
+const (
+	// DuplicateMatchWindowDays is how far apart a statement row and a transaction it duplicates may be posted;
+	// banks book card payments a few days after they were entered by hand
+	DuplicateMatchWindowDays = 5
+	// LikelyDuplicateScore is the score from which a match is marked as a likely duplicate
+	LikelyDuplicateScore = 80
+	// minDuplicateScore is the lowest score a match is reported with; below it, matches are mostly coincidences
+	minDuplicateScore = 60
+	// maxDuplicateMatches is how many matches are reported per row
+	maxDuplicateMatches = 3
+)
+
+// StatementRowFingerprints identifies each row of a statement by its date, amount, description, memo and
+// external ID, so that the same row is recognized in a later file covering the same days. Amounts are
+// normalized, as files differ in how many decimals they write, e.g. 12.5 in one and 12.50 in the next.
+// Identical rows, e.g. two coffees on one day, are told apart by how many of them precede the row. Rows that
+// cannot be read get an empty fingerprint.
+func StatementRowFingerprints(rows []model.StatementRow) []string {
+	fingerprints := make([]string, len(rows))
+	occurrences := make(map[string]int)
+	for i, row := range rows {
+		if row.Err != nil {
+			continue
+		}
+		key := strings.Join([]string{
+			row.PostedOn.Format("2006-01-02"),
+			row.Amount.Normalize().String(),
+			row.Payee,
+			row.Memo,
+			row.ExternalID,
+		}, "\x00")
+		occurrences[key]++
+		sum := sha256.Sum256([]byte(key + "\x00" + strconv.Itoa(occurrences[key])))
+		fingerprints[i] = hex.EncodeToString(sum[:16])
+	}
+	return fingerprints
+}
+
+// ScoreDuplicate rates from 0 to 100 how likely a statement row is an existing transaction of its account again.
+// A matching external ID scores 100 and a different one 0. Otherwise the amounts must be equal: that makes
+// half the score, how close the dates are within DuplicateMatchWindowDays a quarter, and how alike the
+// descriptions are the last quarter.
+func ScoreDuplicate(row model.StatementRow, transaction *model.Transaction) int {
+	if row.ExternalID != "" && transaction.ExternalID != nil {
+		if *transaction.ExternalID == row.ExternalID {
+			return 100
+		}
+		return 0
+	}
+	if row.Amount.Cmp(transaction.Amount.Decimal()) != 0 {
+		return 0
+	}
+	days := math.Round(math.Abs(row.PostedOn.Sub(transaction.PostedOn).Hours()) / 24)
+	if days > DuplicateMatchWindowDays {
+		return 0
+	}
+
+	dateScore := 1 - days/(DuplicateMatchWindowDays+1)
+	textScore := max(
+		descriptionSimilarity(row.Payee, transaction.Payee),
+		descriptionSimilarity(row.Payee+" "+row.Memo, transaction.Payee+" "+transaction.Memo),
+	)
+	return int(math.Round(50 + 25*dateScore + 25*textScore))
+}
+
+// MatchDuplicates finds, for each statement row, the existing transactions of its account it may duplicate,
+// best first and at most maxDuplicateMatches of them. Pairs scoring at least LikelyDuplicateScore are marked
+// likely, best pairs first, so that no transaction is the likely duplicate of two rows. Callers pass the
+// transactions posted within DuplicateMatchWindowDays of the rows.
+func MatchDuplicates(rows []model.StatementRow, transactions []*model.Transaction) [][]model.DuplicateMatch {
+	type pair struct {
+		row   int
+		match model.DuplicateMatch
+	}
+	var pairs []pair
+	for i, row := range rows {
+		if row.Err != nil {
+			continue
+		}
+		for _, transaction := range transactions {
+			if score := ScoreDuplicate(row, transaction); score >= minDuplicateScore {
+				pairs = append(pairs, pair{row: i, match: model.DuplicateMatch{TransactionID: transaction.ID, Score: score}})
+			}
+		}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool {
+		if pairs[i].match.Score != pairs[j].match.Score {
+			return pairs[i].match.Score > pairs[j].match.Score
+		}
+		if pairs[i].row != pairs[j].row {
+			return pairs[i].row < pairs[j].row
+		}
+		return pairs[i].match.TransactionID < pairs[j].match.TransactionID
+	})
+
+	matchedRows := make(map[int]bool)
+	matchedTransactions := make(map[int]bool)
+	for i := range pairs {
+		p := &pairs[i]
+		if p.match.Score < LikelyDuplicateScore || matchedRows[p.row] || matchedTransactions[p.match.TransactionID] {
+			continue
+		}
+		p.match.Likely = true
+		matchedRows[p.row] = true
+		matchedTransactions[p.match.TransactionID] = true
+	}
+
+	matches := make([][]model.DuplicateMatch, len(rows))
+	for _, p := range pairs {
+		if p.match.Likely {
+			// The likely match leads even when a better one went to another row
+			matches[p.row] = append([]model.DuplicateMatch{p.match}, matches[p.row]...)
+		} else {
+			matches[p.row] = append(matches[p.row], p.match)
+		}
+	}
+	for i := range matches {
+		matches[i] = matches[i][:min(len(matches[i]), maxDuplicateMatches)]
+	}
+	return matches
+}
+
+// MergeStatementRow fills in a transaction from a statement row the user confirmed as its duplicate: the bank's
+// identifiers and value date where the transaction has none, and the cleared status. What was entered by hand,
+// such as the description and category, is kept.
+func MergeStatementRow(transaction *model.Transaction, row model.StatementRow) {
+	if transaction.ExternalID == nil && row.ExternalID != "" {
+		externalID := row.ExternalID
+		transaction.ExternalID = &externalID
+	}
+	if transaction.ValueDate == nil {
+		transaction.ValueDate = row.ValueDate
+	}
+	if transaction.CounterpartyIBAN == "" {
+		transaction.CounterpartyIBAN = row.CounterpartyIBAN
+	}
+	if transaction.EndToEndID == "" {
+		transaction.EndToEndID = row.EndToEndID
+	}
+	if transaction.Status == model.TransactionStatusUncleared {
+		transaction.Status = model.TransactionStatusCleared
+	}
+}
+
+// descriptionSimilarity rates from 0 to 1 how alike two descriptions are after normalization. Shared words count
+// against the shorter description, so "Starbucks" matches "STARBUCKS STORE 1234" fully; descriptions without
+// spaces between words, as in Japanese, are compared by their character pairs instead.
+func descriptionSimilarity(a, b string) float64 {
+	a, b = NormalizePayeeDescription(a), NormalizePayeeDescription(b)
+	if a == "" || b == "" {
+		return 0
+	}
+	if a == b {
+		return 1
+	}
+	return max(wordOverlap(a, b), bigramSimilarity(a, b))
+}
+
+// wordOverlap returns the share of the words of the shorter text that the other text has too
+func wordOverlap(a, b string) float64 {
+	wordsA, wordsB := strings.Fields(a), strings.Fields(b)
+	counts := make(map[string]int, len(wordsB))
+	for _, word := range wordsB {
+		counts[word]++
+	}
+	shared := 0
+	for _, word := range wordsA {
+		if counts[word] > 0 {
+			counts[word]--
+			shared++
+		}
+	}
+	return float64(shared) / float64(min(len(wordsA), len(wordsB)))
+}
+
+// bigramSimilarity returns the Dice coefficient of the character pairs of two texts, ignoring spaces
+func bigramSimilarity(a, b string) float64 {
+	bigramsA, bigramsB := bigrams(a), bigrams(b)
+	counts := make(map[string]int, len(bigramsB))
+	for _, bigram := range bigramsB {
+		counts[bigram]++
+	}
+	shared := 0
+	for _, bigram := range bigramsA {
+		if counts[bigram] > 0 {
+			counts[bigram]--
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(bigramsA)+len(bigramsB))
+}
+
+// bigrams returns the pairs of adjacent characters of a text without its spaces; a single character is its own pair
+func bigrams(text string) []string {
+	runes := []rune(strings.ReplaceAll(text, " ", ""))
+	if len(runes) < 2 {
+		return []string{string(runes)}
+	}
+	pairs := make([]string, 0, len(runes)-1)
+	for i := 0; i+1 < len(runes); i++ {
+		pairs = append(pairs, string(runes[i:i+2]))
+	}
+	return pairs
+}
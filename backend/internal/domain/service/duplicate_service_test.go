@@ -0,0 +1,34 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"backend/internal/domain/model"
+)
+
+func TestStatementRowFingerprints(t *testing.T) {
+	row := func(amount, payee string) model.StatementRow {
+		return model.StatementRow{PostedOn: date("2026-03-01"), Amount: mustDecimal(t, amount), Payee: payee}
+	}
+	fingerprints := StatementRowFingerprints([]model.StatementRow{
+		row("-4.50", "Coffee"),
+		row("-4.50", "Coffee"),
+		row("-4.50", "Tea"),
+		{Err: errors.New("unreadable")},
+	})
+	if fingerprints[0] == "" || fingerprints[0] == fingerprints[1] || fingerprints[0] == fingerprints[2] {
+		t.Errorf("fingerprints = %q, want a distinct fingerprint for each row, identical ones included", fingerprints)
+	}
+	if fingerprints[3] != "" {
+		t.Errorf("fingerprint of the unreadable row = %q, want none", fingerprints[3])
+	}
+
+	// The same rows written with other decimals, as in an OFX file after a CSV export of the same days
+	again := StatementRowFingerprints([]model.StatementRow{row("-4.5", "Coffee"), row("-4.500", "Coffee"), row("-4.5", "Tea")})
+	for i, fingerprint := range again {
+		if fingerprint != fingerprints[i] {
+			t.Errorf("fingerprint %d = %s with other decimals, want %s", i, fingerprint, fingerprints[i])
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"backend/internal/domain/model"
+)
+
+var (
+	// ErrJournalEntryTooFewPostings is returned for journal entries with fewer than two postings
+	ErrJournalEntryTooFewPostings = errors.New("journal entry needs at least two postings")
+	// ErrUnbalancedJournalEntry is returned when the debits of a journal entry do not equal its credits
+	ErrUnbalancedJournalEntry = errors.New("journal entry debits do not equal credits")
+)
+
+// ValidateJournalEntry checks that an entry has at least two postings and that they sum to zero in every currency
+func ValidateJournalEntry(entry *model.JournalEntry) error {
+	if len(entry.Postings) < 2 {
+		return ErrJournalEntryTooFewPostings
+	}
+
+	totals := make(map[string]model.Money)
+	for _, posting := range entry.Postings {
+		total, ok := totals[posting.Amount.Currency()]
+		if !ok {
+			totals[posting.Amount.Currency()] = posting.Amount
+			continue
+		}
+		sum, err := total.Add(posting.Amount)
+		if err != nil {
+			return err
+		}
+		totals[posting.Amount.Currency()] = sum
+	}
+	for currency, total := range totals {
+		if !total.IsZero() {
+			return fmt.Errorf("%w: off by %s %s", ErrUnbalancedJournalEntry, total.Amount(), currency)
+		}
+	}
+	return nil
+}
+
+// BalancingAccountType returns the nominal account that balances a transaction amount:
+// money leaving an account is an expense and money coming in is income
+func BalancingAccountType(amount model.Money) model.AccountType {
+	if amount.IsNegative() {
+		return model.AccountTypeExpense
+	}
+	return model.AccountTypeIncome
+}
+
+// TransactionJournalEntry returns the journal entry of a transaction: the amount is posted to the
+// transaction's account and each split, or the whole amount, is balanced against the income or
+// expense account of balancingAccountIDs chosen by BalancingAccountType
+func TransactionJournalEntry(transaction *model.Transaction, balancingAccountIDs map[model.AccountType]int) *model.JournalEntry {
+	transactionID := transaction.ID
+	entry := &model.JournalEntry{
+		WorkspaceID:   transaction.WorkspaceID,
+		TransactionID: &transactionID,
+		PostedOn:      transaction.PostedOn,
+		Memo:          transaction.Memo,
+		Postings: []*model.Posting{
+			{AccountID: transaction.AccountID, Amount: transaction.Amount},
+		},
+	}
+	for _, allocation := range TransactionAllocations(transaction) {
+		entry.Postings = append(entry.Postings, &model.Posting{
+			AccountID: balancingAccountIDs[BalancingAccountType(allocation.Amount)],
+			Amount:    allocation.Amount.Neg(),
+		})
+	}
+	return entry
+}
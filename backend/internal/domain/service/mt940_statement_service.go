@@ -0,0 +1,362 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"backend/internal/domain/model"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+var (
+	// mt940FieldPattern matches the tag starting a field of an MT940 message, such as :61: or :60F:
+	mt940FieldPattern = regexp.MustCompile(`^:(\d{2}[A-Z]?):`)
+	// mt940StatementPattern matches the opening balance field every MT940 statement has
+	mt940StatementPattern = regexp.MustCompile(`(?m)^:60[FM]:`)
+	// sepaRemittancePattern matches the keywords dividing the remittance information of SEPA payments in German :86: fields
+	sepaRemittancePattern = regexp.MustCompile(`(EREF|KREF|MREF|CRED|DEBT|COAM|OAMT|SVWZ|ABWA|ABWE)\+`)
+	// mt940SlashCodePattern matches the codes dividing /CODE/value style :86: fields of Dutch and other banks
+	mt940SlashCodePattern = regexp.MustCompile(`/(TRTP|IBAN|BIC|NAME|REMI|EREF|MARF|CSID|ORDP|BENM|CNTP|ADDR|PREF|RTRN|SWOC|ISDT)/`)
+)
+
+// mt940Field is a field of an MT940 message with its continuation lines
+type mt940Field struct {
+	tag   string
+	value string
+	line  int // 1-based line of the tag
+}
+
+// IsMT940Statement reports whether content looks like a SWIFT MT940 statement
+func IsMT940Statement(content []byte) bool {
+	head := content[:min(len(content), 4096)]
+	return mt940StatementPattern.Match(head) && (strings.HasPrefix(string(head), ":20:") || strings.Contains(string(head), "\n:20:"))
+}
+
+// ParseMT940Statement reads a SWIFT MT940 customer statement. Each :61: statement line becomes a row dated by
+// its booking date and identified by the bank reference, with the counterparty and remittance information of
+// the :86: field that follows it. Several messages of the same account, e.g. one per day or page, are read as
+// one, and the rows must add up from the :60F: opening to the :62F: closing balance.
+func ParseMT940Statement(content []byte) (*model.Statement, error) {
+	text, err := decodeMT940Statement(content)
+	if err != nil {
+		return nil, err
+	}
+	fields := readMT940Fields(text)
+
+	var accounts []string
+	var statements []*model.Statement
+	var statement *model.Statement
+	for i, field := range fields {
+		if field.tag == "20" {
+			statement = &model.Statement{}
+			statements = append(statements, statement)
+			accounts = append(accounts, "")
+			continue
+		}
+		if statement == nil {
+			return nil, fmt.Errorf("%w: field :%s: on line %d comes before any :20: field", ErrMalformedStatement, field.tag, field.line)
+		}
+
+		switch field.tag {
+		case "25":
+			accounts[len(accounts)-1] = strings.TrimSpace(field.value)
+		case "60F", "60M":
+			balance, currency, err := parseMT940Balance(field.value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid opening balance on line %d", ErrMalformedStatement, field.line)
+			}
+			statement.OpeningBalance = &balance
+			statement.Currency = currency
+		case "62F", "62M":
+			balance, _, err := parseMT940Balance(field.value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid closing balance on line %d", ErrMalformedStatement, field.line)
+			}
+			statement.ClosingBalance = &balance
+		case "61":
+			row := parseMT940StatementLine(field)
+			if row.Err == nil {
+				if i+1 < len(fields) && fields[i+1].tag == "86" {
+					applyMT940Information(&row, fields[i+1].value)
+				}
+				row = finishStatementRow(row)
+			}
+			statement.Rows = append(statement.Rows, row)
+		}
+	}
+	if len(statements) == 0 {
+		return nil, fmt.Errorf("%w: no MT940 statement", ErrMalformedStatement)
+	}
+	return joinStatements(accounts, statements)
+}
+
+// decodeMT940Statement converts an MT940 file to text. Files that are not UTF-8 are decoded as Windows-1252,
+// a superset of the ISO-8859-1 that banks use for accented names.
+func decodeMT940Statement(content []byte) (string, error) {
+	if utf8.Valid(content) {
+		return strings.TrimPrefix(string(content), "\uFEFF"), nil
+	}
+	decoded, err := charmap.Windows1252.NewDecoder().Bytes(content)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrMalformedStatement, err)
+	}
+	return string(decoded), nil
+}
+
+// readMT940Fields splits messages into fields, leaving out the SWIFT header and trailer blocks
+func readMT940Fields(text string) []mt940Field {
+	var fields []mt940Field
+	for i, line := range strings.Split(text, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if index := strings.Index(line, "{4:"); index >= 0 {
+			line = line[index+3:]
+		}
+		if match := mt940FieldPattern.FindStringSubmatch(line); match != nil {
+			fields = append(fields, mt940Field{tag: match[1], value: line[len(match[0]):], line: i + 1})
+			continue
+		}
+		if line == "" || line == "-" || strings.HasPrefix(line, "-}") || strings.HasPrefix(line, "{") {
+			continue
+		}
+		if len(fields) > 0 {
+			fields[len(fields)-1].value += "\n" + line
+		}
+	}
+	return fields
+}
+
+// parseMT940Balance reads a balance field: debit/credit mark, date YYMMDD, currency and amount, e.g. C240131EUR1234,56
+func parseMT940Balance(value string) (model.StatementBalance, string, error) {
+	value = strings.TrimSpace(value)
+	if len(value) < 11 {
+		return model.StatementBalance{}, "", ErrInvalidStatementAmount
+	}
+	asOf, err := time.Parse("060102", value[1:7])
+	if err != nil {
+		return model.StatementBalance{}, "", ErrInvalidStatementDate
+	}
+	amount, err := parseMT940Amount(value[10:])
+	if err != nil {
+		return model.StatementBalance{}, "", err
+	}
+	switch value[0] {
+	case 'C':
+	case 'D':
+		amount = amount.Neg()
+	default:
+		return model.StatementBalance{}, "", ErrInvalidStatementAmount
+	}
+	return model.StatementBalance{Amount: amount, AsOf: asOf}, strings.ToUpper(value[7:10]), nil
+}
+
+// parseMT940StatementLine reads a :61: field: value date YYMMDD, optional booking date MMDD, debit/credit mark,
+// optional funds code, amount, transaction type, customer reference and optional bank reference after //
+func parseMT940StatementLine(field mt940Field) model.StatementRow {
+	row := model.StatementRow{Line: field.line}
+	value, _, _ := strings.Cut(field.value, "\n")
+
+	if len(value) < 6 {
+		row.Err = ErrInvalidStatementDate
+		return row
+	}
+	valueDate, err := time.Parse("060102", value[:6])
+	if err != nil {
+		row.Err = ErrInvalidStatementDate
+		return row
+	}
+	rest := value[6:]
+	postedOn := valueDate
+	if len(rest) >= 4 && isDigits(rest[:4]) {
+		if postedOn, err = mt940BookingDate(valueDate, rest[:4]); err != nil {
+			row.Err = err
+			return row
+		}
+		rest = rest[4:]
+	}
+
+	negative := false
+	switch {
+	case strings.HasPrefix(rest, "RC"): // Reversal of a credit
+		negative = true
+		rest = rest[2:]
+	case strings.HasPrefix(rest, "RD"): // Reversal of a debit
+		rest = rest[2:]
+	case strings.HasPrefix(rest, "C"):
+		rest = rest[1:]
+	case strings.HasPrefix(rest, "D"):
+		negative = true
+		rest = rest[1:]
+	default:
+		row.Err = ErrInvalidStatementAmount
+		return row
+	}
+	if rest != "" && rest[0] >= 'A' && rest[0] <= 'Z' {
+		// Funds code, the third letter of the currency
+		rest = rest[1:]
+	}
+	end := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != ',' })
+	if end < 0 {
+		end = len(rest)
+	}
+	amount, err := parseMT940Amount(rest[:end])
+	if err != nil {
+		row.Err = err
+		return row
+	}
+	if negative {
+		amount = amount.Neg()
+	}
+
+	// Transaction type such as NTRF, then the customer reference and the bank reference
+	rest = rest[end:]
+	if _, bankReference, ok := strings.Cut(rest, "//"); ok {
+		if bankReference = strings.TrimSpace(bankReference); !strings.EqualFold(bankReference, "NONREF") {
+			row.ExternalID = bankReference
+		}
+	}
+
+	row.PostedOn = postedOn
+	row.ValueDate = &valueDate
+	row.Amount = amount
+	return row
+}
+
+// mt940BookingDate returns the booking date MMDD of a statement line, in the year of its value date unless
+// the two dates straddle the turn of the year
+func mt940BookingDate(valueDate time.Time, monthDay string) (time.Time, error) {
+	month, err := strconv.Atoi(monthDay[:2])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidStatementDate, monthDay)
+	}
+	day, err := strconv.Atoi(monthDay[2:])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidStatementDate, monthDay)
+	}
+	year := valueDate.Year()
+	switch {
+	case valueDate.Month() == time.December && month == 1:
+		year++
+	case valueDate.Month() == time.January && month == 12:
+		year--
+	}
+
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if date.Month() != time.Month(month) || date.Day() != day {
+		return time.Time{}, ErrInvalidStatementDate
+	}
+	return date, nil
+}
+
+// parseMT940Amount reads an amount with a decimal comma, e.g. 1234,56 or 1234,
+func parseMT940Amount(value string) (model.Decimal, error) {
+	value = strings.TrimSuffix(strings.TrimSpace(value), ",")
+	amount, err := model.ParseDecimal(strings.Replace(value, ",", ".", 1))
+	if err != nil {
+		return model.Decimal{}, ErrInvalidStatementAmount
+	}
+	return amount, nil
+}
+
+// applyMT940Information reads the :86: field following a statement line. Its layout is up to the bank: German
+// banks use ?NN subfields with SEPA keywords such as SVWZ+ in the remittance information, Dutch banks /CODE/value
+// pairs, and others free text.
+func applyMT940Information(row *model.StatementRow, value string) {
+	if len(value) > 4 && isDigits(value[:3]) && !isDigits(value[3:4]) {
+		applyMT940Subfields(row, strings.ReplaceAll(value, "\n", ""))
+		return
+	}
+	value = strings.ReplaceAll(value, "\n", "")
+	if codes := mt940SlashCodePattern.FindAllStringSubmatchIndex(value, -1); len(codes) > 0 && codes[0][0] == 0 {
+		applyMT940SlashCodes(row, value, codes)
+		return
+	}
+	row.Memo = value
+}
+
+// applyMT940Subfields reads the German structured :86: layout: a transaction code, then subfields behind a
+// separator, usually ?, numbered 00 for the posting text, 20-29 and 60-63 for the remittance information,
+// 31 for the counterparty account and 32-33 for its name
+func applyMT940Subfields(row *model.StatementRow, value string) {
+	subfields := make(map[int]string)
+	for _, part := range strings.Split(value[4:], value[3:4]) {
+		if len(part) < 2 || !isDigits(part[:2]) {
+			continue
+		}
+		number, _ := strconv.Atoi(part[:2])
+		subfields[number] += part[2:]
+	}
+
+	var remittance strings.Builder
+	for number := 20; number <= 63; number++ {
+		if number <= 29 || number >= 60 {
+			remittance.WriteString(subfields[number])
+		}
+	}
+	row.Payee = subfields[32] + subfields[33]
+	row.CounterpartyIBAN = subfields[31]
+	row.Memo = remittance.String()
+
+	if keywords := sepaRemittancePattern.FindAllStringSubmatchIndex(row.Memo, -1); len(keywords) > 0 {
+		sepa := make(map[string]string)
+		for i, keyword := range keywords {
+			end := len(row.Memo)
+			if i+1 < len(keywords) {
+				end = keywords[i+1][0]
+			}
+			sepa[row.Memo[keyword[2]:keyword[3]]] = strings.TrimSpace(row.Memo[keyword[1]:end])
+		}
+		row.Memo = sepa["SVWZ"]
+		if endToEndID := sepa["EREF"]; endToEndID != "NOTPROVIDED" {
+			row.EndToEndID = endToEndID
+		}
+	}
+	if strings.TrimSpace(row.Memo) == "" {
+		row.Memo = subfields[0]
+	}
+}
+
+// applyMT940SlashCodes reads the /CODE/value layout, e.g. /TRTP/SEPA OVERBOEKING/IBAN/NL12ABNA0123456789/NAME/...
+func applyMT940SlashCodes(row *model.StatementRow, value string, codes [][]int) {
+	for i, code := range codes {
+		end := len(value)
+		if i+1 < len(codes) {
+			end = codes[i+1][0]
+		}
+		content := strings.TrimSpace(value[code[1]:end])
+		switch value[code[2]:code[3]] {
+		case "NAME":
+			row.Payee = content
+		case "IBAN":
+			row.CounterpartyIBAN = content
+		case "REMI":
+			// Unstructured remittance information may be marked /REMI/USTD//text
+			row.Memo = strings.TrimSuffix(strings.TrimPrefix(content, "USTD//"), "/")
+		case "EREF":
+			if content != "NOTPROVIDED" {
+				row.EndToEndID = content
+			}
+		case "CNTP":
+			// Counterparty as account/BIC/name/city
+			parts := strings.Split(content, "/")
+			row.CounterpartyIBAN = parts[0]
+			if len(parts) > 2 {
+				row.Payee = parts[2]
+			}
+		}
+	}
+}
+
+// isDigits reports whether value consists of ASCII digits only
+func isDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return value != ""
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseMT940Statement(t *testing.T) {
+	content := readFixture(t, "statement.mt940")
+	if !IsMT940Statement(content) {
+		t.Error("IsMT940Statement = false")
+	}
+	statement, err := ParseMT940Statement(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if statement.Currency != "EUR" {
+		t.Errorf("currency = %q, want EUR", statement.Currency)
+	}
+	// The two messages of the file are joined into one statement
+	checkBalances(t, statement, "1000.00", "2026-03-01", "2654.50", "2026-03-05")
+	checkRows(t, statement.Rows, []wantRow{
+		{
+			line: 6, postedOn: "2026-03-02", amount: "-45.50", payee: "Stadtwerke Musterstadt", memo: "Abschlag Strom Maerz 2026",
+			externalID: "REF-0001", iban: "DE02120300000000202051", endToEndID: "E2E-4711",
+		},
+		{line: 8, postedOn: "2026-03-03", amount: "2000", payee: "ACME GmbH", memo: "Gehalt Maerz", externalID: "REF-0002"},
+		{line: 17, postedOn: "2026-03-04", amount: "-300", payee: "Landlord", memo: "Garage and storage", iban: "NL91ABNA0417164300"},
+	})
+}
+
+func TestParseMT940StatementMalformed(t *testing.T) {
+	fixture := string(readFixture(t, "statement.mt940"))
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{name: "unbalanced", content: strings.Replace(fixture, ":62F:C260305EUR2654,50", ":62F:C260305EUR2645,50", 1), wantErr: ErrUnbalancedStatement},
+		{name: "invalid opening balance", content: strings.Replace(fixture, ":60F:C260301EUR1000,00", ":60F:X260301EUR1000,00", 1), wantErr: ErrMalformedStatement},
+		{name: "invalid closing balance", content: strings.Replace(fixture, ":62F:C260303EUR2954,50", ":62F:C261303EUR2954,50", 1), wantErr: ErrMalformedStatement},
+		{name: "several accounts", content: strings.Replace(fixture, ":28C:00002/001", ":25:DE44500105175407324931\r\n:28C:00002/001", 1), wantErr: ErrMalformedStatement},
+		{name: "field before :20:", content: ":25:37040044/0532013000\n:20:STARTUMS\n", wantErr: ErrMalformedStatement},
+		{name: "no statement", content: "-}\n", wantErr: ErrMalformedStatement},
+		{name: "no statement lines", content: ":20:STARTUMS\n:60F:C260301EUR0,\n:62F:C260301EUR0,\n", wantErr: ErrEmptyStatement},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseMT940Statement([]byte(tt.content)); !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// An unreadable statement line is reported as a row, and the totals are then left unchecked
+	content := strings.Replace(fixture, ":61:260303CR2000,", ":61:260303XX2000,", 1)
+	statement, err := ParseMT940Statement([]byte(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := statement.Rows[1].Err; !errors.Is(err, ErrInvalidStatementAmount) {
+		t.Errorf("row 1 error = %v, want ErrInvalidStatementAmount", err)
+	}
+}
+
+func TestMT940BookingDate(t *testing.T) {
+	tests := []struct {
+		valueDate string
+		monthDay  string
+		want      string
+		wantErr   bool
+	}{
+		{valueDate: "2026-03-02", monthDay: "0302", want: "2026-03-02"},
+		{valueDate: "2026-03-02", monthDay: "0227", want: "2026-02-27"},
+		{valueDate: "2025-12-31", monthDay: "0102", want: "2026-01-02"}, // Booked after the turn of the year
+		{valueDate: "2026-01-02", monthDay: "1231", want: "2025-12-31"}, // Value-dated after the turn of the year
+		{valueDate: "2026-03-02", monthDay: "1301", wantErr: true},
+		{valueDate: "2026-02-02", monthDay: "0230", wantErr: true},
+		{valueDate: "2026-03-02", monthDay: "0x02", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := mt940BookingDate(date(tt.valueDate), tt.monthDay)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidStatementDate) {
+				t.Errorf("mt940BookingDate(%s, %s) error = %v, want ErrInvalidStatementDate", tt.valueDate, tt.monthDay, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("mt940BookingDate(%s, %s): %v", tt.valueDate, tt.monthDay, err)
+		}
+		if !got.Equal(date(tt.want)) {
+			t.Errorf("mt940BookingDate(%s, %s) = %s, want %s", tt.valueDate, tt.monthDay, got.Format("2006-01-02"), tt.want)
+		}
+	}
+}
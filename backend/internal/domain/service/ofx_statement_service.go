@@ -0,0 +1,284 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"backend/internal/domain/model"
+
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+)
+
+var (
+	// ErrForeignCurrencyAmount is reported for OFX transactions whose amount is in a currency other than the statement's
+	ErrForeignCurrencyAmount = errors.New("amount is in a foreign currency")
+)
+
+// ofxStatementAggregates are the OFX aggregates holding the statement of a bank account and of a credit card
+var ofxStatementAggregates = []string{"STMTRS", "CCSTMTRS"}
+
+// ofxElement is an element of an OFX document. Elements holding a value have no children.
+type ofxElement struct {
+	name     string
+	value    string
+	line     int // 1-based line of the start tag
+	children []*ofxElement
+}
+
+// child returns the first child element of the given name, or nil
+func (e *ofxElement) child(name string) *ofxElement {
+	for _, c := range e.children {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// text returns the value of the element at the path below e, or "" when there is none
+func (e *ofxElement) text(path ...string) string {
+	element := e
+	for _, name := range path {
+		if element = element.child(name); element == nil {
+			return ""
+		}
+	}
+	return element.value
+}
+
+// descendants returns the elements of the given name below e in document order, without descending into them
+func (e *ofxElement) descendants(name string) []*ofxElement {
+	var found []*ofxElement
+	for _, c := range e.children {
+		if c.name == name {
+			found = append(found, c)
+			continue
+		}
+		found = append(found, c.descendants(name)...)
+	}
+	return found
+}
+
+// IsOFXStatement reports whether content looks like an OFX or QFX file
+func IsOFXStatement(content []byte) bool {
+	head := strings.ToUpper(string(content[:min(len(content), 1024)]))
+	return strings.Contains(head, "OFXHEADER") || strings.Contains(head, "<OFX>")
+}
+
+// ParseOFXStatement reads the bank or credit card statement of an OFX 1.x (SGML) or 2.x (XML) file, including
+// QFX files. Each STMTTRN becomes a row carrying its FITID as external ID, and LEDGERBAL gives the closing balance.
+func ParseOFXStatement(content []byte) (*model.Statement, error) {
+	text, err := decodeOFXStatement(content)
+	if err != nil {
+		return nil, err
+	}
+	document, err := parseOFXDocument(text)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, status := range document.descendants("STATUS") {
+		if code := status.text("CODE"); code != "0" && strings.EqualFold(status.text("SEVERITY"), "ERROR") {
+			return nil, fmt.Errorf("%w: the bank reported error %s %s", ErrMalformedStatement, code, status.text("MESSAGE"))
+		}
+	}
+
+	var responses []*ofxElement
+	for _, aggregate := range ofxStatementAggregates {
+		responses = append(responses, document.descendants(aggregate)...)
+	}
+	if len(responses) == 0 {
+		return nil, ErrEmptyStatement
+	}
+	if len(responses) > 1 {
+		return nil, fmt.Errorf("%w: the file holds the statements of %d accounts; download one account at a time", ErrMalformedStatement, len(responses))
+	}
+	response := responses[0]
+
+	statement := &model.Statement{Currency: strings.ToUpper(strings.TrimSpace(response.text("CURDEF")))}
+	for _, element := range response.descendants("STMTTRN") {
+		statement.Rows = append(statement.Rows, ofxStatementRow(element))
+	}
+	if len(statement.Rows) == 0 {
+		return nil, ErrEmptyStatement
+	}
+
+	if ledger := response.child("LEDGERBAL"); ledger != nil {
+		amount, err := parseOFXAmount(ledger.text("BALAMT"))
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid LEDGERBAL amount on line %d", ErrMalformedStatement, ledger.line)
+		}
+		asOf, err := parseOFXDate(ledger.text("DTASOF"))
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid LEDGERBAL date on line %d", ErrMalformedStatement, ledger.line)
+		}
+		statement.ClosingBalance = &model.StatementBalance{Amount: amount, AsOf: asOf}
+	}
+	return statement, nil
+}
+
+// ofxStatementRow reads a STMTTRN aggregate. Transactions without a NAME take their description from MEMO.
+func ofxStatementRow(element *ofxElement) model.StatementRow {
+	row := model.StatementRow{
+		Line:       element.line,
+		ExternalID: strings.TrimSpace(element.text("FITID")),
+	}
+
+	postedOn, err := parseOFXDate(element.text("DTPOSTED"))
+	if err != nil {
+		row.Err = err
+		return row
+	}
+	// TRNAMT is in the currency of a CURRENCY aggregate when there is one; ORIGCURRENCY only informs
+	if element.child("CURRENCY") != nil {
+		row.Err = ErrForeignCurrencyAmount
+		return row
+	}
+	amount, err := parseOFXAmount(element.text("TRNAMT"))
+	if err != nil {
+		row.Err = err
+		return row
+	}
+
+	row.PostedOn = postedOn
+	row.Amount = amount
+	row.Payee = element.text("NAME")
+	if row.Payee == "" {
+		row.Payee = element.text("PAYEE", "NAME")
+	}
+	row.Memo = element.text("MEMO")
+	return finishStatementRow(row)
+}
+
+// decodeOFXStatement converts an OFX file to text. Files that are not UTF-8 are decoded as Shift_JIS when
+// their header says so, as used by Japanese banks, and as Windows-1252 otherwise, the OFX 1.x default.
+func decodeOFXStatement(content []byte) (string, error) {
+	if utf8.Valid(content) {
+		return strings.TrimPrefix(string(content), "\uFEFF"), nil
+	}
+
+	decoder := charmap.Windows1252.NewDecoder()
+	head := strings.ToUpper(string(content[:min(len(content), 1024)]))
+	if strings.Contains(head, "SHIFT_JIS") || strings.Contains(head, "SJIS") || strings.Contains(head, "CHARSET:932") {
+		decoder = japanese.ShiftJIS.NewDecoder()
+	}
+	decoded, err := decoder.Bytes(content)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrMalformedStatement, err)
+	}
+	return string(decoded), nil
+}
+
+// parseOFXDocument reads the OFX element of a document into a tree. OFX 1.x SGML leaves out the end tags
+// of elements holding a value, so both versions are read by this lenient tokenizer rather than an XML decoder:
+// a start tag followed by text opens an element holding a value, whose end tag is optional, and any other
+// start tag opens an aggregate, closed by its end tag or by that of an enclosing aggregate.
+func parseOFXDocument(text string) (*ofxElement, error) {
+	start := strings.Index(text, "<OFX>")
+	if start < 0 {
+		start = strings.Index(text, "<ofx>")
+	}
+	if start < 0 {
+		return nil, fmt.Errorf("%w: no OFX element", ErrMalformedStatement)
+	}
+
+	line := 1 + strings.Count(text[:start], "\n")
+	root := &ofxElement{}
+	stack := []*ofxElement{root}
+	rest := text[start:]
+	for {
+		open := strings.IndexByte(rest, '<')
+		if open < 0 {
+			break
+		}
+		line += strings.Count(rest[:open], "\n")
+		end := strings.IndexByte(rest[open:], '>')
+		if end < 0 {
+			return nil, fmt.Errorf("%w: unterminated tag on line %d", ErrMalformedStatement, line)
+		}
+		tag := rest[open+1 : open+end]
+		line += strings.Count(tag, "\n")
+		rest = rest[open+end+1:]
+
+		switch {
+		case strings.HasPrefix(tag, "?"), strings.HasPrefix(tag, "!"):
+			// Processing instructions, such as the OFX 2.x header, and comments
+			continue
+		case strings.HasPrefix(tag, "/"):
+			name := strings.ToUpper(strings.TrimSpace(tag[1:]))
+			for i := len(stack) - 1; i > 0; i-- {
+				if stack[i].name == name {
+					stack = stack[:i]
+					break
+				}
+			}
+			continue
+		}
+
+		fields := strings.Fields(strings.TrimSuffix(tag, "/"))
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("%w: empty tag on line %d", ErrMalformedStatement, line)
+		}
+		element := &ofxElement{name: strings.ToUpper(fields[0]), line: line}
+		parent := stack[len(stack)-1]
+		parent.children = append(parent.children, element)
+		if strings.HasSuffix(tag, "/") {
+			continue
+		}
+
+		next := strings.IndexByte(rest, '<')
+		if next < 0 {
+			next = len(rest)
+		}
+		if value := strings.TrimSpace(rest[:next]); value != "" {
+			element.value = html.UnescapeString(value)
+			line += strings.Count(rest[:next], "\n")
+			rest = rest[next:]
+			if endTag := "</" + element.name + ">"; len(rest) >= len(endTag) && strings.EqualFold(rest[:len(endTag)], endTag) {
+				rest = rest[len(endTag):]
+			}
+			continue
+		}
+		stack = append(stack, element)
+	}
+
+	document := root.child("OFX")
+	if document == nil {
+		return nil, fmt.Errorf("%w: no OFX element", ErrMalformedStatement)
+	}
+	return document, nil
+}
+
+// parseOFXDate reads the day of an OFX datetime, YYYYMMDD[HHMMSS[.XXX]][[offset:TZ]], as the bank wrote it
+func parseOFXDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if len(value) < 8 {
+		return time.Time{}, ErrInvalidStatementDate
+	}
+	date, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return time.Time{}, ErrInvalidStatementDate
+	}
+	return date, nil
+}
+
+// parseOFXAmount reads an OFX amount, which has no thousands separators and may use a decimal comma
+func parseOFXAmount(value string) (model.Decimal, error) {
+	decimalSeparator := "."
+	if strings.Contains(value, ",") && !strings.Contains(value, ".") {
+		decimalSeparator = ","
+	}
+	amount, ok, err := parseStatementAmount(value, decimalSeparator)
+	if err != nil {
+		return model.Decimal{}, err
+	}
+	if !ok {
+		return model.Decimal{}, ErrMissingStatementAmount
+	}
+	return amount, nil
+}
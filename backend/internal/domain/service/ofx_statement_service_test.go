@@ -0,0 +1,147 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"backend/internal/domain/model"
+)
+
+func TestParseOFXStatement(t *testing.T) {
+	tests := []struct {
+		fixture     string
+		currency    string
+		closing     string
+		closingAsOf string
+		want        []wantRow
+	}{
+		{
+			fixture:     "statement_v1.ofx",
+			currency:    "USD",
+			closing:     "1233.50",
+			closingAsOf: "2026-03-05",
+			want: []wantRow{
+				{line: 39, postedOn: "2026-03-01", amount: "-4.50", payee: "STARBUCKS STORE 1234", memo: "card 1234", externalID: "20260301-001"},
+				{postedOn: "2026-03-02", amount: "1250.00", payee: "ACME PAYROLL & CO", externalID: "20260302-001"},
+				{postedOn: "2026-03-03", amount: "-12.00", payee: "Monthly fee", externalID: "20260303-001"},
+				{err: ErrInvalidStatementDate},
+				{err: ErrForeignCurrencyAmount},
+			},
+		},
+		{
+			fixture:     "statement_v2.ofx",
+			currency:    "EUR",
+			closing:     "-150.55",
+			closingAsOf: "2026-03-31",
+			want: []wantRow{
+				{line: 14, postedOn: "2026-03-10", amount: "-23.45", payee: "REWE Markt", externalID: "CC-0001"},
+				{postedOn: "2026-03-15", amount: "100.00", payee: "Payment - thank you", externalID: "CC-0002"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			content := readFixture(t, tt.fixture)
+			if !IsOFXStatement(content) {
+				t.Error("IsOFXStatement = false")
+			}
+			statement, err := ParseOFXStatement(content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if statement.Currency != tt.currency {
+				t.Errorf("currency = %q, want %q", statement.Currency, tt.currency)
+			}
+			if statement.OpeningBalance != nil {
+				t.Errorf("opening balance = %s, want none", statement.OpeningBalance.Amount)
+			}
+			if b := statement.ClosingBalance; b == nil || b.Amount.String() != tt.closing || !b.AsOf.Equal(date(tt.closingAsOf)) {
+				t.Errorf("closing balance = %+v, want %s on %s", b, tt.closing, tt.closingAsOf)
+			}
+			checkRows(t, statement.Rows, tt.want)
+		})
+	}
+}
+
+func TestParseOFXStatementMalformed(t *testing.T) {
+	const transaction = "<STMTTRN><DTPOSTED>20260301<TRNAMT>-1.00<FITID>1<NAME>Coffee</STMTTRN>"
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{name: "no OFX element", content: "OFXHEADER:100\n<FOO>", wantErr: ErrMalformedStatement},
+		{name: "unterminated tag", content: "<OFX><BANKMSGSRSV1><STMTRS", wantErr: ErrMalformedStatement},
+		{name: "empty tag", content: "<OFX><>", wantErr: ErrMalformedStatement},
+		{
+			name:    "error status",
+			content: "<OFX><STMTTRNRS><STATUS><CODE>2000<SEVERITY>ERROR<MESSAGE>General error</STATUS></STMTTRNRS></OFX>",
+			wantErr: ErrMalformedStatement,
+		},
+		{name: "no statement", content: "<OFX><SIGNONMSGSRSV1></SIGNONMSGSRSV1></OFX>", wantErr: ErrEmptyStatement},
+		{name: "no transactions", content: "<OFX><STMTRS><CURDEF>USD<BANKTRANLIST></BANKTRANLIST></STMTRS></OFX>", wantErr: ErrEmptyStatement},
+		{
+			name:    "several accounts",
+			content: "<OFX><STMTRS>" + transaction + "</STMTRS><STMTRS>" + transaction + "</STMTRS></OFX>",
+			wantErr: ErrMalformedStatement,
+		},
+		{
+			name:    "invalid ledger balance",
+			content: "<OFX><STMTRS>" + transaction + "<LEDGERBAL><BALAMT>n/a<DTASOF>20260301</LEDGERBAL></STMTRS></OFX>",
+			wantErr: ErrMalformedStatement,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseOFXStatement([]byte(tt.content)); !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseOFXStatementShiftJIS(t *testing.T) {
+	// Japanese banks send OFX 1.x in Shift_JIS; "ｾﾌﾞﾝ" is half-width katakana, one byte per character
+	content := "OFXHEADER:100\nCHARSET:SHIFT_JIS\n\n<OFX><STMTRS><CURDEF>JPY<STMTTRN><DTPOSTED>20260301<TRNAMT>-500<FITID>1<NAME>\xbe\xcc\xde\xdd</STMTTRN></STMTRS></OFX>"
+	statement, err := ParseOFXStatement([]byte(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkRows(t, statement.Rows, []wantRow{{postedOn: "2026-03-01", amount: "-500", payee: "ｾﾌﾞﾝ", externalID: "1"}})
+}
+
+func TestCheckStatementBalance(t *testing.T) {
+	tests := []struct {
+		statement      string
+		account        string
+		wantDifference string
+	}{
+		{statement: "1233.50", account: "1233.50", wantDifference: "0.00"},
+		{statement: "1233.50", account: "1245.50", wantDifference: "-12.00"},
+		{statement: "-150.55", account: "-50.55", wantDifference: "-100.00"},
+		{statement: "10.005", account: "10.00", wantDifference: "0.00"}, // Rounded half to even to cents
+	}
+	for _, tt := range tests {
+		balance := model.StatementBalance{Amount: mustDecimal(t, tt.statement), AsOf: date("2026-03-05")}
+		account, err := model.ParseMoney(tt.account, "USD")
+		if err != nil {
+			t.Fatal(err)
+		}
+		check, err := CheckStatementBalance(balance, account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if check.Difference.Amount() != tt.wantDifference {
+			t.Errorf("statement %s, account %s: difference = %s, want %s", tt.statement, tt.account, check.Difference.Amount(), tt.wantDifference)
+		}
+	}
+}
+
+func mustDecimal(t *testing.T, s string) model.Decimal {
+	t.Helper()
+	d, err := model.ParseDecimal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
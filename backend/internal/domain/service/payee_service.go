@@ -0,0 +1,177 @@
+package service
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"unicode"
+
+	"backend/internal/domain/model"
+)
+
+var (
+	// ErrPayeeNameRequired is returned for payees without a name
+	ErrPayeeNameRequired = errors.New("payee name is required")
+	// ErrInvalidPayeeAlias is returned for alias patterns without any character besides wildcards
+	ErrInvalidPayeeAlias = errors.New("payee alias must contain more than wildcards")
+	// ErrPayeeAliasConflict is returned when another payee of the workspace already has the alias
+	ErrPayeeAliasConflict = errors.New("payee alias is already used by another payee")
+	// ErrInvalidPayeeLogoURL is returned for logo URLs that are not absolute http or https URLs
+	ErrInvalidPayeeLogoURL = errors.New("payee logo URL must be an http or https URL")
+	// ErrInvalidPayeeWebsite is returned for websites that are not absolute http or https URLs
+	ErrInvalidPayeeWebsite = errors.New("payee website must be an http or https URL")
+	// ErrPayeeMergeSelf is returned when merging a payee into itself
+	ErrPayeeMergeSelf = errors.New("payee cannot be merged into itself")
+)
+
+// payeeWildcard matches any run of characters in an alias pattern
+const payeeWildcard = "*"
+
+// ValidatePayee checks the user-editable fields of a payee. The aliases must already be normalized.
+func ValidatePayee(payee *model.Payee) error {
+	if strings.TrimSpace(payee.Name) == "" {
+		return ErrPayeeNameRequired
+	}
+	for _, alias := range payee.Aliases {
+		if strings.Trim(alias, payeeWildcard+" ") == "" {
+			return ErrInvalidPayeeAlias
+		}
+	}
+	if payee.LogoURL != "" && !isHTTPURL(payee.LogoURL) {
+		return ErrInvalidPayeeLogoURL
+	}
+	if payee.Website != "" && !isHTTPURL(payee.Website) {
+		return ErrInvalidPayeeWebsite
+	}
+	return nil
+}
+
+// ValidatePayeeAliases checks that no other payee of the workspace has one of the payee's aliases,
+// given all payees of the workspace
+func ValidatePayeeAliases(payee *model.Payee, payees []*model.Payee) error {
+	for _, other := range payees {
+		if other.ID == payee.ID {
+			continue
+		}
+		for _, alias := range payee.Aliases {
+			for _, otherAlias := range other.Aliases {
+				if alias == otherAlias {
+					return ErrPayeeAliasConflict
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// NormalizePayeeDescription reduces a raw description to the form alias patterns are matched against:
+// upper case, with punctuation other than & . ' turned into spaces and runs of spaces collapsed,
+// so "AMZN Mktp US*2K3" becomes "AMZN MKTP US 2K3"
+func NormalizePayeeDescription(description string) string {
+	return normalizePayeeText(description, false)
+}
+
+// NormalizePayeeAlias normalizes an alias pattern like NormalizePayeeDescription, keeping its wildcards;
+// "amzn mktp *" becomes "AMZN MKTP*"
+func NormalizePayeeAlias(alias string) string {
+	return normalizePayeeText(alias, true)
+}
+
+// NormalizePayeeAliases normalizes alias patterns and drops duplicates, keeping the first occurrence
+func NormalizePayeeAliases(aliases []string) []string {
+	normalized := make([]string, 0, len(aliases))
+	seen := make(map[string]bool, len(aliases))
+	for _, alias := range aliases {
+		alias = NormalizePayeeAlias(alias)
+		if !seen[alias] {
+			seen[alias] = true
+			normalized = append(normalized, alias)
+		}
+	}
+	return normalized
+}
+
+func normalizePayeeText(text string, keepWildcards bool) string {
+	var b strings.Builder
+	var last rune // Last rune written
+	pendingSpace := false
+	for _, r := range strings.ToUpper(text) {
+		switch {
+		case keepWildcards && r == '*':
+			// Wildcards absorb the spaces around them, and consecutive wildcards match the same as one
+			if last != '*' {
+				b.WriteRune(r)
+				last = r
+			}
+			pendingSpace = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '&' || r == '.' || r == '\'':
+			if pendingSpace && last != 0 && last != '*' {
+				b.WriteByte(' ')
+			}
+			b.WriteRune(r)
+			last = r
+			pendingSpace = false
+		default:
+			pendingSpace = true
+		}
+	}
+	return b.String()
+}
+
+// MatchPayee returns the payee a raw description resolves to, or nil when none matches.
+// A payee whose name equals the description wins; otherwise the alias pattern with the most
+// literal characters decides, so "AMZN MKTP*" beats "AMZN*". Ties go to the earlier payee.
+func MatchPayee(payees []*model.Payee, description string) *model.Payee {
+	normalized := NormalizePayeeDescription(description)
+	if normalized == "" {
+		return nil
+	}
+
+	var best *model.Payee
+	bestScore := 0
+	for _, payee := range payees {
+		if NormalizePayeeDescription(payee.Name) == normalized {
+			return payee
+		}
+		for _, alias := range payee.Aliases {
+			if !matchPayeeAlias(alias, normalized) {
+				continue
+			}
+			if score := len(alias) - strings.Count(alias, payeeWildcard); score > bestScore {
+				best, bestScore = payee, score
+			}
+		}
+	}
+	return best
+}
+
+// matchPayeeAlias reports whether a normalized alias pattern matches the whole normalized description
+func matchPayeeAlias(alias, description string) bool {
+	parts := strings.Split(alias, payeeWildcard)
+	if len(parts) == 1 {
+		return alias == description
+	}
+
+	first, last := parts[0], parts[len(parts)-1]
+	if !strings.HasPrefix(description, first) {
+		return false
+	}
+	description = description[len(first):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(description, part)
+		if i < 0 {
+			return false
+		}
+		description = description[i+len(part):]
+	}
+	return strings.HasSuffix(description, last)
+}
+
+// isHTTPURL reports whether s is an absolute http or https URL
+func isHTTPURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"errors"
+
+	"backend/internal/domain/model"
+)
+
+// Permission is an action a workspace member may be allowed to perform
+type Permission string
+
+const (
+	PermissionWorkspaceDelete   Permission = "workspace:delete"
+	PermissionWorkspaceManage   Permission = "workspace:manage"
+	PermissionMembersManage     Permission = "members:manage"
+	PermissionAccountsManage    Permission = "accounts:manage"
+	PermissionCategoriesManage  Permission = "categories:manage"
+	PermissionTransactionsRead  Permission = "transactions:read"
+	PermissionTransactionsWrite Permission = "transactions:write"
+)
+
+// ErrPermissionDenied is returned when a member's role does not grant a permission
+var ErrPermissionDenied = errors.New("permission denied")
+
+// rolePermissions lists what each role may do; higher roles repeat the lower roles' permissions
+var rolePermissions = map[model.Role][]Permission{
+	model.RoleOwner: {
+		PermissionWorkspaceDelete,
+		PermissionWorkspaceManage,
+		PermissionMembersManage,
+		PermissionAccountsManage,
+		PermissionCategoriesManage,
+		PermissionTransactionsRead,
+		PermissionTransactionsWrite,
+	},
+	model.RoleAdmin: {
+		PermissionWorkspaceManage,
+		PermissionMembersManage,
+		PermissionAccountsManage,
+		PermissionCategoriesManage,
+		PermissionTransactionsRead,
+		PermissionTransactionsWrite,
+	},
+	model.RoleEditor: {
+		PermissionAccountsManage,
+		PermissionCategoriesManage,
+		PermissionTransactionsRead,
+		PermissionTransactionsWrite,
+	},
+	model.RoleViewer: {
+		PermissionTransactionsRead,
+	},
+}
+
+// HasPermission reports whether the role grants the permission
+func HasPermission(role model.Role, permission Permission) bool {
+	for _, p := range rolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Authorize returns ErrPermissionDenied unless the membership's role grants the permission
+func Authorize(membership *model.Membership, permission Permission) error {
+	if membership == nil || !HasPermission(membership.Role, permission) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
@@ -0,0 +1,553 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"backend/internal/domain/model"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+var (
+	// ErrInvalidQIFDateFormat is returned for unsupported QIF date formats
+	ErrInvalidQIFDateFormat = errors.New("date format must be MM/DD/YYYY, DD/MM/YYYY, DD.MM.YYYY or YYYY-MM-DD")
+	// ErrMalformedQIF is returned when a QIF file cannot be read
+	ErrMalformedQIF = errors.New("QIF file is malformed")
+	// ErrEmptyQIF is returned for QIF files without categories or transactions
+	ErrEmptyQIF = errors.New("QIF file has no categories or transactions")
+)
+
+// qifAccountTypes maps the account types of QIF headers to account types. Investment registers are read for
+// their cash amounts only, and other assets, such as a house or a car, are taken as investments.
+var qifAccountTypes = map[string]model.AccountType{
+	"bank":          model.AccountTypeBank,
+	"cash":          model.AccountTypeCash,
+	"ccard":         model.AccountTypeCreditCard,
+	"oth l":         model.AccountTypeLoan,
+	"oth a":         model.AccountTypeInvestment,
+	"invst":         model.AccountTypeInvestment,
+	"port":          model.AccountTypeInvestment,
+	"401(k)/403(b)": model.AccountTypeInvestment,
+}
+
+// qifRegisterTypes are the QIF headers of the registers accounts are exported with; investments are
+// exported as other assets as they have no securities
+var qifRegisterTypes = map[model.AccountType]string{
+	model.AccountTypeBank:       "Bank",
+	model.AccountTypeCash:       "Cash",
+	model.AccountTypeCreditCard: "CCard",
+	model.AccountTypeLoan:       "Oth L",
+	model.AccountTypeInvestment: "Oth A",
+}
+
+// qifDateLayouts maps the QIF date formats to the Go layouts dates are exported with
+var qifDateLayouts = map[model.QIFDateFormat]string{
+	model.QIFDateFormatMDY:    "01/02/2006",
+	model.QIFDateFormatDMY:    "02/01/2006",
+	model.QIFDateFormatDMYDot: "02.01.2006",
+	model.QIFDateFormatYMD:    "2006-01-02",
+}
+
+// qifSection is the kind of records following a QIF header
+type qifSection int
+
+const (
+	qifSectionNone qifSection = iota
+	qifSectionAccount
+	qifSectionCategory
+	qifSectionRegister
+	qifSectionIgnored // Classes, memorized transactions, securities, prices and the like
+)
+
+// ParseQIF reads the category list and account registers of a QIF file as written by Quicken, GnuCash,
+// Microsoft Money and the like. Dates are read in dateFormat, or in the format guessed from the file when it
+// is empty. Transactions that cannot be read are returned with their error so that the rest can be imported.
+func ParseQIF(content []byte, dateFormat model.QIFDateFormat) (*model.QIFFile, error) {
+	if dateFormat != "" && !dateFormat.IsValid() {
+		return nil, ErrInvalidQIFDateFormat
+	}
+	text, err := decodeQIF(content)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	if dateFormat == "" {
+		dateFormat = guessQIFDateFormat(lines)
+	}
+
+	file := &model.QIFFile{}
+	section := qifSectionNone
+	current := -1 // Index of the account the registers that follow belong to
+	var record []string
+	recordLine := 0
+	for i, line := range lines {
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "!") {
+			if len(record) > 0 {
+				return nil, fmt.Errorf("%w: record starting on line %d is not terminated by ^", ErrMalformedQIF, recordLine)
+			}
+			header := strings.ToLower(strings.TrimSpace(line[1:]))
+			switch {
+			case header == "account":
+				section = qifSectionAccount
+			case header == "type:cat":
+				section = qifSectionCategory
+			case strings.HasPrefix(header, "type:"):
+				accountType, ok := qifAccountTypes[strings.TrimSpace(strings.TrimPrefix(header, "type:"))]
+				if !ok {
+					section = qifSectionIgnored
+					continue
+				}
+				section = qifSectionRegister
+				if current < 0 {
+					file.Accounts = append(file.Accounts, model.QIFAccount{Type: accountType})
+					current = len(file.Accounts) - 1
+				}
+				if file.Accounts[current].Type == "" {
+					file.Accounts[current].Type = accountType
+				}
+			case strings.HasPrefix(header, "option:"), strings.HasPrefix(header, "clear:"):
+				// AutoSwitch options bracket the account list; accounts are looked up by name either way
+			default:
+				section = qifSectionIgnored
+			}
+			continue
+		}
+
+		if len(record) == 0 {
+			recordLine = i + 1
+		}
+		if strings.HasPrefix(line, "^") {
+			switch section {
+			case qifSectionAccount:
+				account := readQIFAccount(record)
+				current = findQIFAccount(file, account.Name)
+				if current < 0 {
+					file.Accounts = append(file.Accounts, account)
+					current = len(file.Accounts) - 1
+				} else if file.Accounts[current].Type == "" {
+					file.Accounts[current].Type = account.Type
+				}
+			case qifSectionCategory:
+				if category, ok := readQIFCategory(record); ok {
+					file.Categories = append(file.Categories, category)
+				}
+			case qifSectionRegister:
+				transaction := readQIFTransaction(record, dateFormat)
+				transaction.Line = recordLine
+				file.Accounts[current].Transactions = append(file.Accounts[current].Transactions, transaction)
+			case qifSectionNone:
+				return nil, fmt.Errorf("%w: record on line %d comes before any !Type header", ErrMalformedQIF, recordLine)
+			}
+			record = record[:0]
+			continue
+		}
+		record = append(record, line)
+	}
+	if len(record) > 0 && section == qifSectionRegister {
+		// Some tools leave out the ^ after the last transaction
+		transaction := readQIFTransaction(record, dateFormat)
+		transaction.Line = recordLine
+		file.Accounts[current].Transactions = append(file.Accounts[current].Transactions, transaction)
+	}
+
+	transactionCount := 0
+	for _, account := range file.Accounts {
+		transactionCount += len(account.Transactions)
+	}
+	if transactionCount == 0 && len(file.Categories) == 0 {
+		return nil, ErrEmptyQIF
+	}
+	return file, nil
+}
+
+// FormatQIF writes the category list and account registers of a QIF file with dates in dateFormat. Several
+// accounts are listed up front between AutoSwitch options, as Quicken writes them.
+func FormatQIF(file *model.QIFFile, dateFormat model.QIFDateFormat) ([]byte, error) {
+	layout, ok := qifDateLayouts[dateFormat]
+	if !ok {
+		return nil, ErrInvalidQIFDateFormat
+	}
+
+	var b strings.Builder
+	field := func(code byte, value string) {
+		b.WriteByte(code)
+		b.WriteString(qifText(value))
+		b.WriteByte('\n')
+	}
+
+	if len(file.Categories) > 0 {
+		b.WriteString("!Type:Cat\n")
+		for _, category := range file.Categories {
+			field('N', strings.Join(category.Path, ":"))
+			if category.Description != "" {
+				field('D', category.Description)
+			}
+			if category.Kind == model.CategoryKindIncome {
+				b.WriteString("I\n")
+			} else {
+				b.WriteString("E\n")
+			}
+			b.WriteString("^\n")
+		}
+	}
+
+	writeAccount := func(account model.QIFAccount) {
+		field('N', account.Name)
+		field('T', qifRegisterTypes[account.Type])
+		if account.Description != "" {
+			field('D', account.Description)
+		}
+		b.WriteString("^\n")
+	}
+	if len(file.Accounts) > 1 {
+		b.WriteString("!Option:AutoSwitch\n!Account\n")
+		for _, account := range file.Accounts {
+			writeAccount(account)
+		}
+		b.WriteString("!Clear:AutoSwitch\n")
+	}
+
+	for _, account := range file.Accounts {
+		b.WriteString("!Account\n")
+		writeAccount(account)
+		b.WriteString("!Type:" + qifRegisterTypes[account.Type] + "\n")
+		for _, transaction := range account.Transactions {
+			field('D', transaction.PostedOn.Format(layout))
+			field('T', transaction.Amount.String())
+			switch transaction.Status {
+			case model.TransactionStatusCleared:
+				b.WriteString("C*\n")
+			case model.TransactionStatusReconciled:
+				b.WriteString("CX\n")
+			}
+			if transaction.Number != "" {
+				field('N', transaction.Number)
+			}
+			if transaction.Payee != "" {
+				field('P', transaction.Payee)
+			}
+			if transaction.Memo != "" {
+				field('M', transaction.Memo)
+			}
+			if category := qifCategoryField(transaction.Category, transaction.TransferAccount); category != "" {
+				field('L', category)
+			}
+			for _, split := range transaction.Splits {
+				field('S', qifCategoryField(split.Category, split.TransferAccount))
+				if split.Memo != "" {
+					field('E', split.Memo)
+				}
+				field('$', split.Amount.String())
+			}
+			b.WriteString("^\n")
+		}
+	}
+	return []byte(b.String()), nil
+}
+
+// QIFTransactionIDs returns the external IDs the transactions of a QIF register are imported with. QIF has no
+// transaction identifiers, so an ID hashes the fields of a transaction and the number of identical transactions
+// before it in the register, which makes importing the same file again create nothing.
+func QIFTransactionIDs(transactions []model.QIFTransaction) []string {
+	ids := make([]string, len(transactions))
+	occurrences := make(map[string]int)
+	for i, transaction := range transactions {
+		if transaction.Err != nil {
+			continue
+		}
+		key := strings.Join([]string{
+			transaction.PostedOn.Format("2006-01-02"),
+			transaction.Amount.String(),
+			transaction.Payee,
+			transaction.Memo,
+			transaction.Number,
+			qifCategoryField(transaction.Category, transaction.TransferAccount),
+		}, "\x00")
+		occurrences[key]++
+		sum := sha256.Sum256([]byte(key + "\x00" + strconv.Itoa(occurrences[key])))
+		ids[i] = "qif:" + hex.EncodeToString(sum[:16])
+	}
+	return ids
+}
+
+// decodeQIF converts a QIF file to text. Files that are not UTF-8 are decoded as Windows-1252, the code page
+// of the desktop tools that write them.
+func decodeQIF(content []byte) (string, error) {
+	if utf8.Valid(content) {
+		return strings.TrimPrefix(string(content), "\uFEFF"), nil
+	}
+	decoded, err := charmap.Windows1252.NewDecoder().Bytes(content)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrMalformedQIF, err)
+	}
+	return string(decoded), nil
+}
+
+// readQIFAccount reads an account record: N name, T type and D description
+func readQIFAccount(record []string) model.QIFAccount {
+	var account model.QIFAccount
+	for _, line := range record {
+		value := strings.TrimSpace(line[1:])
+		switch line[0] {
+		case 'N':
+			account.Name = statementText(value)
+		case 'T':
+			account.Type = qifAccountTypes[strings.ToLower(value)]
+		case 'D':
+			account.Description = statementText(value)
+		}
+	}
+	return account
+}
+
+// readQIFCategory reads a category record: N name with its parents, D description and I or E for income or
+// expense. Records without a name are dropped.
+func readQIFCategory(record []string) (model.QIFCategory, bool) {
+	category := model.QIFCategory{Kind: model.CategoryKindExpense}
+	for _, line := range record {
+		value := strings.TrimSpace(line[1:])
+		switch line[0] {
+		case 'N':
+			category.Path = qifCategoryPath(value)
+		case 'D':
+			category.Description = statementText(value)
+		case 'I':
+			category.Kind = model.CategoryKindIncome
+		case 'E':
+			category.Kind = model.CategoryKindExpense
+		}
+	}
+	return category, len(category.Path) > 0
+}
+
+// readQIFTransaction reads a register record. Investment registers use N for the action and other codes for
+// securities, which are left out with the rest of the codes not listed here.
+func readQIFTransaction(record []string, dateFormat model.QIFDateFormat) model.QIFTransaction {
+	transaction := model.QIFTransaction{Status: model.TransactionStatusUncleared}
+	var date, amount, alternateAmount string
+	var split *model.QIFSplit
+	for _, line := range record {
+		value := strings.TrimSpace(line[1:])
+		switch line[0] {
+		case 'D':
+			date = value
+		case 'T':
+			amount = value
+		case 'U':
+			alternateAmount = value
+		case 'C':
+			transaction.Status = qifTransactionStatus(value)
+		case 'N':
+			transaction.Number = statementText(value)
+		case 'P':
+			transaction.Payee = statementText(value)
+		case 'M':
+			transaction.Memo = statementText(value)
+		case 'L':
+			transaction.Category, transaction.TransferAccount = readQIFCategoryField(value)
+		case 'S':
+			transaction.Splits = append(transaction.Splits, model.QIFSplit{})
+			split = &transaction.Splits[len(transaction.Splits)-1]
+			split.Category, split.TransferAccount = readQIFCategoryField(value)
+		case 'E':
+			if split != nil {
+				split.Memo = statementText(value)
+			}
+		case '$':
+			if split == nil {
+				continue
+			}
+			splitAmount, err := parseQIFAmount(value)
+			if err != nil {
+				transaction.Err = err
+				return transaction
+			}
+			split.Amount = splitAmount
+		}
+	}
+
+	postedOn, err := parseQIFDate(date, dateFormat)
+	if err != nil {
+		transaction.Err = err
+		return transaction
+	}
+	if amount == "" {
+		amount = alternateAmount
+	}
+	transactionAmount, err := parseQIFAmount(amount)
+	if err != nil {
+		transaction.Err = err
+		return transaction
+	}
+	transaction.PostedOn = postedOn
+	transaction.Amount = transactionAmount
+	if transaction.Payee == "" {
+		transaction.Payee = transaction.Memo
+	}
+	if len(transaction.Splits) > 0 {
+		// Split transactions are categorized by their splits
+		transaction.Category, transaction.TransferAccount = nil, ""
+	}
+	return transaction
+}
+
+// findQIFAccount returns the index of the account of the file with the given name, ignoring case, or -1
+func findQIFAccount(file *model.QIFFile, name string) int {
+	for i, account := range file.Accounts {
+		if strings.EqualFold(account.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// readQIFCategoryField reads the category of an L or S field: a category path such as Food:Groceries, or an
+// account name in brackets for transfers. A class after a slash, as in Food:Groceries/Vacation, is dropped.
+func readQIFCategoryField(value string) ([]string, string) {
+	if strings.HasPrefix(value, "[") {
+		if end := strings.Index(value, "]"); end > 0 {
+			return nil, statementText(value[1:end])
+		}
+	}
+	if slash := strings.Index(value, "/"); slash >= 0 {
+		value = value[:slash]
+	}
+	return qifCategoryPath(value), ""
+}
+
+// qifCategoryPath splits a category name such as Food:Groceries into the names of the path to it
+func qifCategoryPath(value string) []string {
+	var path []string
+	for _, name := range strings.Split(value, ":") {
+		if name = statementText(name); name != "" {
+			path = append(path, name)
+		}
+	}
+	return path
+}
+
+// qifCategoryField returns the L or S field of a category path or transfer account
+func qifCategoryField(path []string, transferAccount string) string {
+	if transferAccount != "" {
+		return "[" + transferAccount + "]"
+	}
+	return strings.Join(path, ":")
+}
+
+// qifTransactionStatus reads the cleared flag of a transaction: blank, * or c for cleared, and X or R for reconciled
+func qifTransactionStatus(value string) model.TransactionStatus {
+	switch strings.ToUpper(value) {
+	case "*", "C":
+		return model.TransactionStatusCleared
+	case "X", "R":
+		return model.TransactionStatusReconciled
+	}
+	return model.TransactionStatusUncleared
+}
+
+// qifText makes a value fit on one line of a QIF file
+func qifText(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// parseQIFDate reads a date in the order of dateFormat whatever its separators, such as 1/ 2'24 or 02.01.2024.
+// Two-digit years are taken as 19xx from 70 on, and always as 20xx after an apostrophe, as Quicken writes them.
+func parseQIFDate(value string, dateFormat model.QIFDateFormat) (time.Time, error) {
+	parts := qifDateParts(value)
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidStatementDate, value)
+	}
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidStatementDate, value)
+		}
+		numbers[i] = number
+	}
+
+	var year, month, day int
+	switch dateFormat {
+	case model.QIFDateFormatYMD:
+		year, month, day = numbers[0], numbers[1], numbers[2]
+	case model.QIFDateFormatDMY, model.QIFDateFormatDMYDot:
+		day, month, year = numbers[0], numbers[1], numbers[2]
+	default:
+		month, day, year = numbers[0], numbers[1], numbers[2]
+	}
+	if year < 100 {
+		switch {
+		case strings.Contains(value, "'"), year < 70:
+			year += 2000
+		default:
+			year += 1900
+		}
+	}
+
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if month < 1 || month > 12 || date.Day() != day {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidStatementDate, value)
+	}
+	return date, nil
+}
+
+// qifDateParts splits a date into its runs of ASCII digits
+func qifDateParts(value string) []string {
+	return strings.FieldsFunc(value, func(r rune) bool { return r < '0' || r > '9' })
+}
+
+// guessQIFDateFormat guesses the order of the dates of a QIF file from its D fields: year first when the
+// first part has four digits, day first when a first part is over 12, and month first otherwise, as most
+// QIF files come from US software
+func guessQIFDateFormat(lines []string) model.QIFDateFormat {
+	format := model.QIFDateFormatMDY
+	for _, line := range lines {
+		if !strings.HasPrefix(line, "D") {
+			continue
+		}
+		parts := qifDateParts(line[1:])
+		if len(parts) != 3 {
+			continue
+		}
+		if len(parts[0]) == 4 {
+			return model.QIFDateFormatYMD
+		}
+		if first, err := strconv.Atoi(parts[0]); err == nil && first > 12 {
+			format = model.QIFDateFormatDMY
+		}
+	}
+	return format
+}
+
+// parseQIFAmount reads an amount with thousands separators, taking the last of a comma or point as the decimal
+// separator when it is followed by fewer than three digits or both appear, e.g. -1,234.56 or 1.234,56
+func parseQIFAmount(value string) (model.Decimal, error) {
+	decimalSeparator := "."
+	if comma := strings.LastIndex(value, ","); comma >= 0 {
+		point := strings.LastIndex(value, ".")
+		digitsAfter := len(strings.TrimFunc(value[comma+1:], func(r rune) bool { return !unicode.IsDigit(r) }))
+		if comma > point && (point >= 0 || digitsAfter != 3) {
+			decimalSeparator = ","
+		}
+	}
+	amount, ok, err := parseStatementAmount(value, decimalSeparator)
+	if err != nil {
+		return model.Decimal{}, err
+	}
+	if !ok {
+		return model.Decimal{}, ErrMissingStatementAmount
+	}
+	return amount, nil
+}
@@ -0,0 +1,314 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"backend/internal/domain/model"
+)
+
+// wantQIFTransaction is the part of a QIF transaction the tests compare. Category is the L field, and
+// splits are written as "category amount memo".
+type wantQIFTransaction struct {
+	line     int
+	postedOn string
+	amount   string
+	payee    string
+	memo     string
+	number   string
+	status   model.TransactionStatus
+	category string
+	splits   []string
+	err      error
+}
+
+func checkQIFTransactions(t *testing.T, got []model.QIFTransaction, want []wantQIFTransaction) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d transactions, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		transaction := got[i]
+		if w.err != nil {
+			if !errors.Is(transaction.Err, w.err) {
+				t.Errorf("transaction %d error = %v, want %v", i, transaction.Err, w.err)
+			}
+			continue
+		}
+		if transaction.Err != nil {
+			t.Errorf("transaction %d: %v", i, transaction.Err)
+			continue
+		}
+		if w.line != 0 && transaction.Line != w.line {
+			t.Errorf("transaction %d line = %d, want %d", i, transaction.Line, w.line)
+		}
+		if !transaction.PostedOn.Equal(date(w.postedOn)) || transaction.Amount.String() != w.amount {
+			t.Errorf("transaction %d = %s on %s, want %s on %s",
+				i, transaction.Amount, transaction.PostedOn.Format("2006-01-02"), w.amount, w.postedOn)
+		}
+		if transaction.Payee != w.payee || transaction.Memo != w.memo || transaction.Number != w.number {
+			t.Errorf("transaction %d payee, memo, number = %q, %q, %q, want %q, %q, %q",
+				i, transaction.Payee, transaction.Memo, transaction.Number, w.payee, w.memo, w.number)
+		}
+		status := w.status
+		if status == "" {
+			status = model.TransactionStatusUncleared
+		}
+		if transaction.Status != status {
+			t.Errorf("transaction %d status = %s, want %s", i, transaction.Status, status)
+		}
+		if category := qifCategoryField(transaction.Category, transaction.TransferAccount); category != w.category {
+			t.Errorf("transaction %d category = %q, want %q", i, category, w.category)
+		}
+		var splits []string
+		for _, split := range transaction.Splits {
+			splits = append(splits, strings.TrimSpace(fmt.Sprintf("%s %s %s",
+				qifCategoryField(split.Category, split.TransferAccount), split.Amount, split.Memo)))
+		}
+		if strings.Join(splits, "; ") != strings.Join(w.splits, "; ") {
+			t.Errorf("transaction %d splits = %q, want %q", i, splits, w.splits)
+		}
+	}
+}
+
+func TestParseQIF(t *testing.T) {
+	file, err := ParseQIF(readFixture(t, "quicken.qif"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantCategories := []model.QIFCategory{
+		{Path: []string{"Food"}, Kind: model.CategoryKindExpense, Description: "Food and drink"},
+		{Path: []string{"Food", "Groceries"}, Kind: model.CategoryKindExpense},
+		{Path: []string{"Salary"}, Kind: model.CategoryKindIncome},
+	}
+	if len(file.Categories) != len(wantCategories) {
+		t.Fatalf("got %d categories, want %d", len(file.Categories), len(wantCategories))
+	}
+	for i, want := range wantCategories {
+		got := file.Categories[i]
+		if strings.Join(got.Path, ":") != strings.Join(want.Path, ":") || got.Kind != want.Kind || got.Description != want.Description {
+			t.Errorf("category %d = %+v, want %+v", i, got, want)
+		}
+	}
+
+	// The accounts listed up front are matched with the registers that follow by name
+	if len(file.Accounts) != 2 {
+		t.Fatalf("got %d accounts, want 2", len(file.Accounts))
+	}
+	checking, visa := file.Accounts[0], file.Accounts[1]
+	if checking.Name != "Checking" || checking.Type != model.AccountTypeBank {
+		t.Errorf("account 0 = %s %s, want Checking bank", checking.Name, checking.Type)
+	}
+	if visa.Name != "Visa" || visa.Type != model.AccountTypeCreditCard || visa.Description != "Credit card" {
+		t.Errorf("account 1 = %s %s %q, want Visa credit card", visa.Name, visa.Type, visa.Description)
+	}
+	checkQIFTransactions(t, checking.Transactions, []wantQIFTransaction{
+		{
+			line: 27, postedOn: "2026-01-02", amount: "2500.00", payee: "ACME Payroll",
+			status: model.TransactionStatusCleared, category: "Salary",
+		},
+		{
+			line: 33, postedOn: "2026-01-05", amount: "-84.20", payee: "Café Central", memo: "Lunch", number: "1001",
+			status: model.TransactionStatusReconciled, category: "Food:Groceries",
+		},
+		{line: 41, postedOn: "2026-01-07", amount: "-300.00", payee: "Visa payment", category: "[Visa]"},
+		{
+			line: 46, postedOn: "2026-01-09", amount: "-150.00", payee: "Supermarket",
+			splits: []string{"Food:Groceries -120.00 Weekly shop", "Food -30.00"},
+		},
+		{err: ErrInvalidStatementDate},
+	})
+	checkQIFTransactions(t, visa.Transactions, []wantQIFTransaction{
+		{line: 64, postedOn: "2026-01-06", amount: "-42.00", payee: "Bookshop"},
+	})
+}
+
+func TestParseQIFRegister(t *testing.T) {
+	// A register exported for a single account: no account header, day-first dates, decimal commas and no
+	// ^ after the last transaction
+	file, err := ParseQIF(readFixture(t, "register_dmy.qif"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(file.Accounts) != 1 || file.Accounts[0].Name != "" || file.Accounts[0].Type != model.AccountTypeBank {
+		t.Fatalf("accounts = %+v, want one unnamed bank account", file.Accounts)
+	}
+	checkQIFTransactions(t, file.Accounts[0].Transactions, []wantQIFTransaction{
+		{line: 2, postedOn: "2026-01-03", amount: "-12.50", payee: "Bäckerei"},
+		{line: 6, postedOn: "2026-01-15", amount: "1234.56", payee: "Gehalt"},
+		{err: ErrInvalidStatementAmount},
+		{line: 14, postedOn: "2026-01-31", amount: "-5.00", payee: "No payee", memo: "No payee"},
+	})
+
+	// A date format given explicitly wins over the guess
+	file, err = ParseQIF(readFixture(t, "register_dmy.qif"), model.QIFDateFormatMDY)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkQIFTransactions(t, file.Accounts[0].Transactions, []wantQIFTransaction{
+		{postedOn: "2026-03-01", amount: "-12.50", payee: "Bäckerei"},
+		{err: ErrInvalidStatementDate},
+		{err: ErrInvalidStatementDate},
+		{err: ErrInvalidStatementDate},
+	})
+}
+
+func TestGuessQIFDateFormat(t *testing.T) {
+	tests := []struct {
+		name  string
+		dates []string
+		want  model.QIFDateFormat
+	}{
+		{name: "ambiguous", dates: []string{"01/02/2026", "03/04/2026"}, want: model.QIFDateFormatMDY},
+		{name: "month over 12 in the middle part", dates: []string{"01/02/2026", "12/31/2026"}, want: model.QIFDateFormatMDY},
+		{name: "day over 12 in the first part", dates: []string{"01/02/2026", "13/02/2026"}, want: model.QIFDateFormatDMY},
+		{name: "day over 12 with dots", dates: []string{"31.12.2025"}, want: model.QIFDateFormatDMY},
+		{name: "Quicken two-digit year", dates: []string{"1/ 2'26", "12/31'25"}, want: model.QIFDateFormatMDY},
+		{name: "year first", dates: []string{"13/01/2026", "2026-01-14"}, want: model.QIFDateFormatYMD},
+		{name: "non-ASCII digits", dates: []string{"１３/01/2026"}, want: model.QIFDateFormatMDY},
+		{name: "too many digits", dates: []string{"99999999999999999999/01/2026"}, want: model.QIFDateFormatMDY},
+		{name: "not a date", dates: []string{"13/01", "yesterday"}, want: model.QIFDateFormatMDY},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Other fields starting with D, such as descriptions, are not dates
+			lines := []string{"!Type:Bank", "DFood 2024"}
+			for _, d := range tt.dates {
+				lines = append(lines, "D"+d, "T-1.00", "^")
+			}
+			if got := guessQIFDateFormat(lines); got != tt.want {
+				t.Errorf("guessQIFDateFormat(%q) = %s, want %s", tt.dates, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseQIFDate(t *testing.T) {
+	tests := []struct {
+		value   string
+		format  model.QIFDateFormat
+		want    string
+		wantErr bool
+	}{
+		{value: "1/ 2'26", format: model.QIFDateFormatMDY, want: "2026-01-02"},
+		{value: "12/31/99", format: model.QIFDateFormatMDY, want: "1999-12-31"},
+		{value: "12/31/69", format: model.QIFDateFormatMDY, want: "2069-12-31"},
+		{value: "31/12/2025", format: model.QIFDateFormatDMY, want: "2025-12-31"},
+		{value: "31.12.2025", format: model.QIFDateFormatDMYDot, want: "2025-12-31"},
+		{value: "2025-12-31", format: model.QIFDateFormatYMD, want: "2025-12-31"},
+		{value: "31/12/2025", format: model.QIFDateFormatMDY, wantErr: true},
+		{value: "02/29/2025", format: model.QIFDateFormatMDY, wantErr: true},
+		{value: "02/2025", format: model.QIFDateFormatMDY, wantErr: true},
+		{value: "１/２/2026", format: model.QIFDateFormatMDY, wantErr: true},
+		{value: "1/2/99999999999999999999", format: model.QIFDateFormatMDY, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseQIFDate(tt.value, tt.format)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidStatementDate) {
+				t.Errorf("parseQIFDate(%q, %s) error = %v, want ErrInvalidStatementDate", tt.value, tt.format, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseQIFDate(%q, %s): %v", tt.value, tt.format, err)
+			continue
+		}
+		if !got.Equal(date(tt.want)) {
+			t.Errorf("parseQIFDate(%q, %s) = %s, want %s", tt.value, tt.format, got.Format("2006-01-02"), tt.want)
+		}
+	}
+}
+
+func TestParseQIFMalformed(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		dateFormat model.QIFDateFormat
+		wantErr    error
+	}{
+		{name: "date format", content: "!Type:Bank\nD01/02/2026\nT-1.00\n^\n", dateFormat: "DD-MM-YY", wantErr: ErrInvalidQIFDateFormat},
+		{name: "empty", content: "", wantErr: ErrEmptyQIF},
+		{name: "ignored sections only", content: "!Type:Class\nNVacation\n^\n!Type:Memorized\nPRent\n^\n", wantErr: ErrEmptyQIF},
+		{name: "record before any header", content: "D01/02/2026\nT-1.00\n^\n", wantErr: ErrMalformedQIF},
+		{name: "unterminated record", content: "!Type:Bank\nD01/02/2026\nT-1.00\n!Type:Cat\nNFood\n^\n", wantErr: ErrMalformedQIF},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseQIF([]byte(tt.content), tt.dateFormat); !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFormatQIF(t *testing.T) {
+	file, err := ParseQIF(readFixture(t, "quicken.qif"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Unreadable transactions are not exported
+	file.Accounts[0].Transactions = file.Accounts[0].Transactions[:4]
+
+	for _, format := range []model.QIFDateFormat{model.QIFDateFormatMDY, model.QIFDateFormatDMYDot, model.QIFDateFormatYMD} {
+		t.Run(string(format), func(t *testing.T) {
+			content, err := FormatQIF(file, format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			again, err := ParseQIF(content, format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(again.Categories) != len(file.Categories) || len(again.Accounts) != len(file.Accounts) {
+				t.Fatalf("read back %d categories and %d accounts, want %d and %d",
+					len(again.Categories), len(again.Accounts), len(file.Categories), len(file.Accounts))
+			}
+			for i, account := range file.Accounts {
+				got := again.Accounts[i]
+				if got.Name != account.Name || got.Type != account.Type {
+					t.Errorf("account %d read back as %s %s, want %s %s", i, got.Name, got.Type, account.Name, account.Type)
+				}
+				gotIDs, wantIDs := QIFTransactionIDs(got.Transactions), QIFTransactionIDs(account.Transactions)
+				if strings.Join(gotIDs, ",") != strings.Join(wantIDs, ",") {
+					t.Errorf("account %d transactions changed on the way through the file", i)
+				}
+			}
+		})
+	}
+
+	if _, err := FormatQIF(file, "DD-MM-YY"); !errors.Is(err, ErrInvalidQIFDateFormat) {
+		t.Errorf("error = %v, want ErrInvalidQIFDateFormat", err)
+	}
+}
+
+func TestQIFTransactionIDs(t *testing.T) {
+	content := "!Type:Bank\n" +
+		"D01/02/2026\nT-4.50\nPCoffee\n^\n" +
+		"D01/02/2026\nT-4.50\nPCoffee\n^\n" +
+		"D01/03/2026\nT-4.50\nPCoffee\n^\n" +
+		"D02/30/2026\nT-4.50\nPCoffee\n^\n"
+	file, err := ParseQIF([]byte(content), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := QIFTransactionIDs(file.Accounts[0].Transactions)
+	if ids[0] == "" || ids[0] == ids[1] || ids[1] == ids[2] || ids[0] == ids[2] {
+		t.Errorf("IDs = %q, want a distinct ID for each transaction, identical ones included", ids)
+	}
+	if ids[3] != "" {
+		t.Errorf("ID of the unreadable transaction = %q, want none", ids[3])
+	}
+
+	// Importing the same file again gives the same IDs, and adding transactions leaves the earlier ones as they are
+	file, err = ParseQIF([]byte(content+"D01/04/2026\nT-4.50\nPCoffee\n^\n"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again := QIFTransactionIDs(file.Accounts[0].Transactions); strings.Join(again[:4], ",") != strings.Join(ids, ",") {
+		t.Errorf("IDs read again = %q, want %q", again[:4], ids)
+	}
+}
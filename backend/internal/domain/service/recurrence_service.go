@@ -0,0 +1,243 @@
+package service
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	"backend/internal/domain/model"
+)
+
+var (
+	// ErrInvalidRecurrenceFrequency is returned for unknown recurrence frequencies
+	ErrInvalidRecurrenceFrequency = errors.New("recurrence frequency must be daily, weekly, monthly or yearly")
+	// ErrInvalidRecurrenceInterval is returned for intervals below 1
+	ErrInvalidRecurrenceInterval = errors.New("recurrence interval must be at least 1")
+	// ErrInvalidRecurrenceByDay is returned for by-day values that are malformed or do not fit the frequency
+	ErrInvalidRecurrenceByDay = errors.New("recurrence by-day values must be weekdays (MO..SU), prefixed by an ordinal only for monthly rules")
+	// ErrRecurrenceEndsBeforeStart is returned when the end date of a recurrence precedes its start date
+	ErrRecurrenceEndsBeforeStart = errors.New("recurrence end date is before its start date")
+)
+
+// recurrenceWeekdays maps RRULE weekday codes to weekdays
+var recurrenceWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// recurrenceByDay is a parsed RRULE BYDAY value; Ordinal is 0 for every such weekday of the period
+type recurrenceByDay struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+// ValidateRecurrenceRule checks the schedule of a recurrence rule
+func ValidateRecurrenceRule(rule *model.RecurrenceRule) error {
+	if !rule.Frequency.IsValid() {
+		return ErrInvalidRecurrenceFrequency
+	}
+	if rule.Interval < 1 {
+		return ErrInvalidRecurrenceInterval
+	}
+	if _, err := parseRecurrenceByDays(rule); err != nil {
+		return err
+	}
+	if rule.EndsOn != nil && rule.EndsOn.Before(rule.StartsOn) {
+		return ErrRecurrenceEndsBeforeStart
+	}
+	return nil
+}
+
+// parseRecurrenceByDays parses the by-day values of a rule: plain weekdays for weekly rules,
+// weekdays with an optional ordinal between -5 and 5 for monthly rules, and none otherwise
+func parseRecurrenceByDays(rule *model.RecurrenceRule) ([]recurrenceByDay, error) {
+	if len(rule.ByDay) > 0 &&
+		rule.Frequency != model.RecurrenceFrequencyWeekly &&
+		rule.Frequency != model.RecurrenceFrequencyMonthly {
+		return nil, ErrInvalidRecurrenceByDay
+	}
+
+	byDays := make([]recurrenceByDay, 0, len(rule.ByDay))
+	for _, value := range rule.ByDay {
+		if len(value) < 2 {
+			return nil, ErrInvalidRecurrenceByDay
+		}
+		weekday, ok := recurrenceWeekdays[value[len(value)-2:]]
+		if !ok {
+			return nil, ErrInvalidRecurrenceByDay
+		}
+		ordinal := 0
+		if prefix := value[:len(value)-2]; prefix != "" {
+			n, err := strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -5 || n > 5 || rule.Frequency != model.RecurrenceFrequencyMonthly {
+				return nil, ErrInvalidRecurrenceByDay
+			}
+			ordinal = n
+		}
+		byDays = append(byDays, recurrenceByDay{Ordinal: ordinal, Weekday: weekday})
+	}
+	return byDays, nil
+}
+
+// RecurrenceOccurrences returns the due dates of a valid rule between from and to inclusive, in order.
+// Dates are UTC midnights; occurrences before the rule's start or after its end are left out.
+func RecurrenceOccurrences(rule *model.RecurrenceRule, from, to time.Time) []time.Time {
+	byDays, err := parseRecurrenceByDays(rule)
+	if err != nil || rule.Interval < 1 {
+		return nil
+	}
+
+	start := CalendarDate(rule.StartsOn)
+	from, to = CalendarDate(from), CalendarDate(to)
+	if from.Before(start) {
+		from = start
+	}
+	if rule.EndsOn != nil && rule.EndsOn.Before(to) {
+		to = CalendarDate(*rule.EndsOn)
+	}
+	if to.Before(from) {
+		return nil
+	}
+
+	// Jump to the last period starting no later than from; its dates may still fall on or after from
+	period := recurrencePeriodsBetween(rule.Frequency, start, from) / rule.Interval * rule.Interval
+
+	var dates []time.Time
+	for ; ; period += rule.Interval {
+		periodStart := recurrencePeriodStart(rule.Frequency, start, period)
+		if periodStart.After(to) {
+			break
+		}
+		for _, date := range recurrencePeriodDates(rule.Frequency, start, periodStart, byDays) {
+			if !date.Before(from) && !date.After(to) {
+				dates = append(dates, date)
+			}
+		}
+	}
+	return dates
+}
+
+// ScheduledRecurrenceOccurrences returns the due dates of a valid rule from the day its schedule was
+// set through the date, in order. Dates already past when the rule was created or edited are left out.
+func ScheduledRecurrenceOccurrences(rule *model.RecurrenceRule, through time.Time) []time.Time {
+	return RecurrenceOccurrences(rule, rule.ScheduledFrom, through)
+}
+
+// recurrencePeriodStart returns the first day of the period-th period counted from the one containing start.
+// Weeks start on Monday, as with the RRULE default WKST=MO.
+func recurrencePeriodStart(frequency model.RecurrenceFrequency, start time.Time, period int) time.Time {
+	switch frequency {
+	case model.RecurrenceFrequencyWeekly:
+		return weekStart(start).AddDate(0, 0, 7*period)
+	case model.RecurrenceFrequencyMonthly:
+		return time.Date(start.Year(), start.Month()+time.Month(period), 1, 0, 0, 0, 0, time.UTC)
+	case model.RecurrenceFrequencyYearly:
+		return time.Date(start.Year()+period, time.January, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return start.AddDate(0, 0, period)
+	}
+}
+
+// recurrencePeriodsBetween returns how many whole periods separate the periods containing start and date
+func recurrencePeriodsBetween(frequency model.RecurrenceFrequency, start, date time.Time) int {
+	switch frequency {
+	case model.RecurrenceFrequencyWeekly:
+		return daysBetween(weekStart(start), weekStart(date)) / 7
+	case model.RecurrenceFrequencyMonthly:
+		return (date.Year()-start.Year())*12 + int(date.Month()) - int(start.Month())
+	case model.RecurrenceFrequencyYearly:
+		return date.Year() - start.Year()
+	default:
+		return daysBetween(start, date)
+	}
+}
+
+// recurrencePeriodDates returns the dates of a rule within the period starting on periodStart, in order;
+// some may precede the rule's start when the first period only partly follows it
+func recurrencePeriodDates(
+	frequency model.RecurrenceFrequency,
+	start time.Time,
+	periodStart time.Time,
+	byDays []recurrenceByDay,
+) []time.Time {
+	var dates []time.Time
+	switch frequency {
+	case model.RecurrenceFrequencyWeekly:
+		if len(byDays) == 0 {
+			byDays = []recurrenceByDay{{Weekday: start.Weekday()}}
+		}
+		for _, byDay := range byDays {
+			dates = append(dates, periodStart.AddDate(0, 0, (int(byDay.Weekday)+6)%7))
+		}
+	case model.RecurrenceFrequencyMonthly:
+		if len(byDays) == 0 {
+			return []time.Time{clampedDate(periodStart.Year(), periodStart.Month(), start.Day())}
+		}
+		for _, byDay := range byDays {
+			dates = append(dates, monthWeekdays(periodStart, byDay)...)
+		}
+	case model.RecurrenceFrequencyYearly:
+		return []time.Time{clampedDate(periodStart.Year(), start.Month(), start.Day())}
+	default:
+		return []time.Time{periodStart}
+	}
+
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+	unique := dates[:0]
+	for i, date := range dates {
+		if i == 0 || !date.Equal(dates[i-1]) {
+			unique = append(unique, date)
+		}
+	}
+	return unique
+}
+
+// monthWeekdays returns the days of the month starting on monthStart matching byDay:
+// every such weekday for ordinal 0, otherwise the n-th one, counted from the end when negative
+func monthWeekdays(monthStart time.Time, byDay recurrenceByDay) []time.Time {
+	var days []time.Time
+	for day := monthStart; day.Month() == monthStart.Month(); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() == byDay.Weekday {
+			days = append(days, day)
+		}
+	}
+	switch {
+	case byDay.Ordinal == 0:
+		return days
+	case byDay.Ordinal > 0 && byDay.Ordinal <= len(days):
+		return days[byDay.Ordinal-1 : byDay.Ordinal]
+	case byDay.Ordinal < 0 && -byDay.Ordinal <= len(days):
+		i := len(days) + byDay.Ordinal
+		return days[i : i+1]
+	}
+	return nil
+}
+
+// clampedDate returns the date, moved back to the last day of the month when the month is shorter
+func clampedDate(year int, month time.Month, day int) time.Time {
+	if last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day(); day > last {
+		day = last
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// weekStart returns the Monday of the week containing date
+func weekStart(date time.Time) time.Time {
+	return date.AddDate(0, 0, -((int(date.Weekday()) + 6) % 7))
+}
+
+// daysBetween returns the number of days from a to b; both must be UTC midnights
+func daysBetween(a, b time.Time) int {
+	return int(b.Sub(a).Hours() / 24)
+}
+
+// CalendarDate returns the UTC midnight of the calendar date of t, the form due dates are compared in
+func CalendarDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
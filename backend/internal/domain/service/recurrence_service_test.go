@@ -0,0 +1,70 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"backend/internal/domain/model"
+)
+
+// formatDates renders dates as YYYY-MM-DD for comparison
+func formatDates(dates []time.Time) []string {
+	formatted := make([]string, len(dates))
+	for i, d := range dates {
+		formatted[i] = d.Format("2006-01-02")
+	}
+	return formatted
+}
+
+func TestScheduledRecurrenceOccurrences(t *testing.T) {
+	tests := []struct {
+		name          string
+		startsOn      string
+		scheduledFrom string
+		through       string
+		want          []string
+	}{
+		{
+			name:          "created before the start",
+			startsOn:      "2026-01-15",
+			scheduledFrom: "2026-01-10",
+			through:       "2026-03-31",
+			want:          []string{"2026-01-15", "2026-02-15", "2026-03-15"},
+		},
+		{
+			// Created in January and edited in April: the months in between are not backfilled
+			name:          "edited after the start",
+			startsOn:      "2026-01-15",
+			scheduledFrom: "2026-04-20",
+			through:       "2026-06-30",
+			want:          []string{"2026-05-15", "2026-06-15"},
+		},
+		{
+			name:          "edited on a due date",
+			startsOn:      "2026-01-15",
+			scheduledFrom: "2026-04-15",
+			through:       "2026-05-15",
+			want:          []string{"2026-04-15", "2026-05-15"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &model.RecurrenceRule{
+				Frequency:     model.RecurrenceFrequencyMonthly,
+				Interval:      1,
+				StartsOn:      date(tt.startsOn),
+				ScheduledFrom: date(tt.scheduledFrom),
+			}
+			got := formatDates(ScheduledRecurrenceOccurrences(rule, date(tt.through)))
+			if len(got) != len(tt.want) {
+				t.Fatalf("occurrences = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("occurrences = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"backend/internal/domain/model"
+
+	"golang.org/x/text/width"
+)
+
+var (
+	// ErrUnsupportedStatementFormat is returned for uploaded files that are not a supported statement format
+	ErrUnsupportedStatementFormat = errors.New("unsupported statement format; upload a CSV, OFX, camt.053 or MT940 file")
+	// ErrMalformedStatement is returned when a statement file cannot be decoded
+	ErrMalformedStatement = errors.New("statement file is malformed")
+	// ErrEmptyStatement is returned when a statement file has no transaction rows
+	ErrEmptyStatement = errors.New("statement has no transactions")
+	// ErrUnbalancedStatement is returned for statements whose transactions do not add up from the opening to the closing balance
+	ErrUnbalancedStatement = errors.New("statement transactions do not add up to the closing balance")
+	// ErrImportProfileNameRequired is returned for import profiles with a blank name
+	ErrImportProfileNameRequired = errors.New("import profile name is required")
+
+	// ErrMissingStatementColumn is reported for rows lacking a mapped column
+	ErrMissingStatementColumn = errors.New("row has too few columns")
+	// ErrInvalidStatementDate is reported for rows whose date does not match the date format
+	ErrInvalidStatementDate = errors.New("invalid date")
+	// ErrInvalidStatementAmount is reported for rows whose amount is not a number
+	ErrInvalidStatementAmount = errors.New("invalid amount")
+	// ErrMissingStatementAmount is reported for rows without an amount
+	ErrMissingStatementAmount = errors.New("row has no amount")
+	// ErrDebitAndCredit is reported for rows with both a debit and a credit
+	ErrDebitAndCredit = errors.New("row has both a debit and a credit")
+)
+
+// maxStatementTextLength is the length descriptions and memos read from statements are cut to, that of the columns they are stored in
+const maxStatementTextLength = 255
+
+// negativeAmountMarks are the prefixes Japanese statements use for negative amounts instead of a minus sign
+var negativeAmountMarks = []string{"△", "▲"}
+
+// DetectStatementFormat returns the format of an uploaded statement from its content or file name, falling back
+// to CSV for other text files
+func DetectStatementFormat(filename string, content []byte) (model.StatementFormat, error) {
+	switch {
+	case IsOFXStatement(content):
+		return model.StatementFormatOFX, nil
+	case IsCAMT053Statement(content):
+		return model.StatementFormatCAMT053, nil
+	case IsMT940Statement(content):
+		return model.StatementFormatMT940, nil
+	}
+
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".ofx", ".qfx":
+		return model.StatementFormatOFX, nil
+	case ".xml":
+		return model.StatementFormatCAMT053, nil
+	case ".sta", ".mt940", ".940":
+		return model.StatementFormatMT940, nil
+	case ".csv", ".tsv", ".txt":
+		return model.StatementFormatCSV, nil
+	}
+
+	head := content[:min(len(content), 512)]
+	if len(head) == 0 || bytes.IndexByte(head, 0) >= 0 {
+		return "", ErrUnsupportedStatementFormat
+	}
+	return model.StatementFormatCSV, nil
+}
+
+// ParseStatement reads a statement file in a format that describes its own layout. CSV statements are read
+// with a mapping by ParseCSVStatement instead.
+func ParseStatement(format model.StatementFormat, content []byte) (*model.Statement, error) {
+	switch format {
+	case model.StatementFormatOFX:
+		return ParseOFXStatement(content)
+	case model.StatementFormatCAMT053:
+		return ParseCAMT053Statement(content)
+	case model.StatementFormatMT940:
+		return ParseMT940Statement(content)
+	}
+	return nil, ErrUnsupportedStatementFormat
+}
+
+// joinStatements combines the consecutive statements of one account that a file may hold, e.g. one per day,
+// into a statement running from the opening balance of the first to the closing balance of the last.
+// accounts holds the account each statement is for.
+func joinStatements(accounts []string, statements []*model.Statement) (*model.Statement, error) {
+	if len(statements) == 0 {
+		return nil, ErrEmptyStatement
+	}
+	for _, account := range accounts[1:] {
+		if account != accounts[0] {
+			return nil, fmt.Errorf("%w: the file holds the statements of several accounts; download one account at a time", ErrMalformedStatement)
+		}
+	}
+
+	joined := &model.Statement{
+		Currency:       statements[0].Currency,
+		OpeningBalance: statements[0].OpeningBalance,
+		ClosingBalance: statements[len(statements)-1].ClosingBalance,
+	}
+	for _, statement := range statements {
+		joined.Rows = append(joined.Rows, statement.Rows...)
+	}
+	if len(joined.Rows) == 0 {
+		return nil, ErrEmptyStatement
+	}
+	if err := validateStatementTotals(joined); err != nil {
+		return nil, err
+	}
+	return joined, nil
+}
+
+// validateStatementTotals checks that the rows of a statement add up from its opening to its closing balance.
+// Statements with unreadable rows are not checked; the rows are reported on their own.
+func validateStatementTotals(statement *model.Statement) error {
+	if statement.OpeningBalance == nil || statement.ClosingBalance == nil {
+		return nil
+	}
+	total := statement.OpeningBalance.Amount
+	for _, row := range statement.Rows {
+		if row.Err != nil {
+			return nil
+		}
+		total = total.Add(row.Amount)
+	}
+	if total.Cmp(statement.ClosingBalance.Amount) != 0 {
+		return fmt.Errorf("%w: opening balance %s plus transactions makes %s, not %s",
+			ErrUnbalancedStatement, statement.OpeningBalance.Amount, total, statement.ClosingBalance.Amount)
+	}
+	return nil
+}
+
+// CheckStatementBalance compares the closing balance of a statement with the balance of the account on the same day
+func CheckStatementBalance(balance model.StatementBalance, accountBalance model.Money) (*model.StatementBalanceCheck, error) {
+	statementBalance, err := model.RoundMoney(balance.Amount, accountBalance.Currency())
+	if err != nil {
+		return nil, err
+	}
+	difference, err := statementBalance.Sub(accountBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.StatementBalanceCheck{
+		AsOf:             balance.AsOf,
+		StatementBalance: statementBalance,
+		AccountBalance:   accountBalance,
+		Difference:       difference,
+	}, nil
+}
+
+// ValidateImportProfile checks an import profile before it is saved, filling in the defaults of its mapping
+func ValidateImportProfile(profile *model.ImportProfile) error {
+	profile.Name = strings.TrimSpace(profile.Name)
+	if profile.Name == "" {
+		return ErrImportProfileNameRequired
+	}
+	return ValidateCSVMapping(&profile.Mapping)
+}
+
+// parseStatementAmount parses amounts as banks print them: with thousands separators, currency symbols,
+// full-width digits, parentheses or a trailing minus for negatives, and the given decimal separator.
+// It returns false for blank cells, including those holding only a dash.
+func parseStatementAmount(value string, decimalSeparator string) (model.Decimal, bool, error) {
+	value = strings.TrimSpace(width.Fold.String(value))
+	if strings.Trim(value, "-–—") == "" {
+		return model.Decimal{}, false, nil
+	}
+
+	negative := false
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		negative = true
+		value = value[1 : len(value)-1]
+	}
+	for _, mark := range negativeAmountMarks {
+		if strings.HasPrefix(value, mark) {
+			negative = true
+			value = strings.TrimPrefix(value, mark)
+		}
+	}
+	if strings.HasSuffix(value, "-") {
+		negative = true
+		value = strings.TrimSuffix(value, "-")
+	}
+
+	thousandsSeparator := ","
+	if decimalSeparator == "," {
+		thousandsSeparator = "."
+	}
+	var digits strings.Builder
+	for _, r := range value {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case string(r) == decimalSeparator:
+			digits.WriteRune('.')
+		case r == '-' && digits.Len() == 0:
+			negative = !negative
+		case r == '+' && digits.Len() == 0, string(r) == thousandsSeparator, r == ' ', r == '\'':
+		case r > 0x7f || r == '$':
+			// Currency symbols and units such as ¥, € or 円
+		default:
+			return model.Decimal{}, false, ErrInvalidStatementAmount
+		}
+	}
+	if digits.Len() == 0 {
+		return model.Decimal{}, false, ErrInvalidStatementAmount
+	}
+
+	amount, err := model.ParseDecimal(digits.String())
+	if err != nil {
+		return model.Decimal{}, false, ErrInvalidStatementAmount
+	}
+	if negative {
+		amount = amount.Neg()
+	}
+	return amount, true, nil
+}
+
+// statementText trims a description or memo read from a statement, collapsing runs of whitespace such as
+// line breaks, and cuts it to maxStatementTextLength characters
+func statementText(value string) string {
+	value = strings.Join(strings.Fields(value), " ")
+	if runes := []rune(value); len(runes) > maxStatementTextLength {
+		value = strings.TrimSpace(string(runes[:maxStatementTextLength]))
+	}
+	return value
+}
+
+// finishStatementRow tidies the texts of a row read from a statement. Rows without a counterparty name
+// are described by their remittance information instead.
+func finishStatementRow(row model.StatementRow) model.StatementRow {
+	row.Payee = statementText(row.Payee)
+	row.Memo = statementText(row.Memo)
+	if row.Payee == "" {
+		row.Payee, row.Memo = row.Memo, ""
+	}
+	row.CounterpartyIBAN = strings.ReplaceAll(strings.TrimSpace(row.CounterpartyIBAN), " ", "")
+	row.EndToEndID = statementText(row.EndToEndID)
+	return row
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"errors"
+	"strings"
+
+	"backend/internal/domain/model"
+)
+
+var (
+	// ErrTagNameRequired is returned for tags without a name
+	ErrTagNameRequired = errors.New("tag name is required")
+	// ErrInvalidTagColor is returned for colors that are not formatted as #RRGGBB
+	ErrInvalidTagColor = errors.New("tag color must be formatted as #RRGGBB")
+	// ErrInvalidTagMatch is returned for unknown tag matching modes
+	ErrInvalidTagMatch = errors.New("tag match must be any or all")
+)
+
+// ValidateTag checks the user-editable fields of a tag
+func ValidateTag(tag *model.Tag) error {
+	if strings.TrimSpace(tag.Name) == "" {
+		return ErrTagNameRequired
+	}
+	if tag.Color != "" && !colorRegex.MatchString(tag.Color) {
+		return ErrInvalidTagColor
+	}
+	return nil
+}
+
+// validateTagMatch checks the matching mode of a tag filter; empty means any
+func validateTagMatch(match model.TagMatch) error {
+	if match != "" && !match.IsValid() {
+		return ErrInvalidTagMatch
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTP parameters (RFC 6238 defaults, which every authenticator app supports)
+const (
+	totpDigits     = 6
+	totpPeriod     = 30 // seconds
+	totpSkew       = 1  // accepted steps before/after the current one
+	totpSecretSize = 20 // bytes, the HMAC-SHA1 block recommended by RFC 4226
+
+	recoveryCodeCount = 10
+	recoveryCodeSize  = 10 // base32 characters
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret returns a new base32-encoded TOTP shared secret
+func GenerateTOTPSecret() (string, error) {
+	b := make([]byte, totpSecretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate TOTP secret: %w", err)
+	}
+	return totpEncoding.EncodeToString(b), nil
+}
+
+// TOTPURI returns the otpauth:// URI that authenticator apps import (usually via QR code)
+func TOTPURI(issuer, accountName, secret string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(totpDigits))
+	v.Set("period", fmt.Sprint(totpPeriod))
+
+	label := url.PathEscape(issuer + ":" + accountName)
+	return "otpauth://totp/" + label + "?" + v.Encode()
+}
+
+// ValidateTOTP checks a code against the secret at time t, allowing for clock skew.
+// It returns the matching time step so callers can reject a code that was already used.
+func ValidateTOTP(secret, code string, t time.Time) (step int64, ok bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != totpDigits {
+		return 0, false
+	}
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return 0, false
+	}
+
+	current := t.Unix() / totpPeriod
+	for s := current - totpSkew; s <= current+totpSkew; s++ {
+		if subtle.ConstantTimeCompare([]byte(hotp(key, s)), []byte(code)) == 1 {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+// hotp computes the RFC 4226 HOTP value for the counter
+func hotp(key []byte, counter int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
+
+// GenerateRecoveryCodes returns a fresh set of one-time recovery codes formatted as XXXXX-XXXXX
+func GenerateRecoveryCodes() ([]string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, recoveryCodeSize)
+		if _, err := rand.Read(b); err != nil {
+			return nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		raw := totpEncoding.EncodeToString(b)[:recoveryCodeSize]
+		codes = append(codes, raw[:recoveryCodeSize/2]+"-"+raw[recoveryCodeSize/2:])
+	}
+	return codes, nil
+}
+
+// NormalizeRecoveryCode canonicalizes user input so that case and separators do not matter
+func NormalizeRecoveryCode(code string) string {
+	code = strings.ToUpper(code)
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
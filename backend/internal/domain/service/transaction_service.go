@@ -0,0 +1,86 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"backend/internal/domain/model"
+)
+
+var (
+	// ErrInvalidTransactionStatus is returned for unknown transaction statuses
+	ErrInvalidTransactionStatus = errors.New("invalid transaction status")
+	// ErrInvalidTransactionFilter is returned when a range filter has its bounds reversed
+	ErrInvalidTransactionFilter = errors.New("range filter lower bound is greater than its upper bound")
+	// ErrTooFewSplits is returned for split transactions with a single split
+	ErrTooFewSplits = errors.New("a split transaction needs at least two splits")
+	// ErrSplitsAmountMismatch is returned when the splits do not sum exactly to the transaction amount
+	ErrSplitsAmountMismatch = errors.New("splits must sum to the transaction amount")
+	// ErrSplitTransactionCategory is returned when a split transaction also has a category of its own
+	ErrSplitTransactionCategory = errors.New("split transactions are categorized by their splits")
+)
+
+// ValidateTransaction checks the user-editable fields of a transaction
+func ValidateTransaction(transaction *model.Transaction) error {
+	if !transaction.Status.IsValid() {
+		return ErrInvalidTransactionStatus
+	}
+	if transaction.IsSplit() {
+		return validateSplits(transaction)
+	}
+	return nil
+}
+
+// validateSplits checks that the splits of a transaction sum exactly to its amount
+func validateSplits(transaction *model.Transaction) error {
+	if len(transaction.Splits) < 2 {
+		return ErrTooFewSplits
+	}
+	if transaction.CategoryID != nil {
+		return ErrSplitTransactionCategory
+	}
+
+	remaining := transaction.Amount
+	for _, split := range transaction.Splits {
+		var err error
+		if remaining, err = remaining.Sub(split.Amount); err != nil {
+			return err
+		}
+	}
+	if !remaining.IsZero() {
+		return fmt.Errorf("%w: off by %s %s", ErrSplitsAmountMismatch, remaining.Amount(), remaining.Currency())
+	}
+	return nil
+}
+
+// TransactionAllocations returns the amounts a transaction contributes to its categories:
+// one per split, or the whole amount when it is not split
+func TransactionAllocations(transaction *model.Transaction) []model.CategoryTotal {
+	if !transaction.IsSplit() {
+		return []model.CategoryTotal{{CategoryID: transaction.CategoryID, Amount: transaction.Amount}}
+	}
+	allocations := make([]model.CategoryTotal, 0, len(transaction.Splits))
+	for _, split := range transaction.Splits {
+		allocations = append(allocations, model.CategoryTotal{CategoryID: split.CategoryID, Amount: split.Amount})
+	}
+	return allocations
+}
+
+// ValidateTransactionFilter checks that the ranges of a filter are not reversed and that its tag match is known
+func ValidateTransactionFilter(filter model.TransactionFilter) error {
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return ErrInvalidTransactionFilter
+	}
+	if filter.MinAmount != nil && filter.MaxAmount != nil && filter.MinAmount.Cmp(*filter.MaxAmount) > 0 {
+		return ErrInvalidTransactionFilter
+	}
+	return validateTagMatch(filter.TagMatch)
+}
+
+// ValidateReportFilter checks that the date range of a report filter is not reversed and that its tag match is known
+func ValidateReportFilter(filter model.ReportFilter) error {
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return ErrInvalidTransactionFilter
+	}
+	return validateTagMatch(filter.TagMatch)
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"backend/internal/domain/model"
+)
+
+var (
+	// ErrTransferSameAccount is returned for transfers whose two sides are on the same account
+	ErrTransferSameAccount = errors.New("a transfer must move money between two different accounts")
+	// ErrInvalidTransferDirection is returned unless the outgoing side is negative and the incoming side positive
+	ErrInvalidTransferDirection = errors.New("the outgoing side of a transfer must be negative and the incoming side positive")
+	// ErrSplitTransfer is returned when a split transaction would become one side of a transfer
+	ErrSplitTransfer = errors.New("split transactions cannot be part of a transfer")
+	// ErrNegativeTransferFee is returned for negative transfer fees
+	ErrNegativeTransferFee = errors.New("transfer fees cannot be negative")
+	// ErrTransferFeeTooLarge is returned when the sending fee leaves nothing to transfer
+	ErrTransferFeeTooLarge = errors.New("the sending fee must be less than the outgoing amount")
+	// ErrTransferAmountMismatch is returned when a transfer between accounts of the same currency
+	// receives a different amount than it sent
+	ErrTransferAmountMismatch = errors.New("a transfer in a single currency must receive the amount it sent")
+)
+
+// ValidateTransfer checks that a transfer moves money from one account to another.
+// When both accounts share a currency the amount received, before the receiving fee,
+// must equal the amount sent after the sending fee.
+func ValidateTransfer(transfer *model.Transfer) error {
+	if transfer.From.AccountID == transfer.To.AccountID {
+		return ErrTransferSameAccount
+	}
+	if !transfer.From.Amount.IsNegative() || !transfer.To.Amount.IsPositive() {
+		return ErrInvalidTransferDirection
+	}
+	if transfer.From.IsSplit() || transfer.To.IsSplit() {
+		return ErrSplitTransfer
+	}
+	if transfer.FromFee.IsNegative() || transfer.ToFee.IsNegative() {
+		return ErrNegativeTransferFee
+	}
+
+	sent, err := transfer.Sent()
+	if err != nil {
+		return err
+	}
+	if !sent.IsPositive() {
+		return ErrTransferFeeTooLarge
+	}
+	received, err := transfer.Received()
+	if err != nil {
+		return err
+	}
+	if sent.SameCurrency(received) {
+		diff, err := received.Sub(sent)
+		if err != nil {
+			return err
+		}
+		if !diff.IsZero() {
+			return fmt.Errorf("%w: off by %s %s", ErrTransferAmountMismatch, diff.Amount(), diff.Currency())
+		}
+	}
+	return nil
+}
+
+// TransferJournalEntry returns the journal entry of one side of a transfer: the amount is posted to the
+// transaction's account, the fee to the expense account and the rest to the transfer account of
+// balancingAccountIDs, where it cancels out against the other side
+func TransferJournalEntry(
+	transaction *model.Transaction,
+	fee model.Money,
+	balancingAccountIDs map[model.AccountType]int,
+) (*model.JournalEntry, error) {
+	inTransit, err := transaction.Amount.Add(fee)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionID := transaction.ID
+	entry := &model.JournalEntry{
+		WorkspaceID:   transaction.WorkspaceID,
+		TransactionID: &transactionID,
+		PostedOn:      transaction.PostedOn,
+		Memo:          transaction.Memo,
+		Postings: []*model.Posting{
+			{AccountID: transaction.AccountID, Amount: transaction.Amount},
+			{AccountID: balancingAccountIDs[model.AccountTypeTransfer], Amount: inTransit.Neg()},
+		},
+	}
+	if !fee.IsZero() {
+		entry.Postings = append(entry.Postings, &model.Posting{
+			AccountID: balancingAccountIDs[model.AccountTypeExpense],
+			Amount:    fee,
+		})
+	}
+	return entry, nil
+}
+
+// TransferMatch is a pair of unlinked transactions that look like the two sides of a transfer
+type TransferMatch struct {
+	From *model.Transaction
+	To   *model.Transaction
+}
+
+// MatchTransfers pairs outgoing and incoming transactions on different accounts that move the same
+// amount in the same currency within window of each other. Only unambiguous pairs are returned:
+// each side must have the other as its single candidate. Callers pass transactions that are not
+// split and not yet part of a transfer.
+func MatchTransfers(transactions []*model.Transaction, window time.Duration) []TransferMatch {
+	type amountKey struct {
+		currency string
+		minor    int64 // Absolute amount in minor units
+	}
+	incoming := make(map[amountKey][]*model.Transaction)
+	for _, t := range transactions {
+		if t.Amount.IsPositive() {
+			key := amountKey{currency: t.Amount.Currency(), minor: t.Amount.MinorUnits()}
+			incoming[key] = append(incoming[key], t)
+		}
+	}
+
+	candidates := make(map[int][]*model.Transaction) // By outgoing transaction ID
+	candidateCount := make(map[int]int)              // Outgoing candidates per incoming transaction ID
+	var outgoing []*model.Transaction
+	for _, t := range transactions {
+		if !t.Amount.IsNegative() {
+			continue
+		}
+		key := amountKey{currency: t.Amount.Currency(), minor: t.Amount.Abs().MinorUnits()}
+		for _, in := range incoming[key] {
+			if in.AccountID == t.AccountID || absDuration(in.PostedOn.Sub(t.PostedOn)) > window {
+				continue
+			}
+			candidates[t.ID] = append(candidates[t.ID], in)
+			candidateCount[in.ID]++
+		}
+		outgoing = append(outgoing, t)
+	}
+
+	sort.SliceStable(outgoing, func(i, j int) bool {
+		if !outgoing[i].PostedOn.Equal(outgoing[j].PostedOn) {
+			return outgoing[i].PostedOn.Before(outgoing[j].PostedOn)
+		}
+		return outgoing[i].ID < outgoing[j].ID
+	})
+
+	var matches []TransferMatch
+	for _, out := range outgoing {
+		if len(candidates[out.ID]) != 1 {
+			continue
+		}
+		in := candidates[out.ID][0]
+		if candidateCount[in.ID] != 1 {
+			continue
+		}
+		matches = append(matches, TransferMatch{From: out, To: in})
+	}
+	return matches
+}
+
+// absDuration returns the absolute value of d
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/workspace"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Account is the model entity for the Account schema.
+type Account struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Type holds the value of the "type" field.
+	Type account.Type `json:"type,omitempty"`
+	// Institution holds the value of the "institution" field.
+	Institution string `json:"institution,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// OpeningBalance holds the value of the "opening_balance" field.
+	OpeningBalance model.Decimal `json:"opening_balance,omitempty"`
+	// OpenedOn holds the value of the "opened_on" field.
+	OpenedOn *time.Time `json:"opened_on,omitempty"`
+	// ClosedOn holds the value of the "closed_on" field.
+	ClosedOn *time.Time `json:"closed_on,omitempty"`
+	// Archived holds the value of the "archived" field.
+	Archived bool `json:"archived,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AccountQuery when eager-loading is set.
+	Edges        AccountEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AccountEdges holds the relations/edges for other nodes in the graph.
+type AccountEdges struct {
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// Transactions holds the value of the transactions edge.
+	Transactions []*Transaction `json:"transactions,omitempty"`
+	// Postings holds the value of the postings edge.
+	Postings []*Posting `json:"postings,omitempty"`
+	// RecurrenceRules holds the value of the recurrence_rules edge.
+	RecurrenceRules []*RecurrenceRule `json:"recurrence_rules,omitempty"`
+	// StatementImports holds the value of the statement_imports edge.
+	StatementImports []*StatementImport `json:"statement_imports,omitempty"`
+	// ImportProfiles holds the value of the import_profiles edge.
+	ImportProfiles []*ImportProfile `json:"import_profiles,omitempty"`
+	// ImportDecisions holds the value of the import_decisions edge.
+	ImportDecisions []*ImportDecision `json:"import_decisions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [7]bool
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// TransactionsOrErr returns the Transactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) TransactionsOrErr() ([]*Transaction, error) {
+	if e.loadedTypes[1] {
+		return e.Transactions, nil
+	}
+	return nil, &NotLoadedError{edge: "transactions"}
+}
+
+// PostingsOrErr returns the Postings value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) PostingsOrErr() ([]*Posting, error) {
+	if e.loadedTypes[2] {
+		return e.Postings, nil
+	}
+	return nil, &NotLoadedError{edge: "postings"}
+}
+
+// RecurrenceRulesOrErr returns the RecurrenceRules value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) RecurrenceRulesOrErr() ([]*RecurrenceRule, error) {
+	if e.loadedTypes[3] {
+		return e.RecurrenceRules, nil
+	}
+	return nil, &NotLoadedError{edge: "recurrence_rules"}
+}
+
+// StatementImportsOrErr returns the StatementImports value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) StatementImportsOrErr() ([]*StatementImport, error) {
+	if e.loadedTypes[4] {
+		return e.StatementImports, nil
+	}
+	return nil, &NotLoadedError{edge: "statement_imports"}
+}
+
+// ImportProfilesOrErr returns the ImportProfiles value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) ImportProfilesOrErr() ([]*ImportProfile, error) {
+	if e.loadedTypes[5] {
+		return e.ImportProfiles, nil
+	}
+	return nil, &NotLoadedError{edge: "import_profiles"}
+}
+
+// ImportDecisionsOrErr returns the ImportDecisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) ImportDecisionsOrErr() ([]*ImportDecision, error) {
+	if e.loadedTypes[6] {
+		return e.ImportDecisions, nil
+	}
+	return nil, &NotLoadedError{edge: "import_decisions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Account) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case account.FieldOpeningBalance:
+			values[i] = new(model.Decimal)
+		case account.FieldArchived:
+			values[i] = new(sql.NullBool)
+		case account.FieldID, account.FieldWorkspaceID:
+			values[i] = new(sql.NullInt64)
+		case account.FieldName, account.FieldType, account.FieldInstitution, account.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case account.FieldOpenedOn, account.FieldClosedOn, account.FieldCreatedAt, account.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Account fields.
+func (_m *Account) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case account.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case account.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				_m.WorkspaceID = int(value.Int64)
+			}
+		case account.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case account.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = account.Type(value.String)
+			}
+		case account.FieldInstitution:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field institution", values[i])
+			} else if value.Valid {
+				_m.Institution = value.String
+			}
+		case account.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case account.FieldOpeningBalance:
+			if value, ok := values[i].(*model.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field opening_balance", values[i])
+			} else if value != nil {
+				_m.OpeningBalance = *value
+			}
+		case account.FieldOpenedOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field opened_on", values[i])
+			} else if value.Valid {
+				_m.OpenedOn = new(time.Time)
+				*_m.OpenedOn = value.Time
+			}
+		case account.FieldClosedOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_on", values[i])
+			} else if value.Valid {
+				_m.ClosedOn = new(time.Time)
+				*_m.ClosedOn = value.Time
+			}
+		case account.FieldArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field archived", values[i])
+			} else if value.Valid {
+				_m.Archived = value.Bool
+			}
+		case account.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case account.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Account.
+// This includes values selected through modifiers, order, etc.
+func (_m *Account) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWorkspace queries the "workspace" edge of the Account entity.
+func (_m *Account) QueryWorkspace() *WorkspaceQuery {
+	return NewAccountClient(_m.config).QueryWorkspace(_m)
+}
+
+// QueryTransactions queries the "transactions" edge of the Account entity.
+func (_m *Account) QueryTransactions() *TransactionQuery {
+	return NewAccountClient(_m.config).QueryTransactions(_m)
+}
+
+// QueryPostings queries the "postings" edge of the Account entity.
+func (_m *Account) QueryPostings() *PostingQuery {
+	return NewAccountClient(_m.config).QueryPostings(_m)
+}
+
+// QueryRecurrenceRules queries the "recurrence_rules" edge of the Account entity.
+func (_m *Account) QueryRecurrenceRules() *RecurrenceRuleQuery {
+	return NewAccountClient(_m.config).QueryRecurrenceRules(_m)
+}
+
+// QueryStatementImports queries the "statement_imports" edge of the Account entity.
+func (_m *Account) QueryStatementImports() *StatementImportQuery {
+	return NewAccountClient(_m.config).QueryStatementImports(_m)
+}
+
+// QueryImportProfiles queries the "import_profiles" edge of the Account entity.
+func (_m *Account) QueryImportProfiles() *ImportProfileQuery {
+	return NewAccountClient(_m.config).QueryImportProfiles(_m)
+}
+
+// QueryImportDecisions queries the "import_decisions" edge of the Account entity.
+func (_m *Account) QueryImportDecisions() *ImportDecisionQuery {
+	return NewAccountClient(_m.config).QueryImportDecisions(_m)
+}
+
+// Update returns a builder for updating this Account.
+// Note that you need to call Account.Unwrap() before calling this method if this Account
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Account) Update() *AccountUpdateOne {
+	return NewAccountClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Account entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Account) Unwrap() *Account {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Account is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Account) String() string {
+	var builder strings.Builder
+	builder.WriteString("Account(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("institution=")
+	builder.WriteString(_m.Institution)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("opening_balance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OpeningBalance))
+	builder.WriteString(", ")
+	if v := _m.OpenedOn; v != nil {
+		builder.WriteString("opened_on=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ClosedOn; v != nil {
+		builder.WriteString("closed_on=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("archived=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Archived))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Accounts is a parsable slice of Account.
+type Accounts []*Account
@@ -0,0 +1,386 @@
+// Code generated by ent, DO NOT EDIT.
+
+package account
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the account type in the database.
+	Label = "account"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWorkspaceID holds the string denoting the workspace_id field in the database.
+	FieldWorkspaceID = "workspace_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldInstitution holds the string denoting the institution field in the database.
+	FieldInstitution = "institution"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldOpeningBalance holds the string denoting the opening_balance field in the database.
+	FieldOpeningBalance = "opening_balance"
+	// FieldOpenedOn holds the string denoting the opened_on field in the database.
+	FieldOpenedOn = "opened_on"
+	// FieldClosedOn holds the string denoting the closed_on field in the database.
+	FieldClosedOn = "closed_on"
+	// FieldArchived holds the string denoting the archived field in the database.
+	FieldArchived = "archived"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeWorkspace holds the string denoting the workspace edge name in mutations.
+	EdgeWorkspace = "workspace"
+	// EdgeTransactions holds the string denoting the transactions edge name in mutations.
+	EdgeTransactions = "transactions"
+	// EdgePostings holds the string denoting the postings edge name in mutations.
+	EdgePostings = "postings"
+	// EdgeRecurrenceRules holds the string denoting the recurrence_rules edge name in mutations.
+	EdgeRecurrenceRules = "recurrence_rules"
+	// EdgeStatementImports holds the string denoting the statement_imports edge name in mutations.
+	EdgeStatementImports = "statement_imports"
+	// EdgeImportProfiles holds the string denoting the import_profiles edge name in mutations.
+	EdgeImportProfiles = "import_profiles"
+	// EdgeImportDecisions holds the string denoting the import_decisions edge name in mutations.
+	EdgeImportDecisions = "import_decisions"
+	// Table holds the table name of the account in the database.
+	Table = "accounts"
+	// WorkspaceTable is the table that holds the workspace relation/edge.
+	WorkspaceTable = "accounts"
+	// WorkspaceInverseTable is the table name for the Workspace entity.
+	// It exists in this package in order to avoid circular dependency with the "workspace" package.
+	WorkspaceInverseTable = "workspaces"
+	// WorkspaceColumn is the table column denoting the workspace relation/edge.
+	WorkspaceColumn = "workspace_id"
+	// TransactionsTable is the table that holds the transactions relation/edge.
+	TransactionsTable = "transactions"
+	// TransactionsInverseTable is the table name for the Transaction entity.
+	// It exists in this package in order to avoid circular dependency with the "transaction" package.
+	TransactionsInverseTable = "transactions"
+	// TransactionsColumn is the table column denoting the transactions relation/edge.
+	TransactionsColumn = "account_id"
+	// PostingsTable is the table that holds the postings relation/edge.
+	PostingsTable = "postings"
+	// PostingsInverseTable is the table name for the Posting entity.
+	// It exists in this package in order to avoid circular dependency with the "posting" package.
+	PostingsInverseTable = "postings"
+	// PostingsColumn is the table column denoting the postings relation/edge.
+	PostingsColumn = "account_id"
+	// RecurrenceRulesTable is the table that holds the recurrence_rules relation/edge.
+	RecurrenceRulesTable = "recurrence_rules"
+	// RecurrenceRulesInverseTable is the table name for the RecurrenceRule entity.
+	// It exists in this package in order to avoid circular dependency with the "recurrencerule" package.
+	RecurrenceRulesInverseTable = "recurrence_rules"
+	// RecurrenceRulesColumn is the table column denoting the recurrence_rules relation/edge.
+	RecurrenceRulesColumn = "account_id"
+	// StatementImportsTable is the table that holds the statement_imports relation/edge.
+	StatementImportsTable = "statement_imports"
+	// StatementImportsInverseTable is the table name for the StatementImport entity.
+	// It exists in this package in order to avoid circular dependency with the "statementimport" package.
+	StatementImportsInverseTable = "statement_imports"
+	// StatementImportsColumn is the table column denoting the statement_imports relation/edge.
+	StatementImportsColumn = "account_id"
+	// ImportProfilesTable is the table that holds the import_profiles relation/edge.
+	ImportProfilesTable = "import_profiles"
+	// ImportProfilesInverseTable is the table name for the ImportProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "importprofile" package.
+	ImportProfilesInverseTable = "import_profiles"
+	// ImportProfilesColumn is the table column denoting the import_profiles relation/edge.
+	ImportProfilesColumn = "account_id"
+	// ImportDecisionsTable is the table that holds the import_decisions relation/edge.
+	ImportDecisionsTable = "import_decisions"
+	// ImportDecisionsInverseTable is the table name for the ImportDecision entity.
+	// It exists in this package in order to avoid circular dependency with the "importdecision" package.
+	ImportDecisionsInverseTable = "import_decisions"
+	// ImportDecisionsColumn is the table column denoting the import_decisions relation/edge.
+	ImportDecisionsColumn = "account_id"
+)
+
+// Columns holds all SQL columns for account fields.
+var Columns = []string{
+	FieldID,
+	FieldWorkspaceID,
+	FieldName,
+	FieldType,
+	FieldInstitution,
+	FieldCurrency,
+	FieldOpeningBalance,
+	FieldOpenedOn,
+	FieldClosedOn,
+	FieldArchived,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "backend/internal/infrastructure/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// DefaultArchived holds the default value on creation for the "archived" field.
+	DefaultArchived bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeBank       Type = "bank"
+	TypeCreditCard Type = "credit_card"
+	TypeCash       Type = "cash"
+	TypeLoan       Type = "loan"
+	TypeInvestment Type = "investment"
+	TypeIncome     Type = "income"
+	TypeExpense    Type = "expense"
+	TypeTransfer   Type = "transfer"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeBank, TypeCreditCard, TypeCash, TypeLoan, TypeInvestment, TypeIncome, TypeExpense, TypeTransfer:
+		return nil
+	default:
+		return fmt.Errorf("account: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the Account queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByWorkspaceID orders the results by the workspace_id field.
+func ByWorkspaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkspaceID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByInstitution orders the results by the institution field.
+func ByInstitution(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstitution, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByOpeningBalance orders the results by the opening_balance field.
+func ByOpeningBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpeningBalance, opts...).ToFunc()
+}
+
+// ByOpenedOn orders the results by the opened_on field.
+func ByOpenedOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpenedOn, opts...).ToFunc()
+}
+
+// ByClosedOn orders the results by the closed_on field.
+func ByClosedOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosedOn, opts...).ToFunc()
+}
+
+// ByArchived orders the results by the archived field.
+func ByArchived(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchived, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByWorkspaceField orders the results by workspace field.
+func ByWorkspaceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorkspaceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTransactionsCount orders the results by transactions count.
+func ByTransactionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTransactionsStep(), opts...)
+	}
+}
+
+// ByTransactions orders the results by transactions terms.
+func ByTransactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPostingsCount orders the results by postings count.
+func ByPostingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPostingsStep(), opts...)
+	}
+}
+
+// ByPostings orders the results by postings terms.
+func ByPostings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPostingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRecurrenceRulesCount orders the results by recurrence_rules count.
+func ByRecurrenceRulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecurrenceRulesStep(), opts...)
+	}
+}
+
+// ByRecurrenceRules orders the results by recurrence_rules terms.
+func ByRecurrenceRules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecurrenceRulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStatementImportsCount orders the results by statement_imports count.
+func ByStatementImportsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStatementImportsStep(), opts...)
+	}
+}
+
+// ByStatementImports orders the results by statement_imports terms.
+func ByStatementImports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatementImportsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByImportProfilesCount orders the results by import_profiles count.
+func ByImportProfilesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newImportProfilesStep(), opts...)
+	}
+}
+
+// ByImportProfiles orders the results by import_profiles terms.
+func ByImportProfiles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newImportProfilesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByImportDecisionsCount orders the results by import_decisions count.
+func ByImportDecisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newImportDecisionsStep(), opts...)
+	}
+}
+
+// ByImportDecisions orders the results by import_decisions terms.
+func ByImportDecisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newImportDecisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newWorkspaceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorkspaceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, WorkspaceTable, WorkspaceColumn),
+	)
+}
+func newTransactionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransactionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TransactionsTable, TransactionsColumn),
+	)
+}
+func newPostingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PostingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PostingsTable, PostingsColumn),
+	)
+}
+func newRecurrenceRulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecurrenceRulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RecurrenceRulesTable, RecurrenceRulesColumn),
+	)
+}
+func newStatementImportsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatementImportsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StatementImportsTable, StatementImportsColumn),
+	)
+}
+func newImportProfilesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ImportProfilesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ImportProfilesTable, ImportProfilesColumn),
+	)
+}
+func newImportDecisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ImportDecisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ImportDecisionsTable, ImportDecisionsColumn),
+	)
+}
@@ -0,0 +1,758 @@
+// Code generated by ent, DO NOT EDIT.
+
+package account
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldID, id))
+}
+
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v int) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldName, v))
+}
+
+// Institution applies equality check predicate on the "institution" field. It's identical to InstitutionEQ.
+func Institution(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldInstitution, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldCurrency, v))
+}
+
+// OpeningBalance applies equality check predicate on the "opening_balance" field. It's identical to OpeningBalanceEQ.
+func OpeningBalance(v model.Decimal) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldOpeningBalance, v))
+}
+
+// OpenedOn applies equality check predicate on the "opened_on" field. It's identical to OpenedOnEQ.
+func OpenedOn(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldOpenedOn, v))
+}
+
+// ClosedOn applies equality check predicate on the "closed_on" field. It's identical to ClosedOnEQ.
+func ClosedOn(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldClosedOn, v))
+}
+
+// Archived applies equality check predicate on the "archived" field. It's identical to ArchivedEQ.
+func Archived(v bool) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldArchived, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v int) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v int) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...int) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...int) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Account {
+	return predicate.Account(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldContainsFold(FieldName, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldType, vs...))
+}
+
+// InstitutionEQ applies the EQ predicate on the "institution" field.
+func InstitutionEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldInstitution, v))
+}
+
+// InstitutionNEQ applies the NEQ predicate on the "institution" field.
+func InstitutionNEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldInstitution, v))
+}
+
+// InstitutionIn applies the In predicate on the "institution" field.
+func InstitutionIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldInstitution, vs...))
+}
+
+// InstitutionNotIn applies the NotIn predicate on the "institution" field.
+func InstitutionNotIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldInstitution, vs...))
+}
+
+// InstitutionGT applies the GT predicate on the "institution" field.
+func InstitutionGT(v string) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldInstitution, v))
+}
+
+// InstitutionGTE applies the GTE predicate on the "institution" field.
+func InstitutionGTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldInstitution, v))
+}
+
+// InstitutionLT applies the LT predicate on the "institution" field.
+func InstitutionLT(v string) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldInstitution, v))
+}
+
+// InstitutionLTE applies the LTE predicate on the "institution" field.
+func InstitutionLTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldInstitution, v))
+}
+
+// InstitutionContains applies the Contains predicate on the "institution" field.
+func InstitutionContains(v string) predicate.Account {
+	return predicate.Account(sql.FieldContains(FieldInstitution, v))
+}
+
+// InstitutionHasPrefix applies the HasPrefix predicate on the "institution" field.
+func InstitutionHasPrefix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasPrefix(FieldInstitution, v))
+}
+
+// InstitutionHasSuffix applies the HasSuffix predicate on the "institution" field.
+func InstitutionHasSuffix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasSuffix(FieldInstitution, v))
+}
+
+// InstitutionIsNil applies the IsNil predicate on the "institution" field.
+func InstitutionIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldInstitution))
+}
+
+// InstitutionNotNil applies the NotNil predicate on the "institution" field.
+func InstitutionNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldInstitution))
+}
+
+// InstitutionEqualFold applies the EqualFold predicate on the "institution" field.
+func InstitutionEqualFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldEqualFold(FieldInstitution, v))
+}
+
+// InstitutionContainsFold applies the ContainsFold predicate on the "institution" field.
+func InstitutionContainsFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldContainsFold(FieldInstitution, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Account {
+	return predicate.Account(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// OpeningBalanceEQ applies the EQ predicate on the "opening_balance" field.
+func OpeningBalanceEQ(v model.Decimal) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceNEQ applies the NEQ predicate on the "opening_balance" field.
+func OpeningBalanceNEQ(v model.Decimal) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceIn applies the In predicate on the "opening_balance" field.
+func OpeningBalanceIn(vs ...model.Decimal) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldOpeningBalance, vs...))
+}
+
+// OpeningBalanceNotIn applies the NotIn predicate on the "opening_balance" field.
+func OpeningBalanceNotIn(vs ...model.Decimal) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldOpeningBalance, vs...))
+}
+
+// OpeningBalanceGT applies the GT predicate on the "opening_balance" field.
+func OpeningBalanceGT(v model.Decimal) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceGTE applies the GTE predicate on the "opening_balance" field.
+func OpeningBalanceGTE(v model.Decimal) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceLT applies the LT predicate on the "opening_balance" field.
+func OpeningBalanceLT(v model.Decimal) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldOpeningBalance, v))
+}
+
+// OpeningBalanceLTE applies the LTE predicate on the "opening_balance" field.
+func OpeningBalanceLTE(v model.Decimal) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldOpeningBalance, v))
+}
+
+// OpenedOnEQ applies the EQ predicate on the "opened_on" field.
+func OpenedOnEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldOpenedOn, v))
+}
+
+// OpenedOnNEQ applies the NEQ predicate on the "opened_on" field.
+func OpenedOnNEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldOpenedOn, v))
+}
+
+// OpenedOnIn applies the In predicate on the "opened_on" field.
+func OpenedOnIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldOpenedOn, vs...))
+}
+
+// OpenedOnNotIn applies the NotIn predicate on the "opened_on" field.
+func OpenedOnNotIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldOpenedOn, vs...))
+}
+
+// OpenedOnGT applies the GT predicate on the "opened_on" field.
+func OpenedOnGT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldOpenedOn, v))
+}
+
+// OpenedOnGTE applies the GTE predicate on the "opened_on" field.
+func OpenedOnGTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldOpenedOn, v))
+}
+
+// OpenedOnLT applies the LT predicate on the "opened_on" field.
+func OpenedOnLT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldOpenedOn, v))
+}
+
+// OpenedOnLTE applies the LTE predicate on the "opened_on" field.
+func OpenedOnLTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldOpenedOn, v))
+}
+
+// OpenedOnIsNil applies the IsNil predicate on the "opened_on" field.
+func OpenedOnIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldOpenedOn))
+}
+
+// OpenedOnNotNil applies the NotNil predicate on the "opened_on" field.
+func OpenedOnNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldOpenedOn))
+}
+
+// ClosedOnEQ applies the EQ predicate on the "closed_on" field.
+func ClosedOnEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldClosedOn, v))
+}
+
+// ClosedOnNEQ applies the NEQ predicate on the "closed_on" field.
+func ClosedOnNEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldClosedOn, v))
+}
+
+// ClosedOnIn applies the In predicate on the "closed_on" field.
+func ClosedOnIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldClosedOn, vs...))
+}
+
+// ClosedOnNotIn applies the NotIn predicate on the "closed_on" field.
+func ClosedOnNotIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldClosedOn, vs...))
+}
+
+// ClosedOnGT applies the GT predicate on the "closed_on" field.
+func ClosedOnGT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldClosedOn, v))
+}
+
+// ClosedOnGTE applies the GTE predicate on the "closed_on" field.
+func ClosedOnGTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldClosedOn, v))
+}
+
+// ClosedOnLT applies the LT predicate on the "closed_on" field.
+func ClosedOnLT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldClosedOn, v))
+}
+
+// ClosedOnLTE applies the LTE predicate on the "closed_on" field.
+func ClosedOnLTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldClosedOn, v))
+}
+
+// ClosedOnIsNil applies the IsNil predicate on the "closed_on" field.
+func ClosedOnIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldClosedOn))
+}
+
+// ClosedOnNotNil applies the NotNil predicate on the "closed_on" field.
+func ClosedOnNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldClosedOn))
+}
+
+// ArchivedEQ applies the EQ predicate on the "archived" field.
+func ArchivedEQ(v bool) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldArchived, v))
+}
+
+// ArchivedNEQ applies the NEQ predicate on the "archived" field.
+func ArchivedNEQ(v bool) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldArchived, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasWorkspace applies the HasEdge predicate on the "workspace" edge.
+func HasWorkspace() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WorkspaceTable, WorkspaceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkspaceWith applies the HasEdge predicate on the "workspace" edge with a given conditions (other predicates).
+func HasWorkspaceWith(preds ...predicate.Workspace) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newWorkspaceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransactions applies the HasEdge predicate on the "transactions" edge.
+func HasTransactions() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TransactionsTable, TransactionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionsWith applies the HasEdge predicate on the "transactions" edge with a given conditions (other predicates).
+func HasTransactionsWith(preds ...predicate.Transaction) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newTransactionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPostings applies the HasEdge predicate on the "postings" edge.
+func HasPostings() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PostingsTable, PostingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPostingsWith applies the HasEdge predicate on the "postings" edge with a given conditions (other predicates).
+func HasPostingsWith(preds ...predicate.Posting) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newPostingsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecurrenceRules applies the HasEdge predicate on the "recurrence_rules" edge.
+func HasRecurrenceRules() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RecurrenceRulesTable, RecurrenceRulesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecurrenceRulesWith applies the HasEdge predicate on the "recurrence_rules" edge with a given conditions (other predicates).
+func HasRecurrenceRulesWith(preds ...predicate.RecurrenceRule) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newRecurrenceRulesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStatementImports applies the HasEdge predicate on the "statement_imports" edge.
+func HasStatementImports() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StatementImportsTable, StatementImportsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatementImportsWith applies the HasEdge predicate on the "statement_imports" edge with a given conditions (other predicates).
+func HasStatementImportsWith(preds ...predicate.StatementImport) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newStatementImportsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasImportProfiles applies the HasEdge predicate on the "import_profiles" edge.
+func HasImportProfiles() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ImportProfilesTable, ImportProfilesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasImportProfilesWith applies the HasEdge predicate on the "import_profiles" edge with a given conditions (other predicates).
+func HasImportProfilesWith(preds ...predicate.ImportProfile) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newImportProfilesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasImportDecisions applies the HasEdge predicate on the "import_decisions" edge.
+func HasImportDecisions() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ImportDecisionsTable, ImportDecisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasImportDecisionsWith applies the HasEdge predicate on the "import_decisions" edge with a given conditions (other predicates).
+func HasImportDecisionsWith(preds ...predicate.ImportDecision) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newImportDecisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Account) predicate.Account {
+	return predicate.Account(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Account) predicate.Account {
+	return predicate.Account(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Account) predicate.Account {
+	return predicate.Account(sql.NotPredicates(p))
+}
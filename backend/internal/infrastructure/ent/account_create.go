@@ -0,0 +1,610 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/importdecision"
+	"backend/internal/infrastructure/ent/importprofile"
+	"backend/internal/infrastructure/ent/posting"
+	"backend/internal/infrastructure/ent/recurrencerule"
+	"backend/internal/infrastructure/ent/statementimport"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/workspace"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AccountCreate is the builder for creating a Account entity.
+type AccountCreate struct {
+	config
+	mutation *AccountMutation
+	hooks    []Hook
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *AccountCreate) SetWorkspaceID(v int) *AccountCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *AccountCreate) SetName(v string) *AccountCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *AccountCreate) SetType(v account.Type) *AccountCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetInstitution sets the "institution" field.
+func (_c *AccountCreate) SetInstitution(v string) *AccountCreate {
+	_c.mutation.SetInstitution(v)
+	return _c
+}
+
+// SetNillableInstitution sets the "institution" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableInstitution(v *string) *AccountCreate {
+	if v != nil {
+		_c.SetInstitution(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *AccountCreate) SetCurrency(v string) *AccountCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (_c *AccountCreate) SetOpeningBalance(v model.Decimal) *AccountCreate {
+	_c.mutation.SetOpeningBalance(v)
+	return _c
+}
+
+// SetOpenedOn sets the "opened_on" field.
+func (_c *AccountCreate) SetOpenedOn(v time.Time) *AccountCreate {
+	_c.mutation.SetOpenedOn(v)
+	return _c
+}
+
+// SetNillableOpenedOn sets the "opened_on" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableOpenedOn(v *time.Time) *AccountCreate {
+	if v != nil {
+		_c.SetOpenedOn(*v)
+	}
+	return _c
+}
+
+// SetClosedOn sets the "closed_on" field.
+func (_c *AccountCreate) SetClosedOn(v time.Time) *AccountCreate {
+	_c.mutation.SetClosedOn(v)
+	return _c
+}
+
+// SetNillableClosedOn sets the "closed_on" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableClosedOn(v *time.Time) *AccountCreate {
+	if v != nil {
+		_c.SetClosedOn(*v)
+	}
+	return _c
+}
+
+// SetArchived sets the "archived" field.
+func (_c *AccountCreate) SetArchived(v bool) *AccountCreate {
+	_c.mutation.SetArchived(v)
+	return _c
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableArchived(v *bool) *AccountCreate {
+	if v != nil {
+		_c.SetArchived(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AccountCreate) SetCreatedAt(v time.Time) *AccountCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableCreatedAt(v *time.Time) *AccountCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AccountCreate) SetUpdatedAt(v time.Time) *AccountCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableUpdatedAt(v *time.Time) *AccountCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_c *AccountCreate) SetWorkspace(v *Workspace) *AccountCreate {
+	return _c.SetWorkspaceID(v.ID)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (_c *AccountCreate) AddTransactionIDs(ids ...int) *AccountCreate {
+	_c.mutation.AddTransactionIDs(ids...)
+	return _c
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (_c *AccountCreate) AddTransactions(v ...*Transaction) *AccountCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTransactionIDs(ids...)
+}
+
+// AddPostingIDs adds the "postings" edge to the Posting entity by IDs.
+func (_c *AccountCreate) AddPostingIDs(ids ...int) *AccountCreate {
+	_c.mutation.AddPostingIDs(ids...)
+	return _c
+}
+
+// AddPostings adds the "postings" edges to the Posting entity.
+func (_c *AccountCreate) AddPostings(v ...*Posting) *AccountCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPostingIDs(ids...)
+}
+
+// AddRecurrenceRuleIDs adds the "recurrence_rules" edge to the RecurrenceRule entity by IDs.
+func (_c *AccountCreate) AddRecurrenceRuleIDs(ids ...int) *AccountCreate {
+	_c.mutation.AddRecurrenceRuleIDs(ids...)
+	return _c
+}
+
+// AddRecurrenceRules adds the "recurrence_rules" edges to the RecurrenceRule entity.
+func (_c *AccountCreate) AddRecurrenceRules(v ...*RecurrenceRule) *AccountCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRecurrenceRuleIDs(ids...)
+}
+
+// AddStatementImportIDs adds the "statement_imports" edge to the StatementImport entity by IDs.
+func (_c *AccountCreate) AddStatementImportIDs(ids ...int) *AccountCreate {
+	_c.mutation.AddStatementImportIDs(ids...)
+	return _c
+}
+
+// AddStatementImports adds the "statement_imports" edges to the StatementImport entity.
+func (_c *AccountCreate) AddStatementImports(v ...*StatementImport) *AccountCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStatementImportIDs(ids...)
+}
+
+// AddImportProfileIDs adds the "import_profiles" edge to the ImportProfile entity by IDs.
+func (_c *AccountCreate) AddImportProfileIDs(ids ...int) *AccountCreate {
+	_c.mutation.AddImportProfileIDs(ids...)
+	return _c
+}
+
+// AddImportProfiles adds the "import_profiles" edges to the ImportProfile entity.
+func (_c *AccountCreate) AddImportProfiles(v ...*ImportProfile) *AccountCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddImportProfileIDs(ids...)
+}
+
+// AddImportDecisionIDs adds the "import_decisions" edge to the ImportDecision entity by IDs.
+func (_c *AccountCreate) AddImportDecisionIDs(ids ...int) *AccountCreate {
+	_c.mutation.AddImportDecisionIDs(ids...)
+	return _c
+}
+
+// AddImportDecisions adds the "import_decisions" edges to the ImportDecision entity.
+func (_c *AccountCreate) AddImportDecisions(v ...*ImportDecision) *AccountCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddImportDecisionIDs(ids...)
+}
+
+// Mutation returns the AccountMutation object of the builder.
+func (_c *AccountCreate) Mutation() *AccountMutation {
+	return _c.mutation
+}
+
+// Save creates the Account in the database.
+func (_c *AccountCreate) Save(ctx context.Context) (*Account, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AccountCreate) SaveX(ctx context.Context) *Account {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AccountCreate) defaults() error {
+	if _, ok := _c.mutation.Archived(); !ok {
+		v := account.DefaultArchived
+		_c.mutation.SetArchived(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if account.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized account.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := account.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if account.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized account.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := account.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AccountCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "Account.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Account.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := account.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Account.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Account.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := account.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Account.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Account.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := account.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Account.currency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OpeningBalance(); !ok {
+		return &ValidationError{Name: "opening_balance", err: errors.New(`ent: missing required field "Account.opening_balance"`)}
+	}
+	if _, ok := _c.mutation.Archived(); !ok {
+		return &ValidationError{Name: "archived", err: errors.New(`ent: missing required field "Account.archived"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Account.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Account.updated_at"`)}
+	}
+	if len(_c.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "Account.workspace"`)}
+	}
+	return nil
+}
+
+func (_c *AccountCreate) sqlSave(ctx context.Context) (*Account, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Account{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(account.Table, sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(account.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(account.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Institution(); ok {
+		_spec.SetField(account.FieldInstitution, field.TypeString, value)
+		_node.Institution = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(account.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.OpeningBalance(); ok {
+		_spec.SetField(account.FieldOpeningBalance, field.TypeOther, value)
+		_node.OpeningBalance = value
+	}
+	if value, ok := _c.mutation.OpenedOn(); ok {
+		_spec.SetField(account.FieldOpenedOn, field.TypeTime, value)
+		_node.OpenedOn = &value
+	}
+	if value, ok := _c.mutation.ClosedOn(); ok {
+		_spec.SetField(account.FieldClosedOn, field.TypeTime, value)
+		_node.ClosedOn = &value
+	}
+	if value, ok := _c.mutation.Archived(); ok {
+		_spec.SetField(account.FieldArchived, field.TypeBool, value)
+		_node.Archived = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(account.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(account.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   account.WorkspaceTable,
+			Columns: []string{account.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TransactionsTable,
+			Columns: []string{account.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostingsTable,
+			Columns: []string{account.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RecurrenceRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.RecurrenceRulesTable,
+			Columns: []string{account.RecurrenceRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StatementImportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.StatementImportsTable,
+			Columns: []string{account.StatementImportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementimport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ImportProfilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ImportProfilesTable,
+			Columns: []string{account.ImportProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ImportDecisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ImportDecisionsTable,
+			Columns: []string{account.ImportDecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AccountCreateBulk is the builder for creating many Account entities in bulk.
+type AccountCreateBulk struct {
+	config
+	err      error
+	builders []*AccountCreate
+}
+
+// Save creates the Account entities in the database.
+func (_c *AccountCreateBulk) Save(ctx context.Context) ([]*Account, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Account, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AccountCreateBulk) SaveX(ctx context.Context) []*Account {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1553 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/importdecision"
+	"backend/internal/infrastructure/ent/importprofile"
+	"backend/internal/infrastructure/ent/posting"
+	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/recurrencerule"
+	"backend/internal/infrastructure/ent/statementimport"
+	"backend/internal/infrastructure/ent/transaction"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AccountUpdate is the builder for updating Account entities.
+type AccountUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AccountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AccountUpdate builder.
+func (_u *AccountUpdate) Where(ps ...predicate.Account) *AccountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AccountUpdate) SetName(v string) *AccountUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableName(v *string) *AccountUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *AccountUpdate) SetType(v account.Type) *AccountUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableType(v *account.Type) *AccountUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetInstitution sets the "institution" field.
+func (_u *AccountUpdate) SetInstitution(v string) *AccountUpdate {
+	_u.mutation.SetInstitution(v)
+	return _u
+}
+
+// SetNillableInstitution sets the "institution" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableInstitution(v *string) *AccountUpdate {
+	if v != nil {
+		_u.SetInstitution(*v)
+	}
+	return _u
+}
+
+// ClearInstitution clears the value of the "institution" field.
+func (_u *AccountUpdate) ClearInstitution() *AccountUpdate {
+	_u.mutation.ClearInstitution()
+	return _u
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (_u *AccountUpdate) SetOpeningBalance(v model.Decimal) *AccountUpdate {
+	_u.mutation.SetOpeningBalance(v)
+	return _u
+}
+
+// SetNillableOpeningBalance sets the "opening_balance" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableOpeningBalance(v *model.Decimal) *AccountUpdate {
+	if v != nil {
+		_u.SetOpeningBalance(*v)
+	}
+	return _u
+}
+
+// SetOpenedOn sets the "opened_on" field.
+func (_u *AccountUpdate) SetOpenedOn(v time.Time) *AccountUpdate {
+	_u.mutation.SetOpenedOn(v)
+	return _u
+}
+
+// SetNillableOpenedOn sets the "opened_on" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableOpenedOn(v *time.Time) *AccountUpdate {
+	if v != nil {
+		_u.SetOpenedOn(*v)
+	}
+	return _u
+}
+
+// ClearOpenedOn clears the value of the "opened_on" field.
+func (_u *AccountUpdate) ClearOpenedOn() *AccountUpdate {
+	_u.mutation.ClearOpenedOn()
+	return _u
+}
+
+// SetClosedOn sets the "closed_on" field.
+func (_u *AccountUpdate) SetClosedOn(v time.Time) *AccountUpdate {
+	_u.mutation.SetClosedOn(v)
+	return _u
+}
+
+// SetNillableClosedOn sets the "closed_on" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableClosedOn(v *time.Time) *AccountUpdate {
+	if v != nil {
+		_u.SetClosedOn(*v)
+	}
+	return _u
+}
+
+// ClearClosedOn clears the value of the "closed_on" field.
+func (_u *AccountUpdate) ClearClosedOn() *AccountUpdate {
+	_u.mutation.ClearClosedOn()
+	return _u
+}
+
+// SetArchived sets the "archived" field.
+func (_u *AccountUpdate) SetArchived(v bool) *AccountUpdate {
+	_u.mutation.SetArchived(v)
+	return _u
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableArchived(v *bool) *AccountUpdate {
+	if v != nil {
+		_u.SetArchived(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AccountUpdate) SetUpdatedAt(v time.Time) *AccountUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (_u *AccountUpdate) AddTransactionIDs(ids ...int) *AccountUpdate {
+	_u.mutation.AddTransactionIDs(ids...)
+	return _u
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (_u *AccountUpdate) AddTransactions(v ...*Transaction) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransactionIDs(ids...)
+}
+
+// AddPostingIDs adds the "postings" edge to the Posting entity by IDs.
+func (_u *AccountUpdate) AddPostingIDs(ids ...int) *AccountUpdate {
+	_u.mutation.AddPostingIDs(ids...)
+	return _u
+}
+
+// AddPostings adds the "postings" edges to the Posting entity.
+func (_u *AccountUpdate) AddPostings(v ...*Posting) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPostingIDs(ids...)
+}
+
+// AddRecurrenceRuleIDs adds the "recurrence_rules" edge to the RecurrenceRule entity by IDs.
+func (_u *AccountUpdate) AddRecurrenceRuleIDs(ids ...int) *AccountUpdate {
+	_u.mutation.AddRecurrenceRuleIDs(ids...)
+	return _u
+}
+
+// AddRecurrenceRules adds the "recurrence_rules" edges to the RecurrenceRule entity.
+func (_u *AccountUpdate) AddRecurrenceRules(v ...*RecurrenceRule) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecurrenceRuleIDs(ids...)
+}
+
+// AddStatementImportIDs adds the "statement_imports" edge to the StatementImport entity by IDs.
+func (_u *AccountUpdate) AddStatementImportIDs(ids ...int) *AccountUpdate {
+	_u.mutation.AddStatementImportIDs(ids...)
+	return _u
+}
+
+// AddStatementImports adds the "statement_imports" edges to the StatementImport entity.
+func (_u *AccountUpdate) AddStatementImports(v ...*StatementImport) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatementImportIDs(ids...)
+}
+
+// AddImportProfileIDs adds the "import_profiles" edge to the ImportProfile entity by IDs.
+func (_u *AccountUpdate) AddImportProfileIDs(ids ...int) *AccountUpdate {
+	_u.mutation.AddImportProfileIDs(ids...)
+	return _u
+}
+
+// AddImportProfiles adds the "import_profiles" edges to the ImportProfile entity.
+func (_u *AccountUpdate) AddImportProfiles(v ...*ImportProfile) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddImportProfileIDs(ids...)
+}
+
+// AddImportDecisionIDs adds the "import_decisions" edge to the ImportDecision entity by IDs.
+func (_u *AccountUpdate) AddImportDecisionIDs(ids ...int) *AccountUpdate {
+	_u.mutation.AddImportDecisionIDs(ids...)
+	return _u
+}
+
+// AddImportDecisions adds the "import_decisions" edges to the ImportDecision entity.
+func (_u *AccountUpdate) AddImportDecisions(v ...*ImportDecision) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddImportDecisionIDs(ids...)
+}
+
+// Mutation returns the AccountMutation object of the builder.
+func (_u *AccountUpdate) Mutation() *AccountMutation {
+	return _u.mutation
+}
+
+// ClearTransactions clears all "transactions" edges to the Transaction entity.
+func (_u *AccountUpdate) ClearTransactions() *AccountUpdate {
+	_u.mutation.ClearTransactions()
+	return _u
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
+func (_u *AccountUpdate) RemoveTransactionIDs(ids ...int) *AccountUpdate {
+	_u.mutation.RemoveTransactionIDs(ids...)
+	return _u
+}
+
+// RemoveTransactions removes "transactions" edges to Transaction entities.
+func (_u *AccountUpdate) RemoveTransactions(v ...*Transaction) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransactionIDs(ids...)
+}
+
+// ClearPostings clears all "postings" edges to the Posting entity.
+func (_u *AccountUpdate) ClearPostings() *AccountUpdate {
+	_u.mutation.ClearPostings()
+	return _u
+}
+
+// RemovePostingIDs removes the "postings" edge to Posting entities by IDs.
+func (_u *AccountUpdate) RemovePostingIDs(ids ...int) *AccountUpdate {
+	_u.mutation.RemovePostingIDs(ids...)
+	return _u
+}
+
+// RemovePostings removes "postings" edges to Posting entities.
+func (_u *AccountUpdate) RemovePostings(v ...*Posting) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePostingIDs(ids...)
+}
+
+// ClearRecurrenceRules clears all "recurrence_rules" edges to the RecurrenceRule entity.
+func (_u *AccountUpdate) ClearRecurrenceRules() *AccountUpdate {
+	_u.mutation.ClearRecurrenceRules()
+	return _u
+}
+
+// RemoveRecurrenceRuleIDs removes the "recurrence_rules" edge to RecurrenceRule entities by IDs.
+func (_u *AccountUpdate) RemoveRecurrenceRuleIDs(ids ...int) *AccountUpdate {
+	_u.mutation.RemoveRecurrenceRuleIDs(ids...)
+	return _u
+}
+
+// RemoveRecurrenceRules removes "recurrence_rules" edges to RecurrenceRule entities.
+func (_u *AccountUpdate) RemoveRecurrenceRules(v ...*RecurrenceRule) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecurrenceRuleIDs(ids...)
+}
+
+// ClearStatementImports clears all "statement_imports" edges to the StatementImport entity.
+func (_u *AccountUpdate) ClearStatementImports() *AccountUpdate {
+	_u.mutation.ClearStatementImports()
+	return _u
+}
+
+// RemoveStatementImportIDs removes the "statement_imports" edge to StatementImport entities by IDs.
+func (_u *AccountUpdate) RemoveStatementImportIDs(ids ...int) *AccountUpdate {
+	_u.mutation.RemoveStatementImportIDs(ids...)
+	return _u
+}
+
+// RemoveStatementImports removes "statement_imports" edges to StatementImport entities.
+func (_u *AccountUpdate) RemoveStatementImports(v ...*StatementImport) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStatementImportIDs(ids...)
+}
+
+// ClearImportProfiles clears all "import_profiles" edges to the ImportProfile entity.
+func (_u *AccountUpdate) ClearImportProfiles() *AccountUpdate {
+	_u.mutation.ClearImportProfiles()
+	return _u
+}
+
+// RemoveImportProfileIDs removes the "import_profiles" edge to ImportProfile entities by IDs.
+func (_u *AccountUpdate) RemoveImportProfileIDs(ids ...int) *AccountUpdate {
+	_u.mutation.RemoveImportProfileIDs(ids...)
+	return _u
+}
+
+// RemoveImportProfiles removes "import_profiles" edges to ImportProfile entities.
+func (_u *AccountUpdate) RemoveImportProfiles(v ...*ImportProfile) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveImportProfileIDs(ids...)
+}
+
+// ClearImportDecisions clears all "import_decisions" edges to the ImportDecision entity.
+func (_u *AccountUpdate) ClearImportDecisions() *AccountUpdate {
+	_u.mutation.ClearImportDecisions()
+	return _u
+}
+
+// RemoveImportDecisionIDs removes the "import_decisions" edge to ImportDecision entities by IDs.
+func (_u *AccountUpdate) RemoveImportDecisionIDs(ids ...int) *AccountUpdate {
+	_u.mutation.RemoveImportDecisionIDs(ids...)
+	return _u
+}
+
+// RemoveImportDecisions removes "import_decisions" edges to ImportDecision entities.
+func (_u *AccountUpdate) RemoveImportDecisions(v ...*ImportDecision) *AccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveImportDecisionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AccountUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AccountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AccountUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if account.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized account.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := account.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := account.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Account.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := account.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Account.type": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Account.workspace"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AccountUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(account.Table, account.Columns, sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(account.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(account.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Institution(); ok {
+		_spec.SetField(account.FieldInstitution, field.TypeString, value)
+	}
+	if _u.mutation.InstitutionCleared() {
+		_spec.ClearField(account.FieldInstitution, field.TypeString)
+	}
+	if value, ok := _u.mutation.OpeningBalance(); ok {
+		_spec.SetField(account.FieldOpeningBalance, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.OpenedOn(); ok {
+		_spec.SetField(account.FieldOpenedOn, field.TypeTime, value)
+	}
+	if _u.mutation.OpenedOnCleared() {
+		_spec.ClearField(account.FieldOpenedOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosedOn(); ok {
+		_spec.SetField(account.FieldClosedOn, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedOnCleared() {
+		_spec.ClearField(account.FieldClosedOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Archived(); ok {
+		_spec.SetField(account.FieldArchived, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(account.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TransactionsTable,
+			Columns: []string{account.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !_u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TransactionsTable,
+			Columns: []string{account.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TransactionsTable,
+			Columns: []string{account.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostingsTable,
+			Columns: []string{account.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPostingsIDs(); len(nodes) > 0 && !_u.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostingsTable,
+			Columns: []string{account.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostingsTable,
+			Columns: []string{account.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecurrenceRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.RecurrenceRulesTable,
+			Columns: []string{account.RecurrenceRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecurrenceRulesIDs(); len(nodes) > 0 && !_u.mutation.RecurrenceRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.RecurrenceRulesTable,
+			Columns: []string{account.RecurrenceRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecurrenceRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.RecurrenceRulesTable,
+			Columns: []string{account.RecurrenceRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatementImportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.StatementImportsTable,
+			Columns: []string{account.StatementImportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementimport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatementImportsIDs(); len(nodes) > 0 && !_u.mutation.StatementImportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.StatementImportsTable,
+			Columns: []string{account.StatementImportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementimport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatementImportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.StatementImportsTable,
+			Columns: []string{account.StatementImportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementimport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ImportProfilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ImportProfilesTable,
+			Columns: []string{account.ImportProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importprofile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedImportProfilesIDs(); len(nodes) > 0 && !_u.mutation.ImportProfilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ImportProfilesTable,
+			Columns: []string{account.ImportProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImportProfilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ImportProfilesTable,
+			Columns: []string{account.ImportProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ImportDecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ImportDecisionsTable,
+			Columns: []string{account.ImportDecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedImportDecisionsIDs(); len(nodes) > 0 && !_u.mutation.ImportDecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ImportDecisionsTable,
+			Columns: []string{account.ImportDecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImportDecisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ImportDecisionsTable,
+			Columns: []string{account.ImportDecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{account.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AccountUpdateOne is the builder for updating a single Account entity.
+type AccountUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AccountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *AccountUpdateOne) SetName(v string) *AccountUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableName(v *string) *AccountUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *AccountUpdateOne) SetType(v account.Type) *AccountUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableType(v *account.Type) *AccountUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetInstitution sets the "institution" field.
+func (_u *AccountUpdateOne) SetInstitution(v string) *AccountUpdateOne {
+	_u.mutation.SetInstitution(v)
+	return _u
+}
+
+// SetNillableInstitution sets the "institution" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableInstitution(v *string) *AccountUpdateOne {
+	if v != nil {
+		_u.SetInstitution(*v)
+	}
+	return _u
+}
+
+// ClearInstitution clears the value of the "institution" field.
+func (_u *AccountUpdateOne) ClearInstitution() *AccountUpdateOne {
+	_u.mutation.ClearInstitution()
+	return _u
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (_u *AccountUpdateOne) SetOpeningBalance(v model.Decimal) *AccountUpdateOne {
+	_u.mutation.SetOpeningBalance(v)
+	return _u
+}
+
+// SetNillableOpeningBalance sets the "opening_balance" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableOpeningBalance(v *model.Decimal) *AccountUpdateOne {
+	if v != nil {
+		_u.SetOpeningBalance(*v)
+	}
+	return _u
+}
+
+// SetOpenedOn sets the "opened_on" field.
+func (_u *AccountUpdateOne) SetOpenedOn(v time.Time) *AccountUpdateOne {
+	_u.mutation.SetOpenedOn(v)
+	return _u
+}
+
+// SetNillableOpenedOn sets the "opened_on" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableOpenedOn(v *time.Time) *AccountUpdateOne {
+	if v != nil {
+		_u.SetOpenedOn(*v)
+	}
+	return _u
+}
+
+// ClearOpenedOn clears the value of the "opened_on" field.
+func (_u *AccountUpdateOne) ClearOpenedOn() *AccountUpdateOne {
+	_u.mutation.ClearOpenedOn()
+	return _u
+}
+
+// SetClosedOn sets the "closed_on" field.
+func (_u *AccountUpdateOne) SetClosedOn(v time.Time) *AccountUpdateOne {
+	_u.mutation.SetClosedOn(v)
+	return _u
+}
+
+// SetNillableClosedOn sets the "closed_on" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableClosedOn(v *time.Time) *AccountUpdateOne {
+	if v != nil {
+		_u.SetClosedOn(*v)
+	}
+	return _u
+}
+
+// ClearClosedOn clears the value of the "closed_on" field.
+func (_u *AccountUpdateOne) ClearClosedOn() *AccountUpdateOne {
+	_u.mutation.ClearClosedOn()
+	return _u
+}
+
+// SetArchived sets the "archived" field.
+func (_u *AccountUpdateOne) SetArchived(v bool) *AccountUpdateOne {
+	_u.mutation.SetArchived(v)
+	return _u
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableArchived(v *bool) *AccountUpdateOne {
+	if v != nil {
+		_u.SetArchived(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AccountUpdateOne) SetUpdatedAt(v time.Time) *AccountUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (_u *AccountUpdateOne) AddTransactionIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.AddTransactionIDs(ids...)
+	return _u
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (_u *AccountUpdateOne) AddTransactions(v ...*Transaction) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransactionIDs(ids...)
+}
+
+// AddPostingIDs adds the "postings" edge to the Posting entity by IDs.
+func (_u *AccountUpdateOne) AddPostingIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.AddPostingIDs(ids...)
+	return _u
+}
+
+// AddPostings adds the "postings" edges to the Posting entity.
+func (_u *AccountUpdateOne) AddPostings(v ...*Posting) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPostingIDs(ids...)
+}
+
+// AddRecurrenceRuleIDs adds the "recurrence_rules" edge to the RecurrenceRule entity by IDs.
+func (_u *AccountUpdateOne) AddRecurrenceRuleIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.AddRecurrenceRuleIDs(ids...)
+	return _u
+}
+
+// AddRecurrenceRules adds the "recurrence_rules" edges to the RecurrenceRule entity.
+func (_u *AccountUpdateOne) AddRecurrenceRules(v ...*RecurrenceRule) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecurrenceRuleIDs(ids...)
+}
+
+// AddStatementImportIDs adds the "statement_imports" edge to the StatementImport entity by IDs.
+func (_u *AccountUpdateOne) AddStatementImportIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.AddStatementImportIDs(ids...)
+	return _u
+}
+
+// AddStatementImports adds the "statement_imports" edges to the StatementImport entity.
+func (_u *AccountUpdateOne) AddStatementImports(v ...*StatementImport) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatementImportIDs(ids...)
+}
+
+// AddImportProfileIDs adds the "import_profiles" edge to the ImportProfile entity by IDs.
+func (_u *AccountUpdateOne) AddImportProfileIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.AddImportProfileIDs(ids...)
+	return _u
+}
+
+// AddImportProfiles adds the "import_profiles" edges to the ImportProfile entity.
+func (_u *AccountUpdateOne) AddImportProfiles(v ...*ImportProfile) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddImportProfileIDs(ids...)
+}
+
+// AddImportDecisionIDs adds the "import_decisions" edge to the ImportDecision entity by IDs.
+func (_u *AccountUpdateOne) AddImportDecisionIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.AddImportDecisionIDs(ids...)
+	return _u
+}
+
+// AddImportDecisions adds the "import_decisions" edges to the ImportDecision entity.
+func (_u *AccountUpdateOne) AddImportDecisions(v ...*ImportDecision) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddImportDecisionIDs(ids...)
+}
+
+// Mutation returns the AccountMutation object of the builder.
+func (_u *AccountUpdateOne) Mutation() *AccountMutation {
+	return _u.mutation
+}
+
+// ClearTransactions clears all "transactions" edges to the Transaction entity.
+func (_u *AccountUpdateOne) ClearTransactions() *AccountUpdateOne {
+	_u.mutation.ClearTransactions()
+	return _u
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
+func (_u *AccountUpdateOne) RemoveTransactionIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.RemoveTransactionIDs(ids...)
+	return _u
+}
+
+// RemoveTransactions removes "transactions" edges to Transaction entities.
+func (_u *AccountUpdateOne) RemoveTransactions(v ...*Transaction) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransactionIDs(ids...)
+}
+
+// ClearPostings clears all "postings" edges to the Posting entity.
+func (_u *AccountUpdateOne) ClearPostings() *AccountUpdateOne {
+	_u.mutation.ClearPostings()
+	return _u
+}
+
+// RemovePostingIDs removes the "postings" edge to Posting entities by IDs.
+func (_u *AccountUpdateOne) RemovePostingIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.RemovePostingIDs(ids...)
+	return _u
+}
+
+// RemovePostings removes "postings" edges to Posting entities.
+func (_u *AccountUpdateOne) RemovePostings(v ...*Posting) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePostingIDs(ids...)
+}
+
+// ClearRecurrenceRules clears all "recurrence_rules" edges to the RecurrenceRule entity.
+func (_u *AccountUpdateOne) ClearRecurrenceRules() *AccountUpdateOne {
+	_u.mutation.ClearRecurrenceRules()
+	return _u
+}
+
+// RemoveRecurrenceRuleIDs removes the "recurrence_rules" edge to RecurrenceRule entities by IDs.
+func (_u *AccountUpdateOne) RemoveRecurrenceRuleIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.RemoveRecurrenceRuleIDs(ids...)
+	return _u
+}
+
+// RemoveRecurrenceRules removes "recurrence_rules" edges to RecurrenceRule entities.
+func (_u *AccountUpdateOne) RemoveRecurrenceRules(v ...*RecurrenceRule) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecurrenceRuleIDs(ids...)
+}
+
+// ClearStatementImports clears all "statement_imports" edges to the StatementImport entity.
+func (_u *AccountUpdateOne) ClearStatementImports() *AccountUpdateOne {
+	_u.mutation.ClearStatementImports()
+	return _u
+}
+
+// RemoveStatementImportIDs removes the "statement_imports" edge to StatementImport entities by IDs.
+func (_u *AccountUpdateOne) RemoveStatementImportIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.RemoveStatementImportIDs(ids...)
+	return _u
+}
+
+// RemoveStatementImports removes "statement_imports" edges to StatementImport entities.
+func (_u *AccountUpdateOne) RemoveStatementImports(v ...*StatementImport) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStatementImportIDs(ids...)
+}
+
+// ClearImportProfiles clears all "import_profiles" edges to the ImportProfile entity.
+func (_u *AccountUpdateOne) ClearImportProfiles() *AccountUpdateOne {
+	_u.mutation.ClearImportProfiles()
+	return _u
+}
+
+// RemoveImportProfileIDs removes the "import_profiles" edge to ImportProfile entities by IDs.
+func (_u *AccountUpdateOne) RemoveImportProfileIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.RemoveImportProfileIDs(ids...)
+	return _u
+}
+
+// RemoveImportProfiles removes "import_profiles" edges to ImportProfile entities.
+func (_u *AccountUpdateOne) RemoveImportProfiles(v ...*ImportProfile) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveImportProfileIDs(ids...)
+}
+
+// ClearImportDecisions clears all "import_decisions" edges to the ImportDecision entity.
+func (_u *AccountUpdateOne) ClearImportDecisions() *AccountUpdateOne {
+	_u.mutation.ClearImportDecisions()
+	return _u
+}
+
+// RemoveImportDecisionIDs removes the "import_decisions" edge to ImportDecision entities by IDs.
+func (_u *AccountUpdateOne) RemoveImportDecisionIDs(ids ...int) *AccountUpdateOne {
+	_u.mutation.RemoveImportDecisionIDs(ids...)
+	return _u
+}
+
+// RemoveImportDecisions removes "import_decisions" edges to ImportDecision entities.
+func (_u *AccountUpdateOne) RemoveImportDecisions(v ...*ImportDecision) *AccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveImportDecisionIDs(ids...)
+}
+
+// Where appends a list predicates to the AccountUpdate builder.
+func (_u *AccountUpdateOne) Where(ps ...predicate.Account) *AccountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AccountUpdateOne) Select(field string, fields ...string) *AccountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Account entity.
+func (_u *AccountUpdateOne) Save(ctx context.Context) (*Account, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountUpdateOne) SaveX(ctx context.Context) *Account {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AccountUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if account.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized account.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := account.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := account.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Account.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := account.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Account.type": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Account.workspace"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AccountUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(account.Table, account.Columns, sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Account.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, account.FieldID)
+		for _, f := range fields {
+			if !account.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != account.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(account.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(account.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Institution(); ok {
+		_spec.SetField(account.FieldInstitution, field.TypeString, value)
+	}
+	if _u.mutation.InstitutionCleared() {
+		_spec.ClearField(account.FieldInstitution, field.TypeString)
+	}
+	if value, ok := _u.mutation.OpeningBalance(); ok {
+		_spec.SetField(account.FieldOpeningBalance, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.OpenedOn(); ok {
+		_spec.SetField(account.FieldOpenedOn, field.TypeTime, value)
+	}
+	if _u.mutation.OpenedOnCleared() {
+		_spec.ClearField(account.FieldOpenedOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosedOn(); ok {
+		_spec.SetField(account.FieldClosedOn, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedOnCleared() {
+		_spec.ClearField(account.FieldClosedOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Archived(); ok {
+		_spec.SetField(account.FieldArchived, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(account.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TransactionsTable,
+			Columns: []string{account.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !_u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TransactionsTable,
+			Columns: []string{account.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TransactionsTable,
+			Columns: []string{account.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostingsTable,
+			Columns: []string{account.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPostingsIDs(); len(nodes) > 0 && !_u.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostingsTable,
+			Columns: []string{account.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostingsTable,
+			Columns: []string{account.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecurrenceRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.RecurrenceRulesTable,
+			Columns: []string{account.RecurrenceRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecurrenceRulesIDs(); len(nodes) > 0 && !_u.mutation.RecurrenceRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.RecurrenceRulesTable,
+			Columns: []string{account.RecurrenceRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecurrenceRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.RecurrenceRulesTable,
+			Columns: []string{account.RecurrenceRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatementImportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.StatementImportsTable,
+			Columns: []string{account.StatementImportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementimport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatementImportsIDs(); len(nodes) > 0 && !_u.mutation.StatementImportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.StatementImportsTable,
+			Columns: []string{account.StatementImportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementimport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatementImportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.StatementImportsTable,
+			Columns: []string{account.StatementImportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementimport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ImportProfilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ImportProfilesTable,
+			Columns: []string{account.ImportProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importprofile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedImportProfilesIDs(); len(nodes) > 0 && !_u.mutation.ImportProfilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ImportProfilesTable,
+			Columns: []string{account.ImportProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImportProfilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ImportProfilesTable,
+			Columns: []string{account.ImportProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ImportDecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ImportDecisionsTable,
+			Columns: []string{account.ImportDecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedImportDecisionsIDs(); len(nodes) > 0 && !_u.mutation.ImportDecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ImportDecisionsTable,
+			Columns: []string{account.ImportDecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImportDecisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ImportDecisionsTable,
+			Columns: []string{account.ImportDecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Account{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{account.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,326 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/ent/workspace"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Category is the model entity for the Category schema.
+type Category struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *int `json:"parent_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind category.Kind `json:"kind,omitempty"`
+	// Color holds the value of the "color" field.
+	Color string `json:"color,omitempty"`
+	// Icon holds the value of the "icon" field.
+	Icon string `json:"icon,omitempty"`
+	// Archived holds the value of the "archived" field.
+	Archived bool `json:"archived,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CategoryQuery when eager-loading is set.
+	Edges        CategoryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CategoryEdges holds the relations/edges for other nodes in the graph.
+type CategoryEdges struct {
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Category `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Category `json:"children,omitempty"`
+	// Transactions holds the value of the transactions edge.
+	Transactions []*Transaction `json:"transactions,omitempty"`
+	// Splits holds the value of the splits edge.
+	Splits []*TransactionSplit `json:"splits,omitempty"`
+	// Payees holds the value of the payees edge.
+	Payees []*Payee `json:"payees,omitempty"`
+	// RecurrenceRules holds the value of the recurrence_rules edge.
+	RecurrenceRules []*RecurrenceRule `json:"recurrence_rules,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [7]bool
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CategoryEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CategoryEdges) ParentOrErr() (*Category, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: category.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e CategoryEdges) ChildrenOrErr() ([]*Category, error) {
+	if e.loadedTypes[2] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// TransactionsOrErr returns the Transactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e CategoryEdges) TransactionsOrErr() ([]*Transaction, error) {
+	if e.loadedTypes[3] {
+		return e.Transactions, nil
+	}
+	return nil, &NotLoadedError{edge: "transactions"}
+}
+
+// SplitsOrErr returns the Splits value or an error if the edge
+// was not loaded in eager-loading.
+func (e CategoryEdges) SplitsOrErr() ([]*TransactionSplit, error) {
+	if e.loadedTypes[4] {
+		return e.Splits, nil
+	}
+	return nil, &NotLoadedError{edge: "splits"}
+}
+
+// PayeesOrErr returns the Payees value or an error if the edge
+// was not loaded in eager-loading.
+func (e CategoryEdges) PayeesOrErr() ([]*Payee, error) {
+	if e.loadedTypes[5] {
+		return e.Payees, nil
+	}
+	return nil, &NotLoadedError{edge: "payees"}
+}
+
+// RecurrenceRulesOrErr returns the RecurrenceRules value or an error if the edge
+// was not loaded in eager-loading.
+func (e CategoryEdges) RecurrenceRulesOrErr() ([]*RecurrenceRule, error) {
+	if e.loadedTypes[6] {
+		return e.RecurrenceRules, nil
+	}
+	return nil, &NotLoadedError{edge: "recurrence_rules"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Category) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case category.FieldArchived:
+			values[i] = new(sql.NullBool)
+		case category.FieldID, category.FieldWorkspaceID, category.FieldParentID:
+			values[i] = new(sql.NullInt64)
+		case category.FieldName, category.FieldKind, category.FieldColor, category.FieldIcon:
+			values[i] = new(sql.NullString)
+		case category.FieldCreatedAt, category.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Category fields.
+func (_m *Category) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case category.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case category.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				_m.WorkspaceID = int(value.Int64)
+			}
+		case category.FieldParentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				_m.ParentID = new(int)
+				*_m.ParentID = int(value.Int64)
+			}
+		case category.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case category.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = category.Kind(value.String)
+			}
+		case category.FieldColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field color", values[i])
+			} else if value.Valid {
+				_m.Color = value.String
+			}
+		case category.FieldIcon:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field icon", values[i])
+			} else if value.Valid {
+				_m.Icon = value.String
+			}
+		case category.FieldArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field archived", values[i])
+			} else if value.Valid {
+				_m.Archived = value.Bool
+			}
+		case category.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case category.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Category.
+// This includes values selected through modifiers, order, etc.
+func (_m *Category) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWorkspace queries the "workspace" edge of the Category entity.
+func (_m *Category) QueryWorkspace() *WorkspaceQuery {
+	return NewCategoryClient(_m.config).QueryWorkspace(_m)
+}
+
+// QueryParent queries the "parent" edge of the Category entity.
+func (_m *Category) QueryParent() *CategoryQuery {
+	return NewCategoryClient(_m.config).QueryParent(_m)
+}
+
+// QueryChildren queries the "children" edge of the Category entity.
+func (_m *Category) QueryChildren() *CategoryQuery {
+	return NewCategoryClient(_m.config).QueryChildren(_m)
+}
+
+// QueryTransactions queries the "transactions" edge of the Category entity.
+func (_m *Category) QueryTransactions() *TransactionQuery {
+	return NewCategoryClient(_m.config).QueryTransactions(_m)
+}
+
+// QuerySplits queries the "splits" edge of the Category entity.
+func (_m *Category) QuerySplits() *TransactionSplitQuery {
+	return NewCategoryClient(_m.config).QuerySplits(_m)
+}
+
+// QueryPayees queries the "payees" edge of the Category entity.
+func (_m *Category) QueryPayees() *PayeeQuery {
+	return NewCategoryClient(_m.config).QueryPayees(_m)
+}
+
+// QueryRecurrenceRules queries the "recurrence_rules" edge of the Category entity.
+func (_m *Category) QueryRecurrenceRules() *RecurrenceRuleQuery {
+	return NewCategoryClient(_m.config).QueryRecurrenceRules(_m)
+}
+
+// Update returns a builder for updating this Category.
+// Note that you need to call Category.Unwrap() before calling this method if this Category
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Category) Update() *CategoryUpdateOne {
+	return NewCategoryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Category entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Category) Unwrap() *Category {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Category is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Category) String() string {
+	var builder strings.Builder
+	builder.WriteString("Category(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	if v := _m.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("color=")
+	builder.WriteString(_m.Color)
+	builder.WriteString(", ")
+	builder.WriteString("icon=")
+	builder.WriteString(_m.Icon)
+	builder.WriteString(", ")
+	builder.WriteString("archived=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Archived))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Categories is a parsable slice of Category.
+type Categories []*Category
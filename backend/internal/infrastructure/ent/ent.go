@@ -3,8 +3,28 @@
 package ent
 
 import (
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/ent/emailverificationtoken"
+	"backend/internal/infrastructure/ent/importdecision"
+	"backend/internal/infrastructure/ent/importprofile"
+	"backend/internal/infrastructure/ent/journalentry"
+	"backend/internal/infrastructure/ent/membership"
+	"backend/internal/infrastructure/ent/passwordresettoken"
+	"backend/internal/infrastructure/ent/payee"
+	"backend/internal/infrastructure/ent/posting"
+	"backend/internal/infrastructure/ent/recoverycode"
+	"backend/internal/infrastructure/ent/recurrenceoccurrence"
+	"backend/internal/infrastructure/ent/recurrencerule"
+	"backend/internal/infrastructure/ent/session"
+	"backend/internal/infrastructure/ent/statementimport"
+	"backend/internal/infrastructure/ent/tag"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/transactionsplit"
+	"backend/internal/infrastructure/ent/transfer"
 	"backend/internal/infrastructure/ent/user"
 	"backend/internal/infrastructure/ent/workspace"
+	"backend/internal/infrastructure/ent/workspaceinvitation"
 	"context"
 	"errors"
 	"fmt"
@@ -74,8 +94,28 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			user.Table:      user.ValidColumn,
-			workspace.Table: workspace.ValidColumn,
+			account.Table:                account.ValidColumn,
+			category.Table:               category.ValidColumn,
+			emailverificationtoken.Table: emailverificationtoken.ValidColumn,
+			importdecision.Table:         importdecision.ValidColumn,
+			importprofile.Table:          importprofile.ValidColumn,
+			journalentry.Table:           journalentry.ValidColumn,
+			membership.Table:             membership.ValidColumn,
+			passwordresettoken.Table:     passwordresettoken.ValidColumn,
+			payee.Table:                  payee.ValidColumn,
+			posting.Table:                posting.ValidColumn,
+			recoverycode.Table:           recoverycode.ValidColumn,
+			recurrenceoccurrence.Table:   recurrenceoccurrence.ValidColumn,
+			recurrencerule.Table:         recurrencerule.ValidColumn,
+			session.Table:                session.ValidColumn,
+			statementimport.Table:        statementimport.ValidColumn,
+			tag.Table:                    tag.ValidColumn,
+			transaction.Table:            transaction.ValidColumn,
+			transactionsplit.Table:       transactionsplit.ValidColumn,
+			transfer.Table:               transfer.ValidColumn,
+			user.Table:                   user.ValidColumn,
+			workspace.Table:              workspace.ValidColumn,
+			workspaceinvitation.Table:    workspaceinvitation.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,4714 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/ent/emailverificationtoken"
+	"backend/internal/infrastructure/ent/importdecision"
+	"backend/internal/infrastructure/ent/importprofile"
+	"backend/internal/infrastructure/ent/journalentry"
+	"backend/internal/infrastructure/ent/membership"
+	"backend/internal/infrastructure/ent/passwordresettoken"
+	"backend/internal/infrastructure/ent/payee"
+	"backend/internal/infrastructure/ent/posting"
+	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/recoverycode"
+	"backend/internal/infrastructure/ent/recurrenceoccurrence"
+	"backend/internal/infrastructure/ent/recurrencerule"
+	"backend/internal/infrastructure/ent/session"
+	"backend/internal/infrastructure/ent/statementimport"
+	"backend/internal/infrastructure/ent/tag"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/transactionsplit"
+	"backend/internal/infrastructure/ent/transfer"
+	"backend/internal/infrastructure/ent/user"
+	"backend/internal/infrastructure/ent/workspace"
+	"backend/internal/infrastructure/ent/workspaceinvitation"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entql"
+	"entgo.io/ent/schema/field"
+)
+
+// schemaGraph holds a representation of ent/schema at runtime.
+var schemaGraph = func() *sqlgraph.Schema {
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 22)}
+	graph.Nodes[0] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   account.Table,
+			Columns: account.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: account.FieldID,
+			},
+		},
+		Type: "Account",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			account.FieldWorkspaceID:    {Type: field.TypeInt, Column: account.FieldWorkspaceID},
+			account.FieldName:           {Type: field.TypeString, Column: account.FieldName},
+			account.FieldType:           {Type: field.TypeEnum, Column: account.FieldType},
+			account.FieldInstitution:    {Type: field.TypeString, Column: account.FieldInstitution},
+			account.FieldCurrency:       {Type: field.TypeString, Column: account.FieldCurrency},
+			account.FieldOpeningBalance: {Type: field.TypeOther, Column: account.FieldOpeningBalance},
+			account.FieldOpenedOn:       {Type: field.TypeTime, Column: account.FieldOpenedOn},
+			account.FieldClosedOn:       {Type: field.TypeTime, Column: account.FieldClosedOn},
+			account.FieldArchived:       {Type: field.TypeBool, Column: account.FieldArchived},
+			account.FieldCreatedAt:      {Type: field.TypeTime, Column: account.FieldCreatedAt},
+			account.FieldUpdatedAt:      {Type: field.TypeTime, Column: account.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[1] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   category.Table,
+			Columns: category.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: category.FieldID,
+			},
+		},
+		Type: "Category",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			category.FieldWorkspaceID: {Type: field.TypeInt, Column: category.FieldWorkspaceID},
+			category.FieldParentID:    {Type: field.TypeInt, Column: category.FieldParentID},
+			category.FieldName:        {Type: field.TypeString, Column: category.FieldName},
+			category.FieldKind:        {Type: field.TypeEnum, Column: category.FieldKind},
+			category.FieldColor:       {Type: field.TypeString, Column: category.FieldColor},
+			category.FieldIcon:        {Type: field.TypeString, Column: category.FieldIcon},
+			category.FieldArchived:    {Type: field.TypeBool, Column: category.FieldArchived},
+			category.FieldCreatedAt:   {Type: field.TypeTime, Column: category.FieldCreatedAt},
+			category.FieldUpdatedAt:   {Type: field.TypeTime, Column: category.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[2] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   emailverificationtoken.Table,
+			Columns: emailverificationtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: emailverificationtoken.FieldID,
+			},
+		},
+		Type: "EmailVerificationToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			emailverificationtoken.FieldTokenHash: {Type: field.TypeString, Column: emailverificationtoken.FieldTokenHash},
+			emailverificationtoken.FieldUserID:    {Type: field.TypeInt, Column: emailverificationtoken.FieldUserID},
+			emailverificationtoken.FieldExpiresAt: {Type: field.TypeTime, Column: emailverificationtoken.FieldExpiresAt},
+			emailverificationtoken.FieldUsedAt:    {Type: field.TypeTime, Column: emailverificationtoken.FieldUsedAt},
+			emailverificationtoken.FieldCreatedAt: {Type: field.TypeTime, Column: emailverificationtoken.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[3] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   importdecision.Table,
+			Columns: importdecision.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: importdecision.FieldID,
+			},
+		},
+		Type: "ImportDecision",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			importdecision.FieldWorkspaceID:       {Type: field.TypeInt, Column: importdecision.FieldWorkspaceID},
+			importdecision.FieldAccountID:         {Type: field.TypeInt, Column: importdecision.FieldAccountID},
+			importdecision.FieldStatementImportID: {Type: field.TypeInt, Column: importdecision.FieldStatementImportID},
+			importdecision.FieldFingerprint:       {Type: field.TypeString, Column: importdecision.FieldFingerprint},
+			importdecision.FieldAction:            {Type: field.TypeEnum, Column: importdecision.FieldAction},
+			importdecision.FieldTransactionID:     {Type: field.TypeInt, Column: importdecision.FieldTransactionID},
+			importdecision.FieldCreatedAt:         {Type: field.TypeTime, Column: importdecision.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[4] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   importprofile.Table,
+			Columns: importprofile.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: importprofile.FieldID,
+			},
+		},
+		Type: "ImportProfile",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			importprofile.FieldWorkspaceID: {Type: field.TypeInt, Column: importprofile.FieldWorkspaceID},
+			importprofile.FieldAccountID:   {Type: field.TypeInt, Column: importprofile.FieldAccountID},
+			importprofile.FieldName:        {Type: field.TypeString, Column: importprofile.FieldName},
+			importprofile.FieldMapping:     {Type: field.TypeJSON, Column: importprofile.FieldMapping},
+			importprofile.FieldCreatedAt:   {Type: field.TypeTime, Column: importprofile.FieldCreatedAt},
+			importprofile.FieldUpdatedAt:   {Type: field.TypeTime, Column: importprofile.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[5] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   journalentry.Table,
+			Columns: journalentry.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: journalentry.FieldID,
+			},
+		},
+		Type: "JournalEntry",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			journalentry.FieldWorkspaceID:   {Type: field.TypeInt, Column: journalentry.FieldWorkspaceID},
+			journalentry.FieldTransactionID: {Type: field.TypeInt, Column: journalentry.FieldTransactionID},
+			journalentry.FieldPostedOn:      {Type: field.TypeTime, Column: journalentry.FieldPostedOn},
+			journalentry.FieldMemo:          {Type: field.TypeString, Column: journalentry.FieldMemo},
+			journalentry.FieldCreatedAt:     {Type: field.TypeTime, Column: journalentry.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[6] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   membership.Table,
+			Columns: membership.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: membership.FieldID,
+			},
+		},
+		Type: "Membership",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			membership.FieldWorkspaceID: {Type: field.TypeInt, Column: membership.FieldWorkspaceID},
+			membership.FieldUserID:      {Type: field.TypeInt, Column: membership.FieldUserID},
+			membership.FieldRole:        {Type: field.TypeEnum, Column: membership.FieldRole},
+			membership.FieldJoinedAt:    {Type: field.TypeTime, Column: membership.FieldJoinedAt},
+			membership.FieldInvitedByID: {Type: field.TypeInt, Column: membership.FieldInvitedByID},
+		},
+	}
+	graph.Nodes[7] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   passwordresettoken.Table,
+			Columns: passwordresettoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: passwordresettoken.FieldID,
+			},
+		},
+		Type: "PasswordResetToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			passwordresettoken.FieldTokenHash: {Type: field.TypeString, Column: passwordresettoken.FieldTokenHash},
+			passwordresettoken.FieldUserID:    {Type: field.TypeInt, Column: passwordresettoken.FieldUserID},
+			passwordresettoken.FieldExpiresAt: {Type: field.TypeTime, Column: passwordresettoken.FieldExpiresAt},
+			passwordresettoken.FieldUsedAt:    {Type: field.TypeTime, Column: passwordresettoken.FieldUsedAt},
+			passwordresettoken.FieldCreatedAt: {Type: field.TypeTime, Column: passwordresettoken.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[8] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   payee.Table,
+			Columns: payee.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: payee.FieldID,
+			},
+		},
+		Type: "Payee",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			payee.FieldWorkspaceID:       {Type: field.TypeInt, Column: payee.FieldWorkspaceID},
+			payee.FieldName:              {Type: field.TypeString, Column: payee.FieldName},
+			payee.FieldAliases:           {Type: field.TypeJSON, Column: payee.FieldAliases},
+			payee.FieldDefaultCategoryID: {Type: field.TypeInt, Column: payee.FieldDefaultCategoryID},
+			payee.FieldLogoURL:           {Type: field.TypeString, Column: payee.FieldLogoURL},
+			payee.FieldWebsite:           {Type: field.TypeString, Column: payee.FieldWebsite},
+			payee.FieldCreatedAt:         {Type: field.TypeTime, Column: payee.FieldCreatedAt},
+			payee.FieldUpdatedAt:         {Type: field.TypeTime, Column: payee.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[9] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   posting.Table,
+			Columns: posting.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: posting.FieldID,
+			},
+		},
+		Type: "Posting",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			posting.FieldWorkspaceID:    {Type: field.TypeInt, Column: posting.FieldWorkspaceID},
+			posting.FieldJournalEntryID: {Type: field.TypeInt, Column: posting.FieldJournalEntryID},
+			posting.FieldAccountID:      {Type: field.TypeInt, Column: posting.FieldAccountID},
+			posting.FieldAmount:         {Type: field.TypeOther, Column: posting.FieldAmount},
+			posting.FieldCurrency:       {Type: field.TypeString, Column: posting.FieldCurrency},
+		},
+	}
+	graph.Nodes[10] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   recoverycode.Table,
+			Columns: recoverycode.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: recoverycode.FieldID,
+			},
+		},
+		Type: "RecoveryCode",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			recoverycode.FieldCodeHash:  {Type: field.TypeString, Column: recoverycode.FieldCodeHash},
+			recoverycode.FieldUserID:    {Type: field.TypeInt, Column: recoverycode.FieldUserID},
+			recoverycode.FieldUsedAt:    {Type: field.TypeTime, Column: recoverycode.FieldUsedAt},
+			recoverycode.FieldCreatedAt: {Type: field.TypeTime, Column: recoverycode.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[11] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   recurrenceoccurrence.Table,
+			Columns: recurrenceoccurrence.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: recurrenceoccurrence.FieldID,
+			},
+		},
+		Type: "RecurrenceOccurrence",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			recurrenceoccurrence.FieldWorkspaceID:   {Type: field.TypeInt, Column: recurrenceoccurrence.FieldWorkspaceID},
+			recurrenceoccurrence.FieldRuleID:        {Type: field.TypeInt, Column: recurrenceoccurrence.FieldRuleID},
+			recurrenceoccurrence.FieldDueOn:         {Type: field.TypeTime, Column: recurrenceoccurrence.FieldDueOn},
+			recurrenceoccurrence.FieldStatus:        {Type: field.TypeEnum, Column: recurrenceoccurrence.FieldStatus},
+			recurrenceoccurrence.FieldTransactionID: {Type: field.TypeInt, Column: recurrenceoccurrence.FieldTransactionID},
+			recurrenceoccurrence.FieldCreatedAt:     {Type: field.TypeTime, Column: recurrenceoccurrence.FieldCreatedAt},
+			recurrenceoccurrence.FieldUpdatedAt:     {Type: field.TypeTime, Column: recurrenceoccurrence.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[12] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   recurrencerule.Table,
+			Columns: recurrencerule.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: recurrencerule.FieldID,
+			},
+		},
+		Type: "RecurrenceRule",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			recurrencerule.FieldWorkspaceID:   {Type: field.TypeInt, Column: recurrencerule.FieldWorkspaceID},
+			recurrencerule.FieldAccountID:     {Type: field.TypeInt, Column: recurrencerule.FieldAccountID},
+			recurrencerule.FieldCategoryID:    {Type: field.TypeInt, Column: recurrencerule.FieldCategoryID},
+			recurrencerule.FieldPayeeID:       {Type: field.TypeInt, Column: recurrencerule.FieldPayeeID},
+			recurrencerule.FieldAmount:        {Type: field.TypeOther, Column: recurrencerule.FieldAmount},
+			recurrencerule.FieldCurrency:      {Type: field.TypeString, Column: recurrencerule.FieldCurrency},
+			recurrencerule.FieldPayee:         {Type: field.TypeString, Column: recurrencerule.FieldPayee},
+			recurrencerule.FieldMemo:          {Type: field.TypeString, Column: recurrencerule.FieldMemo},
+			recurrencerule.FieldFrequency:     {Type: field.TypeEnum, Column: recurrencerule.FieldFrequency},
+			recurrencerule.FieldInterval:      {Type: field.TypeInt, Column: recurrencerule.FieldInterval},
+			recurrencerule.FieldByDay:         {Type: field.TypeJSON, Column: recurrencerule.FieldByDay},
+			recurrencerule.FieldStartsOn:      {Type: field.TypeTime, Column: recurrencerule.FieldStartsOn},
+			recurrencerule.FieldEndsOn:        {Type: field.TypeTime, Column: recurrencerule.FieldEndsOn},
+			recurrencerule.FieldScheduledFrom: {Type: field.TypeTime, Column: recurrencerule.FieldScheduledFrom},
+			recurrencerule.FieldCreatedAt:     {Type: field.TypeTime, Column: recurrencerule.FieldCreatedAt},
+			recurrencerule.FieldUpdatedAt:     {Type: field.TypeTime, Column: recurrencerule.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[13] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   session.Table,
+			Columns: session.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: session.FieldID,
+			},
+		},
+		Type: "Session",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			session.FieldTokenHash:  {Type: field.TypeString, Column: session.FieldTokenHash},
+			session.FieldUserID:     {Type: field.TypeInt, Column: session.FieldUserID},
+			session.FieldData:       {Type: field.TypeBytes, Column: session.FieldData},
+			session.FieldUserAgent:  {Type: field.TypeString, Column: session.FieldUserAgent},
+			session.FieldIPAddress:  {Type: field.TypeString, Column: session.FieldIPAddress},
+			session.FieldLastSeenAt: {Type: field.TypeTime, Column: session.FieldLastSeenAt},
+			session.FieldExpiresAt:  {Type: field.TypeTime, Column: session.FieldExpiresAt},
+			session.FieldCreatedAt:  {Type: field.TypeTime, Column: session.FieldCreatedAt},
+			session.FieldUpdatedAt:  {Type: field.TypeTime, Column: session.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[14] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   statementimport.Table,
+			Columns: statementimport.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: statementimport.FieldID,
+			},
+		},
+		Type: "StatementImport",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			statementimport.FieldWorkspaceID:  {Type: field.TypeInt, Column: statementimport.FieldWorkspaceID},
+			statementimport.FieldAccountID:    {Type: field.TypeInt, Column: statementimport.FieldAccountID},
+			statementimport.FieldFormat:       {Type: field.TypeEnum, Column: statementimport.FieldFormat},
+			statementimport.FieldFilename:     {Type: field.TypeString, Column: statementimport.FieldFilename},
+			statementimport.FieldContent:      {Type: field.TypeBytes, Column: statementimport.FieldContent},
+			statementimport.FieldStatus:       {Type: field.TypeEnum, Column: statementimport.FieldStatus},
+			statementimport.FieldCreatedCount: {Type: field.TypeInt, Column: statementimport.FieldCreatedCount},
+			statementimport.FieldSkippedCount: {Type: field.TypeInt, Column: statementimport.FieldSkippedCount},
+			statementimport.FieldMergedCount:  {Type: field.TypeInt, Column: statementimport.FieldMergedCount},
+			statementimport.FieldFailedCount:  {Type: field.TypeInt, Column: statementimport.FieldFailedCount},
+			statementimport.FieldCommittedAt:  {Type: field.TypeTime, Column: statementimport.FieldCommittedAt},
+			statementimport.FieldCreatedAt:    {Type: field.TypeTime, Column: statementimport.FieldCreatedAt},
+			statementimport.FieldUpdatedAt:    {Type: field.TypeTime, Column: statementimport.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[15] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   tag.Table,
+			Columns: tag.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: tag.FieldID,
+			},
+		},
+		Type: "Tag",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			tag.FieldWorkspaceID: {Type: field.TypeInt, Column: tag.FieldWorkspaceID},
+			tag.FieldName:        {Type: field.TypeString, Column: tag.FieldName},
+			tag.FieldColor:       {Type: field.TypeString, Column: tag.FieldColor},
+			tag.FieldCreatedAt:   {Type: field.TypeTime, Column: tag.FieldCreatedAt},
+			tag.FieldUpdatedAt:   {Type: field.TypeTime, Column: tag.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[16] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   transaction.Table,
+			Columns: transaction.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: transaction.FieldID,
+			},
+		},
+		Type: "Transaction",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			transaction.FieldWorkspaceID:      {Type: field.TypeInt, Column: transaction.FieldWorkspaceID},
+			transaction.FieldAccountID:        {Type: field.TypeInt, Column: transaction.FieldAccountID},
+			transaction.FieldCategoryID:       {Type: field.TypeInt, Column: transaction.FieldCategoryID},
+			transaction.FieldPayeeID:          {Type: field.TypeInt, Column: transaction.FieldPayeeID},
+			transaction.FieldPostedOn:         {Type: field.TypeTime, Column: transaction.FieldPostedOn},
+			transaction.FieldAmount:           {Type: field.TypeOther, Column: transaction.FieldAmount},
+			transaction.FieldCurrency:         {Type: field.TypeString, Column: transaction.FieldCurrency},
+			transaction.FieldPayee:            {Type: field.TypeString, Column: transaction.FieldPayee},
+			transaction.FieldMemo:             {Type: field.TypeString, Column: transaction.FieldMemo},
+			transaction.FieldExternalID:       {Type: field.TypeString, Column: transaction.FieldExternalID},
+			transaction.FieldValueDate:        {Type: field.TypeTime, Column: transaction.FieldValueDate},
+			transaction.FieldCounterpartyIban: {Type: field.TypeString, Column: transaction.FieldCounterpartyIban},
+			transaction.FieldEndToEndID:       {Type: field.TypeString, Column: transaction.FieldEndToEndID},
+			transaction.FieldStatus:           {Type: field.TypeEnum, Column: transaction.FieldStatus},
+			transaction.FieldCreatedAt:        {Type: field.TypeTime, Column: transaction.FieldCreatedAt},
+			transaction.FieldUpdatedAt:        {Type: field.TypeTime, Column: transaction.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[17] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   transactionsplit.Table,
+			Columns: transactionsplit.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: transactionsplit.FieldID,
+			},
+		},
+		Type: "TransactionSplit",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			transactionsplit.FieldWorkspaceID:   {Type: field.TypeInt, Column: transactionsplit.FieldWorkspaceID},
+			transactionsplit.FieldTransactionID: {Type: field.TypeInt, Column: transactionsplit.FieldTransactionID},
+			transactionsplit.FieldCategoryID:    {Type: field.TypeInt, Column: transactionsplit.FieldCategoryID},
+			transactionsplit.FieldAmount:        {Type: field.TypeOther, Column: transactionsplit.FieldAmount},
+			transactionsplit.FieldCurrency:      {Type: field.TypeString, Column: transactionsplit.FieldCurrency},
+			transactionsplit.FieldMemo:          {Type: field.TypeString, Column: transactionsplit.FieldMemo},
+		},
+	}
+	graph.Nodes[18] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   transfer.Table,
+			Columns: transfer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: transfer.FieldID,
+			},
+		},
+		Type: "Transfer",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			transfer.FieldWorkspaceID:       {Type: field.TypeInt, Column: transfer.FieldWorkspaceID},
+			transfer.FieldFromTransactionID: {Type: field.TypeInt, Column: transfer.FieldFromTransactionID},
+			transfer.FieldToTransactionID:   {Type: field.TypeInt, Column: transfer.FieldToTransactionID},
+			transfer.FieldFromFee:           {Type: field.TypeOther, Column: transfer.FieldFromFee},
+			transfer.FieldToFee:             {Type: field.TypeOther, Column: transfer.FieldToFee},
+			transfer.FieldCreatedAt:         {Type: field.TypeTime, Column: transfer.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[19] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   user.Table,
+			Columns: user.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: user.FieldID,
+			},
+		},
+		Type: "User",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			user.FieldEmail:                   {Type: field.TypeString, Column: user.FieldEmail},
+			user.FieldPasswordHash:            {Type: field.TypeString, Column: user.FieldPasswordHash},
+			user.FieldEmailVerifiedAt:         {Type: field.TypeTime, Column: user.FieldEmailVerifiedAt},
+			user.FieldTotpSecret:              {Type: field.TypeString, Column: user.FieldTotpSecret},
+			user.FieldTotpLastUsedStep:        {Type: field.TypeInt64, Column: user.FieldTotpLastUsedStep},
+			user.FieldTwoFactorEnabledAt:      {Type: field.TypeTime, Column: user.FieldTwoFactorEnabledAt},
+			user.FieldTwoFactorFailedAttempts: {Type: field.TypeInt, Column: user.FieldTwoFactorFailedAttempts},
+			user.FieldTwoFactorLockedUntil:    {Type: field.TypeTime, Column: user.FieldTwoFactorLockedUntil},
+			user.FieldCreatedAt:               {Type: field.TypeTime, Column: user.FieldCreatedAt},
+			user.FieldUpdatedAt:               {Type: field.TypeTime, Column: user.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[20] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   workspace.Table,
+			Columns: workspace.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: workspace.FieldID,
+			},
+		},
+		Type: "Workspace",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			workspace.FieldName:                 {Type: field.TypeString, Column: workspace.FieldName},
+			workspace.FieldRequireVerifiedEmail: {Type: field.TypeBool, Column: workspace.FieldRequireVerifiedEmail},
+			workspace.FieldCreatedAt:            {Type: field.TypeTime, Column: workspace.FieldCreatedAt},
+			workspace.FieldUpdatedAt:            {Type: field.TypeTime, Column: workspace.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[21] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   workspaceinvitation.Table,
+			Columns: workspaceinvitation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: workspaceinvitation.FieldID,
+			},
+		},
+		Type: "WorkspaceInvitation",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			workspaceinvitation.FieldWorkspaceID:  {Type: field.TypeInt, Column: workspaceinvitation.FieldWorkspaceID},
+			workspaceinvitation.FieldEmail:        {Type: field.TypeString, Column: workspaceinvitation.FieldEmail},
+			workspaceinvitation.FieldRole:         {Type: field.TypeEnum, Column: workspaceinvitation.FieldRole},
+			workspaceinvitation.FieldTokenHash:    {Type: field.TypeString, Column: workspaceinvitation.FieldTokenHash},
+			workspaceinvitation.FieldStatus:       {Type: field.TypeEnum, Column: workspaceinvitation.FieldStatus},
+			workspaceinvitation.FieldExpiresAt:    {Type: field.TypeTime, Column: workspaceinvitation.FieldExpiresAt},
+			workspaceinvitation.FieldInvitedByID:  {Type: field.TypeInt, Column: workspaceinvitation.FieldInvitedByID},
+			workspaceinvitation.FieldAcceptedByID: {Type: field.TypeInt, Column: workspaceinvitation.FieldAcceptedByID},
+			workspaceinvitation.FieldRespondedAt:  {Type: field.TypeTime, Column: workspaceinvitation.FieldRespondedAt},
+			workspaceinvitation.FieldCreatedAt:    {Type: field.TypeTime, Column: workspaceinvitation.FieldCreatedAt},
+		},
+	}
+	graph.MustAddE(
+		"workspace",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   account.WorkspaceTable,
+			Columns: []string{account.WorkspaceColumn},
+			Bidi:    false,
+		},
+		"Account",
+		"Workspace",
+	)
+	graph.MustAddE(
+		"transactions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.TransactionsTable,
+			Columns: []string{account.TransactionsColumn},
+			Bidi:    false,
+		},
+		"Account",
+		"Transaction",
+	)
+	graph.MustAddE(
+		"postings",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.PostingsTable,
+			Columns: []string{account.PostingsColumn},
+			Bidi:    false,
+		},
+		"Account",
+		"Posting",
+	)
+	graph.MustAddE(
+		"recurrence_rules",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.RecurrenceRulesTable,
+			Columns: []string{account.RecurrenceRulesColumn},
+			Bidi:    false,
+		},
+		"Account",
+		"RecurrenceRule",
+	)
+	graph.MustAddE(
+		"statement_imports",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.StatementImportsTable,
+			Columns: []string{account.StatementImportsColumn},
+			Bidi:    false,
+		},
+		"Account",
+		"StatementImport",
+	)
+	graph.MustAddE(
+		"import_profiles",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ImportProfilesTable,
+			Columns: []string{account.ImportProfilesColumn},
+			Bidi:    false,
+		},
+		"Account",
+		"ImportProfile",
+	)
+	graph.MustAddE(
+		"import_decisions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.ImportDecisionsTable,
+			Columns: []string{account.ImportDecisionsColumn},
+			Bidi:    false,
+		},
+		"Account",
+		"ImportDecision",
+	)
+	graph.MustAddE(
+		"workspace",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   category.WorkspaceTable,
+			Columns: []string{category.WorkspaceColumn},
+			Bidi:    false,
+		},
+		"Category",
+		"Workspace",
+	)
+	graph.MustAddE(
+		"parent",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   category.ParentTable,
+			Columns: []string{category.ParentColumn},
+			Bidi:    false,
+		},
+		"Category",
+		"Category",
+	)
+	graph.MustAddE(
+		"children",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.ChildrenTable,
+			Columns: []string{category.ChildrenColumn},
+			Bidi:    false,
+		},
+		"Category",
+		"Category",
+	)
+	graph.MustAddE(
+		"transactions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.TransactionsTable,
+			Columns: []string{category.TransactionsColumn},
+			Bidi:    false,
+		},
+		"Category",
+		"Transaction",
+	)
+	graph.MustAddE(
+		"splits",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.SplitsTable,
+			Columns: []string{category.SplitsColumn},
+			Bidi:    false,
+		},
+		"Category",
+		"TransactionSplit",
+	)
+	graph.MustAddE(
+		"payees",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.PayeesTable,
+			Columns: []string{category.PayeesColumn},
+			Bidi:    false,
+		},
+		"Category",
+		"Payee",
+	)
+	graph.MustAddE(
+		"recurrence_rules",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   category.RecurrenceRulesTable,
+			Columns: []string{category.RecurrenceRulesColumn},
+			Bidi:    false,
+		},
+		"Category",
+		"RecurrenceRule",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailverificationtoken.UserTable,
+			Columns: []string{emailverificationtoken.UserColumn},
+			Bidi:    false,
+		},
+		"EmailVerificationToken",
+		"User",
+	)
+	graph.MustAddE(
+		"workspace",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importdecision.WorkspaceTable,
+			Columns: []string{importdecision.WorkspaceColumn},
+			Bidi:    false,
+		},
+		"ImportDecision",
+		"Workspace",
+	)
+	graph.MustAddE(
+		"account",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importdecision.AccountTable,
+			Columns: []string{importdecision.AccountColumn},
+			Bidi:    false,
+		},
+		"ImportDecision",
+		"Account",
+	)
+	graph.MustAddE(
+		"statement_import",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importdecision.StatementImportTable,
+			Columns: []string{importdecision.StatementImportColumn},
+			Bidi:    false,
+		},
+		"ImportDecision",
+		"StatementImport",
+	)
+	graph.MustAddE(
+		"transaction",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importdecision.TransactionTable,
+			Columns: []string{importdecision.TransactionColumn},
+			Bidi:    false,
+		},
+		"ImportDecision",
+		"Transaction",
+	)
+	graph.MustAddE(
+		"workspace",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importprofile.WorkspaceTable,
+			Columns: []string{importprofile.WorkspaceColumn},
+			Bidi:    false,
+		},
+		"ImportProfile",
+		"Workspace",
+	)
+	graph.MustAddE(
+		"account",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importprofile.AccountTable,
+			Columns: []string{importprofile.AccountColumn},
+			Bidi:    false,
+		},
+		"ImportProfile",
+		"Account",
+	)
+	graph.MustAddE(
+		"workspace",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   journalentry.WorkspaceTable,
+			Columns: []string{journalentry.WorkspaceColumn},
+			Bidi:    false,
+		},
+		"JournalEntry",
+		"Workspace",
+	)
+	graph.MustAddE(
+		"transaction",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   journalentry.TransactionTable,
+			Columns: []string{journalentry.TransactionColumn},
+			Bidi:    false,
+		},
+		"JournalEntry",
+		"Transaction",
+	)
+	graph.MustAddE(
+		"postings",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+		},
+		"JournalEntry",
+		"Posting",
+	)
+	graph.MustAddE(
+		"workspace",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membership.WorkspaceTable,
+			Columns: []string{membership.WorkspaceColumn},
+			Bidi:    false,
+		},
+		"Membership",
+		"Workspace",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membership.UserTable,
+			Columns: []string{membership.UserColumn},
+			Bidi:    false,
+		},
+		"Membership",
+		"User",
+	)
+	graph.MustAddE(
+		"invited_by",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   membership.InvitedByTable,
+			Columns: []string{membership.InvitedByColumn},
+			Bidi:    false,
+		},
+		"Membership",
+		"User",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordresettoken.UserTable,
+			Columns: []string{passwordresettoken.UserColumn},
+			Bidi:    false,
+		},
+		"PasswordResetToken",
+		"User",
+	)
+	graph.MustAddE(
+		"workspace",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payee.WorkspaceTable,
+			Columns: []string{payee.WorkspaceColumn},
+			Bidi:    false,
+		},
+		"Payee",
+		"Workspace",
+	)
+	graph.MustAddE(
+		"default_category",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payee.DefaultCategoryTable,
+			Columns: []string{payee.DefaultCategoryColumn},
+			Bidi:    false,
+		},
+		"Payee",
+		"Category",
+	)
+	graph.MustAddE(
+		"transactions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payee.TransactionsTable,
+			Columns: []string{payee.TransactionsColumn},
+			Bidi:    false,
+		},
+		"Payee",
+		"Transaction",
+	)
+	graph.MustAddE(
+		"recurrence_rules",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payee.RecurrenceRulesTable,
+			Columns: []string{payee.RecurrenceRulesColumn},
+			Bidi:    false,
+		},
+		"Payee",
+		"RecurrenceRule",
+	)
+	graph.MustAddE(
+		"workspace",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posting.WorkspaceTable,
+			Columns: []string{posting.WorkspaceColumn},
+			Bidi:    false,
+		},
+		"Posting",
+		"Workspace",
+	)
+	graph.MustAddE(
+		"journal_entry",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posting.JournalEntryTable,
+			Columns: []string{posting.JournalEntryColumn},
+			Bidi:    false,
+		},
+		"Posting",
+		"JournalEntry",
+	)
+	graph.MustAddE(
+		"account",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posting.AccountTable,
+			Columns: []string{posting.AccountColumn},
+			Bidi:    false,
+		},
+		"Posting",
+		"Account",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recoverycode.UserTable,
+			Columns: []string{recoverycode.UserColumn},
+			Bidi:    false,
+		},
+		"RecoveryCode",
+		"User",
+	)
+	graph.MustAddE(
+		"workspace",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrenceoccurrence.WorkspaceTable,
+			Columns: []string{recurrenceoccurrence.WorkspaceColumn},
+			Bidi:    false,
+		},
+		"RecurrenceOccurrence",
+		"Workspace",
+	)
+	graph.MustAddE(
+		"rule",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrenceoccurrence.RuleTable,
+			Columns: []string{recurrenceoccurrence.RuleColumn},
+			Bidi:    false,
+		},
+		"RecurrenceOccurrence",
+		"RecurrenceRule",
+	)
+	graph.MustAddE(
+		"transaction",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   recurrenceoccurrence.TransactionTable,
+			Columns: []string{recurrenceoccurrence.TransactionColumn},
+			Bidi:    false,
+		},
+		"RecurrenceOccurrence",
+		"Transaction",
+	)
+	graph.MustAddE(
+		"workspace",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.WorkspaceTable,
+			Columns: []string{recurrencerule.WorkspaceColumn},
+			Bidi:    false,
+		},
+		"RecurrenceRule",
+		"Workspace",
+	)
+	graph.MustAddE(
+		"account",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.AccountTable,
+			Columns: []string{recurrencerule.AccountColumn},
+			Bidi:    false,
+		},
+		"RecurrenceRule",
+		"Account",
+	)
+	graph.MustAddE(
+		"category",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.CategoryTable,
+			Columns: []string{recurrencerule.CategoryColumn},
+			Bidi:    false,
+		},
+		"RecurrenceRule",
+		"Category",
+	)
+	graph.MustAddE(
+		"linked_payee",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.LinkedPayeeTable,
+			Columns: []string{recurrencerule.LinkedPayeeColumn},
+			Bidi:    false,
+		},
+		"RecurrenceRule",
+		"Payee",
+	)
+	graph.MustAddE(
+		"occurrences",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recurrencerule.OccurrencesTable,
+			Columns: []string{recurrencerule.OccurrencesColumn},
+			Bidi:    false,
+		},
+		"RecurrenceRule",
+		"RecurrenceOccurrence",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.UserTable,
+			Columns: []string{session.UserColumn},
+			Bidi:    false,
+		},
+		"Session",
+		"User",
+	)
+	graph.MustAddE(
+		"workspace",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   statementimport.WorkspaceTable,
+			Columns: []string{statementimport.WorkspaceColumn},
+			Bidi:    false,
+		},
+		"StatementImport",
+		"Workspace",
+	)
+	graph.MustAddE(
+		"account",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   statementimport.AccountTable,
+			Columns: []string{statementimport.AccountColumn},
+			Bidi:    false,
+		},
+		"StatementImport",
+		"Account",
+	)
+	graph.MustAddE(
+		"decisions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   statementimport.DecisionsTable,
+			Columns: []string{statementimport.DecisionsColumn},
+			Bidi:    false,
+		},
+		"StatementImport",
+		"ImportDecision",
+	)
+	graph.MustAddE(
+		"workspace",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tag.WorkspaceTable,
+			Columns: []string{tag.WorkspaceColumn},
+			Bidi:    false,
+		},
+		"Tag",
+		"Workspace",
+	)
+	graph.MustAddE(
+		"transactions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tag.TransactionsTable,
+			Columns: tag.TransactionsPrimaryKey,
+			Bidi:    false,
+		},
+		"Tag",
+		"Transaction",
+	)
+	graph.MustAddE(
+		"splits",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tag.SplitsTable,
+			Columns: tag.SplitsPrimaryKey,
+			Bidi:    false,
+		},
+		"Tag",
+		"TransactionSplit",
+	)
+	graph.MustAddE(
+		"workspace",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.WorkspaceTable,
+			Columns: []string{transaction.WorkspaceColumn},
+			Bidi:    false,
+		},
+		"Transaction",
+		"Workspace",
+	)
+	graph.MustAddE(
+		"account",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.AccountTable,
+			Columns: []string{transaction.AccountColumn},
+			Bidi:    false,
+		},
+		"Transaction",
+		"Account",
+	)
+	graph.MustAddE(
+		"category",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.CategoryTable,
+			Columns: []string{transaction.CategoryColumn},
+			Bidi:    false,
+		},
+		"Transaction",
+		"Category",
+	)
+	graph.MustAddE(
+		"linked_payee",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.LinkedPayeeTable,
+			Columns: []string{transaction.LinkedPayeeColumn},
+			Bidi:    false,
+		},
+		"Transaction",
+		"Payee",
+	)
+	graph.MustAddE(
+		"tags",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   transaction.TagsTable,
+			Columns: transaction.TagsPrimaryKey,
+			Bidi:    false,
+		},
+		"Transaction",
+		"Tag",
+	)
+	graph.MustAddE(
+		"splits",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.SplitsTable,
+			Columns: []string{transaction.SplitsColumn},
+			Bidi:    false,
+		},
+		"Transaction",
+		"TransactionSplit",
+	)
+	graph.MustAddE(
+		"journal_entry",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.JournalEntryTable,
+			Columns: []string{transaction.JournalEntryColumn},
+			Bidi:    false,
+		},
+		"Transaction",
+		"JournalEntry",
+	)
+	graph.MustAddE(
+		"outgoing_transfer",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.OutgoingTransferTable,
+			Columns: []string{transaction.OutgoingTransferColumn},
+			Bidi:    false,
+		},
+		"Transaction",
+		"Transfer",
+	)
+	graph.MustAddE(
+		"incoming_transfer",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.IncomingTransferTable,
+			Columns: []string{transaction.IncomingTransferColumn},
+			Bidi:    false,
+		},
+		"Transaction",
+		"Transfer",
+	)
+	graph.MustAddE(
+		"recurrence_occurrence",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.RecurrenceOccurrenceTable,
+			Columns: []string{transaction.RecurrenceOccurrenceColumn},
+			Bidi:    false,
+		},
+		"Transaction",
+		"RecurrenceOccurrence",
+	)
+	graph.MustAddE(
+		"import_decisions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ImportDecisionsTable,
+			Columns: []string{transaction.ImportDecisionsColumn},
+			Bidi:    false,
+		},
+		"Transaction",
+		"ImportDecision",
+	)
+	graph.MustAddE(
+		"workspace",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transactionsplit.WorkspaceTable,
+			Columns: []string{transactionsplit.WorkspaceColumn},
+			Bidi:    false,
+		},
+		"TransactionSplit",
+		"Workspace",
+	)
+	graph.MustAddE(
+		"transaction",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transactionsplit.TransactionTable,
+			Columns: []string{transactionsplit.TransactionColumn},
+			Bidi:    false,
+		},
+		"TransactionSplit",
+		"Transaction",
+	)
+	graph.MustAddE(
+		"category",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transactionsplit.CategoryTable,
+			Columns: []string{transactionsplit.CategoryColumn},
+			Bidi:    false,
+		},
+		"TransactionSplit",
+		"Category",
+	)
+	graph.MustAddE(
+		"tags",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   transactionsplit.TagsTable,
+			Columns: transactionsplit.TagsPrimaryKey,
+			Bidi:    false,
+		},
+		"TransactionSplit",
+		"Tag",
+	)
+	graph.MustAddE(
+		"workspace",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transfer.WorkspaceTable,
+			Columns: []string{transfer.WorkspaceColumn},
+			Bidi:    false,
+		},
+		"Transfer",
+		"Workspace",
+	)
+	graph.MustAddE(
+		"from_transaction",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   transfer.FromTransactionTable,
+			Columns: []string{transfer.FromTransactionColumn},
+			Bidi:    false,
+		},
+		"Transfer",
+		"Transaction",
+	)
+	graph.MustAddE(
+		"to_transaction",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   transfer.ToTransactionTable,
+			Columns: []string{transfer.ToTransactionColumn},
+			Bidi:    false,
+		},
+		"Transfer",
+		"Transaction",
+	)
+	graph.MustAddE(
+		"workspaces",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.WorkspacesTable,
+			Columns: user.WorkspacesPrimaryKey,
+			Bidi:    false,
+		},
+		"User",
+		"Workspace",
+	)
+	graph.MustAddE(
+		"sessions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+		},
+		"User",
+		"Session",
+	)
+	graph.MustAddE(
+		"password_reset_tokens",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordResetTokensTable,
+			Columns: []string{user.PasswordResetTokensColumn},
+			Bidi:    false,
+		},
+		"User",
+		"PasswordResetToken",
+	)
+	graph.MustAddE(
+		"email_verification_tokens",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailVerificationTokensTable,
+			Columns: []string{user.EmailVerificationTokensColumn},
+			Bidi:    false,
+		},
+		"User",
+		"EmailVerificationToken",
+	)
+	graph.MustAddE(
+		"recovery_codes",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+		},
+		"User",
+		"RecoveryCode",
+	)
+	graph.MustAddE(
+		"memberships",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+		},
+		"User",
+		"Membership",
+	)
+	graph.MustAddE(
+		"users",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   workspace.UsersTable,
+			Columns: workspace.UsersPrimaryKey,
+			Bidi:    false,
+		},
+		"Workspace",
+		"User",
+	)
+	graph.MustAddE(
+		"invitations",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.InvitationsTable,
+			Columns: []string{workspace.InvitationsColumn},
+			Bidi:    false,
+		},
+		"Workspace",
+		"WorkspaceInvitation",
+	)
+	graph.MustAddE(
+		"accounts",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.AccountsTable,
+			Columns: []string{workspace.AccountsColumn},
+			Bidi:    false,
+		},
+		"Workspace",
+		"Account",
+	)
+	graph.MustAddE(
+		"transactions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.TransactionsTable,
+			Columns: []string{workspace.TransactionsColumn},
+			Bidi:    false,
+		},
+		"Workspace",
+		"Transaction",
+	)
+	graph.MustAddE(
+		"transaction_splits",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.TransactionSplitsTable,
+			Columns: []string{workspace.TransactionSplitsColumn},
+			Bidi:    false,
+		},
+		"Workspace",
+		"TransactionSplit",
+	)
+	graph.MustAddE(
+		"transfers",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.TransfersTable,
+			Columns: []string{workspace.TransfersColumn},
+			Bidi:    false,
+		},
+		"Workspace",
+		"Transfer",
+	)
+	graph.MustAddE(
+		"categories",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.CategoriesTable,
+			Columns: []string{workspace.CategoriesColumn},
+			Bidi:    false,
+		},
+		"Workspace",
+		"Category",
+	)
+	graph.MustAddE(
+		"payees",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.PayeesTable,
+			Columns: []string{workspace.PayeesColumn},
+			Bidi:    false,
+		},
+		"Workspace",
+		"Payee",
+	)
+	graph.MustAddE(
+		"recurrence_rules",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.RecurrenceRulesTable,
+			Columns: []string{workspace.RecurrenceRulesColumn},
+			Bidi:    false,
+		},
+		"Workspace",
+		"RecurrenceRule",
+	)
+	graph.MustAddE(
+		"recurrence_occurrences",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.RecurrenceOccurrencesTable,
+			Columns: []string{workspace.RecurrenceOccurrencesColumn},
+			Bidi:    false,
+		},
+		"Workspace",
+		"RecurrenceOccurrence",
+	)
+	graph.MustAddE(
+		"statement_imports",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.StatementImportsTable,
+			Columns: []string{workspace.StatementImportsColumn},
+			Bidi:    false,
+		},
+		"Workspace",
+		"StatementImport",
+	)
+	graph.MustAddE(
+		"import_profiles",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.ImportProfilesTable,
+			Columns: []string{workspace.ImportProfilesColumn},
+			Bidi:    false,
+		},
+		"Workspace",
+		"ImportProfile",
+	)
+	graph.MustAddE(
+		"import_decisions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.ImportDecisionsTable,
+			Columns: []string{workspace.ImportDecisionsColumn},
+			Bidi:    false,
+		},
+		"Workspace",
+		"ImportDecision",
+	)
+	graph.MustAddE(
+		"tags",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.TagsTable,
+			Columns: []string{workspace.TagsColumn},
+			Bidi:    false,
+		},
+		"Workspace",
+		"Tag",
+	)
+	graph.MustAddE(
+		"journal_entries",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.JournalEntriesTable,
+			Columns: []string{workspace.JournalEntriesColumn},
+			Bidi:    false,
+		},
+		"Workspace",
+		"JournalEntry",
+	)
+	graph.MustAddE(
+		"postings",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.PostingsTable,
+			Columns: []string{workspace.PostingsColumn},
+			Bidi:    false,
+		},
+		"Workspace",
+		"Posting",
+	)
+	graph.MustAddE(
+		"memberships",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   workspace.MembershipsTable,
+			Columns: []string{workspace.MembershipsColumn},
+			Bidi:    false,
+		},
+		"Workspace",
+		"Membership",
+	)
+	graph.MustAddE(
+		"workspace",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workspaceinvitation.WorkspaceTable,
+			Columns: []string{workspaceinvitation.WorkspaceColumn},
+			Bidi:    false,
+		},
+		"WorkspaceInvitation",
+		"Workspace",
+	)
+	graph.MustAddE(
+		"invited_by",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   workspaceinvitation.InvitedByTable,
+			Columns: []string{workspaceinvitation.InvitedByColumn},
+			Bidi:    false,
+		},
+		"WorkspaceInvitation",
+		"User",
+	)
+	graph.MustAddE(
+		"accepted_by",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   workspaceinvitation.AcceptedByTable,
+			Columns: []string{workspaceinvitation.AcceptedByColumn},
+			Bidi:    false,
+		},
+		"WorkspaceInvitation",
+		"User",
+	)
+	return graph
+}()
+
+// predicateAdder wraps the addPredicate method.
+// All update, update-one and query builders implement this interface.
+type predicateAdder interface {
+	addPredicate(func(s *sql.Selector))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *AccountQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the AccountQuery builder.
+func (_q *AccountQuery) Filter() *AccountFilter {
+	return &AccountFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *AccountMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the AccountMutation builder.
+func (m *AccountMutation) Filter() *AccountFilter {
+	return &AccountFilter{config: m.config, predicateAdder: m}
+}
+
+// AccountFilter provides a generic filtering capability at runtime for AccountQuery.
+type AccountFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *AccountFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *AccountFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(account.FieldID))
+}
+
+// WhereWorkspaceID applies the entql int predicate on the workspace_id field.
+func (f *AccountFilter) WhereWorkspaceID(p entql.IntP) {
+	f.Where(p.Field(account.FieldWorkspaceID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *AccountFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(account.FieldName))
+}
+
+// WhereType applies the entql string predicate on the type field.
+func (f *AccountFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(account.FieldType))
+}
+
+// WhereInstitution applies the entql string predicate on the institution field.
+func (f *AccountFilter) WhereInstitution(p entql.StringP) {
+	f.Where(p.Field(account.FieldInstitution))
+}
+
+// WhereCurrency applies the entql string predicate on the currency field.
+func (f *AccountFilter) WhereCurrency(p entql.StringP) {
+	f.Where(p.Field(account.FieldCurrency))
+}
+
+// WhereOpeningBalance applies the entql other predicate on the opening_balance field.
+func (f *AccountFilter) WhereOpeningBalance(p entql.OtherP) {
+	f.Where(p.Field(account.FieldOpeningBalance))
+}
+
+// WhereOpenedOn applies the entql time.Time predicate on the opened_on field.
+func (f *AccountFilter) WhereOpenedOn(p entql.TimeP) {
+	f.Where(p.Field(account.FieldOpenedOn))
+}
+
+// WhereClosedOn applies the entql time.Time predicate on the closed_on field.
+func (f *AccountFilter) WhereClosedOn(p entql.TimeP) {
+	f.Where(p.Field(account.FieldClosedOn))
+}
+
+// WhereArchived applies the entql bool predicate on the archived field.
+func (f *AccountFilter) WhereArchived(p entql.BoolP) {
+	f.Where(p.Field(account.FieldArchived))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *AccountFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(account.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *AccountFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(account.FieldUpdatedAt))
+}
+
+// WhereHasWorkspace applies a predicate to check if query has an edge workspace.
+func (f *AccountFilter) WhereHasWorkspace() {
+	f.Where(entql.HasEdge("workspace"))
+}
+
+// WhereHasWorkspaceWith applies a predicate to check if query has an edge workspace with a given conditions (other predicates).
+func (f *AccountFilter) WhereHasWorkspaceWith(preds ...predicate.Workspace) {
+	f.Where(entql.HasEdgeWith("workspace", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTransactions applies a predicate to check if query has an edge transactions.
+func (f *AccountFilter) WhereHasTransactions() {
+	f.Where(entql.HasEdge("transactions"))
+}
+
+// WhereHasTransactionsWith applies a predicate to check if query has an edge transactions with a given conditions (other predicates).
+func (f *AccountFilter) WhereHasTransactionsWith(preds ...predicate.Transaction) {
+	f.Where(entql.HasEdgeWith("transactions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPostings applies a predicate to check if query has an edge postings.
+func (f *AccountFilter) WhereHasPostings() {
+	f.Where(entql.HasEdge("postings"))
+}
+
+// WhereHasPostingsWith applies a predicate to check if query has an edge postings with a given conditions (other predicates).
+func (f *AccountFilter) WhereHasPostingsWith(preds ...predicate.Posting) {
+	f.Where(entql.HasEdgeWith("postings", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRecurrenceRules applies a predicate to check if query has an edge recurrence_rules.
+func (f *AccountFilter) WhereHasRecurrenceRules() {
+	f.Where(entql.HasEdge("recurrence_rules"))
+}
+
+// WhereHasRecurrenceRulesWith applies a predicate to check if query has an edge recurrence_rules with a given conditions (other predicates).
+func (f *AccountFilter) WhereHasRecurrenceRulesWith(preds ...predicate.RecurrenceRule) {
+	f.Where(entql.HasEdgeWith("recurrence_rules", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasStatementImports applies a predicate to check if query has an edge statement_imports.
+func (f *AccountFilter) WhereHasStatementImports() {
+	f.Where(entql.HasEdge("statement_imports"))
+}
+
+// WhereHasStatementImportsWith applies a predicate to check if query has an edge statement_imports with a given conditions (other predicates).
+func (f *AccountFilter) WhereHasStatementImportsWith(preds ...predicate.StatementImport) {
+	f.Where(entql.HasEdgeWith("statement_imports", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasImportProfiles applies a predicate to check if query has an edge import_profiles.
+func (f *AccountFilter) WhereHasImportProfiles() {
+	f.Where(entql.HasEdge("import_profiles"))
+}
+
+// WhereHasImportProfilesWith applies a predicate to check if query has an edge import_profiles with a given conditions (other predicates).
+func (f *AccountFilter) WhereHasImportProfilesWith(preds ...predicate.ImportProfile) {
+	f.Where(entql.HasEdgeWith("import_profiles", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasImportDecisions applies a predicate to check if query has an edge import_decisions.
+func (f *AccountFilter) WhereHasImportDecisions() {
+	f.Where(entql.HasEdge("import_decisions"))
+}
+
+// WhereHasImportDecisionsWith applies a predicate to check if query has an edge import_decisions with a given conditions (other predicates).
+func (f *AccountFilter) WhereHasImportDecisionsWith(preds ...predicate.ImportDecision) {
+	f.Where(entql.HasEdgeWith("import_decisions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *CategoryQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the CategoryQuery builder.
+func (_q *CategoryQuery) Filter() *CategoryFilter {
+	return &CategoryFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *CategoryMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the CategoryMutation builder.
+func (m *CategoryMutation) Filter() *CategoryFilter {
+	return &CategoryFilter{config: m.config, predicateAdder: m}
+}
+
+// CategoryFilter provides a generic filtering capability at runtime for CategoryQuery.
+type CategoryFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *CategoryFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *CategoryFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(category.FieldID))
+}
+
+// WhereWorkspaceID applies the entql int predicate on the workspace_id field.
+func (f *CategoryFilter) WhereWorkspaceID(p entql.IntP) {
+	f.Where(p.Field(category.FieldWorkspaceID))
+}
+
+// WhereParentID applies the entql int predicate on the parent_id field.
+func (f *CategoryFilter) WhereParentID(p entql.IntP) {
+	f.Where(p.Field(category.FieldParentID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *CategoryFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(category.FieldName))
+}
+
+// WhereKind applies the entql string predicate on the kind field.
+func (f *CategoryFilter) WhereKind(p entql.StringP) {
+	f.Where(p.Field(category.FieldKind))
+}
+
+// WhereColor applies the entql string predicate on the color field.
+func (f *CategoryFilter) WhereColor(p entql.StringP) {
+	f.Where(p.Field(category.FieldColor))
+}
+
+// WhereIcon applies the entql string predicate on the icon field.
+func (f *CategoryFilter) WhereIcon(p entql.StringP) {
+	f.Where(p.Field(category.FieldIcon))
+}
+
+// WhereArchived applies the entql bool predicate on the archived field.
+func (f *CategoryFilter) WhereArchived(p entql.BoolP) {
+	f.Where(p.Field(category.FieldArchived))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *CategoryFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(category.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *CategoryFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(category.FieldUpdatedAt))
+}
+
+// WhereHasWorkspace applies a predicate to check if query has an edge workspace.
+func (f *CategoryFilter) WhereHasWorkspace() {
+	f.Where(entql.HasEdge("workspace"))
+}
+
+// WhereHasWorkspaceWith applies a predicate to check if query has an edge workspace with a given conditions (other predicates).
+func (f *CategoryFilter) WhereHasWorkspaceWith(preds ...predicate.Workspace) {
+	f.Where(entql.HasEdgeWith("workspace", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasParent applies a predicate to check if query has an edge parent.
+func (f *CategoryFilter) WhereHasParent() {
+	f.Where(entql.HasEdge("parent"))
+}
+
+// WhereHasParentWith applies a predicate to check if query has an edge parent with a given conditions (other predicates).
+func (f *CategoryFilter) WhereHasParentWith(preds ...predicate.Category) {
+	f.Where(entql.HasEdgeWith("parent", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasChildren applies a predicate to check if query has an edge children.
+func (f *CategoryFilter) WhereHasChildren() {
+	f.Where(entql.HasEdge("children"))
+}
+
+// WhereHasChildrenWith applies a predicate to check if query has an edge children with a given conditions (other predicates).
+func (f *CategoryFilter) WhereHasChildrenWith(preds ...predicate.Category) {
+	f.Where(entql.HasEdgeWith("children", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTransactions applies a predicate to check if query has an edge transactions.
+func (f *CategoryFilter) WhereHasTransactions() {
+	f.Where(entql.HasEdge("transactions"))
+}
+
+// WhereHasTransactionsWith applies a predicate to check if query has an edge transactions with a given conditions (other predicates).
+func (f *CategoryFilter) WhereHasTransactionsWith(preds ...predicate.Transaction) {
+	f.Where(entql.HasEdgeWith("transactions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasSplits applies a predicate to check if query has an edge splits.
+func (f *CategoryFilter) WhereHasSplits() {
+	f.Where(entql.HasEdge("splits"))
+}
+
+// WhereHasSplitsWith applies a predicate to check if query has an edge splits with a given conditions (other predicates).
+func (f *CategoryFilter) WhereHasSplitsWith(preds ...predicate.TransactionSplit) {
+	f.Where(entql.HasEdgeWith("splits", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPayees applies a predicate to check if query has an edge payees.
+func (f *CategoryFilter) WhereHasPayees() {
+	f.Where(entql.HasEdge("payees"))
+}
+
+// WhereHasPayeesWith applies a predicate to check if query has an edge payees with a given conditions (other predicates).
+func (f *CategoryFilter) WhereHasPayeesWith(preds ...predicate.Payee) {
+	f.Where(entql.HasEdgeWith("payees", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRecurrenceRules applies a predicate to check if query has an edge recurrence_rules.
+func (f *CategoryFilter) WhereHasRecurrenceRules() {
+	f.Where(entql.HasEdge("recurrence_rules"))
+}
+
+// WhereHasRecurrenceRulesWith applies a predicate to check if query has an edge recurrence_rules with a given conditions (other predicates).
+func (f *CategoryFilter) WhereHasRecurrenceRulesWith(preds ...predicate.RecurrenceRule) {
+	f.Where(entql.HasEdgeWith("recurrence_rules", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *EmailVerificationTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the EmailVerificationTokenQuery builder.
+func (_q *EmailVerificationTokenQuery) Filter() *EmailVerificationTokenFilter {
+	return &EmailVerificationTokenFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *EmailVerificationTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the EmailVerificationTokenMutation builder.
+func (m *EmailVerificationTokenMutation) Filter() *EmailVerificationTokenFilter {
+	return &EmailVerificationTokenFilter{config: m.config, predicateAdder: m}
+}
+
+// EmailVerificationTokenFilter provides a generic filtering capability at runtime for EmailVerificationTokenQuery.
+type EmailVerificationTokenFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *EmailVerificationTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *EmailVerificationTokenFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(emailverificationtoken.FieldID))
+}
+
+// WhereTokenHash applies the entql string predicate on the token_hash field.
+func (f *EmailVerificationTokenFilter) WhereTokenHash(p entql.StringP) {
+	f.Where(p.Field(emailverificationtoken.FieldTokenHash))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *EmailVerificationTokenFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(emailverificationtoken.FieldUserID))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *EmailVerificationTokenFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(emailverificationtoken.FieldExpiresAt))
+}
+
+// WhereUsedAt applies the entql time.Time predicate on the used_at field.
+func (f *EmailVerificationTokenFilter) WhereUsedAt(p entql.TimeP) {
+	f.Where(p.Field(emailverificationtoken.FieldUsedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *EmailVerificationTokenFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(emailverificationtoken.FieldCreatedAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *EmailVerificationTokenFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *EmailVerificationTokenFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *ImportDecisionQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ImportDecisionQuery builder.
+func (_q *ImportDecisionQuery) Filter() *ImportDecisionFilter {
+	return &ImportDecisionFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ImportDecisionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ImportDecisionMutation builder.
+func (m *ImportDecisionMutation) Filter() *ImportDecisionFilter {
+	return &ImportDecisionFilter{config: m.config, predicateAdder: m}
+}
+
+// ImportDecisionFilter provides a generic filtering capability at runtime for ImportDecisionQuery.
+type ImportDecisionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ImportDecisionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *ImportDecisionFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(importdecision.FieldID))
+}
+
+// WhereWorkspaceID applies the entql int predicate on the workspace_id field.
+func (f *ImportDecisionFilter) WhereWorkspaceID(p entql.IntP) {
+	f.Where(p.Field(importdecision.FieldWorkspaceID))
+}
+
+// WhereAccountID applies the entql int predicate on the account_id field.
+func (f *ImportDecisionFilter) WhereAccountID(p entql.IntP) {
+	f.Where(p.Field(importdecision.FieldAccountID))
+}
+
+// WhereStatementImportID applies the entql int predicate on the statement_import_id field.
+func (f *ImportDecisionFilter) WhereStatementImportID(p entql.IntP) {
+	f.Where(p.Field(importdecision.FieldStatementImportID))
+}
+
+// WhereFingerprint applies the entql string predicate on the fingerprint field.
+func (f *ImportDecisionFilter) WhereFingerprint(p entql.StringP) {
+	f.Where(p.Field(importdecision.FieldFingerprint))
+}
+
+// WhereAction applies the entql string predicate on the action field.
+func (f *ImportDecisionFilter) WhereAction(p entql.StringP) {
+	f.Where(p.Field(importdecision.FieldAction))
+}
+
+// WhereTransactionID applies the entql int predicate on the transaction_id field.
+func (f *ImportDecisionFilter) WhereTransactionID(p entql.IntP) {
+	f.Where(p.Field(importdecision.FieldTransactionID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *ImportDecisionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(importdecision.FieldCreatedAt))
+}
+
+// WhereHasWorkspace applies a predicate to check if query has an edge workspace.
+func (f *ImportDecisionFilter) WhereHasWorkspace() {
+	f.Where(entql.HasEdge("workspace"))
+}
+
+// WhereHasWorkspaceWith applies a predicate to check if query has an edge workspace with a given conditions (other predicates).
+func (f *ImportDecisionFilter) WhereHasWorkspaceWith(preds ...predicate.Workspace) {
+	f.Where(entql.HasEdgeWith("workspace", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasAccount applies a predicate to check if query has an edge account.
+func (f *ImportDecisionFilter) WhereHasAccount() {
+	f.Where(entql.HasEdge("account"))
+}
+
+// WhereHasAccountWith applies a predicate to check if query has an edge account with a given conditions (other predicates).
+func (f *ImportDecisionFilter) WhereHasAccountWith(preds ...predicate.Account) {
+	f.Where(entql.HasEdgeWith("account", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasStatementImport applies a predicate to check if query has an edge statement_import.
+func (f *ImportDecisionFilter) WhereHasStatementImport() {
+	f.Where(entql.HasEdge("statement_import"))
+}
+
+// WhereHasStatementImportWith applies a predicate to check if query has an edge statement_import with a given conditions (other predicates).
+func (f *ImportDecisionFilter) WhereHasStatementImportWith(preds ...predicate.StatementImport) {
+	f.Where(entql.HasEdgeWith("statement_import", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTransaction applies a predicate to check if query has an edge transaction.
+func (f *ImportDecisionFilter) WhereHasTransaction() {
+	f.Where(entql.HasEdge("transaction"))
+}
+
+// WhereHasTransactionWith applies a predicate to check if query has an edge transaction with a given conditions (other predicates).
+func (f *ImportDecisionFilter) WhereHasTransactionWith(preds ...predicate.Transaction) {
+	f.Where(entql.HasEdgeWith("transaction", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *ImportProfileQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ImportProfileQuery builder.
+func (_q *ImportProfileQuery) Filter() *ImportProfileFilter {
+	return &ImportProfileFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ImportProfileMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ImportProfileMutation builder.
+func (m *ImportProfileMutation) Filter() *ImportProfileFilter {
+	return &ImportProfileFilter{config: m.config, predicateAdder: m}
+}
+
+// ImportProfileFilter provides a generic filtering capability at runtime for ImportProfileQuery.
+type ImportProfileFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ImportProfileFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *ImportProfileFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(importprofile.FieldID))
+}
+
+// WhereWorkspaceID applies the entql int predicate on the workspace_id field.
+func (f *ImportProfileFilter) WhereWorkspaceID(p entql.IntP) {
+	f.Where(p.Field(importprofile.FieldWorkspaceID))
+}
+
+// WhereAccountID applies the entql int predicate on the account_id field.
+func (f *ImportProfileFilter) WhereAccountID(p entql.IntP) {
+	f.Where(p.Field(importprofile.FieldAccountID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *ImportProfileFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(importprofile.FieldName))
+}
+
+// WhereMapping applies the entql json.RawMessage predicate on the mapping field.
+func (f *ImportProfileFilter) WhereMapping(p entql.BytesP) {
+	f.Where(p.Field(importprofile.FieldMapping))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *ImportProfileFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(importprofile.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *ImportProfileFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(importprofile.FieldUpdatedAt))
+}
+
+// WhereHasWorkspace applies a predicate to check if query has an edge workspace.
+func (f *ImportProfileFilter) WhereHasWorkspace() {
+	f.Where(entql.HasEdge("workspace"))
+}
+
+// WhereHasWorkspaceWith applies a predicate to check if query has an edge workspace with a given conditions (other predicates).
+func (f *ImportProfileFilter) WhereHasWorkspaceWith(preds ...predicate.Workspace) {
+	f.Where(entql.HasEdgeWith("workspace", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasAccount applies a predicate to check if query has an edge account.
+func (f *ImportProfileFilter) WhereHasAccount() {
+	f.Where(entql.HasEdge("account"))
+}
+
+// WhereHasAccountWith applies a predicate to check if query has an edge account with a given conditions (other predicates).
+func (f *ImportProfileFilter) WhereHasAccountWith(preds ...predicate.Account) {
+	f.Where(entql.HasEdgeWith("account", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *JournalEntryQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the JournalEntryQuery builder.
+func (_q *JournalEntryQuery) Filter() *JournalEntryFilter {
+	return &JournalEntryFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *JournalEntryMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the JournalEntryMutation builder.
+func (m *JournalEntryMutation) Filter() *JournalEntryFilter {
+	return &JournalEntryFilter{config: m.config, predicateAdder: m}
+}
+
+// JournalEntryFilter provides a generic filtering capability at runtime for JournalEntryQuery.
+type JournalEntryFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *JournalEntryFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *JournalEntryFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(journalentry.FieldID))
+}
+
+// WhereWorkspaceID applies the entql int predicate on the workspace_id field.
+func (f *JournalEntryFilter) WhereWorkspaceID(p entql.IntP) {
+	f.Where(p.Field(journalentry.FieldWorkspaceID))
+}
+
+// WhereTransactionID applies the entql int predicate on the transaction_id field.
+func (f *JournalEntryFilter) WhereTransactionID(p entql.IntP) {
+	f.Where(p.Field(journalentry.FieldTransactionID))
+}
+
+// WherePostedOn applies the entql time.Time predicate on the posted_on field.
+func (f *JournalEntryFilter) WherePostedOn(p entql.TimeP) {
+	f.Where(p.Field(journalentry.FieldPostedOn))
+}
+
+// WhereMemo applies the entql string predicate on the memo field.
+func (f *JournalEntryFilter) WhereMemo(p entql.StringP) {
+	f.Where(p.Field(journalentry.FieldMemo))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *JournalEntryFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(journalentry.FieldCreatedAt))
+}
+
+// WhereHasWorkspace applies a predicate to check if query has an edge workspace.
+func (f *JournalEntryFilter) WhereHasWorkspace() {
+	f.Where(entql.HasEdge("workspace"))
+}
+
+// WhereHasWorkspaceWith applies a predicate to check if query has an edge workspace with a given conditions (other predicates).
+func (f *JournalEntryFilter) WhereHasWorkspaceWith(preds ...predicate.Workspace) {
+	f.Where(entql.HasEdgeWith("workspace", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTransaction applies a predicate to check if query has an edge transaction.
+func (f *JournalEntryFilter) WhereHasTransaction() {
+	f.Where(entql.HasEdge("transaction"))
+}
+
+// WhereHasTransactionWith applies a predicate to check if query has an edge transaction with a given conditions (other predicates).
+func (f *JournalEntryFilter) WhereHasTransactionWith(preds ...predicate.Transaction) {
+	f.Where(entql.HasEdgeWith("transaction", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPostings applies a predicate to check if query has an edge postings.
+func (f *JournalEntryFilter) WhereHasPostings() {
+	f.Where(entql.HasEdge("postings"))
+}
+
+// WhereHasPostingsWith applies a predicate to check if query has an edge postings with a given conditions (other predicates).
+func (f *JournalEntryFilter) WhereHasPostingsWith(preds ...predicate.Posting) {
+	f.Where(entql.HasEdgeWith("postings", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *MembershipQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the MembershipQuery builder.
+func (_q *MembershipQuery) Filter() *MembershipFilter {
+	return &MembershipFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *MembershipMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the MembershipMutation builder.
+func (m *MembershipMutation) Filter() *MembershipFilter {
+	return &MembershipFilter{config: m.config, predicateAdder: m}
+}
+
+// MembershipFilter provides a generic filtering capability at runtime for MembershipQuery.
+type MembershipFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *MembershipFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *MembershipFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(membership.FieldID))
+}
+
+// WhereWorkspaceID applies the entql int predicate on the workspace_id field.
+func (f *MembershipFilter) WhereWorkspaceID(p entql.IntP) {
+	f.Where(p.Field(membership.FieldWorkspaceID))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *MembershipFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(membership.FieldUserID))
+}
+
+// WhereRole applies the entql string predicate on the role field.
+func (f *MembershipFilter) WhereRole(p entql.StringP) {
+	f.Where(p.Field(membership.FieldRole))
+}
+
+// WhereJoinedAt applies the entql time.Time predicate on the joined_at field.
+func (f *MembershipFilter) WhereJoinedAt(p entql.TimeP) {
+	f.Where(p.Field(membership.FieldJoinedAt))
+}
+
+// WhereInvitedByID applies the entql int predicate on the invited_by_id field.
+func (f *MembershipFilter) WhereInvitedByID(p entql.IntP) {
+	f.Where(p.Field(membership.FieldInvitedByID))
+}
+
+// WhereHasWorkspace applies a predicate to check if query has an edge workspace.
+func (f *MembershipFilter) WhereHasWorkspace() {
+	f.Where(entql.HasEdge("workspace"))
+}
+
+// WhereHasWorkspaceWith applies a predicate to check if query has an edge workspace with a given conditions (other predicates).
+func (f *MembershipFilter) WhereHasWorkspaceWith(preds ...predicate.Workspace) {
+	f.Where(entql.HasEdgeWith("workspace", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *MembershipFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *MembershipFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasInvitedBy applies a predicate to check if query has an edge invited_by.
+func (f *MembershipFilter) WhereHasInvitedBy() {
+	f.Where(entql.HasEdge("invited_by"))
+}
+
+// WhereHasInvitedByWith applies a predicate to check if query has an edge invited_by with a given conditions (other predicates).
+func (f *MembershipFilter) WhereHasInvitedByWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("invited_by", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *PasswordResetTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PasswordResetTokenQuery builder.
+func (_q *PasswordResetTokenQuery) Filter() *PasswordResetTokenFilter {
+	return &PasswordResetTokenFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PasswordResetTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PasswordResetTokenMutation builder.
+func (m *PasswordResetTokenMutation) Filter() *PasswordResetTokenFilter {
+	return &PasswordResetTokenFilter{config: m.config, predicateAdder: m}
+}
+
+// PasswordResetTokenFilter provides a generic filtering capability at runtime for PasswordResetTokenQuery.
+type PasswordResetTokenFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PasswordResetTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *PasswordResetTokenFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(passwordresettoken.FieldID))
+}
+
+// WhereTokenHash applies the entql string predicate on the token_hash field.
+func (f *PasswordResetTokenFilter) WhereTokenHash(p entql.StringP) {
+	f.Where(p.Field(passwordresettoken.FieldTokenHash))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *PasswordResetTokenFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(passwordresettoken.FieldUserID))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *PasswordResetTokenFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(passwordresettoken.FieldExpiresAt))
+}
+
+// WhereUsedAt applies the entql time.Time predicate on the used_at field.
+func (f *PasswordResetTokenFilter) WhereUsedAt(p entql.TimeP) {
+	f.Where(p.Field(passwordresettoken.FieldUsedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *PasswordResetTokenFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(passwordresettoken.FieldCreatedAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *PasswordResetTokenFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *PasswordResetTokenFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *PayeeQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PayeeQuery builder.
+func (_q *PayeeQuery) Filter() *PayeeFilter {
+	return &PayeeFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PayeeMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PayeeMutation builder.
+func (m *PayeeMutation) Filter() *PayeeFilter {
+	return &PayeeFilter{config: m.config, predicateAdder: m}
+}
+
+// PayeeFilter provides a generic filtering capability at runtime for PayeeQuery.
+type PayeeFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PayeeFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *PayeeFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(payee.FieldID))
+}
+
+// WhereWorkspaceID applies the entql int predicate on the workspace_id field.
+func (f *PayeeFilter) WhereWorkspaceID(p entql.IntP) {
+	f.Where(p.Field(payee.FieldWorkspaceID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *PayeeFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(payee.FieldName))
+}
+
+// WhereAliases applies the entql json.RawMessage predicate on the aliases field.
+func (f *PayeeFilter) WhereAliases(p entql.BytesP) {
+	f.Where(p.Field(payee.FieldAliases))
+}
+
+// WhereDefaultCategoryID applies the entql int predicate on the default_category_id field.
+func (f *PayeeFilter) WhereDefaultCategoryID(p entql.IntP) {
+	f.Where(p.Field(payee.FieldDefaultCategoryID))
+}
+
+// WhereLogoURL applies the entql string predicate on the logo_url field.
+func (f *PayeeFilter) WhereLogoURL(p entql.StringP) {
+	f.Where(p.Field(payee.FieldLogoURL))
+}
+
+// WhereWebsite applies the entql string predicate on the website field.
+func (f *PayeeFilter) WhereWebsite(p entql.StringP) {
+	f.Where(p.Field(payee.FieldWebsite))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *PayeeFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(payee.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *PayeeFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(payee.FieldUpdatedAt))
+}
+
+// WhereHasWorkspace applies a predicate to check if query has an edge workspace.
+func (f *PayeeFilter) WhereHasWorkspace() {
+	f.Where(entql.HasEdge("workspace"))
+}
+
+// WhereHasWorkspaceWith applies a predicate to check if query has an edge workspace with a given conditions (other predicates).
+func (f *PayeeFilter) WhereHasWorkspaceWith(preds ...predicate.Workspace) {
+	f.Where(entql.HasEdgeWith("workspace", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasDefaultCategory applies a predicate to check if query has an edge default_category.
+func (f *PayeeFilter) WhereHasDefaultCategory() {
+	f.Where(entql.HasEdge("default_category"))
+}
+
+// WhereHasDefaultCategoryWith applies a predicate to check if query has an edge default_category with a given conditions (other predicates).
+func (f *PayeeFilter) WhereHasDefaultCategoryWith(preds ...predicate.Category) {
+	f.Where(entql.HasEdgeWith("default_category", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTransactions applies a predicate to check if query has an edge transactions.
+func (f *PayeeFilter) WhereHasTransactions() {
+	f.Where(entql.HasEdge("transactions"))
+}
+
+// WhereHasTransactionsWith applies a predicate to check if query has an edge transactions with a given conditions (other predicates).
+func (f *PayeeFilter) WhereHasTransactionsWith(preds ...predicate.Transaction) {
+	f.Where(entql.HasEdgeWith("transactions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRecurrenceRules applies a predicate to check if query has an edge recurrence_rules.
+func (f *PayeeFilter) WhereHasRecurrenceRules() {
+	f.Where(entql.HasEdge("recurrence_rules"))
+}
+
+// WhereHasRecurrenceRulesWith applies a predicate to check if query has an edge recurrence_rules with a given conditions (other predicates).
+func (f *PayeeFilter) WhereHasRecurrenceRulesWith(preds ...predicate.RecurrenceRule) {
+	f.Where(entql.HasEdgeWith("recurrence_rules", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *PostingQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PostingQuery builder.
+func (_q *PostingQuery) Filter() *PostingFilter {
+	return &PostingFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PostingMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PostingMutation builder.
+func (m *PostingMutation) Filter() *PostingFilter {
+	return &PostingFilter{config: m.config, predicateAdder: m}
+}
+
+// PostingFilter provides a generic filtering capability at runtime for PostingQuery.
+type PostingFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PostingFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *PostingFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(posting.FieldID))
+}
+
+// WhereWorkspaceID applies the entql int predicate on the workspace_id field.
+func (f *PostingFilter) WhereWorkspaceID(p entql.IntP) {
+	f.Where(p.Field(posting.FieldWorkspaceID))
+}
+
+// WhereJournalEntryID applies the entql int predicate on the journal_entry_id field.
+func (f *PostingFilter) WhereJournalEntryID(p entql.IntP) {
+	f.Where(p.Field(posting.FieldJournalEntryID))
+}
+
+// WhereAccountID applies the entql int predicate on the account_id field.
+func (f *PostingFilter) WhereAccountID(p entql.IntP) {
+	f.Where(p.Field(posting.FieldAccountID))
+}
+
+// WhereAmount applies the entql other predicate on the amount field.
+func (f *PostingFilter) WhereAmount(p entql.OtherP) {
+	f.Where(p.Field(posting.FieldAmount))
+}
+
+// WhereCurrency applies the entql string predicate on the currency field.
+func (f *PostingFilter) WhereCurrency(p entql.StringP) {
+	f.Where(p.Field(posting.FieldCurrency))
+}
+
+// WhereHasWorkspace applies a predicate to check if query has an edge workspace.
+func (f *PostingFilter) WhereHasWorkspace() {
+	f.Where(entql.HasEdge("workspace"))
+}
+
+// WhereHasWorkspaceWith applies a predicate to check if query has an edge workspace with a given conditions (other predicates).
+func (f *PostingFilter) WhereHasWorkspaceWith(preds ...predicate.Workspace) {
+	f.Where(entql.HasEdgeWith("workspace", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasJournalEntry applies a predicate to check if query has an edge journal_entry.
+func (f *PostingFilter) WhereHasJournalEntry() {
+	f.Where(entql.HasEdge("journal_entry"))
+}
+
+// WhereHasJournalEntryWith applies a predicate to check if query has an edge journal_entry with a given conditions (other predicates).
+func (f *PostingFilter) WhereHasJournalEntryWith(preds ...predicate.JournalEntry) {
+	f.Where(entql.HasEdgeWith("journal_entry", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasAccount applies a predicate to check if query has an edge account.
+func (f *PostingFilter) WhereHasAccount() {
+	f.Where(entql.HasEdge("account"))
+}
+
+// WhereHasAccountWith applies a predicate to check if query has an edge account with a given conditions (other predicates).
+func (f *PostingFilter) WhereHasAccountWith(preds ...predicate.Account) {
+	f.Where(entql.HasEdgeWith("account", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *RecoveryCodeQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RecoveryCodeQuery builder.
+func (_q *RecoveryCodeQuery) Filter() *RecoveryCodeFilter {
+	return &RecoveryCodeFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RecoveryCodeMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RecoveryCodeMutation builder.
+func (m *RecoveryCodeMutation) Filter() *RecoveryCodeFilter {
+	return &RecoveryCodeFilter{config: m.config, predicateAdder: m}
+}
+
+// RecoveryCodeFilter provides a generic filtering capability at runtime for RecoveryCodeQuery.
+type RecoveryCodeFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RecoveryCodeFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *RecoveryCodeFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(recoverycode.FieldID))
+}
+
+// WhereCodeHash applies the entql string predicate on the code_hash field.
+func (f *RecoveryCodeFilter) WhereCodeHash(p entql.StringP) {
+	f.Where(p.Field(recoverycode.FieldCodeHash))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *RecoveryCodeFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(recoverycode.FieldUserID))
+}
+
+// WhereUsedAt applies the entql time.Time predicate on the used_at field.
+func (f *RecoveryCodeFilter) WhereUsedAt(p entql.TimeP) {
+	f.Where(p.Field(recoverycode.FieldUsedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *RecoveryCodeFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(recoverycode.FieldCreatedAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *RecoveryCodeFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *RecoveryCodeFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *RecurrenceOccurrenceQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RecurrenceOccurrenceQuery builder.
+func (_q *RecurrenceOccurrenceQuery) Filter() *RecurrenceOccurrenceFilter {
+	return &RecurrenceOccurrenceFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RecurrenceOccurrenceMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RecurrenceOccurrenceMutation builder.
+func (m *RecurrenceOccurrenceMutation) Filter() *RecurrenceOccurrenceFilter {
+	return &RecurrenceOccurrenceFilter{config: m.config, predicateAdder: m}
+}
+
+// RecurrenceOccurrenceFilter provides a generic filtering capability at runtime for RecurrenceOccurrenceQuery.
+type RecurrenceOccurrenceFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RecurrenceOccurrenceFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *RecurrenceOccurrenceFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(recurrenceoccurrence.FieldID))
+}
+
+// WhereWorkspaceID applies the entql int predicate on the workspace_id field.
+func (f *RecurrenceOccurrenceFilter) WhereWorkspaceID(p entql.IntP) {
+	f.Where(p.Field(recurrenceoccurrence.FieldWorkspaceID))
+}
+
+// WhereRuleID applies the entql int predicate on the rule_id field.
+func (f *RecurrenceOccurrenceFilter) WhereRuleID(p entql.IntP) {
+	f.Where(p.Field(recurrenceoccurrence.FieldRuleID))
+}
+
+// WhereDueOn applies the entql time.Time predicate on the due_on field.
+func (f *RecurrenceOccurrenceFilter) WhereDueOn(p entql.TimeP) {
+	f.Where(p.Field(recurrenceoccurrence.FieldDueOn))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *RecurrenceOccurrenceFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(recurrenceoccurrence.FieldStatus))
+}
+
+// WhereTransactionID applies the entql int predicate on the transaction_id field.
+func (f *RecurrenceOccurrenceFilter) WhereTransactionID(p entql.IntP) {
+	f.Where(p.Field(recurrenceoccurrence.FieldTransactionID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *RecurrenceOccurrenceFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(recurrenceoccurrence.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *RecurrenceOccurrenceFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(recurrenceoccurrence.FieldUpdatedAt))
+}
+
+// WhereHasWorkspace applies a predicate to check if query has an edge workspace.
+func (f *RecurrenceOccurrenceFilter) WhereHasWorkspace() {
+	f.Where(entql.HasEdge("workspace"))
+}
+
+// WhereHasWorkspaceWith applies a predicate to check if query has an edge workspace with a given conditions (other predicates).
+func (f *RecurrenceOccurrenceFilter) WhereHasWorkspaceWith(preds ...predicate.Workspace) {
+	f.Where(entql.HasEdgeWith("workspace", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRule applies a predicate to check if query has an edge rule.
+func (f *RecurrenceOccurrenceFilter) WhereHasRule() {
+	f.Where(entql.HasEdge("rule"))
+}
+
+// WhereHasRuleWith applies a predicate to check if query has an edge rule with a given conditions (other predicates).
+func (f *RecurrenceOccurrenceFilter) WhereHasRuleWith(preds ...predicate.RecurrenceRule) {
+	f.Where(entql.HasEdgeWith("rule", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTransaction applies a predicate to check if query has an edge transaction.
+func (f *RecurrenceOccurrenceFilter) WhereHasTransaction() {
+	f.Where(entql.HasEdge("transaction"))
+}
+
+// WhereHasTransactionWith applies a predicate to check if query has an edge transaction with a given conditions (other predicates).
+func (f *RecurrenceOccurrenceFilter) WhereHasTransactionWith(preds ...predicate.Transaction) {
+	f.Where(entql.HasEdgeWith("transaction", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *RecurrenceRuleQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RecurrenceRuleQuery builder.
+func (_q *RecurrenceRuleQuery) Filter() *RecurrenceRuleFilter {
+	return &RecurrenceRuleFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RecurrenceRuleMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RecurrenceRuleMutation builder.
+func (m *RecurrenceRuleMutation) Filter() *RecurrenceRuleFilter {
+	return &RecurrenceRuleFilter{config: m.config, predicateAdder: m}
+}
+
+// RecurrenceRuleFilter provides a generic filtering capability at runtime for RecurrenceRuleQuery.
+type RecurrenceRuleFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RecurrenceRuleFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *RecurrenceRuleFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(recurrencerule.FieldID))
+}
+
+// WhereWorkspaceID applies the entql int predicate on the workspace_id field.
+func (f *RecurrenceRuleFilter) WhereWorkspaceID(p entql.IntP) {
+	f.Where(p.Field(recurrencerule.FieldWorkspaceID))
+}
+
+// WhereAccountID applies the entql int predicate on the account_id field.
+func (f *RecurrenceRuleFilter) WhereAccountID(p entql.IntP) {
+	f.Where(p.Field(recurrencerule.FieldAccountID))
+}
+
+// WhereCategoryID applies the entql int predicate on the category_id field.
+func (f *RecurrenceRuleFilter) WhereCategoryID(p entql.IntP) {
+	f.Where(p.Field(recurrencerule.FieldCategoryID))
+}
+
+// WherePayeeID applies the entql int predicate on the payee_id field.
+func (f *RecurrenceRuleFilter) WherePayeeID(p entql.IntP) {
+	f.Where(p.Field(recurrencerule.FieldPayeeID))
+}
+
+// WhereAmount applies the entql other predicate on the amount field.
+func (f *RecurrenceRuleFilter) WhereAmount(p entql.OtherP) {
+	f.Where(p.Field(recurrencerule.FieldAmount))
+}
+
+// WhereCurrency applies the entql string predicate on the currency field.
+func (f *RecurrenceRuleFilter) WhereCurrency(p entql.StringP) {
+	f.Where(p.Field(recurrencerule.FieldCurrency))
+}
+
+// WherePayee applies the entql string predicate on the payee field.
+func (f *RecurrenceRuleFilter) WherePayee(p entql.StringP) {
+	f.Where(p.Field(recurrencerule.FieldPayee))
+}
+
+// WhereMemo applies the entql string predicate on the memo field.
+func (f *RecurrenceRuleFilter) WhereMemo(p entql.StringP) {
+	f.Where(p.Field(recurrencerule.FieldMemo))
+}
+
+// WhereFrequency applies the entql string predicate on the frequency field.
+func (f *RecurrenceRuleFilter) WhereFrequency(p entql.StringP) {
+	f.Where(p.Field(recurrencerule.FieldFrequency))
+}
+
+// WhereInterval applies the entql int predicate on the interval field.
+func (f *RecurrenceRuleFilter) WhereInterval(p entql.IntP) {
+	f.Where(p.Field(recurrencerule.FieldInterval))
+}
+
+// WhereByDay applies the entql json.RawMessage predicate on the by_day field.
+func (f *RecurrenceRuleFilter) WhereByDay(p entql.BytesP) {
+	f.Where(p.Field(recurrencerule.FieldByDay))
+}
+
+// WhereStartsOn applies the entql time.Time predicate on the starts_on field.
+func (f *RecurrenceRuleFilter) WhereStartsOn(p entql.TimeP) {
+	f.Where(p.Field(recurrencerule.FieldStartsOn))
+}
+
+// WhereEndsOn applies the entql time.Time predicate on the ends_on field.
+func (f *RecurrenceRuleFilter) WhereEndsOn(p entql.TimeP) {
+	f.Where(p.Field(recurrencerule.FieldEndsOn))
+}
+
+// WhereScheduledFrom applies the entql time.Time predicate on the scheduled_from field.
+func (f *RecurrenceRuleFilter) WhereScheduledFrom(p entql.TimeP) {
+	f.Where(p.Field(recurrencerule.FieldScheduledFrom))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *RecurrenceRuleFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(recurrencerule.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *RecurrenceRuleFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(recurrencerule.FieldUpdatedAt))
+}
+
+// WhereHasWorkspace applies a predicate to check if query has an edge workspace.
+func (f *RecurrenceRuleFilter) WhereHasWorkspace() {
+	f.Where(entql.HasEdge("workspace"))
+}
+
+// WhereHasWorkspaceWith applies a predicate to check if query has an edge workspace with a given conditions (other predicates).
+func (f *RecurrenceRuleFilter) WhereHasWorkspaceWith(preds ...predicate.Workspace) {
+	f.Where(entql.HasEdgeWith("workspace", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasAccount applies a predicate to check if query has an edge account.
+func (f *RecurrenceRuleFilter) WhereHasAccount() {
+	f.Where(entql.HasEdge("account"))
+}
+
+// WhereHasAccountWith applies a predicate to check if query has an edge account with a given conditions (other predicates).
+func (f *RecurrenceRuleFilter) WhereHasAccountWith(preds ...predicate.Account) {
+	f.Where(entql.HasEdgeWith("account", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasCategory applies a predicate to check if query has an edge category.
+func (f *RecurrenceRuleFilter) WhereHasCategory() {
+	f.Where(entql.HasEdge("category"))
+}
+
+// WhereHasCategoryWith applies a predicate to check if query has an edge category with a given conditions (other predicates).
+func (f *RecurrenceRuleFilter) WhereHasCategoryWith(preds ...predicate.Category) {
+	f.Where(entql.HasEdgeWith("category", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasLinkedPayee applies a predicate to check if query has an edge linked_payee.
+func (f *RecurrenceRuleFilter) WhereHasLinkedPayee() {
+	f.Where(entql.HasEdge("linked_payee"))
+}
+
+// WhereHasLinkedPayeeWith applies a predicate to check if query has an edge linked_payee with a given conditions (other predicates).
+func (f *RecurrenceRuleFilter) WhereHasLinkedPayeeWith(preds ...predicate.Payee) {
+	f.Where(entql.HasEdgeWith("linked_payee", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasOccurrences applies a predicate to check if query has an edge occurrences.
+func (f *RecurrenceRuleFilter) WhereHasOccurrences() {
+	f.Where(entql.HasEdge("occurrences"))
+}
+
+// WhereHasOccurrencesWith applies a predicate to check if query has an edge occurrences with a given conditions (other predicates).
+func (f *RecurrenceRuleFilter) WhereHasOccurrencesWith(preds ...predicate.RecurrenceOccurrence) {
+	f.Where(entql.HasEdgeWith("occurrences", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *SessionQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SessionQuery builder.
+func (_q *SessionQuery) Filter() *SessionFilter {
+	return &SessionFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SessionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SessionMutation builder.
+func (m *SessionMutation) Filter() *SessionFilter {
+	return &SessionFilter{config: m.config, predicateAdder: m}
+}
+
+// SessionFilter provides a generic filtering capability at runtime for SessionQuery.
+type SessionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SessionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *SessionFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(session.FieldID))
+}
+
+// WhereTokenHash applies the entql string predicate on the token_hash field.
+func (f *SessionFilter) WhereTokenHash(p entql.StringP) {
+	f.Where(p.Field(session.FieldTokenHash))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *SessionFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(session.FieldUserID))
+}
+
+// WhereData applies the entql []byte predicate on the data field.
+func (f *SessionFilter) WhereData(p entql.BytesP) {
+	f.Where(p.Field(session.FieldData))
+}
+
+// WhereUserAgent applies the entql string predicate on the user_agent field.
+func (f *SessionFilter) WhereUserAgent(p entql.StringP) {
+	f.Where(p.Field(session.FieldUserAgent))
+}
+
+// WhereIPAddress applies the entql string predicate on the ip_address field.
+func (f *SessionFilter) WhereIPAddress(p entql.StringP) {
+	f.Where(p.Field(session.FieldIPAddress))
+}
+
+// WhereLastSeenAt applies the entql time.Time predicate on the last_seen_at field.
+func (f *SessionFilter) WhereLastSeenAt(p entql.TimeP) {
+	f.Where(p.Field(session.FieldLastSeenAt))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *SessionFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(session.FieldExpiresAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *SessionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(session.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *SessionFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(session.FieldUpdatedAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *SessionFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *SessionFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *StatementImportQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the StatementImportQuery builder.
+func (_q *StatementImportQuery) Filter() *StatementImportFilter {
+	return &StatementImportFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *StatementImportMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the StatementImportMutation builder.
+func (m *StatementImportMutation) Filter() *StatementImportFilter {
+	return &StatementImportFilter{config: m.config, predicateAdder: m}
+}
+
+// StatementImportFilter provides a generic filtering capability at runtime for StatementImportQuery.
+type StatementImportFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *StatementImportFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *StatementImportFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(statementimport.FieldID))
+}
+
+// WhereWorkspaceID applies the entql int predicate on the workspace_id field.
+func (f *StatementImportFilter) WhereWorkspaceID(p entql.IntP) {
+	f.Where(p.Field(statementimport.FieldWorkspaceID))
+}
+
+// WhereAccountID applies the entql int predicate on the account_id field.
+func (f *StatementImportFilter) WhereAccountID(p entql.IntP) {
+	f.Where(p.Field(statementimport.FieldAccountID))
+}
+
+// WhereFormat applies the entql string predicate on the format field.
+func (f *StatementImportFilter) WhereFormat(p entql.StringP) {
+	f.Where(p.Field(statementimport.FieldFormat))
+}
+
+// WhereFilename applies the entql string predicate on the filename field.
+func (f *StatementImportFilter) WhereFilename(p entql.StringP) {
+	f.Where(p.Field(statementimport.FieldFilename))
+}
+
+// WhereContent applies the entql []byte predicate on the content field.
+func (f *StatementImportFilter) WhereContent(p entql.BytesP) {
+	f.Where(p.Field(statementimport.FieldContent))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *StatementImportFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(statementimport.FieldStatus))
+}
+
+// WhereCreatedCount applies the entql int predicate on the created_count field.
+func (f *StatementImportFilter) WhereCreatedCount(p entql.IntP) {
+	f.Where(p.Field(statementimport.FieldCreatedCount))
+}
+
+// WhereSkippedCount applies the entql int predicate on the skipped_count field.
+func (f *StatementImportFilter) WhereSkippedCount(p entql.IntP) {
+	f.Where(p.Field(statementimport.FieldSkippedCount))
+}
+
+// WhereMergedCount applies the entql int predicate on the merged_count field.
+func (f *StatementImportFilter) WhereMergedCount(p entql.IntP) {
+	f.Where(p.Field(statementimport.FieldMergedCount))
+}
+
+// WhereFailedCount applies the entql int predicate on the failed_count field.
+func (f *StatementImportFilter) WhereFailedCount(p entql.IntP) {
+	f.Where(p.Field(statementimport.FieldFailedCount))
+}
+
+// WhereCommittedAt applies the entql time.Time predicate on the committed_at field.
+func (f *StatementImportFilter) WhereCommittedAt(p entql.TimeP) {
+	f.Where(p.Field(statementimport.FieldCommittedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *StatementImportFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(statementimport.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *StatementImportFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(statementimport.FieldUpdatedAt))
+}
+
+// WhereHasWorkspace applies a predicate to check if query has an edge workspace.
+func (f *StatementImportFilter) WhereHasWorkspace() {
+	f.Where(entql.HasEdge("workspace"))
+}
+
+// WhereHasWorkspaceWith applies a predicate to check if query has an edge workspace with a given conditions (other predicates).
+func (f *StatementImportFilter) WhereHasWorkspaceWith(preds ...predicate.Workspace) {
+	f.Where(entql.HasEdgeWith("workspace", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasAccount applies a predicate to check if query has an edge account.
+func (f *StatementImportFilter) WhereHasAccount() {
+	f.Where(entql.HasEdge("account"))
+}
+
+// WhereHasAccountWith applies a predicate to check if query has an edge account with a given conditions (other predicates).
+func (f *StatementImportFilter) WhereHasAccountWith(preds ...predicate.Account) {
+	f.Where(entql.HasEdgeWith("account", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasDecisions applies a predicate to check if query has an edge decisions.
+func (f *StatementImportFilter) WhereHasDecisions() {
+	f.Where(entql.HasEdge("decisions"))
+}
+
+// WhereHasDecisionsWith applies a predicate to check if query has an edge decisions with a given conditions (other predicates).
+func (f *StatementImportFilter) WhereHasDecisionsWith(preds ...predicate.ImportDecision) {
+	f.Where(entql.HasEdgeWith("decisions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *TagQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TagQuery builder.
+func (_q *TagQuery) Filter() *TagFilter {
+	return &TagFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TagMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TagMutation builder.
+func (m *TagMutation) Filter() *TagFilter {
+	return &TagFilter{config: m.config, predicateAdder: m}
+}
+
+// TagFilter provides a generic filtering capability at runtime for TagQuery.
+type TagFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TagFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *TagFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(tag.FieldID))
+}
+
+// WhereWorkspaceID applies the entql int predicate on the workspace_id field.
+func (f *TagFilter) WhereWorkspaceID(p entql.IntP) {
+	f.Where(p.Field(tag.FieldWorkspaceID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *TagFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(tag.FieldName))
+}
+
+// WhereColor applies the entql string predicate on the color field.
+func (f *TagFilter) WhereColor(p entql.StringP) {
+	f.Where(p.Field(tag.FieldColor))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TagFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(tag.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *TagFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(tag.FieldUpdatedAt))
+}
+
+// WhereHasWorkspace applies a predicate to check if query has an edge workspace.
+func (f *TagFilter) WhereHasWorkspace() {
+	f.Where(entql.HasEdge("workspace"))
+}
+
+// WhereHasWorkspaceWith applies a predicate to check if query has an edge workspace with a given conditions (other predicates).
+func (f *TagFilter) WhereHasWorkspaceWith(preds ...predicate.Workspace) {
+	f.Where(entql.HasEdgeWith("workspace", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTransactions applies a predicate to check if query has an edge transactions.
+func (f *TagFilter) WhereHasTransactions() {
+	f.Where(entql.HasEdge("transactions"))
+}
+
+// WhereHasTransactionsWith applies a predicate to check if query has an edge transactions with a given conditions (other predicates).
+func (f *TagFilter) WhereHasTransactionsWith(preds ...predicate.Transaction) {
+	f.Where(entql.HasEdgeWith("transactions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasSplits applies a predicate to check if query has an edge splits.
+func (f *TagFilter) WhereHasSplits() {
+	f.Where(entql.HasEdge("splits"))
+}
+
+// WhereHasSplitsWith applies a predicate to check if query has an edge splits with a given conditions (other predicates).
+func (f *TagFilter) WhereHasSplitsWith(preds ...predicate.TransactionSplit) {
+	f.Where(entql.HasEdgeWith("splits", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *TransactionQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TransactionQuery builder.
+func (_q *TransactionQuery) Filter() *TransactionFilter {
+	return &TransactionFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TransactionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TransactionMutation builder.
+func (m *TransactionMutation) Filter() *TransactionFilter {
+	return &TransactionFilter{config: m.config, predicateAdder: m}
+}
+
+// TransactionFilter provides a generic filtering capability at runtime for TransactionQuery.
+type TransactionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TransactionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *TransactionFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(transaction.FieldID))
+}
+
+// WhereWorkspaceID applies the entql int predicate on the workspace_id field.
+func (f *TransactionFilter) WhereWorkspaceID(p entql.IntP) {
+	f.Where(p.Field(transaction.FieldWorkspaceID))
+}
+
+// WhereAccountID applies the entql int predicate on the account_id field.
+func (f *TransactionFilter) WhereAccountID(p entql.IntP) {
+	f.Where(p.Field(transaction.FieldAccountID))
+}
+
+// WhereCategoryID applies the entql int predicate on the category_id field.
+func (f *TransactionFilter) WhereCategoryID(p entql.IntP) {
+	f.Where(p.Field(transaction.FieldCategoryID))
+}
+
+// WherePayeeID applies the entql int predicate on the payee_id field.
+func (f *TransactionFilter) WherePayeeID(p entql.IntP) {
+	f.Where(p.Field(transaction.FieldPayeeID))
+}
+
+// WherePostedOn applies the entql time.Time predicate on the posted_on field.
+func (f *TransactionFilter) WherePostedOn(p entql.TimeP) {
+	f.Where(p.Field(transaction.FieldPostedOn))
+}
+
+// WhereAmount applies the entql other predicate on the amount field.
+func (f *TransactionFilter) WhereAmount(p entql.OtherP) {
+	f.Where(p.Field(transaction.FieldAmount))
+}
+
+// WhereCurrency applies the entql string predicate on the currency field.
+func (f *TransactionFilter) WhereCurrency(p entql.StringP) {
+	f.Where(p.Field(transaction.FieldCurrency))
+}
+
+// WherePayee applies the entql string predicate on the payee field.
+func (f *TransactionFilter) WherePayee(p entql.StringP) {
+	f.Where(p.Field(transaction.FieldPayee))
+}
+
+// WhereMemo applies the entql string predicate on the memo field.
+func (f *TransactionFilter) WhereMemo(p entql.StringP) {
+	f.Where(p.Field(transaction.FieldMemo))
+}
+
+// WhereExternalID applies the entql string predicate on the external_id field.
+func (f *TransactionFilter) WhereExternalID(p entql.StringP) {
+	f.Where(p.Field(transaction.FieldExternalID))
+}
+
+// WhereValueDate applies the entql time.Time predicate on the value_date field.
+func (f *TransactionFilter) WhereValueDate(p entql.TimeP) {
+	f.Where(p.Field(transaction.FieldValueDate))
+}
+
+// WhereCounterpartyIban applies the entql string predicate on the counterparty_iban field.
+func (f *TransactionFilter) WhereCounterpartyIban(p entql.StringP) {
+	f.Where(p.Field(transaction.FieldCounterpartyIban))
+}
+
+// WhereEndToEndID applies the entql string predicate on the end_to_end_id field.
+func (f *TransactionFilter) WhereEndToEndID(p entql.StringP) {
+	f.Where(p.Field(transaction.FieldEndToEndID))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *TransactionFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(transaction.FieldStatus))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TransactionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(transaction.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *TransactionFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(transaction.FieldUpdatedAt))
+}
+
+// WhereHasWorkspace applies a predicate to check if query has an edge workspace.
+func (f *TransactionFilter) WhereHasWorkspace() {
+	f.Where(entql.HasEdge("workspace"))
+}
+
+// WhereHasWorkspaceWith applies a predicate to check if query has an edge workspace with a given conditions (other predicates).
+func (f *TransactionFilter) WhereHasWorkspaceWith(preds ...predicate.Workspace) {
+	f.Where(entql.HasEdgeWith("workspace", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasAccount applies a predicate to check if query has an edge account.
+func (f *TransactionFilter) WhereHasAccount() {
+	f.Where(entql.HasEdge("account"))
+}
+
+// WhereHasAccountWith applies a predicate to check if query has an edge account with a given conditions (other predicates).
+func (f *TransactionFilter) WhereHasAccountWith(preds ...predicate.Account) {
+	f.Where(entql.HasEdgeWith("account", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasCategory applies a predicate to check if query has an edge category.
+func (f *TransactionFilter) WhereHasCategory() {
+	f.Where(entql.HasEdge("category"))
+}
+
+// WhereHasCategoryWith applies a predicate to check if query has an edge category with a given conditions (other predicates).
+func (f *TransactionFilter) WhereHasCategoryWith(preds ...predicate.Category) {
+	f.Where(entql.HasEdgeWith("category", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasLinkedPayee applies a predicate to check if query has an edge linked_payee.
+func (f *TransactionFilter) WhereHasLinkedPayee() {
+	f.Where(entql.HasEdge("linked_payee"))
+}
+
+// WhereHasLinkedPayeeWith applies a predicate to check if query has an edge linked_payee with a given conditions (other predicates).
+func (f *TransactionFilter) WhereHasLinkedPayeeWith(preds ...predicate.Payee) {
+	f.Where(entql.HasEdgeWith("linked_payee", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTags applies a predicate to check if query has an edge tags.
+func (f *TransactionFilter) WhereHasTags() {
+	f.Where(entql.HasEdge("tags"))
+}
+
+// WhereHasTagsWith applies a predicate to check if query has an edge tags with a given conditions (other predicates).
+func (f *TransactionFilter) WhereHasTagsWith(preds ...predicate.Tag) {
+	f.Where(entql.HasEdgeWith("tags", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasSplits applies a predicate to check if query has an edge splits.
+func (f *TransactionFilter) WhereHasSplits() {
+	f.Where(entql.HasEdge("splits"))
+}
+
+// WhereHasSplitsWith applies a predicate to check if query has an edge splits with a given conditions (other predicates).
+func (f *TransactionFilter) WhereHasSplitsWith(preds ...predicate.TransactionSplit) {
+	f.Where(entql.HasEdgeWith("splits", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasJournalEntry applies a predicate to check if query has an edge journal_entry.
+func (f *TransactionFilter) WhereHasJournalEntry() {
+	f.Where(entql.HasEdge("journal_entry"))
+}
+
+// WhereHasJournalEntryWith applies a predicate to check if query has an edge journal_entry with a given conditions (other predicates).
+func (f *TransactionFilter) WhereHasJournalEntryWith(preds ...predicate.JournalEntry) {
+	f.Where(entql.HasEdgeWith("journal_entry", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasOutgoingTransfer applies a predicate to check if query has an edge outgoing_transfer.
+func (f *TransactionFilter) WhereHasOutgoingTransfer() {
+	f.Where(entql.HasEdge("outgoing_transfer"))
+}
+
+// WhereHasOutgoingTransferWith applies a predicate to check if query has an edge outgoing_transfer with a given conditions (other predicates).
+func (f *TransactionFilter) WhereHasOutgoingTransferWith(preds ...predicate.Transfer) {
+	f.Where(entql.HasEdgeWith("outgoing_transfer", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasIncomingTransfer applies a predicate to check if query has an edge incoming_transfer.
+func (f *TransactionFilter) WhereHasIncomingTransfer() {
+	f.Where(entql.HasEdge("incoming_transfer"))
+}
+
+// WhereHasIncomingTransferWith applies a predicate to check if query has an edge incoming_transfer with a given conditions (other predicates).
+func (f *TransactionFilter) WhereHasIncomingTransferWith(preds ...predicate.Transfer) {
+	f.Where(entql.HasEdgeWith("incoming_transfer", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRecurrenceOccurrence applies a predicate to check if query has an edge recurrence_occurrence.
+func (f *TransactionFilter) WhereHasRecurrenceOccurrence() {
+	f.Where(entql.HasEdge("recurrence_occurrence"))
+}
+
+// WhereHasRecurrenceOccurrenceWith applies a predicate to check if query has an edge recurrence_occurrence with a given conditions (other predicates).
+func (f *TransactionFilter) WhereHasRecurrenceOccurrenceWith(preds ...predicate.RecurrenceOccurrence) {
+	f.Where(entql.HasEdgeWith("recurrence_occurrence", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasImportDecisions applies a predicate to check if query has an edge import_decisions.
+func (f *TransactionFilter) WhereHasImportDecisions() {
+	f.Where(entql.HasEdge("import_decisions"))
+}
+
+// WhereHasImportDecisionsWith applies a predicate to check if query has an edge import_decisions with a given conditions (other predicates).
+func (f *TransactionFilter) WhereHasImportDecisionsWith(preds ...predicate.ImportDecision) {
+	f.Where(entql.HasEdgeWith("import_decisions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *TransactionSplitQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TransactionSplitQuery builder.
+func (_q *TransactionSplitQuery) Filter() *TransactionSplitFilter {
+	return &TransactionSplitFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TransactionSplitMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TransactionSplitMutation builder.
+func (m *TransactionSplitMutation) Filter() *TransactionSplitFilter {
+	return &TransactionSplitFilter{config: m.config, predicateAdder: m}
+}
+
+// TransactionSplitFilter provides a generic filtering capability at runtime for TransactionSplitQuery.
+type TransactionSplitFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TransactionSplitFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *TransactionSplitFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(transactionsplit.FieldID))
+}
+
+// WhereWorkspaceID applies the entql int predicate on the workspace_id field.
+func (f *TransactionSplitFilter) WhereWorkspaceID(p entql.IntP) {
+	f.Where(p.Field(transactionsplit.FieldWorkspaceID))
+}
+
+// WhereTransactionID applies the entql int predicate on the transaction_id field.
+func (f *TransactionSplitFilter) WhereTransactionID(p entql.IntP) {
+	f.Where(p.Field(transactionsplit.FieldTransactionID))
+}
+
+// WhereCategoryID applies the entql int predicate on the category_id field.
+func (f *TransactionSplitFilter) WhereCategoryID(p entql.IntP) {
+	f.Where(p.Field(transactionsplit.FieldCategoryID))
+}
+
+// WhereAmount applies the entql other predicate on the amount field.
+func (f *TransactionSplitFilter) WhereAmount(p entql.OtherP) {
+	f.Where(p.Field(transactionsplit.FieldAmount))
+}
+
+// WhereCurrency applies the entql string predicate on the currency field.
+func (f *TransactionSplitFilter) WhereCurrency(p entql.StringP) {
+	f.Where(p.Field(transactionsplit.FieldCurrency))
+}
+
+// WhereMemo applies the entql string predicate on the memo field.
+func (f *TransactionSplitFilter) WhereMemo(p entql.StringP) {
+	f.Where(p.Field(transactionsplit.FieldMemo))
+}
+
+// WhereHasWorkspace applies a predicate to check if query has an edge workspace.
+func (f *TransactionSplitFilter) WhereHasWorkspace() {
+	f.Where(entql.HasEdge("workspace"))
+}
+
+// WhereHasWorkspaceWith applies a predicate to check if query has an edge workspace with a given conditions (other predicates).
+func (f *TransactionSplitFilter) WhereHasWorkspaceWith(preds ...predicate.Workspace) {
+	f.Where(entql.HasEdgeWith("workspace", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTransaction applies a predicate to check if query has an edge transaction.
+func (f *TransactionSplitFilter) WhereHasTransaction() {
+	f.Where(entql.HasEdge("transaction"))
+}
+
+// WhereHasTransactionWith applies a predicate to check if query has an edge transaction with a given conditions (other predicates).
+func (f *TransactionSplitFilter) WhereHasTransactionWith(preds ...predicate.Transaction) {
+	f.Where(entql.HasEdgeWith("transaction", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasCategory applies a predicate to check if query has an edge category.
+func (f *TransactionSplitFilter) WhereHasCategory() {
+	f.Where(entql.HasEdge("category"))
+}
+
+// WhereHasCategoryWith applies a predicate to check if query has an edge category with a given conditions (other predicates).
+func (f *TransactionSplitFilter) WhereHasCategoryWith(preds ...predicate.Category) {
+	f.Where(entql.HasEdgeWith("category", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTags applies a predicate to check if query has an edge tags.
+func (f *TransactionSplitFilter) WhereHasTags() {
+	f.Where(entql.HasEdge("tags"))
+}
+
+// WhereHasTagsWith applies a predicate to check if query has an edge tags with a given conditions (other predicates).
+func (f *TransactionSplitFilter) WhereHasTagsWith(preds ...predicate.Tag) {
+	f.Where(entql.HasEdgeWith("tags", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *TransferQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TransferQuery builder.
+func (_q *TransferQuery) Filter() *TransferFilter {
+	return &TransferFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TransferMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TransferMutation builder.
+func (m *TransferMutation) Filter() *TransferFilter {
+	return &TransferFilter{config: m.config, predicateAdder: m}
+}
+
+// TransferFilter provides a generic filtering capability at runtime for TransferQuery.
+type TransferFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TransferFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[18].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *TransferFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(transfer.FieldID))
+}
+
+// WhereWorkspaceID applies the entql int predicate on the workspace_id field.
+func (f *TransferFilter) WhereWorkspaceID(p entql.IntP) {
+	f.Where(p.Field(transfer.FieldWorkspaceID))
+}
+
+// WhereFromTransactionID applies the entql int predicate on the from_transaction_id field.
+func (f *TransferFilter) WhereFromTransactionID(p entql.IntP) {
+	f.Where(p.Field(transfer.FieldFromTransactionID))
+}
+
+// WhereToTransactionID applies the entql int predicate on the to_transaction_id field.
+func (f *TransferFilter) WhereToTransactionID(p entql.IntP) {
+	f.Where(p.Field(transfer.FieldToTransactionID))
+}
+
+// WhereFromFee applies the entql other predicate on the from_fee field.
+func (f *TransferFilter) WhereFromFee(p entql.OtherP) {
+	f.Where(p.Field(transfer.FieldFromFee))
+}
+
+// WhereToFee applies the entql other predicate on the to_fee field.
+func (f *TransferFilter) WhereToFee(p entql.OtherP) {
+	f.Where(p.Field(transfer.FieldToFee))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TransferFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(transfer.FieldCreatedAt))
+}
+
+// WhereHasWorkspace applies a predicate to check if query has an edge workspace.
+func (f *TransferFilter) WhereHasWorkspace() {
+	f.Where(entql.HasEdge("workspace"))
+}
+
+// WhereHasWorkspaceWith applies a predicate to check if query has an edge workspace with a given conditions (other predicates).
+func (f *TransferFilter) WhereHasWorkspaceWith(preds ...predicate.Workspace) {
+	f.Where(entql.HasEdgeWith("workspace", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasFromTransaction applies a predicate to check if query has an edge from_transaction.
+func (f *TransferFilter) WhereHasFromTransaction() {
+	f.Where(entql.HasEdge("from_transaction"))
+}
+
+// WhereHasFromTransactionWith applies a predicate to check if query has an edge from_transaction with a given conditions (other predicates).
+func (f *TransferFilter) WhereHasFromTransactionWith(preds ...predicate.Transaction) {
+	f.Where(entql.HasEdgeWith("from_transaction", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasToTransaction applies a predicate to check if query has an edge to_transaction.
+func (f *TransferFilter) WhereHasToTransaction() {
+	f.Where(entql.HasEdge("to_transaction"))
+}
+
+// WhereHasToTransactionWith applies a predicate to check if query has an edge to_transaction with a given conditions (other predicates).
+func (f *TransferFilter) WhereHasToTransactionWith(preds ...predicate.Transaction) {
+	f.Where(entql.HasEdgeWith("to_transaction", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *UserQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the UserQuery builder.
+func (_q *UserQuery) Filter() *UserFilter {
+	return &UserFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *UserMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the UserMutation builder.
+func (m *UserMutation) Filter() *UserFilter {
+	return &UserFilter{config: m.config, predicateAdder: m}
+}
+
+// UserFilter provides a generic filtering capability at runtime for UserQuery.
+type UserFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *UserFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[19].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *UserFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(user.FieldID))
+}
+
+// WhereEmail applies the entql string predicate on the email field.
+func (f *UserFilter) WhereEmail(p entql.StringP) {
+	f.Where(p.Field(user.FieldEmail))
+}
+
+// WherePasswordHash applies the entql string predicate on the password_hash field.
+func (f *UserFilter) WherePasswordHash(p entql.StringP) {
+	f.Where(p.Field(user.FieldPasswordHash))
+}
+
+// WhereEmailVerifiedAt applies the entql time.Time predicate on the email_verified_at field.
+func (f *UserFilter) WhereEmailVerifiedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldEmailVerifiedAt))
+}
+
+// WhereTotpSecret applies the entql string predicate on the totp_secret field.
+func (f *UserFilter) WhereTotpSecret(p entql.StringP) {
+	f.Where(p.Field(user.FieldTotpSecret))
+}
+
+// WhereTotpLastUsedStep applies the entql int64 predicate on the totp_last_used_step field.
+func (f *UserFilter) WhereTotpLastUsedStep(p entql.Int64P) {
+	f.Where(p.Field(user.FieldTotpLastUsedStep))
+}
+
+// WhereTwoFactorEnabledAt applies the entql time.Time predicate on the two_factor_enabled_at field.
+func (f *UserFilter) WhereTwoFactorEnabledAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldTwoFactorEnabledAt))
+}
+
+// WhereTwoFactorFailedAttempts applies the entql int predicate on the two_factor_failed_attempts field.
+func (f *UserFilter) WhereTwoFactorFailedAttempts(p entql.IntP) {
+	f.Where(p.Field(user.FieldTwoFactorFailedAttempts))
+}
+
+// WhereTwoFactorLockedUntil applies the entql time.Time predicate on the two_factor_locked_until field.
+func (f *UserFilter) WhereTwoFactorLockedUntil(p entql.TimeP) {
+	f.Where(p.Field(user.FieldTwoFactorLockedUntil))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *UserFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *UserFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldUpdatedAt))
+}
+
+// WhereHasWorkspaces applies a predicate to check if query has an edge workspaces.
+func (f *UserFilter) WhereHasWorkspaces() {
+	f.Where(entql.HasEdge("workspaces"))
+}
+
+// WhereHasWorkspacesWith applies a predicate to check if query has an edge workspaces with a given conditions (other predicates).
+func (f *UserFilter) WhereHasWorkspacesWith(preds ...predicate.Workspace) {
+	f.Where(entql.HasEdgeWith("workspaces", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasSessions applies a predicate to check if query has an edge sessions.
+func (f *UserFilter) WhereHasSessions() {
+	f.Where(entql.HasEdge("sessions"))
+}
+
+// WhereHasSessionsWith applies a predicate to check if query has an edge sessions with a given conditions (other predicates).
+func (f *UserFilter) WhereHasSessionsWith(preds ...predicate.Session) {
+	f.Where(entql.HasEdgeWith("sessions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPasswordResetTokens applies a predicate to check if query has an edge password_reset_tokens.
+func (f *UserFilter) WhereHasPasswordResetTokens() {
+	f.Where(entql.HasEdge("password_reset_tokens"))
+}
+
+// WhereHasPasswordResetTokensWith applies a predicate to check if query has an edge password_reset_tokens with a given conditions (other predicates).
+func (f *UserFilter) WhereHasPasswordResetTokensWith(preds ...predicate.PasswordResetToken) {
+	f.Where(entql.HasEdgeWith("password_reset_tokens", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasEmailVerificationTokens applies a predicate to check if query has an edge email_verification_tokens.
+func (f *UserFilter) WhereHasEmailVerificationTokens() {
+	f.Where(entql.HasEdge("email_verification_tokens"))
+}
+
+// WhereHasEmailVerificationTokensWith applies a predicate to check if query has an edge email_verification_tokens with a given conditions (other predicates).
+func (f *UserFilter) WhereHasEmailVerificationTokensWith(preds ...predicate.EmailVerificationToken) {
+	f.Where(entql.HasEdgeWith("email_verification_tokens", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRecoveryCodes applies a predicate to check if query has an edge recovery_codes.
+func (f *UserFilter) WhereHasRecoveryCodes() {
+	f.Where(entql.HasEdge("recovery_codes"))
+}
+
+// WhereHasRecoveryCodesWith applies a predicate to check if query has an edge recovery_codes with a given conditions (other predicates).
+func (f *UserFilter) WhereHasRecoveryCodesWith(preds ...predicate.RecoveryCode) {
+	f.Where(entql.HasEdgeWith("recovery_codes", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasMemberships applies a predicate to check if query has an edge memberships.
+func (f *UserFilter) WhereHasMemberships() {
+	f.Where(entql.HasEdge("memberships"))
+}
+
+// WhereHasMembershipsWith applies a predicate to check if query has an edge memberships with a given conditions (other predicates).
+func (f *UserFilter) WhereHasMembershipsWith(preds ...predicate.Membership) {
+	f.Where(entql.HasEdgeWith("memberships", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *WorkspaceQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WorkspaceQuery builder.
+func (_q *WorkspaceQuery) Filter() *WorkspaceFilter {
+	return &WorkspaceFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WorkspaceMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WorkspaceMutation builder.
+func (m *WorkspaceMutation) Filter() *WorkspaceFilter {
+	return &WorkspaceFilter{config: m.config, predicateAdder: m}
+}
+
+// WorkspaceFilter provides a generic filtering capability at runtime for WorkspaceQuery.
+type WorkspaceFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WorkspaceFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[20].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *WorkspaceFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(workspace.FieldID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *WorkspaceFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(workspace.FieldName))
+}
+
+// WhereRequireVerifiedEmail applies the entql bool predicate on the require_verified_email field.
+func (f *WorkspaceFilter) WhereRequireVerifiedEmail(p entql.BoolP) {
+	f.Where(p.Field(workspace.FieldRequireVerifiedEmail))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *WorkspaceFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(workspace.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *WorkspaceFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(workspace.FieldUpdatedAt))
+}
+
+// WhereHasUsers applies a predicate to check if query has an edge users.
+func (f *WorkspaceFilter) WhereHasUsers() {
+	f.Where(entql.HasEdge("users"))
+}
+
+// WhereHasUsersWith applies a predicate to check if query has an edge users with a given conditions (other predicates).
+func (f *WorkspaceFilter) WhereHasUsersWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("users", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasInvitations applies a predicate to check if query has an edge invitations.
+func (f *WorkspaceFilter) WhereHasInvitations() {
+	f.Where(entql.HasEdge("invitations"))
+}
+
+// WhereHasInvitationsWith applies a predicate to check if query has an edge invitations with a given conditions (other predicates).
+func (f *WorkspaceFilter) WhereHasInvitationsWith(preds ...predicate.WorkspaceInvitation) {
+	f.Where(entql.HasEdgeWith("invitations", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasAccounts applies a predicate to check if query has an edge accounts.
+func (f *WorkspaceFilter) WhereHasAccounts() {
+	f.Where(entql.HasEdge("accounts"))
+}
+
+// WhereHasAccountsWith applies a predicate to check if query has an edge accounts with a given conditions (other predicates).
+func (f *WorkspaceFilter) WhereHasAccountsWith(preds ...predicate.Account) {
+	f.Where(entql.HasEdgeWith("accounts", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTransactions applies a predicate to check if query has an edge transactions.
+func (f *WorkspaceFilter) WhereHasTransactions() {
+	f.Where(entql.HasEdge("transactions"))
+}
+
+// WhereHasTransactionsWith applies a predicate to check if query has an edge transactions with a given conditions (other predicates).
+func (f *WorkspaceFilter) WhereHasTransactionsWith(preds ...predicate.Transaction) {
+	f.Where(entql.HasEdgeWith("transactions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTransactionSplits applies a predicate to check if query has an edge transaction_splits.
+func (f *WorkspaceFilter) WhereHasTransactionSplits() {
+	f.Where(entql.HasEdge("transaction_splits"))
+}
+
+// WhereHasTransactionSplitsWith applies a predicate to check if query has an edge transaction_splits with a given conditions (other predicates).
+func (f *WorkspaceFilter) WhereHasTransactionSplitsWith(preds ...predicate.TransactionSplit) {
+	f.Where(entql.HasEdgeWith("transaction_splits", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTransfers applies a predicate to check if query has an edge transfers.
+func (f *WorkspaceFilter) WhereHasTransfers() {
+	f.Where(entql.HasEdge("transfers"))
+}
+
+// WhereHasTransfersWith applies a predicate to check if query has an edge transfers with a given conditions (other predicates).
+func (f *WorkspaceFilter) WhereHasTransfersWith(preds ...predicate.Transfer) {
+	f.Where(entql.HasEdgeWith("transfers", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasCategories applies a predicate to check if query has an edge categories.
+func (f *WorkspaceFilter) WhereHasCategories() {
+	f.Where(entql.HasEdge("categories"))
+}
+
+// WhereHasCategoriesWith applies a predicate to check if query has an edge categories with a given conditions (other predicates).
+func (f *WorkspaceFilter) WhereHasCategoriesWith(preds ...predicate.Category) {
+	f.Where(entql.HasEdgeWith("categories", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPayees applies a predicate to check if query has an edge payees.
+func (f *WorkspaceFilter) WhereHasPayees() {
+	f.Where(entql.HasEdge("payees"))
+}
+
+// WhereHasPayeesWith applies a predicate to check if query has an edge payees with a given conditions (other predicates).
+func (f *WorkspaceFilter) WhereHasPayeesWith(preds ...predicate.Payee) {
+	f.Where(entql.HasEdgeWith("payees", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRecurrenceRules applies a predicate to check if query has an edge recurrence_rules.
+func (f *WorkspaceFilter) WhereHasRecurrenceRules() {
+	f.Where(entql.HasEdge("recurrence_rules"))
+}
+
+// WhereHasRecurrenceRulesWith applies a predicate to check if query has an edge recurrence_rules with a given conditions (other predicates).
+func (f *WorkspaceFilter) WhereHasRecurrenceRulesWith(preds ...predicate.RecurrenceRule) {
+	f.Where(entql.HasEdgeWith("recurrence_rules", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRecurrenceOccurrences applies a predicate to check if query has an edge recurrence_occurrences.
+func (f *WorkspaceFilter) WhereHasRecurrenceOccurrences() {
+	f.Where(entql.HasEdge("recurrence_occurrences"))
+}
+
+// WhereHasRecurrenceOccurrencesWith applies a predicate to check if query has an edge recurrence_occurrences with a given conditions (other predicates).
+func (f *WorkspaceFilter) WhereHasRecurrenceOccurrencesWith(preds ...predicate.RecurrenceOccurrence) {
+	f.Where(entql.HasEdgeWith("recurrence_occurrences", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasStatementImports applies a predicate to check if query has an edge statement_imports.
+func (f *WorkspaceFilter) WhereHasStatementImports() {
+	f.Where(entql.HasEdge("statement_imports"))
+}
+
+// WhereHasStatementImportsWith applies a predicate to check if query has an edge statement_imports with a given conditions (other predicates).
+func (f *WorkspaceFilter) WhereHasStatementImportsWith(preds ...predicate.StatementImport) {
+	f.Where(entql.HasEdgeWith("statement_imports", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasImportProfiles applies a predicate to check if query has an edge import_profiles.
+func (f *WorkspaceFilter) WhereHasImportProfiles() {
+	f.Where(entql.HasEdge("import_profiles"))
+}
+
+// WhereHasImportProfilesWith applies a predicate to check if query has an edge import_profiles with a given conditions (other predicates).
+func (f *WorkspaceFilter) WhereHasImportProfilesWith(preds ...predicate.ImportProfile) {
+	f.Where(entql.HasEdgeWith("import_profiles", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasImportDecisions applies a predicate to check if query has an edge import_decisions.
+func (f *WorkspaceFilter) WhereHasImportDecisions() {
+	f.Where(entql.HasEdge("import_decisions"))
+}
+
+// WhereHasImportDecisionsWith applies a predicate to check if query has an edge import_decisions with a given conditions (other predicates).
+func (f *WorkspaceFilter) WhereHasImportDecisionsWith(preds ...predicate.ImportDecision) {
+	f.Where(entql.HasEdgeWith("import_decisions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTags applies a predicate to check if query has an edge tags.
+func (f *WorkspaceFilter) WhereHasTags() {
+	f.Where(entql.HasEdge("tags"))
+}
+
+// WhereHasTagsWith applies a predicate to check if query has an edge tags with a given conditions (other predicates).
+func (f *WorkspaceFilter) WhereHasTagsWith(preds ...predicate.Tag) {
+	f.Where(entql.HasEdgeWith("tags", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasJournalEntries applies a predicate to check if query has an edge journal_entries.
+func (f *WorkspaceFilter) WhereHasJournalEntries() {
+	f.Where(entql.HasEdge("journal_entries"))
+}
+
+// WhereHasJournalEntriesWith applies a predicate to check if query has an edge journal_entries with a given conditions (other predicates).
+func (f *WorkspaceFilter) WhereHasJournalEntriesWith(preds ...predicate.JournalEntry) {
+	f.Where(entql.HasEdgeWith("journal_entries", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPostings applies a predicate to check if query has an edge postings.
+func (f *WorkspaceFilter) WhereHasPostings() {
+	f.Where(entql.HasEdge("postings"))
+}
+
+// WhereHasPostingsWith applies a predicate to check if query has an edge postings with a given conditions (other predicates).
+func (f *WorkspaceFilter) WhereHasPostingsWith(preds ...predicate.Posting) {
+	f.Where(entql.HasEdgeWith("postings", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasMemberships applies a predicate to check if query has an edge memberships.
+func (f *WorkspaceFilter) WhereHasMemberships() {
+	f.Where(entql.HasEdge("memberships"))
+}
+
+// WhereHasMembershipsWith applies a predicate to check if query has an edge memberships with a given conditions (other predicates).
+func (f *WorkspaceFilter) WhereHasMembershipsWith(preds ...predicate.Membership) {
+	f.Where(entql.HasEdgeWith("memberships", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *WorkspaceInvitationQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WorkspaceInvitationQuery builder.
+func (_q *WorkspaceInvitationQuery) Filter() *WorkspaceInvitationFilter {
+	return &WorkspaceInvitationFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WorkspaceInvitationMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WorkspaceInvitationMutation builder.
+func (m *WorkspaceInvitationMutation) Filter() *WorkspaceInvitationFilter {
+	return &WorkspaceInvitationFilter{config: m.config, predicateAdder: m}
+}
+
+// WorkspaceInvitationFilter provides a generic filtering capability at runtime for WorkspaceInvitationQuery.
+type WorkspaceInvitationFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WorkspaceInvitationFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[21].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *WorkspaceInvitationFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(workspaceinvitation.FieldID))
+}
+
+// WhereWorkspaceID applies the entql int predicate on the workspace_id field.
+func (f *WorkspaceInvitationFilter) WhereWorkspaceID(p entql.IntP) {
+	f.Where(p.Field(workspaceinvitation.FieldWorkspaceID))
+}
+
+// WhereEmail applies the entql string predicate on the email field.
+func (f *WorkspaceInvitationFilter) WhereEmail(p entql.StringP) {
+	f.Where(p.Field(workspaceinvitation.FieldEmail))
+}
+
+// WhereRole applies the entql string predicate on the role field.
+func (f *WorkspaceInvitationFilter) WhereRole(p entql.StringP) {
+	f.Where(p.Field(workspaceinvitation.FieldRole))
+}
+
+// WhereTokenHash applies the entql string predicate on the token_hash field.
+func (f *WorkspaceInvitationFilter) WhereTokenHash(p entql.StringP) {
+	f.Where(p.Field(workspaceinvitation.FieldTokenHash))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *WorkspaceInvitationFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(workspaceinvitation.FieldStatus))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *WorkspaceInvitationFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(workspaceinvitation.FieldExpiresAt))
+}
+
+// WhereInvitedByID applies the entql int predicate on the invited_by_id field.
+func (f *WorkspaceInvitationFilter) WhereInvitedByID(p entql.IntP) {
+	f.Where(p.Field(workspaceinvitation.FieldInvitedByID))
+}
+
+// WhereAcceptedByID applies the entql int predicate on the accepted_by_id field.
+func (f *WorkspaceInvitationFilter) WhereAcceptedByID(p entql.IntP) {
+	f.Where(p.Field(workspaceinvitation.FieldAcceptedByID))
+}
+
+// WhereRespondedAt applies the entql time.Time predicate on the responded_at field.
+func (f *WorkspaceInvitationFilter) WhereRespondedAt(p entql.TimeP) {
+	f.Where(p.Field(workspaceinvitation.FieldRespondedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *WorkspaceInvitationFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(workspaceinvitation.FieldCreatedAt))
+}
+
+// WhereHasWorkspace applies a predicate to check if query has an edge workspace.
+func (f *WorkspaceInvitationFilter) WhereHasWorkspace() {
+	f.Where(entql.HasEdge("workspace"))
+}
+
+// WhereHasWorkspaceWith applies a predicate to check if query has an edge workspace with a given conditions (other predicates).
+func (f *WorkspaceInvitationFilter) WhereHasWorkspaceWith(preds ...predicate.Workspace) {
+	f.Where(entql.HasEdgeWith("workspace", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasInvitedBy applies a predicate to check if query has an edge invited_by.
+func (f *WorkspaceInvitationFilter) WhereHasInvitedBy() {
+	f.Where(entql.HasEdge("invited_by"))
+}
+
+// WhereHasInvitedByWith applies a predicate to check if query has an edge invited_by with a given conditions (other predicates).
+func (f *WorkspaceInvitationFilter) WhereHasInvitedByWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("invited_by", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasAcceptedBy applies a predicate to check if query has an edge accepted_by.
+func (f *WorkspaceInvitationFilter) WhereHasAcceptedBy() {
+	f.Where(entql.HasEdge("accepted_by"))
+}
+
+// WhereHasAcceptedByWith applies a predicate to check if query has an edge accepted_by with a given conditions (other predicates).
+func (f *WorkspaceInvitationFilter) WhereHasAcceptedByWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("accepted_by", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
@@ -0,0 +1,5 @@
+package ent
+
+// The privacy and entql features are required by the tenant policy of workspace-owned entities;
+// sql/modifier lets reports aggregate over the tag join tables
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature privacy,entql,sql/modifier ./schema
@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/importdecision"
+	"backend/internal/infrastructure/ent/statementimport"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/workspace"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ImportDecision is the model entity for the ImportDecision schema.
+type ImportDecision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID int `json:"account_id,omitempty"`
+	// StatementImportID holds the value of the "statement_import_id" field.
+	StatementImportID *int `json:"statement_import_id,omitempty"`
+	// Fingerprint holds the value of the "fingerprint" field.
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// Action holds the value of the "action" field.
+	Action importdecision.Action `json:"action,omitempty"`
+	// TransactionID holds the value of the "transaction_id" field.
+	TransactionID int `json:"transaction_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ImportDecisionQuery when eager-loading is set.
+	Edges        ImportDecisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ImportDecisionEdges holds the relations/edges for other nodes in the graph.
+type ImportDecisionEdges struct {
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// StatementImport holds the value of the statement_import edge.
+	StatementImport *StatementImport `json:"statement_import,omitempty"`
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ImportDecisionEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ImportDecisionEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// StatementImportOrErr returns the StatementImport value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ImportDecisionEdges) StatementImportOrErr() (*StatementImport, error) {
+	if e.StatementImport != nil {
+		return e.StatementImport, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: statementimport.Label}
+	}
+	return nil, &NotLoadedError{edge: "statement_import"}
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ImportDecisionEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ImportDecision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case importdecision.FieldID, importdecision.FieldWorkspaceID, importdecision.FieldAccountID, importdecision.FieldStatementImportID, importdecision.FieldTransactionID:
+			values[i] = new(sql.NullInt64)
+		case importdecision.FieldFingerprint, importdecision.FieldAction:
+			values[i] = new(sql.NullString)
+		case importdecision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ImportDecision fields.
+func (_m *ImportDecision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case importdecision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case importdecision.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				_m.WorkspaceID = int(value.Int64)
+			}
+		case importdecision.FieldAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = int(value.Int64)
+			}
+		case importdecision.FieldStatementImportID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field statement_import_id", values[i])
+			} else if value.Valid {
+				_m.StatementImportID = new(int)
+				*_m.StatementImportID = int(value.Int64)
+			}
+		case importdecision.FieldFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				_m.Fingerprint = value.String
+			}
+		case importdecision.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = importdecision.Action(value.String)
+			}
+		case importdecision.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				_m.TransactionID = int(value.Int64)
+			}
+		case importdecision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ImportDecision.
+// This includes values selected through modifiers, order, etc.
+func (_m *ImportDecision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWorkspace queries the "workspace" edge of the ImportDecision entity.
+func (_m *ImportDecision) QueryWorkspace() *WorkspaceQuery {
+	return NewImportDecisionClient(_m.config).QueryWorkspace(_m)
+}
+
+// QueryAccount queries the "account" edge of the ImportDecision entity.
+func (_m *ImportDecision) QueryAccount() *AccountQuery {
+	return NewImportDecisionClient(_m.config).QueryAccount(_m)
+}
+
+// QueryStatementImport queries the "statement_import" edge of the ImportDecision entity.
+func (_m *ImportDecision) QueryStatementImport() *StatementImportQuery {
+	return NewImportDecisionClient(_m.config).QueryStatementImport(_m)
+}
+
+// QueryTransaction queries the "transaction" edge of the ImportDecision entity.
+func (_m *ImportDecision) QueryTransaction() *TransactionQuery {
+	return NewImportDecisionClient(_m.config).QueryTransaction(_m)
+}
+
+// Update returns a builder for updating this ImportDecision.
+// Note that you need to call ImportDecision.Unwrap() before calling this method if this ImportDecision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ImportDecision) Update() *ImportDecisionUpdateOne {
+	return NewImportDecisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ImportDecision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ImportDecision) Unwrap() *ImportDecision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ImportDecision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ImportDecision) String() string {
+	var builder strings.Builder
+	builder.WriteString("ImportDecision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	if v := _m.StatementImportID; v != nil {
+		builder.WriteString("statement_import_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(_m.Fingerprint)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("transaction_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TransactionID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ImportDecisions is a parsable slice of ImportDecision.
+type ImportDecisions []*ImportDecision
@@ -0,0 +1,408 @@
+// Code generated by ent, DO NOT EDIT.
+
+package importdecision
+
+import (
+	"backend/internal/infrastructure/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldLTE(FieldID, id))
+}
+
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldEQ(FieldAccountID, v))
+}
+
+// StatementImportID applies equality check predicate on the "statement_import_id" field. It's identical to StatementImportIDEQ.
+func StatementImportID(v int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldEQ(FieldStatementImportID, v))
+}
+
+// Fingerprint applies equality check predicate on the "fingerprint" field. It's identical to FingerprintEQ.
+func Fingerprint(v string) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// TransactionID applies equality check predicate on the "transaction_id" field. It's identical to TransactionIDEQ.
+func TransactionID(v int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// StatementImportIDEQ applies the EQ predicate on the "statement_import_id" field.
+func StatementImportIDEQ(v int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldEQ(FieldStatementImportID, v))
+}
+
+// StatementImportIDNEQ applies the NEQ predicate on the "statement_import_id" field.
+func StatementImportIDNEQ(v int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldNEQ(FieldStatementImportID, v))
+}
+
+// StatementImportIDIn applies the In predicate on the "statement_import_id" field.
+func StatementImportIDIn(vs ...int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldIn(FieldStatementImportID, vs...))
+}
+
+// StatementImportIDNotIn applies the NotIn predicate on the "statement_import_id" field.
+func StatementImportIDNotIn(vs ...int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldNotIn(FieldStatementImportID, vs...))
+}
+
+// StatementImportIDIsNil applies the IsNil predicate on the "statement_import_id" field.
+func StatementImportIDIsNil() predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldIsNull(FieldStatementImportID))
+}
+
+// StatementImportIDNotNil applies the NotNil predicate on the "statement_import_id" field.
+func StatementImportIDNotNil() predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldNotNull(FieldStatementImportID))
+}
+
+// FingerprintEQ applies the EQ predicate on the "fingerprint" field.
+func FingerprintEQ(v string) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// FingerprintNEQ applies the NEQ predicate on the "fingerprint" field.
+func FingerprintNEQ(v string) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldNEQ(FieldFingerprint, v))
+}
+
+// FingerprintIn applies the In predicate on the "fingerprint" field.
+func FingerprintIn(vs ...string) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldIn(FieldFingerprint, vs...))
+}
+
+// FingerprintNotIn applies the NotIn predicate on the "fingerprint" field.
+func FingerprintNotIn(vs ...string) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldNotIn(FieldFingerprint, vs...))
+}
+
+// FingerprintGT applies the GT predicate on the "fingerprint" field.
+func FingerprintGT(v string) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldGT(FieldFingerprint, v))
+}
+
+// FingerprintGTE applies the GTE predicate on the "fingerprint" field.
+func FingerprintGTE(v string) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldGTE(FieldFingerprint, v))
+}
+
+// FingerprintLT applies the LT predicate on the "fingerprint" field.
+func FingerprintLT(v string) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldLT(FieldFingerprint, v))
+}
+
+// FingerprintLTE applies the LTE predicate on the "fingerprint" field.
+func FingerprintLTE(v string) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldLTE(FieldFingerprint, v))
+}
+
+// FingerprintContains applies the Contains predicate on the "fingerprint" field.
+func FingerprintContains(v string) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldContains(FieldFingerprint, v))
+}
+
+// FingerprintHasPrefix applies the HasPrefix predicate on the "fingerprint" field.
+func FingerprintHasPrefix(v string) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldHasPrefix(FieldFingerprint, v))
+}
+
+// FingerprintHasSuffix applies the HasSuffix predicate on the "fingerprint" field.
+func FingerprintHasSuffix(v string) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldHasSuffix(FieldFingerprint, v))
+}
+
+// FingerprintEqualFold applies the EqualFold predicate on the "fingerprint" field.
+func FingerprintEqualFold(v string) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldEqualFold(FieldFingerprint, v))
+}
+
+// FingerprintContainsFold applies the ContainsFold predicate on the "fingerprint" field.
+func FingerprintContainsFold(v string) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldContainsFold(FieldFingerprint, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// TransactionIDEQ applies the EQ predicate on the "transaction_id" field.
+func TransactionIDEQ(v int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// TransactionIDNEQ applies the NEQ predicate on the "transaction_id" field.
+func TransactionIDNEQ(v int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldNEQ(FieldTransactionID, v))
+}
+
+// TransactionIDIn applies the In predicate on the "transaction_id" field.
+func TransactionIDIn(vs ...int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDNotIn applies the NotIn predicate on the "transaction_id" field.
+func TransactionIDNotIn(vs ...int) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldNotIn(FieldTransactionID, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasWorkspace applies the HasEdge predicate on the "workspace" edge.
+func HasWorkspace() predicate.ImportDecision {
+	return predicate.ImportDecision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WorkspaceTable, WorkspaceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkspaceWith applies the HasEdge predicate on the "workspace" edge with a given conditions (other predicates).
+func HasWorkspaceWith(preds ...predicate.Workspace) predicate.ImportDecision {
+	return predicate.ImportDecision(func(s *sql.Selector) {
+		step := newWorkspaceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.ImportDecision {
+	return predicate.ImportDecision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.ImportDecision {
+	return predicate.ImportDecision(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStatementImport applies the HasEdge predicate on the "statement_import" edge.
+func HasStatementImport() predicate.ImportDecision {
+	return predicate.ImportDecision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, StatementImportTable, StatementImportColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatementImportWith applies the HasEdge predicate on the "statement_import" edge with a given conditions (other predicates).
+func HasStatementImportWith(preds ...predicate.StatementImport) predicate.ImportDecision {
+	return predicate.ImportDecision(func(s *sql.Selector) {
+		step := newStatementImportStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransaction applies the HasEdge predicate on the "transaction" edge.
+func HasTransaction() predicate.ImportDecision {
+	return predicate.ImportDecision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TransactionTable, TransactionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionWith applies the HasEdge predicate on the "transaction" edge with a given conditions (other predicates).
+func HasTransactionWith(preds ...predicate.Transaction) predicate.ImportDecision {
+	return predicate.ImportDecision(func(s *sql.Selector) {
+		step := newTransactionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ImportDecision) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ImportDecision) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ImportDecision) predicate.ImportDecision {
+	return predicate.ImportDecision(sql.NotPredicates(p))
+}
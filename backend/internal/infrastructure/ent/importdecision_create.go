@@ -0,0 +1,386 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/importdecision"
+	"backend/internal/infrastructure/ent/statementimport"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/workspace"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ImportDecisionCreate is the builder for creating a ImportDecision entity.
+type ImportDecisionCreate struct {
+	config
+	mutation *ImportDecisionMutation
+	hooks    []Hook
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *ImportDecisionCreate) SetWorkspaceID(v int) *ImportDecisionCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *ImportDecisionCreate) SetAccountID(v int) *ImportDecisionCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetStatementImportID sets the "statement_import_id" field.
+func (_c *ImportDecisionCreate) SetStatementImportID(v int) *ImportDecisionCreate {
+	_c.mutation.SetStatementImportID(v)
+	return _c
+}
+
+// SetNillableStatementImportID sets the "statement_import_id" field if the given value is not nil.
+func (_c *ImportDecisionCreate) SetNillableStatementImportID(v *int) *ImportDecisionCreate {
+	if v != nil {
+		_c.SetStatementImportID(*v)
+	}
+	return _c
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (_c *ImportDecisionCreate) SetFingerprint(v string) *ImportDecisionCreate {
+	_c.mutation.SetFingerprint(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *ImportDecisionCreate) SetAction(v importdecision.Action) *ImportDecisionCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_c *ImportDecisionCreate) SetTransactionID(v int) *ImportDecisionCreate {
+	_c.mutation.SetTransactionID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ImportDecisionCreate) SetCreatedAt(v time.Time) *ImportDecisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ImportDecisionCreate) SetNillableCreatedAt(v *time.Time) *ImportDecisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_c *ImportDecisionCreate) SetWorkspace(v *Workspace) *ImportDecisionCreate {
+	return _c.SetWorkspaceID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *ImportDecisionCreate) SetAccount(v *Account) *ImportDecisionCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetStatementImport sets the "statement_import" edge to the StatementImport entity.
+func (_c *ImportDecisionCreate) SetStatementImport(v *StatementImport) *ImportDecisionCreate {
+	return _c.SetStatementImportID(v.ID)
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_c *ImportDecisionCreate) SetTransaction(v *Transaction) *ImportDecisionCreate {
+	return _c.SetTransactionID(v.ID)
+}
+
+// Mutation returns the ImportDecisionMutation object of the builder.
+func (_c *ImportDecisionCreate) Mutation() *ImportDecisionMutation {
+	return _c.mutation
+}
+
+// Save creates the ImportDecision in the database.
+func (_c *ImportDecisionCreate) Save(ctx context.Context) (*ImportDecision, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImportDecisionCreate) SaveX(ctx context.Context) *ImportDecision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportDecisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportDecisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImportDecisionCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if importdecision.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized importdecision.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := importdecision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImportDecisionCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "ImportDecision.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "ImportDecision.account_id"`)}
+	}
+	if _, ok := _c.mutation.Fingerprint(); !ok {
+		return &ValidationError{Name: "fingerprint", err: errors.New(`ent: missing required field "ImportDecision.fingerprint"`)}
+	}
+	if v, ok := _c.mutation.Fingerprint(); ok {
+		if err := importdecision.FingerprintValidator(v); err != nil {
+			return &ValidationError{Name: "fingerprint", err: fmt.Errorf(`ent: validator failed for field "ImportDecision.fingerprint": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "ImportDecision.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := importdecision.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ImportDecision.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TransactionID(); !ok {
+		return &ValidationError{Name: "transaction_id", err: errors.New(`ent: missing required field "ImportDecision.transaction_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ImportDecision.created_at"`)}
+	}
+	if len(_c.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "ImportDecision.workspace"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "ImportDecision.account"`)}
+	}
+	if len(_c.mutation.TransactionIDs()) == 0 {
+		return &ValidationError{Name: "transaction", err: errors.New(`ent: missing required edge "ImportDecision.transaction"`)}
+	}
+	return nil
+}
+
+func (_c *ImportDecisionCreate) sqlSave(ctx context.Context) (*ImportDecision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImportDecisionCreate) createSpec() (*ImportDecision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ImportDecision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(importdecision.Table, sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Fingerprint(); ok {
+		_spec.SetField(importdecision.FieldFingerprint, field.TypeString, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(importdecision.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(importdecision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importdecision.WorkspaceTable,
+			Columns: []string{importdecision.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importdecision.AccountTable,
+			Columns: []string{importdecision.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StatementImportIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importdecision.StatementImportTable,
+			Columns: []string{importdecision.StatementImportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementimport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StatementImportID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importdecision.TransactionTable,
+			Columns: []string{importdecision.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TransactionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ImportDecisionCreateBulk is the builder for creating many ImportDecision entities in bulk.
+type ImportDecisionCreateBulk struct {
+	config
+	err      error
+	builders []*ImportDecisionCreate
+}
+
+// Save creates the ImportDecision entities in the database.
+func (_c *ImportDecisionCreateBulk) Save(ctx context.Context) ([]*ImportDecision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ImportDecision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImportDecisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImportDecisionCreateBulk) SaveX(ctx context.Context) []*ImportDecision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportDecisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportDecisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
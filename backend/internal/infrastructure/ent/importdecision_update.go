@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/importdecision"
+	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/statementimport"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ImportDecisionUpdate is the builder for updating ImportDecision entities.
+type ImportDecisionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ImportDecisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ImportDecisionUpdate builder.
+func (_u *ImportDecisionUpdate) Where(ps ...predicate.ImportDecision) *ImportDecisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatementImportID sets the "statement_import_id" field.
+func (_u *ImportDecisionUpdate) SetStatementImportID(v int) *ImportDecisionUpdate {
+	_u.mutation.SetStatementImportID(v)
+	return _u
+}
+
+// SetNillableStatementImportID sets the "statement_import_id" field if the given value is not nil.
+func (_u *ImportDecisionUpdate) SetNillableStatementImportID(v *int) *ImportDecisionUpdate {
+	if v != nil {
+		_u.SetStatementImportID(*v)
+	}
+	return _u
+}
+
+// ClearStatementImportID clears the value of the "statement_import_id" field.
+func (_u *ImportDecisionUpdate) ClearStatementImportID() *ImportDecisionUpdate {
+	_u.mutation.ClearStatementImportID()
+	return _u
+}
+
+// SetStatementImport sets the "statement_import" edge to the StatementImport entity.
+func (_u *ImportDecisionUpdate) SetStatementImport(v *StatementImport) *ImportDecisionUpdate {
+	return _u.SetStatementImportID(v.ID)
+}
+
+// Mutation returns the ImportDecisionMutation object of the builder.
+func (_u *ImportDecisionUpdate) Mutation() *ImportDecisionMutation {
+	return _u.mutation
+}
+
+// ClearStatementImport clears the "statement_import" edge to the StatementImport entity.
+func (_u *ImportDecisionUpdate) ClearStatementImport() *ImportDecisionUpdate {
+	_u.mutation.ClearStatementImport()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImportDecisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportDecisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImportDecisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportDecisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportDecisionUpdate) check() error {
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportDecision.workspace"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportDecision.account"`)
+	}
+	if _u.mutation.TransactionCleared() && len(_u.mutation.TransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportDecision.transaction"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImportDecisionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportDecisionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImportDecisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importdecision.Table, importdecision.Columns, sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.StatementImportCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importdecision.StatementImportTable,
+			Columns: []string{importdecision.StatementImportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementimport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatementImportIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importdecision.StatementImportTable,
+			Columns: []string{importdecision.StatementImportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementimport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importdecision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImportDecisionUpdateOne is the builder for updating a single ImportDecision entity.
+type ImportDecisionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ImportDecisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStatementImportID sets the "statement_import_id" field.
+func (_u *ImportDecisionUpdateOne) SetStatementImportID(v int) *ImportDecisionUpdateOne {
+	_u.mutation.SetStatementImportID(v)
+	return _u
+}
+
+// SetNillableStatementImportID sets the "statement_import_id" field if the given value is not nil.
+func (_u *ImportDecisionUpdateOne) SetNillableStatementImportID(v *int) *ImportDecisionUpdateOne {
+	if v != nil {
+		_u.SetStatementImportID(*v)
+	}
+	return _u
+}
+
+// ClearStatementImportID clears the value of the "statement_import_id" field.
+func (_u *ImportDecisionUpdateOne) ClearStatementImportID() *ImportDecisionUpdateOne {
+	_u.mutation.ClearStatementImportID()
+	return _u
+}
+
+// SetStatementImport sets the "statement_import" edge to the StatementImport entity.
+func (_u *ImportDecisionUpdateOne) SetStatementImport(v *StatementImport) *ImportDecisionUpdateOne {
+	return _u.SetStatementImportID(v.ID)
+}
+
+// Mutation returns the ImportDecisionMutation object of the builder.
+func (_u *ImportDecisionUpdateOne) Mutation() *ImportDecisionMutation {
+	return _u.mutation
+}
+
+// ClearStatementImport clears the "statement_import" edge to the StatementImport entity.
+func (_u *ImportDecisionUpdateOne) ClearStatementImport() *ImportDecisionUpdateOne {
+	_u.mutation.ClearStatementImport()
+	return _u
+}
+
+// Where appends a list predicates to the ImportDecisionUpdate builder.
+func (_u *ImportDecisionUpdateOne) Where(ps ...predicate.ImportDecision) *ImportDecisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImportDecisionUpdateOne) Select(field string, fields ...string) *ImportDecisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ImportDecision entity.
+func (_u *ImportDecisionUpdateOne) Save(ctx context.Context) (*ImportDecision, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportDecisionUpdateOne) SaveX(ctx context.Context) *ImportDecision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImportDecisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportDecisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportDecisionUpdateOne) check() error {
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportDecision.workspace"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportDecision.account"`)
+	}
+	if _u.mutation.TransactionCleared() && len(_u.mutation.TransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportDecision.transaction"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImportDecisionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportDecisionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImportDecisionUpdateOne) sqlSave(ctx context.Context) (_node *ImportDecision, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importdecision.Table, importdecision.Columns, sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ImportDecision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, importdecision.FieldID)
+		for _, f := range fields {
+			if !importdecision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != importdecision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.StatementImportCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importdecision.StatementImportTable,
+			Columns: []string{importdecision.StatementImportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementimport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatementImportIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importdecision.StatementImportTable,
+			Columns: []string{importdecision.StatementImportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementimport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ImportDecision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importdecision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
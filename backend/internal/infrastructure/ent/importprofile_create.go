@@ -0,0 +1,338 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/importprofile"
+	"backend/internal/infrastructure/ent/workspace"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ImportProfileCreate is the builder for creating a ImportProfile entity.
+type ImportProfileCreate struct {
+	config
+	mutation *ImportProfileMutation
+	hooks    []Hook
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *ImportProfileCreate) SetWorkspaceID(v int) *ImportProfileCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *ImportProfileCreate) SetAccountID(v int) *ImportProfileCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ImportProfileCreate) SetName(v string) *ImportProfileCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetMapping sets the "mapping" field.
+func (_c *ImportProfileCreate) SetMapping(v model.CSVMapping) *ImportProfileCreate {
+	_c.mutation.SetMapping(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ImportProfileCreate) SetCreatedAt(v time.Time) *ImportProfileCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ImportProfileCreate) SetNillableCreatedAt(v *time.Time) *ImportProfileCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ImportProfileCreate) SetUpdatedAt(v time.Time) *ImportProfileCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ImportProfileCreate) SetNillableUpdatedAt(v *time.Time) *ImportProfileCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_c *ImportProfileCreate) SetWorkspace(v *Workspace) *ImportProfileCreate {
+	return _c.SetWorkspaceID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *ImportProfileCreate) SetAccount(v *Account) *ImportProfileCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the ImportProfileMutation object of the builder.
+func (_c *ImportProfileCreate) Mutation() *ImportProfileMutation {
+	return _c.mutation
+}
+
+// Save creates the ImportProfile in the database.
+func (_c *ImportProfileCreate) Save(ctx context.Context) (*ImportProfile, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImportProfileCreate) SaveX(ctx context.Context) *ImportProfile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportProfileCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportProfileCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImportProfileCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if importprofile.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized importprofile.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := importprofile.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if importprofile.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized importprofile.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := importprofile.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImportProfileCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "ImportProfile.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "ImportProfile.account_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ImportProfile.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := importprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ImportProfile.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Mapping(); !ok {
+		return &ValidationError{Name: "mapping", err: errors.New(`ent: missing required field "ImportProfile.mapping"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ImportProfile.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ImportProfile.updated_at"`)}
+	}
+	if len(_c.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "ImportProfile.workspace"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "ImportProfile.account"`)}
+	}
+	return nil
+}
+
+func (_c *ImportProfileCreate) sqlSave(ctx context.Context) (*ImportProfile, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImportProfileCreate) createSpec() (*ImportProfile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ImportProfile{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(importprofile.Table, sqlgraph.NewFieldSpec(importprofile.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(importprofile.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Mapping(); ok {
+		_spec.SetField(importprofile.FieldMapping, field.TypeJSON, value)
+		_node.Mapping = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(importprofile.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(importprofile.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importprofile.WorkspaceTable,
+			Columns: []string{importprofile.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importprofile.AccountTable,
+			Columns: []string{importprofile.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ImportProfileCreateBulk is the builder for creating many ImportProfile entities in bulk.
+type ImportProfileCreateBulk struct {
+	config
+	err      error
+	builders []*ImportProfileCreate
+}
+
+// Save creates the ImportProfile entities in the database.
+func (_c *ImportProfileCreateBulk) Save(ctx context.Context) ([]*ImportProfile, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ImportProfile, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImportProfileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImportProfileCreateBulk) SaveX(ctx context.Context) []*ImportProfile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportProfileCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportProfileCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,347 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/importprofile"
+	"backend/internal/infrastructure/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ImportProfileUpdate is the builder for updating ImportProfile entities.
+type ImportProfileUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ImportProfileMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ImportProfileUpdate builder.
+func (_u *ImportProfileUpdate) Where(ps ...predicate.ImportProfile) *ImportProfileUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ImportProfileUpdate) SetName(v string) *ImportProfileUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ImportProfileUpdate) SetNillableName(v *string) *ImportProfileUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetMapping sets the "mapping" field.
+func (_u *ImportProfileUpdate) SetMapping(v model.CSVMapping) *ImportProfileUpdate {
+	_u.mutation.SetMapping(v)
+	return _u
+}
+
+// SetNillableMapping sets the "mapping" field if the given value is not nil.
+func (_u *ImportProfileUpdate) SetNillableMapping(v *model.CSVMapping) *ImportProfileUpdate {
+	if v != nil {
+		_u.SetMapping(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ImportProfileUpdate) SetUpdatedAt(v time.Time) *ImportProfileUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ImportProfileMutation object of the builder.
+func (_u *ImportProfileUpdate) Mutation() *ImportProfileMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImportProfileUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportProfileUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImportProfileUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportProfileUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImportProfileUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if importprofile.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized importprofile.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := importprofile.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportProfileUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := importprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ImportProfile.name": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportProfile.workspace"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportProfile.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImportProfileUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportProfileUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImportProfileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importprofile.Table, importprofile.Columns, sqlgraph.NewFieldSpec(importprofile.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(importprofile.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Mapping(); ok {
+		_spec.SetField(importprofile.FieldMapping, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(importprofile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImportProfileUpdateOne is the builder for updating a single ImportProfile entity.
+type ImportProfileUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ImportProfileMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *ImportProfileUpdateOne) SetName(v string) *ImportProfileUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ImportProfileUpdateOne) SetNillableName(v *string) *ImportProfileUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetMapping sets the "mapping" field.
+func (_u *ImportProfileUpdateOne) SetMapping(v model.CSVMapping) *ImportProfileUpdateOne {
+	_u.mutation.SetMapping(v)
+	return _u
+}
+
+// SetNillableMapping sets the "mapping" field if the given value is not nil.
+func (_u *ImportProfileUpdateOne) SetNillableMapping(v *model.CSVMapping) *ImportProfileUpdateOne {
+	if v != nil {
+		_u.SetMapping(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ImportProfileUpdateOne) SetUpdatedAt(v time.Time) *ImportProfileUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ImportProfileMutation object of the builder.
+func (_u *ImportProfileUpdateOne) Mutation() *ImportProfileMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ImportProfileUpdate builder.
+func (_u *ImportProfileUpdateOne) Where(ps ...predicate.ImportProfile) *ImportProfileUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImportProfileUpdateOne) Select(field string, fields ...string) *ImportProfileUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ImportProfile entity.
+func (_u *ImportProfileUpdateOne) Save(ctx context.Context) (*ImportProfile, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportProfileUpdateOne) SaveX(ctx context.Context) *ImportProfile {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImportProfileUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportProfileUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImportProfileUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if importprofile.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized importprofile.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := importprofile.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportProfileUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := importprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ImportProfile.name": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportProfile.workspace"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportProfile.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImportProfileUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportProfileUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImportProfileUpdateOne) sqlSave(ctx context.Context) (_node *ImportProfile, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importprofile.Table, importprofile.Columns, sqlgraph.NewFieldSpec(importprofile.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ImportProfile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, importprofile.FieldID)
+		for _, f := range fields {
+			if !importprofile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != importprofile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(importprofile.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Mapping(); ok {
+		_spec.SetField(importprofile.FieldMapping, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(importprofile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ImportProfile{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/journalentry"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/workspace"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// JournalEntry is the model entity for the JournalEntry schema.
+type JournalEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+	// TransactionID holds the value of the "transaction_id" field.
+	TransactionID *int `json:"transaction_id,omitempty"`
+	// PostedOn holds the value of the "posted_on" field.
+	PostedOn time.Time `json:"posted_on,omitempty"`
+	// Memo holds the value of the "memo" field.
+	Memo string `json:"memo,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the JournalEntryQuery when eager-loading is set.
+	Edges        JournalEntryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// JournalEntryEdges holds the relations/edges for other nodes in the graph.
+type JournalEntryEdges struct {
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// Postings holds the value of the postings edge.
+	Postings []*Posting `json:"postings,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e JournalEntryEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e JournalEntryEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// PostingsOrErr returns the Postings value or an error if the edge
+// was not loaded in eager-loading.
+func (e JournalEntryEdges) PostingsOrErr() ([]*Posting, error) {
+	if e.loadedTypes[2] {
+		return e.Postings, nil
+	}
+	return nil, &NotLoadedError{edge: "postings"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JournalEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case journalentry.FieldID, journalentry.FieldWorkspaceID, journalentry.FieldTransactionID:
+			values[i] = new(sql.NullInt64)
+		case journalentry.FieldMemo:
+			values[i] = new(sql.NullString)
+		case journalentry.FieldPostedOn, journalentry.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JournalEntry fields.
+func (_m *JournalEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case journalentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case journalentry.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				_m.WorkspaceID = int(value.Int64)
+			}
+		case journalentry.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				_m.TransactionID = new(int)
+				*_m.TransactionID = int(value.Int64)
+			}
+		case journalentry.FieldPostedOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field posted_on", values[i])
+			} else if value.Valid {
+				_m.PostedOn = value.Time
+			}
+		case journalentry.FieldMemo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field memo", values[i])
+			} else if value.Valid {
+				_m.Memo = value.String
+			}
+		case journalentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the JournalEntry.
+// This includes values selected through modifiers, order, etc.
+func (_m *JournalEntry) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWorkspace queries the "workspace" edge of the JournalEntry entity.
+func (_m *JournalEntry) QueryWorkspace() *WorkspaceQuery {
+	return NewJournalEntryClient(_m.config).QueryWorkspace(_m)
+}
+
+// QueryTransaction queries the "transaction" edge of the JournalEntry entity.
+func (_m *JournalEntry) QueryTransaction() *TransactionQuery {
+	return NewJournalEntryClient(_m.config).QueryTransaction(_m)
+}
+
+// QueryPostings queries the "postings" edge of the JournalEntry entity.
+func (_m *JournalEntry) QueryPostings() *PostingQuery {
+	return NewJournalEntryClient(_m.config).QueryPostings(_m)
+}
+
+// Update returns a builder for updating this JournalEntry.
+// Note that you need to call JournalEntry.Unwrap() before calling this method if this JournalEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *JournalEntry) Update() *JournalEntryUpdateOne {
+	return NewJournalEntryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the JournalEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *JournalEntry) Unwrap() *JournalEntry {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: JournalEntry is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *JournalEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("JournalEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	if v := _m.TransactionID; v != nil {
+		builder.WriteString("transaction_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("posted_on=")
+	builder.WriteString(_m.PostedOn.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("memo=")
+	builder.WriteString(_m.Memo)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JournalEntries is a parsable slice of JournalEntry.
+type JournalEntries []*JournalEntry
@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/journalentry"
+	"backend/internal/infrastructure/ent/posting"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/workspace"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JournalEntryCreate is the builder for creating a JournalEntry entity.
+type JournalEntryCreate struct {
+	config
+	mutation *JournalEntryMutation
+	hooks    []Hook
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *JournalEntryCreate) SetWorkspaceID(v int) *JournalEntryCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_c *JournalEntryCreate) SetTransactionID(v int) *JournalEntryCreate {
+	_c.mutation.SetTransactionID(v)
+	return _c
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_c *JournalEntryCreate) SetNillableTransactionID(v *int) *JournalEntryCreate {
+	if v != nil {
+		_c.SetTransactionID(*v)
+	}
+	return _c
+}
+
+// SetPostedOn sets the "posted_on" field.
+func (_c *JournalEntryCreate) SetPostedOn(v time.Time) *JournalEntryCreate {
+	_c.mutation.SetPostedOn(v)
+	return _c
+}
+
+// SetMemo sets the "memo" field.
+func (_c *JournalEntryCreate) SetMemo(v string) *JournalEntryCreate {
+	_c.mutation.SetMemo(v)
+	return _c
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_c *JournalEntryCreate) SetNillableMemo(v *string) *JournalEntryCreate {
+	if v != nil {
+		_c.SetMemo(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *JournalEntryCreate) SetCreatedAt(v time.Time) *JournalEntryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *JournalEntryCreate) SetNillableCreatedAt(v *time.Time) *JournalEntryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_c *JournalEntryCreate) SetWorkspace(v *Workspace) *JournalEntryCreate {
+	return _c.SetWorkspaceID(v.ID)
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_c *JournalEntryCreate) SetTransaction(v *Transaction) *JournalEntryCreate {
+	return _c.SetTransactionID(v.ID)
+}
+
+// AddPostingIDs adds the "postings" edge to the Posting entity by IDs.
+func (_c *JournalEntryCreate) AddPostingIDs(ids ...int) *JournalEntryCreate {
+	_c.mutation.AddPostingIDs(ids...)
+	return _c
+}
+
+// AddPostings adds the "postings" edges to the Posting entity.
+func (_c *JournalEntryCreate) AddPostings(v ...*Posting) *JournalEntryCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPostingIDs(ids...)
+}
+
+// Mutation returns the JournalEntryMutation object of the builder.
+func (_c *JournalEntryCreate) Mutation() *JournalEntryMutation {
+	return _c.mutation
+}
+
+// Save creates the JournalEntry in the database.
+func (_c *JournalEntryCreate) Save(ctx context.Context) (*JournalEntry, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *JournalEntryCreate) SaveX(ctx context.Context) *JournalEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JournalEntryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JournalEntryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *JournalEntryCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if journalentry.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized journalentry.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := journalentry.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *JournalEntryCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "JournalEntry.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.PostedOn(); !ok {
+		return &ValidationError{Name: "posted_on", err: errors.New(`ent: missing required field "JournalEntry.posted_on"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "JournalEntry.created_at"`)}
+	}
+	if len(_c.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "JournalEntry.workspace"`)}
+	}
+	return nil
+}
+
+func (_c *JournalEntryCreate) sqlSave(ctx context.Context) (*JournalEntry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *JournalEntryCreate) createSpec() (*JournalEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JournalEntry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(journalentry.Table, sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.PostedOn(); ok {
+		_spec.SetField(journalentry.FieldPostedOn, field.TypeTime, value)
+		_node.PostedOn = value
+	}
+	if value, ok := _c.mutation.Memo(); ok {
+		_spec.SetField(journalentry.FieldMemo, field.TypeString, value)
+		_node.Memo = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(journalentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   journalentry.WorkspaceTable,
+			Columns: []string{journalentry.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   journalentry.TransactionTable,
+			Columns: []string{journalentry.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TransactionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// JournalEntryCreateBulk is the builder for creating many JournalEntry entities in bulk.
+type JournalEntryCreateBulk struct {
+	config
+	err      error
+	builders []*JournalEntryCreate
+}
+
+// Save creates the JournalEntry entities in the database.
+func (_c *JournalEntryCreateBulk) Save(ctx context.Context) ([]*JournalEntry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*JournalEntry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JournalEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *JournalEntryCreateBulk) SaveX(ctx context.Context) []*JournalEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JournalEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JournalEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,584 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/journalentry"
+	"backend/internal/infrastructure/ent/posting"
+	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/transaction"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JournalEntryUpdate is the builder for updating JournalEntry entities.
+type JournalEntryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *JournalEntryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the JournalEntryUpdate builder.
+func (_u *JournalEntryUpdate) Where(ps ...predicate.JournalEntry) *JournalEntryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_u *JournalEntryUpdate) SetTransactionID(v int) *JournalEntryUpdate {
+	_u.mutation.SetTransactionID(v)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_u *JournalEntryUpdate) SetNillableTransactionID(v *int) *JournalEntryUpdate {
+	if v != nil {
+		_u.SetTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (_u *JournalEntryUpdate) ClearTransactionID() *JournalEntryUpdate {
+	_u.mutation.ClearTransactionID()
+	return _u
+}
+
+// SetPostedOn sets the "posted_on" field.
+func (_u *JournalEntryUpdate) SetPostedOn(v time.Time) *JournalEntryUpdate {
+	_u.mutation.SetPostedOn(v)
+	return _u
+}
+
+// SetNillablePostedOn sets the "posted_on" field if the given value is not nil.
+func (_u *JournalEntryUpdate) SetNillablePostedOn(v *time.Time) *JournalEntryUpdate {
+	if v != nil {
+		_u.SetPostedOn(*v)
+	}
+	return _u
+}
+
+// SetMemo sets the "memo" field.
+func (_u *JournalEntryUpdate) SetMemo(v string) *JournalEntryUpdate {
+	_u.mutation.SetMemo(v)
+	return _u
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_u *JournalEntryUpdate) SetNillableMemo(v *string) *JournalEntryUpdate {
+	if v != nil {
+		_u.SetMemo(*v)
+	}
+	return _u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (_u *JournalEntryUpdate) ClearMemo() *JournalEntryUpdate {
+	_u.mutation.ClearMemo()
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *JournalEntryUpdate) SetTransaction(v *Transaction) *JournalEntryUpdate {
+	return _u.SetTransactionID(v.ID)
+}
+
+// AddPostingIDs adds the "postings" edge to the Posting entity by IDs.
+func (_u *JournalEntryUpdate) AddPostingIDs(ids ...int) *JournalEntryUpdate {
+	_u.mutation.AddPostingIDs(ids...)
+	return _u
+}
+
+// AddPostings adds the "postings" edges to the Posting entity.
+func (_u *JournalEntryUpdate) AddPostings(v ...*Posting) *JournalEntryUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPostingIDs(ids...)
+}
+
+// Mutation returns the JournalEntryMutation object of the builder.
+func (_u *JournalEntryUpdate) Mutation() *JournalEntryMutation {
+	return _u.mutation
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *JournalEntryUpdate) ClearTransaction() *JournalEntryUpdate {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// ClearPostings clears all "postings" edges to the Posting entity.
+func (_u *JournalEntryUpdate) ClearPostings() *JournalEntryUpdate {
+	_u.mutation.ClearPostings()
+	return _u
+}
+
+// RemovePostingIDs removes the "postings" edge to Posting entities by IDs.
+func (_u *JournalEntryUpdate) RemovePostingIDs(ids ...int) *JournalEntryUpdate {
+	_u.mutation.RemovePostingIDs(ids...)
+	return _u
+}
+
+// RemovePostings removes "postings" edges to Posting entities.
+func (_u *JournalEntryUpdate) RemovePostings(v ...*Posting) *JournalEntryUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePostingIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *JournalEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JournalEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *JournalEntryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JournalEntryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *JournalEntryUpdate) check() error {
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "JournalEntry.workspace"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *JournalEntryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *JournalEntryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *JournalEntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(journalentry.Table, journalentry.Columns, sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PostedOn(); ok {
+		_spec.SetField(journalentry.FieldPostedOn, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Memo(); ok {
+		_spec.SetField(journalentry.FieldMemo, field.TypeString, value)
+	}
+	if _u.mutation.MemoCleared() {
+		_spec.ClearField(journalentry.FieldMemo, field.TypeString)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   journalentry.TransactionTable,
+			Columns: []string{journalentry.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   journalentry.TransactionTable,
+			Columns: []string{journalentry.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPostingsIDs(); len(nodes) > 0 && !_u.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{journalentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// JournalEntryUpdateOne is the builder for updating a single JournalEntry entity.
+type JournalEntryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *JournalEntryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_u *JournalEntryUpdateOne) SetTransactionID(v int) *JournalEntryUpdateOne {
+	_u.mutation.SetTransactionID(v)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_u *JournalEntryUpdateOne) SetNillableTransactionID(v *int) *JournalEntryUpdateOne {
+	if v != nil {
+		_u.SetTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (_u *JournalEntryUpdateOne) ClearTransactionID() *JournalEntryUpdateOne {
+	_u.mutation.ClearTransactionID()
+	return _u
+}
+
+// SetPostedOn sets the "posted_on" field.
+func (_u *JournalEntryUpdateOne) SetPostedOn(v time.Time) *JournalEntryUpdateOne {
+	_u.mutation.SetPostedOn(v)
+	return _u
+}
+
+// SetNillablePostedOn sets the "posted_on" field if the given value is not nil.
+func (_u *JournalEntryUpdateOne) SetNillablePostedOn(v *time.Time) *JournalEntryUpdateOne {
+	if v != nil {
+		_u.SetPostedOn(*v)
+	}
+	return _u
+}
+
+// SetMemo sets the "memo" field.
+func (_u *JournalEntryUpdateOne) SetMemo(v string) *JournalEntryUpdateOne {
+	_u.mutation.SetMemo(v)
+	return _u
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_u *JournalEntryUpdateOne) SetNillableMemo(v *string) *JournalEntryUpdateOne {
+	if v != nil {
+		_u.SetMemo(*v)
+	}
+	return _u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (_u *JournalEntryUpdateOne) ClearMemo() *JournalEntryUpdateOne {
+	_u.mutation.ClearMemo()
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *JournalEntryUpdateOne) SetTransaction(v *Transaction) *JournalEntryUpdateOne {
+	return _u.SetTransactionID(v.ID)
+}
+
+// AddPostingIDs adds the "postings" edge to the Posting entity by IDs.
+func (_u *JournalEntryUpdateOne) AddPostingIDs(ids ...int) *JournalEntryUpdateOne {
+	_u.mutation.AddPostingIDs(ids...)
+	return _u
+}
+
+// AddPostings adds the "postings" edges to the Posting entity.
+func (_u *JournalEntryUpdateOne) AddPostings(v ...*Posting) *JournalEntryUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPostingIDs(ids...)
+}
+
+// Mutation returns the JournalEntryMutation object of the builder.
+func (_u *JournalEntryUpdateOne) Mutation() *JournalEntryMutation {
+	return _u.mutation
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *JournalEntryUpdateOne) ClearTransaction() *JournalEntryUpdateOne {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// ClearPostings clears all "postings" edges to the Posting entity.
+func (_u *JournalEntryUpdateOne) ClearPostings() *JournalEntryUpdateOne {
+	_u.mutation.ClearPostings()
+	return _u
+}
+
+// RemovePostingIDs removes the "postings" edge to Posting entities by IDs.
+func (_u *JournalEntryUpdateOne) RemovePostingIDs(ids ...int) *JournalEntryUpdateOne {
+	_u.mutation.RemovePostingIDs(ids...)
+	return _u
+}
+
+// RemovePostings removes "postings" edges to Posting entities.
+func (_u *JournalEntryUpdateOne) RemovePostings(v ...*Posting) *JournalEntryUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePostingIDs(ids...)
+}
+
+// Where appends a list predicates to the JournalEntryUpdate builder.
+func (_u *JournalEntryUpdateOne) Where(ps ...predicate.JournalEntry) *JournalEntryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *JournalEntryUpdateOne) Select(field string, fields ...string) *JournalEntryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated JournalEntry entity.
+func (_u *JournalEntryUpdateOne) Save(ctx context.Context) (*JournalEntry, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JournalEntryUpdateOne) SaveX(ctx context.Context) *JournalEntry {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *JournalEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JournalEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *JournalEntryUpdateOne) check() error {
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "JournalEntry.workspace"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *JournalEntryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *JournalEntryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *JournalEntryUpdateOne) sqlSave(ctx context.Context) (_node *JournalEntry, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(journalentry.Table, journalentry.Columns, sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "JournalEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, journalentry.FieldID)
+		for _, f := range fields {
+			if !journalentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != journalentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PostedOn(); ok {
+		_spec.SetField(journalentry.FieldPostedOn, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Memo(); ok {
+		_spec.SetField(journalentry.FieldMemo, field.TypeString, value)
+	}
+	if _u.mutation.MemoCleared() {
+		_spec.ClearField(journalentry.FieldMemo, field.TypeString)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   journalentry.TransactionTable,
+			Columns: []string{journalentry.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   journalentry.TransactionTable,
+			Columns: []string{journalentry.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPostingsIDs(); len(nodes) > 0 && !_u.mutation.PostingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   journalentry.PostingsTable,
+			Columns: []string{journalentry.PostingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &JournalEntry{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{journalentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
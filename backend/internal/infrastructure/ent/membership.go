@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/membership"
+	"backend/internal/infrastructure/ent/user"
+	"backend/internal/infrastructure/ent/workspace"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Membership is the model entity for the Membership schema.
+type Membership struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Role holds the value of the "role" field.
+	Role membership.Role `json:"role,omitempty"`
+	// JoinedAt holds the value of the "joined_at" field.
+	JoinedAt time.Time `json:"joined_at,omitempty"`
+	// InvitedByID holds the value of the "invited_by_id" field.
+	InvitedByID *int `json:"invited_by_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MembershipQuery when eager-loading is set.
+	Edges        MembershipEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MembershipEdges holds the relations/edges for other nodes in the graph.
+type MembershipEdges struct {
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// InvitedBy holds the value of the invited_by edge.
+	InvitedBy *User `json:"invited_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MembershipEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MembershipEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// InvitedByOrErr returns the InvitedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MembershipEdges) InvitedByOrErr() (*User, error) {
+	if e.InvitedBy != nil {
+		return e.InvitedBy, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "invited_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Membership) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case membership.FieldID, membership.FieldWorkspaceID, membership.FieldUserID, membership.FieldInvitedByID:
+			values[i] = new(sql.NullInt64)
+		case membership.FieldRole:
+			values[i] = new(sql.NullString)
+		case membership.FieldJoinedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Membership fields.
+func (_m *Membership) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case membership.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case membership.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				_m.WorkspaceID = int(value.Int64)
+			}
+		case membership.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case membership.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = membership.Role(value.String)
+			}
+		case membership.FieldJoinedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field joined_at", values[i])
+			} else if value.Valid {
+				_m.JoinedAt = value.Time
+			}
+		case membership.FieldInvitedByID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invited_by_id", values[i])
+			} else if value.Valid {
+				_m.InvitedByID = new(int)
+				*_m.InvitedByID = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Membership.
+// This includes values selected through modifiers, order, etc.
+func (_m *Membership) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWorkspace queries the "workspace" edge of the Membership entity.
+func (_m *Membership) QueryWorkspace() *WorkspaceQuery {
+	return NewMembershipClient(_m.config).QueryWorkspace(_m)
+}
+
+// QueryUser queries the "user" edge of the Membership entity.
+func (_m *Membership) QueryUser() *UserQuery {
+	return NewMembershipClient(_m.config).QueryUser(_m)
+}
+
+// QueryInvitedBy queries the "invited_by" edge of the Membership entity.
+func (_m *Membership) QueryInvitedBy() *UserQuery {
+	return NewMembershipClient(_m.config).QueryInvitedBy(_m)
+}
+
+// Update returns a builder for updating this Membership.
+// Note that you need to call Membership.Unwrap() before calling this method if this Membership
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Membership) Update() *MembershipUpdateOne {
+	return NewMembershipClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Membership entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Membership) Unwrap() *Membership {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Membership is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Membership) String() string {
+	var builder strings.Builder
+	builder.WriteString("Membership(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteString(", ")
+	builder.WriteString("joined_at=")
+	builder.WriteString(_m.JoinedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.InvitedByID; v != nil {
+		builder.WriteString("invited_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Memberships is a parsable slice of Membership.
+type Memberships []*Membership
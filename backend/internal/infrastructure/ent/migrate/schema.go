@@ -3,16 +3,834 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// AccountsColumns holds the columns for the "accounts" table.
+	AccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"bank", "credit_card", "cash", "loan", "investment", "income", "expense", "transfer"}},
+		{Name: "institution", Type: field.TypeString, Nullable: true},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "opening_balance", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(24,4)", "sqlite3": "numeric"}},
+		{Name: "opened_on", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "closed_on", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "archived", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// AccountsTable holds the schema information for the "accounts" table.
+	AccountsTable = &schema.Table{
+		Name:       "accounts",
+		Columns:    AccountsColumns,
+		PrimaryKey: []*schema.Column{AccountsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "accounts_workspaces_accounts",
+				Columns:    []*schema.Column{AccountsColumns[11]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "account_workspace_id_archived",
+				Unique:  false,
+				Columns: []*schema.Column{AccountsColumns[11], AccountsColumns[8]},
+			},
+			{
+				Name:    "account_workspace_id_type_currency",
+				Unique:  true,
+				Columns: []*schema.Column{AccountsColumns[11], AccountsColumns[2], AccountsColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "type IN ('income', 'expense', 'transfer')",
+				},
+			},
+		},
+	}
+	// CategoriesColumns holds the columns for the "categories" table.
+	CategoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"income", "expense", "transfer"}},
+		{Name: "color", Type: field.TypeString, Nullable: true},
+		{Name: "icon", Type: field.TypeString, Nullable: true},
+		{Name: "archived", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// CategoriesTable holds the schema information for the "categories" table.
+	CategoriesTable = &schema.Table{
+		Name:       "categories",
+		Columns:    CategoriesColumns,
+		PrimaryKey: []*schema.Column{CategoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "categories_categories_children",
+				Columns:    []*schema.Column{CategoriesColumns[8]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "categories_workspaces_categories",
+				Columns:    []*schema.Column{CategoriesColumns[9]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "category_workspace_id_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{CategoriesColumns[9], CategoriesColumns[8]},
+			},
+		},
+	}
+	// EmailVerificationTokensColumns holds the columns for the "email_verification_tokens" table.
+	EmailVerificationTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// EmailVerificationTokensTable holds the schema information for the "email_verification_tokens" table.
+	EmailVerificationTokensTable = &schema.Table{
+		Name:       "email_verification_tokens",
+		Columns:    EmailVerificationTokensColumns,
+		PrimaryKey: []*schema.Column{EmailVerificationTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "email_verification_tokens_users_email_verification_tokens",
+				Columns:    []*schema.Column{EmailVerificationTokensColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "emailverificationtoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{EmailVerificationTokensColumns[5]},
+			},
+		},
+	}
+	// ImportDecisionsColumns holds the columns for the "import_decisions" table.
+	ImportDecisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "fingerprint", Type: field.TypeString},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"imported", "merged"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeInt},
+		{Name: "statement_import_id", Type: field.TypeInt, Nullable: true},
+		{Name: "transaction_id", Type: field.TypeInt},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// ImportDecisionsTable holds the schema information for the "import_decisions" table.
+	ImportDecisionsTable = &schema.Table{
+		Name:       "import_decisions",
+		Columns:    ImportDecisionsColumns,
+		PrimaryKey: []*schema.Column{ImportDecisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "import_decisions_accounts_import_decisions",
+				Columns:    []*schema.Column{ImportDecisionsColumns[4]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "import_decisions_statement_imports_decisions",
+				Columns:    []*schema.Column{ImportDecisionsColumns[5]},
+				RefColumns: []*schema.Column{StatementImportsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "import_decisions_transactions_import_decisions",
+				Columns:    []*schema.Column{ImportDecisionsColumns[6]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "import_decisions_workspaces_import_decisions",
+				Columns:    []*schema.Column{ImportDecisionsColumns[7]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "importdecision_account_id_fingerprint",
+				Unique:  true,
+				Columns: []*schema.Column{ImportDecisionsColumns[4], ImportDecisionsColumns[1]},
+			},
+			{
+				Name:    "importdecision_transaction_id",
+				Unique:  false,
+				Columns: []*schema.Column{ImportDecisionsColumns[6]},
+			},
+		},
+	}
+	// ImportProfilesColumns holds the columns for the "import_profiles" table.
+	ImportProfilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "mapping", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeInt},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// ImportProfilesTable holds the schema information for the "import_profiles" table.
+	ImportProfilesTable = &schema.Table{
+		Name:       "import_profiles",
+		Columns:    ImportProfilesColumns,
+		PrimaryKey: []*schema.Column{ImportProfilesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "import_profiles_accounts_import_profiles",
+				Columns:    []*schema.Column{ImportProfilesColumns[5]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "import_profiles_workspaces_import_profiles",
+				Columns:    []*schema.Column{ImportProfilesColumns[6]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "importprofile_account_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{ImportProfilesColumns[5], ImportProfilesColumns[1]},
+			},
+		},
+	}
+	// JournalEntriesColumns holds the columns for the "journal_entries" table.
+	JournalEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "posted_on", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "memo", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "transaction_id", Type: field.TypeInt, Unique: true, Nullable: true},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// JournalEntriesTable holds the schema information for the "journal_entries" table.
+	JournalEntriesTable = &schema.Table{
+		Name:       "journal_entries",
+		Columns:    JournalEntriesColumns,
+		PrimaryKey: []*schema.Column{JournalEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "journal_entries_transactions_journal_entry",
+				Columns:    []*schema.Column{JournalEntriesColumns[4]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "journal_entries_workspaces_journal_entries",
+				Columns:    []*schema.Column{JournalEntriesColumns[5]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "journalentry_transaction_id",
+				Unique:  true,
+				Columns: []*schema.Column{JournalEntriesColumns[4]},
+			},
+		},
+	}
+	// MembershipsColumns holds the columns for the "memberships" table.
+	MembershipsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "admin", "editor", "viewer"}, Default: "viewer"},
+		{Name: "joined_at", Type: field.TypeTime},
+		{Name: "workspace_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "invited_by_id", Type: field.TypeInt, Nullable: true},
+	}
+	// MembershipsTable holds the schema information for the "memberships" table.
+	MembershipsTable = &schema.Table{
+		Name:       "memberships",
+		Columns:    MembershipsColumns,
+		PrimaryKey: []*schema.Column{MembershipsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "memberships_workspaces_workspace",
+				Columns:    []*schema.Column{MembershipsColumns[3]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "memberships_users_user",
+				Columns:    []*schema.Column{MembershipsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "memberships_users_invited_by",
+				Columns:    []*schema.Column{MembershipsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "membership_workspace_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{MembershipsColumns[3], MembershipsColumns[4]},
+			},
+			{
+				Name:    "membership_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{MembershipsColumns[4]},
+			},
+		},
+	}
+	// PasswordResetTokensColumns holds the columns for the "password_reset_tokens" table.
+	PasswordResetTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// PasswordResetTokensTable holds the schema information for the "password_reset_tokens" table.
+	PasswordResetTokensTable = &schema.Table{
+		Name:       "password_reset_tokens",
+		Columns:    PasswordResetTokensColumns,
+		PrimaryKey: []*schema.Column{PasswordResetTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "password_reset_tokens_users_password_reset_tokens",
+				Columns:    []*schema.Column{PasswordResetTokensColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "passwordresettoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PasswordResetTokensColumns[5]},
+			},
+		},
+	}
+	// PayeesColumns holds the columns for the "payees" table.
+	PayeesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "aliases", Type: field.TypeJSON, Nullable: true},
+		{Name: "logo_url", Type: field.TypeString, Nullable: true},
+		{Name: "website", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "default_category_id", Type: field.TypeInt, Nullable: true},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// PayeesTable holds the schema information for the "payees" table.
+	PayeesTable = &schema.Table{
+		Name:       "payees",
+		Columns:    PayeesColumns,
+		PrimaryKey: []*schema.Column{PayeesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payees_categories_payees",
+				Columns:    []*schema.Column{PayeesColumns[7]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "payees_workspaces_payees",
+				Columns:    []*schema.Column{PayeesColumns[8]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "payee_workspace_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{PayeesColumns[8], PayeesColumns[1]},
+			},
+		},
+	}
+	// PostingsColumns holds the columns for the "postings" table.
+	PostingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(24,4)", "sqlite3": "numeric"}},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "account_id", Type: field.TypeInt},
+		{Name: "journal_entry_id", Type: field.TypeInt},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// PostingsTable holds the schema information for the "postings" table.
+	PostingsTable = &schema.Table{
+		Name:       "postings",
+		Columns:    PostingsColumns,
+		PrimaryKey: []*schema.Column{PostingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "postings_accounts_postings",
+				Columns:    []*schema.Column{PostingsColumns[3]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "postings_journal_entries_postings",
+				Columns:    []*schema.Column{PostingsColumns[4]},
+				RefColumns: []*schema.Column{JournalEntriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "postings_workspaces_postings",
+				Columns:    []*schema.Column{PostingsColumns[5]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "posting_journal_entry_id",
+				Unique:  false,
+				Columns: []*schema.Column{PostingsColumns[4]},
+			},
+			{
+				Name:    "posting_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{PostingsColumns[3]},
+			},
+		},
+	}
+	// RecoveryCodesColumns holds the columns for the "recovery_codes" table.
+	RecoveryCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code_hash", Type: field.TypeString},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// RecoveryCodesTable holds the schema information for the "recovery_codes" table.
+	RecoveryCodesTable = &schema.Table{
+		Name:       "recovery_codes",
+		Columns:    RecoveryCodesColumns,
+		PrimaryKey: []*schema.Column{RecoveryCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recovery_codes_users_recovery_codes",
+				Columns:    []*schema.Column{RecoveryCodesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "recoverycode_user_id_code_hash",
+				Unique:  true,
+				Columns: []*schema.Column{RecoveryCodesColumns[4], RecoveryCodesColumns[1]},
+			},
+		},
+	}
+	// RecurrenceOccurrencesColumns holds the columns for the "recurrence_occurrences" table.
+	RecurrenceOccurrencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "due_on", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"scheduled", "posted", "skipped"}, Default: "scheduled"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "rule_id", Type: field.TypeInt},
+		{Name: "transaction_id", Type: field.TypeInt, Unique: true, Nullable: true},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// RecurrenceOccurrencesTable holds the schema information for the "recurrence_occurrences" table.
+	RecurrenceOccurrencesTable = &schema.Table{
+		Name:       "recurrence_occurrences",
+		Columns:    RecurrenceOccurrencesColumns,
+		PrimaryKey: []*schema.Column{RecurrenceOccurrencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recurrence_occurrences_recurrence_rules_occurrences",
+				Columns:    []*schema.Column{RecurrenceOccurrencesColumns[5]},
+				RefColumns: []*schema.Column{RecurrenceRulesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "recurrence_occurrences_transactions_recurrence_occurrence",
+				Columns:    []*schema.Column{RecurrenceOccurrencesColumns[6]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "recurrence_occurrences_workspaces_recurrence_occurrences",
+				Columns:    []*schema.Column{RecurrenceOccurrencesColumns[7]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "recurrenceoccurrence_rule_id_due_on",
+				Unique:  true,
+				Columns: []*schema.Column{RecurrenceOccurrencesColumns[5], RecurrenceOccurrencesColumns[1]},
+			},
+			{
+				Name:    "recurrenceoccurrence_workspace_id_status_due_on",
+				Unique:  false,
+				Columns: []*schema.Column{RecurrenceOccurrencesColumns[7], RecurrenceOccurrencesColumns[2], RecurrenceOccurrencesColumns[1]},
+			},
+		},
+	}
+	// RecurrenceRulesColumns holds the columns for the "recurrence_rules" table.
+	RecurrenceRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(24,4)", "sqlite3": "numeric"}},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "payee", Type: field.TypeString, Nullable: true},
+		{Name: "memo", Type: field.TypeString, Nullable: true},
+		{Name: "frequency", Type: field.TypeEnum, Enums: []string{"daily", "weekly", "monthly", "yearly"}},
+		{Name: "interval", Type: field.TypeInt, Default: 1},
+		{Name: "by_day", Type: field.TypeJSON, Nullable: true},
+		{Name: "starts_on", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "ends_on", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "scheduled_from", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeInt},
+		{Name: "category_id", Type: field.TypeInt, Nullable: true},
+		{Name: "payee_id", Type: field.TypeInt, Nullable: true},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// RecurrenceRulesTable holds the schema information for the "recurrence_rules" table.
+	RecurrenceRulesTable = &schema.Table{
+		Name:       "recurrence_rules",
+		Columns:    RecurrenceRulesColumns,
+		PrimaryKey: []*schema.Column{RecurrenceRulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recurrence_rules_accounts_recurrence_rules",
+				Columns:    []*schema.Column{RecurrenceRulesColumns[13]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "recurrence_rules_categories_recurrence_rules",
+				Columns:    []*schema.Column{RecurrenceRulesColumns[14]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "recurrence_rules_payees_recurrence_rules",
+				Columns:    []*schema.Column{RecurrenceRulesColumns[15]},
+				RefColumns: []*schema.Column{PayeesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "recurrence_rules_workspaces_recurrence_rules",
+				Columns:    []*schema.Column{RecurrenceRulesColumns[16]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "data", Type: field.TypeBytes},
+		{Name: "user_agent", Type: field.TypeString, Default: ""},
+		{Name: "ip_address", Type: field.TypeString, Default: ""},
+		{Name: "last_seen_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_sessions",
+				Columns:    []*schema.Column{SessionsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "session_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[9]},
+			},
+			{
+				Name:    "session_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[6]},
+			},
+		},
+	}
+	// StatementImportsColumns holds the columns for the "statement_imports" table.
+	StatementImportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"csv", "ofx", "camt053", "mt940"}},
+		{Name: "filename", Type: field.TypeString, Nullable: true},
+		{Name: "content", Type: field.TypeBytes},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "committed"}, Default: "pending"},
+		{Name: "created_count", Type: field.TypeInt, Default: 0},
+		{Name: "skipped_count", Type: field.TypeInt, Default: 0},
+		{Name: "merged_count", Type: field.TypeInt, Default: 0},
+		{Name: "failed_count", Type: field.TypeInt, Default: 0},
+		{Name: "committed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeInt},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// StatementImportsTable holds the schema information for the "statement_imports" table.
+	StatementImportsTable = &schema.Table{
+		Name:       "statement_imports",
+		Columns:    StatementImportsColumns,
+		PrimaryKey: []*schema.Column{StatementImportsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "statement_imports_accounts_statement_imports",
+				Columns:    []*schema.Column{StatementImportsColumns[12]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "statement_imports_workspaces_statement_imports",
+				Columns:    []*schema.Column{StatementImportsColumns[13]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "statementimport_workspace_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{StatementImportsColumns[13], StatementImportsColumns[10]},
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "color", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tags_workspaces_tags",
+				Columns:    []*schema.Column{TagsColumns[5]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tag_workspace_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{TagsColumns[5], TagsColumns[1]},
+			},
+		},
+	}
+	// TransactionsColumns holds the columns for the "transactions" table.
+	TransactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "posted_on", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(24,4)", "sqlite3": "numeric"}},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "payee", Type: field.TypeString, Nullable: true},
+		{Name: "memo", Type: field.TypeString, Nullable: true},
+		{Name: "external_id", Type: field.TypeString, Nullable: true},
+		{Name: "value_date", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "counterparty_iban", Type: field.TypeString, Nullable: true},
+		{Name: "end_to_end_id", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"uncleared", "cleared", "reconciled"}, Default: "uncleared"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeInt},
+		{Name: "category_id", Type: field.TypeInt, Nullable: true},
+		{Name: "payee_id", Type: field.TypeInt, Nullable: true},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// TransactionsTable holds the schema information for the "transactions" table.
+	TransactionsTable = &schema.Table{
+		Name:       "transactions",
+		Columns:    TransactionsColumns,
+		PrimaryKey: []*schema.Column{TransactionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "transactions_accounts_transactions",
+				Columns:    []*schema.Column{TransactionsColumns[13]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "transactions_categories_transactions",
+				Columns:    []*schema.Column{TransactionsColumns[14]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "transactions_payees_transactions",
+				Columns:    []*schema.Column{TransactionsColumns[15]},
+				RefColumns: []*schema.Column{PayeesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "transactions_workspaces_transactions",
+				Columns:    []*schema.Column{TransactionsColumns[16]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "transaction_workspace_id_posted_on",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[16], TransactionsColumns[1]},
+			},
+			{
+				Name:    "transaction_account_id_posted_on",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[13], TransactionsColumns[1]},
+			},
+			{
+				Name:    "transaction_category_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[14]},
+			},
+			{
+				Name:    "transaction_payee_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[15]},
+			},
+			{
+				Name:    "transaction_account_id_external_id",
+				Unique:  true,
+				Columns: []*schema.Column{TransactionsColumns[13], TransactionsColumns[6]},
+			},
+		},
+	}
+	// TransactionSplitsColumns holds the columns for the "transaction_splits" table.
+	TransactionSplitsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(24,4)", "sqlite3": "numeric"}},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "memo", Type: field.TypeString, Nullable: true},
+		{Name: "category_id", Type: field.TypeInt, Nullable: true},
+		{Name: "transaction_id", Type: field.TypeInt},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// TransactionSplitsTable holds the schema information for the "transaction_splits" table.
+	TransactionSplitsTable = &schema.Table{
+		Name:       "transaction_splits",
+		Columns:    TransactionSplitsColumns,
+		PrimaryKey: []*schema.Column{TransactionSplitsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "transaction_splits_categories_splits",
+				Columns:    []*schema.Column{TransactionSplitsColumns[4]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "transaction_splits_transactions_splits",
+				Columns:    []*schema.Column{TransactionSplitsColumns[5]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "transaction_splits_workspaces_transaction_splits",
+				Columns:    []*schema.Column{TransactionSplitsColumns[6]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "transactionsplit_transaction_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionSplitsColumns[5]},
+			},
+			{
+				Name:    "transactionsplit_category_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionSplitsColumns[4]},
+			},
+		},
+	}
+	// TransfersColumns holds the columns for the "transfers" table.
+	TransfersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "from_fee", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(24,4)", "sqlite3": "numeric"}},
+		{Name: "to_fee", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(24,4)", "sqlite3": "numeric"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "from_transaction_id", Type: field.TypeInt, Unique: true},
+		{Name: "to_transaction_id", Type: field.TypeInt, Unique: true},
+		{Name: "workspace_id", Type: field.TypeInt},
+	}
+	// TransfersTable holds the schema information for the "transfers" table.
+	TransfersTable = &schema.Table{
+		Name:       "transfers",
+		Columns:    TransfersColumns,
+		PrimaryKey: []*schema.Column{TransfersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "transfers_transactions_outgoing_transfer",
+				Columns:    []*schema.Column{TransfersColumns[4]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "transfers_transactions_incoming_transfer",
+				Columns:    []*schema.Column{TransfersColumns[5]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "transfers_workspaces_transfers",
+				Columns:    []*schema.Column{TransfersColumns[6]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "email", Type: field.TypeString, Unique: true},
 		{Name: "password_hash", Type: field.TypeString},
+		{Name: "email_verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true},
+		{Name: "totp_last_used_step", Type: field.TypeInt64, Nullable: true},
+		{Name: "two_factor_enabled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "two_factor_failed_attempts", Type: field.TypeInt, Default: 0},
+		{Name: "two_factor_locked_until", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -26,6 +844,7 @@ var (
 	WorkspacesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString},
+		{Name: "require_verified_email", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -35,40 +854,181 @@ var (
 		Columns:    WorkspacesColumns,
 		PrimaryKey: []*schema.Column{WorkspacesColumns[0]},
 	}
-	// WorkspaceUsersColumns holds the columns for the "workspace_users" table.
-	WorkspaceUsersColumns = []*schema.Column{
+	// WorkspaceInvitationsColumns holds the columns for the "workspace_invitations" table.
+	WorkspaceInvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "email", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "editor", "viewer"}},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "revoked"}, Default: "pending"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "responded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
 		{Name: "workspace_id", Type: field.TypeInt},
-		{Name: "user_id", Type: field.TypeInt},
+		{Name: "invited_by_id", Type: field.TypeInt, Nullable: true},
+		{Name: "accepted_by_id", Type: field.TypeInt, Nullable: true},
 	}
-	// WorkspaceUsersTable holds the schema information for the "workspace_users" table.
-	WorkspaceUsersTable = &schema.Table{
-		Name:       "workspace_users",
-		Columns:    WorkspaceUsersColumns,
-		PrimaryKey: []*schema.Column{WorkspaceUsersColumns[0], WorkspaceUsersColumns[1]},
+	// WorkspaceInvitationsTable holds the schema information for the "workspace_invitations" table.
+	WorkspaceInvitationsTable = &schema.Table{
+		Name:       "workspace_invitations",
+		Columns:    WorkspaceInvitationsColumns,
+		PrimaryKey: []*schema.Column{WorkspaceInvitationsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "workspace_users_workspace_id",
-				Columns:    []*schema.Column{WorkspaceUsersColumns[0]},
+				Symbol:     "workspace_invitations_workspaces_invitations",
+				Columns:    []*schema.Column{WorkspaceInvitationsColumns[8]},
 				RefColumns: []*schema.Column{WorkspacesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
-				Symbol:     "workspace_users_user_id",
-				Columns:    []*schema.Column{WorkspaceUsersColumns[1]},
+				Symbol:     "workspace_invitations_users_invited_by",
+				Columns:    []*schema.Column{WorkspaceInvitationsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "workspace_invitations_users_accepted_by",
+				Columns:    []*schema.Column{WorkspaceInvitationsColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "workspaceinvitation_workspace_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{WorkspaceInvitationsColumns[8], WorkspaceInvitationsColumns[4]},
+			},
+		},
+	}
+	// TagTransactionsColumns holds the columns for the "tag_transactions" table.
+	TagTransactionsColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeInt},
+		{Name: "transaction_id", Type: field.TypeInt},
+	}
+	// TagTransactionsTable holds the schema information for the "tag_transactions" table.
+	TagTransactionsTable = &schema.Table{
+		Name:       "tag_transactions",
+		Columns:    TagTransactionsColumns,
+		PrimaryKey: []*schema.Column{TagTransactionsColumns[0], TagTransactionsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_transactions_tag_id",
+				Columns:    []*schema.Column{TagTransactionsColumns[0]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_transactions_transaction_id",
+				Columns:    []*schema.Column{TagTransactionsColumns[1]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TagSplitsColumns holds the columns for the "tag_splits" table.
+	TagSplitsColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeInt},
+		{Name: "transaction_split_id", Type: field.TypeInt},
+	}
+	// TagSplitsTable holds the schema information for the "tag_splits" table.
+	TagSplitsTable = &schema.Table{
+		Name:       "tag_splits",
+		Columns:    TagSplitsColumns,
+		PrimaryKey: []*schema.Column{TagSplitsColumns[0], TagSplitsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_splits_tag_id",
+				Columns:    []*schema.Column{TagSplitsColumns[0]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_splits_transaction_split_id",
+				Columns:    []*schema.Column{TagSplitsColumns[1]},
+				RefColumns: []*schema.Column{TransactionSplitsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccountsTable,
+		CategoriesTable,
+		EmailVerificationTokensTable,
+		ImportDecisionsTable,
+		ImportProfilesTable,
+		JournalEntriesTable,
+		MembershipsTable,
+		PasswordResetTokensTable,
+		PayeesTable,
+		PostingsTable,
+		RecoveryCodesTable,
+		RecurrenceOccurrencesTable,
+		RecurrenceRulesTable,
+		SessionsTable,
+		StatementImportsTable,
+		TagsTable,
+		TransactionsTable,
+		TransactionSplitsTable,
+		TransfersTable,
 		UsersTable,
 		WorkspacesTable,
-		WorkspaceUsersTable,
+		WorkspaceInvitationsTable,
+		TagTransactionsTable,
+		TagSplitsTable,
 	}
 )
 
 func init() {
-	WorkspaceUsersTable.ForeignKeys[0].RefTable = WorkspacesTable
-	WorkspaceUsersTable.ForeignKeys[1].RefTable = UsersTable
+	AccountsTable.ForeignKeys[0].RefTable = WorkspacesTable
+	CategoriesTable.ForeignKeys[0].RefTable = CategoriesTable
+	CategoriesTable.ForeignKeys[1].RefTable = WorkspacesTable
+	EmailVerificationTokensTable.ForeignKeys[0].RefTable = UsersTable
+	ImportDecisionsTable.ForeignKeys[0].RefTable = AccountsTable
+	ImportDecisionsTable.ForeignKeys[1].RefTable = StatementImportsTable
+	ImportDecisionsTable.ForeignKeys[2].RefTable = TransactionsTable
+	ImportDecisionsTable.ForeignKeys[3].RefTable = WorkspacesTable
+	ImportProfilesTable.ForeignKeys[0].RefTable = AccountsTable
+	ImportProfilesTable.ForeignKeys[1].RefTable = WorkspacesTable
+	JournalEntriesTable.ForeignKeys[0].RefTable = TransactionsTable
+	JournalEntriesTable.ForeignKeys[1].RefTable = WorkspacesTable
+	MembershipsTable.ForeignKeys[0].RefTable = WorkspacesTable
+	MembershipsTable.ForeignKeys[1].RefTable = UsersTable
+	MembershipsTable.ForeignKeys[2].RefTable = UsersTable
+	PasswordResetTokensTable.ForeignKeys[0].RefTable = UsersTable
+	PayeesTable.ForeignKeys[0].RefTable = CategoriesTable
+	PayeesTable.ForeignKeys[1].RefTable = WorkspacesTable
+	PostingsTable.ForeignKeys[0].RefTable = AccountsTable
+	PostingsTable.ForeignKeys[1].RefTable = JournalEntriesTable
+	PostingsTable.ForeignKeys[2].RefTable = WorkspacesTable
+	RecoveryCodesTable.ForeignKeys[0].RefTable = UsersTable
+	RecurrenceOccurrencesTable.ForeignKeys[0].RefTable = RecurrenceRulesTable
+	RecurrenceOccurrencesTable.ForeignKeys[1].RefTable = TransactionsTable
+	RecurrenceOccurrencesTable.ForeignKeys[2].RefTable = WorkspacesTable
+	RecurrenceRulesTable.ForeignKeys[0].RefTable = AccountsTable
+	RecurrenceRulesTable.ForeignKeys[1].RefTable = CategoriesTable
+	RecurrenceRulesTable.ForeignKeys[2].RefTable = PayeesTable
+	RecurrenceRulesTable.ForeignKeys[3].RefTable = WorkspacesTable
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
+	StatementImportsTable.ForeignKeys[0].RefTable = AccountsTable
+	StatementImportsTable.ForeignKeys[1].RefTable = WorkspacesTable
+	TagsTable.ForeignKeys[0].RefTable = WorkspacesTable
+	TransactionsTable.ForeignKeys[0].RefTable = AccountsTable
+	TransactionsTable.ForeignKeys[1].RefTable = CategoriesTable
+	TransactionsTable.ForeignKeys[2].RefTable = PayeesTable
+	TransactionsTable.ForeignKeys[3].RefTable = WorkspacesTable
+	TransactionSplitsTable.ForeignKeys[0].RefTable = CategoriesTable
+	TransactionSplitsTable.ForeignKeys[1].RefTable = TransactionsTable
+	TransactionSplitsTable.ForeignKeys[2].RefTable = WorkspacesTable
+	TransfersTable.ForeignKeys[0].RefTable = TransactionsTable
+	TransfersTable.ForeignKeys[1].RefTable = TransactionsTable
+	TransfersTable.ForeignKeys[2].RefTable = WorkspacesTable
+	WorkspaceInvitationsTable.ForeignKeys[0].RefTable = WorkspacesTable
+	WorkspaceInvitationsTable.ForeignKeys[1].RefTable = UsersTable
+	WorkspaceInvitationsTable.ForeignKeys[2].RefTable = UsersTable
+	TagTransactionsTable.ForeignKeys[0].RefTable = TagsTable
+	TagTransactionsTable.ForeignKeys[1].RefTable = TransactionsTable
+	TagSplitsTable.ForeignKeys[0].RefTable = TagsTable
+	TagSplitsTable.ForeignKeys[1].RefTable = TransactionSplitsTable
 }
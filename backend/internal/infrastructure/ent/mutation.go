@@ -3,9 +3,30 @@
 package ent
 
 import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/ent/emailverificationtoken"
+	"backend/internal/infrastructure/ent/importdecision"
+	"backend/internal/infrastructure/ent/importprofile"
+	"backend/internal/infrastructure/ent/journalentry"
+	"backend/internal/infrastructure/ent/membership"
+	"backend/internal/infrastructure/ent/passwordresettoken"
+	"backend/internal/infrastructure/ent/payee"
+	"backend/internal/infrastructure/ent/posting"
 	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/recoverycode"
+	"backend/internal/infrastructure/ent/recurrenceoccurrence"
+	"backend/internal/infrastructure/ent/recurrencerule"
+	"backend/internal/infrastructure/ent/session"
+	"backend/internal/infrastructure/ent/statementimport"
+	"backend/internal/infrastructure/ent/tag"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/transactionsplit"
+	"backend/internal/infrastructure/ent/transfer"
 	"backend/internal/infrastructure/ent/user"
 	"backend/internal/infrastructure/ent/workspace"
+	"backend/internal/infrastructure/ent/workspaceinvitation"
 	"context"
 	"errors"
 	"fmt"
@@ -25,40 +46,83 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeUser      = "User"
-	TypeWorkspace = "Workspace"
+	TypeAccount                = "Account"
+	TypeCategory               = "Category"
+	TypeEmailVerificationToken = "EmailVerificationToken"
+	TypeImportDecision         = "ImportDecision"
+	TypeImportProfile          = "ImportProfile"
+	TypeJournalEntry           = "JournalEntry"
+	TypeMembership             = "Membership"
+	TypePasswordResetToken     = "PasswordResetToken"
+	TypePayee                  = "Payee"
+	TypePosting                = "Posting"
+	TypeRecoveryCode           = "RecoveryCode"
+	TypeRecurrenceOccurrence   = "RecurrenceOccurrence"
+	TypeRecurrenceRule         = "RecurrenceRule"
+	TypeSession                = "Session"
+	TypeStatementImport        = "StatementImport"
+	TypeTag                    = "Tag"
+	TypeTransaction            = "Transaction"
+	TypeTransactionSplit       = "TransactionSplit"
+	TypeTransfer               = "Transfer"
+	TypeUser                   = "User"
+	TypeWorkspace              = "Workspace"
+	TypeWorkspaceInvitation    = "WorkspaceInvitation"
 )
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// AccountMutation represents an operation that mutates the Account nodes in the graph.
+type AccountMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	email             *string
-	password_hash     *string
-	created_at        *time.Time
-	updated_at        *time.Time
-	clearedFields     map[string]struct{}
-	workspaces        map[int]struct{}
-	removedworkspaces map[int]struct{}
-	clearedworkspaces bool
-	done              bool
-	oldValue          func(context.Context) (*User, error)
-	predicates        []predicate.User
+	op                       Op
+	typ                      string
+	id                       *int
+	name                     *string
+	_type                    *account.Type
+	institution              *string
+	currency                 *string
+	opening_balance          *model.Decimal
+	opened_on                *time.Time
+	closed_on                *time.Time
+	archived                 *bool
+	created_at               *time.Time
+	updated_at               *time.Time
+	clearedFields            map[string]struct{}
+	workspace                *int
+	clearedworkspace         bool
+	transactions             map[int]struct{}
+	removedtransactions      map[int]struct{}
+	clearedtransactions      bool
+	postings                 map[int]struct{}
+	removedpostings          map[int]struct{}
+	clearedpostings          bool
+	recurrence_rules         map[int]struct{}
+	removedrecurrence_rules  map[int]struct{}
+	clearedrecurrence_rules  bool
+	statement_imports        map[int]struct{}
+	removedstatement_imports map[int]struct{}
+	clearedstatement_imports bool
+	import_profiles          map[int]struct{}
+	removedimport_profiles   map[int]struct{}
+	clearedimport_profiles   bool
+	import_decisions         map[int]struct{}
+	removedimport_decisions  map[int]struct{}
+	clearedimport_decisions  bool
+	done                     bool
+	oldValue                 func(context.Context) (*Account, error)
+	predicates               []predicate.Account
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*AccountMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// accountOption allows management of the mutation configuration using functional options.
+type accountOption func(*AccountMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newAccountMutation creates new mutation for the Account entity.
+func newAccountMutation(c config, op Op, opts ...accountOption) *AccountMutation {
+	m := &AccountMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeAccount,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -67,20 +131,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
+// withAccountID sets the ID field of the mutation.
+func withAccountID(id int) accountOption {
+	return func(m *AccountMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *Account
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*Account, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().Account.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -89,10 +153,10 @@ func withUserID(id int) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withAccount sets the old Account of the mutation.
+func withAccount(node *Account) accountOption {
+	return func(m *AccountMutation) {
+		m.oldValue = func(context.Context) (*Account, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -101,7 +165,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m AccountMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -109,7 +173,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m AccountMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -120,7 +184,7 @@ func (m UserMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
+func (m *AccountMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -131,7 +195,7 @@ func (m *UserMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AccountMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -140,321 +204,21120 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Account.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *AccountMutation) SetWorkspaceID(i int) {
+	m.workspace = &i
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *AccountMutation) WorkspaceID() (r int, exists bool) {
+	v := m.workspace
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldWorkspaceID returns the old "workspace_id" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *AccountMutation) OldWorkspaceID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.WorkspaceID, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *AccountMutation) ResetWorkspaceID() {
+	m.workspace = nil
 }
 
-// SetPasswordHash sets the "password_hash" field.
-func (m *UserMutation) SetPasswordHash(s string) {
-	m.password_hash = &s
+// SetName sets the "name" field.
+func (m *AccountMutation) SetName(s string) {
+	m.name = &s
 }
 
-// PasswordHash returns the value of the "password_hash" field in the mutation.
-func (m *UserMutation) PasswordHash() (r string, exists bool) {
-	v := m.password_hash
+// Name returns the value of the "name" field in the mutation.
+func (m *AccountMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPasswordHash returns the old "password_hash" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+func (m *AccountMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.PasswordHash, nil
+	return oldValue.Name, nil
 }
 
-// ResetPasswordHash resets all changes to the "password_hash" field.
-func (m *UserMutation) ResetPasswordHash() {
-	m.password_hash = nil
+// ResetName resets all changes to the "name" field.
+func (m *AccountMutation) ResetName() {
+	m.name = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetType sets the "type" field.
+func (m *AccountMutation) SetType(a account.Type) {
+	m._type = &a
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// GetType returns the value of the "type" field in the mutation.
+func (m *AccountMutation) GetType() (r account.Type, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AccountMutation) OldType(ctx context.Context) (v account.Type, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Type, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetType resets all changes to the "type" field.
+func (m *AccountMutation) ResetType() {
+	m._type = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetInstitution sets the "institution" field.
+func (m *AccountMutation) SetInstitution(s string) {
+	m.institution = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Institution returns the value of the "institution" field in the mutation.
+func (m *AccountMutation) Institution() (r string, exists bool) {
+	v := m.institution
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldInstitution returns the old "institution" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AccountMutation) OldInstitution(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldInstitution is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldInstitution requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldInstitution: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Institution, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearInstitution clears the value of the "institution" field.
+func (m *AccountMutation) ClearInstitution() {
+	m.institution = nil
+	m.clearedFields[account.FieldInstitution] = struct{}{}
 }
 
-// AddWorkspaceIDs adds the "workspaces" edge to the Workspace entity by ids.
-func (m *UserMutation) AddWorkspaceIDs(ids ...int) {
-	if m.workspaces == nil {
-		m.workspaces = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.workspaces[ids[i]] = struct{}{}
-	}
+// InstitutionCleared returns if the "institution" field was cleared in this mutation.
+func (m *AccountMutation) InstitutionCleared() bool {
+	_, ok := m.clearedFields[account.FieldInstitution]
+	return ok
 }
 
-// ClearWorkspaces clears the "workspaces" edge to the Workspace entity.
-func (m *UserMutation) ClearWorkspaces() {
-	m.clearedworkspaces = true
+// ResetInstitution resets all changes to the "institution" field.
+func (m *AccountMutation) ResetInstitution() {
+	m.institution = nil
+	delete(m.clearedFields, account.FieldInstitution)
 }
 
-// WorkspacesCleared reports if the "workspaces" edge to the Workspace entity was cleared.
-func (m *UserMutation) WorkspacesCleared() bool {
-	return m.clearedworkspaces
+// SetCurrency sets the "currency" field.
+func (m *AccountMutation) SetCurrency(s string) {
+	m.currency = &s
 }
 
-// RemoveWorkspaceIDs removes the "workspaces" edge to the Workspace entity by IDs.
-func (m *UserMutation) RemoveWorkspaceIDs(ids ...int) {
-	if m.removedworkspaces == nil {
-		m.removedworkspaces = make(map[int]struct{})
+// Currency returns the value of the "currency" field in the mutation.
+func (m *AccountMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.workspaces, ids[i])
-		m.removedworkspaces[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
 	}
+	return oldValue.Currency, nil
 }
 
-// RemovedWorkspaces returns the removed IDs of the "workspaces" edge to the Workspace entity.
-func (m *UserMutation) RemovedWorkspacesIDs() (ids []int) {
-	for id := range m.removedworkspaces {
-		ids = append(ids, id)
+// ResetCurrency resets all changes to the "currency" field.
+func (m *AccountMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetOpeningBalance sets the "opening_balance" field.
+func (m *AccountMutation) SetOpeningBalance(value model.Decimal) {
+	m.opening_balance = &value
+}
+
+// OpeningBalance returns the value of the "opening_balance" field in the mutation.
+func (m *AccountMutation) OpeningBalance() (r model.Decimal, exists bool) {
+	v := m.opening_balance
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// WorkspacesIDs returns the "workspaces" edge IDs in the mutation.
-func (m *UserMutation) WorkspacesIDs() (ids []int) {
-	for id := range m.workspaces {
-		ids = append(ids, id)
+// OldOpeningBalance returns the old "opening_balance" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldOpeningBalance(ctx context.Context) (v model.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpeningBalance is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpeningBalance requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpeningBalance: %w", err)
+	}
+	return oldValue.OpeningBalance, nil
 }
 
-// ResetWorkspaces resets all changes to the "workspaces" edge.
-func (m *UserMutation) ResetWorkspaces() {
-	m.workspaces = nil
-	m.clearedworkspaces = false
-	m.removedworkspaces = nil
+// ResetOpeningBalance resets all changes to the "opening_balance" field.
+func (m *AccountMutation) ResetOpeningBalance() {
+	m.opening_balance = nil
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
-	m.predicates = append(m.predicates, ps...)
+// SetOpenedOn sets the "opened_on" field.
+func (m *AccountMutation) SetOpenedOn(t time.Time) {
+	m.opened_on = &t
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// OpenedOn returns the value of the "opened_on" field in the mutation.
+func (m *AccountMutation) OpenedOn() (r time.Time, exists bool) {
+	v := m.opened_on
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *UserMutation) Op() Op {
-	return m.op
+// OldOpenedOn returns the old "opened_on" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldOpenedOn(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpenedOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpenedOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpenedOn: %w", err)
+	}
+	return oldValue.OpenedOn, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
-	m.op = op
+// ClearOpenedOn clears the value of the "opened_on" field.
+func (m *AccountMutation) ClearOpenedOn() {
+	m.opened_on = nil
+	m.clearedFields[account.FieldOpenedOn] = struct{}{}
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
-	return m.typ
+// OpenedOnCleared returns if the "opened_on" field was cleared in this mutation.
+func (m *AccountMutation) OpenedOnCleared() bool {
+	_, ok := m.clearedFields[account.FieldOpenedOn]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+// ResetOpenedOn resets all changes to the "opened_on" field.
+func (m *AccountMutation) ResetOpenedOn() {
+	m.opened_on = nil
+	delete(m.clearedFields, account.FieldOpenedOn)
+}
+
+// SetClosedOn sets the "closed_on" field.
+func (m *AccountMutation) SetClosedOn(t time.Time) {
+	m.closed_on = &t
+}
+
+// ClosedOn returns the value of the "closed_on" field in the mutation.
+func (m *AccountMutation) ClosedOn() (r time.Time, exists bool) {
+	v := m.closed_on
+	if v == nil {
+		return
 	}
-	if m.password_hash != nil {
-		fields = append(fields, user.FieldPasswordHash)
+	return *v, true
+}
+
+// OldClosedOn returns the old "closed_on" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldClosedOn(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClosedOn is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClosedOn requires an ID field in the mutation")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosedOn: %w", err)
 	}
-	return fields
+	return oldValue.ClosedOn, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldPasswordHash:
-		return m.PasswordHash()
-	case user.FieldCreatedAt:
-		return m.CreatedAt()
-	case user.FieldUpdatedAt:
-		return m.UpdatedAt()
-	}
-	return nil, false
+// ClearClosedOn clears the value of the "closed_on" field.
+func (m *AccountMutation) ClearClosedOn() {
+	m.closed_on = nil
+	m.clearedFields[account.FieldClosedOn] = struct{}{}
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldPasswordHash:
-		return m.OldPasswordHash(ctx)
-	case user.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+// ClosedOnCleared returns if the "closed_on" field was cleared in this mutation.
+func (m *AccountMutation) ClosedOnCleared() bool {
+	_, ok := m.clearedFields[account.FieldClosedOn]
+	return ok
+}
+
+// ResetClosedOn resets all changes to the "closed_on" field.
+func (m *AccountMutation) ResetClosedOn() {
+	m.closed_on = nil
+	delete(m.clearedFields, account.FieldClosedOn)
+}
+
+// SetArchived sets the "archived" field.
+func (m *AccountMutation) SetArchived(b bool) {
+	m.archived = &b
+}
+
+// Archived returns the value of the "archived" field in the mutation.
+func (m *AccountMutation) Archived() (r bool, exists bool) {
+	v := m.archived
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchived returns the old "archived" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldArchived(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchived is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchived requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchived: %w", err)
+	}
+	return oldValue.Archived, nil
+}
+
+// ResetArchived resets all changes to the "archived" field.
+func (m *AccountMutation) ResetArchived() {
+	m.archived = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AccountMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AccountMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AccountMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *AccountMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *AccountMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *AccountMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (m *AccountMutation) ClearWorkspace() {
+	m.clearedworkspace = true
+	m.clearedFields[account.FieldWorkspaceID] = struct{}{}
+}
+
+// WorkspaceCleared reports if the "workspace" edge to the Workspace entity was cleared.
+func (m *AccountMutation) WorkspaceCleared() bool {
+	return m.clearedworkspace
+}
+
+// WorkspaceIDs returns the "workspace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkspaceID instead. It exists only for internal usage by the builders.
+func (m *AccountMutation) WorkspaceIDs() (ids []int) {
+	if id := m.workspace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkspace resets all changes to the "workspace" edge.
+func (m *AccountMutation) ResetWorkspace() {
+	m.workspace = nil
+	m.clearedworkspace = false
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by ids.
+func (m *AccountMutation) AddTransactionIDs(ids ...int) {
+	if m.transactions == nil {
+		m.transactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.transactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTransactions clears the "transactions" edge to the Transaction entity.
+func (m *AccountMutation) ClearTransactions() {
+	m.clearedtransactions = true
+}
+
+// TransactionsCleared reports if the "transactions" edge to the Transaction entity was cleared.
+func (m *AccountMutation) TransactionsCleared() bool {
+	return m.clearedtransactions
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to the Transaction entity by IDs.
+func (m *AccountMutation) RemoveTransactionIDs(ids ...int) {
+	if m.removedtransactions == nil {
+		m.removedtransactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.transactions, ids[i])
+		m.removedtransactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTransactions returns the removed IDs of the "transactions" edge to the Transaction entity.
+func (m *AccountMutation) RemovedTransactionsIDs() (ids []int) {
+	for id := range m.removedtransactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TransactionsIDs returns the "transactions" edge IDs in the mutation.
+func (m *AccountMutation) TransactionsIDs() (ids []int) {
+	for id := range m.transactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTransactions resets all changes to the "transactions" edge.
+func (m *AccountMutation) ResetTransactions() {
+	m.transactions = nil
+	m.clearedtransactions = false
+	m.removedtransactions = nil
+}
+
+// AddPostingIDs adds the "postings" edge to the Posting entity by ids.
+func (m *AccountMutation) AddPostingIDs(ids ...int) {
+	if m.postings == nil {
+		m.postings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.postings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPostings clears the "postings" edge to the Posting entity.
+func (m *AccountMutation) ClearPostings() {
+	m.clearedpostings = true
+}
+
+// PostingsCleared reports if the "postings" edge to the Posting entity was cleared.
+func (m *AccountMutation) PostingsCleared() bool {
+	return m.clearedpostings
+}
+
+// RemovePostingIDs removes the "postings" edge to the Posting entity by IDs.
+func (m *AccountMutation) RemovePostingIDs(ids ...int) {
+	if m.removedpostings == nil {
+		m.removedpostings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.postings, ids[i])
+		m.removedpostings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPostings returns the removed IDs of the "postings" edge to the Posting entity.
+func (m *AccountMutation) RemovedPostingsIDs() (ids []int) {
+	for id := range m.removedpostings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PostingsIDs returns the "postings" edge IDs in the mutation.
+func (m *AccountMutation) PostingsIDs() (ids []int) {
+	for id := range m.postings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPostings resets all changes to the "postings" edge.
+func (m *AccountMutation) ResetPostings() {
+	m.postings = nil
+	m.clearedpostings = false
+	m.removedpostings = nil
+}
+
+// AddRecurrenceRuleIDs adds the "recurrence_rules" edge to the RecurrenceRule entity by ids.
+func (m *AccountMutation) AddRecurrenceRuleIDs(ids ...int) {
+	if m.recurrence_rules == nil {
+		m.recurrence_rules = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.recurrence_rules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRecurrenceRules clears the "recurrence_rules" edge to the RecurrenceRule entity.
+func (m *AccountMutation) ClearRecurrenceRules() {
+	m.clearedrecurrence_rules = true
+}
+
+// RecurrenceRulesCleared reports if the "recurrence_rules" edge to the RecurrenceRule entity was cleared.
+func (m *AccountMutation) RecurrenceRulesCleared() bool {
+	return m.clearedrecurrence_rules
+}
+
+// RemoveRecurrenceRuleIDs removes the "recurrence_rules" edge to the RecurrenceRule entity by IDs.
+func (m *AccountMutation) RemoveRecurrenceRuleIDs(ids ...int) {
+	if m.removedrecurrence_rules == nil {
+		m.removedrecurrence_rules = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.recurrence_rules, ids[i])
+		m.removedrecurrence_rules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRecurrenceRules returns the removed IDs of the "recurrence_rules" edge to the RecurrenceRule entity.
+func (m *AccountMutation) RemovedRecurrenceRulesIDs() (ids []int) {
+	for id := range m.removedrecurrence_rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RecurrenceRulesIDs returns the "recurrence_rules" edge IDs in the mutation.
+func (m *AccountMutation) RecurrenceRulesIDs() (ids []int) {
+	for id := range m.recurrence_rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRecurrenceRules resets all changes to the "recurrence_rules" edge.
+func (m *AccountMutation) ResetRecurrenceRules() {
+	m.recurrence_rules = nil
+	m.clearedrecurrence_rules = false
+	m.removedrecurrence_rules = nil
+}
+
+// AddStatementImportIDs adds the "statement_imports" edge to the StatementImport entity by ids.
+func (m *AccountMutation) AddStatementImportIDs(ids ...int) {
+	if m.statement_imports == nil {
+		m.statement_imports = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.statement_imports[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStatementImports clears the "statement_imports" edge to the StatementImport entity.
+func (m *AccountMutation) ClearStatementImports() {
+	m.clearedstatement_imports = true
+}
+
+// StatementImportsCleared reports if the "statement_imports" edge to the StatementImport entity was cleared.
+func (m *AccountMutation) StatementImportsCleared() bool {
+	return m.clearedstatement_imports
+}
+
+// RemoveStatementImportIDs removes the "statement_imports" edge to the StatementImport entity by IDs.
+func (m *AccountMutation) RemoveStatementImportIDs(ids ...int) {
+	if m.removedstatement_imports == nil {
+		m.removedstatement_imports = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.statement_imports, ids[i])
+		m.removedstatement_imports[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStatementImports returns the removed IDs of the "statement_imports" edge to the StatementImport entity.
+func (m *AccountMutation) RemovedStatementImportsIDs() (ids []int) {
+	for id := range m.removedstatement_imports {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StatementImportsIDs returns the "statement_imports" edge IDs in the mutation.
+func (m *AccountMutation) StatementImportsIDs() (ids []int) {
+	for id := range m.statement_imports {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStatementImports resets all changes to the "statement_imports" edge.
+func (m *AccountMutation) ResetStatementImports() {
+	m.statement_imports = nil
+	m.clearedstatement_imports = false
+	m.removedstatement_imports = nil
+}
+
+// AddImportProfileIDs adds the "import_profiles" edge to the ImportProfile entity by ids.
+func (m *AccountMutation) AddImportProfileIDs(ids ...int) {
+	if m.import_profiles == nil {
+		m.import_profiles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.import_profiles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearImportProfiles clears the "import_profiles" edge to the ImportProfile entity.
+func (m *AccountMutation) ClearImportProfiles() {
+	m.clearedimport_profiles = true
+}
+
+// ImportProfilesCleared reports if the "import_profiles" edge to the ImportProfile entity was cleared.
+func (m *AccountMutation) ImportProfilesCleared() bool {
+	return m.clearedimport_profiles
+}
+
+// RemoveImportProfileIDs removes the "import_profiles" edge to the ImportProfile entity by IDs.
+func (m *AccountMutation) RemoveImportProfileIDs(ids ...int) {
+	if m.removedimport_profiles == nil {
+		m.removedimport_profiles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.import_profiles, ids[i])
+		m.removedimport_profiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedImportProfiles returns the removed IDs of the "import_profiles" edge to the ImportProfile entity.
+func (m *AccountMutation) RemovedImportProfilesIDs() (ids []int) {
+	for id := range m.removedimport_profiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ImportProfilesIDs returns the "import_profiles" edge IDs in the mutation.
+func (m *AccountMutation) ImportProfilesIDs() (ids []int) {
+	for id := range m.import_profiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetImportProfiles resets all changes to the "import_profiles" edge.
+func (m *AccountMutation) ResetImportProfiles() {
+	m.import_profiles = nil
+	m.clearedimport_profiles = false
+	m.removedimport_profiles = nil
+}
+
+// AddImportDecisionIDs adds the "import_decisions" edge to the ImportDecision entity by ids.
+func (m *AccountMutation) AddImportDecisionIDs(ids ...int) {
+	if m.import_decisions == nil {
+		m.import_decisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.import_decisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearImportDecisions clears the "import_decisions" edge to the ImportDecision entity.
+func (m *AccountMutation) ClearImportDecisions() {
+	m.clearedimport_decisions = true
+}
+
+// ImportDecisionsCleared reports if the "import_decisions" edge to the ImportDecision entity was cleared.
+func (m *AccountMutation) ImportDecisionsCleared() bool {
+	return m.clearedimport_decisions
+}
+
+// RemoveImportDecisionIDs removes the "import_decisions" edge to the ImportDecision entity by IDs.
+func (m *AccountMutation) RemoveImportDecisionIDs(ids ...int) {
+	if m.removedimport_decisions == nil {
+		m.removedimport_decisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.import_decisions, ids[i])
+		m.removedimport_decisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedImportDecisions returns the removed IDs of the "import_decisions" edge to the ImportDecision entity.
+func (m *AccountMutation) RemovedImportDecisionsIDs() (ids []int) {
+	for id := range m.removedimport_decisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ImportDecisionsIDs returns the "import_decisions" edge IDs in the mutation.
+func (m *AccountMutation) ImportDecisionsIDs() (ids []int) {
+	for id := range m.import_decisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetImportDecisions resets all changes to the "import_decisions" edge.
+func (m *AccountMutation) ResetImportDecisions() {
+	m.import_decisions = nil
+	m.clearedimport_decisions = false
+	m.removedimport_decisions = nil
+}
+
+// Where appends a list predicates to the AccountMutation builder.
+func (m *AccountMutation) Where(ps ...predicate.Account) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AccountMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AccountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Account, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AccountMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AccountMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Account).
+func (m *AccountMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AccountMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.workspace != nil {
+		fields = append(fields, account.FieldWorkspaceID)
+	}
+	if m.name != nil {
+		fields = append(fields, account.FieldName)
+	}
+	if m._type != nil {
+		fields = append(fields, account.FieldType)
+	}
+	if m.institution != nil {
+		fields = append(fields, account.FieldInstitution)
+	}
+	if m.currency != nil {
+		fields = append(fields, account.FieldCurrency)
+	}
+	if m.opening_balance != nil {
+		fields = append(fields, account.FieldOpeningBalance)
+	}
+	if m.opened_on != nil {
+		fields = append(fields, account.FieldOpenedOn)
+	}
+	if m.closed_on != nil {
+		fields = append(fields, account.FieldClosedOn)
+	}
+	if m.archived != nil {
+		fields = append(fields, account.FieldArchived)
+	}
+	if m.created_at != nil {
+		fields = append(fields, account.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, account.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AccountMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case account.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case account.FieldName:
+		return m.Name()
+	case account.FieldType:
+		return m.GetType()
+	case account.FieldInstitution:
+		return m.Institution()
+	case account.FieldCurrency:
+		return m.Currency()
+	case account.FieldOpeningBalance:
+		return m.OpeningBalance()
+	case account.FieldOpenedOn:
+		return m.OpenedOn()
+	case account.FieldClosedOn:
+		return m.ClosedOn()
+	case account.FieldArchived:
+		return m.Archived()
+	case account.FieldCreatedAt:
+		return m.CreatedAt()
+	case account.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case account.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case account.FieldName:
+		return m.OldName(ctx)
+	case account.FieldType:
+		return m.OldType(ctx)
+	case account.FieldInstitution:
+		return m.OldInstitution(ctx)
+	case account.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case account.FieldOpeningBalance:
+		return m.OldOpeningBalance(ctx)
+	case account.FieldOpenedOn:
+		return m.OldOpenedOn(ctx)
+	case account.FieldClosedOn:
+		return m.OldClosedOn(ctx)
+	case account.FieldArchived:
+		return m.OldArchived(ctx)
+	case account.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case account.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Account field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AccountMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case account.FieldWorkspaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case account.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case account.FieldType:
+		v, ok := value.(account.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case account.FieldInstitution:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstitution(v)
+		return nil
+	case account.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case account.FieldOpeningBalance:
+		v, ok := value.(model.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpeningBalance(v)
+		return nil
+	case account.FieldOpenedOn:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpenedOn(v)
+		return nil
+	case account.FieldClosedOn:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosedOn(v)
+		return nil
+	case account.FieldArchived:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchived(v)
+		return nil
+	case account.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case account.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Account field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AccountMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AccountMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AccountMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Account numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AccountMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(account.FieldInstitution) {
+		fields = append(fields, account.FieldInstitution)
+	}
+	if m.FieldCleared(account.FieldOpenedOn) {
+		fields = append(fields, account.FieldOpenedOn)
+	}
+	if m.FieldCleared(account.FieldClosedOn) {
+		fields = append(fields, account.FieldClosedOn)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AccountMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AccountMutation) ClearField(name string) error {
+	switch name {
+	case account.FieldInstitution:
+		m.ClearInstitution()
+		return nil
+	case account.FieldOpenedOn:
+		m.ClearOpenedOn()
+		return nil
+	case account.FieldClosedOn:
+		m.ClearClosedOn()
+		return nil
+	}
+	return fmt.Errorf("unknown Account nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AccountMutation) ResetField(name string) error {
+	switch name {
+	case account.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case account.FieldName:
+		m.ResetName()
+		return nil
+	case account.FieldType:
+		m.ResetType()
+		return nil
+	case account.FieldInstitution:
+		m.ResetInstitution()
+		return nil
+	case account.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case account.FieldOpeningBalance:
+		m.ResetOpeningBalance()
+		return nil
+	case account.FieldOpenedOn:
+		m.ResetOpenedOn()
+		return nil
+	case account.FieldClosedOn:
+		m.ResetClosedOn()
+		return nil
+	case account.FieldArchived:
+		m.ResetArchived()
+		return nil
+	case account.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case account.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Account field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AccountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.workspace != nil {
+		edges = append(edges, account.EdgeWorkspace)
+	}
+	if m.transactions != nil {
+		edges = append(edges, account.EdgeTransactions)
+	}
+	if m.postings != nil {
+		edges = append(edges, account.EdgePostings)
+	}
+	if m.recurrence_rules != nil {
+		edges = append(edges, account.EdgeRecurrenceRules)
+	}
+	if m.statement_imports != nil {
+		edges = append(edges, account.EdgeStatementImports)
+	}
+	if m.import_profiles != nil {
+		edges = append(edges, account.EdgeImportProfiles)
+	}
+	if m.import_decisions != nil {
+		edges = append(edges, account.EdgeImportDecisions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AccountMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case account.EdgeWorkspace:
+		if id := m.workspace; id != nil {
+			return []ent.Value{*id}
+		}
+	case account.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.transactions))
+		for id := range m.transactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgePostings:
+		ids := make([]ent.Value, 0, len(m.postings))
+		for id := range m.postings {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeRecurrenceRules:
+		ids := make([]ent.Value, 0, len(m.recurrence_rules))
+		for id := range m.recurrence_rules {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeStatementImports:
+		ids := make([]ent.Value, 0, len(m.statement_imports))
+		for id := range m.statement_imports {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeImportProfiles:
+		ids := make([]ent.Value, 0, len(m.import_profiles))
+		for id := range m.import_profiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeImportDecisions:
+		ids := make([]ent.Value, 0, len(m.import_decisions))
+		for id := range m.import_decisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AccountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedtransactions != nil {
+		edges = append(edges, account.EdgeTransactions)
+	}
+	if m.removedpostings != nil {
+		edges = append(edges, account.EdgePostings)
+	}
+	if m.removedrecurrence_rules != nil {
+		edges = append(edges, account.EdgeRecurrenceRules)
+	}
+	if m.removedstatement_imports != nil {
+		edges = append(edges, account.EdgeStatementImports)
+	}
+	if m.removedimport_profiles != nil {
+		edges = append(edges, account.EdgeImportProfiles)
+	}
+	if m.removedimport_decisions != nil {
+		edges = append(edges, account.EdgeImportDecisions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AccountMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case account.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.removedtransactions))
+		for id := range m.removedtransactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgePostings:
+		ids := make([]ent.Value, 0, len(m.removedpostings))
+		for id := range m.removedpostings {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeRecurrenceRules:
+		ids := make([]ent.Value, 0, len(m.removedrecurrence_rules))
+		for id := range m.removedrecurrence_rules {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeStatementImports:
+		ids := make([]ent.Value, 0, len(m.removedstatement_imports))
+		for id := range m.removedstatement_imports {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeImportProfiles:
+		ids := make([]ent.Value, 0, len(m.removedimport_profiles))
+		for id := range m.removedimport_profiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeImportDecisions:
+		ids := make([]ent.Value, 0, len(m.removedimport_decisions))
+		for id := range m.removedimport_decisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AccountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedworkspace {
+		edges = append(edges, account.EdgeWorkspace)
+	}
+	if m.clearedtransactions {
+		edges = append(edges, account.EdgeTransactions)
+	}
+	if m.clearedpostings {
+		edges = append(edges, account.EdgePostings)
+	}
+	if m.clearedrecurrence_rules {
+		edges = append(edges, account.EdgeRecurrenceRules)
+	}
+	if m.clearedstatement_imports {
+		edges = append(edges, account.EdgeStatementImports)
+	}
+	if m.clearedimport_profiles {
+		edges = append(edges, account.EdgeImportProfiles)
+	}
+	if m.clearedimport_decisions {
+		edges = append(edges, account.EdgeImportDecisions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AccountMutation) EdgeCleared(name string) bool {
+	switch name {
+	case account.EdgeWorkspace:
+		return m.clearedworkspace
+	case account.EdgeTransactions:
+		return m.clearedtransactions
+	case account.EdgePostings:
+		return m.clearedpostings
+	case account.EdgeRecurrenceRules:
+		return m.clearedrecurrence_rules
+	case account.EdgeStatementImports:
+		return m.clearedstatement_imports
+	case account.EdgeImportProfiles:
+		return m.clearedimport_profiles
+	case account.EdgeImportDecisions:
+		return m.clearedimport_decisions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AccountMutation) ClearEdge(name string) error {
+	switch name {
+	case account.EdgeWorkspace:
+		m.ClearWorkspace()
+		return nil
+	}
+	return fmt.Errorf("unknown Account unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AccountMutation) ResetEdge(name string) error {
+	switch name {
+	case account.EdgeWorkspace:
+		m.ResetWorkspace()
+		return nil
+	case account.EdgeTransactions:
+		m.ResetTransactions()
+		return nil
+	case account.EdgePostings:
+		m.ResetPostings()
+		return nil
+	case account.EdgeRecurrenceRules:
+		m.ResetRecurrenceRules()
+		return nil
+	case account.EdgeStatementImports:
+		m.ResetStatementImports()
+		return nil
+	case account.EdgeImportProfiles:
+		m.ResetImportProfiles()
+		return nil
+	case account.EdgeImportDecisions:
+		m.ResetImportDecisions()
+		return nil
+	}
+	return fmt.Errorf("unknown Account edge %s", name)
+}
+
+// CategoryMutation represents an operation that mutates the Category nodes in the graph.
+type CategoryMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	name                    *string
+	kind                    *category.Kind
+	color                   *string
+	icon                    *string
+	archived                *bool
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	workspace               *int
+	clearedworkspace        bool
+	parent                  *int
+	clearedparent           bool
+	children                map[int]struct{}
+	removedchildren         map[int]struct{}
+	clearedchildren         bool
+	transactions            map[int]struct{}
+	removedtransactions     map[int]struct{}
+	clearedtransactions     bool
+	splits                  map[int]struct{}
+	removedsplits           map[int]struct{}
+	clearedsplits           bool
+	payees                  map[int]struct{}
+	removedpayees           map[int]struct{}
+	clearedpayees           bool
+	recurrence_rules        map[int]struct{}
+	removedrecurrence_rules map[int]struct{}
+	clearedrecurrence_rules bool
+	done                    bool
+	oldValue                func(context.Context) (*Category, error)
+	predicates              []predicate.Category
+}
+
+var _ ent.Mutation = (*CategoryMutation)(nil)
+
+// categoryOption allows management of the mutation configuration using functional options.
+type categoryOption func(*CategoryMutation)
+
+// newCategoryMutation creates new mutation for the Category entity.
+func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutation {
+	m := &CategoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCategory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCategoryID sets the ID field of the mutation.
+func withCategoryID(id int) categoryOption {
+	return func(m *CategoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Category
+		)
+		m.oldValue = func(ctx context.Context) (*Category, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Category.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCategory sets the old Category of the mutation.
+func withCategory(node *Category) categoryOption {
+	return func(m *CategoryMutation) {
+		m.oldValue = func(context.Context) (*Category, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CategoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CategoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CategoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CategoryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Category.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *CategoryMutation) SetWorkspaceID(i int) {
+	m.workspace = &i
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *CategoryMutation) WorkspaceID() (r int, exists bool) {
+	v := m.workspace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldWorkspaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *CategoryMutation) ResetWorkspaceID() {
+	m.workspace = nil
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *CategoryMutation) SetParentID(i int) {
+	m.parent = &i
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *CategoryMutation) ParentID() (r int, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldParentID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *CategoryMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[category.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *CategoryMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[category.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *CategoryMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, category.FieldParentID)
+}
+
+// SetName sets the "name" field.
+func (m *CategoryMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CategoryMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CategoryMutation) ResetName() {
+	m.name = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *CategoryMutation) SetKind(c category.Kind) {
+	m.kind = &c
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *CategoryMutation) Kind() (r category.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldKind(ctx context.Context) (v category.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *CategoryMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetColor sets the "color" field.
+func (m *CategoryMutation) SetColor(s string) {
+	m.color = &s
+}
+
+// Color returns the value of the "color" field in the mutation.
+func (m *CategoryMutation) Color() (r string, exists bool) {
+	v := m.color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColor returns the old "color" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+	}
+	return oldValue.Color, nil
+}
+
+// ClearColor clears the value of the "color" field.
+func (m *CategoryMutation) ClearColor() {
+	m.color = nil
+	m.clearedFields[category.FieldColor] = struct{}{}
+}
+
+// ColorCleared returns if the "color" field was cleared in this mutation.
+func (m *CategoryMutation) ColorCleared() bool {
+	_, ok := m.clearedFields[category.FieldColor]
+	return ok
+}
+
+// ResetColor resets all changes to the "color" field.
+func (m *CategoryMutation) ResetColor() {
+	m.color = nil
+	delete(m.clearedFields, category.FieldColor)
+}
+
+// SetIcon sets the "icon" field.
+func (m *CategoryMutation) SetIcon(s string) {
+	m.icon = &s
+}
+
+// Icon returns the value of the "icon" field in the mutation.
+func (m *CategoryMutation) Icon() (r string, exists bool) {
+	v := m.icon
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIcon returns the old "icon" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldIcon(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIcon requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
+	}
+	return oldValue.Icon, nil
+}
+
+// ClearIcon clears the value of the "icon" field.
+func (m *CategoryMutation) ClearIcon() {
+	m.icon = nil
+	m.clearedFields[category.FieldIcon] = struct{}{}
+}
+
+// IconCleared returns if the "icon" field was cleared in this mutation.
+func (m *CategoryMutation) IconCleared() bool {
+	_, ok := m.clearedFields[category.FieldIcon]
+	return ok
+}
+
+// ResetIcon resets all changes to the "icon" field.
+func (m *CategoryMutation) ResetIcon() {
+	m.icon = nil
+	delete(m.clearedFields, category.FieldIcon)
+}
+
+// SetArchived sets the "archived" field.
+func (m *CategoryMutation) SetArchived(b bool) {
+	m.archived = &b
+}
+
+// Archived returns the value of the "archived" field in the mutation.
+func (m *CategoryMutation) Archived() (r bool, exists bool) {
+	v := m.archived
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchived returns the old "archived" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldArchived(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchived is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchived requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchived: %w", err)
+	}
+	return oldValue.Archived, nil
+}
+
+// ResetArchived resets all changes to the "archived" field.
+func (m *CategoryMutation) ResetArchived() {
+	m.archived = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CategoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CategoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CategoryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CategoryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CategoryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CategoryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (m *CategoryMutation) ClearWorkspace() {
+	m.clearedworkspace = true
+	m.clearedFields[category.FieldWorkspaceID] = struct{}{}
+}
+
+// WorkspaceCleared reports if the "workspace" edge to the Workspace entity was cleared.
+func (m *CategoryMutation) WorkspaceCleared() bool {
+	return m.clearedworkspace
+}
+
+// WorkspaceIDs returns the "workspace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkspaceID instead. It exists only for internal usage by the builders.
+func (m *CategoryMutation) WorkspaceIDs() (ids []int) {
+	if id := m.workspace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkspace resets all changes to the "workspace" edge.
+func (m *CategoryMutation) ResetWorkspace() {
+	m.workspace = nil
+	m.clearedworkspace = false
+}
+
+// ClearParent clears the "parent" edge to the Category entity.
+func (m *CategoryMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[category.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Category entity was cleared.
+func (m *CategoryMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *CategoryMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *CategoryMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Category entity by ids.
+func (m *CategoryMutation) AddChildIDs(ids ...int) {
+	if m.children == nil {
+		m.children = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Category entity.
+func (m *CategoryMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Category entity was cleared.
+func (m *CategoryMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Category entity by IDs.
+func (m *CategoryMutation) RemoveChildIDs(ids ...int) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Category entity.
+func (m *CategoryMutation) RemovedChildrenIDs() (ids []int) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *CategoryMutation) ChildrenIDs() (ids []int) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *CategoryMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by ids.
+func (m *CategoryMutation) AddTransactionIDs(ids ...int) {
+	if m.transactions == nil {
+		m.transactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.transactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTransactions clears the "transactions" edge to the Transaction entity.
+func (m *CategoryMutation) ClearTransactions() {
+	m.clearedtransactions = true
+}
+
+// TransactionsCleared reports if the "transactions" edge to the Transaction entity was cleared.
+func (m *CategoryMutation) TransactionsCleared() bool {
+	return m.clearedtransactions
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to the Transaction entity by IDs.
+func (m *CategoryMutation) RemoveTransactionIDs(ids ...int) {
+	if m.removedtransactions == nil {
+		m.removedtransactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.transactions, ids[i])
+		m.removedtransactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTransactions returns the removed IDs of the "transactions" edge to the Transaction entity.
+func (m *CategoryMutation) RemovedTransactionsIDs() (ids []int) {
+	for id := range m.removedtransactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TransactionsIDs returns the "transactions" edge IDs in the mutation.
+func (m *CategoryMutation) TransactionsIDs() (ids []int) {
+	for id := range m.transactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTransactions resets all changes to the "transactions" edge.
+func (m *CategoryMutation) ResetTransactions() {
+	m.transactions = nil
+	m.clearedtransactions = false
+	m.removedtransactions = nil
+}
+
+// AddSplitIDs adds the "splits" edge to the TransactionSplit entity by ids.
+func (m *CategoryMutation) AddSplitIDs(ids ...int) {
+	if m.splits == nil {
+		m.splits = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.splits[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSplits clears the "splits" edge to the TransactionSplit entity.
+func (m *CategoryMutation) ClearSplits() {
+	m.clearedsplits = true
+}
+
+// SplitsCleared reports if the "splits" edge to the TransactionSplit entity was cleared.
+func (m *CategoryMutation) SplitsCleared() bool {
+	return m.clearedsplits
+}
+
+// RemoveSplitIDs removes the "splits" edge to the TransactionSplit entity by IDs.
+func (m *CategoryMutation) RemoveSplitIDs(ids ...int) {
+	if m.removedsplits == nil {
+		m.removedsplits = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.splits, ids[i])
+		m.removedsplits[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSplits returns the removed IDs of the "splits" edge to the TransactionSplit entity.
+func (m *CategoryMutation) RemovedSplitsIDs() (ids []int) {
+	for id := range m.removedsplits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SplitsIDs returns the "splits" edge IDs in the mutation.
+func (m *CategoryMutation) SplitsIDs() (ids []int) {
+	for id := range m.splits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSplits resets all changes to the "splits" edge.
+func (m *CategoryMutation) ResetSplits() {
+	m.splits = nil
+	m.clearedsplits = false
+	m.removedsplits = nil
+}
+
+// AddPayeeIDs adds the "payees" edge to the Payee entity by ids.
+func (m *CategoryMutation) AddPayeeIDs(ids ...int) {
+	if m.payees == nil {
+		m.payees = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.payees[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPayees clears the "payees" edge to the Payee entity.
+func (m *CategoryMutation) ClearPayees() {
+	m.clearedpayees = true
+}
+
+// PayeesCleared reports if the "payees" edge to the Payee entity was cleared.
+func (m *CategoryMutation) PayeesCleared() bool {
+	return m.clearedpayees
+}
+
+// RemovePayeeIDs removes the "payees" edge to the Payee entity by IDs.
+func (m *CategoryMutation) RemovePayeeIDs(ids ...int) {
+	if m.removedpayees == nil {
+		m.removedpayees = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.payees, ids[i])
+		m.removedpayees[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPayees returns the removed IDs of the "payees" edge to the Payee entity.
+func (m *CategoryMutation) RemovedPayeesIDs() (ids []int) {
+	for id := range m.removedpayees {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PayeesIDs returns the "payees" edge IDs in the mutation.
+func (m *CategoryMutation) PayeesIDs() (ids []int) {
+	for id := range m.payees {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPayees resets all changes to the "payees" edge.
+func (m *CategoryMutation) ResetPayees() {
+	m.payees = nil
+	m.clearedpayees = false
+	m.removedpayees = nil
+}
+
+// AddRecurrenceRuleIDs adds the "recurrence_rules" edge to the RecurrenceRule entity by ids.
+func (m *CategoryMutation) AddRecurrenceRuleIDs(ids ...int) {
+	if m.recurrence_rules == nil {
+		m.recurrence_rules = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.recurrence_rules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRecurrenceRules clears the "recurrence_rules" edge to the RecurrenceRule entity.
+func (m *CategoryMutation) ClearRecurrenceRules() {
+	m.clearedrecurrence_rules = true
+}
+
+// RecurrenceRulesCleared reports if the "recurrence_rules" edge to the RecurrenceRule entity was cleared.
+func (m *CategoryMutation) RecurrenceRulesCleared() bool {
+	return m.clearedrecurrence_rules
+}
+
+// RemoveRecurrenceRuleIDs removes the "recurrence_rules" edge to the RecurrenceRule entity by IDs.
+func (m *CategoryMutation) RemoveRecurrenceRuleIDs(ids ...int) {
+	if m.removedrecurrence_rules == nil {
+		m.removedrecurrence_rules = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.recurrence_rules, ids[i])
+		m.removedrecurrence_rules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRecurrenceRules returns the removed IDs of the "recurrence_rules" edge to the RecurrenceRule entity.
+func (m *CategoryMutation) RemovedRecurrenceRulesIDs() (ids []int) {
+	for id := range m.removedrecurrence_rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RecurrenceRulesIDs returns the "recurrence_rules" edge IDs in the mutation.
+func (m *CategoryMutation) RecurrenceRulesIDs() (ids []int) {
+	for id := range m.recurrence_rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRecurrenceRules resets all changes to the "recurrence_rules" edge.
+func (m *CategoryMutation) ResetRecurrenceRules() {
+	m.recurrence_rules = nil
+	m.clearedrecurrence_rules = false
+	m.removedrecurrence_rules = nil
+}
+
+// Where appends a list predicates to the CategoryMutation builder.
+func (m *CategoryMutation) Where(ps ...predicate.Category) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CategoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CategoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Category, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CategoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CategoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Category).
+func (m *CategoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CategoryMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.workspace != nil {
+		fields = append(fields, category.FieldWorkspaceID)
+	}
+	if m.parent != nil {
+		fields = append(fields, category.FieldParentID)
+	}
+	if m.name != nil {
+		fields = append(fields, category.FieldName)
+	}
+	if m.kind != nil {
+		fields = append(fields, category.FieldKind)
+	}
+	if m.color != nil {
+		fields = append(fields, category.FieldColor)
+	}
+	if m.icon != nil {
+		fields = append(fields, category.FieldIcon)
+	}
+	if m.archived != nil {
+		fields = append(fields, category.FieldArchived)
+	}
+	if m.created_at != nil {
+		fields = append(fields, category.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, category.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case category.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case category.FieldParentID:
+		return m.ParentID()
+	case category.FieldName:
+		return m.Name()
+	case category.FieldKind:
+		return m.Kind()
+	case category.FieldColor:
+		return m.Color()
+	case category.FieldIcon:
+		return m.Icon()
+	case category.FieldArchived:
+		return m.Archived()
+	case category.FieldCreatedAt:
+		return m.CreatedAt()
+	case category.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case category.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case category.FieldParentID:
+		return m.OldParentID(ctx)
+	case category.FieldName:
+		return m.OldName(ctx)
+	case category.FieldKind:
+		return m.OldKind(ctx)
+	case category.FieldColor:
+		return m.OldColor(ctx)
+	case category.FieldIcon:
+		return m.OldIcon(ctx)
+	case category.FieldArchived:
+		return m.OldArchived(ctx)
+	case category.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case category.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Category field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case category.FieldWorkspaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case category.FieldParentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case category.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case category.FieldKind:
+		v, ok := value.(category.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case category.FieldColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColor(v)
+		return nil
+	case category.FieldIcon:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIcon(v)
+		return nil
+	case category.FieldArchived:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchived(v)
+		return nil
+	case category.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case category.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Category field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CategoryMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CategoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Category numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CategoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(category.FieldParentID) {
+		fields = append(fields, category.FieldParentID)
+	}
+	if m.FieldCleared(category.FieldColor) {
+		fields = append(fields, category.FieldColor)
+	}
+	if m.FieldCleared(category.FieldIcon) {
+		fields = append(fields, category.FieldIcon)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CategoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CategoryMutation) ClearField(name string) error {
+	switch name {
+	case category.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case category.FieldColor:
+		m.ClearColor()
+		return nil
+	case category.FieldIcon:
+		m.ClearIcon()
+		return nil
+	}
+	return fmt.Errorf("unknown Category nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CategoryMutation) ResetField(name string) error {
+	switch name {
+	case category.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case category.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case category.FieldName:
+		m.ResetName()
+		return nil
+	case category.FieldKind:
+		m.ResetKind()
+		return nil
+	case category.FieldColor:
+		m.ResetColor()
+		return nil
+	case category.FieldIcon:
+		m.ResetIcon()
+		return nil
+	case category.FieldArchived:
+		m.ResetArchived()
+		return nil
+	case category.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case category.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Category field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CategoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.workspace != nil {
+		edges = append(edges, category.EdgeWorkspace)
+	}
+	if m.parent != nil {
+		edges = append(edges, category.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, category.EdgeChildren)
+	}
+	if m.transactions != nil {
+		edges = append(edges, category.EdgeTransactions)
+	}
+	if m.splits != nil {
+		edges = append(edges, category.EdgeSplits)
+	}
+	if m.payees != nil {
+		edges = append(edges, category.EdgePayees)
+	}
+	if m.recurrence_rules != nil {
+		edges = append(edges, category.EdgeRecurrenceRules)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CategoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case category.EdgeWorkspace:
+		if id := m.workspace; id != nil {
+			return []ent.Value{*id}
+		}
+	case category.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case category.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case category.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.transactions))
+		for id := range m.transactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case category.EdgeSplits:
+		ids := make([]ent.Value, 0, len(m.splits))
+		for id := range m.splits {
+			ids = append(ids, id)
+		}
+		return ids
+	case category.EdgePayees:
+		ids := make([]ent.Value, 0, len(m.payees))
+		for id := range m.payees {
+			ids = append(ids, id)
+		}
+		return ids
+	case category.EdgeRecurrenceRules:
+		ids := make([]ent.Value, 0, len(m.recurrence_rules))
+		for id := range m.recurrence_rules {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CategoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedchildren != nil {
+		edges = append(edges, category.EdgeChildren)
+	}
+	if m.removedtransactions != nil {
+		edges = append(edges, category.EdgeTransactions)
+	}
+	if m.removedsplits != nil {
+		edges = append(edges, category.EdgeSplits)
+	}
+	if m.removedpayees != nil {
+		edges = append(edges, category.EdgePayees)
+	}
+	if m.removedrecurrence_rules != nil {
+		edges = append(edges, category.EdgeRecurrenceRules)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CategoryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case category.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case category.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.removedtransactions))
+		for id := range m.removedtransactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case category.EdgeSplits:
+		ids := make([]ent.Value, 0, len(m.removedsplits))
+		for id := range m.removedsplits {
+			ids = append(ids, id)
+		}
+		return ids
+	case category.EdgePayees:
+		ids := make([]ent.Value, 0, len(m.removedpayees))
+		for id := range m.removedpayees {
+			ids = append(ids, id)
+		}
+		return ids
+	case category.EdgeRecurrenceRules:
+		ids := make([]ent.Value, 0, len(m.removedrecurrence_rules))
+		for id := range m.removedrecurrence_rules {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CategoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedworkspace {
+		edges = append(edges, category.EdgeWorkspace)
+	}
+	if m.clearedparent {
+		edges = append(edges, category.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, category.EdgeChildren)
+	}
+	if m.clearedtransactions {
+		edges = append(edges, category.EdgeTransactions)
+	}
+	if m.clearedsplits {
+		edges = append(edges, category.EdgeSplits)
+	}
+	if m.clearedpayees {
+		edges = append(edges, category.EdgePayees)
+	}
+	if m.clearedrecurrence_rules {
+		edges = append(edges, category.EdgeRecurrenceRules)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CategoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case category.EdgeWorkspace:
+		return m.clearedworkspace
+	case category.EdgeParent:
+		return m.clearedparent
+	case category.EdgeChildren:
+		return m.clearedchildren
+	case category.EdgeTransactions:
+		return m.clearedtransactions
+	case category.EdgeSplits:
+		return m.clearedsplits
+	case category.EdgePayees:
+		return m.clearedpayees
+	case category.EdgeRecurrenceRules:
+		return m.clearedrecurrence_rules
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CategoryMutation) ClearEdge(name string) error {
+	switch name {
+	case category.EdgeWorkspace:
+		m.ClearWorkspace()
+		return nil
+	case category.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Category unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CategoryMutation) ResetEdge(name string) error {
+	switch name {
+	case category.EdgeWorkspace:
+		m.ResetWorkspace()
+		return nil
+	case category.EdgeParent:
+		m.ResetParent()
+		return nil
+	case category.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case category.EdgeTransactions:
+		m.ResetTransactions()
+		return nil
+	case category.EdgeSplits:
+		m.ResetSplits()
+		return nil
+	case category.EdgePayees:
+		m.ResetPayees()
+		return nil
+	case category.EdgeRecurrenceRules:
+		m.ResetRecurrenceRules()
+		return nil
+	}
+	return fmt.Errorf("unknown Category edge %s", name)
+}
+
+// EmailVerificationTokenMutation represents an operation that mutates the EmailVerificationToken nodes in the graph.
+type EmailVerificationTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	token_hash    *string
+	expires_at    *time.Time
+	used_at       *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*EmailVerificationToken, error)
+	predicates    []predicate.EmailVerificationToken
+}
+
+var _ ent.Mutation = (*EmailVerificationTokenMutation)(nil)
+
+// emailverificationtokenOption allows management of the mutation configuration using functional options.
+type emailverificationtokenOption func(*EmailVerificationTokenMutation)
+
+// newEmailVerificationTokenMutation creates new mutation for the EmailVerificationToken entity.
+func newEmailVerificationTokenMutation(c config, op Op, opts ...emailverificationtokenOption) *EmailVerificationTokenMutation {
+	m := &EmailVerificationTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEmailVerificationToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEmailVerificationTokenID sets the ID field of the mutation.
+func withEmailVerificationTokenID(id int) emailverificationtokenOption {
+	return func(m *EmailVerificationTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EmailVerificationToken
+		)
+		m.oldValue = func(ctx context.Context) (*EmailVerificationToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EmailVerificationToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEmailVerificationToken sets the old EmailVerificationToken of the mutation.
+func withEmailVerificationToken(node *EmailVerificationToken) emailverificationtokenOption {
+	return func(m *EmailVerificationTokenMutation) {
+		m.oldValue = func(context.Context) (*EmailVerificationToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EmailVerificationTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EmailVerificationTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EmailVerificationTokenMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EmailVerificationTokenMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EmailVerificationToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *EmailVerificationTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *EmailVerificationTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the EmailVerificationToken entity.
+// If the EmailVerificationToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *EmailVerificationTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *EmailVerificationTokenMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *EmailVerificationTokenMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the EmailVerificationToken entity.
+// If the EmailVerificationToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationTokenMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *EmailVerificationTokenMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *EmailVerificationTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *EmailVerificationTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the EmailVerificationToken entity.
+// If the EmailVerificationToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *EmailVerificationTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetUsedAt sets the "used_at" field.
+func (m *EmailVerificationTokenMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
+}
+
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *EmailVerificationTokenMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsedAt returns the old "used_at" field's value of the EmailVerificationToken entity.
+// If the EmailVerificationToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationTokenMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+	}
+	return oldValue.UsedAt, nil
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *EmailVerificationTokenMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[emailverificationtoken.FieldUsedAt] = struct{}{}
+}
+
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *EmailVerificationTokenMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[emailverificationtoken.FieldUsedAt]
+	return ok
+}
+
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *EmailVerificationTokenMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, emailverificationtoken.FieldUsedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EmailVerificationTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EmailVerificationTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EmailVerificationToken entity.
+// If the EmailVerificationToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EmailVerificationTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *EmailVerificationTokenMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[emailverificationtoken.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *EmailVerificationTokenMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *EmailVerificationTokenMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *EmailVerificationTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the EmailVerificationTokenMutation builder.
+func (m *EmailVerificationTokenMutation) Where(ps ...predicate.EmailVerificationToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EmailVerificationTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EmailVerificationTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EmailVerificationToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EmailVerificationTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EmailVerificationTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EmailVerificationToken).
+func (m *EmailVerificationTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EmailVerificationTokenMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.token_hash != nil {
+		fields = append(fields, emailverificationtoken.FieldTokenHash)
+	}
+	if m.user != nil {
+		fields = append(fields, emailverificationtoken.FieldUserID)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, emailverificationtoken.FieldExpiresAt)
+	}
+	if m.used_at != nil {
+		fields = append(fields, emailverificationtoken.FieldUsedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, emailverificationtoken.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EmailVerificationTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case emailverificationtoken.FieldTokenHash:
+		return m.TokenHash()
+	case emailverificationtoken.FieldUserID:
+		return m.UserID()
+	case emailverificationtoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case emailverificationtoken.FieldUsedAt:
+		return m.UsedAt()
+	case emailverificationtoken.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EmailVerificationTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case emailverificationtoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case emailverificationtoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case emailverificationtoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case emailverificationtoken.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case emailverificationtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EmailVerificationToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailVerificationTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case emailverificationtoken.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case emailverificationtoken.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case emailverificationtoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case emailverificationtoken.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
+	case emailverificationtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerificationToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EmailVerificationTokenMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EmailVerificationTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailVerificationTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EmailVerificationToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EmailVerificationTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(emailverificationtoken.FieldUsedAt) {
+		fields = append(fields, emailverificationtoken.FieldUsedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EmailVerificationTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EmailVerificationTokenMutation) ClearField(name string) error {
+	switch name {
+	case emailverificationtoken.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerificationToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EmailVerificationTokenMutation) ResetField(name string) error {
+	switch name {
+	case emailverificationtoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case emailverificationtoken.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case emailverificationtoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case emailverificationtoken.FieldUsedAt:
+		m.ResetUsedAt()
+		return nil
+	case emailverificationtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerificationToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EmailVerificationTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, emailverificationtoken.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EmailVerificationTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case emailverificationtoken.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EmailVerificationTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EmailVerificationTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EmailVerificationTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, emailverificationtoken.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EmailVerificationTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case emailverificationtoken.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EmailVerificationTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case emailverificationtoken.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerificationToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EmailVerificationTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case emailverificationtoken.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerificationToken edge %s", name)
+}
+
+// ImportDecisionMutation represents an operation that mutates the ImportDecision nodes in the graph.
+type ImportDecisionMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	fingerprint             *string
+	action                  *importdecision.Action
+	created_at              *time.Time
+	clearedFields           map[string]struct{}
+	workspace               *int
+	clearedworkspace        bool
+	account                 *int
+	clearedaccount          bool
+	statement_import        *int
+	clearedstatement_import bool
+	transaction             *int
+	clearedtransaction      bool
+	done                    bool
+	oldValue                func(context.Context) (*ImportDecision, error)
+	predicates              []predicate.ImportDecision
+}
+
+var _ ent.Mutation = (*ImportDecisionMutation)(nil)
+
+// importdecisionOption allows management of the mutation configuration using functional options.
+type importdecisionOption func(*ImportDecisionMutation)
+
+// newImportDecisionMutation creates new mutation for the ImportDecision entity.
+func newImportDecisionMutation(c config, op Op, opts ...importdecisionOption) *ImportDecisionMutation {
+	m := &ImportDecisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeImportDecision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withImportDecisionID sets the ID field of the mutation.
+func withImportDecisionID(id int) importdecisionOption {
+	return func(m *ImportDecisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ImportDecision
+		)
+		m.oldValue = func(ctx context.Context) (*ImportDecision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ImportDecision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withImportDecision sets the old ImportDecision of the mutation.
+func withImportDecision(node *ImportDecision) importdecisionOption {
+	return func(m *ImportDecisionMutation) {
+		m.oldValue = func(context.Context) (*ImportDecision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ImportDecisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ImportDecisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ImportDecisionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ImportDecisionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ImportDecision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *ImportDecisionMutation) SetWorkspaceID(i int) {
+	m.workspace = &i
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *ImportDecisionMutation) WorkspaceID() (r int, exists bool) {
+	v := m.workspace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the ImportDecision entity.
+// If the ImportDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportDecisionMutation) OldWorkspaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *ImportDecisionMutation) ResetWorkspaceID() {
+	m.workspace = nil
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *ImportDecisionMutation) SetAccountID(i int) {
+	m.account = &i
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *ImportDecisionMutation) AccountID() (r int, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the ImportDecision entity.
+// If the ImportDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportDecisionMutation) OldAccountID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *ImportDecisionMutation) ResetAccountID() {
+	m.account = nil
+}
+
+// SetStatementImportID sets the "statement_import_id" field.
+func (m *ImportDecisionMutation) SetStatementImportID(i int) {
+	m.statement_import = &i
+}
+
+// StatementImportID returns the value of the "statement_import_id" field in the mutation.
+func (m *ImportDecisionMutation) StatementImportID() (r int, exists bool) {
+	v := m.statement_import
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatementImportID returns the old "statement_import_id" field's value of the ImportDecision entity.
+// If the ImportDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportDecisionMutation) OldStatementImportID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatementImportID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatementImportID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatementImportID: %w", err)
+	}
+	return oldValue.StatementImportID, nil
+}
+
+// ClearStatementImportID clears the value of the "statement_import_id" field.
+func (m *ImportDecisionMutation) ClearStatementImportID() {
+	m.statement_import = nil
+	m.clearedFields[importdecision.FieldStatementImportID] = struct{}{}
+}
+
+// StatementImportIDCleared returns if the "statement_import_id" field was cleared in this mutation.
+func (m *ImportDecisionMutation) StatementImportIDCleared() bool {
+	_, ok := m.clearedFields[importdecision.FieldStatementImportID]
+	return ok
+}
+
+// ResetStatementImportID resets all changes to the "statement_import_id" field.
+func (m *ImportDecisionMutation) ResetStatementImportID() {
+	m.statement_import = nil
+	delete(m.clearedFields, importdecision.FieldStatementImportID)
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (m *ImportDecisionMutation) SetFingerprint(s string) {
+	m.fingerprint = &s
+}
+
+// Fingerprint returns the value of the "fingerprint" field in the mutation.
+func (m *ImportDecisionMutation) Fingerprint() (r string, exists bool) {
+	v := m.fingerprint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFingerprint returns the old "fingerprint" field's value of the ImportDecision entity.
+// If the ImportDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportDecisionMutation) OldFingerprint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFingerprint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFingerprint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFingerprint: %w", err)
+	}
+	return oldValue.Fingerprint, nil
+}
+
+// ResetFingerprint resets all changes to the "fingerprint" field.
+func (m *ImportDecisionMutation) ResetFingerprint() {
+	m.fingerprint = nil
+}
+
+// SetAction sets the "action" field.
+func (m *ImportDecisionMutation) SetAction(i importdecision.Action) {
+	m.action = &i
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *ImportDecisionMutation) Action() (r importdecision.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the ImportDecision entity.
+// If the ImportDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportDecisionMutation) OldAction(ctx context.Context) (v importdecision.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *ImportDecisionMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (m *ImportDecisionMutation) SetTransactionID(i int) {
+	m.transaction = &i
+}
+
+// TransactionID returns the value of the "transaction_id" field in the mutation.
+func (m *ImportDecisionMutation) TransactionID() (r int, exists bool) {
+	v := m.transaction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransactionID returns the old "transaction_id" field's value of the ImportDecision entity.
+// If the ImportDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportDecisionMutation) OldTransactionID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransactionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransactionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransactionID: %w", err)
+	}
+	return oldValue.TransactionID, nil
+}
+
+// ResetTransactionID resets all changes to the "transaction_id" field.
+func (m *ImportDecisionMutation) ResetTransactionID() {
+	m.transaction = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ImportDecisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ImportDecisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ImportDecision entity.
+// If the ImportDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportDecisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ImportDecisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (m *ImportDecisionMutation) ClearWorkspace() {
+	m.clearedworkspace = true
+	m.clearedFields[importdecision.FieldWorkspaceID] = struct{}{}
+}
+
+// WorkspaceCleared reports if the "workspace" edge to the Workspace entity was cleared.
+func (m *ImportDecisionMutation) WorkspaceCleared() bool {
+	return m.clearedworkspace
+}
+
+// WorkspaceIDs returns the "workspace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkspaceID instead. It exists only for internal usage by the builders.
+func (m *ImportDecisionMutation) WorkspaceIDs() (ids []int) {
+	if id := m.workspace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkspace resets all changes to the "workspace" edge.
+func (m *ImportDecisionMutation) ResetWorkspace() {
+	m.workspace = nil
+	m.clearedworkspace = false
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *ImportDecisionMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[importdecision.FieldAccountID] = struct{}{}
+}
+
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *ImportDecisionMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *ImportDecisionMutation) AccountIDs() (ids []int) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *ImportDecisionMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// ClearStatementImport clears the "statement_import" edge to the StatementImport entity.
+func (m *ImportDecisionMutation) ClearStatementImport() {
+	m.clearedstatement_import = true
+	m.clearedFields[importdecision.FieldStatementImportID] = struct{}{}
+}
+
+// StatementImportCleared reports if the "statement_import" edge to the StatementImport entity was cleared.
+func (m *ImportDecisionMutation) StatementImportCleared() bool {
+	return m.StatementImportIDCleared() || m.clearedstatement_import
+}
+
+// StatementImportIDs returns the "statement_import" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StatementImportID instead. It exists only for internal usage by the builders.
+func (m *ImportDecisionMutation) StatementImportIDs() (ids []int) {
+	if id := m.statement_import; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStatementImport resets all changes to the "statement_import" edge.
+func (m *ImportDecisionMutation) ResetStatementImport() {
+	m.statement_import = nil
+	m.clearedstatement_import = false
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (m *ImportDecisionMutation) ClearTransaction() {
+	m.clearedtransaction = true
+	m.clearedFields[importdecision.FieldTransactionID] = struct{}{}
+}
+
+// TransactionCleared reports if the "transaction" edge to the Transaction entity was cleared.
+func (m *ImportDecisionMutation) TransactionCleared() bool {
+	return m.clearedtransaction
+}
+
+// TransactionIDs returns the "transaction" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TransactionID instead. It exists only for internal usage by the builders.
+func (m *ImportDecisionMutation) TransactionIDs() (ids []int) {
+	if id := m.transaction; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTransaction resets all changes to the "transaction" edge.
+func (m *ImportDecisionMutation) ResetTransaction() {
+	m.transaction = nil
+	m.clearedtransaction = false
+}
+
+// Where appends a list predicates to the ImportDecisionMutation builder.
+func (m *ImportDecisionMutation) Where(ps ...predicate.ImportDecision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ImportDecisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ImportDecisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ImportDecision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ImportDecisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ImportDecisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ImportDecision).
+func (m *ImportDecisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ImportDecisionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.workspace != nil {
+		fields = append(fields, importdecision.FieldWorkspaceID)
+	}
+	if m.account != nil {
+		fields = append(fields, importdecision.FieldAccountID)
+	}
+	if m.statement_import != nil {
+		fields = append(fields, importdecision.FieldStatementImportID)
+	}
+	if m.fingerprint != nil {
+		fields = append(fields, importdecision.FieldFingerprint)
+	}
+	if m.action != nil {
+		fields = append(fields, importdecision.FieldAction)
+	}
+	if m.transaction != nil {
+		fields = append(fields, importdecision.FieldTransactionID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, importdecision.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ImportDecisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case importdecision.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case importdecision.FieldAccountID:
+		return m.AccountID()
+	case importdecision.FieldStatementImportID:
+		return m.StatementImportID()
+	case importdecision.FieldFingerprint:
+		return m.Fingerprint()
+	case importdecision.FieldAction:
+		return m.Action()
+	case importdecision.FieldTransactionID:
+		return m.TransactionID()
+	case importdecision.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ImportDecisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case importdecision.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case importdecision.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case importdecision.FieldStatementImportID:
+		return m.OldStatementImportID(ctx)
+	case importdecision.FieldFingerprint:
+		return m.OldFingerprint(ctx)
+	case importdecision.FieldAction:
+		return m.OldAction(ctx)
+	case importdecision.FieldTransactionID:
+		return m.OldTransactionID(ctx)
+	case importdecision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ImportDecision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImportDecisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case importdecision.FieldWorkspaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case importdecision.FieldAccountID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case importdecision.FieldStatementImportID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatementImportID(v)
+		return nil
+	case importdecision.FieldFingerprint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFingerprint(v)
+		return nil
+	case importdecision.FieldAction:
+		v, ok := value.(importdecision.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case importdecision.FieldTransactionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransactionID(v)
+		return nil
+	case importdecision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ImportDecision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ImportDecisionMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ImportDecisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImportDecisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ImportDecision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ImportDecisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(importdecision.FieldStatementImportID) {
+		fields = append(fields, importdecision.FieldStatementImportID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ImportDecisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ImportDecisionMutation) ClearField(name string) error {
+	switch name {
+	case importdecision.FieldStatementImportID:
+		m.ClearStatementImportID()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportDecision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ImportDecisionMutation) ResetField(name string) error {
+	switch name {
+	case importdecision.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case importdecision.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case importdecision.FieldStatementImportID:
+		m.ResetStatementImportID()
+		return nil
+	case importdecision.FieldFingerprint:
+		m.ResetFingerprint()
+		return nil
+	case importdecision.FieldAction:
+		m.ResetAction()
+		return nil
+	case importdecision.FieldTransactionID:
+		m.ResetTransactionID()
+		return nil
+	case importdecision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportDecision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ImportDecisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.workspace != nil {
+		edges = append(edges, importdecision.EdgeWorkspace)
+	}
+	if m.account != nil {
+		edges = append(edges, importdecision.EdgeAccount)
+	}
+	if m.statement_import != nil {
+		edges = append(edges, importdecision.EdgeStatementImport)
+	}
+	if m.transaction != nil {
+		edges = append(edges, importdecision.EdgeTransaction)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ImportDecisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case importdecision.EdgeWorkspace:
+		if id := m.workspace; id != nil {
+			return []ent.Value{*id}
+		}
+	case importdecision.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	case importdecision.EdgeStatementImport:
+		if id := m.statement_import; id != nil {
+			return []ent.Value{*id}
+		}
+	case importdecision.EdgeTransaction:
+		if id := m.transaction; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ImportDecisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ImportDecisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ImportDecisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedworkspace {
+		edges = append(edges, importdecision.EdgeWorkspace)
+	}
+	if m.clearedaccount {
+		edges = append(edges, importdecision.EdgeAccount)
+	}
+	if m.clearedstatement_import {
+		edges = append(edges, importdecision.EdgeStatementImport)
+	}
+	if m.clearedtransaction {
+		edges = append(edges, importdecision.EdgeTransaction)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ImportDecisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case importdecision.EdgeWorkspace:
+		return m.clearedworkspace
+	case importdecision.EdgeAccount:
+		return m.clearedaccount
+	case importdecision.EdgeStatementImport:
+		return m.clearedstatement_import
+	case importdecision.EdgeTransaction:
+		return m.clearedtransaction
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ImportDecisionMutation) ClearEdge(name string) error {
+	switch name {
+	case importdecision.EdgeWorkspace:
+		m.ClearWorkspace()
+		return nil
+	case importdecision.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	case importdecision.EdgeStatementImport:
+		m.ClearStatementImport()
+		return nil
+	case importdecision.EdgeTransaction:
+		m.ClearTransaction()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportDecision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ImportDecisionMutation) ResetEdge(name string) error {
+	switch name {
+	case importdecision.EdgeWorkspace:
+		m.ResetWorkspace()
+		return nil
+	case importdecision.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	case importdecision.EdgeStatementImport:
+		m.ResetStatementImport()
+		return nil
+	case importdecision.EdgeTransaction:
+		m.ResetTransaction()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportDecision edge %s", name)
+}
+
+// ImportProfileMutation represents an operation that mutates the ImportProfile nodes in the graph.
+type ImportProfileMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	name             *string
+	mapping          *model.CSVMapping
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	workspace        *int
+	clearedworkspace bool
+	account          *int
+	clearedaccount   bool
+	done             bool
+	oldValue         func(context.Context) (*ImportProfile, error)
+	predicates       []predicate.ImportProfile
+}
+
+var _ ent.Mutation = (*ImportProfileMutation)(nil)
+
+// importprofileOption allows management of the mutation configuration using functional options.
+type importprofileOption func(*ImportProfileMutation)
+
+// newImportProfileMutation creates new mutation for the ImportProfile entity.
+func newImportProfileMutation(c config, op Op, opts ...importprofileOption) *ImportProfileMutation {
+	m := &ImportProfileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeImportProfile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withImportProfileID sets the ID field of the mutation.
+func withImportProfileID(id int) importprofileOption {
+	return func(m *ImportProfileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ImportProfile
+		)
+		m.oldValue = func(ctx context.Context) (*ImportProfile, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ImportProfile.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withImportProfile sets the old ImportProfile of the mutation.
+func withImportProfile(node *ImportProfile) importprofileOption {
+	return func(m *ImportProfileMutation) {
+		m.oldValue = func(context.Context) (*ImportProfile, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ImportProfileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ImportProfileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ImportProfileMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ImportProfileMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ImportProfile.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *ImportProfileMutation) SetWorkspaceID(i int) {
+	m.workspace = &i
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *ImportProfileMutation) WorkspaceID() (r int, exists bool) {
+	v := m.workspace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the ImportProfile entity.
+// If the ImportProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportProfileMutation) OldWorkspaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *ImportProfileMutation) ResetWorkspaceID() {
+	m.workspace = nil
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *ImportProfileMutation) SetAccountID(i int) {
+	m.account = &i
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *ImportProfileMutation) AccountID() (r int, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the ImportProfile entity.
+// If the ImportProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportProfileMutation) OldAccountID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *ImportProfileMutation) ResetAccountID() {
+	m.account = nil
+}
+
+// SetName sets the "name" field.
+func (m *ImportProfileMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ImportProfileMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ImportProfile entity.
+// If the ImportProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportProfileMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ImportProfileMutation) ResetName() {
+	m.name = nil
+}
+
+// SetMapping sets the "mapping" field.
+func (m *ImportProfileMutation) SetMapping(mm model.CSVMapping) {
+	m.mapping = &mm
+}
+
+// Mapping returns the value of the "mapping" field in the mutation.
+func (m *ImportProfileMutation) Mapping() (r model.CSVMapping, exists bool) {
+	v := m.mapping
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMapping returns the old "mapping" field's value of the ImportProfile entity.
+// If the ImportProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportProfileMutation) OldMapping(ctx context.Context) (v model.CSVMapping, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMapping is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMapping requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMapping: %w", err)
+	}
+	return oldValue.Mapping, nil
+}
+
+// ResetMapping resets all changes to the "mapping" field.
+func (m *ImportProfileMutation) ResetMapping() {
+	m.mapping = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ImportProfileMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ImportProfileMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ImportProfile entity.
+// If the ImportProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ImportProfileMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ImportProfileMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ImportProfileMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ImportProfile entity.
+// If the ImportProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportProfileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ImportProfileMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (m *ImportProfileMutation) ClearWorkspace() {
+	m.clearedworkspace = true
+	m.clearedFields[importprofile.FieldWorkspaceID] = struct{}{}
+}
+
+// WorkspaceCleared reports if the "workspace" edge to the Workspace entity was cleared.
+func (m *ImportProfileMutation) WorkspaceCleared() bool {
+	return m.clearedworkspace
+}
+
+// WorkspaceIDs returns the "workspace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkspaceID instead. It exists only for internal usage by the builders.
+func (m *ImportProfileMutation) WorkspaceIDs() (ids []int) {
+	if id := m.workspace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkspace resets all changes to the "workspace" edge.
+func (m *ImportProfileMutation) ResetWorkspace() {
+	m.workspace = nil
+	m.clearedworkspace = false
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *ImportProfileMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[importprofile.FieldAccountID] = struct{}{}
+}
+
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *ImportProfileMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *ImportProfileMutation) AccountIDs() (ids []int) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *ImportProfileMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// Where appends a list predicates to the ImportProfileMutation builder.
+func (m *ImportProfileMutation) Where(ps ...predicate.ImportProfile) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ImportProfileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ImportProfileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ImportProfile, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ImportProfileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ImportProfileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ImportProfile).
+func (m *ImportProfileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ImportProfileMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.workspace != nil {
+		fields = append(fields, importprofile.FieldWorkspaceID)
+	}
+	if m.account != nil {
+		fields = append(fields, importprofile.FieldAccountID)
+	}
+	if m.name != nil {
+		fields = append(fields, importprofile.FieldName)
+	}
+	if m.mapping != nil {
+		fields = append(fields, importprofile.FieldMapping)
+	}
+	if m.created_at != nil {
+		fields = append(fields, importprofile.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, importprofile.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ImportProfileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case importprofile.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case importprofile.FieldAccountID:
+		return m.AccountID()
+	case importprofile.FieldName:
+		return m.Name()
+	case importprofile.FieldMapping:
+		return m.Mapping()
+	case importprofile.FieldCreatedAt:
+		return m.CreatedAt()
+	case importprofile.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ImportProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case importprofile.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case importprofile.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case importprofile.FieldName:
+		return m.OldName(ctx)
+	case importprofile.FieldMapping:
+		return m.OldMapping(ctx)
+	case importprofile.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case importprofile.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ImportProfile field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImportProfileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case importprofile.FieldWorkspaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case importprofile.FieldAccountID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case importprofile.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case importprofile.FieldMapping:
+		v, ok := value.(model.CSVMapping)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMapping(v)
+		return nil
+	case importprofile.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case importprofile.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ImportProfile field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ImportProfileMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ImportProfileMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImportProfileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ImportProfile numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ImportProfileMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ImportProfileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ImportProfileMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ImportProfile nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ImportProfileMutation) ResetField(name string) error {
+	switch name {
+	case importprofile.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case importprofile.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case importprofile.FieldName:
+		m.ResetName()
+		return nil
+	case importprofile.FieldMapping:
+		m.ResetMapping()
+		return nil
+	case importprofile.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case importprofile.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportProfile field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ImportProfileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.workspace != nil {
+		edges = append(edges, importprofile.EdgeWorkspace)
+	}
+	if m.account != nil {
+		edges = append(edges, importprofile.EdgeAccount)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ImportProfileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case importprofile.EdgeWorkspace:
+		if id := m.workspace; id != nil {
+			return []ent.Value{*id}
+		}
+	case importprofile.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ImportProfileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ImportProfileMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ImportProfileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedworkspace {
+		edges = append(edges, importprofile.EdgeWorkspace)
+	}
+	if m.clearedaccount {
+		edges = append(edges, importprofile.EdgeAccount)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ImportProfileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case importprofile.EdgeWorkspace:
+		return m.clearedworkspace
+	case importprofile.EdgeAccount:
+		return m.clearedaccount
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ImportProfileMutation) ClearEdge(name string) error {
+	switch name {
+	case importprofile.EdgeWorkspace:
+		m.ClearWorkspace()
+		return nil
+	case importprofile.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportProfile unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ImportProfileMutation) ResetEdge(name string) error {
+	switch name {
+	case importprofile.EdgeWorkspace:
+		m.ResetWorkspace()
+		return nil
+	case importprofile.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportProfile edge %s", name)
+}
+
+// JournalEntryMutation represents an operation that mutates the JournalEntry nodes in the graph.
+type JournalEntryMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	posted_on          *time.Time
+	memo               *string
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	workspace          *int
+	clearedworkspace   bool
+	transaction        *int
+	clearedtransaction bool
+	postings           map[int]struct{}
+	removedpostings    map[int]struct{}
+	clearedpostings    bool
+	done               bool
+	oldValue           func(context.Context) (*JournalEntry, error)
+	predicates         []predicate.JournalEntry
+}
+
+var _ ent.Mutation = (*JournalEntryMutation)(nil)
+
+// journalentryOption allows management of the mutation configuration using functional options.
+type journalentryOption func(*JournalEntryMutation)
+
+// newJournalEntryMutation creates new mutation for the JournalEntry entity.
+func newJournalEntryMutation(c config, op Op, opts ...journalentryOption) *JournalEntryMutation {
+	m := &JournalEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJournalEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJournalEntryID sets the ID field of the mutation.
+func withJournalEntryID(id int) journalentryOption {
+	return func(m *JournalEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *JournalEntry
+		)
+		m.oldValue = func(ctx context.Context) (*JournalEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().JournalEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJournalEntry sets the old JournalEntry of the mutation.
+func withJournalEntry(node *JournalEntry) journalentryOption {
+	return func(m *JournalEntryMutation) {
+		m.oldValue = func(context.Context) (*JournalEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JournalEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JournalEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JournalEntryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JournalEntryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().JournalEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *JournalEntryMutation) SetWorkspaceID(i int) {
+	m.workspace = &i
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *JournalEntryMutation) WorkspaceID() (r int, exists bool) {
+	v := m.workspace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the JournalEntry entity.
+// If the JournalEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalEntryMutation) OldWorkspaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *JournalEntryMutation) ResetWorkspaceID() {
+	m.workspace = nil
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (m *JournalEntryMutation) SetTransactionID(i int) {
+	m.transaction = &i
+}
+
+// TransactionID returns the value of the "transaction_id" field in the mutation.
+func (m *JournalEntryMutation) TransactionID() (r int, exists bool) {
+	v := m.transaction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransactionID returns the old "transaction_id" field's value of the JournalEntry entity.
+// If the JournalEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalEntryMutation) OldTransactionID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransactionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransactionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransactionID: %w", err)
+	}
+	return oldValue.TransactionID, nil
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (m *JournalEntryMutation) ClearTransactionID() {
+	m.transaction = nil
+	m.clearedFields[journalentry.FieldTransactionID] = struct{}{}
+}
+
+// TransactionIDCleared returns if the "transaction_id" field was cleared in this mutation.
+func (m *JournalEntryMutation) TransactionIDCleared() bool {
+	_, ok := m.clearedFields[journalentry.FieldTransactionID]
+	return ok
+}
+
+// ResetTransactionID resets all changes to the "transaction_id" field.
+func (m *JournalEntryMutation) ResetTransactionID() {
+	m.transaction = nil
+	delete(m.clearedFields, journalentry.FieldTransactionID)
+}
+
+// SetPostedOn sets the "posted_on" field.
+func (m *JournalEntryMutation) SetPostedOn(t time.Time) {
+	m.posted_on = &t
+}
+
+// PostedOn returns the value of the "posted_on" field in the mutation.
+func (m *JournalEntryMutation) PostedOn() (r time.Time, exists bool) {
+	v := m.posted_on
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostedOn returns the old "posted_on" field's value of the JournalEntry entity.
+// If the JournalEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalEntryMutation) OldPostedOn(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostedOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostedOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostedOn: %w", err)
+	}
+	return oldValue.PostedOn, nil
+}
+
+// ResetPostedOn resets all changes to the "posted_on" field.
+func (m *JournalEntryMutation) ResetPostedOn() {
+	m.posted_on = nil
+}
+
+// SetMemo sets the "memo" field.
+func (m *JournalEntryMutation) SetMemo(s string) {
+	m.memo = &s
+}
+
+// Memo returns the value of the "memo" field in the mutation.
+func (m *JournalEntryMutation) Memo() (r string, exists bool) {
+	v := m.memo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMemo returns the old "memo" field's value of the JournalEntry entity.
+// If the JournalEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalEntryMutation) OldMemo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMemo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMemo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMemo: %w", err)
+	}
+	return oldValue.Memo, nil
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (m *JournalEntryMutation) ClearMemo() {
+	m.memo = nil
+	m.clearedFields[journalentry.FieldMemo] = struct{}{}
+}
+
+// MemoCleared returns if the "memo" field was cleared in this mutation.
+func (m *JournalEntryMutation) MemoCleared() bool {
+	_, ok := m.clearedFields[journalentry.FieldMemo]
+	return ok
+}
+
+// ResetMemo resets all changes to the "memo" field.
+func (m *JournalEntryMutation) ResetMemo() {
+	m.memo = nil
+	delete(m.clearedFields, journalentry.FieldMemo)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *JournalEntryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JournalEntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the JournalEntry entity.
+// If the JournalEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JournalEntryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (m *JournalEntryMutation) ClearWorkspace() {
+	m.clearedworkspace = true
+	m.clearedFields[journalentry.FieldWorkspaceID] = struct{}{}
+}
+
+// WorkspaceCleared reports if the "workspace" edge to the Workspace entity was cleared.
+func (m *JournalEntryMutation) WorkspaceCleared() bool {
+	return m.clearedworkspace
+}
+
+// WorkspaceIDs returns the "workspace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkspaceID instead. It exists only for internal usage by the builders.
+func (m *JournalEntryMutation) WorkspaceIDs() (ids []int) {
+	if id := m.workspace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkspace resets all changes to the "workspace" edge.
+func (m *JournalEntryMutation) ResetWorkspace() {
+	m.workspace = nil
+	m.clearedworkspace = false
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (m *JournalEntryMutation) ClearTransaction() {
+	m.clearedtransaction = true
+	m.clearedFields[journalentry.FieldTransactionID] = struct{}{}
+}
+
+// TransactionCleared reports if the "transaction" edge to the Transaction entity was cleared.
+func (m *JournalEntryMutation) TransactionCleared() bool {
+	return m.TransactionIDCleared() || m.clearedtransaction
+}
+
+// TransactionIDs returns the "transaction" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TransactionID instead. It exists only for internal usage by the builders.
+func (m *JournalEntryMutation) TransactionIDs() (ids []int) {
+	if id := m.transaction; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTransaction resets all changes to the "transaction" edge.
+func (m *JournalEntryMutation) ResetTransaction() {
+	m.transaction = nil
+	m.clearedtransaction = false
+}
+
+// AddPostingIDs adds the "postings" edge to the Posting entity by ids.
+func (m *JournalEntryMutation) AddPostingIDs(ids ...int) {
+	if m.postings == nil {
+		m.postings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.postings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPostings clears the "postings" edge to the Posting entity.
+func (m *JournalEntryMutation) ClearPostings() {
+	m.clearedpostings = true
+}
+
+// PostingsCleared reports if the "postings" edge to the Posting entity was cleared.
+func (m *JournalEntryMutation) PostingsCleared() bool {
+	return m.clearedpostings
+}
+
+// RemovePostingIDs removes the "postings" edge to the Posting entity by IDs.
+func (m *JournalEntryMutation) RemovePostingIDs(ids ...int) {
+	if m.removedpostings == nil {
+		m.removedpostings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.postings, ids[i])
+		m.removedpostings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPostings returns the removed IDs of the "postings" edge to the Posting entity.
+func (m *JournalEntryMutation) RemovedPostingsIDs() (ids []int) {
+	for id := range m.removedpostings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PostingsIDs returns the "postings" edge IDs in the mutation.
+func (m *JournalEntryMutation) PostingsIDs() (ids []int) {
+	for id := range m.postings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPostings resets all changes to the "postings" edge.
+func (m *JournalEntryMutation) ResetPostings() {
+	m.postings = nil
+	m.clearedpostings = false
+	m.removedpostings = nil
+}
+
+// Where appends a list predicates to the JournalEntryMutation builder.
+func (m *JournalEntryMutation) Where(ps ...predicate.JournalEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JournalEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JournalEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.JournalEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JournalEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JournalEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (JournalEntry).
+func (m *JournalEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JournalEntryMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.workspace != nil {
+		fields = append(fields, journalentry.FieldWorkspaceID)
+	}
+	if m.transaction != nil {
+		fields = append(fields, journalentry.FieldTransactionID)
+	}
+	if m.posted_on != nil {
+		fields = append(fields, journalentry.FieldPostedOn)
+	}
+	if m.memo != nil {
+		fields = append(fields, journalentry.FieldMemo)
+	}
+	if m.created_at != nil {
+		fields = append(fields, journalentry.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JournalEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case journalentry.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case journalentry.FieldTransactionID:
+		return m.TransactionID()
+	case journalentry.FieldPostedOn:
+		return m.PostedOn()
+	case journalentry.FieldMemo:
+		return m.Memo()
+	case journalentry.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JournalEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case journalentry.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case journalentry.FieldTransactionID:
+		return m.OldTransactionID(ctx)
+	case journalentry.FieldPostedOn:
+		return m.OldPostedOn(ctx)
+	case journalentry.FieldMemo:
+		return m.OldMemo(ctx)
+	case journalentry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown JournalEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JournalEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case journalentry.FieldWorkspaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case journalentry.FieldTransactionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransactionID(v)
+		return nil
+	case journalentry.FieldPostedOn:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostedOn(v)
+		return nil
+	case journalentry.FieldMemo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemo(v)
+		return nil
+	case journalentry.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JournalEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JournalEntryMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JournalEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JournalEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown JournalEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JournalEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(journalentry.FieldTransactionID) {
+		fields = append(fields, journalentry.FieldTransactionID)
+	}
+	if m.FieldCleared(journalentry.FieldMemo) {
+		fields = append(fields, journalentry.FieldMemo)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JournalEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JournalEntryMutation) ClearField(name string) error {
+	switch name {
+	case journalentry.FieldTransactionID:
+		m.ClearTransactionID()
+		return nil
+	case journalentry.FieldMemo:
+		m.ClearMemo()
+		return nil
+	}
+	return fmt.Errorf("unknown JournalEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JournalEntryMutation) ResetField(name string) error {
+	switch name {
+	case journalentry.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case journalentry.FieldTransactionID:
+		m.ResetTransactionID()
+		return nil
+	case journalentry.FieldPostedOn:
+		m.ResetPostedOn()
+		return nil
+	case journalentry.FieldMemo:
+		m.ResetMemo()
+		return nil
+	case journalentry.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown JournalEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JournalEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.workspace != nil {
+		edges = append(edges, journalentry.EdgeWorkspace)
+	}
+	if m.transaction != nil {
+		edges = append(edges, journalentry.EdgeTransaction)
+	}
+	if m.postings != nil {
+		edges = append(edges, journalentry.EdgePostings)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JournalEntryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case journalentry.EdgeWorkspace:
+		if id := m.workspace; id != nil {
+			return []ent.Value{*id}
+		}
+	case journalentry.EdgeTransaction:
+		if id := m.transaction; id != nil {
+			return []ent.Value{*id}
+		}
+	case journalentry.EdgePostings:
+		ids := make([]ent.Value, 0, len(m.postings))
+		for id := range m.postings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JournalEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedpostings != nil {
+		edges = append(edges, journalentry.EdgePostings)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JournalEntryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case journalentry.EdgePostings:
+		ids := make([]ent.Value, 0, len(m.removedpostings))
+		for id := range m.removedpostings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JournalEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedworkspace {
+		edges = append(edges, journalentry.EdgeWorkspace)
+	}
+	if m.clearedtransaction {
+		edges = append(edges, journalentry.EdgeTransaction)
+	}
+	if m.clearedpostings {
+		edges = append(edges, journalentry.EdgePostings)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JournalEntryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case journalentry.EdgeWorkspace:
+		return m.clearedworkspace
+	case journalentry.EdgeTransaction:
+		return m.clearedtransaction
+	case journalentry.EdgePostings:
+		return m.clearedpostings
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JournalEntryMutation) ClearEdge(name string) error {
+	switch name {
+	case journalentry.EdgeWorkspace:
+		m.ClearWorkspace()
+		return nil
+	case journalentry.EdgeTransaction:
+		m.ClearTransaction()
+		return nil
+	}
+	return fmt.Errorf("unknown JournalEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JournalEntryMutation) ResetEdge(name string) error {
+	switch name {
+	case journalentry.EdgeWorkspace:
+		m.ResetWorkspace()
+		return nil
+	case journalentry.EdgeTransaction:
+		m.ResetTransaction()
+		return nil
+	case journalentry.EdgePostings:
+		m.ResetPostings()
+		return nil
+	}
+	return fmt.Errorf("unknown JournalEntry edge %s", name)
+}
+
+// MembershipMutation represents an operation that mutates the Membership nodes in the graph.
+type MembershipMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	role              *membership.Role
+	joined_at         *time.Time
+	clearedFields     map[string]struct{}
+	workspace         *int
+	clearedworkspace  bool
+	user              *int
+	cleareduser       bool
+	invited_by        *int
+	clearedinvited_by bool
+	done              bool
+	oldValue          func(context.Context) (*Membership, error)
+	predicates        []predicate.Membership
+}
+
+var _ ent.Mutation = (*MembershipMutation)(nil)
+
+// membershipOption allows management of the mutation configuration using functional options.
+type membershipOption func(*MembershipMutation)
+
+// newMembershipMutation creates new mutation for the Membership entity.
+func newMembershipMutation(c config, op Op, opts ...membershipOption) *MembershipMutation {
+	m := &MembershipMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMembership,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMembershipID sets the ID field of the mutation.
+func withMembershipID(id int) membershipOption {
+	return func(m *MembershipMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Membership
+		)
+		m.oldValue = func(ctx context.Context) (*Membership, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Membership.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMembership sets the old Membership of the mutation.
+func withMembership(node *Membership) membershipOption {
+	return func(m *MembershipMutation) {
+		m.oldValue = func(context.Context) (*Membership, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MembershipMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MembershipMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MembershipMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MembershipMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Membership.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *MembershipMutation) SetWorkspaceID(i int) {
+	m.workspace = &i
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *MembershipMutation) WorkspaceID() (r int, exists bool) {
+	v := m.workspace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the Membership entity.
+// If the Membership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MembershipMutation) OldWorkspaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *MembershipMutation) ResetWorkspaceID() {
+	m.workspace = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *MembershipMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *MembershipMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Membership entity.
+// If the Membership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MembershipMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *MembershipMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetRole sets the "role" field.
+func (m *MembershipMutation) SetRole(value membership.Role) {
+	m.role = &value
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *MembershipMutation) Role() (r membership.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the Membership entity.
+// If the Membership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MembershipMutation) OldRole(ctx context.Context) (v membership.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *MembershipMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (m *MembershipMutation) SetJoinedAt(t time.Time) {
+	m.joined_at = &t
+}
+
+// JoinedAt returns the value of the "joined_at" field in the mutation.
+func (m *MembershipMutation) JoinedAt() (r time.Time, exists bool) {
+	v := m.joined_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJoinedAt returns the old "joined_at" field's value of the Membership entity.
+// If the Membership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MembershipMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
+	}
+	return oldValue.JoinedAt, nil
+}
+
+// ResetJoinedAt resets all changes to the "joined_at" field.
+func (m *MembershipMutation) ResetJoinedAt() {
+	m.joined_at = nil
+}
+
+// SetInvitedByID sets the "invited_by_id" field.
+func (m *MembershipMutation) SetInvitedByID(i int) {
+	m.invited_by = &i
+}
+
+// InvitedByID returns the value of the "invited_by_id" field in the mutation.
+func (m *MembershipMutation) InvitedByID() (r int, exists bool) {
+	v := m.invited_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInvitedByID returns the old "invited_by_id" field's value of the Membership entity.
+// If the Membership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MembershipMutation) OldInvitedByID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInvitedByID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInvitedByID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInvitedByID: %w", err)
+	}
+	return oldValue.InvitedByID, nil
+}
+
+// ClearInvitedByID clears the value of the "invited_by_id" field.
+func (m *MembershipMutation) ClearInvitedByID() {
+	m.invited_by = nil
+	m.clearedFields[membership.FieldInvitedByID] = struct{}{}
+}
+
+// InvitedByIDCleared returns if the "invited_by_id" field was cleared in this mutation.
+func (m *MembershipMutation) InvitedByIDCleared() bool {
+	_, ok := m.clearedFields[membership.FieldInvitedByID]
+	return ok
+}
+
+// ResetInvitedByID resets all changes to the "invited_by_id" field.
+func (m *MembershipMutation) ResetInvitedByID() {
+	m.invited_by = nil
+	delete(m.clearedFields, membership.FieldInvitedByID)
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (m *MembershipMutation) ClearWorkspace() {
+	m.clearedworkspace = true
+	m.clearedFields[membership.FieldWorkspaceID] = struct{}{}
+}
+
+// WorkspaceCleared reports if the "workspace" edge to the Workspace entity was cleared.
+func (m *MembershipMutation) WorkspaceCleared() bool {
+	return m.clearedworkspace
+}
+
+// WorkspaceIDs returns the "workspace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkspaceID instead. It exists only for internal usage by the builders.
+func (m *MembershipMutation) WorkspaceIDs() (ids []int) {
+	if id := m.workspace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkspace resets all changes to the "workspace" edge.
+func (m *MembershipMutation) ResetWorkspace() {
+	m.workspace = nil
+	m.clearedworkspace = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *MembershipMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[membership.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *MembershipMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *MembershipMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *MembershipMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearInvitedBy clears the "invited_by" edge to the User entity.
+func (m *MembershipMutation) ClearInvitedBy() {
+	m.clearedinvited_by = true
+	m.clearedFields[membership.FieldInvitedByID] = struct{}{}
+}
+
+// InvitedByCleared reports if the "invited_by" edge to the User entity was cleared.
+func (m *MembershipMutation) InvitedByCleared() bool {
+	return m.InvitedByIDCleared() || m.clearedinvited_by
+}
+
+// InvitedByIDs returns the "invited_by" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// InvitedByID instead. It exists only for internal usage by the builders.
+func (m *MembershipMutation) InvitedByIDs() (ids []int) {
+	if id := m.invited_by; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetInvitedBy resets all changes to the "invited_by" edge.
+func (m *MembershipMutation) ResetInvitedBy() {
+	m.invited_by = nil
+	m.clearedinvited_by = false
+}
+
+// Where appends a list predicates to the MembershipMutation builder.
+func (m *MembershipMutation) Where(ps ...predicate.Membership) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MembershipMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MembershipMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Membership, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MembershipMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MembershipMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Membership).
+func (m *MembershipMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MembershipMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.workspace != nil {
+		fields = append(fields, membership.FieldWorkspaceID)
+	}
+	if m.user != nil {
+		fields = append(fields, membership.FieldUserID)
+	}
+	if m.role != nil {
+		fields = append(fields, membership.FieldRole)
+	}
+	if m.joined_at != nil {
+		fields = append(fields, membership.FieldJoinedAt)
+	}
+	if m.invited_by != nil {
+		fields = append(fields, membership.FieldInvitedByID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MembershipMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case membership.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case membership.FieldUserID:
+		return m.UserID()
+	case membership.FieldRole:
+		return m.Role()
+	case membership.FieldJoinedAt:
+		return m.JoinedAt()
+	case membership.FieldInvitedByID:
+		return m.InvitedByID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MembershipMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case membership.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case membership.FieldUserID:
+		return m.OldUserID(ctx)
+	case membership.FieldRole:
+		return m.OldRole(ctx)
+	case membership.FieldJoinedAt:
+		return m.OldJoinedAt(ctx)
+	case membership.FieldInvitedByID:
+		return m.OldInvitedByID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Membership field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MembershipMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case membership.FieldWorkspaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case membership.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case membership.FieldRole:
+		v, ok := value.(membership.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case membership.FieldJoinedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJoinedAt(v)
+		return nil
+	case membership.FieldInvitedByID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInvitedByID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Membership field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MembershipMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MembershipMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MembershipMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Membership numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MembershipMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(membership.FieldInvitedByID) {
+		fields = append(fields, membership.FieldInvitedByID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MembershipMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MembershipMutation) ClearField(name string) error {
+	switch name {
+	case membership.FieldInvitedByID:
+		m.ClearInvitedByID()
+		return nil
+	}
+	return fmt.Errorf("unknown Membership nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MembershipMutation) ResetField(name string) error {
+	switch name {
+	case membership.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case membership.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case membership.FieldRole:
+		m.ResetRole()
+		return nil
+	case membership.FieldJoinedAt:
+		m.ResetJoinedAt()
+		return nil
+	case membership.FieldInvitedByID:
+		m.ResetInvitedByID()
+		return nil
+	}
+	return fmt.Errorf("unknown Membership field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MembershipMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.workspace != nil {
+		edges = append(edges, membership.EdgeWorkspace)
+	}
+	if m.user != nil {
+		edges = append(edges, membership.EdgeUser)
+	}
+	if m.invited_by != nil {
+		edges = append(edges, membership.EdgeInvitedBy)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MembershipMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case membership.EdgeWorkspace:
+		if id := m.workspace; id != nil {
+			return []ent.Value{*id}
+		}
+	case membership.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case membership.EdgeInvitedBy:
+		if id := m.invited_by; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MembershipMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MembershipMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MembershipMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedworkspace {
+		edges = append(edges, membership.EdgeWorkspace)
+	}
+	if m.cleareduser {
+		edges = append(edges, membership.EdgeUser)
+	}
+	if m.clearedinvited_by {
+		edges = append(edges, membership.EdgeInvitedBy)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MembershipMutation) EdgeCleared(name string) bool {
+	switch name {
+	case membership.EdgeWorkspace:
+		return m.clearedworkspace
+	case membership.EdgeUser:
+		return m.cleareduser
+	case membership.EdgeInvitedBy:
+		return m.clearedinvited_by
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MembershipMutation) ClearEdge(name string) error {
+	switch name {
+	case membership.EdgeWorkspace:
+		m.ClearWorkspace()
+		return nil
+	case membership.EdgeUser:
+		m.ClearUser()
+		return nil
+	case membership.EdgeInvitedBy:
+		m.ClearInvitedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Membership unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MembershipMutation) ResetEdge(name string) error {
+	switch name {
+	case membership.EdgeWorkspace:
+		m.ResetWorkspace()
+		return nil
+	case membership.EdgeUser:
+		m.ResetUser()
+		return nil
+	case membership.EdgeInvitedBy:
+		m.ResetInvitedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Membership edge %s", name)
+}
+
+// PasswordResetTokenMutation represents an operation that mutates the PasswordResetToken nodes in the graph.
+type PasswordResetTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	token_hash    *string
+	expires_at    *time.Time
+	used_at       *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*PasswordResetToken, error)
+	predicates    []predicate.PasswordResetToken
+}
+
+var _ ent.Mutation = (*PasswordResetTokenMutation)(nil)
+
+// passwordresettokenOption allows management of the mutation configuration using functional options.
+type passwordresettokenOption func(*PasswordResetTokenMutation)
+
+// newPasswordResetTokenMutation creates new mutation for the PasswordResetToken entity.
+func newPasswordResetTokenMutation(c config, op Op, opts ...passwordresettokenOption) *PasswordResetTokenMutation {
+	m := &PasswordResetTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePasswordResetToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPasswordResetTokenID sets the ID field of the mutation.
+func withPasswordResetTokenID(id int) passwordresettokenOption {
+	return func(m *PasswordResetTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PasswordResetToken
+		)
+		m.oldValue = func(ctx context.Context) (*PasswordResetToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PasswordResetToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPasswordResetToken sets the old PasswordResetToken of the mutation.
+func withPasswordResetToken(node *PasswordResetToken) passwordresettokenOption {
+	return func(m *PasswordResetTokenMutation) {
+		m.oldValue = func(context.Context) (*PasswordResetToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PasswordResetTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PasswordResetTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PasswordResetTokenMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PasswordResetTokenMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PasswordResetToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *PasswordResetTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *PasswordResetTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the PasswordResetToken entity.
+// If the PasswordResetToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *PasswordResetTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PasswordResetTokenMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PasswordResetTokenMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PasswordResetToken entity.
+// If the PasswordResetToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetTokenMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PasswordResetTokenMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *PasswordResetTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *PasswordResetTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the PasswordResetToken entity.
+// If the PasswordResetToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *PasswordResetTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetUsedAt sets the "used_at" field.
+func (m *PasswordResetTokenMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
+}
+
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *PasswordResetTokenMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsedAt returns the old "used_at" field's value of the PasswordResetToken entity.
+// If the PasswordResetToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetTokenMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+	}
+	return oldValue.UsedAt, nil
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *PasswordResetTokenMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[passwordresettoken.FieldUsedAt] = struct{}{}
+}
+
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *PasswordResetTokenMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[passwordresettoken.FieldUsedAt]
+	return ok
+}
+
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *PasswordResetTokenMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, passwordresettoken.FieldUsedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PasswordResetTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PasswordResetTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PasswordResetToken entity.
+// If the PasswordResetToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PasswordResetTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PasswordResetTokenMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[passwordresettoken.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PasswordResetTokenMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PasswordResetTokenMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PasswordResetTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the PasswordResetTokenMutation builder.
+func (m *PasswordResetTokenMutation) Where(ps ...predicate.PasswordResetToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PasswordResetTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PasswordResetTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PasswordResetToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PasswordResetTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PasswordResetTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PasswordResetToken).
+func (m *PasswordResetTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PasswordResetTokenMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.token_hash != nil {
+		fields = append(fields, passwordresettoken.FieldTokenHash)
+	}
+	if m.user != nil {
+		fields = append(fields, passwordresettoken.FieldUserID)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, passwordresettoken.FieldExpiresAt)
+	}
+	if m.used_at != nil {
+		fields = append(fields, passwordresettoken.FieldUsedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, passwordresettoken.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PasswordResetTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case passwordresettoken.FieldTokenHash:
+		return m.TokenHash()
+	case passwordresettoken.FieldUserID:
+		return m.UserID()
+	case passwordresettoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case passwordresettoken.FieldUsedAt:
+		return m.UsedAt()
+	case passwordresettoken.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PasswordResetTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case passwordresettoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case passwordresettoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case passwordresettoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case passwordresettoken.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case passwordresettoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PasswordResetToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordResetTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case passwordresettoken.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case passwordresettoken.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case passwordresettoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case passwordresettoken.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
+	case passwordresettoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordResetToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PasswordResetTokenMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PasswordResetTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordResetTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PasswordResetToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PasswordResetTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(passwordresettoken.FieldUsedAt) {
+		fields = append(fields, passwordresettoken.FieldUsedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PasswordResetTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PasswordResetTokenMutation) ClearField(name string) error {
+	switch name {
+	case passwordresettoken.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordResetToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PasswordResetTokenMutation) ResetField(name string) error {
+	switch name {
+	case passwordresettoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case passwordresettoken.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case passwordresettoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case passwordresettoken.FieldUsedAt:
+		m.ResetUsedAt()
+		return nil
+	case passwordresettoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordResetToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PasswordResetTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, passwordresettoken.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PasswordResetTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case passwordresettoken.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PasswordResetTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PasswordResetTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PasswordResetTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, passwordresettoken.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PasswordResetTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case passwordresettoken.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PasswordResetTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case passwordresettoken.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordResetToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PasswordResetTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case passwordresettoken.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordResetToken edge %s", name)
+}
+
+// PayeeMutation represents an operation that mutates the Payee nodes in the graph.
+type PayeeMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	name                    *string
+	aliases                 *[]string
+	appendaliases           []string
+	logo_url                *string
+	website                 *string
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	workspace               *int
+	clearedworkspace        bool
+	default_category        *int
+	cleareddefault_category bool
+	transactions            map[int]struct{}
+	removedtransactions     map[int]struct{}
+	clearedtransactions     bool
+	recurrence_rules        map[int]struct{}
+	removedrecurrence_rules map[int]struct{}
+	clearedrecurrence_rules bool
+	done                    bool
+	oldValue                func(context.Context) (*Payee, error)
+	predicates              []predicate.Payee
+}
+
+var _ ent.Mutation = (*PayeeMutation)(nil)
+
+// payeeOption allows management of the mutation configuration using functional options.
+type payeeOption func(*PayeeMutation)
+
+// newPayeeMutation creates new mutation for the Payee entity.
+func newPayeeMutation(c config, op Op, opts ...payeeOption) *PayeeMutation {
+	m := &PayeeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePayee,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPayeeID sets the ID field of the mutation.
+func withPayeeID(id int) payeeOption {
+	return func(m *PayeeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Payee
+		)
+		m.oldValue = func(ctx context.Context) (*Payee, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Payee.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPayee sets the old Payee of the mutation.
+func withPayee(node *Payee) payeeOption {
+	return func(m *PayeeMutation) {
+		m.oldValue = func(context.Context) (*Payee, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PayeeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PayeeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PayeeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PayeeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Payee.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *PayeeMutation) SetWorkspaceID(i int) {
+	m.workspace = &i
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *PayeeMutation) WorkspaceID() (r int, exists bool) {
+	v := m.workspace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the Payee entity.
+// If the Payee object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayeeMutation) OldWorkspaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *PayeeMutation) ResetWorkspaceID() {
+	m.workspace = nil
+}
+
+// SetName sets the "name" field.
+func (m *PayeeMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PayeeMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Payee entity.
+// If the Payee object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayeeMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PayeeMutation) ResetName() {
+	m.name = nil
+}
+
+// SetAliases sets the "aliases" field.
+func (m *PayeeMutation) SetAliases(s []string) {
+	m.aliases = &s
+	m.appendaliases = nil
+}
+
+// Aliases returns the value of the "aliases" field in the mutation.
+func (m *PayeeMutation) Aliases() (r []string, exists bool) {
+	v := m.aliases
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAliases returns the old "aliases" field's value of the Payee entity.
+// If the Payee object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayeeMutation) OldAliases(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAliases is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAliases requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAliases: %w", err)
+	}
+	return oldValue.Aliases, nil
+}
+
+// AppendAliases adds s to the "aliases" field.
+func (m *PayeeMutation) AppendAliases(s []string) {
+	m.appendaliases = append(m.appendaliases, s...)
+}
+
+// AppendedAliases returns the list of values that were appended to the "aliases" field in this mutation.
+func (m *PayeeMutation) AppendedAliases() ([]string, bool) {
+	if len(m.appendaliases) == 0 {
+		return nil, false
+	}
+	return m.appendaliases, true
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (m *PayeeMutation) ClearAliases() {
+	m.aliases = nil
+	m.appendaliases = nil
+	m.clearedFields[payee.FieldAliases] = struct{}{}
+}
+
+// AliasesCleared returns if the "aliases" field was cleared in this mutation.
+func (m *PayeeMutation) AliasesCleared() bool {
+	_, ok := m.clearedFields[payee.FieldAliases]
+	return ok
+}
+
+// ResetAliases resets all changes to the "aliases" field.
+func (m *PayeeMutation) ResetAliases() {
+	m.aliases = nil
+	m.appendaliases = nil
+	delete(m.clearedFields, payee.FieldAliases)
+}
+
+// SetDefaultCategoryID sets the "default_category_id" field.
+func (m *PayeeMutation) SetDefaultCategoryID(i int) {
+	m.default_category = &i
+}
+
+// DefaultCategoryID returns the value of the "default_category_id" field in the mutation.
+func (m *PayeeMutation) DefaultCategoryID() (r int, exists bool) {
+	v := m.default_category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultCategoryID returns the old "default_category_id" field's value of the Payee entity.
+// If the Payee object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayeeMutation) OldDefaultCategoryID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultCategoryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultCategoryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultCategoryID: %w", err)
+	}
+	return oldValue.DefaultCategoryID, nil
+}
+
+// ClearDefaultCategoryID clears the value of the "default_category_id" field.
+func (m *PayeeMutation) ClearDefaultCategoryID() {
+	m.default_category = nil
+	m.clearedFields[payee.FieldDefaultCategoryID] = struct{}{}
+}
+
+// DefaultCategoryIDCleared returns if the "default_category_id" field was cleared in this mutation.
+func (m *PayeeMutation) DefaultCategoryIDCleared() bool {
+	_, ok := m.clearedFields[payee.FieldDefaultCategoryID]
+	return ok
+}
+
+// ResetDefaultCategoryID resets all changes to the "default_category_id" field.
+func (m *PayeeMutation) ResetDefaultCategoryID() {
+	m.default_category = nil
+	delete(m.clearedFields, payee.FieldDefaultCategoryID)
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (m *PayeeMutation) SetLogoURL(s string) {
+	m.logo_url = &s
+}
+
+// LogoURL returns the value of the "logo_url" field in the mutation.
+func (m *PayeeMutation) LogoURL() (r string, exists bool) {
+	v := m.logo_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogoURL returns the old "logo_url" field's value of the Payee entity.
+// If the Payee object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayeeMutation) OldLogoURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogoURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogoURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogoURL: %w", err)
+	}
+	return oldValue.LogoURL, nil
+}
+
+// ClearLogoURL clears the value of the "logo_url" field.
+func (m *PayeeMutation) ClearLogoURL() {
+	m.logo_url = nil
+	m.clearedFields[payee.FieldLogoURL] = struct{}{}
+}
+
+// LogoURLCleared returns if the "logo_url" field was cleared in this mutation.
+func (m *PayeeMutation) LogoURLCleared() bool {
+	_, ok := m.clearedFields[payee.FieldLogoURL]
+	return ok
+}
+
+// ResetLogoURL resets all changes to the "logo_url" field.
+func (m *PayeeMutation) ResetLogoURL() {
+	m.logo_url = nil
+	delete(m.clearedFields, payee.FieldLogoURL)
+}
+
+// SetWebsite sets the "website" field.
+func (m *PayeeMutation) SetWebsite(s string) {
+	m.website = &s
+}
+
+// Website returns the value of the "website" field in the mutation.
+func (m *PayeeMutation) Website() (r string, exists bool) {
+	v := m.website
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWebsite returns the old "website" field's value of the Payee entity.
+// If the Payee object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayeeMutation) OldWebsite(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebsite is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebsite requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebsite: %w", err)
+	}
+	return oldValue.Website, nil
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (m *PayeeMutation) ClearWebsite() {
+	m.website = nil
+	m.clearedFields[payee.FieldWebsite] = struct{}{}
+}
+
+// WebsiteCleared returns if the "website" field was cleared in this mutation.
+func (m *PayeeMutation) WebsiteCleared() bool {
+	_, ok := m.clearedFields[payee.FieldWebsite]
+	return ok
+}
+
+// ResetWebsite resets all changes to the "website" field.
+func (m *PayeeMutation) ResetWebsite() {
+	m.website = nil
+	delete(m.clearedFields, payee.FieldWebsite)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PayeeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PayeeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Payee entity.
+// If the Payee object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayeeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PayeeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PayeeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PayeeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Payee entity.
+// If the Payee object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PayeeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PayeeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (m *PayeeMutation) ClearWorkspace() {
+	m.clearedworkspace = true
+	m.clearedFields[payee.FieldWorkspaceID] = struct{}{}
+}
+
+// WorkspaceCleared reports if the "workspace" edge to the Workspace entity was cleared.
+func (m *PayeeMutation) WorkspaceCleared() bool {
+	return m.clearedworkspace
+}
+
+// WorkspaceIDs returns the "workspace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkspaceID instead. It exists only for internal usage by the builders.
+func (m *PayeeMutation) WorkspaceIDs() (ids []int) {
+	if id := m.workspace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkspace resets all changes to the "workspace" edge.
+func (m *PayeeMutation) ResetWorkspace() {
+	m.workspace = nil
+	m.clearedworkspace = false
+}
+
+// ClearDefaultCategory clears the "default_category" edge to the Category entity.
+func (m *PayeeMutation) ClearDefaultCategory() {
+	m.cleareddefault_category = true
+	m.clearedFields[payee.FieldDefaultCategoryID] = struct{}{}
+}
+
+// DefaultCategoryCleared reports if the "default_category" edge to the Category entity was cleared.
+func (m *PayeeMutation) DefaultCategoryCleared() bool {
+	return m.DefaultCategoryIDCleared() || m.cleareddefault_category
+}
+
+// DefaultCategoryIDs returns the "default_category" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DefaultCategoryID instead. It exists only for internal usage by the builders.
+func (m *PayeeMutation) DefaultCategoryIDs() (ids []int) {
+	if id := m.default_category; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDefaultCategory resets all changes to the "default_category" edge.
+func (m *PayeeMutation) ResetDefaultCategory() {
+	m.default_category = nil
+	m.cleareddefault_category = false
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by ids.
+func (m *PayeeMutation) AddTransactionIDs(ids ...int) {
+	if m.transactions == nil {
+		m.transactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.transactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTransactions clears the "transactions" edge to the Transaction entity.
+func (m *PayeeMutation) ClearTransactions() {
+	m.clearedtransactions = true
+}
+
+// TransactionsCleared reports if the "transactions" edge to the Transaction entity was cleared.
+func (m *PayeeMutation) TransactionsCleared() bool {
+	return m.clearedtransactions
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to the Transaction entity by IDs.
+func (m *PayeeMutation) RemoveTransactionIDs(ids ...int) {
+	if m.removedtransactions == nil {
+		m.removedtransactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.transactions, ids[i])
+		m.removedtransactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTransactions returns the removed IDs of the "transactions" edge to the Transaction entity.
+func (m *PayeeMutation) RemovedTransactionsIDs() (ids []int) {
+	for id := range m.removedtransactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TransactionsIDs returns the "transactions" edge IDs in the mutation.
+func (m *PayeeMutation) TransactionsIDs() (ids []int) {
+	for id := range m.transactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTransactions resets all changes to the "transactions" edge.
+func (m *PayeeMutation) ResetTransactions() {
+	m.transactions = nil
+	m.clearedtransactions = false
+	m.removedtransactions = nil
+}
+
+// AddRecurrenceRuleIDs adds the "recurrence_rules" edge to the RecurrenceRule entity by ids.
+func (m *PayeeMutation) AddRecurrenceRuleIDs(ids ...int) {
+	if m.recurrence_rules == nil {
+		m.recurrence_rules = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.recurrence_rules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRecurrenceRules clears the "recurrence_rules" edge to the RecurrenceRule entity.
+func (m *PayeeMutation) ClearRecurrenceRules() {
+	m.clearedrecurrence_rules = true
+}
+
+// RecurrenceRulesCleared reports if the "recurrence_rules" edge to the RecurrenceRule entity was cleared.
+func (m *PayeeMutation) RecurrenceRulesCleared() bool {
+	return m.clearedrecurrence_rules
+}
+
+// RemoveRecurrenceRuleIDs removes the "recurrence_rules" edge to the RecurrenceRule entity by IDs.
+func (m *PayeeMutation) RemoveRecurrenceRuleIDs(ids ...int) {
+	if m.removedrecurrence_rules == nil {
+		m.removedrecurrence_rules = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.recurrence_rules, ids[i])
+		m.removedrecurrence_rules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRecurrenceRules returns the removed IDs of the "recurrence_rules" edge to the RecurrenceRule entity.
+func (m *PayeeMutation) RemovedRecurrenceRulesIDs() (ids []int) {
+	for id := range m.removedrecurrence_rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RecurrenceRulesIDs returns the "recurrence_rules" edge IDs in the mutation.
+func (m *PayeeMutation) RecurrenceRulesIDs() (ids []int) {
+	for id := range m.recurrence_rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRecurrenceRules resets all changes to the "recurrence_rules" edge.
+func (m *PayeeMutation) ResetRecurrenceRules() {
+	m.recurrence_rules = nil
+	m.clearedrecurrence_rules = false
+	m.removedrecurrence_rules = nil
+}
+
+// Where appends a list predicates to the PayeeMutation builder.
+func (m *PayeeMutation) Where(ps ...predicate.Payee) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PayeeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PayeeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Payee, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PayeeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PayeeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Payee).
+func (m *PayeeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PayeeMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.workspace != nil {
+		fields = append(fields, payee.FieldWorkspaceID)
+	}
+	if m.name != nil {
+		fields = append(fields, payee.FieldName)
+	}
+	if m.aliases != nil {
+		fields = append(fields, payee.FieldAliases)
+	}
+	if m.default_category != nil {
+		fields = append(fields, payee.FieldDefaultCategoryID)
+	}
+	if m.logo_url != nil {
+		fields = append(fields, payee.FieldLogoURL)
+	}
+	if m.website != nil {
+		fields = append(fields, payee.FieldWebsite)
+	}
+	if m.created_at != nil {
+		fields = append(fields, payee.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, payee.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PayeeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case payee.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case payee.FieldName:
+		return m.Name()
+	case payee.FieldAliases:
+		return m.Aliases()
+	case payee.FieldDefaultCategoryID:
+		return m.DefaultCategoryID()
+	case payee.FieldLogoURL:
+		return m.LogoURL()
+	case payee.FieldWebsite:
+		return m.Website()
+	case payee.FieldCreatedAt:
+		return m.CreatedAt()
+	case payee.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PayeeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case payee.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case payee.FieldName:
+		return m.OldName(ctx)
+	case payee.FieldAliases:
+		return m.OldAliases(ctx)
+	case payee.FieldDefaultCategoryID:
+		return m.OldDefaultCategoryID(ctx)
+	case payee.FieldLogoURL:
+		return m.OldLogoURL(ctx)
+	case payee.FieldWebsite:
+		return m.OldWebsite(ctx)
+	case payee.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case payee.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Payee field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PayeeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case payee.FieldWorkspaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case payee.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case payee.FieldAliases:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAliases(v)
+		return nil
+	case payee.FieldDefaultCategoryID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultCategoryID(v)
+		return nil
+	case payee.FieldLogoURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogoURL(v)
+		return nil
+	case payee.FieldWebsite:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebsite(v)
+		return nil
+	case payee.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case payee.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Payee field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PayeeMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PayeeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PayeeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Payee numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PayeeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(payee.FieldAliases) {
+		fields = append(fields, payee.FieldAliases)
+	}
+	if m.FieldCleared(payee.FieldDefaultCategoryID) {
+		fields = append(fields, payee.FieldDefaultCategoryID)
+	}
+	if m.FieldCleared(payee.FieldLogoURL) {
+		fields = append(fields, payee.FieldLogoURL)
+	}
+	if m.FieldCleared(payee.FieldWebsite) {
+		fields = append(fields, payee.FieldWebsite)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PayeeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PayeeMutation) ClearField(name string) error {
+	switch name {
+	case payee.FieldAliases:
+		m.ClearAliases()
+		return nil
+	case payee.FieldDefaultCategoryID:
+		m.ClearDefaultCategoryID()
+		return nil
+	case payee.FieldLogoURL:
+		m.ClearLogoURL()
+		return nil
+	case payee.FieldWebsite:
+		m.ClearWebsite()
+		return nil
+	}
+	return fmt.Errorf("unknown Payee nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PayeeMutation) ResetField(name string) error {
+	switch name {
+	case payee.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case payee.FieldName:
+		m.ResetName()
+		return nil
+	case payee.FieldAliases:
+		m.ResetAliases()
+		return nil
+	case payee.FieldDefaultCategoryID:
+		m.ResetDefaultCategoryID()
+		return nil
+	case payee.FieldLogoURL:
+		m.ResetLogoURL()
+		return nil
+	case payee.FieldWebsite:
+		m.ResetWebsite()
+		return nil
+	case payee.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case payee.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Payee field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PayeeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.workspace != nil {
+		edges = append(edges, payee.EdgeWorkspace)
+	}
+	if m.default_category != nil {
+		edges = append(edges, payee.EdgeDefaultCategory)
+	}
+	if m.transactions != nil {
+		edges = append(edges, payee.EdgeTransactions)
+	}
+	if m.recurrence_rules != nil {
+		edges = append(edges, payee.EdgeRecurrenceRules)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PayeeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case payee.EdgeWorkspace:
+		if id := m.workspace; id != nil {
+			return []ent.Value{*id}
+		}
+	case payee.EdgeDefaultCategory:
+		if id := m.default_category; id != nil {
+			return []ent.Value{*id}
+		}
+	case payee.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.transactions))
+		for id := range m.transactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case payee.EdgeRecurrenceRules:
+		ids := make([]ent.Value, 0, len(m.recurrence_rules))
+		for id := range m.recurrence_rules {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PayeeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedtransactions != nil {
+		edges = append(edges, payee.EdgeTransactions)
+	}
+	if m.removedrecurrence_rules != nil {
+		edges = append(edges, payee.EdgeRecurrenceRules)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PayeeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case payee.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.removedtransactions))
+		for id := range m.removedtransactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case payee.EdgeRecurrenceRules:
+		ids := make([]ent.Value, 0, len(m.removedrecurrence_rules))
+		for id := range m.removedrecurrence_rules {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PayeeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedworkspace {
+		edges = append(edges, payee.EdgeWorkspace)
+	}
+	if m.cleareddefault_category {
+		edges = append(edges, payee.EdgeDefaultCategory)
+	}
+	if m.clearedtransactions {
+		edges = append(edges, payee.EdgeTransactions)
+	}
+	if m.clearedrecurrence_rules {
+		edges = append(edges, payee.EdgeRecurrenceRules)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PayeeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case payee.EdgeWorkspace:
+		return m.clearedworkspace
+	case payee.EdgeDefaultCategory:
+		return m.cleareddefault_category
+	case payee.EdgeTransactions:
+		return m.clearedtransactions
+	case payee.EdgeRecurrenceRules:
+		return m.clearedrecurrence_rules
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PayeeMutation) ClearEdge(name string) error {
+	switch name {
+	case payee.EdgeWorkspace:
+		m.ClearWorkspace()
+		return nil
+	case payee.EdgeDefaultCategory:
+		m.ClearDefaultCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown Payee unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PayeeMutation) ResetEdge(name string) error {
+	switch name {
+	case payee.EdgeWorkspace:
+		m.ResetWorkspace()
+		return nil
+	case payee.EdgeDefaultCategory:
+		m.ResetDefaultCategory()
+		return nil
+	case payee.EdgeTransactions:
+		m.ResetTransactions()
+		return nil
+	case payee.EdgeRecurrenceRules:
+		m.ResetRecurrenceRules()
+		return nil
+	}
+	return fmt.Errorf("unknown Payee edge %s", name)
+}
+
+// PostingMutation represents an operation that mutates the Posting nodes in the graph.
+type PostingMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	amount               *model.Decimal
+	currency             *string
+	clearedFields        map[string]struct{}
+	workspace            *int
+	clearedworkspace     bool
+	journal_entry        *int
+	clearedjournal_entry bool
+	account              *int
+	clearedaccount       bool
+	done                 bool
+	oldValue             func(context.Context) (*Posting, error)
+	predicates           []predicate.Posting
+}
+
+var _ ent.Mutation = (*PostingMutation)(nil)
+
+// postingOption allows management of the mutation configuration using functional options.
+type postingOption func(*PostingMutation)
+
+// newPostingMutation creates new mutation for the Posting entity.
+func newPostingMutation(c config, op Op, opts ...postingOption) *PostingMutation {
+	m := &PostingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePosting,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPostingID sets the ID field of the mutation.
+func withPostingID(id int) postingOption {
+	return func(m *PostingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Posting
+		)
+		m.oldValue = func(ctx context.Context) (*Posting, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Posting.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPosting sets the old Posting of the mutation.
+func withPosting(node *Posting) postingOption {
+	return func(m *PostingMutation) {
+		m.oldValue = func(context.Context) (*Posting, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Posting.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *PostingMutation) SetWorkspaceID(i int) {
+	m.workspace = &i
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *PostingMutation) WorkspaceID() (r int, exists bool) {
+	v := m.workspace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the Posting entity.
+// If the Posting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostingMutation) OldWorkspaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *PostingMutation) ResetWorkspaceID() {
+	m.workspace = nil
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (m *PostingMutation) SetJournalEntryID(i int) {
+	m.journal_entry = &i
+}
+
+// JournalEntryID returns the value of the "journal_entry_id" field in the mutation.
+func (m *PostingMutation) JournalEntryID() (r int, exists bool) {
+	v := m.journal_entry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJournalEntryID returns the old "journal_entry_id" field's value of the Posting entity.
+// If the Posting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostingMutation) OldJournalEntryID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJournalEntryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJournalEntryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJournalEntryID: %w", err)
+	}
+	return oldValue.JournalEntryID, nil
+}
+
+// ResetJournalEntryID resets all changes to the "journal_entry_id" field.
+func (m *PostingMutation) ResetJournalEntryID() {
+	m.journal_entry = nil
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *PostingMutation) SetAccountID(i int) {
+	m.account = &i
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *PostingMutation) AccountID() (r int, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the Posting entity.
+// If the Posting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostingMutation) OldAccountID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *PostingMutation) ResetAccountID() {
+	m.account = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *PostingMutation) SetAmount(value model.Decimal) {
+	m.amount = &value
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *PostingMutation) Amount() (r model.Decimal, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Posting entity.
+// If the Posting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostingMutation) OldAmount(ctx context.Context) (v model.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *PostingMutation) ResetAmount() {
+	m.amount = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *PostingMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *PostingMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Posting entity.
+// If the Posting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostingMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *PostingMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (m *PostingMutation) ClearWorkspace() {
+	m.clearedworkspace = true
+	m.clearedFields[posting.FieldWorkspaceID] = struct{}{}
+}
+
+// WorkspaceCleared reports if the "workspace" edge to the Workspace entity was cleared.
+func (m *PostingMutation) WorkspaceCleared() bool {
+	return m.clearedworkspace
+}
+
+// WorkspaceIDs returns the "workspace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkspaceID instead. It exists only for internal usage by the builders.
+func (m *PostingMutation) WorkspaceIDs() (ids []int) {
+	if id := m.workspace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkspace resets all changes to the "workspace" edge.
+func (m *PostingMutation) ResetWorkspace() {
+	m.workspace = nil
+	m.clearedworkspace = false
+}
+
+// ClearJournalEntry clears the "journal_entry" edge to the JournalEntry entity.
+func (m *PostingMutation) ClearJournalEntry() {
+	m.clearedjournal_entry = true
+	m.clearedFields[posting.FieldJournalEntryID] = struct{}{}
+}
+
+// JournalEntryCleared reports if the "journal_entry" edge to the JournalEntry entity was cleared.
+func (m *PostingMutation) JournalEntryCleared() bool {
+	return m.clearedjournal_entry
+}
+
+// JournalEntryIDs returns the "journal_entry" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// JournalEntryID instead. It exists only for internal usage by the builders.
+func (m *PostingMutation) JournalEntryIDs() (ids []int) {
+	if id := m.journal_entry; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetJournalEntry resets all changes to the "journal_entry" edge.
+func (m *PostingMutation) ResetJournalEntry() {
+	m.journal_entry = nil
+	m.clearedjournal_entry = false
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *PostingMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[posting.FieldAccountID] = struct{}{}
+}
+
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *PostingMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *PostingMutation) AccountIDs() (ids []int) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *PostingMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// Where appends a list predicates to the PostingMutation builder.
+func (m *PostingMutation) Where(ps ...predicate.Posting) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PostingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Posting, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PostingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PostingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Posting).
+func (m *PostingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostingMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.workspace != nil {
+		fields = append(fields, posting.FieldWorkspaceID)
+	}
+	if m.journal_entry != nil {
+		fields = append(fields, posting.FieldJournalEntryID)
+	}
+	if m.account != nil {
+		fields = append(fields, posting.FieldAccountID)
+	}
+	if m.amount != nil {
+		fields = append(fields, posting.FieldAmount)
+	}
+	if m.currency != nil {
+		fields = append(fields, posting.FieldCurrency)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case posting.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case posting.FieldJournalEntryID:
+		return m.JournalEntryID()
+	case posting.FieldAccountID:
+		return m.AccountID()
+	case posting.FieldAmount:
+		return m.Amount()
+	case posting.FieldCurrency:
+		return m.Currency()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PostingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case posting.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case posting.FieldJournalEntryID:
+		return m.OldJournalEntryID(ctx)
+	case posting.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case posting.FieldAmount:
+		return m.OldAmount(ctx)
+	case posting.FieldCurrency:
+		return m.OldCurrency(ctx)
+	}
+	return nil, fmt.Errorf("unknown Posting field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case posting.FieldWorkspaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case posting.FieldJournalEntryID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJournalEntryID(v)
+		return nil
+	case posting.FieldAccountID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case posting.FieldAmount:
+		v, ok := value.(model.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case posting.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Posting field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PostingMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PostingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Posting numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PostingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PostingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PostingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Posting nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PostingMutation) ResetField(name string) error {
+	switch name {
+	case posting.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case posting.FieldJournalEntryID:
+		m.ResetJournalEntryID()
+		return nil
+	case posting.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case posting.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case posting.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	}
+	return fmt.Errorf("unknown Posting field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PostingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.workspace != nil {
+		edges = append(edges, posting.EdgeWorkspace)
+	}
+	if m.journal_entry != nil {
+		edges = append(edges, posting.EdgeJournalEntry)
+	}
+	if m.account != nil {
+		edges = append(edges, posting.EdgeAccount)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PostingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case posting.EdgeWorkspace:
+		if id := m.workspace; id != nil {
+			return []ent.Value{*id}
+		}
+	case posting.EdgeJournalEntry:
+		if id := m.journal_entry; id != nil {
+			return []ent.Value{*id}
+		}
+	case posting.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PostingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PostingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PostingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedworkspace {
+		edges = append(edges, posting.EdgeWorkspace)
+	}
+	if m.clearedjournal_entry {
+		edges = append(edges, posting.EdgeJournalEntry)
+	}
+	if m.clearedaccount {
+		edges = append(edges, posting.EdgeAccount)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PostingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case posting.EdgeWorkspace:
+		return m.clearedworkspace
+	case posting.EdgeJournalEntry:
+		return m.clearedjournal_entry
+	case posting.EdgeAccount:
+		return m.clearedaccount
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PostingMutation) ClearEdge(name string) error {
+	switch name {
+	case posting.EdgeWorkspace:
+		m.ClearWorkspace()
+		return nil
+	case posting.EdgeJournalEntry:
+		m.ClearJournalEntry()
+		return nil
+	case posting.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown Posting unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PostingMutation) ResetEdge(name string) error {
+	switch name {
+	case posting.EdgeWorkspace:
+		m.ResetWorkspace()
+		return nil
+	case posting.EdgeJournalEntry:
+		m.ResetJournalEntry()
+		return nil
+	case posting.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown Posting edge %s", name)
+}
+
+// RecoveryCodeMutation represents an operation that mutates the RecoveryCode nodes in the graph.
+type RecoveryCodeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	code_hash     *string
+	used_at       *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*RecoveryCode, error)
+	predicates    []predicate.RecoveryCode
+}
+
+var _ ent.Mutation = (*RecoveryCodeMutation)(nil)
+
+// recoverycodeOption allows management of the mutation configuration using functional options.
+type recoverycodeOption func(*RecoveryCodeMutation)
+
+// newRecoveryCodeMutation creates new mutation for the RecoveryCode entity.
+func newRecoveryCodeMutation(c config, op Op, opts ...recoverycodeOption) *RecoveryCodeMutation {
+	m := &RecoveryCodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRecoveryCode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRecoveryCodeID sets the ID field of the mutation.
+func withRecoveryCodeID(id int) recoverycodeOption {
+	return func(m *RecoveryCodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RecoveryCode
+		)
+		m.oldValue = func(ctx context.Context) (*RecoveryCode, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RecoveryCode.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRecoveryCode sets the old RecoveryCode of the mutation.
+func withRecoveryCode(node *RecoveryCode) recoverycodeOption {
+	return func(m *RecoveryCodeMutation) {
+		m.oldValue = func(context.Context) (*RecoveryCode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RecoveryCodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RecoveryCodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RecoveryCodeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RecoveryCodeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RecoveryCode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (m *RecoveryCodeMutation) SetCodeHash(s string) {
+	m.code_hash = &s
+}
+
+// CodeHash returns the value of the "code_hash" field in the mutation.
+func (m *RecoveryCodeMutation) CodeHash() (r string, exists bool) {
+	v := m.code_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCodeHash returns the old "code_hash" field's value of the RecoveryCode entity.
+// If the RecoveryCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecoveryCodeMutation) OldCodeHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodeHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodeHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeHash: %w", err)
+	}
+	return oldValue.CodeHash, nil
+}
+
+// ResetCodeHash resets all changes to the "code_hash" field.
+func (m *RecoveryCodeMutation) ResetCodeHash() {
+	m.code_hash = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RecoveryCodeMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RecoveryCodeMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RecoveryCode entity.
+// If the RecoveryCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecoveryCodeMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RecoveryCodeMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetUsedAt sets the "used_at" field.
+func (m *RecoveryCodeMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
+}
+
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *RecoveryCodeMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsedAt returns the old "used_at" field's value of the RecoveryCode entity.
+// If the RecoveryCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecoveryCodeMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+	}
+	return oldValue.UsedAt, nil
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *RecoveryCodeMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[recoverycode.FieldUsedAt] = struct{}{}
+}
+
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *RecoveryCodeMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[recoverycode.FieldUsedAt]
+	return ok
+}
+
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *RecoveryCodeMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, recoverycode.FieldUsedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RecoveryCodeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RecoveryCodeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RecoveryCode entity.
+// If the RecoveryCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecoveryCodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RecoveryCodeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RecoveryCodeMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[recoverycode.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RecoveryCodeMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RecoveryCodeMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RecoveryCodeMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the RecoveryCodeMutation builder.
+func (m *RecoveryCodeMutation) Where(ps ...predicate.RecoveryCode) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RecoveryCodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RecoveryCodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RecoveryCode, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RecoveryCodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RecoveryCodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RecoveryCode).
+func (m *RecoveryCodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RecoveryCodeMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.code_hash != nil {
+		fields = append(fields, recoverycode.FieldCodeHash)
+	}
+	if m.user != nil {
+		fields = append(fields, recoverycode.FieldUserID)
+	}
+	if m.used_at != nil {
+		fields = append(fields, recoverycode.FieldUsedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, recoverycode.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RecoveryCodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case recoverycode.FieldCodeHash:
+		return m.CodeHash()
+	case recoverycode.FieldUserID:
+		return m.UserID()
+	case recoverycode.FieldUsedAt:
+		return m.UsedAt()
+	case recoverycode.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RecoveryCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case recoverycode.FieldCodeHash:
+		return m.OldCodeHash(ctx)
+	case recoverycode.FieldUserID:
+		return m.OldUserID(ctx)
+	case recoverycode.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case recoverycode.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RecoveryCode field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecoveryCodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case recoverycode.FieldCodeHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodeHash(v)
+		return nil
+	case recoverycode.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case recoverycode.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
+	case recoverycode.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecoveryCode field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RecoveryCodeMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RecoveryCodeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecoveryCodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RecoveryCode numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RecoveryCodeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(recoverycode.FieldUsedAt) {
+		fields = append(fields, recoverycode.FieldUsedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RecoveryCodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RecoveryCodeMutation) ClearField(name string) error {
+	switch name {
+	case recoverycode.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecoveryCode nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RecoveryCodeMutation) ResetField(name string) error {
+	switch name {
+	case recoverycode.FieldCodeHash:
+		m.ResetCodeHash()
+		return nil
+	case recoverycode.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case recoverycode.FieldUsedAt:
+		m.ResetUsedAt()
+		return nil
+	case recoverycode.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecoveryCode field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RecoveryCodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, recoverycode.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RecoveryCodeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case recoverycode.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RecoveryCodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RecoveryCodeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RecoveryCodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, recoverycode.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RecoveryCodeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case recoverycode.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RecoveryCodeMutation) ClearEdge(name string) error {
+	switch name {
+	case recoverycode.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RecoveryCode unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RecoveryCodeMutation) ResetEdge(name string) error {
+	switch name {
+	case recoverycode.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RecoveryCode edge %s", name)
+}
+
+// RecurrenceOccurrenceMutation represents an operation that mutates the RecurrenceOccurrence nodes in the graph.
+type RecurrenceOccurrenceMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	due_on             *time.Time
+	status             *recurrenceoccurrence.Status
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	workspace          *int
+	clearedworkspace   bool
+	rule               *int
+	clearedrule        bool
+	transaction        *int
+	clearedtransaction bool
+	done               bool
+	oldValue           func(context.Context) (*RecurrenceOccurrence, error)
+	predicates         []predicate.RecurrenceOccurrence
+}
+
+var _ ent.Mutation = (*RecurrenceOccurrenceMutation)(nil)
+
+// recurrenceoccurrenceOption allows management of the mutation configuration using functional options.
+type recurrenceoccurrenceOption func(*RecurrenceOccurrenceMutation)
+
+// newRecurrenceOccurrenceMutation creates new mutation for the RecurrenceOccurrence entity.
+func newRecurrenceOccurrenceMutation(c config, op Op, opts ...recurrenceoccurrenceOption) *RecurrenceOccurrenceMutation {
+	m := &RecurrenceOccurrenceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRecurrenceOccurrence,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRecurrenceOccurrenceID sets the ID field of the mutation.
+func withRecurrenceOccurrenceID(id int) recurrenceoccurrenceOption {
+	return func(m *RecurrenceOccurrenceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RecurrenceOccurrence
+		)
+		m.oldValue = func(ctx context.Context) (*RecurrenceOccurrence, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RecurrenceOccurrence.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRecurrenceOccurrence sets the old RecurrenceOccurrence of the mutation.
+func withRecurrenceOccurrence(node *RecurrenceOccurrence) recurrenceoccurrenceOption {
+	return func(m *RecurrenceOccurrenceMutation) {
+		m.oldValue = func(context.Context) (*RecurrenceOccurrence, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RecurrenceOccurrenceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RecurrenceOccurrenceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RecurrenceOccurrenceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RecurrenceOccurrenceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RecurrenceOccurrence.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *RecurrenceOccurrenceMutation) SetWorkspaceID(i int) {
+	m.workspace = &i
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *RecurrenceOccurrenceMutation) WorkspaceID() (r int, exists bool) {
+	v := m.workspace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the RecurrenceOccurrence entity.
+// If the RecurrenceOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceOccurrenceMutation) OldWorkspaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *RecurrenceOccurrenceMutation) ResetWorkspaceID() {
+	m.workspace = nil
+}
+
+// SetRuleID sets the "rule_id" field.
+func (m *RecurrenceOccurrenceMutation) SetRuleID(i int) {
+	m.rule = &i
+}
+
+// RuleID returns the value of the "rule_id" field in the mutation.
+func (m *RecurrenceOccurrenceMutation) RuleID() (r int, exists bool) {
+	v := m.rule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRuleID returns the old "rule_id" field's value of the RecurrenceOccurrence entity.
+// If the RecurrenceOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceOccurrenceMutation) OldRuleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRuleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRuleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRuleID: %w", err)
+	}
+	return oldValue.RuleID, nil
+}
+
+// ResetRuleID resets all changes to the "rule_id" field.
+func (m *RecurrenceOccurrenceMutation) ResetRuleID() {
+	m.rule = nil
+}
+
+// SetDueOn sets the "due_on" field.
+func (m *RecurrenceOccurrenceMutation) SetDueOn(t time.Time) {
+	m.due_on = &t
+}
+
+// DueOn returns the value of the "due_on" field in the mutation.
+func (m *RecurrenceOccurrenceMutation) DueOn() (r time.Time, exists bool) {
+	v := m.due_on
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDueOn returns the old "due_on" field's value of the RecurrenceOccurrence entity.
+// If the RecurrenceOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceOccurrenceMutation) OldDueOn(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDueOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDueOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDueOn: %w", err)
+	}
+	return oldValue.DueOn, nil
+}
+
+// ResetDueOn resets all changes to the "due_on" field.
+func (m *RecurrenceOccurrenceMutation) ResetDueOn() {
+	m.due_on = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *RecurrenceOccurrenceMutation) SetStatus(r recurrenceoccurrence.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *RecurrenceOccurrenceMutation) Status() (r recurrenceoccurrence.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the RecurrenceOccurrence entity.
+// If the RecurrenceOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceOccurrenceMutation) OldStatus(ctx context.Context) (v recurrenceoccurrence.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *RecurrenceOccurrenceMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (m *RecurrenceOccurrenceMutation) SetTransactionID(i int) {
+	m.transaction = &i
+}
+
+// TransactionID returns the value of the "transaction_id" field in the mutation.
+func (m *RecurrenceOccurrenceMutation) TransactionID() (r int, exists bool) {
+	v := m.transaction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransactionID returns the old "transaction_id" field's value of the RecurrenceOccurrence entity.
+// If the RecurrenceOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceOccurrenceMutation) OldTransactionID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransactionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransactionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransactionID: %w", err)
+	}
+	return oldValue.TransactionID, nil
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (m *RecurrenceOccurrenceMutation) ClearTransactionID() {
+	m.transaction = nil
+	m.clearedFields[recurrenceoccurrence.FieldTransactionID] = struct{}{}
+}
+
+// TransactionIDCleared returns if the "transaction_id" field was cleared in this mutation.
+func (m *RecurrenceOccurrenceMutation) TransactionIDCleared() bool {
+	_, ok := m.clearedFields[recurrenceoccurrence.FieldTransactionID]
+	return ok
+}
+
+// ResetTransactionID resets all changes to the "transaction_id" field.
+func (m *RecurrenceOccurrenceMutation) ResetTransactionID() {
+	m.transaction = nil
+	delete(m.clearedFields, recurrenceoccurrence.FieldTransactionID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RecurrenceOccurrenceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RecurrenceOccurrenceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RecurrenceOccurrence entity.
+// If the RecurrenceOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceOccurrenceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RecurrenceOccurrenceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RecurrenceOccurrenceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RecurrenceOccurrenceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RecurrenceOccurrence entity.
+// If the RecurrenceOccurrence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceOccurrenceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RecurrenceOccurrenceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (m *RecurrenceOccurrenceMutation) ClearWorkspace() {
+	m.clearedworkspace = true
+	m.clearedFields[recurrenceoccurrence.FieldWorkspaceID] = struct{}{}
+}
+
+// WorkspaceCleared reports if the "workspace" edge to the Workspace entity was cleared.
+func (m *RecurrenceOccurrenceMutation) WorkspaceCleared() bool {
+	return m.clearedworkspace
+}
+
+// WorkspaceIDs returns the "workspace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkspaceID instead. It exists only for internal usage by the builders.
+func (m *RecurrenceOccurrenceMutation) WorkspaceIDs() (ids []int) {
+	if id := m.workspace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkspace resets all changes to the "workspace" edge.
+func (m *RecurrenceOccurrenceMutation) ResetWorkspace() {
+	m.workspace = nil
+	m.clearedworkspace = false
+}
+
+// ClearRule clears the "rule" edge to the RecurrenceRule entity.
+func (m *RecurrenceOccurrenceMutation) ClearRule() {
+	m.clearedrule = true
+	m.clearedFields[recurrenceoccurrence.FieldRuleID] = struct{}{}
+}
+
+// RuleCleared reports if the "rule" edge to the RecurrenceRule entity was cleared.
+func (m *RecurrenceOccurrenceMutation) RuleCleared() bool {
+	return m.clearedrule
+}
+
+// RuleIDs returns the "rule" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RuleID instead. It exists only for internal usage by the builders.
+func (m *RecurrenceOccurrenceMutation) RuleIDs() (ids []int) {
+	if id := m.rule; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRule resets all changes to the "rule" edge.
+func (m *RecurrenceOccurrenceMutation) ResetRule() {
+	m.rule = nil
+	m.clearedrule = false
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (m *RecurrenceOccurrenceMutation) ClearTransaction() {
+	m.clearedtransaction = true
+	m.clearedFields[recurrenceoccurrence.FieldTransactionID] = struct{}{}
+}
+
+// TransactionCleared reports if the "transaction" edge to the Transaction entity was cleared.
+func (m *RecurrenceOccurrenceMutation) TransactionCleared() bool {
+	return m.TransactionIDCleared() || m.clearedtransaction
+}
+
+// TransactionIDs returns the "transaction" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TransactionID instead. It exists only for internal usage by the builders.
+func (m *RecurrenceOccurrenceMutation) TransactionIDs() (ids []int) {
+	if id := m.transaction; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTransaction resets all changes to the "transaction" edge.
+func (m *RecurrenceOccurrenceMutation) ResetTransaction() {
+	m.transaction = nil
+	m.clearedtransaction = false
+}
+
+// Where appends a list predicates to the RecurrenceOccurrenceMutation builder.
+func (m *RecurrenceOccurrenceMutation) Where(ps ...predicate.RecurrenceOccurrence) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RecurrenceOccurrenceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RecurrenceOccurrenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RecurrenceOccurrence, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RecurrenceOccurrenceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RecurrenceOccurrenceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RecurrenceOccurrence).
+func (m *RecurrenceOccurrenceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RecurrenceOccurrenceMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.workspace != nil {
+		fields = append(fields, recurrenceoccurrence.FieldWorkspaceID)
+	}
+	if m.rule != nil {
+		fields = append(fields, recurrenceoccurrence.FieldRuleID)
+	}
+	if m.due_on != nil {
+		fields = append(fields, recurrenceoccurrence.FieldDueOn)
+	}
+	if m.status != nil {
+		fields = append(fields, recurrenceoccurrence.FieldStatus)
+	}
+	if m.transaction != nil {
+		fields = append(fields, recurrenceoccurrence.FieldTransactionID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, recurrenceoccurrence.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, recurrenceoccurrence.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RecurrenceOccurrenceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case recurrenceoccurrence.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case recurrenceoccurrence.FieldRuleID:
+		return m.RuleID()
+	case recurrenceoccurrence.FieldDueOn:
+		return m.DueOn()
+	case recurrenceoccurrence.FieldStatus:
+		return m.Status()
+	case recurrenceoccurrence.FieldTransactionID:
+		return m.TransactionID()
+	case recurrenceoccurrence.FieldCreatedAt:
+		return m.CreatedAt()
+	case recurrenceoccurrence.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RecurrenceOccurrenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case recurrenceoccurrence.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case recurrenceoccurrence.FieldRuleID:
+		return m.OldRuleID(ctx)
+	case recurrenceoccurrence.FieldDueOn:
+		return m.OldDueOn(ctx)
+	case recurrenceoccurrence.FieldStatus:
+		return m.OldStatus(ctx)
+	case recurrenceoccurrence.FieldTransactionID:
+		return m.OldTransactionID(ctx)
+	case recurrenceoccurrence.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case recurrenceoccurrence.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RecurrenceOccurrence field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecurrenceOccurrenceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case recurrenceoccurrence.FieldWorkspaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case recurrenceoccurrence.FieldRuleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRuleID(v)
+		return nil
+	case recurrenceoccurrence.FieldDueOn:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueOn(v)
+		return nil
+	case recurrenceoccurrence.FieldStatus:
+		v, ok := value.(recurrenceoccurrence.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case recurrenceoccurrence.FieldTransactionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransactionID(v)
+		return nil
+	case recurrenceoccurrence.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case recurrenceoccurrence.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecurrenceOccurrence field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RecurrenceOccurrenceMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RecurrenceOccurrenceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecurrenceOccurrenceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RecurrenceOccurrence numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RecurrenceOccurrenceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(recurrenceoccurrence.FieldTransactionID) {
+		fields = append(fields, recurrenceoccurrence.FieldTransactionID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RecurrenceOccurrenceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RecurrenceOccurrenceMutation) ClearField(name string) error {
+	switch name {
+	case recurrenceoccurrence.FieldTransactionID:
+		m.ClearTransactionID()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurrenceOccurrence nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RecurrenceOccurrenceMutation) ResetField(name string) error {
+	switch name {
+	case recurrenceoccurrence.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case recurrenceoccurrence.FieldRuleID:
+		m.ResetRuleID()
+		return nil
+	case recurrenceoccurrence.FieldDueOn:
+		m.ResetDueOn()
+		return nil
+	case recurrenceoccurrence.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case recurrenceoccurrence.FieldTransactionID:
+		m.ResetTransactionID()
+		return nil
+	case recurrenceoccurrence.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case recurrenceoccurrence.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurrenceOccurrence field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RecurrenceOccurrenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.workspace != nil {
+		edges = append(edges, recurrenceoccurrence.EdgeWorkspace)
+	}
+	if m.rule != nil {
+		edges = append(edges, recurrenceoccurrence.EdgeRule)
+	}
+	if m.transaction != nil {
+		edges = append(edges, recurrenceoccurrence.EdgeTransaction)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RecurrenceOccurrenceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case recurrenceoccurrence.EdgeWorkspace:
+		if id := m.workspace; id != nil {
+			return []ent.Value{*id}
+		}
+	case recurrenceoccurrence.EdgeRule:
+		if id := m.rule; id != nil {
+			return []ent.Value{*id}
+		}
+	case recurrenceoccurrence.EdgeTransaction:
+		if id := m.transaction; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RecurrenceOccurrenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RecurrenceOccurrenceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RecurrenceOccurrenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedworkspace {
+		edges = append(edges, recurrenceoccurrence.EdgeWorkspace)
+	}
+	if m.clearedrule {
+		edges = append(edges, recurrenceoccurrence.EdgeRule)
+	}
+	if m.clearedtransaction {
+		edges = append(edges, recurrenceoccurrence.EdgeTransaction)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RecurrenceOccurrenceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case recurrenceoccurrence.EdgeWorkspace:
+		return m.clearedworkspace
+	case recurrenceoccurrence.EdgeRule:
+		return m.clearedrule
+	case recurrenceoccurrence.EdgeTransaction:
+		return m.clearedtransaction
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RecurrenceOccurrenceMutation) ClearEdge(name string) error {
+	switch name {
+	case recurrenceoccurrence.EdgeWorkspace:
+		m.ClearWorkspace()
+		return nil
+	case recurrenceoccurrence.EdgeRule:
+		m.ClearRule()
+		return nil
+	case recurrenceoccurrence.EdgeTransaction:
+		m.ClearTransaction()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurrenceOccurrence unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RecurrenceOccurrenceMutation) ResetEdge(name string) error {
+	switch name {
+	case recurrenceoccurrence.EdgeWorkspace:
+		m.ResetWorkspace()
+		return nil
+	case recurrenceoccurrence.EdgeRule:
+		m.ResetRule()
+		return nil
+	case recurrenceoccurrence.EdgeTransaction:
+		m.ResetTransaction()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurrenceOccurrence edge %s", name)
+}
+
+// RecurrenceRuleMutation represents an operation that mutates the RecurrenceRule nodes in the graph.
+type RecurrenceRuleMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	amount              *model.Decimal
+	currency            *string
+	payee               *string
+	memo                *string
+	frequency           *recurrencerule.Frequency
+	interval            *int
+	addinterval         *int
+	by_day              *[]string
+	appendby_day        []string
+	starts_on           *time.Time
+	ends_on             *time.Time
+	scheduled_from      *time.Time
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	workspace           *int
+	clearedworkspace    bool
+	account             *int
+	clearedaccount      bool
+	category            *int
+	clearedcategory     bool
+	linked_payee        *int
+	clearedlinked_payee bool
+	occurrences         map[int]struct{}
+	removedoccurrences  map[int]struct{}
+	clearedoccurrences  bool
+	done                bool
+	oldValue            func(context.Context) (*RecurrenceRule, error)
+	predicates          []predicate.RecurrenceRule
+}
+
+var _ ent.Mutation = (*RecurrenceRuleMutation)(nil)
+
+// recurrenceruleOption allows management of the mutation configuration using functional options.
+type recurrenceruleOption func(*RecurrenceRuleMutation)
+
+// newRecurrenceRuleMutation creates new mutation for the RecurrenceRule entity.
+func newRecurrenceRuleMutation(c config, op Op, opts ...recurrenceruleOption) *RecurrenceRuleMutation {
+	m := &RecurrenceRuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRecurrenceRule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRecurrenceRuleID sets the ID field of the mutation.
+func withRecurrenceRuleID(id int) recurrenceruleOption {
+	return func(m *RecurrenceRuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RecurrenceRule
+		)
+		m.oldValue = func(ctx context.Context) (*RecurrenceRule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RecurrenceRule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRecurrenceRule sets the old RecurrenceRule of the mutation.
+func withRecurrenceRule(node *RecurrenceRule) recurrenceruleOption {
+	return func(m *RecurrenceRuleMutation) {
+		m.oldValue = func(context.Context) (*RecurrenceRule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RecurrenceRuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RecurrenceRuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RecurrenceRuleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RecurrenceRuleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RecurrenceRule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *RecurrenceRuleMutation) SetWorkspaceID(i int) {
+	m.workspace = &i
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *RecurrenceRuleMutation) WorkspaceID() (r int, exists bool) {
+	v := m.workspace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the RecurrenceRule entity.
+// If the RecurrenceRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceRuleMutation) OldWorkspaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *RecurrenceRuleMutation) ResetWorkspaceID() {
+	m.workspace = nil
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *RecurrenceRuleMutation) SetAccountID(i int) {
+	m.account = &i
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *RecurrenceRuleMutation) AccountID() (r int, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the RecurrenceRule entity.
+// If the RecurrenceRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceRuleMutation) OldAccountID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *RecurrenceRuleMutation) ResetAccountID() {
+	m.account = nil
+}
+
+// SetCategoryID sets the "category_id" field.
+func (m *RecurrenceRuleMutation) SetCategoryID(i int) {
+	m.category = &i
+}
+
+// CategoryID returns the value of the "category_id" field in the mutation.
+func (m *RecurrenceRuleMutation) CategoryID() (r int, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategoryID returns the old "category_id" field's value of the RecurrenceRule entity.
+// If the RecurrenceRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceRuleMutation) OldCategoryID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategoryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategoryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategoryID: %w", err)
+	}
+	return oldValue.CategoryID, nil
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (m *RecurrenceRuleMutation) ClearCategoryID() {
+	m.category = nil
+	m.clearedFields[recurrencerule.FieldCategoryID] = struct{}{}
+}
+
+// CategoryIDCleared returns if the "category_id" field was cleared in this mutation.
+func (m *RecurrenceRuleMutation) CategoryIDCleared() bool {
+	_, ok := m.clearedFields[recurrencerule.FieldCategoryID]
+	return ok
+}
+
+// ResetCategoryID resets all changes to the "category_id" field.
+func (m *RecurrenceRuleMutation) ResetCategoryID() {
+	m.category = nil
+	delete(m.clearedFields, recurrencerule.FieldCategoryID)
+}
+
+// SetPayeeID sets the "payee_id" field.
+func (m *RecurrenceRuleMutation) SetPayeeID(i int) {
+	m.linked_payee = &i
+}
+
+// PayeeID returns the value of the "payee_id" field in the mutation.
+func (m *RecurrenceRuleMutation) PayeeID() (r int, exists bool) {
+	v := m.linked_payee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayeeID returns the old "payee_id" field's value of the RecurrenceRule entity.
+// If the RecurrenceRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceRuleMutation) OldPayeeID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayeeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayeeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayeeID: %w", err)
+	}
+	return oldValue.PayeeID, nil
+}
+
+// ClearPayeeID clears the value of the "payee_id" field.
+func (m *RecurrenceRuleMutation) ClearPayeeID() {
+	m.linked_payee = nil
+	m.clearedFields[recurrencerule.FieldPayeeID] = struct{}{}
+}
+
+// PayeeIDCleared returns if the "payee_id" field was cleared in this mutation.
+func (m *RecurrenceRuleMutation) PayeeIDCleared() bool {
+	_, ok := m.clearedFields[recurrencerule.FieldPayeeID]
+	return ok
+}
+
+// ResetPayeeID resets all changes to the "payee_id" field.
+func (m *RecurrenceRuleMutation) ResetPayeeID() {
+	m.linked_payee = nil
+	delete(m.clearedFields, recurrencerule.FieldPayeeID)
+}
+
+// SetAmount sets the "amount" field.
+func (m *RecurrenceRuleMutation) SetAmount(value model.Decimal) {
+	m.amount = &value
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *RecurrenceRuleMutation) Amount() (r model.Decimal, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the RecurrenceRule entity.
+// If the RecurrenceRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceRuleMutation) OldAmount(ctx context.Context) (v model.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *RecurrenceRuleMutation) ResetAmount() {
+	m.amount = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *RecurrenceRuleMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *RecurrenceRuleMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the RecurrenceRule entity.
+// If the RecurrenceRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceRuleMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *RecurrenceRuleMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetPayee sets the "payee" field.
+func (m *RecurrenceRuleMutation) SetPayee(s string) {
+	m.payee = &s
+}
+
+// Payee returns the value of the "payee" field in the mutation.
+func (m *RecurrenceRuleMutation) Payee() (r string, exists bool) {
+	v := m.payee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayee returns the old "payee" field's value of the RecurrenceRule entity.
+// If the RecurrenceRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceRuleMutation) OldPayee(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayee: %w", err)
+	}
+	return oldValue.Payee, nil
+}
+
+// ClearPayee clears the value of the "payee" field.
+func (m *RecurrenceRuleMutation) ClearPayee() {
+	m.payee = nil
+	m.clearedFields[recurrencerule.FieldPayee] = struct{}{}
+}
+
+// PayeeCleared returns if the "payee" field was cleared in this mutation.
+func (m *RecurrenceRuleMutation) PayeeCleared() bool {
+	_, ok := m.clearedFields[recurrencerule.FieldPayee]
+	return ok
+}
+
+// ResetPayee resets all changes to the "payee" field.
+func (m *RecurrenceRuleMutation) ResetPayee() {
+	m.payee = nil
+	delete(m.clearedFields, recurrencerule.FieldPayee)
+}
+
+// SetMemo sets the "memo" field.
+func (m *RecurrenceRuleMutation) SetMemo(s string) {
+	m.memo = &s
+}
+
+// Memo returns the value of the "memo" field in the mutation.
+func (m *RecurrenceRuleMutation) Memo() (r string, exists bool) {
+	v := m.memo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMemo returns the old "memo" field's value of the RecurrenceRule entity.
+// If the RecurrenceRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceRuleMutation) OldMemo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMemo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMemo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMemo: %w", err)
+	}
+	return oldValue.Memo, nil
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (m *RecurrenceRuleMutation) ClearMemo() {
+	m.memo = nil
+	m.clearedFields[recurrencerule.FieldMemo] = struct{}{}
+}
+
+// MemoCleared returns if the "memo" field was cleared in this mutation.
+func (m *RecurrenceRuleMutation) MemoCleared() bool {
+	_, ok := m.clearedFields[recurrencerule.FieldMemo]
+	return ok
+}
+
+// ResetMemo resets all changes to the "memo" field.
+func (m *RecurrenceRuleMutation) ResetMemo() {
+	m.memo = nil
+	delete(m.clearedFields, recurrencerule.FieldMemo)
+}
+
+// SetFrequency sets the "frequency" field.
+func (m *RecurrenceRuleMutation) SetFrequency(r recurrencerule.Frequency) {
+	m.frequency = &r
+}
+
+// Frequency returns the value of the "frequency" field in the mutation.
+func (m *RecurrenceRuleMutation) Frequency() (r recurrencerule.Frequency, exists bool) {
+	v := m.frequency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFrequency returns the old "frequency" field's value of the RecurrenceRule entity.
+// If the RecurrenceRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceRuleMutation) OldFrequency(ctx context.Context) (v recurrencerule.Frequency, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFrequency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFrequency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFrequency: %w", err)
+	}
+	return oldValue.Frequency, nil
+}
+
+// ResetFrequency resets all changes to the "frequency" field.
+func (m *RecurrenceRuleMutation) ResetFrequency() {
+	m.frequency = nil
+}
+
+// SetInterval sets the "interval" field.
+func (m *RecurrenceRuleMutation) SetInterval(i int) {
+	m.interval = &i
+	m.addinterval = nil
+}
+
+// Interval returns the value of the "interval" field in the mutation.
+func (m *RecurrenceRuleMutation) Interval() (r int, exists bool) {
+	v := m.interval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInterval returns the old "interval" field's value of the RecurrenceRule entity.
+// If the RecurrenceRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceRuleMutation) OldInterval(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInterval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInterval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInterval: %w", err)
+	}
+	return oldValue.Interval, nil
+}
+
+// AddInterval adds i to the "interval" field.
+func (m *RecurrenceRuleMutation) AddInterval(i int) {
+	if m.addinterval != nil {
+		*m.addinterval += i
+	} else {
+		m.addinterval = &i
+	}
+}
+
+// AddedInterval returns the value that was added to the "interval" field in this mutation.
+func (m *RecurrenceRuleMutation) AddedInterval() (r int, exists bool) {
+	v := m.addinterval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInterval resets all changes to the "interval" field.
+func (m *RecurrenceRuleMutation) ResetInterval() {
+	m.interval = nil
+	m.addinterval = nil
+}
+
+// SetByDay sets the "by_day" field.
+func (m *RecurrenceRuleMutation) SetByDay(s []string) {
+	m.by_day = &s
+	m.appendby_day = nil
+}
+
+// ByDay returns the value of the "by_day" field in the mutation.
+func (m *RecurrenceRuleMutation) ByDay() (r []string, exists bool) {
+	v := m.by_day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldByDay returns the old "by_day" field's value of the RecurrenceRule entity.
+// If the RecurrenceRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceRuleMutation) OldByDay(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldByDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldByDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldByDay: %w", err)
+	}
+	return oldValue.ByDay, nil
+}
+
+// AppendByDay adds s to the "by_day" field.
+func (m *RecurrenceRuleMutation) AppendByDay(s []string) {
+	m.appendby_day = append(m.appendby_day, s...)
+}
+
+// AppendedByDay returns the list of values that were appended to the "by_day" field in this mutation.
+func (m *RecurrenceRuleMutation) AppendedByDay() ([]string, bool) {
+	if len(m.appendby_day) == 0 {
+		return nil, false
+	}
+	return m.appendby_day, true
+}
+
+// ClearByDay clears the value of the "by_day" field.
+func (m *RecurrenceRuleMutation) ClearByDay() {
+	m.by_day = nil
+	m.appendby_day = nil
+	m.clearedFields[recurrencerule.FieldByDay] = struct{}{}
+}
+
+// ByDayCleared returns if the "by_day" field was cleared in this mutation.
+func (m *RecurrenceRuleMutation) ByDayCleared() bool {
+	_, ok := m.clearedFields[recurrencerule.FieldByDay]
+	return ok
+}
+
+// ResetByDay resets all changes to the "by_day" field.
+func (m *RecurrenceRuleMutation) ResetByDay() {
+	m.by_day = nil
+	m.appendby_day = nil
+	delete(m.clearedFields, recurrencerule.FieldByDay)
+}
+
+// SetStartsOn sets the "starts_on" field.
+func (m *RecurrenceRuleMutation) SetStartsOn(t time.Time) {
+	m.starts_on = &t
+}
+
+// StartsOn returns the value of the "starts_on" field in the mutation.
+func (m *RecurrenceRuleMutation) StartsOn() (r time.Time, exists bool) {
+	v := m.starts_on
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartsOn returns the old "starts_on" field's value of the RecurrenceRule entity.
+// If the RecurrenceRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceRuleMutation) OldStartsOn(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsOn: %w", err)
+	}
+	return oldValue.StartsOn, nil
+}
+
+// ResetStartsOn resets all changes to the "starts_on" field.
+func (m *RecurrenceRuleMutation) ResetStartsOn() {
+	m.starts_on = nil
+}
+
+// SetEndsOn sets the "ends_on" field.
+func (m *RecurrenceRuleMutation) SetEndsOn(t time.Time) {
+	m.ends_on = &t
+}
+
+// EndsOn returns the value of the "ends_on" field in the mutation.
+func (m *RecurrenceRuleMutation) EndsOn() (r time.Time, exists bool) {
+	v := m.ends_on
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndsOn returns the old "ends_on" field's value of the RecurrenceRule entity.
+// If the RecurrenceRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceRuleMutation) OldEndsOn(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndsOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndsOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndsOn: %w", err)
+	}
+	return oldValue.EndsOn, nil
+}
+
+// ClearEndsOn clears the value of the "ends_on" field.
+func (m *RecurrenceRuleMutation) ClearEndsOn() {
+	m.ends_on = nil
+	m.clearedFields[recurrencerule.FieldEndsOn] = struct{}{}
+}
+
+// EndsOnCleared returns if the "ends_on" field was cleared in this mutation.
+func (m *RecurrenceRuleMutation) EndsOnCleared() bool {
+	_, ok := m.clearedFields[recurrencerule.FieldEndsOn]
+	return ok
+}
+
+// ResetEndsOn resets all changes to the "ends_on" field.
+func (m *RecurrenceRuleMutation) ResetEndsOn() {
+	m.ends_on = nil
+	delete(m.clearedFields, recurrencerule.FieldEndsOn)
+}
+
+// SetScheduledFrom sets the "scheduled_from" field.
+func (m *RecurrenceRuleMutation) SetScheduledFrom(t time.Time) {
+	m.scheduled_from = &t
+}
+
+// ScheduledFrom returns the value of the "scheduled_from" field in the mutation.
+func (m *RecurrenceRuleMutation) ScheduledFrom() (r time.Time, exists bool) {
+	v := m.scheduled_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduledFrom returns the old "scheduled_from" field's value of the RecurrenceRule entity.
+// If the RecurrenceRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceRuleMutation) OldScheduledFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduledFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduledFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduledFrom: %w", err)
+	}
+	return oldValue.ScheduledFrom, nil
+}
+
+// ResetScheduledFrom resets all changes to the "scheduled_from" field.
+func (m *RecurrenceRuleMutation) ResetScheduledFrom() {
+	m.scheduled_from = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RecurrenceRuleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RecurrenceRuleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RecurrenceRule entity.
+// If the RecurrenceRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RecurrenceRuleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RecurrenceRuleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RecurrenceRuleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RecurrenceRule entity.
+// If the RecurrenceRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurrenceRuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RecurrenceRuleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (m *RecurrenceRuleMutation) ClearWorkspace() {
+	m.clearedworkspace = true
+	m.clearedFields[recurrencerule.FieldWorkspaceID] = struct{}{}
+}
+
+// WorkspaceCleared reports if the "workspace" edge to the Workspace entity was cleared.
+func (m *RecurrenceRuleMutation) WorkspaceCleared() bool {
+	return m.clearedworkspace
+}
+
+// WorkspaceIDs returns the "workspace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkspaceID instead. It exists only for internal usage by the builders.
+func (m *RecurrenceRuleMutation) WorkspaceIDs() (ids []int) {
+	if id := m.workspace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkspace resets all changes to the "workspace" edge.
+func (m *RecurrenceRuleMutation) ResetWorkspace() {
+	m.workspace = nil
+	m.clearedworkspace = false
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *RecurrenceRuleMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[recurrencerule.FieldAccountID] = struct{}{}
+}
+
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *RecurrenceRuleMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *RecurrenceRuleMutation) AccountIDs() (ids []int) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *RecurrenceRuleMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (m *RecurrenceRuleMutation) ClearCategory() {
+	m.clearedcategory = true
+	m.clearedFields[recurrencerule.FieldCategoryID] = struct{}{}
+}
+
+// CategoryCleared reports if the "category" edge to the Category entity was cleared.
+func (m *RecurrenceRuleMutation) CategoryCleared() bool {
+	return m.CategoryIDCleared() || m.clearedcategory
+}
+
+// CategoryIDs returns the "category" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CategoryID instead. It exists only for internal usage by the builders.
+func (m *RecurrenceRuleMutation) CategoryIDs() (ids []int) {
+	if id := m.category; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCategory resets all changes to the "category" edge.
+func (m *RecurrenceRuleMutation) ResetCategory() {
+	m.category = nil
+	m.clearedcategory = false
+}
+
+// SetLinkedPayeeID sets the "linked_payee" edge to the Payee entity by id.
+func (m *RecurrenceRuleMutation) SetLinkedPayeeID(id int) {
+	m.linked_payee = &id
+}
+
+// ClearLinkedPayee clears the "linked_payee" edge to the Payee entity.
+func (m *RecurrenceRuleMutation) ClearLinkedPayee() {
+	m.clearedlinked_payee = true
+	m.clearedFields[recurrencerule.FieldPayeeID] = struct{}{}
+}
+
+// LinkedPayeeCleared reports if the "linked_payee" edge to the Payee entity was cleared.
+func (m *RecurrenceRuleMutation) LinkedPayeeCleared() bool {
+	return m.PayeeIDCleared() || m.clearedlinked_payee
+}
+
+// LinkedPayeeID returns the "linked_payee" edge ID in the mutation.
+func (m *RecurrenceRuleMutation) LinkedPayeeID() (id int, exists bool) {
+	if m.linked_payee != nil {
+		return *m.linked_payee, true
+	}
+	return
+}
+
+// LinkedPayeeIDs returns the "linked_payee" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LinkedPayeeID instead. It exists only for internal usage by the builders.
+func (m *RecurrenceRuleMutation) LinkedPayeeIDs() (ids []int) {
+	if id := m.linked_payee; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLinkedPayee resets all changes to the "linked_payee" edge.
+func (m *RecurrenceRuleMutation) ResetLinkedPayee() {
+	m.linked_payee = nil
+	m.clearedlinked_payee = false
+}
+
+// AddOccurrenceIDs adds the "occurrences" edge to the RecurrenceOccurrence entity by ids.
+func (m *RecurrenceRuleMutation) AddOccurrenceIDs(ids ...int) {
+	if m.occurrences == nil {
+		m.occurrences = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.occurrences[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOccurrences clears the "occurrences" edge to the RecurrenceOccurrence entity.
+func (m *RecurrenceRuleMutation) ClearOccurrences() {
+	m.clearedoccurrences = true
+}
+
+// OccurrencesCleared reports if the "occurrences" edge to the RecurrenceOccurrence entity was cleared.
+func (m *RecurrenceRuleMutation) OccurrencesCleared() bool {
+	return m.clearedoccurrences
+}
+
+// RemoveOccurrenceIDs removes the "occurrences" edge to the RecurrenceOccurrence entity by IDs.
+func (m *RecurrenceRuleMutation) RemoveOccurrenceIDs(ids ...int) {
+	if m.removedoccurrences == nil {
+		m.removedoccurrences = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.occurrences, ids[i])
+		m.removedoccurrences[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOccurrences returns the removed IDs of the "occurrences" edge to the RecurrenceOccurrence entity.
+func (m *RecurrenceRuleMutation) RemovedOccurrencesIDs() (ids []int) {
+	for id := range m.removedoccurrences {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OccurrencesIDs returns the "occurrences" edge IDs in the mutation.
+func (m *RecurrenceRuleMutation) OccurrencesIDs() (ids []int) {
+	for id := range m.occurrences {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOccurrences resets all changes to the "occurrences" edge.
+func (m *RecurrenceRuleMutation) ResetOccurrences() {
+	m.occurrences = nil
+	m.clearedoccurrences = false
+	m.removedoccurrences = nil
+}
+
+// Where appends a list predicates to the RecurrenceRuleMutation builder.
+func (m *RecurrenceRuleMutation) Where(ps ...predicate.RecurrenceRule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RecurrenceRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RecurrenceRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RecurrenceRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RecurrenceRuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RecurrenceRuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RecurrenceRule).
+func (m *RecurrenceRuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RecurrenceRuleMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.workspace != nil {
+		fields = append(fields, recurrencerule.FieldWorkspaceID)
+	}
+	if m.account != nil {
+		fields = append(fields, recurrencerule.FieldAccountID)
+	}
+	if m.category != nil {
+		fields = append(fields, recurrencerule.FieldCategoryID)
+	}
+	if m.linked_payee != nil {
+		fields = append(fields, recurrencerule.FieldPayeeID)
+	}
+	if m.amount != nil {
+		fields = append(fields, recurrencerule.FieldAmount)
+	}
+	if m.currency != nil {
+		fields = append(fields, recurrencerule.FieldCurrency)
+	}
+	if m.payee != nil {
+		fields = append(fields, recurrencerule.FieldPayee)
+	}
+	if m.memo != nil {
+		fields = append(fields, recurrencerule.FieldMemo)
+	}
+	if m.frequency != nil {
+		fields = append(fields, recurrencerule.FieldFrequency)
+	}
+	if m.interval != nil {
+		fields = append(fields, recurrencerule.FieldInterval)
+	}
+	if m.by_day != nil {
+		fields = append(fields, recurrencerule.FieldByDay)
+	}
+	if m.starts_on != nil {
+		fields = append(fields, recurrencerule.FieldStartsOn)
+	}
+	if m.ends_on != nil {
+		fields = append(fields, recurrencerule.FieldEndsOn)
+	}
+	if m.scheduled_from != nil {
+		fields = append(fields, recurrencerule.FieldScheduledFrom)
+	}
+	if m.created_at != nil {
+		fields = append(fields, recurrencerule.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, recurrencerule.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RecurrenceRuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case recurrencerule.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case recurrencerule.FieldAccountID:
+		return m.AccountID()
+	case recurrencerule.FieldCategoryID:
+		return m.CategoryID()
+	case recurrencerule.FieldPayeeID:
+		return m.PayeeID()
+	case recurrencerule.FieldAmount:
+		return m.Amount()
+	case recurrencerule.FieldCurrency:
+		return m.Currency()
+	case recurrencerule.FieldPayee:
+		return m.Payee()
+	case recurrencerule.FieldMemo:
+		return m.Memo()
+	case recurrencerule.FieldFrequency:
+		return m.Frequency()
+	case recurrencerule.FieldInterval:
+		return m.Interval()
+	case recurrencerule.FieldByDay:
+		return m.ByDay()
+	case recurrencerule.FieldStartsOn:
+		return m.StartsOn()
+	case recurrencerule.FieldEndsOn:
+		return m.EndsOn()
+	case recurrencerule.FieldScheduledFrom:
+		return m.ScheduledFrom()
+	case recurrencerule.FieldCreatedAt:
+		return m.CreatedAt()
+	case recurrencerule.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RecurrenceRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case recurrencerule.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case recurrencerule.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case recurrencerule.FieldCategoryID:
+		return m.OldCategoryID(ctx)
+	case recurrencerule.FieldPayeeID:
+		return m.OldPayeeID(ctx)
+	case recurrencerule.FieldAmount:
+		return m.OldAmount(ctx)
+	case recurrencerule.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case recurrencerule.FieldPayee:
+		return m.OldPayee(ctx)
+	case recurrencerule.FieldMemo:
+		return m.OldMemo(ctx)
+	case recurrencerule.FieldFrequency:
+		return m.OldFrequency(ctx)
+	case recurrencerule.FieldInterval:
+		return m.OldInterval(ctx)
+	case recurrencerule.FieldByDay:
+		return m.OldByDay(ctx)
+	case recurrencerule.FieldStartsOn:
+		return m.OldStartsOn(ctx)
+	case recurrencerule.FieldEndsOn:
+		return m.OldEndsOn(ctx)
+	case recurrencerule.FieldScheduledFrom:
+		return m.OldScheduledFrom(ctx)
+	case recurrencerule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case recurrencerule.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RecurrenceRule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecurrenceRuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case recurrencerule.FieldWorkspaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case recurrencerule.FieldAccountID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case recurrencerule.FieldCategoryID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategoryID(v)
+		return nil
+	case recurrencerule.FieldPayeeID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayeeID(v)
+		return nil
+	case recurrencerule.FieldAmount:
+		v, ok := value.(model.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case recurrencerule.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case recurrencerule.FieldPayee:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayee(v)
+		return nil
+	case recurrencerule.FieldMemo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemo(v)
+		return nil
+	case recurrencerule.FieldFrequency:
+		v, ok := value.(recurrencerule.Frequency)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFrequency(v)
+		return nil
+	case recurrencerule.FieldInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInterval(v)
+		return nil
+	case recurrencerule.FieldByDay:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetByDay(v)
+		return nil
+	case recurrencerule.FieldStartsOn:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartsOn(v)
+		return nil
+	case recurrencerule.FieldEndsOn:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndsOn(v)
+		return nil
+	case recurrencerule.FieldScheduledFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledFrom(v)
+		return nil
+	case recurrencerule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case recurrencerule.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecurrenceRule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RecurrenceRuleMutation) AddedFields() []string {
+	var fields []string
+	if m.addinterval != nil {
+		fields = append(fields, recurrencerule.FieldInterval)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RecurrenceRuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case recurrencerule.FieldInterval:
+		return m.AddedInterval()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecurrenceRuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case recurrencerule.FieldInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInterval(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecurrenceRule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RecurrenceRuleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(recurrencerule.FieldCategoryID) {
+		fields = append(fields, recurrencerule.FieldCategoryID)
+	}
+	if m.FieldCleared(recurrencerule.FieldPayeeID) {
+		fields = append(fields, recurrencerule.FieldPayeeID)
+	}
+	if m.FieldCleared(recurrencerule.FieldPayee) {
+		fields = append(fields, recurrencerule.FieldPayee)
+	}
+	if m.FieldCleared(recurrencerule.FieldMemo) {
+		fields = append(fields, recurrencerule.FieldMemo)
+	}
+	if m.FieldCleared(recurrencerule.FieldByDay) {
+		fields = append(fields, recurrencerule.FieldByDay)
+	}
+	if m.FieldCleared(recurrencerule.FieldEndsOn) {
+		fields = append(fields, recurrencerule.FieldEndsOn)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RecurrenceRuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RecurrenceRuleMutation) ClearField(name string) error {
+	switch name {
+	case recurrencerule.FieldCategoryID:
+		m.ClearCategoryID()
+		return nil
+	case recurrencerule.FieldPayeeID:
+		m.ClearPayeeID()
+		return nil
+	case recurrencerule.FieldPayee:
+		m.ClearPayee()
+		return nil
+	case recurrencerule.FieldMemo:
+		m.ClearMemo()
+		return nil
+	case recurrencerule.FieldByDay:
+		m.ClearByDay()
+		return nil
+	case recurrencerule.FieldEndsOn:
+		m.ClearEndsOn()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurrenceRule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RecurrenceRuleMutation) ResetField(name string) error {
+	switch name {
+	case recurrencerule.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case recurrencerule.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case recurrencerule.FieldCategoryID:
+		m.ResetCategoryID()
+		return nil
+	case recurrencerule.FieldPayeeID:
+		m.ResetPayeeID()
+		return nil
+	case recurrencerule.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case recurrencerule.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case recurrencerule.FieldPayee:
+		m.ResetPayee()
+		return nil
+	case recurrencerule.FieldMemo:
+		m.ResetMemo()
+		return nil
+	case recurrencerule.FieldFrequency:
+		m.ResetFrequency()
+		return nil
+	case recurrencerule.FieldInterval:
+		m.ResetInterval()
+		return nil
+	case recurrencerule.FieldByDay:
+		m.ResetByDay()
+		return nil
+	case recurrencerule.FieldStartsOn:
+		m.ResetStartsOn()
+		return nil
+	case recurrencerule.FieldEndsOn:
+		m.ResetEndsOn()
+		return nil
+	case recurrencerule.FieldScheduledFrom:
+		m.ResetScheduledFrom()
+		return nil
+	case recurrencerule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case recurrencerule.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurrenceRule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RecurrenceRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.workspace != nil {
+		edges = append(edges, recurrencerule.EdgeWorkspace)
+	}
+	if m.account != nil {
+		edges = append(edges, recurrencerule.EdgeAccount)
+	}
+	if m.category != nil {
+		edges = append(edges, recurrencerule.EdgeCategory)
+	}
+	if m.linked_payee != nil {
+		edges = append(edges, recurrencerule.EdgeLinkedPayee)
+	}
+	if m.occurrences != nil {
+		edges = append(edges, recurrencerule.EdgeOccurrences)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RecurrenceRuleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case recurrencerule.EdgeWorkspace:
+		if id := m.workspace; id != nil {
+			return []ent.Value{*id}
+		}
+	case recurrencerule.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	case recurrencerule.EdgeCategory:
+		if id := m.category; id != nil {
+			return []ent.Value{*id}
+		}
+	case recurrencerule.EdgeLinkedPayee:
+		if id := m.linked_payee; id != nil {
+			return []ent.Value{*id}
+		}
+	case recurrencerule.EdgeOccurrences:
+		ids := make([]ent.Value, 0, len(m.occurrences))
+		for id := range m.occurrences {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RecurrenceRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedoccurrences != nil {
+		edges = append(edges, recurrencerule.EdgeOccurrences)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RecurrenceRuleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case recurrencerule.EdgeOccurrences:
+		ids := make([]ent.Value, 0, len(m.removedoccurrences))
+		for id := range m.removedoccurrences {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RecurrenceRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedworkspace {
+		edges = append(edges, recurrencerule.EdgeWorkspace)
+	}
+	if m.clearedaccount {
+		edges = append(edges, recurrencerule.EdgeAccount)
+	}
+	if m.clearedcategory {
+		edges = append(edges, recurrencerule.EdgeCategory)
+	}
+	if m.clearedlinked_payee {
+		edges = append(edges, recurrencerule.EdgeLinkedPayee)
+	}
+	if m.clearedoccurrences {
+		edges = append(edges, recurrencerule.EdgeOccurrences)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RecurrenceRuleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case recurrencerule.EdgeWorkspace:
+		return m.clearedworkspace
+	case recurrencerule.EdgeAccount:
+		return m.clearedaccount
+	case recurrencerule.EdgeCategory:
+		return m.clearedcategory
+	case recurrencerule.EdgeLinkedPayee:
+		return m.clearedlinked_payee
+	case recurrencerule.EdgeOccurrences:
+		return m.clearedoccurrences
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RecurrenceRuleMutation) ClearEdge(name string) error {
+	switch name {
+	case recurrencerule.EdgeWorkspace:
+		m.ClearWorkspace()
+		return nil
+	case recurrencerule.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	case recurrencerule.EdgeCategory:
+		m.ClearCategory()
+		return nil
+	case recurrencerule.EdgeLinkedPayee:
+		m.ClearLinkedPayee()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurrenceRule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RecurrenceRuleMutation) ResetEdge(name string) error {
+	switch name {
+	case recurrencerule.EdgeWorkspace:
+		m.ResetWorkspace()
+		return nil
+	case recurrencerule.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	case recurrencerule.EdgeCategory:
+		m.ResetCategory()
+		return nil
+	case recurrencerule.EdgeLinkedPayee:
+		m.ResetLinkedPayee()
+		return nil
+	case recurrencerule.EdgeOccurrences:
+		m.ResetOccurrences()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurrenceRule edge %s", name)
+}
+
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	token_hash    *string
+	data          *[]byte
+	user_agent    *string
+	ip_address    *string
+	last_seen_at  *time.Time
+	expires_at    *time.Time
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*Session, error)
+	predicates    []predicate.Session
+}
+
+var _ ent.Mutation = (*SessionMutation)(nil)
+
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
+
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id int) sessionOption {
+	return func(m *SessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Session
+		)
+		m.oldValue = func(ctx context.Context) (*Session, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Session.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SessionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SessionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *SessionMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *SessionMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *SessionMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SessionMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SessionMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldUserID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *SessionMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[session.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *SessionMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[session.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SessionMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, session.FieldUserID)
+}
+
+// SetData sets the "data" field.
+func (m *SessionMutation) SetData(b []byte) {
+	m.data = &b
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *SessionMutation) Data() (r []byte, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldData(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *SessionMutation) ResetData() {
+	m.data = nil
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *SessionMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *SessionMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *SessionMutation) ResetUserAgent() {
+	m.user_agent = nil
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (m *SessionMutation) SetIPAddress(s string) {
+	m.ip_address = &s
+}
+
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *SessionMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIPAddress returns the old "ip_address" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+	}
+	return oldValue.IPAddress, nil
+}
+
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *SessionMutation) ResetIPAddress() {
+	m.ip_address = nil
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *SessionMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *SessionMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *SessionMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *SessionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SessionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SessionMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SessionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SessionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SessionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SessionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SessionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SessionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[session.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SessionMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SessionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.token_hash != nil {
+		fields = append(fields, session.FieldTokenHash)
+	}
+	if m.user != nil {
+		fields = append(fields, session.FieldUserID)
+	}
+	if m.data != nil {
+		fields = append(fields, session.FieldData)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, session.FieldUserAgent)
+	}
+	if m.ip_address != nil {
+		fields = append(fields, session.FieldIPAddress)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, session.FieldLastSeenAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, session.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, session.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, session.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case session.FieldTokenHash:
+		return m.TokenHash()
+	case session.FieldUserID:
+		return m.UserID()
+	case session.FieldData:
+		return m.Data()
+	case session.FieldUserAgent:
+		return m.UserAgent()
+	case session.FieldIPAddress:
+		return m.IPAddress()
+	case session.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case session.FieldExpiresAt:
+		return m.ExpiresAt()
+	case session.FieldCreatedAt:
+		return m.CreatedAt()
+	case session.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case session.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case session.FieldUserID:
+		return m.OldUserID(ctx)
+	case session.FieldData:
+		return m.OldData(ctx)
+	case session.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case session.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case session.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case session.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case session.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case session.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Session field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case session.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case session.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case session.FieldData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case session.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case session.FieldIPAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIPAddress(v)
+		return nil
+	case session.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	case session.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case session.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case session.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SessionMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Session numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(session.FieldUserID) {
+		fields = append(fields, session.FieldUserID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SessionMutation) ClearField(name string) error {
+	switch name {
+	case session.FieldUserID:
+		m.ClearUserID()
+		return nil
+	}
+	return fmt.Errorf("unknown Session nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SessionMutation) ResetField(name string) error {
+	switch name {
+	case session.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case session.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case session.FieldData:
+		m.ResetData()
+		return nil
+	case session.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case session.FieldIPAddress:
+		m.ResetIPAddress()
+		return nil
+	case session.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	case session.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case session.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case session.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, session.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case session.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, session.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SessionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case session.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SessionMutation) ClearEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Session unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SessionMutation) ResetEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Session edge %s", name)
+}
+
+// StatementImportMutation represents an operation that mutates the StatementImport nodes in the graph.
+type StatementImportMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	format           *statementimport.Format
+	filename         *string
+	content          *[]byte
+	status           *statementimport.Status
+	created_count    *int
+	addcreated_count *int
+	skipped_count    *int
+	addskipped_count *int
+	merged_count     *int
+	addmerged_count  *int
+	failed_count     *int
+	addfailed_count  *int
+	committed_at     *time.Time
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	workspace        *int
+	clearedworkspace bool
+	account          *int
+	clearedaccount   bool
+	decisions        map[int]struct{}
+	removeddecisions map[int]struct{}
+	cleareddecisions bool
+	done             bool
+	oldValue         func(context.Context) (*StatementImport, error)
+	predicates       []predicate.StatementImport
+}
+
+var _ ent.Mutation = (*StatementImportMutation)(nil)
+
+// statementimportOption allows management of the mutation configuration using functional options.
+type statementimportOption func(*StatementImportMutation)
+
+// newStatementImportMutation creates new mutation for the StatementImport entity.
+func newStatementImportMutation(c config, op Op, opts ...statementimportOption) *StatementImportMutation {
+	m := &StatementImportMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStatementImport,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStatementImportID sets the ID field of the mutation.
+func withStatementImportID(id int) statementimportOption {
+	return func(m *StatementImportMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StatementImport
+		)
+		m.oldValue = func(ctx context.Context) (*StatementImport, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StatementImport.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStatementImport sets the old StatementImport of the mutation.
+func withStatementImport(node *StatementImport) statementimportOption {
+	return func(m *StatementImportMutation) {
+		m.oldValue = func(context.Context) (*StatementImport, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StatementImportMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StatementImportMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StatementImportMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StatementImportMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StatementImport.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *StatementImportMutation) SetWorkspaceID(i int) {
+	m.workspace = &i
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *StatementImportMutation) WorkspaceID() (r int, exists bool) {
+	v := m.workspace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the StatementImport entity.
+// If the StatementImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatementImportMutation) OldWorkspaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *StatementImportMutation) ResetWorkspaceID() {
+	m.workspace = nil
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *StatementImportMutation) SetAccountID(i int) {
+	m.account = &i
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *StatementImportMutation) AccountID() (r int, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the StatementImport entity.
+// If the StatementImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatementImportMutation) OldAccountID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *StatementImportMutation) ResetAccountID() {
+	m.account = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *StatementImportMutation) SetFormat(s statementimport.Format) {
+	m.format = &s
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *StatementImportMutation) Format() (r statementimport.Format, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the StatementImport entity.
+// If the StatementImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatementImportMutation) OldFormat(ctx context.Context) (v statementimport.Format, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *StatementImportMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetFilename sets the "filename" field.
+func (m *StatementImportMutation) SetFilename(s string) {
+	m.filename = &s
+}
+
+// Filename returns the value of the "filename" field in the mutation.
+func (m *StatementImportMutation) Filename() (r string, exists bool) {
+	v := m.filename
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilename returns the old "filename" field's value of the StatementImport entity.
+// If the StatementImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatementImportMutation) OldFilename(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilename requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+	}
+	return oldValue.Filename, nil
+}
+
+// ClearFilename clears the value of the "filename" field.
+func (m *StatementImportMutation) ClearFilename() {
+	m.filename = nil
+	m.clearedFields[statementimport.FieldFilename] = struct{}{}
+}
+
+// FilenameCleared returns if the "filename" field was cleared in this mutation.
+func (m *StatementImportMutation) FilenameCleared() bool {
+	_, ok := m.clearedFields[statementimport.FieldFilename]
+	return ok
+}
+
+// ResetFilename resets all changes to the "filename" field.
+func (m *StatementImportMutation) ResetFilename() {
+	m.filename = nil
+	delete(m.clearedFields, statementimport.FieldFilename)
+}
+
+// SetContent sets the "content" field.
+func (m *StatementImportMutation) SetContent(b []byte) {
+	m.content = &b
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *StatementImportMutation) Content() (r []byte, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the StatementImport entity.
+// If the StatementImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatementImportMutation) OldContent(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *StatementImportMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *StatementImportMutation) SetStatus(s statementimport.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *StatementImportMutation) Status() (r statementimport.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the StatementImport entity.
+// If the StatementImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatementImportMutation) OldStatus(ctx context.Context) (v statementimport.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *StatementImportMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedCount sets the "created_count" field.
+func (m *StatementImportMutation) SetCreatedCount(i int) {
+	m.created_count = &i
+	m.addcreated_count = nil
+}
+
+// CreatedCount returns the value of the "created_count" field in the mutation.
+func (m *StatementImportMutation) CreatedCount() (r int, exists bool) {
+	v := m.created_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedCount returns the old "created_count" field's value of the StatementImport entity.
+// If the StatementImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatementImportMutation) OldCreatedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedCount: %w", err)
+	}
+	return oldValue.CreatedCount, nil
+}
+
+// AddCreatedCount adds i to the "created_count" field.
+func (m *StatementImportMutation) AddCreatedCount(i int) {
+	if m.addcreated_count != nil {
+		*m.addcreated_count += i
+	} else {
+		m.addcreated_count = &i
+	}
+}
+
+// AddedCreatedCount returns the value that was added to the "created_count" field in this mutation.
+func (m *StatementImportMutation) AddedCreatedCount() (r int, exists bool) {
+	v := m.addcreated_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedCount resets all changes to the "created_count" field.
+func (m *StatementImportMutation) ResetCreatedCount() {
+	m.created_count = nil
+	m.addcreated_count = nil
+}
+
+// SetSkippedCount sets the "skipped_count" field.
+func (m *StatementImportMutation) SetSkippedCount(i int) {
+	m.skipped_count = &i
+	m.addskipped_count = nil
+}
+
+// SkippedCount returns the value of the "skipped_count" field in the mutation.
+func (m *StatementImportMutation) SkippedCount() (r int, exists bool) {
+	v := m.skipped_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSkippedCount returns the old "skipped_count" field's value of the StatementImport entity.
+// If the StatementImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatementImportMutation) OldSkippedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkippedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkippedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkippedCount: %w", err)
+	}
+	return oldValue.SkippedCount, nil
+}
+
+// AddSkippedCount adds i to the "skipped_count" field.
+func (m *StatementImportMutation) AddSkippedCount(i int) {
+	if m.addskipped_count != nil {
+		*m.addskipped_count += i
+	} else {
+		m.addskipped_count = &i
+	}
+}
+
+// AddedSkippedCount returns the value that was added to the "skipped_count" field in this mutation.
+func (m *StatementImportMutation) AddedSkippedCount() (r int, exists bool) {
+	v := m.addskipped_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSkippedCount resets all changes to the "skipped_count" field.
+func (m *StatementImportMutation) ResetSkippedCount() {
+	m.skipped_count = nil
+	m.addskipped_count = nil
+}
+
+// SetMergedCount sets the "merged_count" field.
+func (m *StatementImportMutation) SetMergedCount(i int) {
+	m.merged_count = &i
+	m.addmerged_count = nil
+}
+
+// MergedCount returns the value of the "merged_count" field in the mutation.
+func (m *StatementImportMutation) MergedCount() (r int, exists bool) {
+	v := m.merged_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMergedCount returns the old "merged_count" field's value of the StatementImport entity.
+// If the StatementImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatementImportMutation) OldMergedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMergedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMergedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMergedCount: %w", err)
+	}
+	return oldValue.MergedCount, nil
+}
+
+// AddMergedCount adds i to the "merged_count" field.
+func (m *StatementImportMutation) AddMergedCount(i int) {
+	if m.addmerged_count != nil {
+		*m.addmerged_count += i
+	} else {
+		m.addmerged_count = &i
+	}
+}
+
+// AddedMergedCount returns the value that was added to the "merged_count" field in this mutation.
+func (m *StatementImportMutation) AddedMergedCount() (r int, exists bool) {
+	v := m.addmerged_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMergedCount resets all changes to the "merged_count" field.
+func (m *StatementImportMutation) ResetMergedCount() {
+	m.merged_count = nil
+	m.addmerged_count = nil
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (m *StatementImportMutation) SetFailedCount(i int) {
+	m.failed_count = &i
+	m.addfailed_count = nil
+}
+
+// FailedCount returns the value of the "failed_count" field in the mutation.
+func (m *StatementImportMutation) FailedCount() (r int, exists bool) {
+	v := m.failed_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailedCount returns the old "failed_count" field's value of the StatementImport entity.
+// If the StatementImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatementImportMutation) OldFailedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailedCount: %w", err)
+	}
+	return oldValue.FailedCount, nil
+}
+
+// AddFailedCount adds i to the "failed_count" field.
+func (m *StatementImportMutation) AddFailedCount(i int) {
+	if m.addfailed_count != nil {
+		*m.addfailed_count += i
+	} else {
+		m.addfailed_count = &i
+	}
+}
+
+// AddedFailedCount returns the value that was added to the "failed_count" field in this mutation.
+func (m *StatementImportMutation) AddedFailedCount() (r int, exists bool) {
+	v := m.addfailed_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailedCount resets all changes to the "failed_count" field.
+func (m *StatementImportMutation) ResetFailedCount() {
+	m.failed_count = nil
+	m.addfailed_count = nil
+}
+
+// SetCommittedAt sets the "committed_at" field.
+func (m *StatementImportMutation) SetCommittedAt(t time.Time) {
+	m.committed_at = &t
+}
+
+// CommittedAt returns the value of the "committed_at" field in the mutation.
+func (m *StatementImportMutation) CommittedAt() (r time.Time, exists bool) {
+	v := m.committed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommittedAt returns the old "committed_at" field's value of the StatementImport entity.
+// If the StatementImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatementImportMutation) OldCommittedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommittedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommittedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommittedAt: %w", err)
+	}
+	return oldValue.CommittedAt, nil
+}
+
+// ClearCommittedAt clears the value of the "committed_at" field.
+func (m *StatementImportMutation) ClearCommittedAt() {
+	m.committed_at = nil
+	m.clearedFields[statementimport.FieldCommittedAt] = struct{}{}
+}
+
+// CommittedAtCleared returns if the "committed_at" field was cleared in this mutation.
+func (m *StatementImportMutation) CommittedAtCleared() bool {
+	_, ok := m.clearedFields[statementimport.FieldCommittedAt]
+	return ok
+}
+
+// ResetCommittedAt resets all changes to the "committed_at" field.
+func (m *StatementImportMutation) ResetCommittedAt() {
+	m.committed_at = nil
+	delete(m.clearedFields, statementimport.FieldCommittedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *StatementImportMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StatementImportMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the StatementImport entity.
+// If the StatementImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatementImportMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StatementImportMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *StatementImportMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *StatementImportMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the StatementImport entity.
+// If the StatementImport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatementImportMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *StatementImportMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (m *StatementImportMutation) ClearWorkspace() {
+	m.clearedworkspace = true
+	m.clearedFields[statementimport.FieldWorkspaceID] = struct{}{}
+}
+
+// WorkspaceCleared reports if the "workspace" edge to the Workspace entity was cleared.
+func (m *StatementImportMutation) WorkspaceCleared() bool {
+	return m.clearedworkspace
+}
+
+// WorkspaceIDs returns the "workspace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkspaceID instead. It exists only for internal usage by the builders.
+func (m *StatementImportMutation) WorkspaceIDs() (ids []int) {
+	if id := m.workspace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkspace resets all changes to the "workspace" edge.
+func (m *StatementImportMutation) ResetWorkspace() {
+	m.workspace = nil
+	m.clearedworkspace = false
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *StatementImportMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[statementimport.FieldAccountID] = struct{}{}
+}
+
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *StatementImportMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *StatementImportMutation) AccountIDs() (ids []int) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *StatementImportMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// AddDecisionIDs adds the "decisions" edge to the ImportDecision entity by ids.
+func (m *StatementImportMutation) AddDecisionIDs(ids ...int) {
+	if m.decisions == nil {
+		m.decisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.decisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDecisions clears the "decisions" edge to the ImportDecision entity.
+func (m *StatementImportMutation) ClearDecisions() {
+	m.cleareddecisions = true
+}
+
+// DecisionsCleared reports if the "decisions" edge to the ImportDecision entity was cleared.
+func (m *StatementImportMutation) DecisionsCleared() bool {
+	return m.cleareddecisions
+}
+
+// RemoveDecisionIDs removes the "decisions" edge to the ImportDecision entity by IDs.
+func (m *StatementImportMutation) RemoveDecisionIDs(ids ...int) {
+	if m.removeddecisions == nil {
+		m.removeddecisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.decisions, ids[i])
+		m.removeddecisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDecisions returns the removed IDs of the "decisions" edge to the ImportDecision entity.
+func (m *StatementImportMutation) RemovedDecisionsIDs() (ids []int) {
+	for id := range m.removeddecisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DecisionsIDs returns the "decisions" edge IDs in the mutation.
+func (m *StatementImportMutation) DecisionsIDs() (ids []int) {
+	for id := range m.decisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDecisions resets all changes to the "decisions" edge.
+func (m *StatementImportMutation) ResetDecisions() {
+	m.decisions = nil
+	m.cleareddecisions = false
+	m.removeddecisions = nil
+}
+
+// Where appends a list predicates to the StatementImportMutation builder.
+func (m *StatementImportMutation) Where(ps ...predicate.StatementImport) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StatementImportMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StatementImportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StatementImport, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StatementImportMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StatementImportMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StatementImport).
+func (m *StatementImportMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StatementImportMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.workspace != nil {
+		fields = append(fields, statementimport.FieldWorkspaceID)
+	}
+	if m.account != nil {
+		fields = append(fields, statementimport.FieldAccountID)
+	}
+	if m.format != nil {
+		fields = append(fields, statementimport.FieldFormat)
+	}
+	if m.filename != nil {
+		fields = append(fields, statementimport.FieldFilename)
+	}
+	if m.content != nil {
+		fields = append(fields, statementimport.FieldContent)
+	}
+	if m.status != nil {
+		fields = append(fields, statementimport.FieldStatus)
+	}
+	if m.created_count != nil {
+		fields = append(fields, statementimport.FieldCreatedCount)
+	}
+	if m.skipped_count != nil {
+		fields = append(fields, statementimport.FieldSkippedCount)
+	}
+	if m.merged_count != nil {
+		fields = append(fields, statementimport.FieldMergedCount)
+	}
+	if m.failed_count != nil {
+		fields = append(fields, statementimport.FieldFailedCount)
+	}
+	if m.committed_at != nil {
+		fields = append(fields, statementimport.FieldCommittedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, statementimport.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, statementimport.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StatementImportMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case statementimport.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case statementimport.FieldAccountID:
+		return m.AccountID()
+	case statementimport.FieldFormat:
+		return m.Format()
+	case statementimport.FieldFilename:
+		return m.Filename()
+	case statementimport.FieldContent:
+		return m.Content()
+	case statementimport.FieldStatus:
+		return m.Status()
+	case statementimport.FieldCreatedCount:
+		return m.CreatedCount()
+	case statementimport.FieldSkippedCount:
+		return m.SkippedCount()
+	case statementimport.FieldMergedCount:
+		return m.MergedCount()
+	case statementimport.FieldFailedCount:
+		return m.FailedCount()
+	case statementimport.FieldCommittedAt:
+		return m.CommittedAt()
+	case statementimport.FieldCreatedAt:
+		return m.CreatedAt()
+	case statementimport.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StatementImportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case statementimport.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case statementimport.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case statementimport.FieldFormat:
+		return m.OldFormat(ctx)
+	case statementimport.FieldFilename:
+		return m.OldFilename(ctx)
+	case statementimport.FieldContent:
+		return m.OldContent(ctx)
+	case statementimport.FieldStatus:
+		return m.OldStatus(ctx)
+	case statementimport.FieldCreatedCount:
+		return m.OldCreatedCount(ctx)
+	case statementimport.FieldSkippedCount:
+		return m.OldSkippedCount(ctx)
+	case statementimport.FieldMergedCount:
+		return m.OldMergedCount(ctx)
+	case statementimport.FieldFailedCount:
+		return m.OldFailedCount(ctx)
+	case statementimport.FieldCommittedAt:
+		return m.OldCommittedAt(ctx)
+	case statementimport.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case statementimport.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown StatementImport field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StatementImportMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case statementimport.FieldWorkspaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case statementimport.FieldAccountID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case statementimport.FieldFormat:
+		v, ok := value.(statementimport.Format)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case statementimport.FieldFilename:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilename(v)
+		return nil
+	case statementimport.FieldContent:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case statementimport.FieldStatus:
+		v, ok := value.(statementimport.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case statementimport.FieldCreatedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedCount(v)
+		return nil
+	case statementimport.FieldSkippedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkippedCount(v)
+		return nil
+	case statementimport.FieldMergedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMergedCount(v)
+		return nil
+	case statementimport.FieldFailedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedCount(v)
+		return nil
+	case statementimport.FieldCommittedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommittedAt(v)
+		return nil
+	case statementimport.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case statementimport.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StatementImport field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StatementImportMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_count != nil {
+		fields = append(fields, statementimport.FieldCreatedCount)
+	}
+	if m.addskipped_count != nil {
+		fields = append(fields, statementimport.FieldSkippedCount)
+	}
+	if m.addmerged_count != nil {
+		fields = append(fields, statementimport.FieldMergedCount)
+	}
+	if m.addfailed_count != nil {
+		fields = append(fields, statementimport.FieldFailedCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StatementImportMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case statementimport.FieldCreatedCount:
+		return m.AddedCreatedCount()
+	case statementimport.FieldSkippedCount:
+		return m.AddedSkippedCount()
+	case statementimport.FieldMergedCount:
+		return m.AddedMergedCount()
+	case statementimport.FieldFailedCount:
+		return m.AddedFailedCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StatementImportMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case statementimport.FieldCreatedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedCount(v)
+		return nil
+	case statementimport.FieldSkippedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSkippedCount(v)
+		return nil
+	case statementimport.FieldMergedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMergedCount(v)
+		return nil
+	case statementimport.FieldFailedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailedCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StatementImport numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StatementImportMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(statementimport.FieldFilename) {
+		fields = append(fields, statementimport.FieldFilename)
+	}
+	if m.FieldCleared(statementimport.FieldCommittedAt) {
+		fields = append(fields, statementimport.FieldCommittedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StatementImportMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StatementImportMutation) ClearField(name string) error {
+	switch name {
+	case statementimport.FieldFilename:
+		m.ClearFilename()
+		return nil
+	case statementimport.FieldCommittedAt:
+		m.ClearCommittedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown StatementImport nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StatementImportMutation) ResetField(name string) error {
+	switch name {
+	case statementimport.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case statementimport.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case statementimport.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case statementimport.FieldFilename:
+		m.ResetFilename()
+		return nil
+	case statementimport.FieldContent:
+		m.ResetContent()
+		return nil
+	case statementimport.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case statementimport.FieldCreatedCount:
+		m.ResetCreatedCount()
+		return nil
+	case statementimport.FieldSkippedCount:
+		m.ResetSkippedCount()
+		return nil
+	case statementimport.FieldMergedCount:
+		m.ResetMergedCount()
+		return nil
+	case statementimport.FieldFailedCount:
+		m.ResetFailedCount()
+		return nil
+	case statementimport.FieldCommittedAt:
+		m.ResetCommittedAt()
+		return nil
+	case statementimport.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case statementimport.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown StatementImport field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StatementImportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.workspace != nil {
+		edges = append(edges, statementimport.EdgeWorkspace)
+	}
+	if m.account != nil {
+		edges = append(edges, statementimport.EdgeAccount)
+	}
+	if m.decisions != nil {
+		edges = append(edges, statementimport.EdgeDecisions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StatementImportMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case statementimport.EdgeWorkspace:
+		if id := m.workspace; id != nil {
+			return []ent.Value{*id}
+		}
+	case statementimport.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	case statementimport.EdgeDecisions:
+		ids := make([]ent.Value, 0, len(m.decisions))
+		for id := range m.decisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StatementImportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removeddecisions != nil {
+		edges = append(edges, statementimport.EdgeDecisions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StatementImportMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case statementimport.EdgeDecisions:
+		ids := make([]ent.Value, 0, len(m.removeddecisions))
+		for id := range m.removeddecisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StatementImportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedworkspace {
+		edges = append(edges, statementimport.EdgeWorkspace)
+	}
+	if m.clearedaccount {
+		edges = append(edges, statementimport.EdgeAccount)
+	}
+	if m.cleareddecisions {
+		edges = append(edges, statementimport.EdgeDecisions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StatementImportMutation) EdgeCleared(name string) bool {
+	switch name {
+	case statementimport.EdgeWorkspace:
+		return m.clearedworkspace
+	case statementimport.EdgeAccount:
+		return m.clearedaccount
+	case statementimport.EdgeDecisions:
+		return m.cleareddecisions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StatementImportMutation) ClearEdge(name string) error {
+	switch name {
+	case statementimport.EdgeWorkspace:
+		m.ClearWorkspace()
+		return nil
+	case statementimport.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown StatementImport unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StatementImportMutation) ResetEdge(name string) error {
+	switch name {
+	case statementimport.EdgeWorkspace:
+		m.ResetWorkspace()
+		return nil
+	case statementimport.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	case statementimport.EdgeDecisions:
+		m.ResetDecisions()
+		return nil
+	}
+	return fmt.Errorf("unknown StatementImport edge %s", name)
+}
+
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	name                *string
+	color               *string
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	workspace           *int
+	clearedworkspace    bool
+	transactions        map[int]struct{}
+	removedtransactions map[int]struct{}
+	clearedtransactions bool
+	splits              map[int]struct{}
+	removedsplits       map[int]struct{}
+	clearedsplits       bool
+	done                bool
+	oldValue            func(context.Context) (*Tag, error)
+	predicates          []predicate.Tag
+}
+
+var _ ent.Mutation = (*TagMutation)(nil)
+
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
+
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagID sets the ID field of the mutation.
+func withTagID(id int) tagOption {
+	return func(m *TagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tag
+		)
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *TagMutation) SetWorkspaceID(i int) {
+	m.workspace = &i
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *TagMutation) WorkspaceID() (r int, exists bool) {
+	v := m.workspace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldWorkspaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *TagMutation) ResetWorkspaceID() {
+	m.workspace = nil
+}
+
+// SetName sets the "name" field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
+}
+
+// SetColor sets the "color" field.
+func (m *TagMutation) SetColor(s string) {
+	m.color = &s
+}
+
+// Color returns the value of the "color" field in the mutation.
+func (m *TagMutation) Color() (r string, exists bool) {
+	v := m.color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColor returns the old "color" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+	}
+	return oldValue.Color, nil
+}
+
+// ClearColor clears the value of the "color" field.
+func (m *TagMutation) ClearColor() {
+	m.color = nil
+	m.clearedFields[tag.FieldColor] = struct{}{}
+}
+
+// ColorCleared returns if the "color" field was cleared in this mutation.
+func (m *TagMutation) ColorCleared() bool {
+	_, ok := m.clearedFields[tag.FieldColor]
+	return ok
+}
+
+// ResetColor resets all changes to the "color" field.
+func (m *TagMutation) ResetColor() {
+	m.color = nil
+	delete(m.clearedFields, tag.FieldColor)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TagMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TagMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TagMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TagMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (m *TagMutation) ClearWorkspace() {
+	m.clearedworkspace = true
+	m.clearedFields[tag.FieldWorkspaceID] = struct{}{}
+}
+
+// WorkspaceCleared reports if the "workspace" edge to the Workspace entity was cleared.
+func (m *TagMutation) WorkspaceCleared() bool {
+	return m.clearedworkspace
+}
+
+// WorkspaceIDs returns the "workspace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkspaceID instead. It exists only for internal usage by the builders.
+func (m *TagMutation) WorkspaceIDs() (ids []int) {
+	if id := m.workspace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkspace resets all changes to the "workspace" edge.
+func (m *TagMutation) ResetWorkspace() {
+	m.workspace = nil
+	m.clearedworkspace = false
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by ids.
+func (m *TagMutation) AddTransactionIDs(ids ...int) {
+	if m.transactions == nil {
+		m.transactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.transactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTransactions clears the "transactions" edge to the Transaction entity.
+func (m *TagMutation) ClearTransactions() {
+	m.clearedtransactions = true
+}
+
+// TransactionsCleared reports if the "transactions" edge to the Transaction entity was cleared.
+func (m *TagMutation) TransactionsCleared() bool {
+	return m.clearedtransactions
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to the Transaction entity by IDs.
+func (m *TagMutation) RemoveTransactionIDs(ids ...int) {
+	if m.removedtransactions == nil {
+		m.removedtransactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.transactions, ids[i])
+		m.removedtransactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTransactions returns the removed IDs of the "transactions" edge to the Transaction entity.
+func (m *TagMutation) RemovedTransactionsIDs() (ids []int) {
+	for id := range m.removedtransactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TransactionsIDs returns the "transactions" edge IDs in the mutation.
+func (m *TagMutation) TransactionsIDs() (ids []int) {
+	for id := range m.transactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTransactions resets all changes to the "transactions" edge.
+func (m *TagMutation) ResetTransactions() {
+	m.transactions = nil
+	m.clearedtransactions = false
+	m.removedtransactions = nil
+}
+
+// AddSplitIDs adds the "splits" edge to the TransactionSplit entity by ids.
+func (m *TagMutation) AddSplitIDs(ids ...int) {
+	if m.splits == nil {
+		m.splits = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.splits[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSplits clears the "splits" edge to the TransactionSplit entity.
+func (m *TagMutation) ClearSplits() {
+	m.clearedsplits = true
+}
+
+// SplitsCleared reports if the "splits" edge to the TransactionSplit entity was cleared.
+func (m *TagMutation) SplitsCleared() bool {
+	return m.clearedsplits
+}
+
+// RemoveSplitIDs removes the "splits" edge to the TransactionSplit entity by IDs.
+func (m *TagMutation) RemoveSplitIDs(ids ...int) {
+	if m.removedsplits == nil {
+		m.removedsplits = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.splits, ids[i])
+		m.removedsplits[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSplits returns the removed IDs of the "splits" edge to the TransactionSplit entity.
+func (m *TagMutation) RemovedSplitsIDs() (ids []int) {
+	for id := range m.removedsplits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SplitsIDs returns the "splits" edge IDs in the mutation.
+func (m *TagMutation) SplitsIDs() (ids []int) {
+	for id := range m.splits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSplits resets all changes to the "splits" edge.
+func (m *TagMutation) ResetSplits() {
+	m.splits = nil
+	m.clearedsplits = false
+	m.removedsplits = nil
+}
+
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.workspace != nil {
+		fields = append(fields, tag.FieldWorkspaceID)
+	}
+	if m.name != nil {
+		fields = append(fields, tag.FieldName)
+	}
+	if m.color != nil {
+		fields = append(fields, tag.FieldColor)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tag.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, tag.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tag.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case tag.FieldName:
+		return m.Name()
+	case tag.FieldColor:
+		return m.Color()
+	case tag.FieldCreatedAt:
+		return m.CreatedAt()
+	case tag.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tag.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case tag.FieldName:
+		return m.OldName(ctx)
+	case tag.FieldColor:
+		return m.OldColor(ctx)
+	case tag.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case tag.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tag.FieldWorkspaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case tag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tag.FieldColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColor(v)
+		return nil
+	case tag.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case tag.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TagMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TagMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tag.FieldColor) {
+		fields = append(fields, tag.FieldColor)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TagMutation) ClearField(name string) error {
+	switch name {
+	case tag.FieldColor:
+		m.ClearColor()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TagMutation) ResetField(name string) error {
+	switch name {
+	case tag.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case tag.FieldName:
+		m.ResetName()
+		return nil
+	case tag.FieldColor:
+		m.ResetColor()
+		return nil
+	case tag.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case tag.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.workspace != nil {
+		edges = append(edges, tag.EdgeWorkspace)
+	}
+	if m.transactions != nil {
+		edges = append(edges, tag.EdgeTransactions)
+	}
+	if m.splits != nil {
+		edges = append(edges, tag.EdgeSplits)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeWorkspace:
+		if id := m.workspace; id != nil {
+			return []ent.Value{*id}
+		}
+	case tag.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.transactions))
+		for id := range m.transactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case tag.EdgeSplits:
+		ids := make([]ent.Value, 0, len(m.splits))
+		for id := range m.splits {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedtransactions != nil {
+		edges = append(edges, tag.EdgeTransactions)
+	}
+	if m.removedsplits != nil {
+		edges = append(edges, tag.EdgeSplits)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.removedtransactions))
+		for id := range m.removedtransactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case tag.EdgeSplits:
+		ids := make([]ent.Value, 0, len(m.removedsplits))
+		for id := range m.removedsplits {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedworkspace {
+		edges = append(edges, tag.EdgeWorkspace)
+	}
+	if m.clearedtransactions {
+		edges = append(edges, tag.EdgeTransactions)
+	}
+	if m.clearedsplits {
+		edges = append(edges, tag.EdgeSplits)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tag.EdgeWorkspace:
+		return m.clearedworkspace
+	case tag.EdgeTransactions:
+		return m.clearedtransactions
+	case tag.EdgeSplits:
+		return m.clearedsplits
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TagMutation) ClearEdge(name string) error {
+	switch name {
+	case tag.EdgeWorkspace:
+		m.ClearWorkspace()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TagMutation) ResetEdge(name string) error {
+	switch name {
+	case tag.EdgeWorkspace:
+		m.ResetWorkspace()
+		return nil
+	case tag.EdgeTransactions:
+		m.ResetTransactions()
+		return nil
+	case tag.EdgeSplits:
+		m.ResetSplits()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag edge %s", name)
+}
+
+// TransactionMutation represents an operation that mutates the Transaction nodes in the graph.
+type TransactionMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *int
+	posted_on                    *time.Time
+	amount                       *model.Decimal
+	currency                     *string
+	payee                        *string
+	memo                         *string
+	external_id                  *string
+	value_date                   *time.Time
+	counterparty_iban            *string
+	end_to_end_id                *string
+	status                       *transaction.Status
+	created_at                   *time.Time
+	updated_at                   *time.Time
+	clearedFields                map[string]struct{}
+	workspace                    *int
+	clearedworkspace             bool
+	account                      *int
+	clearedaccount               bool
+	category                     *int
+	clearedcategory              bool
+	linked_payee                 *int
+	clearedlinked_payee          bool
+	tags                         map[int]struct{}
+	removedtags                  map[int]struct{}
+	clearedtags                  bool
+	splits                       map[int]struct{}
+	removedsplits                map[int]struct{}
+	clearedsplits                bool
+	journal_entry                *int
+	clearedjournal_entry         bool
+	outgoing_transfer            *int
+	clearedoutgoing_transfer     bool
+	incoming_transfer            *int
+	clearedincoming_transfer     bool
+	recurrence_occurrence        *int
+	clearedrecurrence_occurrence bool
+	import_decisions             map[int]struct{}
+	removedimport_decisions      map[int]struct{}
+	clearedimport_decisions      bool
+	done                         bool
+	oldValue                     func(context.Context) (*Transaction, error)
+	predicates                   []predicate.Transaction
+}
+
+var _ ent.Mutation = (*TransactionMutation)(nil)
+
+// transactionOption allows management of the mutation configuration using functional options.
+type transactionOption func(*TransactionMutation)
+
+// newTransactionMutation creates new mutation for the Transaction entity.
+func newTransactionMutation(c config, op Op, opts ...transactionOption) *TransactionMutation {
+	m := &TransactionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTransaction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTransactionID sets the ID field of the mutation.
+func withTransactionID(id int) transactionOption {
+	return func(m *TransactionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Transaction
+		)
+		m.oldValue = func(ctx context.Context) (*Transaction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Transaction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTransaction sets the old Transaction of the mutation.
+func withTransaction(node *Transaction) transactionOption {
+	return func(m *TransactionMutation) {
+		m.oldValue = func(context.Context) (*Transaction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TransactionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TransactionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TransactionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TransactionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Transaction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *TransactionMutation) SetWorkspaceID(i int) {
+	m.workspace = &i
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *TransactionMutation) WorkspaceID() (r int, exists bool) {
+	v := m.workspace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldWorkspaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *TransactionMutation) ResetWorkspaceID() {
+	m.workspace = nil
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *TransactionMutation) SetAccountID(i int) {
+	m.account = &i
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *TransactionMutation) AccountID() (r int, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldAccountID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *TransactionMutation) ResetAccountID() {
+	m.account = nil
+}
+
+// SetCategoryID sets the "category_id" field.
+func (m *TransactionMutation) SetCategoryID(i int) {
+	m.category = &i
+}
+
+// CategoryID returns the value of the "category_id" field in the mutation.
+func (m *TransactionMutation) CategoryID() (r int, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategoryID returns the old "category_id" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldCategoryID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategoryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategoryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategoryID: %w", err)
+	}
+	return oldValue.CategoryID, nil
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (m *TransactionMutation) ClearCategoryID() {
+	m.category = nil
+	m.clearedFields[transaction.FieldCategoryID] = struct{}{}
+}
+
+// CategoryIDCleared returns if the "category_id" field was cleared in this mutation.
+func (m *TransactionMutation) CategoryIDCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldCategoryID]
+	return ok
+}
+
+// ResetCategoryID resets all changes to the "category_id" field.
+func (m *TransactionMutation) ResetCategoryID() {
+	m.category = nil
+	delete(m.clearedFields, transaction.FieldCategoryID)
+}
+
+// SetPayeeID sets the "payee_id" field.
+func (m *TransactionMutation) SetPayeeID(i int) {
+	m.linked_payee = &i
+}
+
+// PayeeID returns the value of the "payee_id" field in the mutation.
+func (m *TransactionMutation) PayeeID() (r int, exists bool) {
+	v := m.linked_payee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayeeID returns the old "payee_id" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldPayeeID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayeeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayeeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayeeID: %w", err)
+	}
+	return oldValue.PayeeID, nil
+}
+
+// ClearPayeeID clears the value of the "payee_id" field.
+func (m *TransactionMutation) ClearPayeeID() {
+	m.linked_payee = nil
+	m.clearedFields[transaction.FieldPayeeID] = struct{}{}
+}
+
+// PayeeIDCleared returns if the "payee_id" field was cleared in this mutation.
+func (m *TransactionMutation) PayeeIDCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldPayeeID]
+	return ok
+}
+
+// ResetPayeeID resets all changes to the "payee_id" field.
+func (m *TransactionMutation) ResetPayeeID() {
+	m.linked_payee = nil
+	delete(m.clearedFields, transaction.FieldPayeeID)
+}
+
+// SetPostedOn sets the "posted_on" field.
+func (m *TransactionMutation) SetPostedOn(t time.Time) {
+	m.posted_on = &t
+}
+
+// PostedOn returns the value of the "posted_on" field in the mutation.
+func (m *TransactionMutation) PostedOn() (r time.Time, exists bool) {
+	v := m.posted_on
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostedOn returns the old "posted_on" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldPostedOn(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostedOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostedOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostedOn: %w", err)
+	}
+	return oldValue.PostedOn, nil
+}
+
+// ResetPostedOn resets all changes to the "posted_on" field.
+func (m *TransactionMutation) ResetPostedOn() {
+	m.posted_on = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *TransactionMutation) SetAmount(value model.Decimal) {
+	m.amount = &value
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *TransactionMutation) Amount() (r model.Decimal, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldAmount(ctx context.Context) (v model.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *TransactionMutation) ResetAmount() {
+	m.amount = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *TransactionMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *TransactionMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *TransactionMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetPayee sets the "payee" field.
+func (m *TransactionMutation) SetPayee(s string) {
+	m.payee = &s
+}
+
+// Payee returns the value of the "payee" field in the mutation.
+func (m *TransactionMutation) Payee() (r string, exists bool) {
+	v := m.payee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayee returns the old "payee" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldPayee(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayee: %w", err)
+	}
+	return oldValue.Payee, nil
+}
+
+// ClearPayee clears the value of the "payee" field.
+func (m *TransactionMutation) ClearPayee() {
+	m.payee = nil
+	m.clearedFields[transaction.FieldPayee] = struct{}{}
+}
+
+// PayeeCleared returns if the "payee" field was cleared in this mutation.
+func (m *TransactionMutation) PayeeCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldPayee]
+	return ok
+}
+
+// ResetPayee resets all changes to the "payee" field.
+func (m *TransactionMutation) ResetPayee() {
+	m.payee = nil
+	delete(m.clearedFields, transaction.FieldPayee)
+}
+
+// SetMemo sets the "memo" field.
+func (m *TransactionMutation) SetMemo(s string) {
+	m.memo = &s
+}
+
+// Memo returns the value of the "memo" field in the mutation.
+func (m *TransactionMutation) Memo() (r string, exists bool) {
+	v := m.memo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMemo returns the old "memo" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldMemo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMemo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMemo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMemo: %w", err)
+	}
+	return oldValue.Memo, nil
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (m *TransactionMutation) ClearMemo() {
+	m.memo = nil
+	m.clearedFields[transaction.FieldMemo] = struct{}{}
+}
+
+// MemoCleared returns if the "memo" field was cleared in this mutation.
+func (m *TransactionMutation) MemoCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldMemo]
+	return ok
+}
+
+// ResetMemo resets all changes to the "memo" field.
+func (m *TransactionMutation) ResetMemo() {
+	m.memo = nil
+	delete(m.clearedFields, transaction.FieldMemo)
+}
+
+// SetExternalID sets the "external_id" field.
+func (m *TransactionMutation) SetExternalID(s string) {
+	m.external_id = &s
+}
+
+// ExternalID returns the value of the "external_id" field in the mutation.
+func (m *TransactionMutation) ExternalID() (r string, exists bool) {
+	v := m.external_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalID returns the old "external_id" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldExternalID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
+	}
+	return oldValue.ExternalID, nil
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (m *TransactionMutation) ClearExternalID() {
+	m.external_id = nil
+	m.clearedFields[transaction.FieldExternalID] = struct{}{}
+}
+
+// ExternalIDCleared returns if the "external_id" field was cleared in this mutation.
+func (m *TransactionMutation) ExternalIDCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldExternalID]
+	return ok
+}
+
+// ResetExternalID resets all changes to the "external_id" field.
+func (m *TransactionMutation) ResetExternalID() {
+	m.external_id = nil
+	delete(m.clearedFields, transaction.FieldExternalID)
+}
+
+// SetValueDate sets the "value_date" field.
+func (m *TransactionMutation) SetValueDate(t time.Time) {
+	m.value_date = &t
+}
+
+// ValueDate returns the value of the "value_date" field in the mutation.
+func (m *TransactionMutation) ValueDate() (r time.Time, exists bool) {
+	v := m.value_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValueDate returns the old "value_date" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldValueDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValueDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValueDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValueDate: %w", err)
+	}
+	return oldValue.ValueDate, nil
+}
+
+// ClearValueDate clears the value of the "value_date" field.
+func (m *TransactionMutation) ClearValueDate() {
+	m.value_date = nil
+	m.clearedFields[transaction.FieldValueDate] = struct{}{}
+}
+
+// ValueDateCleared returns if the "value_date" field was cleared in this mutation.
+func (m *TransactionMutation) ValueDateCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldValueDate]
+	return ok
+}
+
+// ResetValueDate resets all changes to the "value_date" field.
+func (m *TransactionMutation) ResetValueDate() {
+	m.value_date = nil
+	delete(m.clearedFields, transaction.FieldValueDate)
+}
+
+// SetCounterpartyIban sets the "counterparty_iban" field.
+func (m *TransactionMutation) SetCounterpartyIban(s string) {
+	m.counterparty_iban = &s
+}
+
+// CounterpartyIban returns the value of the "counterparty_iban" field in the mutation.
+func (m *TransactionMutation) CounterpartyIban() (r string, exists bool) {
+	v := m.counterparty_iban
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCounterpartyIban returns the old "counterparty_iban" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldCounterpartyIban(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCounterpartyIban is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCounterpartyIban requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCounterpartyIban: %w", err)
+	}
+	return oldValue.CounterpartyIban, nil
+}
+
+// ClearCounterpartyIban clears the value of the "counterparty_iban" field.
+func (m *TransactionMutation) ClearCounterpartyIban() {
+	m.counterparty_iban = nil
+	m.clearedFields[transaction.FieldCounterpartyIban] = struct{}{}
+}
+
+// CounterpartyIbanCleared returns if the "counterparty_iban" field was cleared in this mutation.
+func (m *TransactionMutation) CounterpartyIbanCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldCounterpartyIban]
+	return ok
+}
+
+// ResetCounterpartyIban resets all changes to the "counterparty_iban" field.
+func (m *TransactionMutation) ResetCounterpartyIban() {
+	m.counterparty_iban = nil
+	delete(m.clearedFields, transaction.FieldCounterpartyIban)
+}
+
+// SetEndToEndID sets the "end_to_end_id" field.
+func (m *TransactionMutation) SetEndToEndID(s string) {
+	m.end_to_end_id = &s
+}
+
+// EndToEndID returns the value of the "end_to_end_id" field in the mutation.
+func (m *TransactionMutation) EndToEndID() (r string, exists bool) {
+	v := m.end_to_end_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndToEndID returns the old "end_to_end_id" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldEndToEndID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndToEndID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndToEndID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndToEndID: %w", err)
+	}
+	return oldValue.EndToEndID, nil
+}
+
+// ClearEndToEndID clears the value of the "end_to_end_id" field.
+func (m *TransactionMutation) ClearEndToEndID() {
+	m.end_to_end_id = nil
+	m.clearedFields[transaction.FieldEndToEndID] = struct{}{}
+}
+
+// EndToEndIDCleared returns if the "end_to_end_id" field was cleared in this mutation.
+func (m *TransactionMutation) EndToEndIDCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldEndToEndID]
+	return ok
+}
+
+// ResetEndToEndID resets all changes to the "end_to_end_id" field.
+func (m *TransactionMutation) ResetEndToEndID() {
+	m.end_to_end_id = nil
+	delete(m.clearedFields, transaction.FieldEndToEndID)
+}
+
+// SetStatus sets the "status" field.
+func (m *TransactionMutation) SetStatus(t transaction.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TransactionMutation) Status() (r transaction.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldStatus(ctx context.Context) (v transaction.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *TransactionMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TransactionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TransactionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TransactionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TransactionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TransactionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TransactionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (m *TransactionMutation) ClearWorkspace() {
+	m.clearedworkspace = true
+	m.clearedFields[transaction.FieldWorkspaceID] = struct{}{}
+}
+
+// WorkspaceCleared reports if the "workspace" edge to the Workspace entity was cleared.
+func (m *TransactionMutation) WorkspaceCleared() bool {
+	return m.clearedworkspace
+}
+
+// WorkspaceIDs returns the "workspace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkspaceID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) WorkspaceIDs() (ids []int) {
+	if id := m.workspace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkspace resets all changes to the "workspace" edge.
+func (m *TransactionMutation) ResetWorkspace() {
+	m.workspace = nil
+	m.clearedworkspace = false
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *TransactionMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[transaction.FieldAccountID] = struct{}{}
+}
+
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *TransactionMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) AccountIDs() (ids []int) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *TransactionMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (m *TransactionMutation) ClearCategory() {
+	m.clearedcategory = true
+	m.clearedFields[transaction.FieldCategoryID] = struct{}{}
+}
+
+// CategoryCleared reports if the "category" edge to the Category entity was cleared.
+func (m *TransactionMutation) CategoryCleared() bool {
+	return m.CategoryIDCleared() || m.clearedcategory
+}
+
+// CategoryIDs returns the "category" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CategoryID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) CategoryIDs() (ids []int) {
+	if id := m.category; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCategory resets all changes to the "category" edge.
+func (m *TransactionMutation) ResetCategory() {
+	m.category = nil
+	m.clearedcategory = false
+}
+
+// SetLinkedPayeeID sets the "linked_payee" edge to the Payee entity by id.
+func (m *TransactionMutation) SetLinkedPayeeID(id int) {
+	m.linked_payee = &id
+}
+
+// ClearLinkedPayee clears the "linked_payee" edge to the Payee entity.
+func (m *TransactionMutation) ClearLinkedPayee() {
+	m.clearedlinked_payee = true
+	m.clearedFields[transaction.FieldPayeeID] = struct{}{}
+}
+
+// LinkedPayeeCleared reports if the "linked_payee" edge to the Payee entity was cleared.
+func (m *TransactionMutation) LinkedPayeeCleared() bool {
+	return m.PayeeIDCleared() || m.clearedlinked_payee
+}
+
+// LinkedPayeeID returns the "linked_payee" edge ID in the mutation.
+func (m *TransactionMutation) LinkedPayeeID() (id int, exists bool) {
+	if m.linked_payee != nil {
+		return *m.linked_payee, true
+	}
+	return
+}
+
+// LinkedPayeeIDs returns the "linked_payee" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LinkedPayeeID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) LinkedPayeeIDs() (ids []int) {
+	if id := m.linked_payee; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLinkedPayee resets all changes to the "linked_payee" edge.
+func (m *TransactionMutation) ResetLinkedPayee() {
+	m.linked_payee = nil
+	m.clearedlinked_payee = false
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *TransactionMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *TransactionMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *TransactionMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *TransactionMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *TransactionMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *TransactionMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *TransactionMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddSplitIDs adds the "splits" edge to the TransactionSplit entity by ids.
+func (m *TransactionMutation) AddSplitIDs(ids ...int) {
+	if m.splits == nil {
+		m.splits = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.splits[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSplits clears the "splits" edge to the TransactionSplit entity.
+func (m *TransactionMutation) ClearSplits() {
+	m.clearedsplits = true
+}
+
+// SplitsCleared reports if the "splits" edge to the TransactionSplit entity was cleared.
+func (m *TransactionMutation) SplitsCleared() bool {
+	return m.clearedsplits
+}
+
+// RemoveSplitIDs removes the "splits" edge to the TransactionSplit entity by IDs.
+func (m *TransactionMutation) RemoveSplitIDs(ids ...int) {
+	if m.removedsplits == nil {
+		m.removedsplits = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.splits, ids[i])
+		m.removedsplits[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSplits returns the removed IDs of the "splits" edge to the TransactionSplit entity.
+func (m *TransactionMutation) RemovedSplitsIDs() (ids []int) {
+	for id := range m.removedsplits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SplitsIDs returns the "splits" edge IDs in the mutation.
+func (m *TransactionMutation) SplitsIDs() (ids []int) {
+	for id := range m.splits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSplits resets all changes to the "splits" edge.
+func (m *TransactionMutation) ResetSplits() {
+	m.splits = nil
+	m.clearedsplits = false
+	m.removedsplits = nil
+}
+
+// SetJournalEntryID sets the "journal_entry" edge to the JournalEntry entity by id.
+func (m *TransactionMutation) SetJournalEntryID(id int) {
+	m.journal_entry = &id
+}
+
+// ClearJournalEntry clears the "journal_entry" edge to the JournalEntry entity.
+func (m *TransactionMutation) ClearJournalEntry() {
+	m.clearedjournal_entry = true
+}
+
+// JournalEntryCleared reports if the "journal_entry" edge to the JournalEntry entity was cleared.
+func (m *TransactionMutation) JournalEntryCleared() bool {
+	return m.clearedjournal_entry
+}
+
+// JournalEntryID returns the "journal_entry" edge ID in the mutation.
+func (m *TransactionMutation) JournalEntryID() (id int, exists bool) {
+	if m.journal_entry != nil {
+		return *m.journal_entry, true
+	}
+	return
+}
+
+// JournalEntryIDs returns the "journal_entry" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// JournalEntryID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) JournalEntryIDs() (ids []int) {
+	if id := m.journal_entry; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetJournalEntry resets all changes to the "journal_entry" edge.
+func (m *TransactionMutation) ResetJournalEntry() {
+	m.journal_entry = nil
+	m.clearedjournal_entry = false
+}
+
+// SetOutgoingTransferID sets the "outgoing_transfer" edge to the Transfer entity by id.
+func (m *TransactionMutation) SetOutgoingTransferID(id int) {
+	m.outgoing_transfer = &id
+}
+
+// ClearOutgoingTransfer clears the "outgoing_transfer" edge to the Transfer entity.
+func (m *TransactionMutation) ClearOutgoingTransfer() {
+	m.clearedoutgoing_transfer = true
+}
+
+// OutgoingTransferCleared reports if the "outgoing_transfer" edge to the Transfer entity was cleared.
+func (m *TransactionMutation) OutgoingTransferCleared() bool {
+	return m.clearedoutgoing_transfer
+}
+
+// OutgoingTransferID returns the "outgoing_transfer" edge ID in the mutation.
+func (m *TransactionMutation) OutgoingTransferID() (id int, exists bool) {
+	if m.outgoing_transfer != nil {
+		return *m.outgoing_transfer, true
+	}
+	return
+}
+
+// OutgoingTransferIDs returns the "outgoing_transfer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OutgoingTransferID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) OutgoingTransferIDs() (ids []int) {
+	if id := m.outgoing_transfer; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOutgoingTransfer resets all changes to the "outgoing_transfer" edge.
+func (m *TransactionMutation) ResetOutgoingTransfer() {
+	m.outgoing_transfer = nil
+	m.clearedoutgoing_transfer = false
+}
+
+// SetIncomingTransferID sets the "incoming_transfer" edge to the Transfer entity by id.
+func (m *TransactionMutation) SetIncomingTransferID(id int) {
+	m.incoming_transfer = &id
+}
+
+// ClearIncomingTransfer clears the "incoming_transfer" edge to the Transfer entity.
+func (m *TransactionMutation) ClearIncomingTransfer() {
+	m.clearedincoming_transfer = true
+}
+
+// IncomingTransferCleared reports if the "incoming_transfer" edge to the Transfer entity was cleared.
+func (m *TransactionMutation) IncomingTransferCleared() bool {
+	return m.clearedincoming_transfer
+}
+
+// IncomingTransferID returns the "incoming_transfer" edge ID in the mutation.
+func (m *TransactionMutation) IncomingTransferID() (id int, exists bool) {
+	if m.incoming_transfer != nil {
+		return *m.incoming_transfer, true
+	}
+	return
+}
+
+// IncomingTransferIDs returns the "incoming_transfer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// IncomingTransferID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) IncomingTransferIDs() (ids []int) {
+	if id := m.incoming_transfer; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetIncomingTransfer resets all changes to the "incoming_transfer" edge.
+func (m *TransactionMutation) ResetIncomingTransfer() {
+	m.incoming_transfer = nil
+	m.clearedincoming_transfer = false
+}
+
+// SetRecurrenceOccurrenceID sets the "recurrence_occurrence" edge to the RecurrenceOccurrence entity by id.
+func (m *TransactionMutation) SetRecurrenceOccurrenceID(id int) {
+	m.recurrence_occurrence = &id
+}
+
+// ClearRecurrenceOccurrence clears the "recurrence_occurrence" edge to the RecurrenceOccurrence entity.
+func (m *TransactionMutation) ClearRecurrenceOccurrence() {
+	m.clearedrecurrence_occurrence = true
+}
+
+// RecurrenceOccurrenceCleared reports if the "recurrence_occurrence" edge to the RecurrenceOccurrence entity was cleared.
+func (m *TransactionMutation) RecurrenceOccurrenceCleared() bool {
+	return m.clearedrecurrence_occurrence
+}
+
+// RecurrenceOccurrenceID returns the "recurrence_occurrence" edge ID in the mutation.
+func (m *TransactionMutation) RecurrenceOccurrenceID() (id int, exists bool) {
+	if m.recurrence_occurrence != nil {
+		return *m.recurrence_occurrence, true
+	}
+	return
+}
+
+// RecurrenceOccurrenceIDs returns the "recurrence_occurrence" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RecurrenceOccurrenceID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) RecurrenceOccurrenceIDs() (ids []int) {
+	if id := m.recurrence_occurrence; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRecurrenceOccurrence resets all changes to the "recurrence_occurrence" edge.
+func (m *TransactionMutation) ResetRecurrenceOccurrence() {
+	m.recurrence_occurrence = nil
+	m.clearedrecurrence_occurrence = false
+}
+
+// AddImportDecisionIDs adds the "import_decisions" edge to the ImportDecision entity by ids.
+func (m *TransactionMutation) AddImportDecisionIDs(ids ...int) {
+	if m.import_decisions == nil {
+		m.import_decisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.import_decisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearImportDecisions clears the "import_decisions" edge to the ImportDecision entity.
+func (m *TransactionMutation) ClearImportDecisions() {
+	m.clearedimport_decisions = true
+}
+
+// ImportDecisionsCleared reports if the "import_decisions" edge to the ImportDecision entity was cleared.
+func (m *TransactionMutation) ImportDecisionsCleared() bool {
+	return m.clearedimport_decisions
+}
+
+// RemoveImportDecisionIDs removes the "import_decisions" edge to the ImportDecision entity by IDs.
+func (m *TransactionMutation) RemoveImportDecisionIDs(ids ...int) {
+	if m.removedimport_decisions == nil {
+		m.removedimport_decisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.import_decisions, ids[i])
+		m.removedimport_decisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedImportDecisions returns the removed IDs of the "import_decisions" edge to the ImportDecision entity.
+func (m *TransactionMutation) RemovedImportDecisionsIDs() (ids []int) {
+	for id := range m.removedimport_decisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ImportDecisionsIDs returns the "import_decisions" edge IDs in the mutation.
+func (m *TransactionMutation) ImportDecisionsIDs() (ids []int) {
+	for id := range m.import_decisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetImportDecisions resets all changes to the "import_decisions" edge.
+func (m *TransactionMutation) ResetImportDecisions() {
+	m.import_decisions = nil
+	m.clearedimport_decisions = false
+	m.removedimport_decisions = nil
+}
+
+// Where appends a list predicates to the TransactionMutation builder.
+func (m *TransactionMutation) Where(ps ...predicate.Transaction) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TransactionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TransactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Transaction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TransactionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TransactionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Transaction).
+func (m *TransactionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TransactionMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.workspace != nil {
+		fields = append(fields, transaction.FieldWorkspaceID)
+	}
+	if m.account != nil {
+		fields = append(fields, transaction.FieldAccountID)
+	}
+	if m.category != nil {
+		fields = append(fields, transaction.FieldCategoryID)
+	}
+	if m.linked_payee != nil {
+		fields = append(fields, transaction.FieldPayeeID)
+	}
+	if m.posted_on != nil {
+		fields = append(fields, transaction.FieldPostedOn)
+	}
+	if m.amount != nil {
+		fields = append(fields, transaction.FieldAmount)
+	}
+	if m.currency != nil {
+		fields = append(fields, transaction.FieldCurrency)
+	}
+	if m.payee != nil {
+		fields = append(fields, transaction.FieldPayee)
+	}
+	if m.memo != nil {
+		fields = append(fields, transaction.FieldMemo)
+	}
+	if m.external_id != nil {
+		fields = append(fields, transaction.FieldExternalID)
+	}
+	if m.value_date != nil {
+		fields = append(fields, transaction.FieldValueDate)
+	}
+	if m.counterparty_iban != nil {
+		fields = append(fields, transaction.FieldCounterpartyIban)
+	}
+	if m.end_to_end_id != nil {
+		fields = append(fields, transaction.FieldEndToEndID)
+	}
+	if m.status != nil {
+		fields = append(fields, transaction.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, transaction.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, transaction.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TransactionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case transaction.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case transaction.FieldAccountID:
+		return m.AccountID()
+	case transaction.FieldCategoryID:
+		return m.CategoryID()
+	case transaction.FieldPayeeID:
+		return m.PayeeID()
+	case transaction.FieldPostedOn:
+		return m.PostedOn()
+	case transaction.FieldAmount:
+		return m.Amount()
+	case transaction.FieldCurrency:
+		return m.Currency()
+	case transaction.FieldPayee:
+		return m.Payee()
+	case transaction.FieldMemo:
+		return m.Memo()
+	case transaction.FieldExternalID:
+		return m.ExternalID()
+	case transaction.FieldValueDate:
+		return m.ValueDate()
+	case transaction.FieldCounterpartyIban:
+		return m.CounterpartyIban()
+	case transaction.FieldEndToEndID:
+		return m.EndToEndID()
+	case transaction.FieldStatus:
+		return m.Status()
+	case transaction.FieldCreatedAt:
+		return m.CreatedAt()
+	case transaction.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TransactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case transaction.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case transaction.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case transaction.FieldCategoryID:
+		return m.OldCategoryID(ctx)
+	case transaction.FieldPayeeID:
+		return m.OldPayeeID(ctx)
+	case transaction.FieldPostedOn:
+		return m.OldPostedOn(ctx)
+	case transaction.FieldAmount:
+		return m.OldAmount(ctx)
+	case transaction.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case transaction.FieldPayee:
+		return m.OldPayee(ctx)
+	case transaction.FieldMemo:
+		return m.OldMemo(ctx)
+	case transaction.FieldExternalID:
+		return m.OldExternalID(ctx)
+	case transaction.FieldValueDate:
+		return m.OldValueDate(ctx)
+	case transaction.FieldCounterpartyIban:
+		return m.OldCounterpartyIban(ctx)
+	case transaction.FieldEndToEndID:
+		return m.OldEndToEndID(ctx)
+	case transaction.FieldStatus:
+		return m.OldStatus(ctx)
+	case transaction.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case transaction.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Transaction field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransactionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case transaction.FieldWorkspaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case transaction.FieldAccountID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case transaction.FieldCategoryID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategoryID(v)
+		return nil
+	case transaction.FieldPayeeID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayeeID(v)
+		return nil
+	case transaction.FieldPostedOn:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostedOn(v)
+		return nil
+	case transaction.FieldAmount:
+		v, ok := value.(model.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case transaction.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case transaction.FieldPayee:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayee(v)
+		return nil
+	case transaction.FieldMemo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemo(v)
+		return nil
+	case transaction.FieldExternalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalID(v)
+		return nil
+	case transaction.FieldValueDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValueDate(v)
+		return nil
+	case transaction.FieldCounterpartyIban:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCounterpartyIban(v)
+		return nil
+	case transaction.FieldEndToEndID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndToEndID(v)
+		return nil
+	case transaction.FieldStatus:
+		v, ok := value.(transaction.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case transaction.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case transaction.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TransactionMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TransactionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransactionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Transaction numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TransactionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(transaction.FieldCategoryID) {
+		fields = append(fields, transaction.FieldCategoryID)
+	}
+	if m.FieldCleared(transaction.FieldPayeeID) {
+		fields = append(fields, transaction.FieldPayeeID)
+	}
+	if m.FieldCleared(transaction.FieldPayee) {
+		fields = append(fields, transaction.FieldPayee)
+	}
+	if m.FieldCleared(transaction.FieldMemo) {
+		fields = append(fields, transaction.FieldMemo)
+	}
+	if m.FieldCleared(transaction.FieldExternalID) {
+		fields = append(fields, transaction.FieldExternalID)
+	}
+	if m.FieldCleared(transaction.FieldValueDate) {
+		fields = append(fields, transaction.FieldValueDate)
+	}
+	if m.FieldCleared(transaction.FieldCounterpartyIban) {
+		fields = append(fields, transaction.FieldCounterpartyIban)
+	}
+	if m.FieldCleared(transaction.FieldEndToEndID) {
+		fields = append(fields, transaction.FieldEndToEndID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TransactionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TransactionMutation) ClearField(name string) error {
+	switch name {
+	case transaction.FieldCategoryID:
+		m.ClearCategoryID()
+		return nil
+	case transaction.FieldPayeeID:
+		m.ClearPayeeID()
+		return nil
+	case transaction.FieldPayee:
+		m.ClearPayee()
+		return nil
+	case transaction.FieldMemo:
+		m.ClearMemo()
+		return nil
+	case transaction.FieldExternalID:
+		m.ClearExternalID()
+		return nil
+	case transaction.FieldValueDate:
+		m.ClearValueDate()
+		return nil
+	case transaction.FieldCounterpartyIban:
+		m.ClearCounterpartyIban()
+		return nil
+	case transaction.FieldEndToEndID:
+		m.ClearEndToEndID()
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TransactionMutation) ResetField(name string) error {
+	switch name {
+	case transaction.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case transaction.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case transaction.FieldCategoryID:
+		m.ResetCategoryID()
+		return nil
+	case transaction.FieldPayeeID:
+		m.ResetPayeeID()
+		return nil
+	case transaction.FieldPostedOn:
+		m.ResetPostedOn()
+		return nil
+	case transaction.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case transaction.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case transaction.FieldPayee:
+		m.ResetPayee()
+		return nil
+	case transaction.FieldMemo:
+		m.ResetMemo()
+		return nil
+	case transaction.FieldExternalID:
+		m.ResetExternalID()
+		return nil
+	case transaction.FieldValueDate:
+		m.ResetValueDate()
+		return nil
+	case transaction.FieldCounterpartyIban:
+		m.ResetCounterpartyIban()
+		return nil
+	case transaction.FieldEndToEndID:
+		m.ResetEndToEndID()
+		return nil
+	case transaction.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case transaction.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case transaction.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TransactionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 11)
+	if m.workspace != nil {
+		edges = append(edges, transaction.EdgeWorkspace)
+	}
+	if m.account != nil {
+		edges = append(edges, transaction.EdgeAccount)
+	}
+	if m.category != nil {
+		edges = append(edges, transaction.EdgeCategory)
+	}
+	if m.linked_payee != nil {
+		edges = append(edges, transaction.EdgeLinkedPayee)
+	}
+	if m.tags != nil {
+		edges = append(edges, transaction.EdgeTags)
+	}
+	if m.splits != nil {
+		edges = append(edges, transaction.EdgeSplits)
+	}
+	if m.journal_entry != nil {
+		edges = append(edges, transaction.EdgeJournalEntry)
+	}
+	if m.outgoing_transfer != nil {
+		edges = append(edges, transaction.EdgeOutgoingTransfer)
+	}
+	if m.incoming_transfer != nil {
+		edges = append(edges, transaction.EdgeIncomingTransfer)
+	}
+	if m.recurrence_occurrence != nil {
+		edges = append(edges, transaction.EdgeRecurrenceOccurrence)
+	}
+	if m.import_decisions != nil {
+		edges = append(edges, transaction.EdgeImportDecisions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TransactionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case transaction.EdgeWorkspace:
+		if id := m.workspace; id != nil {
+			return []ent.Value{*id}
+		}
+	case transaction.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	case transaction.EdgeCategory:
+		if id := m.category; id != nil {
+			return []ent.Value{*id}
+		}
+	case transaction.EdgeLinkedPayee:
+		if id := m.linked_payee; id != nil {
+			return []ent.Value{*id}
+		}
+	case transaction.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case transaction.EdgeSplits:
+		ids := make([]ent.Value, 0, len(m.splits))
+		for id := range m.splits {
+			ids = append(ids, id)
+		}
+		return ids
+	case transaction.EdgeJournalEntry:
+		if id := m.journal_entry; id != nil {
+			return []ent.Value{*id}
+		}
+	case transaction.EdgeOutgoingTransfer:
+		if id := m.outgoing_transfer; id != nil {
+			return []ent.Value{*id}
+		}
+	case transaction.EdgeIncomingTransfer:
+		if id := m.incoming_transfer; id != nil {
+			return []ent.Value{*id}
+		}
+	case transaction.EdgeRecurrenceOccurrence:
+		if id := m.recurrence_occurrence; id != nil {
+			return []ent.Value{*id}
+		}
+	case transaction.EdgeImportDecisions:
+		ids := make([]ent.Value, 0, len(m.import_decisions))
+		for id := range m.import_decisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TransactionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 11)
+	if m.removedtags != nil {
+		edges = append(edges, transaction.EdgeTags)
+	}
+	if m.removedsplits != nil {
+		edges = append(edges, transaction.EdgeSplits)
+	}
+	if m.removedimport_decisions != nil {
+		edges = append(edges, transaction.EdgeImportDecisions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TransactionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case transaction.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case transaction.EdgeSplits:
+		ids := make([]ent.Value, 0, len(m.removedsplits))
+		for id := range m.removedsplits {
+			ids = append(ids, id)
+		}
+		return ids
+	case transaction.EdgeImportDecisions:
+		ids := make([]ent.Value, 0, len(m.removedimport_decisions))
+		for id := range m.removedimport_decisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TransactionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 11)
+	if m.clearedworkspace {
+		edges = append(edges, transaction.EdgeWorkspace)
+	}
+	if m.clearedaccount {
+		edges = append(edges, transaction.EdgeAccount)
+	}
+	if m.clearedcategory {
+		edges = append(edges, transaction.EdgeCategory)
+	}
+	if m.clearedlinked_payee {
+		edges = append(edges, transaction.EdgeLinkedPayee)
+	}
+	if m.clearedtags {
+		edges = append(edges, transaction.EdgeTags)
+	}
+	if m.clearedsplits {
+		edges = append(edges, transaction.EdgeSplits)
+	}
+	if m.clearedjournal_entry {
+		edges = append(edges, transaction.EdgeJournalEntry)
+	}
+	if m.clearedoutgoing_transfer {
+		edges = append(edges, transaction.EdgeOutgoingTransfer)
+	}
+	if m.clearedincoming_transfer {
+		edges = append(edges, transaction.EdgeIncomingTransfer)
+	}
+	if m.clearedrecurrence_occurrence {
+		edges = append(edges, transaction.EdgeRecurrenceOccurrence)
+	}
+	if m.clearedimport_decisions {
+		edges = append(edges, transaction.EdgeImportDecisions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TransactionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case transaction.EdgeWorkspace:
+		return m.clearedworkspace
+	case transaction.EdgeAccount:
+		return m.clearedaccount
+	case transaction.EdgeCategory:
+		return m.clearedcategory
+	case transaction.EdgeLinkedPayee:
+		return m.clearedlinked_payee
+	case transaction.EdgeTags:
+		return m.clearedtags
+	case transaction.EdgeSplits:
+		return m.clearedsplits
+	case transaction.EdgeJournalEntry:
+		return m.clearedjournal_entry
+	case transaction.EdgeOutgoingTransfer:
+		return m.clearedoutgoing_transfer
+	case transaction.EdgeIncomingTransfer:
+		return m.clearedincoming_transfer
+	case transaction.EdgeRecurrenceOccurrence:
+		return m.clearedrecurrence_occurrence
+	case transaction.EdgeImportDecisions:
+		return m.clearedimport_decisions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TransactionMutation) ClearEdge(name string) error {
+	switch name {
+	case transaction.EdgeWorkspace:
+		m.ClearWorkspace()
+		return nil
+	case transaction.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	case transaction.EdgeCategory:
+		m.ClearCategory()
+		return nil
+	case transaction.EdgeLinkedPayee:
+		m.ClearLinkedPayee()
+		return nil
+	case transaction.EdgeJournalEntry:
+		m.ClearJournalEntry()
+		return nil
+	case transaction.EdgeOutgoingTransfer:
+		m.ClearOutgoingTransfer()
+		return nil
+	case transaction.EdgeIncomingTransfer:
+		m.ClearIncomingTransfer()
+		return nil
+	case transaction.EdgeRecurrenceOccurrence:
+		m.ClearRecurrenceOccurrence()
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TransactionMutation) ResetEdge(name string) error {
+	switch name {
+	case transaction.EdgeWorkspace:
+		m.ResetWorkspace()
+		return nil
+	case transaction.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	case transaction.EdgeCategory:
+		m.ResetCategory()
+		return nil
+	case transaction.EdgeLinkedPayee:
+		m.ResetLinkedPayee()
+		return nil
+	case transaction.EdgeTags:
+		m.ResetTags()
+		return nil
+	case transaction.EdgeSplits:
+		m.ResetSplits()
+		return nil
+	case transaction.EdgeJournalEntry:
+		m.ResetJournalEntry()
+		return nil
+	case transaction.EdgeOutgoingTransfer:
+		m.ResetOutgoingTransfer()
+		return nil
+	case transaction.EdgeIncomingTransfer:
+		m.ResetIncomingTransfer()
+		return nil
+	case transaction.EdgeRecurrenceOccurrence:
+		m.ResetRecurrenceOccurrence()
+		return nil
+	case transaction.EdgeImportDecisions:
+		m.ResetImportDecisions()
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction edge %s", name)
+}
+
+// TransactionSplitMutation represents an operation that mutates the TransactionSplit nodes in the graph.
+type TransactionSplitMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	amount             *model.Decimal
+	currency           *string
+	memo               *string
+	clearedFields      map[string]struct{}
+	workspace          *int
+	clearedworkspace   bool
+	transaction        *int
+	clearedtransaction bool
+	category           *int
+	clearedcategory    bool
+	tags               map[int]struct{}
+	removedtags        map[int]struct{}
+	clearedtags        bool
+	done               bool
+	oldValue           func(context.Context) (*TransactionSplit, error)
+	predicates         []predicate.TransactionSplit
+}
+
+var _ ent.Mutation = (*TransactionSplitMutation)(nil)
+
+// transactionsplitOption allows management of the mutation configuration using functional options.
+type transactionsplitOption func(*TransactionSplitMutation)
+
+// newTransactionSplitMutation creates new mutation for the TransactionSplit entity.
+func newTransactionSplitMutation(c config, op Op, opts ...transactionsplitOption) *TransactionSplitMutation {
+	m := &TransactionSplitMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTransactionSplit,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTransactionSplitID sets the ID field of the mutation.
+func withTransactionSplitID(id int) transactionsplitOption {
+	return func(m *TransactionSplitMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TransactionSplit
+		)
+		m.oldValue = func(ctx context.Context) (*TransactionSplit, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TransactionSplit.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTransactionSplit sets the old TransactionSplit of the mutation.
+func withTransactionSplit(node *TransactionSplit) transactionsplitOption {
+	return func(m *TransactionSplitMutation) {
+		m.oldValue = func(context.Context) (*TransactionSplit, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TransactionSplitMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TransactionSplitMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TransactionSplitMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TransactionSplitMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TransactionSplit.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *TransactionSplitMutation) SetWorkspaceID(i int) {
+	m.workspace = &i
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *TransactionSplitMutation) WorkspaceID() (r int, exists bool) {
+	v := m.workspace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the TransactionSplit entity.
+// If the TransactionSplit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionSplitMutation) OldWorkspaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *TransactionSplitMutation) ResetWorkspaceID() {
+	m.workspace = nil
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (m *TransactionSplitMutation) SetTransactionID(i int) {
+	m.transaction = &i
+}
+
+// TransactionID returns the value of the "transaction_id" field in the mutation.
+func (m *TransactionSplitMutation) TransactionID() (r int, exists bool) {
+	v := m.transaction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransactionID returns the old "transaction_id" field's value of the TransactionSplit entity.
+// If the TransactionSplit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionSplitMutation) OldTransactionID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransactionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransactionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransactionID: %w", err)
+	}
+	return oldValue.TransactionID, nil
+}
+
+// ResetTransactionID resets all changes to the "transaction_id" field.
+func (m *TransactionSplitMutation) ResetTransactionID() {
+	m.transaction = nil
+}
+
+// SetCategoryID sets the "category_id" field.
+func (m *TransactionSplitMutation) SetCategoryID(i int) {
+	m.category = &i
+}
+
+// CategoryID returns the value of the "category_id" field in the mutation.
+func (m *TransactionSplitMutation) CategoryID() (r int, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategoryID returns the old "category_id" field's value of the TransactionSplit entity.
+// If the TransactionSplit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionSplitMutation) OldCategoryID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategoryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategoryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategoryID: %w", err)
+	}
+	return oldValue.CategoryID, nil
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (m *TransactionSplitMutation) ClearCategoryID() {
+	m.category = nil
+	m.clearedFields[transactionsplit.FieldCategoryID] = struct{}{}
+}
+
+// CategoryIDCleared returns if the "category_id" field was cleared in this mutation.
+func (m *TransactionSplitMutation) CategoryIDCleared() bool {
+	_, ok := m.clearedFields[transactionsplit.FieldCategoryID]
+	return ok
+}
+
+// ResetCategoryID resets all changes to the "category_id" field.
+func (m *TransactionSplitMutation) ResetCategoryID() {
+	m.category = nil
+	delete(m.clearedFields, transactionsplit.FieldCategoryID)
+}
+
+// SetAmount sets the "amount" field.
+func (m *TransactionSplitMutation) SetAmount(value model.Decimal) {
+	m.amount = &value
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *TransactionSplitMutation) Amount() (r model.Decimal, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the TransactionSplit entity.
+// If the TransactionSplit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionSplitMutation) OldAmount(ctx context.Context) (v model.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *TransactionSplitMutation) ResetAmount() {
+	m.amount = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *TransactionSplitMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *TransactionSplitMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the TransactionSplit entity.
+// If the TransactionSplit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionSplitMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *TransactionSplitMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetMemo sets the "memo" field.
+func (m *TransactionSplitMutation) SetMemo(s string) {
+	m.memo = &s
+}
+
+// Memo returns the value of the "memo" field in the mutation.
+func (m *TransactionSplitMutation) Memo() (r string, exists bool) {
+	v := m.memo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMemo returns the old "memo" field's value of the TransactionSplit entity.
+// If the TransactionSplit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionSplitMutation) OldMemo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMemo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMemo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMemo: %w", err)
+	}
+	return oldValue.Memo, nil
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (m *TransactionSplitMutation) ClearMemo() {
+	m.memo = nil
+	m.clearedFields[transactionsplit.FieldMemo] = struct{}{}
+}
+
+// MemoCleared returns if the "memo" field was cleared in this mutation.
+func (m *TransactionSplitMutation) MemoCleared() bool {
+	_, ok := m.clearedFields[transactionsplit.FieldMemo]
+	return ok
+}
+
+// ResetMemo resets all changes to the "memo" field.
+func (m *TransactionSplitMutation) ResetMemo() {
+	m.memo = nil
+	delete(m.clearedFields, transactionsplit.FieldMemo)
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (m *TransactionSplitMutation) ClearWorkspace() {
+	m.clearedworkspace = true
+	m.clearedFields[transactionsplit.FieldWorkspaceID] = struct{}{}
+}
+
+// WorkspaceCleared reports if the "workspace" edge to the Workspace entity was cleared.
+func (m *TransactionSplitMutation) WorkspaceCleared() bool {
+	return m.clearedworkspace
+}
+
+// WorkspaceIDs returns the "workspace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkspaceID instead. It exists only for internal usage by the builders.
+func (m *TransactionSplitMutation) WorkspaceIDs() (ids []int) {
+	if id := m.workspace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkspace resets all changes to the "workspace" edge.
+func (m *TransactionSplitMutation) ResetWorkspace() {
+	m.workspace = nil
+	m.clearedworkspace = false
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (m *TransactionSplitMutation) ClearTransaction() {
+	m.clearedtransaction = true
+	m.clearedFields[transactionsplit.FieldTransactionID] = struct{}{}
+}
+
+// TransactionCleared reports if the "transaction" edge to the Transaction entity was cleared.
+func (m *TransactionSplitMutation) TransactionCleared() bool {
+	return m.clearedtransaction
+}
+
+// TransactionIDs returns the "transaction" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TransactionID instead. It exists only for internal usage by the builders.
+func (m *TransactionSplitMutation) TransactionIDs() (ids []int) {
+	if id := m.transaction; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTransaction resets all changes to the "transaction" edge.
+func (m *TransactionSplitMutation) ResetTransaction() {
+	m.transaction = nil
+	m.clearedtransaction = false
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (m *TransactionSplitMutation) ClearCategory() {
+	m.clearedcategory = true
+	m.clearedFields[transactionsplit.FieldCategoryID] = struct{}{}
+}
+
+// CategoryCleared reports if the "category" edge to the Category entity was cleared.
+func (m *TransactionSplitMutation) CategoryCleared() bool {
+	return m.CategoryIDCleared() || m.clearedcategory
+}
+
+// CategoryIDs returns the "category" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CategoryID instead. It exists only for internal usage by the builders.
+func (m *TransactionSplitMutation) CategoryIDs() (ids []int) {
+	if id := m.category; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCategory resets all changes to the "category" edge.
+func (m *TransactionSplitMutation) ResetCategory() {
+	m.category = nil
+	m.clearedcategory = false
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *TransactionSplitMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *TransactionSplitMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *TransactionSplitMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *TransactionSplitMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *TransactionSplitMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *TransactionSplitMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *TransactionSplitMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// Where appends a list predicates to the TransactionSplitMutation builder.
+func (m *TransactionSplitMutation) Where(ps ...predicate.TransactionSplit) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TransactionSplitMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TransactionSplitMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TransactionSplit, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TransactionSplitMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TransactionSplitMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TransactionSplit).
+func (m *TransactionSplitMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TransactionSplitMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.workspace != nil {
+		fields = append(fields, transactionsplit.FieldWorkspaceID)
+	}
+	if m.transaction != nil {
+		fields = append(fields, transactionsplit.FieldTransactionID)
+	}
+	if m.category != nil {
+		fields = append(fields, transactionsplit.FieldCategoryID)
+	}
+	if m.amount != nil {
+		fields = append(fields, transactionsplit.FieldAmount)
+	}
+	if m.currency != nil {
+		fields = append(fields, transactionsplit.FieldCurrency)
+	}
+	if m.memo != nil {
+		fields = append(fields, transactionsplit.FieldMemo)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TransactionSplitMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case transactionsplit.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case transactionsplit.FieldTransactionID:
+		return m.TransactionID()
+	case transactionsplit.FieldCategoryID:
+		return m.CategoryID()
+	case transactionsplit.FieldAmount:
+		return m.Amount()
+	case transactionsplit.FieldCurrency:
+		return m.Currency()
+	case transactionsplit.FieldMemo:
+		return m.Memo()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TransactionSplitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case transactionsplit.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case transactionsplit.FieldTransactionID:
+		return m.OldTransactionID(ctx)
+	case transactionsplit.FieldCategoryID:
+		return m.OldCategoryID(ctx)
+	case transactionsplit.FieldAmount:
+		return m.OldAmount(ctx)
+	case transactionsplit.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case transactionsplit.FieldMemo:
+		return m.OldMemo(ctx)
+	}
+	return nil, fmt.Errorf("unknown TransactionSplit field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransactionSplitMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case transactionsplit.FieldWorkspaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case transactionsplit.FieldTransactionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransactionID(v)
+		return nil
+	case transactionsplit.FieldCategoryID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategoryID(v)
+		return nil
+	case transactionsplit.FieldAmount:
+		v, ok := value.(model.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case transactionsplit.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case transactionsplit.FieldMemo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemo(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TransactionSplit field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TransactionSplitMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TransactionSplitMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransactionSplitMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TransactionSplit numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TransactionSplitMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(transactionsplit.FieldCategoryID) {
+		fields = append(fields, transactionsplit.FieldCategoryID)
+	}
+	if m.FieldCleared(transactionsplit.FieldMemo) {
+		fields = append(fields, transactionsplit.FieldMemo)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TransactionSplitMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TransactionSplitMutation) ClearField(name string) error {
+	switch name {
+	case transactionsplit.FieldCategoryID:
+		m.ClearCategoryID()
+		return nil
+	case transactionsplit.FieldMemo:
+		m.ClearMemo()
+		return nil
+	}
+	return fmt.Errorf("unknown TransactionSplit nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TransactionSplitMutation) ResetField(name string) error {
+	switch name {
+	case transactionsplit.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case transactionsplit.FieldTransactionID:
+		m.ResetTransactionID()
+		return nil
+	case transactionsplit.FieldCategoryID:
+		m.ResetCategoryID()
+		return nil
+	case transactionsplit.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case transactionsplit.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case transactionsplit.FieldMemo:
+		m.ResetMemo()
+		return nil
+	}
+	return fmt.Errorf("unknown TransactionSplit field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TransactionSplitMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.workspace != nil {
+		edges = append(edges, transactionsplit.EdgeWorkspace)
+	}
+	if m.transaction != nil {
+		edges = append(edges, transactionsplit.EdgeTransaction)
+	}
+	if m.category != nil {
+		edges = append(edges, transactionsplit.EdgeCategory)
+	}
+	if m.tags != nil {
+		edges = append(edges, transactionsplit.EdgeTags)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TransactionSplitMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case transactionsplit.EdgeWorkspace:
+		if id := m.workspace; id != nil {
+			return []ent.Value{*id}
+		}
+	case transactionsplit.EdgeTransaction:
+		if id := m.transaction; id != nil {
+			return []ent.Value{*id}
+		}
+	case transactionsplit.EdgeCategory:
+		if id := m.category; id != nil {
+			return []ent.Value{*id}
+		}
+	case transactionsplit.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TransactionSplitMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedtags != nil {
+		edges = append(edges, transactionsplit.EdgeTags)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TransactionSplitMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case transactionsplit.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TransactionSplitMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedworkspace {
+		edges = append(edges, transactionsplit.EdgeWorkspace)
+	}
+	if m.clearedtransaction {
+		edges = append(edges, transactionsplit.EdgeTransaction)
+	}
+	if m.clearedcategory {
+		edges = append(edges, transactionsplit.EdgeCategory)
+	}
+	if m.clearedtags {
+		edges = append(edges, transactionsplit.EdgeTags)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TransactionSplitMutation) EdgeCleared(name string) bool {
+	switch name {
+	case transactionsplit.EdgeWorkspace:
+		return m.clearedworkspace
+	case transactionsplit.EdgeTransaction:
+		return m.clearedtransaction
+	case transactionsplit.EdgeCategory:
+		return m.clearedcategory
+	case transactionsplit.EdgeTags:
+		return m.clearedtags
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TransactionSplitMutation) ClearEdge(name string) error {
+	switch name {
+	case transactionsplit.EdgeWorkspace:
+		m.ClearWorkspace()
+		return nil
+	case transactionsplit.EdgeTransaction:
+		m.ClearTransaction()
+		return nil
+	case transactionsplit.EdgeCategory:
+		m.ClearCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown TransactionSplit unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TransactionSplitMutation) ResetEdge(name string) error {
+	switch name {
+	case transactionsplit.EdgeWorkspace:
+		m.ResetWorkspace()
+		return nil
+	case transactionsplit.EdgeTransaction:
+		m.ResetTransaction()
+		return nil
+	case transactionsplit.EdgeCategory:
+		m.ResetCategory()
+		return nil
+	case transactionsplit.EdgeTags:
+		m.ResetTags()
+		return nil
+	}
+	return fmt.Errorf("unknown TransactionSplit edge %s", name)
+}
+
+// TransferMutation represents an operation that mutates the Transfer nodes in the graph.
+type TransferMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	from_fee                *model.Decimal
+	to_fee                  *model.Decimal
+	created_at              *time.Time
+	clearedFields           map[string]struct{}
+	workspace               *int
+	clearedworkspace        bool
+	from_transaction        *int
+	clearedfrom_transaction bool
+	to_transaction          *int
+	clearedto_transaction   bool
+	done                    bool
+	oldValue                func(context.Context) (*Transfer, error)
+	predicates              []predicate.Transfer
+}
+
+var _ ent.Mutation = (*TransferMutation)(nil)
+
+// transferOption allows management of the mutation configuration using functional options.
+type transferOption func(*TransferMutation)
+
+// newTransferMutation creates new mutation for the Transfer entity.
+func newTransferMutation(c config, op Op, opts ...transferOption) *TransferMutation {
+	m := &TransferMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTransfer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTransferID sets the ID field of the mutation.
+func withTransferID(id int) transferOption {
+	return func(m *TransferMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Transfer
+		)
+		m.oldValue = func(ctx context.Context) (*Transfer, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Transfer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTransfer sets the old Transfer of the mutation.
+func withTransfer(node *Transfer) transferOption {
+	return func(m *TransferMutation) {
+		m.oldValue = func(context.Context) (*Transfer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TransferMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TransferMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TransferMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TransferMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Transfer.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *TransferMutation) SetWorkspaceID(i int) {
+	m.workspace = &i
+}
+
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *TransferMutation) WorkspaceID() (r int, exists bool) {
+	v := m.workspace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaceID returns the old "workspace_id" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldWorkspaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *TransferMutation) ResetWorkspaceID() {
+	m.workspace = nil
+}
+
+// SetFromTransactionID sets the "from_transaction_id" field.
+func (m *TransferMutation) SetFromTransactionID(i int) {
+	m.from_transaction = &i
+}
+
+// FromTransactionID returns the value of the "from_transaction_id" field in the mutation.
+func (m *TransferMutation) FromTransactionID() (r int, exists bool) {
+	v := m.from_transaction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromTransactionID returns the old "from_transaction_id" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldFromTransactionID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromTransactionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromTransactionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromTransactionID: %w", err)
+	}
+	return oldValue.FromTransactionID, nil
+}
+
+// ResetFromTransactionID resets all changes to the "from_transaction_id" field.
+func (m *TransferMutation) ResetFromTransactionID() {
+	m.from_transaction = nil
+}
+
+// SetToTransactionID sets the "to_transaction_id" field.
+func (m *TransferMutation) SetToTransactionID(i int) {
+	m.to_transaction = &i
+}
+
+// ToTransactionID returns the value of the "to_transaction_id" field in the mutation.
+func (m *TransferMutation) ToTransactionID() (r int, exists bool) {
+	v := m.to_transaction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToTransactionID returns the old "to_transaction_id" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldToTransactionID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToTransactionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToTransactionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToTransactionID: %w", err)
+	}
+	return oldValue.ToTransactionID, nil
+}
+
+// ResetToTransactionID resets all changes to the "to_transaction_id" field.
+func (m *TransferMutation) ResetToTransactionID() {
+	m.to_transaction = nil
+}
+
+// SetFromFee sets the "from_fee" field.
+func (m *TransferMutation) SetFromFee(value model.Decimal) {
+	m.from_fee = &value
+}
+
+// FromFee returns the value of the "from_fee" field in the mutation.
+func (m *TransferMutation) FromFee() (r model.Decimal, exists bool) {
+	v := m.from_fee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromFee returns the old "from_fee" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldFromFee(ctx context.Context) (v model.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromFee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromFee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromFee: %w", err)
+	}
+	return oldValue.FromFee, nil
+}
+
+// ResetFromFee resets all changes to the "from_fee" field.
+func (m *TransferMutation) ResetFromFee() {
+	m.from_fee = nil
+}
+
+// SetToFee sets the "to_fee" field.
+func (m *TransferMutation) SetToFee(value model.Decimal) {
+	m.to_fee = &value
+}
+
+// ToFee returns the value of the "to_fee" field in the mutation.
+func (m *TransferMutation) ToFee() (r model.Decimal, exists bool) {
+	v := m.to_fee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToFee returns the old "to_fee" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldToFee(ctx context.Context) (v model.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToFee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToFee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToFee: %w", err)
+	}
+	return oldValue.ToFee, nil
+}
+
+// ResetToFee resets all changes to the "to_fee" field.
+func (m *TransferMutation) ResetToFee() {
+	m.to_fee = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TransferMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TransferMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TransferMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (m *TransferMutation) ClearWorkspace() {
+	m.clearedworkspace = true
+	m.clearedFields[transfer.FieldWorkspaceID] = struct{}{}
+}
+
+// WorkspaceCleared reports if the "workspace" edge to the Workspace entity was cleared.
+func (m *TransferMutation) WorkspaceCleared() bool {
+	return m.clearedworkspace
+}
+
+// WorkspaceIDs returns the "workspace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkspaceID instead. It exists only for internal usage by the builders.
+func (m *TransferMutation) WorkspaceIDs() (ids []int) {
+	if id := m.workspace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWorkspace resets all changes to the "workspace" edge.
+func (m *TransferMutation) ResetWorkspace() {
+	m.workspace = nil
+	m.clearedworkspace = false
+}
+
+// ClearFromTransaction clears the "from_transaction" edge to the Transaction entity.
+func (m *TransferMutation) ClearFromTransaction() {
+	m.clearedfrom_transaction = true
+	m.clearedFields[transfer.FieldFromTransactionID] = struct{}{}
+}
+
+// FromTransactionCleared reports if the "from_transaction" edge to the Transaction entity was cleared.
+func (m *TransferMutation) FromTransactionCleared() bool {
+	return m.clearedfrom_transaction
+}
+
+// FromTransactionIDs returns the "from_transaction" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FromTransactionID instead. It exists only for internal usage by the builders.
+func (m *TransferMutation) FromTransactionIDs() (ids []int) {
+	if id := m.from_transaction; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFromTransaction resets all changes to the "from_transaction" edge.
+func (m *TransferMutation) ResetFromTransaction() {
+	m.from_transaction = nil
+	m.clearedfrom_transaction = false
+}
+
+// ClearToTransaction clears the "to_transaction" edge to the Transaction entity.
+func (m *TransferMutation) ClearToTransaction() {
+	m.clearedto_transaction = true
+	m.clearedFields[transfer.FieldToTransactionID] = struct{}{}
+}
+
+// ToTransactionCleared reports if the "to_transaction" edge to the Transaction entity was cleared.
+func (m *TransferMutation) ToTransactionCleared() bool {
+	return m.clearedto_transaction
+}
+
+// ToTransactionIDs returns the "to_transaction" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ToTransactionID instead. It exists only for internal usage by the builders.
+func (m *TransferMutation) ToTransactionIDs() (ids []int) {
+	if id := m.to_transaction; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetToTransaction resets all changes to the "to_transaction" edge.
+func (m *TransferMutation) ResetToTransaction() {
+	m.to_transaction = nil
+	m.clearedto_transaction = false
+}
+
+// Where appends a list predicates to the TransferMutation builder.
+func (m *TransferMutation) Where(ps ...predicate.Transfer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TransferMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TransferMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Transfer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TransferMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TransferMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Transfer).
+func (m *TransferMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TransferMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.workspace != nil {
+		fields = append(fields, transfer.FieldWorkspaceID)
+	}
+	if m.from_transaction != nil {
+		fields = append(fields, transfer.FieldFromTransactionID)
+	}
+	if m.to_transaction != nil {
+		fields = append(fields, transfer.FieldToTransactionID)
+	}
+	if m.from_fee != nil {
+		fields = append(fields, transfer.FieldFromFee)
+	}
+	if m.to_fee != nil {
+		fields = append(fields, transfer.FieldToFee)
+	}
+	if m.created_at != nil {
+		fields = append(fields, transfer.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TransferMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case transfer.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case transfer.FieldFromTransactionID:
+		return m.FromTransactionID()
+	case transfer.FieldToTransactionID:
+		return m.ToTransactionID()
+	case transfer.FieldFromFee:
+		return m.FromFee()
+	case transfer.FieldToFee:
+		return m.ToFee()
+	case transfer.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TransferMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case transfer.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case transfer.FieldFromTransactionID:
+		return m.OldFromTransactionID(ctx)
+	case transfer.FieldToTransactionID:
+		return m.OldToTransactionID(ctx)
+	case transfer.FieldFromFee:
+		return m.OldFromFee(ctx)
+	case transfer.FieldToFee:
+		return m.OldToFee(ctx)
+	case transfer.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Transfer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransferMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case transfer.FieldWorkspaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case transfer.FieldFromTransactionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromTransactionID(v)
+		return nil
+	case transfer.FieldToTransactionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToTransactionID(v)
+		return nil
+	case transfer.FieldFromFee:
+		v, ok := value.(model.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromFee(v)
+		return nil
+	case transfer.FieldToFee:
+		v, ok := value.(model.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToFee(v)
+		return nil
+	case transfer.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Transfer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TransferMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TransferMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransferMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Transfer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TransferMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TransferMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TransferMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Transfer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TransferMutation) ResetField(name string) error {
+	switch name {
+	case transfer.FieldWorkspaceID:
+		m.ResetWorkspaceID()
+		return nil
+	case transfer.FieldFromTransactionID:
+		m.ResetFromTransactionID()
+		return nil
+	case transfer.FieldToTransactionID:
+		m.ResetToTransactionID()
+		return nil
+	case transfer.FieldFromFee:
+		m.ResetFromFee()
+		return nil
+	case transfer.FieldToFee:
+		m.ResetToFee()
+		return nil
+	case transfer.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Transfer field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TransferMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.workspace != nil {
+		edges = append(edges, transfer.EdgeWorkspace)
+	}
+	if m.from_transaction != nil {
+		edges = append(edges, transfer.EdgeFromTransaction)
+	}
+	if m.to_transaction != nil {
+		edges = append(edges, transfer.EdgeToTransaction)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TransferMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case transfer.EdgeWorkspace:
+		if id := m.workspace; id != nil {
+			return []ent.Value{*id}
+		}
+	case transfer.EdgeFromTransaction:
+		if id := m.from_transaction; id != nil {
+			return []ent.Value{*id}
+		}
+	case transfer.EdgeToTransaction:
+		if id := m.to_transaction; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TransferMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TransferMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TransferMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedworkspace {
+		edges = append(edges, transfer.EdgeWorkspace)
+	}
+	if m.clearedfrom_transaction {
+		edges = append(edges, transfer.EdgeFromTransaction)
+	}
+	if m.clearedto_transaction {
+		edges = append(edges, transfer.EdgeToTransaction)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TransferMutation) EdgeCleared(name string) bool {
+	switch name {
+	case transfer.EdgeWorkspace:
+		return m.clearedworkspace
+	case transfer.EdgeFromTransaction:
+		return m.clearedfrom_transaction
+	case transfer.EdgeToTransaction:
+		return m.clearedto_transaction
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TransferMutation) ClearEdge(name string) error {
+	switch name {
+	case transfer.EdgeWorkspace:
+		m.ClearWorkspace()
+		return nil
+	case transfer.EdgeFromTransaction:
+		m.ClearFromTransaction()
+		return nil
+	case transfer.EdgeToTransaction:
+		m.ClearToTransaction()
+		return nil
+	}
+	return fmt.Errorf("unknown Transfer unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TransferMutation) ResetEdge(name string) error {
+	switch name {
+	case transfer.EdgeWorkspace:
+		m.ResetWorkspace()
+		return nil
+	case transfer.EdgeFromTransaction:
+		m.ResetFromTransaction()
+		return nil
+	case transfer.EdgeToTransaction:
+		m.ResetToTransaction()
+		return nil
+	}
+	return fmt.Errorf("unknown Transfer edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                               Op
+	typ                              string
+	id                               *int
+	email                            *string
+	password_hash                    *string
+	email_verified_at                *time.Time
+	totp_secret                      *string
+	totp_last_used_step              *int64
+	addtotp_last_used_step           *int64
+	two_factor_enabled_at            *time.Time
+	two_factor_failed_attempts       *int
+	addtwo_factor_failed_attempts    *int
+	two_factor_locked_until          *time.Time
+	created_at                       *time.Time
+	updated_at                       *time.Time
+	clearedFields                    map[string]struct{}
+	workspaces                       map[int]struct{}
+	removedworkspaces                map[int]struct{}
+	clearedworkspaces                bool
+	sessions                         map[int]struct{}
+	removedsessions                  map[int]struct{}
+	clearedsessions                  bool
+	password_reset_tokens            map[int]struct{}
+	removedpassword_reset_tokens     map[int]struct{}
+	clearedpassword_reset_tokens     bool
+	email_verification_tokens        map[int]struct{}
+	removedemail_verification_tokens map[int]struct{}
+	clearedemail_verification_tokens bool
+	recovery_codes                   map[int]struct{}
+	removedrecovery_codes            map[int]struct{}
+	clearedrecovery_codes            bool
+	memberships                      map[int]struct{}
+	removedmemberships               map[int]struct{}
+	clearedmemberships               bool
+	done                             bool
+	oldValue                         func(context.Context) (*User, error)
+	predicates                       []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (m *UserMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
+}
+
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *UserMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordHash returns the old "password_hash" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	}
+	return oldValue.PasswordHash, nil
+}
+
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *UserMutation) ResetPasswordHash() {
+	m.password_hash = nil
+}
+
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (m *UserMutation) SetEmailVerifiedAt(t time.Time) {
+	m.email_verified_at = &t
+}
+
+// EmailVerifiedAt returns the value of the "email_verified_at" field in the mutation.
+func (m *UserMutation) EmailVerifiedAt() (r time.Time, exists bool) {
+	v := m.email_verified_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmailVerifiedAt returns the old "email_verified_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmailVerifiedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmailVerifiedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmailVerifiedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmailVerifiedAt: %w", err)
+	}
+	return oldValue.EmailVerifiedAt, nil
+}
+
+// ClearEmailVerifiedAt clears the value of the "email_verified_at" field.
+func (m *UserMutation) ClearEmailVerifiedAt() {
+	m.email_verified_at = nil
+	m.clearedFields[user.FieldEmailVerifiedAt] = struct{}{}
+}
+
+// EmailVerifiedAtCleared returns if the "email_verified_at" field was cleared in this mutation.
+func (m *UserMutation) EmailVerifiedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldEmailVerifiedAt]
+	return ok
+}
+
+// ResetEmailVerifiedAt resets all changes to the "email_verified_at" field.
+func (m *UserMutation) ResetEmailVerifiedAt() {
+	m.email_verified_at = nil
+	delete(m.clearedFields, user.FieldEmailVerifiedAt)
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (m *UserMutation) SetTotpSecret(s string) {
+	m.totp_secret = &s
+}
+
+// TotpSecret returns the value of the "totp_secret" field in the mutation.
+func (m *UserMutation) TotpSecret() (r string, exists bool) {
+	v := m.totp_secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotpSecret returns the old "totp_secret" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTotpSecret(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotpSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotpSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotpSecret: %w", err)
+	}
+	return oldValue.TotpSecret, nil
+}
+
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (m *UserMutation) ClearTotpSecret() {
+	m.totp_secret = nil
+	m.clearedFields[user.FieldTotpSecret] = struct{}{}
+}
+
+// TotpSecretCleared returns if the "totp_secret" field was cleared in this mutation.
+func (m *UserMutation) TotpSecretCleared() bool {
+	_, ok := m.clearedFields[user.FieldTotpSecret]
+	return ok
+}
+
+// ResetTotpSecret resets all changes to the "totp_secret" field.
+func (m *UserMutation) ResetTotpSecret() {
+	m.totp_secret = nil
+	delete(m.clearedFields, user.FieldTotpSecret)
+}
+
+// SetTotpLastUsedStep sets the "totp_last_used_step" field.
+func (m *UserMutation) SetTotpLastUsedStep(i int64) {
+	m.totp_last_used_step = &i
+	m.addtotp_last_used_step = nil
+}
+
+// TotpLastUsedStep returns the value of the "totp_last_used_step" field in the mutation.
+func (m *UserMutation) TotpLastUsedStep() (r int64, exists bool) {
+	v := m.totp_last_used_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotpLastUsedStep returns the old "totp_last_used_step" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTotpLastUsedStep(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotpLastUsedStep is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotpLastUsedStep requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotpLastUsedStep: %w", err)
+	}
+	return oldValue.TotpLastUsedStep, nil
+}
+
+// AddTotpLastUsedStep adds i to the "totp_last_used_step" field.
+func (m *UserMutation) AddTotpLastUsedStep(i int64) {
+	if m.addtotp_last_used_step != nil {
+		*m.addtotp_last_used_step += i
+	} else {
+		m.addtotp_last_used_step = &i
+	}
+}
+
+// AddedTotpLastUsedStep returns the value that was added to the "totp_last_used_step" field in this mutation.
+func (m *UserMutation) AddedTotpLastUsedStep() (r int64, exists bool) {
+	v := m.addtotp_last_used_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTotpLastUsedStep clears the value of the "totp_last_used_step" field.
+func (m *UserMutation) ClearTotpLastUsedStep() {
+	m.totp_last_used_step = nil
+	m.addtotp_last_used_step = nil
+	m.clearedFields[user.FieldTotpLastUsedStep] = struct{}{}
+}
+
+// TotpLastUsedStepCleared returns if the "totp_last_used_step" field was cleared in this mutation.
+func (m *UserMutation) TotpLastUsedStepCleared() bool {
+	_, ok := m.clearedFields[user.FieldTotpLastUsedStep]
+	return ok
+}
+
+// ResetTotpLastUsedStep resets all changes to the "totp_last_used_step" field.
+func (m *UserMutation) ResetTotpLastUsedStep() {
+	m.totp_last_used_step = nil
+	m.addtotp_last_used_step = nil
+	delete(m.clearedFields, user.FieldTotpLastUsedStep)
+}
+
+// SetTwoFactorEnabledAt sets the "two_factor_enabled_at" field.
+func (m *UserMutation) SetTwoFactorEnabledAt(t time.Time) {
+	m.two_factor_enabled_at = &t
+}
+
+// TwoFactorEnabledAt returns the value of the "two_factor_enabled_at" field in the mutation.
+func (m *UserMutation) TwoFactorEnabledAt() (r time.Time, exists bool) {
+	v := m.two_factor_enabled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTwoFactorEnabledAt returns the old "two_factor_enabled_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTwoFactorEnabledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTwoFactorEnabledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTwoFactorEnabledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTwoFactorEnabledAt: %w", err)
+	}
+	return oldValue.TwoFactorEnabledAt, nil
+}
+
+// ClearTwoFactorEnabledAt clears the value of the "two_factor_enabled_at" field.
+func (m *UserMutation) ClearTwoFactorEnabledAt() {
+	m.two_factor_enabled_at = nil
+	m.clearedFields[user.FieldTwoFactorEnabledAt] = struct{}{}
+}
+
+// TwoFactorEnabledAtCleared returns if the "two_factor_enabled_at" field was cleared in this mutation.
+func (m *UserMutation) TwoFactorEnabledAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldTwoFactorEnabledAt]
+	return ok
+}
+
+// ResetTwoFactorEnabledAt resets all changes to the "two_factor_enabled_at" field.
+func (m *UserMutation) ResetTwoFactorEnabledAt() {
+	m.two_factor_enabled_at = nil
+	delete(m.clearedFields, user.FieldTwoFactorEnabledAt)
+}
+
+// SetTwoFactorFailedAttempts sets the "two_factor_failed_attempts" field.
+func (m *UserMutation) SetTwoFactorFailedAttempts(i int) {
+	m.two_factor_failed_attempts = &i
+	m.addtwo_factor_failed_attempts = nil
+}
+
+// TwoFactorFailedAttempts returns the value of the "two_factor_failed_attempts" field in the mutation.
+func (m *UserMutation) TwoFactorFailedAttempts() (r int, exists bool) {
+	v := m.two_factor_failed_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTwoFactorFailedAttempts returns the old "two_factor_failed_attempts" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTwoFactorFailedAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTwoFactorFailedAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTwoFactorFailedAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTwoFactorFailedAttempts: %w", err)
+	}
+	return oldValue.TwoFactorFailedAttempts, nil
+}
+
+// AddTwoFactorFailedAttempts adds i to the "two_factor_failed_attempts" field.
+func (m *UserMutation) AddTwoFactorFailedAttempts(i int) {
+	if m.addtwo_factor_failed_attempts != nil {
+		*m.addtwo_factor_failed_attempts += i
+	} else {
+		m.addtwo_factor_failed_attempts = &i
+	}
+}
+
+// AddedTwoFactorFailedAttempts returns the value that was added to the "two_factor_failed_attempts" field in this mutation.
+func (m *UserMutation) AddedTwoFactorFailedAttempts() (r int, exists bool) {
+	v := m.addtwo_factor_failed_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTwoFactorFailedAttempts resets all changes to the "two_factor_failed_attempts" field.
+func (m *UserMutation) ResetTwoFactorFailedAttempts() {
+	m.two_factor_failed_attempts = nil
+	m.addtwo_factor_failed_attempts = nil
+}
+
+// SetTwoFactorLockedUntil sets the "two_factor_locked_until" field.
+func (m *UserMutation) SetTwoFactorLockedUntil(t time.Time) {
+	m.two_factor_locked_until = &t
+}
+
+// TwoFactorLockedUntil returns the value of the "two_factor_locked_until" field in the mutation.
+func (m *UserMutation) TwoFactorLockedUntil() (r time.Time, exists bool) {
+	v := m.two_factor_locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTwoFactorLockedUntil returns the old "two_factor_locked_until" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTwoFactorLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTwoFactorLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTwoFactorLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTwoFactorLockedUntil: %w", err)
+	}
+	return oldValue.TwoFactorLockedUntil, nil
+}
+
+// ClearTwoFactorLockedUntil clears the value of the "two_factor_locked_until" field.
+func (m *UserMutation) ClearTwoFactorLockedUntil() {
+	m.two_factor_locked_until = nil
+	m.clearedFields[user.FieldTwoFactorLockedUntil] = struct{}{}
+}
+
+// TwoFactorLockedUntilCleared returns if the "two_factor_locked_until" field was cleared in this mutation.
+func (m *UserMutation) TwoFactorLockedUntilCleared() bool {
+	_, ok := m.clearedFields[user.FieldTwoFactorLockedUntil]
+	return ok
+}
+
+// ResetTwoFactorLockedUntil resets all changes to the "two_factor_locked_until" field.
+func (m *UserMutation) ResetTwoFactorLockedUntil() {
+	m.two_factor_locked_until = nil
+	delete(m.clearedFields, user.FieldTwoFactorLockedUntil)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddWorkspaceIDs adds the "workspaces" edge to the Workspace entity by ids.
+func (m *UserMutation) AddWorkspaceIDs(ids ...int) {
+	if m.workspaces == nil {
+		m.workspaces = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.workspaces[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWorkspaces clears the "workspaces" edge to the Workspace entity.
+func (m *UserMutation) ClearWorkspaces() {
+	m.clearedworkspaces = true
+}
+
+// WorkspacesCleared reports if the "workspaces" edge to the Workspace entity was cleared.
+func (m *UserMutation) WorkspacesCleared() bool {
+	return m.clearedworkspaces
+}
+
+// RemoveWorkspaceIDs removes the "workspaces" edge to the Workspace entity by IDs.
+func (m *UserMutation) RemoveWorkspaceIDs(ids ...int) {
+	if m.removedworkspaces == nil {
+		m.removedworkspaces = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.workspaces, ids[i])
+		m.removedworkspaces[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWorkspaces returns the removed IDs of the "workspaces" edge to the Workspace entity.
+func (m *UserMutation) RemovedWorkspacesIDs() (ids []int) {
+	for id := range m.removedworkspaces {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WorkspacesIDs returns the "workspaces" edge IDs in the mutation.
+func (m *UserMutation) WorkspacesIDs() (ids []int) {
+	for id := range m.workspaces {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWorkspaces resets all changes to the "workspaces" edge.
+func (m *UserMutation) ResetWorkspaces() {
+	m.workspaces = nil
+	m.clearedworkspaces = false
+	m.removedworkspaces = nil
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by ids.
+func (m *UserMutation) AddSessionIDs(ids ...int) {
+	if m.sessions == nil {
+		m.sessions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSessions clears the "sessions" edge to the Session entity.
+func (m *UserMutation) ClearSessions() {
+	m.clearedsessions = true
+}
+
+// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
+func (m *UserMutation) SessionsCleared() bool {
+	return m.clearedsessions
+}
+
+// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
+func (m *UserMutation) RemoveSessionIDs(ids ...int) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
+func (m *UserMutation) RemovedSessionsIDs() (ids []int) {
+	for id := range m.removedsessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *UserMutation) SessionsIDs() (ids []int) {
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *UserMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
+}
+
+// AddPasswordResetTokenIDs adds the "password_reset_tokens" edge to the PasswordResetToken entity by ids.
+func (m *UserMutation) AddPasswordResetTokenIDs(ids ...int) {
+	if m.password_reset_tokens == nil {
+		m.password_reset_tokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.password_reset_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPasswordResetTokens clears the "password_reset_tokens" edge to the PasswordResetToken entity.
+func (m *UserMutation) ClearPasswordResetTokens() {
+	m.clearedpassword_reset_tokens = true
+}
+
+// PasswordResetTokensCleared reports if the "password_reset_tokens" edge to the PasswordResetToken entity was cleared.
+func (m *UserMutation) PasswordResetTokensCleared() bool {
+	return m.clearedpassword_reset_tokens
+}
+
+// RemovePasswordResetTokenIDs removes the "password_reset_tokens" edge to the PasswordResetToken entity by IDs.
+func (m *UserMutation) RemovePasswordResetTokenIDs(ids ...int) {
+	if m.removedpassword_reset_tokens == nil {
+		m.removedpassword_reset_tokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.password_reset_tokens, ids[i])
+		m.removedpassword_reset_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPasswordResetTokens returns the removed IDs of the "password_reset_tokens" edge to the PasswordResetToken entity.
+func (m *UserMutation) RemovedPasswordResetTokensIDs() (ids []int) {
+	for id := range m.removedpassword_reset_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PasswordResetTokensIDs returns the "password_reset_tokens" edge IDs in the mutation.
+func (m *UserMutation) PasswordResetTokensIDs() (ids []int) {
+	for id := range m.password_reset_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPasswordResetTokens resets all changes to the "password_reset_tokens" edge.
+func (m *UserMutation) ResetPasswordResetTokens() {
+	m.password_reset_tokens = nil
+	m.clearedpassword_reset_tokens = false
+	m.removedpassword_reset_tokens = nil
+}
+
+// AddEmailVerificationTokenIDs adds the "email_verification_tokens" edge to the EmailVerificationToken entity by ids.
+func (m *UserMutation) AddEmailVerificationTokenIDs(ids ...int) {
+	if m.email_verification_tokens == nil {
+		m.email_verification_tokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.email_verification_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEmailVerificationTokens clears the "email_verification_tokens" edge to the EmailVerificationToken entity.
+func (m *UserMutation) ClearEmailVerificationTokens() {
+	m.clearedemail_verification_tokens = true
+}
+
+// EmailVerificationTokensCleared reports if the "email_verification_tokens" edge to the EmailVerificationToken entity was cleared.
+func (m *UserMutation) EmailVerificationTokensCleared() bool {
+	return m.clearedemail_verification_tokens
+}
+
+// RemoveEmailVerificationTokenIDs removes the "email_verification_tokens" edge to the EmailVerificationToken entity by IDs.
+func (m *UserMutation) RemoveEmailVerificationTokenIDs(ids ...int) {
+	if m.removedemail_verification_tokens == nil {
+		m.removedemail_verification_tokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.email_verification_tokens, ids[i])
+		m.removedemail_verification_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEmailVerificationTokens returns the removed IDs of the "email_verification_tokens" edge to the EmailVerificationToken entity.
+func (m *UserMutation) RemovedEmailVerificationTokensIDs() (ids []int) {
+	for id := range m.removedemail_verification_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EmailVerificationTokensIDs returns the "email_verification_tokens" edge IDs in the mutation.
+func (m *UserMutation) EmailVerificationTokensIDs() (ids []int) {
+	for id := range m.email_verification_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEmailVerificationTokens resets all changes to the "email_verification_tokens" edge.
+func (m *UserMutation) ResetEmailVerificationTokens() {
+	m.email_verification_tokens = nil
+	m.clearedemail_verification_tokens = false
+	m.removedemail_verification_tokens = nil
+}
+
+// AddRecoveryCodeIDs adds the "recovery_codes" edge to the RecoveryCode entity by ids.
+func (m *UserMutation) AddRecoveryCodeIDs(ids ...int) {
+	if m.recovery_codes == nil {
+		m.recovery_codes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.recovery_codes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRecoveryCodes clears the "recovery_codes" edge to the RecoveryCode entity.
+func (m *UserMutation) ClearRecoveryCodes() {
+	m.clearedrecovery_codes = true
+}
+
+// RecoveryCodesCleared reports if the "recovery_codes" edge to the RecoveryCode entity was cleared.
+func (m *UserMutation) RecoveryCodesCleared() bool {
+	return m.clearedrecovery_codes
+}
+
+// RemoveRecoveryCodeIDs removes the "recovery_codes" edge to the RecoveryCode entity by IDs.
+func (m *UserMutation) RemoveRecoveryCodeIDs(ids ...int) {
+	if m.removedrecovery_codes == nil {
+		m.removedrecovery_codes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.recovery_codes, ids[i])
+		m.removedrecovery_codes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRecoveryCodes returns the removed IDs of the "recovery_codes" edge to the RecoveryCode entity.
+func (m *UserMutation) RemovedRecoveryCodesIDs() (ids []int) {
+	for id := range m.removedrecovery_codes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RecoveryCodesIDs returns the "recovery_codes" edge IDs in the mutation.
+func (m *UserMutation) RecoveryCodesIDs() (ids []int) {
+	for id := range m.recovery_codes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRecoveryCodes resets all changes to the "recovery_codes" edge.
+func (m *UserMutation) ResetRecoveryCodes() {
+	m.recovery_codes = nil
+	m.clearedrecovery_codes = false
+	m.removedrecovery_codes = nil
+}
+
+// AddMembershipIDs adds the "memberships" edge to the Membership entity by ids.
+func (m *UserMutation) AddMembershipIDs(ids ...int) {
+	if m.memberships == nil {
+		m.memberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.memberships[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMemberships clears the "memberships" edge to the Membership entity.
+func (m *UserMutation) ClearMemberships() {
+	m.clearedmemberships = true
+}
+
+// MembershipsCleared reports if the "memberships" edge to the Membership entity was cleared.
+func (m *UserMutation) MembershipsCleared() bool {
+	return m.clearedmemberships
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to the Membership entity by IDs.
+func (m *UserMutation) RemoveMembershipIDs(ids ...int) {
+	if m.removedmemberships == nil {
+		m.removedmemberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.memberships, ids[i])
+		m.removedmemberships[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMemberships returns the removed IDs of the "memberships" edge to the Membership entity.
+func (m *UserMutation) RemovedMembershipsIDs() (ids []int) {
+	for id := range m.removedmemberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembershipsIDs returns the "memberships" edge IDs in the mutation.
+func (m *UserMutation) MembershipsIDs() (ids []int) {
+	for id := range m.memberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMemberships resets all changes to the "memberships" edge.
+func (m *UserMutation) ResetMemberships() {
+	m.memberships = nil
+	m.clearedmemberships = false
+	m.removedmemberships = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.password_hash != nil {
+		fields = append(fields, user.FieldPasswordHash)
+	}
+	if m.email_verified_at != nil {
+		fields = append(fields, user.FieldEmailVerifiedAt)
+	}
+	if m.totp_secret != nil {
+		fields = append(fields, user.FieldTotpSecret)
+	}
+	if m.totp_last_used_step != nil {
+		fields = append(fields, user.FieldTotpLastUsedStep)
+	}
+	if m.two_factor_enabled_at != nil {
+		fields = append(fields, user.FieldTwoFactorEnabledAt)
+	}
+	if m.two_factor_failed_attempts != nil {
+		fields = append(fields, user.FieldTwoFactorFailedAttempts)
+	}
+	if m.two_factor_locked_until != nil {
+		fields = append(fields, user.FieldTwoFactorLockedUntil)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldPasswordHash:
+		return m.PasswordHash()
+	case user.FieldEmailVerifiedAt:
+		return m.EmailVerifiedAt()
+	case user.FieldTotpSecret:
+		return m.TotpSecret()
+	case user.FieldTotpLastUsedStep:
+		return m.TotpLastUsedStep()
+	case user.FieldTwoFactorEnabledAt:
+		return m.TwoFactorEnabledAt()
+	case user.FieldTwoFactorFailedAttempts:
+		return m.TwoFactorFailedAttempts()
+	case user.FieldTwoFactorLockedUntil:
+		return m.TwoFactorLockedUntil()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case user.FieldEmailVerifiedAt:
+		return m.OldEmailVerifiedAt(ctx)
+	case user.FieldTotpSecret:
+		return m.OldTotpSecret(ctx)
+	case user.FieldTotpLastUsedStep:
+		return m.OldTotpLastUsedStep(ctx)
+	case user.FieldTwoFactorEnabledAt:
+		return m.OldTwoFactorEnabledAt(ctx)
+	case user.FieldTwoFactorFailedAttempts:
+		return m.OldTwoFactorFailedAttempts(ctx)
+	case user.FieldTwoFactorLockedUntil:
+		return m.OldTwoFactorLockedUntil(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldPasswordHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHash(v)
+		return nil
+	case user.FieldEmailVerifiedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmailVerifiedAt(v)
+		return nil
+	case user.FieldTotpSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpSecret(v)
+		return nil
+	case user.FieldTotpLastUsedStep:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpLastUsedStep(v)
+		return nil
+	case user.FieldTwoFactorEnabledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTwoFactorEnabledAt(v)
+		return nil
+	case user.FieldTwoFactorFailedAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTwoFactorFailedAttempts(v)
+		return nil
+	case user.FieldTwoFactorLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTwoFactorLockedUntil(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotp_last_used_step != nil {
+		fields = append(fields, user.FieldTotpLastUsedStep)
+	}
+	if m.addtwo_factor_failed_attempts != nil {
+		fields = append(fields, user.FieldTwoFactorFailedAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldTotpLastUsedStep:
+		return m.AddedTotpLastUsedStep()
+	case user.FieldTwoFactorFailedAttempts:
+		return m.AddedTwoFactorFailedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldTotpLastUsedStep:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotpLastUsedStep(v)
+		return nil
+	case user.FieldTwoFactorFailedAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTwoFactorFailedAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldEmailVerifiedAt) {
+		fields = append(fields, user.FieldEmailVerifiedAt)
+	}
+	if m.FieldCleared(user.FieldTotpSecret) {
+		fields = append(fields, user.FieldTotpSecret)
+	}
+	if m.FieldCleared(user.FieldTotpLastUsedStep) {
+		fields = append(fields, user.FieldTotpLastUsedStep)
+	}
+	if m.FieldCleared(user.FieldTwoFactorEnabledAt) {
+		fields = append(fields, user.FieldTwoFactorEnabledAt)
+	}
+	if m.FieldCleared(user.FieldTwoFactorLockedUntil) {
+		fields = append(fields, user.FieldTwoFactorLockedUntil)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldEmailVerifiedAt:
+		m.ClearEmailVerifiedAt()
+		return nil
+	case user.FieldTotpSecret:
+		m.ClearTotpSecret()
+		return nil
+	case user.FieldTotpLastUsedStep:
+		m.ClearTotpLastUsedStep()
+		return nil
+	case user.FieldTwoFactorEnabledAt:
+		m.ClearTwoFactorEnabledAt()
+		return nil
+	case user.FieldTwoFactorLockedUntil:
+		m.ClearTwoFactorLockedUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case user.FieldPasswordHash:
+		m.ResetPasswordHash()
+		return nil
+	case user.FieldEmailVerifiedAt:
+		m.ResetEmailVerifiedAt()
+		return nil
+	case user.FieldTotpSecret:
+		m.ResetTotpSecret()
+		return nil
+	case user.FieldTotpLastUsedStep:
+		m.ResetTotpLastUsedStep()
+		return nil
+	case user.FieldTwoFactorEnabledAt:
+		m.ResetTwoFactorEnabledAt()
+		return nil
+	case user.FieldTwoFactorFailedAttempts:
+		m.ResetTwoFactorFailedAttempts()
+		return nil
+	case user.FieldTwoFactorLockedUntil:
+		m.ResetTwoFactorLockedUntil()
+		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.workspaces != nil {
+		edges = append(edges, user.EdgeWorkspaces)
+	}
+	if m.sessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.password_reset_tokens != nil {
+		edges = append(edges, user.EdgePasswordResetTokens)
+	}
+	if m.email_verification_tokens != nil {
+		edges = append(edges, user.EdgeEmailVerificationTokens)
+	}
+	if m.recovery_codes != nil {
+		edges = append(edges, user.EdgeRecoveryCodes)
+	}
+	if m.memberships != nil {
+		edges = append(edges, user.EdgeMemberships)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeWorkspaces:
+		ids := make([]ent.Value, 0, len(m.workspaces))
+		for id := range m.workspaces {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePasswordResetTokens:
+		ids := make([]ent.Value, 0, len(m.password_reset_tokens))
+		for id := range m.password_reset_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeEmailVerificationTokens:
+		ids := make([]ent.Value, 0, len(m.email_verification_tokens))
+		for id := range m.email_verification_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRecoveryCodes:
+		ids := make([]ent.Value, 0, len(m.recovery_codes))
+		for id := range m.recovery_codes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.memberships))
+		for id := range m.memberships {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedworkspaces != nil {
+		edges = append(edges, user.EdgeWorkspaces)
+	}
+	if m.removedsessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.removedpassword_reset_tokens != nil {
+		edges = append(edges, user.EdgePasswordResetTokens)
+	}
+	if m.removedemail_verification_tokens != nil {
+		edges = append(edges, user.EdgeEmailVerificationTokens)
+	}
+	if m.removedrecovery_codes != nil {
+		edges = append(edges, user.EdgeRecoveryCodes)
+	}
+	if m.removedmemberships != nil {
+		edges = append(edges, user.EdgeMemberships)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeWorkspaces:
+		ids := make([]ent.Value, 0, len(m.removedworkspaces))
+		for id := range m.removedworkspaces {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePasswordResetTokens:
+		ids := make([]ent.Value, 0, len(m.removedpassword_reset_tokens))
+		for id := range m.removedpassword_reset_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeEmailVerificationTokens:
+		ids := make([]ent.Value, 0, len(m.removedemail_verification_tokens))
+		for id := range m.removedemail_verification_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRecoveryCodes:
+		ids := make([]ent.Value, 0, len(m.removedrecovery_codes))
+		for id := range m.removedrecovery_codes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.removedmemberships))
+		for id := range m.removedmemberships {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedworkspaces {
+		edges = append(edges, user.EdgeWorkspaces)
+	}
+	if m.clearedsessions {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.clearedpassword_reset_tokens {
+		edges = append(edges, user.EdgePasswordResetTokens)
+	}
+	if m.clearedemail_verification_tokens {
+		edges = append(edges, user.EdgeEmailVerificationTokens)
+	}
+	if m.clearedrecovery_codes {
+		edges = append(edges, user.EdgeRecoveryCodes)
+	}
+	if m.clearedmemberships {
+		edges = append(edges, user.EdgeMemberships)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeWorkspaces:
+		return m.clearedworkspaces
+	case user.EdgeSessions:
+		return m.clearedsessions
+	case user.EdgePasswordResetTokens:
+		return m.clearedpassword_reset_tokens
+	case user.EdgeEmailVerificationTokens:
+		return m.clearedemail_verification_tokens
+	case user.EdgeRecoveryCodes:
+		return m.clearedrecovery_codes
+	case user.EdgeMemberships:
+		return m.clearedmemberships
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeWorkspaces:
+		m.ResetWorkspaces()
+		return nil
+	case user.EdgeSessions:
+		m.ResetSessions()
+		return nil
+	case user.EdgePasswordResetTokens:
+		m.ResetPasswordResetTokens()
+		return nil
+	case user.EdgeEmailVerificationTokens:
+		m.ResetEmailVerificationTokens()
+		return nil
+	case user.EdgeRecoveryCodes:
+		m.ResetRecoveryCodes()
+		return nil
+	case user.EdgeMemberships:
+		m.ResetMemberships()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
+}
+
+// WorkspaceMutation represents an operation that mutates the Workspace nodes in the graph.
+type WorkspaceMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *int
+	name                          *string
+	require_verified_email        *bool
+	created_at                    *time.Time
+	updated_at                    *time.Time
+	clearedFields                 map[string]struct{}
+	users                         map[int]struct{}
+	removedusers                  map[int]struct{}
+	clearedusers                  bool
+	invitations                   map[int]struct{}
+	removedinvitations            map[int]struct{}
+	clearedinvitations            bool
+	accounts                      map[int]struct{}
+	removedaccounts               map[int]struct{}
+	clearedaccounts               bool
+	transactions                  map[int]struct{}
+	removedtransactions           map[int]struct{}
+	clearedtransactions           bool
+	transaction_splits            map[int]struct{}
+	removedtransaction_splits     map[int]struct{}
+	clearedtransaction_splits     bool
+	transfers                     map[int]struct{}
+	removedtransfers              map[int]struct{}
+	clearedtransfers              bool
+	categories                    map[int]struct{}
+	removedcategories             map[int]struct{}
+	clearedcategories             bool
+	payees                        map[int]struct{}
+	removedpayees                 map[int]struct{}
+	clearedpayees                 bool
+	recurrence_rules              map[int]struct{}
+	removedrecurrence_rules       map[int]struct{}
+	clearedrecurrence_rules       bool
+	recurrence_occurrences        map[int]struct{}
+	removedrecurrence_occurrences map[int]struct{}
+	clearedrecurrence_occurrences bool
+	statement_imports             map[int]struct{}
+	removedstatement_imports      map[int]struct{}
+	clearedstatement_imports      bool
+	import_profiles               map[int]struct{}
+	removedimport_profiles        map[int]struct{}
+	clearedimport_profiles        bool
+	import_decisions              map[int]struct{}
+	removedimport_decisions       map[int]struct{}
+	clearedimport_decisions       bool
+	tags                          map[int]struct{}
+	removedtags                   map[int]struct{}
+	clearedtags                   bool
+	journal_entries               map[int]struct{}
+	removedjournal_entries        map[int]struct{}
+	clearedjournal_entries        bool
+	postings                      map[int]struct{}
+	removedpostings               map[int]struct{}
+	clearedpostings               bool
+	memberships                   map[int]struct{}
+	removedmemberships            map[int]struct{}
+	clearedmemberships            bool
+	done                          bool
+	oldValue                      func(context.Context) (*Workspace, error)
+	predicates                    []predicate.Workspace
+}
+
+var _ ent.Mutation = (*WorkspaceMutation)(nil)
+
+// workspaceOption allows management of the mutation configuration using functional options.
+type workspaceOption func(*WorkspaceMutation)
+
+// newWorkspaceMutation creates new mutation for the Workspace entity.
+func newWorkspaceMutation(c config, op Op, opts ...workspaceOption) *WorkspaceMutation {
+	m := &WorkspaceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWorkspace,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWorkspaceID sets the ID field of the mutation.
+func withWorkspaceID(id int) workspaceOption {
+	return func(m *WorkspaceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Workspace
+		)
+		m.oldValue = func(ctx context.Context) (*Workspace, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Workspace.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWorkspace sets the old Workspace of the mutation.
+func withWorkspace(node *Workspace) workspaceOption {
+	return func(m *WorkspaceMutation) {
+		m.oldValue = func(context.Context) (*Workspace, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WorkspaceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WorkspaceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WorkspaceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WorkspaceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Workspace.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *WorkspaceMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WorkspaceMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Workspace entity.
+// If the Workspace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkspaceMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *WorkspaceMutation) ResetName() {
+	m.name = nil
+}
+
+// SetRequireVerifiedEmail sets the "require_verified_email" field.
+func (m *WorkspaceMutation) SetRequireVerifiedEmail(b bool) {
+	m.require_verified_email = &b
+}
+
+// RequireVerifiedEmail returns the value of the "require_verified_email" field in the mutation.
+func (m *WorkspaceMutation) RequireVerifiedEmail() (r bool, exists bool) {
+	v := m.require_verified_email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequireVerifiedEmail returns the old "require_verified_email" field's value of the Workspace entity.
+// If the Workspace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkspaceMutation) OldRequireVerifiedEmail(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequireVerifiedEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequireVerifiedEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequireVerifiedEmail: %w", err)
+	}
+	return oldValue.RequireVerifiedEmail, nil
+}
+
+// ResetRequireVerifiedEmail resets all changes to the "require_verified_email" field.
+func (m *WorkspaceMutation) ResetRequireVerifiedEmail() {
+	m.require_verified_email = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WorkspaceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WorkspaceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Workspace entity.
+// If the Workspace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkspaceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WorkspaceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WorkspaceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WorkspaceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Workspace entity.
+// If the Workspace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkspaceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WorkspaceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *WorkspaceMutation) AddUserIDs(ids ...int) {
+	if m.users == nil {
+		m.users = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *WorkspaceMutation) ClearUsers() {
+	m.clearedusers = true
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *WorkspaceMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *WorkspaceMutation) RemoveUserIDs(ids ...int) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *WorkspaceMutation) RemovedUsersIDs() (ids []int) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *WorkspaceMutation) UsersIDs() (ids []int) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *WorkspaceMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
+
+// AddInvitationIDs adds the "invitations" edge to the WorkspaceInvitation entity by ids.
+func (m *WorkspaceMutation) AddInvitationIDs(ids ...int) {
+	if m.invitations == nil {
+		m.invitations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.invitations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInvitations clears the "invitations" edge to the WorkspaceInvitation entity.
+func (m *WorkspaceMutation) ClearInvitations() {
+	m.clearedinvitations = true
+}
+
+// InvitationsCleared reports if the "invitations" edge to the WorkspaceInvitation entity was cleared.
+func (m *WorkspaceMutation) InvitationsCleared() bool {
+	return m.clearedinvitations
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to the WorkspaceInvitation entity by IDs.
+func (m *WorkspaceMutation) RemoveInvitationIDs(ids ...int) {
+	if m.removedinvitations == nil {
+		m.removedinvitations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.invitations, ids[i])
+		m.removedinvitations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInvitations returns the removed IDs of the "invitations" edge to the WorkspaceInvitation entity.
+func (m *WorkspaceMutation) RemovedInvitationsIDs() (ids []int) {
+	for id := range m.removedinvitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InvitationsIDs returns the "invitations" edge IDs in the mutation.
+func (m *WorkspaceMutation) InvitationsIDs() (ids []int) {
+	for id := range m.invitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInvitations resets all changes to the "invitations" edge.
+func (m *WorkspaceMutation) ResetInvitations() {
+	m.invitations = nil
+	m.clearedinvitations = false
+	m.removedinvitations = nil
+}
+
+// AddAccountIDs adds the "accounts" edge to the Account entity by ids.
+func (m *WorkspaceMutation) AddAccountIDs(ids ...int) {
+	if m.accounts == nil {
+		m.accounts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.accounts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAccounts clears the "accounts" edge to the Account entity.
+func (m *WorkspaceMutation) ClearAccounts() {
+	m.clearedaccounts = true
+}
+
+// AccountsCleared reports if the "accounts" edge to the Account entity was cleared.
+func (m *WorkspaceMutation) AccountsCleared() bool {
+	return m.clearedaccounts
+}
+
+// RemoveAccountIDs removes the "accounts" edge to the Account entity by IDs.
+func (m *WorkspaceMutation) RemoveAccountIDs(ids ...int) {
+	if m.removedaccounts == nil {
+		m.removedaccounts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.accounts, ids[i])
+		m.removedaccounts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAccounts returns the removed IDs of the "accounts" edge to the Account entity.
+func (m *WorkspaceMutation) RemovedAccountsIDs() (ids []int) {
+	for id := range m.removedaccounts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AccountsIDs returns the "accounts" edge IDs in the mutation.
+func (m *WorkspaceMutation) AccountsIDs() (ids []int) {
+	for id := range m.accounts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAccounts resets all changes to the "accounts" edge.
+func (m *WorkspaceMutation) ResetAccounts() {
+	m.accounts = nil
+	m.clearedaccounts = false
+	m.removedaccounts = nil
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by ids.
+func (m *WorkspaceMutation) AddTransactionIDs(ids ...int) {
+	if m.transactions == nil {
+		m.transactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.transactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTransactions clears the "transactions" edge to the Transaction entity.
+func (m *WorkspaceMutation) ClearTransactions() {
+	m.clearedtransactions = true
+}
+
+// TransactionsCleared reports if the "transactions" edge to the Transaction entity was cleared.
+func (m *WorkspaceMutation) TransactionsCleared() bool {
+	return m.clearedtransactions
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to the Transaction entity by IDs.
+func (m *WorkspaceMutation) RemoveTransactionIDs(ids ...int) {
+	if m.removedtransactions == nil {
+		m.removedtransactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.transactions, ids[i])
+		m.removedtransactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTransactions returns the removed IDs of the "transactions" edge to the Transaction entity.
+func (m *WorkspaceMutation) RemovedTransactionsIDs() (ids []int) {
+	for id := range m.removedtransactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TransactionsIDs returns the "transactions" edge IDs in the mutation.
+func (m *WorkspaceMutation) TransactionsIDs() (ids []int) {
+	for id := range m.transactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTransactions resets all changes to the "transactions" edge.
+func (m *WorkspaceMutation) ResetTransactions() {
+	m.transactions = nil
+	m.clearedtransactions = false
+	m.removedtransactions = nil
+}
+
+// AddTransactionSplitIDs adds the "transaction_splits" edge to the TransactionSplit entity by ids.
+func (m *WorkspaceMutation) AddTransactionSplitIDs(ids ...int) {
+	if m.transaction_splits == nil {
+		m.transaction_splits = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.transaction_splits[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTransactionSplits clears the "transaction_splits" edge to the TransactionSplit entity.
+func (m *WorkspaceMutation) ClearTransactionSplits() {
+	m.clearedtransaction_splits = true
+}
+
+// TransactionSplitsCleared reports if the "transaction_splits" edge to the TransactionSplit entity was cleared.
+func (m *WorkspaceMutation) TransactionSplitsCleared() bool {
+	return m.clearedtransaction_splits
+}
+
+// RemoveTransactionSplitIDs removes the "transaction_splits" edge to the TransactionSplit entity by IDs.
+func (m *WorkspaceMutation) RemoveTransactionSplitIDs(ids ...int) {
+	if m.removedtransaction_splits == nil {
+		m.removedtransaction_splits = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.transaction_splits, ids[i])
+		m.removedtransaction_splits[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTransactionSplits returns the removed IDs of the "transaction_splits" edge to the TransactionSplit entity.
+func (m *WorkspaceMutation) RemovedTransactionSplitsIDs() (ids []int) {
+	for id := range m.removedtransaction_splits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TransactionSplitsIDs returns the "transaction_splits" edge IDs in the mutation.
+func (m *WorkspaceMutation) TransactionSplitsIDs() (ids []int) {
+	for id := range m.transaction_splits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTransactionSplits resets all changes to the "transaction_splits" edge.
+func (m *WorkspaceMutation) ResetTransactionSplits() {
+	m.transaction_splits = nil
+	m.clearedtransaction_splits = false
+	m.removedtransaction_splits = nil
+}
+
+// AddTransferIDs adds the "transfers" edge to the Transfer entity by ids.
+func (m *WorkspaceMutation) AddTransferIDs(ids ...int) {
+	if m.transfers == nil {
+		m.transfers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.transfers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTransfers clears the "transfers" edge to the Transfer entity.
+func (m *WorkspaceMutation) ClearTransfers() {
+	m.clearedtransfers = true
+}
+
+// TransfersCleared reports if the "transfers" edge to the Transfer entity was cleared.
+func (m *WorkspaceMutation) TransfersCleared() bool {
+	return m.clearedtransfers
+}
+
+// RemoveTransferIDs removes the "transfers" edge to the Transfer entity by IDs.
+func (m *WorkspaceMutation) RemoveTransferIDs(ids ...int) {
+	if m.removedtransfers == nil {
+		m.removedtransfers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.transfers, ids[i])
+		m.removedtransfers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTransfers returns the removed IDs of the "transfers" edge to the Transfer entity.
+func (m *WorkspaceMutation) RemovedTransfersIDs() (ids []int) {
+	for id := range m.removedtransfers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TransfersIDs returns the "transfers" edge IDs in the mutation.
+func (m *WorkspaceMutation) TransfersIDs() (ids []int) {
+	for id := range m.transfers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTransfers resets all changes to the "transfers" edge.
+func (m *WorkspaceMutation) ResetTransfers() {
+	m.transfers = nil
+	m.clearedtransfers = false
+	m.removedtransfers = nil
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by ids.
+func (m *WorkspaceMutation) AddCategoryIDs(ids ...int) {
+	if m.categories == nil {
+		m.categories = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.categories[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCategories clears the "categories" edge to the Category entity.
+func (m *WorkspaceMutation) ClearCategories() {
+	m.clearedcategories = true
+}
+
+// CategoriesCleared reports if the "categories" edge to the Category entity was cleared.
+func (m *WorkspaceMutation) CategoriesCleared() bool {
+	return m.clearedcategories
+}
+
+// RemoveCategoryIDs removes the "categories" edge to the Category entity by IDs.
+func (m *WorkspaceMutation) RemoveCategoryIDs(ids ...int) {
+	if m.removedcategories == nil {
+		m.removedcategories = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.categories, ids[i])
+		m.removedcategories[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCategories returns the removed IDs of the "categories" edge to the Category entity.
+func (m *WorkspaceMutation) RemovedCategoriesIDs() (ids []int) {
+	for id := range m.removedcategories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CategoriesIDs returns the "categories" edge IDs in the mutation.
+func (m *WorkspaceMutation) CategoriesIDs() (ids []int) {
+	for id := range m.categories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCategories resets all changes to the "categories" edge.
+func (m *WorkspaceMutation) ResetCategories() {
+	m.categories = nil
+	m.clearedcategories = false
+	m.removedcategories = nil
+}
+
+// AddPayeeIDs adds the "payees" edge to the Payee entity by ids.
+func (m *WorkspaceMutation) AddPayeeIDs(ids ...int) {
+	if m.payees == nil {
+		m.payees = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.payees[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPayees clears the "payees" edge to the Payee entity.
+func (m *WorkspaceMutation) ClearPayees() {
+	m.clearedpayees = true
+}
+
+// PayeesCleared reports if the "payees" edge to the Payee entity was cleared.
+func (m *WorkspaceMutation) PayeesCleared() bool {
+	return m.clearedpayees
+}
+
+// RemovePayeeIDs removes the "payees" edge to the Payee entity by IDs.
+func (m *WorkspaceMutation) RemovePayeeIDs(ids ...int) {
+	if m.removedpayees == nil {
+		m.removedpayees = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.payees, ids[i])
+		m.removedpayees[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPayees returns the removed IDs of the "payees" edge to the Payee entity.
+func (m *WorkspaceMutation) RemovedPayeesIDs() (ids []int) {
+	for id := range m.removedpayees {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PayeesIDs returns the "payees" edge IDs in the mutation.
+func (m *WorkspaceMutation) PayeesIDs() (ids []int) {
+	for id := range m.payees {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPayees resets all changes to the "payees" edge.
+func (m *WorkspaceMutation) ResetPayees() {
+	m.payees = nil
+	m.clearedpayees = false
+	m.removedpayees = nil
+}
+
+// AddRecurrenceRuleIDs adds the "recurrence_rules" edge to the RecurrenceRule entity by ids.
+func (m *WorkspaceMutation) AddRecurrenceRuleIDs(ids ...int) {
+	if m.recurrence_rules == nil {
+		m.recurrence_rules = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.recurrence_rules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRecurrenceRules clears the "recurrence_rules" edge to the RecurrenceRule entity.
+func (m *WorkspaceMutation) ClearRecurrenceRules() {
+	m.clearedrecurrence_rules = true
+}
+
+// RecurrenceRulesCleared reports if the "recurrence_rules" edge to the RecurrenceRule entity was cleared.
+func (m *WorkspaceMutation) RecurrenceRulesCleared() bool {
+	return m.clearedrecurrence_rules
+}
+
+// RemoveRecurrenceRuleIDs removes the "recurrence_rules" edge to the RecurrenceRule entity by IDs.
+func (m *WorkspaceMutation) RemoveRecurrenceRuleIDs(ids ...int) {
+	if m.removedrecurrence_rules == nil {
+		m.removedrecurrence_rules = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.recurrence_rules, ids[i])
+		m.removedrecurrence_rules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRecurrenceRules returns the removed IDs of the "recurrence_rules" edge to the RecurrenceRule entity.
+func (m *WorkspaceMutation) RemovedRecurrenceRulesIDs() (ids []int) {
+	for id := range m.removedrecurrence_rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RecurrenceRulesIDs returns the "recurrence_rules" edge IDs in the mutation.
+func (m *WorkspaceMutation) RecurrenceRulesIDs() (ids []int) {
+	for id := range m.recurrence_rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRecurrenceRules resets all changes to the "recurrence_rules" edge.
+func (m *WorkspaceMutation) ResetRecurrenceRules() {
+	m.recurrence_rules = nil
+	m.clearedrecurrence_rules = false
+	m.removedrecurrence_rules = nil
+}
+
+// AddRecurrenceOccurrenceIDs adds the "recurrence_occurrences" edge to the RecurrenceOccurrence entity by ids.
+func (m *WorkspaceMutation) AddRecurrenceOccurrenceIDs(ids ...int) {
+	if m.recurrence_occurrences == nil {
+		m.recurrence_occurrences = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.recurrence_occurrences[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRecurrenceOccurrences clears the "recurrence_occurrences" edge to the RecurrenceOccurrence entity.
+func (m *WorkspaceMutation) ClearRecurrenceOccurrences() {
+	m.clearedrecurrence_occurrences = true
+}
+
+// RecurrenceOccurrencesCleared reports if the "recurrence_occurrences" edge to the RecurrenceOccurrence entity was cleared.
+func (m *WorkspaceMutation) RecurrenceOccurrencesCleared() bool {
+	return m.clearedrecurrence_occurrences
+}
+
+// RemoveRecurrenceOccurrenceIDs removes the "recurrence_occurrences" edge to the RecurrenceOccurrence entity by IDs.
+func (m *WorkspaceMutation) RemoveRecurrenceOccurrenceIDs(ids ...int) {
+	if m.removedrecurrence_occurrences == nil {
+		m.removedrecurrence_occurrences = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.recurrence_occurrences, ids[i])
+		m.removedrecurrence_occurrences[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRecurrenceOccurrences returns the removed IDs of the "recurrence_occurrences" edge to the RecurrenceOccurrence entity.
+func (m *WorkspaceMutation) RemovedRecurrenceOccurrencesIDs() (ids []int) {
+	for id := range m.removedrecurrence_occurrences {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RecurrenceOccurrencesIDs returns the "recurrence_occurrences" edge IDs in the mutation.
+func (m *WorkspaceMutation) RecurrenceOccurrencesIDs() (ids []int) {
+	for id := range m.recurrence_occurrences {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRecurrenceOccurrences resets all changes to the "recurrence_occurrences" edge.
+func (m *WorkspaceMutation) ResetRecurrenceOccurrences() {
+	m.recurrence_occurrences = nil
+	m.clearedrecurrence_occurrences = false
+	m.removedrecurrence_occurrences = nil
+}
+
+// AddStatementImportIDs adds the "statement_imports" edge to the StatementImport entity by ids.
+func (m *WorkspaceMutation) AddStatementImportIDs(ids ...int) {
+	if m.statement_imports == nil {
+		m.statement_imports = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.statement_imports[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStatementImports clears the "statement_imports" edge to the StatementImport entity.
+func (m *WorkspaceMutation) ClearStatementImports() {
+	m.clearedstatement_imports = true
+}
+
+// StatementImportsCleared reports if the "statement_imports" edge to the StatementImport entity was cleared.
+func (m *WorkspaceMutation) StatementImportsCleared() bool {
+	return m.clearedstatement_imports
+}
+
+// RemoveStatementImportIDs removes the "statement_imports" edge to the StatementImport entity by IDs.
+func (m *WorkspaceMutation) RemoveStatementImportIDs(ids ...int) {
+	if m.removedstatement_imports == nil {
+		m.removedstatement_imports = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.statement_imports, ids[i])
+		m.removedstatement_imports[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStatementImports returns the removed IDs of the "statement_imports" edge to the StatementImport entity.
+func (m *WorkspaceMutation) RemovedStatementImportsIDs() (ids []int) {
+	for id := range m.removedstatement_imports {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StatementImportsIDs returns the "statement_imports" edge IDs in the mutation.
+func (m *WorkspaceMutation) StatementImportsIDs() (ids []int) {
+	for id := range m.statement_imports {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStatementImports resets all changes to the "statement_imports" edge.
+func (m *WorkspaceMutation) ResetStatementImports() {
+	m.statement_imports = nil
+	m.clearedstatement_imports = false
+	m.removedstatement_imports = nil
+}
+
+// AddImportProfileIDs adds the "import_profiles" edge to the ImportProfile entity by ids.
+func (m *WorkspaceMutation) AddImportProfileIDs(ids ...int) {
+	if m.import_profiles == nil {
+		m.import_profiles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.import_profiles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearImportProfiles clears the "import_profiles" edge to the ImportProfile entity.
+func (m *WorkspaceMutation) ClearImportProfiles() {
+	m.clearedimport_profiles = true
+}
+
+// ImportProfilesCleared reports if the "import_profiles" edge to the ImportProfile entity was cleared.
+func (m *WorkspaceMutation) ImportProfilesCleared() bool {
+	return m.clearedimport_profiles
+}
+
+// RemoveImportProfileIDs removes the "import_profiles" edge to the ImportProfile entity by IDs.
+func (m *WorkspaceMutation) RemoveImportProfileIDs(ids ...int) {
+	if m.removedimport_profiles == nil {
+		m.removedimport_profiles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.import_profiles, ids[i])
+		m.removedimport_profiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedImportProfiles returns the removed IDs of the "import_profiles" edge to the ImportProfile entity.
+func (m *WorkspaceMutation) RemovedImportProfilesIDs() (ids []int) {
+	for id := range m.removedimport_profiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ImportProfilesIDs returns the "import_profiles" edge IDs in the mutation.
+func (m *WorkspaceMutation) ImportProfilesIDs() (ids []int) {
+	for id := range m.import_profiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetImportProfiles resets all changes to the "import_profiles" edge.
+func (m *WorkspaceMutation) ResetImportProfiles() {
+	m.import_profiles = nil
+	m.clearedimport_profiles = false
+	m.removedimport_profiles = nil
+}
+
+// AddImportDecisionIDs adds the "import_decisions" edge to the ImportDecision entity by ids.
+func (m *WorkspaceMutation) AddImportDecisionIDs(ids ...int) {
+	if m.import_decisions == nil {
+		m.import_decisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.import_decisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearImportDecisions clears the "import_decisions" edge to the ImportDecision entity.
+func (m *WorkspaceMutation) ClearImportDecisions() {
+	m.clearedimport_decisions = true
+}
+
+// ImportDecisionsCleared reports if the "import_decisions" edge to the ImportDecision entity was cleared.
+func (m *WorkspaceMutation) ImportDecisionsCleared() bool {
+	return m.clearedimport_decisions
+}
+
+// RemoveImportDecisionIDs removes the "import_decisions" edge to the ImportDecision entity by IDs.
+func (m *WorkspaceMutation) RemoveImportDecisionIDs(ids ...int) {
+	if m.removedimport_decisions == nil {
+		m.removedimport_decisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.import_decisions, ids[i])
+		m.removedimport_decisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedImportDecisions returns the removed IDs of the "import_decisions" edge to the ImportDecision entity.
+func (m *WorkspaceMutation) RemovedImportDecisionsIDs() (ids []int) {
+	for id := range m.removedimport_decisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ImportDecisionsIDs returns the "import_decisions" edge IDs in the mutation.
+func (m *WorkspaceMutation) ImportDecisionsIDs() (ids []int) {
+	for id := range m.import_decisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetImportDecisions resets all changes to the "import_decisions" edge.
+func (m *WorkspaceMutation) ResetImportDecisions() {
+	m.import_decisions = nil
+	m.clearedimport_decisions = false
+	m.removedimport_decisions = nil
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *WorkspaceMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *WorkspaceMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *WorkspaceMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *WorkspaceMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *WorkspaceMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *WorkspaceMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *WorkspaceMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddJournalEntryIDs adds the "journal_entries" edge to the JournalEntry entity by ids.
+func (m *WorkspaceMutation) AddJournalEntryIDs(ids ...int) {
+	if m.journal_entries == nil {
+		m.journal_entries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.journal_entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJournalEntries clears the "journal_entries" edge to the JournalEntry entity.
+func (m *WorkspaceMutation) ClearJournalEntries() {
+	m.clearedjournal_entries = true
+}
+
+// JournalEntriesCleared reports if the "journal_entries" edge to the JournalEntry entity was cleared.
+func (m *WorkspaceMutation) JournalEntriesCleared() bool {
+	return m.clearedjournal_entries
+}
+
+// RemoveJournalEntryIDs removes the "journal_entries" edge to the JournalEntry entity by IDs.
+func (m *WorkspaceMutation) RemoveJournalEntryIDs(ids ...int) {
+	if m.removedjournal_entries == nil {
+		m.removedjournal_entries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.journal_entries, ids[i])
+		m.removedjournal_entries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedJournalEntries returns the removed IDs of the "journal_entries" edge to the JournalEntry entity.
+func (m *WorkspaceMutation) RemovedJournalEntriesIDs() (ids []int) {
+	for id := range m.removedjournal_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// JournalEntriesIDs returns the "journal_entries" edge IDs in the mutation.
+func (m *WorkspaceMutation) JournalEntriesIDs() (ids []int) {
+	for id := range m.journal_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetJournalEntries resets all changes to the "journal_entries" edge.
+func (m *WorkspaceMutation) ResetJournalEntries() {
+	m.journal_entries = nil
+	m.clearedjournal_entries = false
+	m.removedjournal_entries = nil
+}
+
+// AddPostingIDs adds the "postings" edge to the Posting entity by ids.
+func (m *WorkspaceMutation) AddPostingIDs(ids ...int) {
+	if m.postings == nil {
+		m.postings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.postings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPostings clears the "postings" edge to the Posting entity.
+func (m *WorkspaceMutation) ClearPostings() {
+	m.clearedpostings = true
+}
+
+// PostingsCleared reports if the "postings" edge to the Posting entity was cleared.
+func (m *WorkspaceMutation) PostingsCleared() bool {
+	return m.clearedpostings
+}
+
+// RemovePostingIDs removes the "postings" edge to the Posting entity by IDs.
+func (m *WorkspaceMutation) RemovePostingIDs(ids ...int) {
+	if m.removedpostings == nil {
+		m.removedpostings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.postings, ids[i])
+		m.removedpostings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPostings returns the removed IDs of the "postings" edge to the Posting entity.
+func (m *WorkspaceMutation) RemovedPostingsIDs() (ids []int) {
+	for id := range m.removedpostings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PostingsIDs returns the "postings" edge IDs in the mutation.
+func (m *WorkspaceMutation) PostingsIDs() (ids []int) {
+	for id := range m.postings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPostings resets all changes to the "postings" edge.
+func (m *WorkspaceMutation) ResetPostings() {
+	m.postings = nil
+	m.clearedpostings = false
+	m.removedpostings = nil
+}
+
+// AddMembershipIDs adds the "memberships" edge to the Membership entity by ids.
+func (m *WorkspaceMutation) AddMembershipIDs(ids ...int) {
+	if m.memberships == nil {
+		m.memberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.memberships[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMemberships clears the "memberships" edge to the Membership entity.
+func (m *WorkspaceMutation) ClearMemberships() {
+	m.clearedmemberships = true
+}
+
+// MembershipsCleared reports if the "memberships" edge to the Membership entity was cleared.
+func (m *WorkspaceMutation) MembershipsCleared() bool {
+	return m.clearedmemberships
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to the Membership entity by IDs.
+func (m *WorkspaceMutation) RemoveMembershipIDs(ids ...int) {
+	if m.removedmemberships == nil {
+		m.removedmemberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.memberships, ids[i])
+		m.removedmemberships[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMemberships returns the removed IDs of the "memberships" edge to the Membership entity.
+func (m *WorkspaceMutation) RemovedMembershipsIDs() (ids []int) {
+	for id := range m.removedmemberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembershipsIDs returns the "memberships" edge IDs in the mutation.
+func (m *WorkspaceMutation) MembershipsIDs() (ids []int) {
+	for id := range m.memberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMemberships resets all changes to the "memberships" edge.
+func (m *WorkspaceMutation) ResetMemberships() {
+	m.memberships = nil
+	m.clearedmemberships = false
+	m.removedmemberships = nil
+}
+
+// Where appends a list predicates to the WorkspaceMutation builder.
+func (m *WorkspaceMutation) Where(ps ...predicate.Workspace) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WorkspaceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WorkspaceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Workspace, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WorkspaceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WorkspaceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Workspace).
+func (m *WorkspaceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WorkspaceMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, workspace.FieldName)
+	}
+	if m.require_verified_email != nil {
+		fields = append(fields, workspace.FieldRequireVerifiedEmail)
+	}
+	if m.created_at != nil {
+		fields = append(fields, workspace.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, workspace.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WorkspaceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case workspace.FieldName:
+		return m.Name()
+	case workspace.FieldRequireVerifiedEmail:
+		return m.RequireVerifiedEmail()
+	case workspace.FieldCreatedAt:
+		return m.CreatedAt()
+	case workspace.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WorkspaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case workspace.FieldName:
+		return m.OldName(ctx)
+	case workspace.FieldRequireVerifiedEmail:
+		return m.OldRequireVerifiedEmail(ctx)
+	case workspace.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case workspace.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Workspace field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *WorkspaceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldEmail:
+	case workspace.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetName(v)
 		return nil
-	case user.FieldPasswordHash:
-		v, ok := value.(string)
+	case workspace.FieldRequireVerifiedEmail:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPasswordHash(v)
+		m.SetRequireVerifiedEmail(v)
 		return nil
-	case user.FieldCreatedAt:
+	case workspace.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case workspace.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -462,86 +21325,230 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown Workspace field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *WorkspaceMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WorkspaceMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *WorkspaceMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown Workspace numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *WorkspaceMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *WorkspaceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *WorkspaceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Workspace nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *WorkspaceMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldEmail:
-		m.ResetEmail()
+	case workspace.FieldName:
+		m.ResetName()
 		return nil
-	case user.FieldPasswordHash:
-		m.ResetPasswordHash()
+	case workspace.FieldRequireVerifiedEmail:
+		m.ResetRequireVerifiedEmail()
 		return nil
-	case user.FieldCreatedAt:
+	case workspace.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case user.FieldUpdatedAt:
+	case workspace.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown Workspace field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.workspaces != nil {
-		edges = append(edges, user.EdgeWorkspaces)
+func (m *WorkspaceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 17)
+	if m.users != nil {
+		edges = append(edges, workspace.EdgeUsers)
+	}
+	if m.invitations != nil {
+		edges = append(edges, workspace.EdgeInvitations)
+	}
+	if m.accounts != nil {
+		edges = append(edges, workspace.EdgeAccounts)
+	}
+	if m.transactions != nil {
+		edges = append(edges, workspace.EdgeTransactions)
+	}
+	if m.transaction_splits != nil {
+		edges = append(edges, workspace.EdgeTransactionSplits)
+	}
+	if m.transfers != nil {
+		edges = append(edges, workspace.EdgeTransfers)
+	}
+	if m.categories != nil {
+		edges = append(edges, workspace.EdgeCategories)
+	}
+	if m.payees != nil {
+		edges = append(edges, workspace.EdgePayees)
+	}
+	if m.recurrence_rules != nil {
+		edges = append(edges, workspace.EdgeRecurrenceRules)
+	}
+	if m.recurrence_occurrences != nil {
+		edges = append(edges, workspace.EdgeRecurrenceOccurrences)
+	}
+	if m.statement_imports != nil {
+		edges = append(edges, workspace.EdgeStatementImports)
+	}
+	if m.import_profiles != nil {
+		edges = append(edges, workspace.EdgeImportProfiles)
+	}
+	if m.import_decisions != nil {
+		edges = append(edges, workspace.EdgeImportDecisions)
+	}
+	if m.tags != nil {
+		edges = append(edges, workspace.EdgeTags)
+	}
+	if m.journal_entries != nil {
+		edges = append(edges, workspace.EdgeJournalEntries)
+	}
+	if m.postings != nil {
+		edges = append(edges, workspace.EdgePostings)
+	}
+	if m.memberships != nil {
+		edges = append(edges, workspace.EdgeMemberships)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *WorkspaceMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeWorkspaces:
-		ids := make([]ent.Value, 0, len(m.workspaces))
-		for id := range m.workspaces {
+	case workspace.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeInvitations:
+		ids := make([]ent.Value, 0, len(m.invitations))
+		for id := range m.invitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeAccounts:
+		ids := make([]ent.Value, 0, len(m.accounts))
+		for id := range m.accounts {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.transactions))
+		for id := range m.transactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeTransactionSplits:
+		ids := make([]ent.Value, 0, len(m.transaction_splits))
+		for id := range m.transaction_splits {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeTransfers:
+		ids := make([]ent.Value, 0, len(m.transfers))
+		for id := range m.transfers {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeCategories:
+		ids := make([]ent.Value, 0, len(m.categories))
+		for id := range m.categories {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgePayees:
+		ids := make([]ent.Value, 0, len(m.payees))
+		for id := range m.payees {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeRecurrenceRules:
+		ids := make([]ent.Value, 0, len(m.recurrence_rules))
+		for id := range m.recurrence_rules {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeRecurrenceOccurrences:
+		ids := make([]ent.Value, 0, len(m.recurrence_occurrences))
+		for id := range m.recurrence_occurrences {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeStatementImports:
+		ids := make([]ent.Value, 0, len(m.statement_imports))
+		for id := range m.statement_imports {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeImportProfiles:
+		ids := make([]ent.Value, 0, len(m.import_profiles))
+		for id := range m.import_profiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeImportDecisions:
+		ids := make([]ent.Value, 0, len(m.import_decisions))
+		for id := range m.import_decisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeJournalEntries:
+		ids := make([]ent.Value, 0, len(m.journal_entries))
+		for id := range m.journal_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgePostings:
+		ids := make([]ent.Value, 0, len(m.postings))
+		for id := range m.postings {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.memberships))
+		for id := range m.memberships {
 			ids = append(ids, id)
 		}
 		return ids
@@ -550,21 +21557,165 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedworkspaces != nil {
-		edges = append(edges, user.EdgeWorkspaces)
+func (m *WorkspaceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 17)
+	if m.removedusers != nil {
+		edges = append(edges, workspace.EdgeUsers)
+	}
+	if m.removedinvitations != nil {
+		edges = append(edges, workspace.EdgeInvitations)
+	}
+	if m.removedaccounts != nil {
+		edges = append(edges, workspace.EdgeAccounts)
+	}
+	if m.removedtransactions != nil {
+		edges = append(edges, workspace.EdgeTransactions)
+	}
+	if m.removedtransaction_splits != nil {
+		edges = append(edges, workspace.EdgeTransactionSplits)
+	}
+	if m.removedtransfers != nil {
+		edges = append(edges, workspace.EdgeTransfers)
+	}
+	if m.removedcategories != nil {
+		edges = append(edges, workspace.EdgeCategories)
+	}
+	if m.removedpayees != nil {
+		edges = append(edges, workspace.EdgePayees)
+	}
+	if m.removedrecurrence_rules != nil {
+		edges = append(edges, workspace.EdgeRecurrenceRules)
+	}
+	if m.removedrecurrence_occurrences != nil {
+		edges = append(edges, workspace.EdgeRecurrenceOccurrences)
+	}
+	if m.removedstatement_imports != nil {
+		edges = append(edges, workspace.EdgeStatementImports)
+	}
+	if m.removedimport_profiles != nil {
+		edges = append(edges, workspace.EdgeImportProfiles)
+	}
+	if m.removedimport_decisions != nil {
+		edges = append(edges, workspace.EdgeImportDecisions)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, workspace.EdgeTags)
+	}
+	if m.removedjournal_entries != nil {
+		edges = append(edges, workspace.EdgeJournalEntries)
+	}
+	if m.removedpostings != nil {
+		edges = append(edges, workspace.EdgePostings)
+	}
+	if m.removedmemberships != nil {
+		edges = append(edges, workspace.EdgeMemberships)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+func (m *WorkspaceMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeWorkspaces:
-		ids := make([]ent.Value, 0, len(m.removedworkspaces))
-		for id := range m.removedworkspaces {
+	case workspace.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeInvitations:
+		ids := make([]ent.Value, 0, len(m.removedinvitations))
+		for id := range m.removedinvitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeAccounts:
+		ids := make([]ent.Value, 0, len(m.removedaccounts))
+		for id := range m.removedaccounts {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.removedtransactions))
+		for id := range m.removedtransactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeTransactionSplits:
+		ids := make([]ent.Value, 0, len(m.removedtransaction_splits))
+		for id := range m.removedtransaction_splits {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeTransfers:
+		ids := make([]ent.Value, 0, len(m.removedtransfers))
+		for id := range m.removedtransfers {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeCategories:
+		ids := make([]ent.Value, 0, len(m.removedcategories))
+		for id := range m.removedcategories {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgePayees:
+		ids := make([]ent.Value, 0, len(m.removedpayees))
+		for id := range m.removedpayees {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeRecurrenceRules:
+		ids := make([]ent.Value, 0, len(m.removedrecurrence_rules))
+		for id := range m.removedrecurrence_rules {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeRecurrenceOccurrences:
+		ids := make([]ent.Value, 0, len(m.removedrecurrence_occurrences))
+		for id := range m.removedrecurrence_occurrences {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeStatementImports:
+		ids := make([]ent.Value, 0, len(m.removedstatement_imports))
+		for id := range m.removedstatement_imports {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeImportProfiles:
+		ids := make([]ent.Value, 0, len(m.removedimport_profiles))
+		for id := range m.removedimport_profiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeImportDecisions:
+		ids := make([]ent.Value, 0, len(m.removedimport_decisions))
+		for id := range m.removedimport_decisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeJournalEntries:
+		ids := make([]ent.Value, 0, len(m.removedjournal_entries))
+		for id := range m.removedjournal_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgePostings:
+		ids := make([]ent.Value, 0, len(m.removedpostings))
+		for id := range m.removedpostings {
+			ids = append(ids, id)
+		}
+		return ids
+	case workspace.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.removedmemberships))
+		for id := range m.removedmemberships {
 			ids = append(ids, id)
 		}
 		return ids
@@ -573,72 +21724,207 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedworkspaces {
-		edges = append(edges, user.EdgeWorkspaces)
+func (m *WorkspaceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 17)
+	if m.clearedusers {
+		edges = append(edges, workspace.EdgeUsers)
+	}
+	if m.clearedinvitations {
+		edges = append(edges, workspace.EdgeInvitations)
+	}
+	if m.clearedaccounts {
+		edges = append(edges, workspace.EdgeAccounts)
+	}
+	if m.clearedtransactions {
+		edges = append(edges, workspace.EdgeTransactions)
+	}
+	if m.clearedtransaction_splits {
+		edges = append(edges, workspace.EdgeTransactionSplits)
+	}
+	if m.clearedtransfers {
+		edges = append(edges, workspace.EdgeTransfers)
+	}
+	if m.clearedcategories {
+		edges = append(edges, workspace.EdgeCategories)
+	}
+	if m.clearedpayees {
+		edges = append(edges, workspace.EdgePayees)
+	}
+	if m.clearedrecurrence_rules {
+		edges = append(edges, workspace.EdgeRecurrenceRules)
+	}
+	if m.clearedrecurrence_occurrences {
+		edges = append(edges, workspace.EdgeRecurrenceOccurrences)
+	}
+	if m.clearedstatement_imports {
+		edges = append(edges, workspace.EdgeStatementImports)
+	}
+	if m.clearedimport_profiles {
+		edges = append(edges, workspace.EdgeImportProfiles)
+	}
+	if m.clearedimport_decisions {
+		edges = append(edges, workspace.EdgeImportDecisions)
+	}
+	if m.clearedtags {
+		edges = append(edges, workspace.EdgeTags)
+	}
+	if m.clearedjournal_entries {
+		edges = append(edges, workspace.EdgeJournalEntries)
+	}
+	if m.clearedpostings {
+		edges = append(edges, workspace.EdgePostings)
+	}
+	if m.clearedmemberships {
+		edges = append(edges, workspace.EdgeMemberships)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *WorkspaceMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeWorkspaces:
-		return m.clearedworkspaces
+	case workspace.EdgeUsers:
+		return m.clearedusers
+	case workspace.EdgeInvitations:
+		return m.clearedinvitations
+	case workspace.EdgeAccounts:
+		return m.clearedaccounts
+	case workspace.EdgeTransactions:
+		return m.clearedtransactions
+	case workspace.EdgeTransactionSplits:
+		return m.clearedtransaction_splits
+	case workspace.EdgeTransfers:
+		return m.clearedtransfers
+	case workspace.EdgeCategories:
+		return m.clearedcategories
+	case workspace.EdgePayees:
+		return m.clearedpayees
+	case workspace.EdgeRecurrenceRules:
+		return m.clearedrecurrence_rules
+	case workspace.EdgeRecurrenceOccurrences:
+		return m.clearedrecurrence_occurrences
+	case workspace.EdgeStatementImports:
+		return m.clearedstatement_imports
+	case workspace.EdgeImportProfiles:
+		return m.clearedimport_profiles
+	case workspace.EdgeImportDecisions:
+		return m.clearedimport_decisions
+	case workspace.EdgeTags:
+		return m.clearedtags
+	case workspace.EdgeJournalEntries:
+		return m.clearedjournal_entries
+	case workspace.EdgePostings:
+		return m.clearedpostings
+	case workspace.EdgeMemberships:
+		return m.clearedmemberships
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *WorkspaceMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown Workspace unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *WorkspaceMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeWorkspaces:
-		m.ResetWorkspaces()
+	case workspace.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case workspace.EdgeInvitations:
+		m.ResetInvitations()
+		return nil
+	case workspace.EdgeAccounts:
+		m.ResetAccounts()
+		return nil
+	case workspace.EdgeTransactions:
+		m.ResetTransactions()
+		return nil
+	case workspace.EdgeTransactionSplits:
+		m.ResetTransactionSplits()
+		return nil
+	case workspace.EdgeTransfers:
+		m.ResetTransfers()
+		return nil
+	case workspace.EdgeCategories:
+		m.ResetCategories()
+		return nil
+	case workspace.EdgePayees:
+		m.ResetPayees()
+		return nil
+	case workspace.EdgeRecurrenceRules:
+		m.ResetRecurrenceRules()
+		return nil
+	case workspace.EdgeRecurrenceOccurrences:
+		m.ResetRecurrenceOccurrences()
+		return nil
+	case workspace.EdgeStatementImports:
+		m.ResetStatementImports()
+		return nil
+	case workspace.EdgeImportProfiles:
+		m.ResetImportProfiles()
+		return nil
+	case workspace.EdgeImportDecisions:
+		m.ResetImportDecisions()
+		return nil
+	case workspace.EdgeTags:
+		m.ResetTags()
+		return nil
+	case workspace.EdgeJournalEntries:
+		m.ResetJournalEntries()
+		return nil
+	case workspace.EdgePostings:
+		m.ResetPostings()
+		return nil
+	case workspace.EdgeMemberships:
+		m.ResetMemberships()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown Workspace edge %s", name)
 }
 
-// WorkspaceMutation represents an operation that mutates the Workspace nodes in the graph.
-type WorkspaceMutation struct {
+// WorkspaceInvitationMutation represents an operation that mutates the WorkspaceInvitation nodes in the graph.
+type WorkspaceInvitationMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	name          *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	users         map[int]struct{}
-	removedusers  map[int]struct{}
-	clearedusers  bool
-	done          bool
-	oldValue      func(context.Context) (*Workspace, error)
-	predicates    []predicate.Workspace
+	op                 Op
+	typ                string
+	id                 *int
+	email              *string
+	role               *workspaceinvitation.Role
+	token_hash         *string
+	status             *workspaceinvitation.Status
+	expires_at         *time.Time
+	responded_at       *time.Time
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	workspace          *int
+	clearedworkspace   bool
+	invited_by         *int
+	clearedinvited_by  bool
+	accepted_by        *int
+	clearedaccepted_by bool
+	done               bool
+	oldValue           func(context.Context) (*WorkspaceInvitation, error)
+	predicates         []predicate.WorkspaceInvitation
 }
 
-var _ ent.Mutation = (*WorkspaceMutation)(nil)
+var _ ent.Mutation = (*WorkspaceInvitationMutation)(nil)
 
-// workspaceOption allows management of the mutation configuration using functional options.
-type workspaceOption func(*WorkspaceMutation)
+// workspaceinvitationOption allows management of the mutation configuration using functional options.
+type workspaceinvitationOption func(*WorkspaceInvitationMutation)
 
-// newWorkspaceMutation creates new mutation for the Workspace entity.
-func newWorkspaceMutation(c config, op Op, opts ...workspaceOption) *WorkspaceMutation {
-	m := &WorkspaceMutation{
+// newWorkspaceInvitationMutation creates new mutation for the WorkspaceInvitation entity.
+func newWorkspaceInvitationMutation(c config, op Op, opts ...workspaceinvitationOption) *WorkspaceInvitationMutation {
+	m := &WorkspaceInvitationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeWorkspace,
+		typ:           TypeWorkspaceInvitation,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -647,20 +21933,20 @@ func newWorkspaceMutation(c config, op Op, opts ...workspaceOption) *WorkspaceMu
 	return m
 }
 
-// withWorkspaceID sets the ID field of the mutation.
-func withWorkspaceID(id int) workspaceOption {
-	return func(m *WorkspaceMutation) {
+// withWorkspaceInvitationID sets the ID field of the mutation.
+func withWorkspaceInvitationID(id int) workspaceinvitationOption {
+	return func(m *WorkspaceInvitationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Workspace
+			value *WorkspaceInvitation
 		)
-		m.oldValue = func(ctx context.Context) (*Workspace, error) {
+		m.oldValue = func(ctx context.Context) (*WorkspaceInvitation, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Workspace.Get(ctx, id)
+					value, err = m.Client().WorkspaceInvitation.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -669,10 +21955,10 @@ func withWorkspaceID(id int) workspaceOption {
 	}
 }
 
-// withWorkspace sets the old Workspace of the mutation.
-func withWorkspace(node *Workspace) workspaceOption {
-	return func(m *WorkspaceMutation) {
-		m.oldValue = func(context.Context) (*Workspace, error) {
+// withWorkspaceInvitation sets the old WorkspaceInvitation of the mutation.
+func withWorkspaceInvitation(node *WorkspaceInvitation) workspaceinvitationOption {
+	return func(m *WorkspaceInvitationMutation) {
+		m.oldValue = func(context.Context) (*WorkspaceInvitation, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -681,7 +21967,7 @@ func withWorkspace(node *Workspace) workspaceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m WorkspaceMutation) Client() *Client {
+func (m WorkspaceInvitationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -689,7 +21975,7 @@ func (m WorkspaceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m WorkspaceMutation) Tx() (*Tx, error) {
+func (m WorkspaceInvitationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -700,7 +21986,7 @@ func (m WorkspaceMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *WorkspaceMutation) ID() (id int, exists bool) {
+func (m *WorkspaceInvitationMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -711,7 +21997,7 @@ func (m *WorkspaceMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *WorkspaceMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WorkspaceInvitationMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -720,55 +22006,382 @@ func (m *WorkspaceMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Workspace.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WorkspaceInvitation.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *WorkspaceMutation) SetName(s string) {
-	m.name = &s
+// SetWorkspaceID sets the "workspace_id" field.
+func (m *WorkspaceInvitationMutation) SetWorkspaceID(i int) {
+	m.workspace = &i
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *WorkspaceMutation) Name() (r string, exists bool) {
-	v := m.name
+// WorkspaceID returns the value of the "workspace_id" field in the mutation.
+func (m *WorkspaceInvitationMutation) WorkspaceID() (r int, exists bool) {
+	v := m.workspace
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Workspace entity.
-// If the Workspace object wasn't provided to the builder, the object is fetched from the database.
+// OldWorkspaceID returns the old "workspace_id" field's value of the WorkspaceInvitation entity.
+// If the WorkspaceInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkspaceInvitationMutation) OldWorkspaceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaceID: %w", err)
+	}
+	return oldValue.WorkspaceID, nil
+}
+
+// ResetWorkspaceID resets all changes to the "workspace_id" field.
+func (m *WorkspaceInvitationMutation) ResetWorkspaceID() {
+	m.workspace = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *WorkspaceInvitationMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *WorkspaceInvitationMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the WorkspaceInvitation entity.
+// If the WorkspaceInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkspaceInvitationMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *WorkspaceInvitationMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetRole sets the "role" field.
+func (m *WorkspaceInvitationMutation) SetRole(w workspaceinvitation.Role) {
+	m.role = &w
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *WorkspaceInvitationMutation) Role() (r workspaceinvitation.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the WorkspaceInvitation entity.
+// If the WorkspaceInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkspaceInvitationMutation) OldRole(ctx context.Context) (v workspaceinvitation.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *WorkspaceInvitationMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *WorkspaceInvitationMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *WorkspaceInvitationMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the WorkspaceInvitation entity.
+// If the WorkspaceInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkspaceInvitationMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *WorkspaceInvitationMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *WorkspaceInvitationMutation) SetStatus(w workspaceinvitation.Status) {
+	m.status = &w
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WorkspaceInvitationMutation) Status() (r workspaceinvitation.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the WorkspaceInvitation entity.
+// If the WorkspaceInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkspaceInvitationMutation) OldStatus(ctx context.Context) (v workspaceinvitation.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *WorkspaceInvitationMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *WorkspaceInvitationMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *WorkspaceInvitationMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the WorkspaceInvitation entity.
+// If the WorkspaceInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkspaceInvitationMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *WorkspaceInvitationMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetInvitedByID sets the "invited_by_id" field.
+func (m *WorkspaceInvitationMutation) SetInvitedByID(i int) {
+	m.invited_by = &i
+}
+
+// InvitedByID returns the value of the "invited_by_id" field in the mutation.
+func (m *WorkspaceInvitationMutation) InvitedByID() (r int, exists bool) {
+	v := m.invited_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInvitedByID returns the old "invited_by_id" field's value of the WorkspaceInvitation entity.
+// If the WorkspaceInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkspaceInvitationMutation) OldInvitedByID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInvitedByID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInvitedByID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInvitedByID: %w", err)
+	}
+	return oldValue.InvitedByID, nil
+}
+
+// ClearInvitedByID clears the value of the "invited_by_id" field.
+func (m *WorkspaceInvitationMutation) ClearInvitedByID() {
+	m.invited_by = nil
+	m.clearedFields[workspaceinvitation.FieldInvitedByID] = struct{}{}
+}
+
+// InvitedByIDCleared returns if the "invited_by_id" field was cleared in this mutation.
+func (m *WorkspaceInvitationMutation) InvitedByIDCleared() bool {
+	_, ok := m.clearedFields[workspaceinvitation.FieldInvitedByID]
+	return ok
+}
+
+// ResetInvitedByID resets all changes to the "invited_by_id" field.
+func (m *WorkspaceInvitationMutation) ResetInvitedByID() {
+	m.invited_by = nil
+	delete(m.clearedFields, workspaceinvitation.FieldInvitedByID)
+}
+
+// SetAcceptedByID sets the "accepted_by_id" field.
+func (m *WorkspaceInvitationMutation) SetAcceptedByID(i int) {
+	m.accepted_by = &i
+}
+
+// AcceptedByID returns the value of the "accepted_by_id" field in the mutation.
+func (m *WorkspaceInvitationMutation) AcceptedByID() (r int, exists bool) {
+	v := m.accepted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcceptedByID returns the old "accepted_by_id" field's value of the WorkspaceInvitation entity.
+// If the WorkspaceInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkspaceInvitationMutation) OldAcceptedByID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcceptedByID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcceptedByID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcceptedByID: %w", err)
+	}
+	return oldValue.AcceptedByID, nil
+}
+
+// ClearAcceptedByID clears the value of the "accepted_by_id" field.
+func (m *WorkspaceInvitationMutation) ClearAcceptedByID() {
+	m.accepted_by = nil
+	m.clearedFields[workspaceinvitation.FieldAcceptedByID] = struct{}{}
+}
+
+// AcceptedByIDCleared returns if the "accepted_by_id" field was cleared in this mutation.
+func (m *WorkspaceInvitationMutation) AcceptedByIDCleared() bool {
+	_, ok := m.clearedFields[workspaceinvitation.FieldAcceptedByID]
+	return ok
+}
+
+// ResetAcceptedByID resets all changes to the "accepted_by_id" field.
+func (m *WorkspaceInvitationMutation) ResetAcceptedByID() {
+	m.accepted_by = nil
+	delete(m.clearedFields, workspaceinvitation.FieldAcceptedByID)
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (m *WorkspaceInvitationMutation) SetRespondedAt(t time.Time) {
+	m.responded_at = &t
+}
+
+// RespondedAt returns the value of the "responded_at" field in the mutation.
+func (m *WorkspaceInvitationMutation) RespondedAt() (r time.Time, exists bool) {
+	v := m.responded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRespondedAt returns the old "responded_at" field's value of the WorkspaceInvitation entity.
+// If the WorkspaceInvitation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkspaceMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WorkspaceInvitationMutation) OldRespondedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldRespondedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldRespondedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldRespondedAt: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.RespondedAt, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *WorkspaceMutation) ResetName() {
-	m.name = nil
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (m *WorkspaceInvitationMutation) ClearRespondedAt() {
+	m.responded_at = nil
+	m.clearedFields[workspaceinvitation.FieldRespondedAt] = struct{}{}
+}
+
+// RespondedAtCleared returns if the "responded_at" field was cleared in this mutation.
+func (m *WorkspaceInvitationMutation) RespondedAtCleared() bool {
+	_, ok := m.clearedFields[workspaceinvitation.FieldRespondedAt]
+	return ok
+}
+
+// ResetRespondedAt resets all changes to the "responded_at" field.
+func (m *WorkspaceInvitationMutation) ResetRespondedAt() {
+	m.responded_at = nil
+	delete(m.clearedFields, workspaceinvitation.FieldRespondedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *WorkspaceMutation) SetCreatedAt(t time.Time) {
+func (m *WorkspaceInvitationMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *WorkspaceMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WorkspaceInvitationMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -776,10 +22389,10 @@ func (m *WorkspaceMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Workspace entity.
-// If the Workspace object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WorkspaceInvitation entity.
+// If the WorkspaceInvitation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkspaceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WorkspaceInvitationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -794,109 +22407,100 @@ func (m *WorkspaceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *WorkspaceMutation) ResetCreatedAt() {
+func (m *WorkspaceInvitationMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *WorkspaceMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (m *WorkspaceInvitationMutation) ClearWorkspace() {
+	m.clearedworkspace = true
+	m.clearedFields[workspaceinvitation.FieldWorkspaceID] = struct{}{}
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *WorkspaceMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// WorkspaceCleared reports if the "workspace" edge to the Workspace entity was cleared.
+func (m *WorkspaceInvitationMutation) WorkspaceCleared() bool {
+	return m.clearedworkspace
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Workspace entity.
-// If the Workspace object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WorkspaceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// WorkspaceIDs returns the "workspace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WorkspaceID instead. It exists only for internal usage by the builders.
+func (m *WorkspaceInvitationMutation) WorkspaceIDs() (ids []int) {
+	if id := m.workspace; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *WorkspaceMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetWorkspace resets all changes to the "workspace" edge.
+func (m *WorkspaceInvitationMutation) ResetWorkspace() {
+	m.workspace = nil
+	m.clearedworkspace = false
 }
 
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *WorkspaceMutation) AddUserIDs(ids ...int) {
-	if m.users == nil {
-		m.users = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
-	}
+// ClearInvitedBy clears the "invited_by" edge to the User entity.
+func (m *WorkspaceInvitationMutation) ClearInvitedBy() {
+	m.clearedinvited_by = true
+	m.clearedFields[workspaceinvitation.FieldInvitedByID] = struct{}{}
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *WorkspaceMutation) ClearUsers() {
-	m.clearedusers = true
+// InvitedByCleared reports if the "invited_by" edge to the User entity was cleared.
+func (m *WorkspaceInvitationMutation) InvitedByCleared() bool {
+	return m.InvitedByIDCleared() || m.clearedinvited_by
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *WorkspaceMutation) UsersCleared() bool {
-	return m.clearedusers
+// InvitedByIDs returns the "invited_by" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// InvitedByID instead. It exists only for internal usage by the builders.
+func (m *WorkspaceInvitationMutation) InvitedByIDs() (ids []int) {
+	if id := m.invited_by; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *WorkspaceMutation) RemoveUserIDs(ids ...int) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
-	}
+// ResetInvitedBy resets all changes to the "invited_by" edge.
+func (m *WorkspaceInvitationMutation) ResetInvitedBy() {
+	m.invited_by = nil
+	m.clearedinvited_by = false
 }
 
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *WorkspaceMutation) RemovedUsersIDs() (ids []int) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
-	}
-	return
+// ClearAcceptedBy clears the "accepted_by" edge to the User entity.
+func (m *WorkspaceInvitationMutation) ClearAcceptedBy() {
+	m.clearedaccepted_by = true
+	m.clearedFields[workspaceinvitation.FieldAcceptedByID] = struct{}{}
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *WorkspaceMutation) UsersIDs() (ids []int) {
-	for id := range m.users {
-		ids = append(ids, id)
+// AcceptedByCleared reports if the "accepted_by" edge to the User entity was cleared.
+func (m *WorkspaceInvitationMutation) AcceptedByCleared() bool {
+	return m.AcceptedByIDCleared() || m.clearedaccepted_by
+}
+
+// AcceptedByIDs returns the "accepted_by" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AcceptedByID instead. It exists only for internal usage by the builders.
+func (m *WorkspaceInvitationMutation) AcceptedByIDs() (ids []int) {
+	if id := m.accepted_by; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *WorkspaceMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
+// ResetAcceptedBy resets all changes to the "accepted_by" edge.
+func (m *WorkspaceInvitationMutation) ResetAcceptedBy() {
+	m.accepted_by = nil
+	m.clearedaccepted_by = false
 }
 
-// Where appends a list predicates to the WorkspaceMutation builder.
-func (m *WorkspaceMutation) Where(ps ...predicate.Workspace) {
+// Where appends a list predicates to the WorkspaceInvitationMutation builder.
+func (m *WorkspaceInvitationMutation) Where(ps ...predicate.WorkspaceInvitation) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the WorkspaceMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WorkspaceInvitationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *WorkspaceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Workspace, len(ps))
+func (m *WorkspaceInvitationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WorkspaceInvitation, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -904,33 +22508,54 @@ func (m *WorkspaceMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *WorkspaceMutation) Op() Op {
+func (m *WorkspaceInvitationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *WorkspaceMutation) SetOp(op Op) {
+func (m *WorkspaceInvitationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Workspace).
-func (m *WorkspaceMutation) Type() string {
+// Type returns the node type of this mutation (WorkspaceInvitation).
+func (m *WorkspaceInvitationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *WorkspaceMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.name != nil {
-		fields = append(fields, workspace.FieldName)
+func (m *WorkspaceInvitationMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.workspace != nil {
+		fields = append(fields, workspaceinvitation.FieldWorkspaceID)
 	}
-	if m.created_at != nil {
-		fields = append(fields, workspace.FieldCreatedAt)
+	if m.email != nil {
+		fields = append(fields, workspaceinvitation.FieldEmail)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, workspace.FieldUpdatedAt)
+	if m.role != nil {
+		fields = append(fields, workspaceinvitation.FieldRole)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, workspaceinvitation.FieldTokenHash)
+	}
+	if m.status != nil {
+		fields = append(fields, workspaceinvitation.FieldStatus)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, workspaceinvitation.FieldExpiresAt)
+	}
+	if m.invited_by != nil {
+		fields = append(fields, workspaceinvitation.FieldInvitedByID)
+	}
+	if m.accepted_by != nil {
+		fields = append(fields, workspaceinvitation.FieldAcceptedByID)
+	}
+	if m.responded_at != nil {
+		fields = append(fields, workspaceinvitation.FieldRespondedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, workspaceinvitation.FieldCreatedAt)
 	}
 	return fields
 }
@@ -938,14 +22563,28 @@ func (m *WorkspaceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *WorkspaceMutation) Field(name string) (ent.Value, bool) {
+func (m *WorkspaceInvitationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case workspace.FieldName:
-		return m.Name()
-	case workspace.FieldCreatedAt:
+	case workspaceinvitation.FieldWorkspaceID:
+		return m.WorkspaceID()
+	case workspaceinvitation.FieldEmail:
+		return m.Email()
+	case workspaceinvitation.FieldRole:
+		return m.Role()
+	case workspaceinvitation.FieldTokenHash:
+		return m.TokenHash()
+	case workspaceinvitation.FieldStatus:
+		return m.Status()
+	case workspaceinvitation.FieldExpiresAt:
+		return m.ExpiresAt()
+	case workspaceinvitation.FieldInvitedByID:
+		return m.InvitedByID()
+	case workspaceinvitation.FieldAcceptedByID:
+		return m.AcceptedByID()
+	case workspaceinvitation.FieldRespondedAt:
+		return m.RespondedAt()
+	case workspaceinvitation.FieldCreatedAt:
 		return m.CreatedAt()
-	case workspace.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -953,186 +22592,320 @@ func (m *WorkspaceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *WorkspaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WorkspaceInvitationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case workspace.FieldName:
-		return m.OldName(ctx)
-	case workspace.FieldCreatedAt:
+	case workspaceinvitation.FieldWorkspaceID:
+		return m.OldWorkspaceID(ctx)
+	case workspaceinvitation.FieldEmail:
+		return m.OldEmail(ctx)
+	case workspaceinvitation.FieldRole:
+		return m.OldRole(ctx)
+	case workspaceinvitation.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case workspaceinvitation.FieldStatus:
+		return m.OldStatus(ctx)
+	case workspaceinvitation.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case workspaceinvitation.FieldInvitedByID:
+		return m.OldInvitedByID(ctx)
+	case workspaceinvitation.FieldAcceptedByID:
+		return m.OldAcceptedByID(ctx)
+	case workspaceinvitation.FieldRespondedAt:
+		return m.OldRespondedAt(ctx)
+	case workspaceinvitation.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case workspace.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Workspace field %s", name)
+	return nil, fmt.Errorf("unknown WorkspaceInvitation field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WorkspaceMutation) SetField(name string, value ent.Value) error {
+func (m *WorkspaceInvitationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case workspace.FieldName:
+	case workspaceinvitation.FieldWorkspaceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaceID(v)
+		return nil
+	case workspaceinvitation.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetEmail(v)
 		return nil
-	case workspace.FieldCreatedAt:
+	case workspaceinvitation.FieldRole:
+		v, ok := value.(workspaceinvitation.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case workspaceinvitation.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case workspaceinvitation.FieldStatus:
+		v, ok := value.(workspaceinvitation.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case workspaceinvitation.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetExpiresAt(v)
 		return nil
-	case workspace.FieldUpdatedAt:
+	case workspaceinvitation.FieldInvitedByID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInvitedByID(v)
+		return nil
+	case workspaceinvitation.FieldAcceptedByID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcceptedByID(v)
+		return nil
+	case workspaceinvitation.FieldRespondedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetRespondedAt(v)
+		return nil
+	case workspaceinvitation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Workspace field %s", name)
+	return fmt.Errorf("unknown WorkspaceInvitation field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *WorkspaceMutation) AddedFields() []string {
-	return nil
+func (m *WorkspaceInvitationMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *WorkspaceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WorkspaceInvitationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WorkspaceMutation) AddField(name string, value ent.Value) error {
+func (m *WorkspaceInvitationMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Workspace numeric field %s", name)
+	return fmt.Errorf("unknown WorkspaceInvitation numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *WorkspaceMutation) ClearedFields() []string {
-	return nil
+func (m *WorkspaceInvitationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(workspaceinvitation.FieldInvitedByID) {
+		fields = append(fields, workspaceinvitation.FieldInvitedByID)
+	}
+	if m.FieldCleared(workspaceinvitation.FieldAcceptedByID) {
+		fields = append(fields, workspaceinvitation.FieldAcceptedByID)
+	}
+	if m.FieldCleared(workspaceinvitation.FieldRespondedAt) {
+		fields = append(fields, workspaceinvitation.FieldRespondedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *WorkspaceMutation) FieldCleared(name string) bool {
+func (m *WorkspaceInvitationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *WorkspaceMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Workspace nullable field %s", name)
+func (m *WorkspaceInvitationMutation) ClearField(name string) error {
+	switch name {
+	case workspaceinvitation.FieldInvitedByID:
+		m.ClearInvitedByID()
+		return nil
+	case workspaceinvitation.FieldAcceptedByID:
+		m.ClearAcceptedByID()
+		return nil
+	case workspaceinvitation.FieldRespondedAt:
+		m.ClearRespondedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkspaceInvitation nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *WorkspaceMutation) ResetField(name string) error {
+func (m *WorkspaceInvitationMutation) ResetField(name string) error {
 	switch name {
-	case workspace.FieldName:
-		m.ResetName()
+	case workspaceinvitation.FieldWorkspaceID:
+		m.ResetWorkspaceID()
 		return nil
-	case workspace.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case workspaceinvitation.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case workspace.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case workspaceinvitation.FieldRole:
+		m.ResetRole()
+		return nil
+	case workspaceinvitation.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case workspaceinvitation.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case workspaceinvitation.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case workspaceinvitation.FieldInvitedByID:
+		m.ResetInvitedByID()
+		return nil
+	case workspaceinvitation.FieldAcceptedByID:
+		m.ResetAcceptedByID()
+		return nil
+	case workspaceinvitation.FieldRespondedAt:
+		m.ResetRespondedAt()
+		return nil
+	case workspaceinvitation.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Workspace field %s", name)
+	return fmt.Errorf("unknown WorkspaceInvitation field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *WorkspaceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.users != nil {
-		edges = append(edges, workspace.EdgeUsers)
+func (m *WorkspaceInvitationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.workspace != nil {
+		edges = append(edges, workspaceinvitation.EdgeWorkspace)
+	}
+	if m.invited_by != nil {
+		edges = append(edges, workspaceinvitation.EdgeInvitedBy)
+	}
+	if m.accepted_by != nil {
+		edges = append(edges, workspaceinvitation.EdgeAcceptedBy)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *WorkspaceMutation) AddedIDs(name string) []ent.Value {
+func (m *WorkspaceInvitationMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case workspace.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
-			ids = append(ids, id)
+	case workspaceinvitation.EdgeWorkspace:
+		if id := m.workspace; id != nil {
+			return []ent.Value{*id}
+		}
+	case workspaceinvitation.EdgeInvitedBy:
+		if id := m.invited_by; id != nil {
+			return []ent.Value{*id}
+		}
+	case workspaceinvitation.EdgeAcceptedBy:
+		if id := m.accepted_by; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *WorkspaceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedusers != nil {
-		edges = append(edges, workspace.EdgeUsers)
-	}
+func (m *WorkspaceInvitationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *WorkspaceMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case workspace.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WorkspaceInvitationMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *WorkspaceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedusers {
-		edges = append(edges, workspace.EdgeUsers)
+func (m *WorkspaceInvitationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedworkspace {
+		edges = append(edges, workspaceinvitation.EdgeWorkspace)
+	}
+	if m.clearedinvited_by {
+		edges = append(edges, workspaceinvitation.EdgeInvitedBy)
+	}
+	if m.clearedaccepted_by {
+		edges = append(edges, workspaceinvitation.EdgeAcceptedBy)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *WorkspaceMutation) EdgeCleared(name string) bool {
+func (m *WorkspaceInvitationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case workspace.EdgeUsers:
-		return m.clearedusers
+	case workspaceinvitation.EdgeWorkspace:
+		return m.clearedworkspace
+	case workspaceinvitation.EdgeInvitedBy:
+		return m.clearedinvited_by
+	case workspaceinvitation.EdgeAcceptedBy:
+		return m.clearedaccepted_by
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *WorkspaceMutation) ClearEdge(name string) error {
+func (m *WorkspaceInvitationMutation) ClearEdge(name string) error {
 	switch name {
+	case workspaceinvitation.EdgeWorkspace:
+		m.ClearWorkspace()
+		return nil
+	case workspaceinvitation.EdgeInvitedBy:
+		m.ClearInvitedBy()
+		return nil
+	case workspaceinvitation.EdgeAcceptedBy:
+		m.ClearAcceptedBy()
+		return nil
 	}
-	return fmt.Errorf("unknown Workspace unique edge %s", name)
+	return fmt.Errorf("unknown WorkspaceInvitation unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *WorkspaceMutation) ResetEdge(name string) error {
+func (m *WorkspaceInvitationMutation) ResetEdge(name string) error {
 	switch name {
-	case workspace.EdgeUsers:
-		m.ResetUsers()
+	case workspaceinvitation.EdgeWorkspace:
+		m.ResetWorkspace()
+		return nil
+	case workspaceinvitation.EdgeInvitedBy:
+		m.ResetInvitedBy()
+		return nil
+	case workspaceinvitation.EdgeAcceptedBy:
+		m.ResetAcceptedBy()
 		return nil
 	}
-	return fmt.Errorf("unknown Workspace edge %s", name)
+	return fmt.Errorf("unknown WorkspaceInvitation edge %s", name)
 }
@@ -0,0 +1,271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/ent/payee"
+	"backend/internal/infrastructure/ent/workspace"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Payee is the model entity for the Payee schema.
+type Payee struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Aliases holds the value of the "aliases" field.
+	Aliases []string `json:"aliases,omitempty"`
+	// DefaultCategoryID holds the value of the "default_category_id" field.
+	DefaultCategoryID *int `json:"default_category_id,omitempty"`
+	// LogoURL holds the value of the "logo_url" field.
+	LogoURL string `json:"logo_url,omitempty"`
+	// Website holds the value of the "website" field.
+	Website string `json:"website,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PayeeQuery when eager-loading is set.
+	Edges        PayeeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PayeeEdges holds the relations/edges for other nodes in the graph.
+type PayeeEdges struct {
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// DefaultCategory holds the value of the default_category edge.
+	DefaultCategory *Category `json:"default_category,omitempty"`
+	// Transactions holds the value of the transactions edge.
+	Transactions []*Transaction `json:"transactions,omitempty"`
+	// RecurrenceRules holds the value of the recurrence_rules edge.
+	RecurrenceRules []*RecurrenceRule `json:"recurrence_rules,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PayeeEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// DefaultCategoryOrErr returns the DefaultCategory value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PayeeEdges) DefaultCategoryOrErr() (*Category, error) {
+	if e.DefaultCategory != nil {
+		return e.DefaultCategory, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: category.Label}
+	}
+	return nil, &NotLoadedError{edge: "default_category"}
+}
+
+// TransactionsOrErr returns the Transactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PayeeEdges) TransactionsOrErr() ([]*Transaction, error) {
+	if e.loadedTypes[2] {
+		return e.Transactions, nil
+	}
+	return nil, &NotLoadedError{edge: "transactions"}
+}
+
+// RecurrenceRulesOrErr returns the RecurrenceRules value or an error if the edge
+// was not loaded in eager-loading.
+func (e PayeeEdges) RecurrenceRulesOrErr() ([]*RecurrenceRule, error) {
+	if e.loadedTypes[3] {
+		return e.RecurrenceRules, nil
+	}
+	return nil, &NotLoadedError{edge: "recurrence_rules"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Payee) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case payee.FieldAliases:
+			values[i] = new([]byte)
+		case payee.FieldID, payee.FieldWorkspaceID, payee.FieldDefaultCategoryID:
+			values[i] = new(sql.NullInt64)
+		case payee.FieldName, payee.FieldLogoURL, payee.FieldWebsite:
+			values[i] = new(sql.NullString)
+		case payee.FieldCreatedAt, payee.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Payee fields.
+func (_m *Payee) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case payee.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case payee.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				_m.WorkspaceID = int(value.Int64)
+			}
+		case payee.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case payee.FieldAliases:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field aliases", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Aliases); err != nil {
+					return fmt.Errorf("unmarshal field aliases: %w", err)
+				}
+			}
+		case payee.FieldDefaultCategoryID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field default_category_id", values[i])
+			} else if value.Valid {
+				_m.DefaultCategoryID = new(int)
+				*_m.DefaultCategoryID = int(value.Int64)
+			}
+		case payee.FieldLogoURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field logo_url", values[i])
+			} else if value.Valid {
+				_m.LogoURL = value.String
+			}
+		case payee.FieldWebsite:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field website", values[i])
+			} else if value.Valid {
+				_m.Website = value.String
+			}
+		case payee.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case payee.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Payee.
+// This includes values selected through modifiers, order, etc.
+func (_m *Payee) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWorkspace queries the "workspace" edge of the Payee entity.
+func (_m *Payee) QueryWorkspace() *WorkspaceQuery {
+	return NewPayeeClient(_m.config).QueryWorkspace(_m)
+}
+
+// QueryDefaultCategory queries the "default_category" edge of the Payee entity.
+func (_m *Payee) QueryDefaultCategory() *CategoryQuery {
+	return NewPayeeClient(_m.config).QueryDefaultCategory(_m)
+}
+
+// QueryTransactions queries the "transactions" edge of the Payee entity.
+func (_m *Payee) QueryTransactions() *TransactionQuery {
+	return NewPayeeClient(_m.config).QueryTransactions(_m)
+}
+
+// QueryRecurrenceRules queries the "recurrence_rules" edge of the Payee entity.
+func (_m *Payee) QueryRecurrenceRules() *RecurrenceRuleQuery {
+	return NewPayeeClient(_m.config).QueryRecurrenceRules(_m)
+}
+
+// Update returns a builder for updating this Payee.
+// Note that you need to call Payee.Unwrap() before calling this method if this Payee
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Payee) Update() *PayeeUpdateOne {
+	return NewPayeeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Payee entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Payee) Unwrap() *Payee {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Payee is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Payee) String() string {
+	var builder strings.Builder
+	builder.WriteString("Payee(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("aliases=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Aliases))
+	builder.WriteString(", ")
+	if v := _m.DefaultCategoryID; v != nil {
+		builder.WriteString("default_category_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("logo_url=")
+	builder.WriteString(_m.LogoURL)
+	builder.WriteString(", ")
+	builder.WriteString("website=")
+	builder.WriteString(_m.Website)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Payees is a parsable slice of Payee.
+type Payees []*Payee
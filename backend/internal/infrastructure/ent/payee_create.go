@@ -0,0 +1,436 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/ent/payee"
+	"backend/internal/infrastructure/ent/recurrencerule"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/workspace"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PayeeCreate is the builder for creating a Payee entity.
+type PayeeCreate struct {
+	config
+	mutation *PayeeMutation
+	hooks    []Hook
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *PayeeCreate) SetWorkspaceID(v int) *PayeeCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *PayeeCreate) SetName(v string) *PayeeCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetAliases sets the "aliases" field.
+func (_c *PayeeCreate) SetAliases(v []string) *PayeeCreate {
+	_c.mutation.SetAliases(v)
+	return _c
+}
+
+// SetDefaultCategoryID sets the "default_category_id" field.
+func (_c *PayeeCreate) SetDefaultCategoryID(v int) *PayeeCreate {
+	_c.mutation.SetDefaultCategoryID(v)
+	return _c
+}
+
+// SetNillableDefaultCategoryID sets the "default_category_id" field if the given value is not nil.
+func (_c *PayeeCreate) SetNillableDefaultCategoryID(v *int) *PayeeCreate {
+	if v != nil {
+		_c.SetDefaultCategoryID(*v)
+	}
+	return _c
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (_c *PayeeCreate) SetLogoURL(v string) *PayeeCreate {
+	_c.mutation.SetLogoURL(v)
+	return _c
+}
+
+// SetNillableLogoURL sets the "logo_url" field if the given value is not nil.
+func (_c *PayeeCreate) SetNillableLogoURL(v *string) *PayeeCreate {
+	if v != nil {
+		_c.SetLogoURL(*v)
+	}
+	return _c
+}
+
+// SetWebsite sets the "website" field.
+func (_c *PayeeCreate) SetWebsite(v string) *PayeeCreate {
+	_c.mutation.SetWebsite(v)
+	return _c
+}
+
+// SetNillableWebsite sets the "website" field if the given value is not nil.
+func (_c *PayeeCreate) SetNillableWebsite(v *string) *PayeeCreate {
+	if v != nil {
+		_c.SetWebsite(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PayeeCreate) SetCreatedAt(v time.Time) *PayeeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PayeeCreate) SetNillableCreatedAt(v *time.Time) *PayeeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PayeeCreate) SetUpdatedAt(v time.Time) *PayeeCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PayeeCreate) SetNillableUpdatedAt(v *time.Time) *PayeeCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_c *PayeeCreate) SetWorkspace(v *Workspace) *PayeeCreate {
+	return _c.SetWorkspaceID(v.ID)
+}
+
+// SetDefaultCategory sets the "default_category" edge to the Category entity.
+func (_c *PayeeCreate) SetDefaultCategory(v *Category) *PayeeCreate {
+	return _c.SetDefaultCategoryID(v.ID)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (_c *PayeeCreate) AddTransactionIDs(ids ...int) *PayeeCreate {
+	_c.mutation.AddTransactionIDs(ids...)
+	return _c
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (_c *PayeeCreate) AddTransactions(v ...*Transaction) *PayeeCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTransactionIDs(ids...)
+}
+
+// AddRecurrenceRuleIDs adds the "recurrence_rules" edge to the RecurrenceRule entity by IDs.
+func (_c *PayeeCreate) AddRecurrenceRuleIDs(ids ...int) *PayeeCreate {
+	_c.mutation.AddRecurrenceRuleIDs(ids...)
+	return _c
+}
+
+// AddRecurrenceRules adds the "recurrence_rules" edges to the RecurrenceRule entity.
+func (_c *PayeeCreate) AddRecurrenceRules(v ...*RecurrenceRule) *PayeeCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRecurrenceRuleIDs(ids...)
+}
+
+// Mutation returns the PayeeMutation object of the builder.
+func (_c *PayeeCreate) Mutation() *PayeeMutation {
+	return _c.mutation
+}
+
+// Save creates the Payee in the database.
+func (_c *PayeeCreate) Save(ctx context.Context) (*Payee, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PayeeCreate) SaveX(ctx context.Context) *Payee {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PayeeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PayeeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PayeeCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if payee.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized payee.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := payee.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if payee.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized payee.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := payee.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PayeeCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "Payee.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Payee.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := payee.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Payee.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Payee.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Payee.updated_at"`)}
+	}
+	if len(_c.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "Payee.workspace"`)}
+	}
+	return nil
+}
+
+func (_c *PayeeCreate) sqlSave(ctx context.Context) (*Payee, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PayeeCreate) createSpec() (*Payee, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Payee{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(payee.Table, sqlgraph.NewFieldSpec(payee.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(payee.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Aliases(); ok {
+		_spec.SetField(payee.FieldAliases, field.TypeJSON, value)
+		_node.Aliases = value
+	}
+	if value, ok := _c.mutation.LogoURL(); ok {
+		_spec.SetField(payee.FieldLogoURL, field.TypeString, value)
+		_node.LogoURL = value
+	}
+	if value, ok := _c.mutation.Website(); ok {
+		_spec.SetField(payee.FieldWebsite, field.TypeString, value)
+		_node.Website = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(payee.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(payee.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payee.WorkspaceTable,
+			Columns: []string{payee.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DefaultCategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payee.DefaultCategoryTable,
+			Columns: []string{payee.DefaultCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DefaultCategoryID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payee.TransactionsTable,
+			Columns: []string{payee.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RecurrenceRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payee.RecurrenceRulesTable,
+			Columns: []string{payee.RecurrenceRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PayeeCreateBulk is the builder for creating many Payee entities in bulk.
+type PayeeCreateBulk struct {
+	config
+	err      error
+	builders []*PayeeCreate
+}
+
+// Save creates the Payee entities in the database.
+func (_c *PayeeCreateBulk) Save(ctx context.Context) ([]*Payee, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Payee, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PayeeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PayeeCreateBulk) SaveX(ctx context.Context) []*Payee {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PayeeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PayeeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,916 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/ent/payee"
+	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/recurrencerule"
+	"backend/internal/infrastructure/ent/transaction"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// PayeeUpdate is the builder for updating Payee entities.
+type PayeeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PayeeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PayeeUpdate builder.
+func (_u *PayeeUpdate) Where(ps ...predicate.Payee) *PayeeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PayeeUpdate) SetName(v string) *PayeeUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PayeeUpdate) SetNillableName(v *string) *PayeeUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetAliases sets the "aliases" field.
+func (_u *PayeeUpdate) SetAliases(v []string) *PayeeUpdate {
+	_u.mutation.SetAliases(v)
+	return _u
+}
+
+// AppendAliases appends value to the "aliases" field.
+func (_u *PayeeUpdate) AppendAliases(v []string) *PayeeUpdate {
+	_u.mutation.AppendAliases(v)
+	return _u
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (_u *PayeeUpdate) ClearAliases() *PayeeUpdate {
+	_u.mutation.ClearAliases()
+	return _u
+}
+
+// SetDefaultCategoryID sets the "default_category_id" field.
+func (_u *PayeeUpdate) SetDefaultCategoryID(v int) *PayeeUpdate {
+	_u.mutation.SetDefaultCategoryID(v)
+	return _u
+}
+
+// SetNillableDefaultCategoryID sets the "default_category_id" field if the given value is not nil.
+func (_u *PayeeUpdate) SetNillableDefaultCategoryID(v *int) *PayeeUpdate {
+	if v != nil {
+		_u.SetDefaultCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearDefaultCategoryID clears the value of the "default_category_id" field.
+func (_u *PayeeUpdate) ClearDefaultCategoryID() *PayeeUpdate {
+	_u.mutation.ClearDefaultCategoryID()
+	return _u
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (_u *PayeeUpdate) SetLogoURL(v string) *PayeeUpdate {
+	_u.mutation.SetLogoURL(v)
+	return _u
+}
+
+// SetNillableLogoURL sets the "logo_url" field if the given value is not nil.
+func (_u *PayeeUpdate) SetNillableLogoURL(v *string) *PayeeUpdate {
+	if v != nil {
+		_u.SetLogoURL(*v)
+	}
+	return _u
+}
+
+// ClearLogoURL clears the value of the "logo_url" field.
+func (_u *PayeeUpdate) ClearLogoURL() *PayeeUpdate {
+	_u.mutation.ClearLogoURL()
+	return _u
+}
+
+// SetWebsite sets the "website" field.
+func (_u *PayeeUpdate) SetWebsite(v string) *PayeeUpdate {
+	_u.mutation.SetWebsite(v)
+	return _u
+}
+
+// SetNillableWebsite sets the "website" field if the given value is not nil.
+func (_u *PayeeUpdate) SetNillableWebsite(v *string) *PayeeUpdate {
+	if v != nil {
+		_u.SetWebsite(*v)
+	}
+	return _u
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (_u *PayeeUpdate) ClearWebsite() *PayeeUpdate {
+	_u.mutation.ClearWebsite()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PayeeUpdate) SetUpdatedAt(v time.Time) *PayeeUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDefaultCategory sets the "default_category" edge to the Category entity.
+func (_u *PayeeUpdate) SetDefaultCategory(v *Category) *PayeeUpdate {
+	return _u.SetDefaultCategoryID(v.ID)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (_u *PayeeUpdate) AddTransactionIDs(ids ...int) *PayeeUpdate {
+	_u.mutation.AddTransactionIDs(ids...)
+	return _u
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (_u *PayeeUpdate) AddTransactions(v ...*Transaction) *PayeeUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransactionIDs(ids...)
+}
+
+// AddRecurrenceRuleIDs adds the "recurrence_rules" edge to the RecurrenceRule entity by IDs.
+func (_u *PayeeUpdate) AddRecurrenceRuleIDs(ids ...int) *PayeeUpdate {
+	_u.mutation.AddRecurrenceRuleIDs(ids...)
+	return _u
+}
+
+// AddRecurrenceRules adds the "recurrence_rules" edges to the RecurrenceRule entity.
+func (_u *PayeeUpdate) AddRecurrenceRules(v ...*RecurrenceRule) *PayeeUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecurrenceRuleIDs(ids...)
+}
+
+// Mutation returns the PayeeMutation object of the builder.
+func (_u *PayeeUpdate) Mutation() *PayeeMutation {
+	return _u.mutation
+}
+
+// ClearDefaultCategory clears the "default_category" edge to the Category entity.
+func (_u *PayeeUpdate) ClearDefaultCategory() *PayeeUpdate {
+	_u.mutation.ClearDefaultCategory()
+	return _u
+}
+
+// ClearTransactions clears all "transactions" edges to the Transaction entity.
+func (_u *PayeeUpdate) ClearTransactions() *PayeeUpdate {
+	_u.mutation.ClearTransactions()
+	return _u
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
+func (_u *PayeeUpdate) RemoveTransactionIDs(ids ...int) *PayeeUpdate {
+	_u.mutation.RemoveTransactionIDs(ids...)
+	return _u
+}
+
+// RemoveTransactions removes "transactions" edges to Transaction entities.
+func (_u *PayeeUpdate) RemoveTransactions(v ...*Transaction) *PayeeUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransactionIDs(ids...)
+}
+
+// ClearRecurrenceRules clears all "recurrence_rules" edges to the RecurrenceRule entity.
+func (_u *PayeeUpdate) ClearRecurrenceRules() *PayeeUpdate {
+	_u.mutation.ClearRecurrenceRules()
+	return _u
+}
+
+// RemoveRecurrenceRuleIDs removes the "recurrence_rules" edge to RecurrenceRule entities by IDs.
+func (_u *PayeeUpdate) RemoveRecurrenceRuleIDs(ids ...int) *PayeeUpdate {
+	_u.mutation.RemoveRecurrenceRuleIDs(ids...)
+	return _u
+}
+
+// RemoveRecurrenceRules removes "recurrence_rules" edges to RecurrenceRule entities.
+func (_u *PayeeUpdate) RemoveRecurrenceRules(v ...*RecurrenceRule) *PayeeUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecurrenceRuleIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PayeeUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PayeeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PayeeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PayeeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PayeeUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if payee.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized payee.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := payee.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PayeeUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := payee.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Payee.name": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Payee.workspace"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PayeeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PayeeUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PayeeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payee.Table, payee.Columns, sqlgraph.NewFieldSpec(payee.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(payee.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Aliases(); ok {
+		_spec.SetField(payee.FieldAliases, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAliases(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, payee.FieldAliases, value)
+		})
+	}
+	if _u.mutation.AliasesCleared() {
+		_spec.ClearField(payee.FieldAliases, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LogoURL(); ok {
+		_spec.SetField(payee.FieldLogoURL, field.TypeString, value)
+	}
+	if _u.mutation.LogoURLCleared() {
+		_spec.ClearField(payee.FieldLogoURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.Website(); ok {
+		_spec.SetField(payee.FieldWebsite, field.TypeString, value)
+	}
+	if _u.mutation.WebsiteCleared() {
+		_spec.ClearField(payee.FieldWebsite, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(payee.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DefaultCategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payee.DefaultCategoryTable,
+			Columns: []string{payee.DefaultCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DefaultCategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payee.DefaultCategoryTable,
+			Columns: []string{payee.DefaultCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payee.TransactionsTable,
+			Columns: []string{payee.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !_u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payee.TransactionsTable,
+			Columns: []string{payee.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payee.TransactionsTable,
+			Columns: []string{payee.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecurrenceRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payee.RecurrenceRulesTable,
+			Columns: []string{payee.RecurrenceRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecurrenceRulesIDs(); len(nodes) > 0 && !_u.mutation.RecurrenceRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payee.RecurrenceRulesTable,
+			Columns: []string{payee.RecurrenceRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecurrenceRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payee.RecurrenceRulesTable,
+			Columns: []string{payee.RecurrenceRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payee.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PayeeUpdateOne is the builder for updating a single Payee entity.
+type PayeeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PayeeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *PayeeUpdateOne) SetName(v string) *PayeeUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PayeeUpdateOne) SetNillableName(v *string) *PayeeUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetAliases sets the "aliases" field.
+func (_u *PayeeUpdateOne) SetAliases(v []string) *PayeeUpdateOne {
+	_u.mutation.SetAliases(v)
+	return _u
+}
+
+// AppendAliases appends value to the "aliases" field.
+func (_u *PayeeUpdateOne) AppendAliases(v []string) *PayeeUpdateOne {
+	_u.mutation.AppendAliases(v)
+	return _u
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (_u *PayeeUpdateOne) ClearAliases() *PayeeUpdateOne {
+	_u.mutation.ClearAliases()
+	return _u
+}
+
+// SetDefaultCategoryID sets the "default_category_id" field.
+func (_u *PayeeUpdateOne) SetDefaultCategoryID(v int) *PayeeUpdateOne {
+	_u.mutation.SetDefaultCategoryID(v)
+	return _u
+}
+
+// SetNillableDefaultCategoryID sets the "default_category_id" field if the given value is not nil.
+func (_u *PayeeUpdateOne) SetNillableDefaultCategoryID(v *int) *PayeeUpdateOne {
+	if v != nil {
+		_u.SetDefaultCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearDefaultCategoryID clears the value of the "default_category_id" field.
+func (_u *PayeeUpdateOne) ClearDefaultCategoryID() *PayeeUpdateOne {
+	_u.mutation.ClearDefaultCategoryID()
+	return _u
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (_u *PayeeUpdateOne) SetLogoURL(v string) *PayeeUpdateOne {
+	_u.mutation.SetLogoURL(v)
+	return _u
+}
+
+// SetNillableLogoURL sets the "logo_url" field if the given value is not nil.
+func (_u *PayeeUpdateOne) SetNillableLogoURL(v *string) *PayeeUpdateOne {
+	if v != nil {
+		_u.SetLogoURL(*v)
+	}
+	return _u
+}
+
+// ClearLogoURL clears the value of the "logo_url" field.
+func (_u *PayeeUpdateOne) ClearLogoURL() *PayeeUpdateOne {
+	_u.mutation.ClearLogoURL()
+	return _u
+}
+
+// SetWebsite sets the "website" field.
+func (_u *PayeeUpdateOne) SetWebsite(v string) *PayeeUpdateOne {
+	_u.mutation.SetWebsite(v)
+	return _u
+}
+
+// SetNillableWebsite sets the "website" field if the given value is not nil.
+func (_u *PayeeUpdateOne) SetNillableWebsite(v *string) *PayeeUpdateOne {
+	if v != nil {
+		_u.SetWebsite(*v)
+	}
+	return _u
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (_u *PayeeUpdateOne) ClearWebsite() *PayeeUpdateOne {
+	_u.mutation.ClearWebsite()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PayeeUpdateOne) SetUpdatedAt(v time.Time) *PayeeUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDefaultCategory sets the "default_category" edge to the Category entity.
+func (_u *PayeeUpdateOne) SetDefaultCategory(v *Category) *PayeeUpdateOne {
+	return _u.SetDefaultCategoryID(v.ID)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (_u *PayeeUpdateOne) AddTransactionIDs(ids ...int) *PayeeUpdateOne {
+	_u.mutation.AddTransactionIDs(ids...)
+	return _u
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (_u *PayeeUpdateOne) AddTransactions(v ...*Transaction) *PayeeUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransactionIDs(ids...)
+}
+
+// AddRecurrenceRuleIDs adds the "recurrence_rules" edge to the RecurrenceRule entity by IDs.
+func (_u *PayeeUpdateOne) AddRecurrenceRuleIDs(ids ...int) *PayeeUpdateOne {
+	_u.mutation.AddRecurrenceRuleIDs(ids...)
+	return _u
+}
+
+// AddRecurrenceRules adds the "recurrence_rules" edges to the RecurrenceRule entity.
+func (_u *PayeeUpdateOne) AddRecurrenceRules(v ...*RecurrenceRule) *PayeeUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecurrenceRuleIDs(ids...)
+}
+
+// Mutation returns the PayeeMutation object of the builder.
+func (_u *PayeeUpdateOne) Mutation() *PayeeMutation {
+	return _u.mutation
+}
+
+// ClearDefaultCategory clears the "default_category" edge to the Category entity.
+func (_u *PayeeUpdateOne) ClearDefaultCategory() *PayeeUpdateOne {
+	_u.mutation.ClearDefaultCategory()
+	return _u
+}
+
+// ClearTransactions clears all "transactions" edges to the Transaction entity.
+func (_u *PayeeUpdateOne) ClearTransactions() *PayeeUpdateOne {
+	_u.mutation.ClearTransactions()
+	return _u
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
+func (_u *PayeeUpdateOne) RemoveTransactionIDs(ids ...int) *PayeeUpdateOne {
+	_u.mutation.RemoveTransactionIDs(ids...)
+	return _u
+}
+
+// RemoveTransactions removes "transactions" edges to Transaction entities.
+func (_u *PayeeUpdateOne) RemoveTransactions(v ...*Transaction) *PayeeUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransactionIDs(ids...)
+}
+
+// ClearRecurrenceRules clears all "recurrence_rules" edges to the RecurrenceRule entity.
+func (_u *PayeeUpdateOne) ClearRecurrenceRules() *PayeeUpdateOne {
+	_u.mutation.ClearRecurrenceRules()
+	return _u
+}
+
+// RemoveRecurrenceRuleIDs removes the "recurrence_rules" edge to RecurrenceRule entities by IDs.
+func (_u *PayeeUpdateOne) RemoveRecurrenceRuleIDs(ids ...int) *PayeeUpdateOne {
+	_u.mutation.RemoveRecurrenceRuleIDs(ids...)
+	return _u
+}
+
+// RemoveRecurrenceRules removes "recurrence_rules" edges to RecurrenceRule entities.
+func (_u *PayeeUpdateOne) RemoveRecurrenceRules(v ...*RecurrenceRule) *PayeeUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecurrenceRuleIDs(ids...)
+}
+
+// Where appends a list predicates to the PayeeUpdate builder.
+func (_u *PayeeUpdateOne) Where(ps ...predicate.Payee) *PayeeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PayeeUpdateOne) Select(field string, fields ...string) *PayeeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Payee entity.
+func (_u *PayeeUpdateOne) Save(ctx context.Context) (*Payee, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PayeeUpdateOne) SaveX(ctx context.Context) *Payee {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PayeeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PayeeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PayeeUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if payee.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized payee.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := payee.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PayeeUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := payee.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Payee.name": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Payee.workspace"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PayeeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PayeeUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PayeeUpdateOne) sqlSave(ctx context.Context) (_node *Payee, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payee.Table, payee.Columns, sqlgraph.NewFieldSpec(payee.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Payee.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payee.FieldID)
+		for _, f := range fields {
+			if !payee.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != payee.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(payee.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Aliases(); ok {
+		_spec.SetField(payee.FieldAliases, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAliases(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, payee.FieldAliases, value)
+		})
+	}
+	if _u.mutation.AliasesCleared() {
+		_spec.ClearField(payee.FieldAliases, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LogoURL(); ok {
+		_spec.SetField(payee.FieldLogoURL, field.TypeString, value)
+	}
+	if _u.mutation.LogoURLCleared() {
+		_spec.ClearField(payee.FieldLogoURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.Website(); ok {
+		_spec.SetField(payee.FieldWebsite, field.TypeString, value)
+	}
+	if _u.mutation.WebsiteCleared() {
+		_spec.ClearField(payee.FieldWebsite, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(payee.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DefaultCategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payee.DefaultCategoryTable,
+			Columns: []string{payee.DefaultCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DefaultCategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payee.DefaultCategoryTable,
+			Columns: []string{payee.DefaultCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payee.TransactionsTable,
+			Columns: []string{payee.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !_u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payee.TransactionsTable,
+			Columns: []string{payee.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payee.TransactionsTable,
+			Columns: []string{payee.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecurrenceRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payee.RecurrenceRulesTable,
+			Columns: []string{payee.RecurrenceRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecurrenceRulesIDs(); len(nodes) > 0 && !_u.mutation.RecurrenceRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payee.RecurrenceRulesTable,
+			Columns: []string{payee.RecurrenceRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecurrenceRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payee.RecurrenceRulesTable,
+			Columns: []string{payee.RecurrenceRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Payee{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payee.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/journalentry"
+	"backend/internal/infrastructure/ent/posting"
+	"backend/internal/infrastructure/ent/workspace"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Posting is the model entity for the Posting schema.
+type Posting struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+	// JournalEntryID holds the value of the "journal_entry_id" field.
+	JournalEntryID int `json:"journal_entry_id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID int `json:"account_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount model.Decimal `json:"amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PostingQuery when eager-loading is set.
+	Edges        PostingEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PostingEdges holds the relations/edges for other nodes in the graph.
+type PostingEdges struct {
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// JournalEntry holds the value of the journal_entry edge.
+	JournalEntry *JournalEntry `json:"journal_entry,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostingEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// JournalEntryOrErr returns the JournalEntry value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostingEdges) JournalEntryOrErr() (*JournalEntry, error) {
+	if e.JournalEntry != nil {
+		return e.JournalEntry, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: journalentry.Label}
+	}
+	return nil, &NotLoadedError{edge: "journal_entry"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostingEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Posting) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case posting.FieldAmount:
+			values[i] = new(model.Decimal)
+		case posting.FieldID, posting.FieldWorkspaceID, posting.FieldJournalEntryID, posting.FieldAccountID:
+			values[i] = new(sql.NullInt64)
+		case posting.FieldCurrency:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Posting fields.
+func (_m *Posting) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case posting.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case posting.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				_m.WorkspaceID = int(value.Int64)
+			}
+		case posting.FieldJournalEntryID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field journal_entry_id", values[i])
+			} else if value.Valid {
+				_m.JournalEntryID = int(value.Int64)
+			}
+		case posting.FieldAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = int(value.Int64)
+			}
+		case posting.FieldAmount:
+			if value, ok := values[i].(*model.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case posting.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Posting.
+// This includes values selected through modifiers, order, etc.
+func (_m *Posting) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWorkspace queries the "workspace" edge of the Posting entity.
+func (_m *Posting) QueryWorkspace() *WorkspaceQuery {
+	return NewPostingClient(_m.config).QueryWorkspace(_m)
+}
+
+// QueryJournalEntry queries the "journal_entry" edge of the Posting entity.
+func (_m *Posting) QueryJournalEntry() *JournalEntryQuery {
+	return NewPostingClient(_m.config).QueryJournalEntry(_m)
+}
+
+// QueryAccount queries the "account" edge of the Posting entity.
+func (_m *Posting) QueryAccount() *AccountQuery {
+	return NewPostingClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this Posting.
+// Note that you need to call Posting.Unwrap() before calling this method if this Posting
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Posting) Update() *PostingUpdateOne {
+	return NewPostingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Posting entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Posting) Unwrap() *Posting {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Posting is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Posting) String() string {
+	var builder strings.Builder
+	builder.WriteString("Posting(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("journal_entry_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JournalEntryID))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Postings is a parsable slice of Posting.
+type Postings []*Posting
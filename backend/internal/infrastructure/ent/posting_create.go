@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/journalentry"
+	"backend/internal/infrastructure/ent/posting"
+	"backend/internal/infrastructure/ent/workspace"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PostingCreate is the builder for creating a Posting entity.
+type PostingCreate struct {
+	config
+	mutation *PostingMutation
+	hooks    []Hook
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *PostingCreate) SetWorkspaceID(v int) *PostingCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetJournalEntryID sets the "journal_entry_id" field.
+func (_c *PostingCreate) SetJournalEntryID(v int) *PostingCreate {
+	_c.mutation.SetJournalEntryID(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *PostingCreate) SetAccountID(v int) *PostingCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *PostingCreate) SetAmount(v model.Decimal) *PostingCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *PostingCreate) SetCurrency(v string) *PostingCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_c *PostingCreate) SetWorkspace(v *Workspace) *PostingCreate {
+	return _c.SetWorkspaceID(v.ID)
+}
+
+// SetJournalEntry sets the "journal_entry" edge to the JournalEntry entity.
+func (_c *PostingCreate) SetJournalEntry(v *JournalEntry) *PostingCreate {
+	return _c.SetJournalEntryID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *PostingCreate) SetAccount(v *Account) *PostingCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the PostingMutation object of the builder.
+func (_c *PostingCreate) Mutation() *PostingMutation {
+	return _c.mutation
+}
+
+// Save creates the Posting in the database.
+func (_c *PostingCreate) Save(ctx context.Context) (*Posting, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PostingCreate) SaveX(ctx context.Context) *Posting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PostingCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "Posting.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.JournalEntryID(); !ok {
+		return &ValidationError{Name: "journal_entry_id", err: errors.New(`ent: missing required field "Posting.journal_entry_id"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "Posting.account_id"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Posting.amount"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Posting.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := posting.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Posting.currency": %w`, err)}
+		}
+	}
+	if len(_c.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "Posting.workspace"`)}
+	}
+	if len(_c.mutation.JournalEntryIDs()) == 0 {
+		return &ValidationError{Name: "journal_entry", err: errors.New(`ent: missing required edge "Posting.journal_entry"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "Posting.account"`)}
+	}
+	return nil
+}
+
+func (_c *PostingCreate) sqlSave(ctx context.Context) (*Posting, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PostingCreate) createSpec() (*Posting, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Posting{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(posting.Table, sqlgraph.NewFieldSpec(posting.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(posting.FieldAmount, field.TypeOther, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(posting.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if nodes := _c.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posting.WorkspaceTable,
+			Columns: []string{posting.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.JournalEntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posting.JournalEntryTable,
+			Columns: []string{posting.JournalEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.JournalEntryID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posting.AccountTable,
+			Columns: []string{posting.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PostingCreateBulk is the builder for creating many Posting entities in bulk.
+type PostingCreateBulk struct {
+	config
+	err      error
+	builders []*PostingCreate
+}
+
+// Save creates the Posting entities in the database.
+func (_c *PostingCreateBulk) Save(ctx context.Context) ([]*Posting, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Posting, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PostingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PostingCreateBulk) SaveX(ctx context.Context) []*Posting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -6,8 +6,68 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Account is the predicate function for account builders.
+type Account func(*sql.Selector)
+
+// Category is the predicate function for category builders.
+type Category func(*sql.Selector)
+
+// EmailVerificationToken is the predicate function for emailverificationtoken builders.
+type EmailVerificationToken func(*sql.Selector)
+
+// ImportDecision is the predicate function for importdecision builders.
+type ImportDecision func(*sql.Selector)
+
+// ImportProfile is the predicate function for importprofile builders.
+type ImportProfile func(*sql.Selector)
+
+// JournalEntry is the predicate function for journalentry builders.
+type JournalEntry func(*sql.Selector)
+
+// Membership is the predicate function for membership builders.
+type Membership func(*sql.Selector)
+
+// PasswordResetToken is the predicate function for passwordresettoken builders.
+type PasswordResetToken func(*sql.Selector)
+
+// Payee is the predicate function for payee builders.
+type Payee func(*sql.Selector)
+
+// Posting is the predicate function for posting builders.
+type Posting func(*sql.Selector)
+
+// RecoveryCode is the predicate function for recoverycode builders.
+type RecoveryCode func(*sql.Selector)
+
+// RecurrenceOccurrence is the predicate function for recurrenceoccurrence builders.
+type RecurrenceOccurrence func(*sql.Selector)
+
+// RecurrenceRule is the predicate function for recurrencerule builders.
+type RecurrenceRule func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
+// StatementImport is the predicate function for statementimport builders.
+type StatementImport func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
+// Transaction is the predicate function for transaction builders.
+type Transaction func(*sql.Selector)
+
+// TransactionSplit is the predicate function for transactionsplit builders.
+type TransactionSplit func(*sql.Selector)
+
+// Transfer is the predicate function for transfer builders.
+type Transfer func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
 // Workspace is the predicate function for workspace builders.
 type Workspace func(*sql.Selector)
+
+// WorkspaceInvitation is the predicate function for workspaceinvitation builders.
+type WorkspaceInvitation func(*sql.Selector)
@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/recoverycode"
+	"backend/internal/infrastructure/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RecoveryCodeCreate is the builder for creating a RecoveryCode entity.
+type RecoveryCodeCreate struct {
+	config
+	mutation *RecoveryCodeMutation
+	hooks    []Hook
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_c *RecoveryCodeCreate) SetCodeHash(v string) *RecoveryCodeCreate {
+	_c.mutation.SetCodeHash(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *RecoveryCodeCreate) SetUserID(v int) *RecoveryCodeCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_c *RecoveryCodeCreate) SetUsedAt(v time.Time) *RecoveryCodeCreate {
+	_c.mutation.SetUsedAt(v)
+	return _c
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_c *RecoveryCodeCreate) SetNillableUsedAt(v *time.Time) *RecoveryCodeCreate {
+	if v != nil {
+		_c.SetUsedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RecoveryCodeCreate) SetCreatedAt(v time.Time) *RecoveryCodeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RecoveryCodeCreate) SetNillableCreatedAt(v *time.Time) *RecoveryCodeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *RecoveryCodeCreate) SetUser(v *User) *RecoveryCodeCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the RecoveryCodeMutation object of the builder.
+func (_c *RecoveryCodeCreate) Mutation() *RecoveryCodeMutation {
+	return _c.mutation
+}
+
+// Save creates the RecoveryCode in the database.
+func (_c *RecoveryCodeCreate) Save(ctx context.Context) (*RecoveryCode, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RecoveryCodeCreate) SaveX(ctx context.Context) *RecoveryCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecoveryCodeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecoveryCodeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RecoveryCodeCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := recoverycode.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RecoveryCodeCreate) check() error {
+	if _, ok := _c.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`ent: missing required field "RecoveryCode.code_hash"`)}
+	}
+	if v, ok := _c.mutation.CodeHash(); ok {
+		if err := recoverycode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "RecoveryCode.code_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RecoveryCode.user_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RecoveryCode.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "RecoveryCode.user"`)}
+	}
+	return nil
+}
+
+func (_c *RecoveryCodeCreate) sqlSave(ctx context.Context) (*RecoveryCode, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RecoveryCodeCreate) createSpec() (*RecoveryCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RecoveryCode{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(recoverycode.Table, sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CodeHash(); ok {
+		_spec.SetField(recoverycode.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := _c.mutation.UsedAt(); ok {
+		_spec.SetField(recoverycode.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(recoverycode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recoverycode.UserTable,
+			Columns: []string{recoverycode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RecoveryCodeCreateBulk is the builder for creating many RecoveryCode entities in bulk.
+type RecoveryCodeCreateBulk struct {
+	config
+	err      error
+	builders []*RecoveryCodeCreate
+}
+
+// Save creates the RecoveryCode entities in the database.
+func (_c *RecoveryCodeCreateBulk) Save(ctx context.Context) ([]*RecoveryCode, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RecoveryCode, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RecoveryCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RecoveryCodeCreateBulk) SaveX(ctx context.Context) []*RecoveryCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecoveryCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecoveryCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/recurrenceoccurrence"
+	"backend/internal/infrastructure/ent/recurrencerule"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/workspace"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// RecurrenceOccurrence is the model entity for the RecurrenceOccurrence schema.
+type RecurrenceOccurrence struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+	// RuleID holds the value of the "rule_id" field.
+	RuleID int `json:"rule_id,omitempty"`
+	// DueOn holds the value of the "due_on" field.
+	DueOn time.Time `json:"due_on,omitempty"`
+	// Status holds the value of the "status" field.
+	Status recurrenceoccurrence.Status `json:"status,omitempty"`
+	// TransactionID holds the value of the "transaction_id" field.
+	TransactionID *int `json:"transaction_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RecurrenceOccurrenceQuery when eager-loading is set.
+	Edges        RecurrenceOccurrenceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RecurrenceOccurrenceEdges holds the relations/edges for other nodes in the graph.
+type RecurrenceOccurrenceEdges struct {
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// Rule holds the value of the rule edge.
+	Rule *RecurrenceRule `json:"rule,omitempty"`
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RecurrenceOccurrenceEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// RuleOrErr returns the Rule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RecurrenceOccurrenceEdges) RuleOrErr() (*RecurrenceRule, error) {
+	if e.Rule != nil {
+		return e.Rule, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: recurrencerule.Label}
+	}
+	return nil, &NotLoadedError{edge: "rule"}
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RecurrenceOccurrenceEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RecurrenceOccurrence) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case recurrenceoccurrence.FieldID, recurrenceoccurrence.FieldWorkspaceID, recurrenceoccurrence.FieldRuleID, recurrenceoccurrence.FieldTransactionID:
+			values[i] = new(sql.NullInt64)
+		case recurrenceoccurrence.FieldStatus:
+			values[i] = new(sql.NullString)
+		case recurrenceoccurrence.FieldDueOn, recurrenceoccurrence.FieldCreatedAt, recurrenceoccurrence.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RecurrenceOccurrence fields.
+func (_m *RecurrenceOccurrence) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case recurrenceoccurrence.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case recurrenceoccurrence.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				_m.WorkspaceID = int(value.Int64)
+			}
+		case recurrenceoccurrence.FieldRuleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_id", values[i])
+			} else if value.Valid {
+				_m.RuleID = int(value.Int64)
+			}
+		case recurrenceoccurrence.FieldDueOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_on", values[i])
+			} else if value.Valid {
+				_m.DueOn = value.Time
+			}
+		case recurrenceoccurrence.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = recurrenceoccurrence.Status(value.String)
+			}
+		case recurrenceoccurrence.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				_m.TransactionID = new(int)
+				*_m.TransactionID = int(value.Int64)
+			}
+		case recurrenceoccurrence.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case recurrenceoccurrence.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RecurrenceOccurrence.
+// This includes values selected through modifiers, order, etc.
+func (_m *RecurrenceOccurrence) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWorkspace queries the "workspace" edge of the RecurrenceOccurrence entity.
+func (_m *RecurrenceOccurrence) QueryWorkspace() *WorkspaceQuery {
+	return NewRecurrenceOccurrenceClient(_m.config).QueryWorkspace(_m)
+}
+
+// QueryRule queries the "rule" edge of the RecurrenceOccurrence entity.
+func (_m *RecurrenceOccurrence) QueryRule() *RecurrenceRuleQuery {
+	return NewRecurrenceOccurrenceClient(_m.config).QueryRule(_m)
+}
+
+// QueryTransaction queries the "transaction" edge of the RecurrenceOccurrence entity.
+func (_m *RecurrenceOccurrence) QueryTransaction() *TransactionQuery {
+	return NewRecurrenceOccurrenceClient(_m.config).QueryTransaction(_m)
+}
+
+// Update returns a builder for updating this RecurrenceOccurrence.
+// Note that you need to call RecurrenceOccurrence.Unwrap() before calling this method if this RecurrenceOccurrence
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RecurrenceOccurrence) Update() *RecurrenceOccurrenceUpdateOne {
+	return NewRecurrenceOccurrenceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RecurrenceOccurrence entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RecurrenceOccurrence) Unwrap() *RecurrenceOccurrence {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RecurrenceOccurrence is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RecurrenceOccurrence) String() string {
+	var builder strings.Builder
+	builder.WriteString("RecurrenceOccurrence(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("rule_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RuleID))
+	builder.WriteString(", ")
+	builder.WriteString("due_on=")
+	builder.WriteString(_m.DueOn.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.TransactionID; v != nil {
+		builder.WriteString("transaction_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RecurrenceOccurrences is a parsable slice of RecurrenceOccurrence.
+type RecurrenceOccurrences []*RecurrenceOccurrence
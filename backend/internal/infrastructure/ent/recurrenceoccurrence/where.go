@@ -0,0 +1,380 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recurrenceoccurrence
+
+import (
+	"backend/internal/infrastructure/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldLTE(FieldID, id))
+}
+
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// RuleID applies equality check predicate on the "rule_id" field. It's identical to RuleIDEQ.
+func RuleID(v int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldEQ(FieldRuleID, v))
+}
+
+// DueOn applies equality check predicate on the "due_on" field. It's identical to DueOnEQ.
+func DueOn(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldEQ(FieldDueOn, v))
+}
+
+// TransactionID applies equality check predicate on the "transaction_id" field. It's identical to TransactionIDEQ.
+func TransactionID(v int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// RuleIDEQ applies the EQ predicate on the "rule_id" field.
+func RuleIDEQ(v int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldEQ(FieldRuleID, v))
+}
+
+// RuleIDNEQ applies the NEQ predicate on the "rule_id" field.
+func RuleIDNEQ(v int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldNEQ(FieldRuleID, v))
+}
+
+// RuleIDIn applies the In predicate on the "rule_id" field.
+func RuleIDIn(vs ...int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldIn(FieldRuleID, vs...))
+}
+
+// RuleIDNotIn applies the NotIn predicate on the "rule_id" field.
+func RuleIDNotIn(vs ...int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldNotIn(FieldRuleID, vs...))
+}
+
+// DueOnEQ applies the EQ predicate on the "due_on" field.
+func DueOnEQ(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldEQ(FieldDueOn, v))
+}
+
+// DueOnNEQ applies the NEQ predicate on the "due_on" field.
+func DueOnNEQ(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldNEQ(FieldDueOn, v))
+}
+
+// DueOnIn applies the In predicate on the "due_on" field.
+func DueOnIn(vs ...time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldIn(FieldDueOn, vs...))
+}
+
+// DueOnNotIn applies the NotIn predicate on the "due_on" field.
+func DueOnNotIn(vs ...time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldNotIn(FieldDueOn, vs...))
+}
+
+// DueOnGT applies the GT predicate on the "due_on" field.
+func DueOnGT(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldGT(FieldDueOn, v))
+}
+
+// DueOnGTE applies the GTE predicate on the "due_on" field.
+func DueOnGTE(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldGTE(FieldDueOn, v))
+}
+
+// DueOnLT applies the LT predicate on the "due_on" field.
+func DueOnLT(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldLT(FieldDueOn, v))
+}
+
+// DueOnLTE applies the LTE predicate on the "due_on" field.
+func DueOnLTE(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldLTE(FieldDueOn, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// TransactionIDEQ applies the EQ predicate on the "transaction_id" field.
+func TransactionIDEQ(v int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// TransactionIDNEQ applies the NEQ predicate on the "transaction_id" field.
+func TransactionIDNEQ(v int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldNEQ(FieldTransactionID, v))
+}
+
+// TransactionIDIn applies the In predicate on the "transaction_id" field.
+func TransactionIDIn(vs ...int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDNotIn applies the NotIn predicate on the "transaction_id" field.
+func TransactionIDNotIn(vs ...int) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldNotIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDIsNil applies the IsNil predicate on the "transaction_id" field.
+func TransactionIDIsNil() predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldIsNull(FieldTransactionID))
+}
+
+// TransactionIDNotNil applies the NotNil predicate on the "transaction_id" field.
+func TransactionIDNotNil() predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldNotNull(FieldTransactionID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasWorkspace applies the HasEdge predicate on the "workspace" edge.
+func HasWorkspace() predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WorkspaceTable, WorkspaceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkspaceWith applies the HasEdge predicate on the "workspace" edge with a given conditions (other predicates).
+func HasWorkspaceWith(preds ...predicate.Workspace) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(func(s *sql.Selector) {
+		step := newWorkspaceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRule applies the HasEdge predicate on the "rule" edge.
+func HasRule() predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RuleTable, RuleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRuleWith applies the HasEdge predicate on the "rule" edge with a given conditions (other predicates).
+func HasRuleWith(preds ...predicate.RecurrenceRule) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(func(s *sql.Selector) {
+		step := newRuleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransaction applies the HasEdge predicate on the "transaction" edge.
+func HasTransaction() predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, TransactionTable, TransactionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionWith applies the HasEdge predicate on the "transaction" edge with a given conditions (other predicates).
+func HasTransactionWith(preds ...predicate.Transaction) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(func(s *sql.Selector) {
+		step := newTransactionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RecurrenceOccurrence) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RecurrenceOccurrence) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RecurrenceOccurrence) predicate.RecurrenceOccurrence {
+	return predicate.RecurrenceOccurrence(sql.NotPredicates(p))
+}
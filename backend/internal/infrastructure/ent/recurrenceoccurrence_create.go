@@ -0,0 +1,386 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/recurrenceoccurrence"
+	"backend/internal/infrastructure/ent/recurrencerule"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/workspace"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RecurrenceOccurrenceCreate is the builder for creating a RecurrenceOccurrence entity.
+type RecurrenceOccurrenceCreate struct {
+	config
+	mutation *RecurrenceOccurrenceMutation
+	hooks    []Hook
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *RecurrenceOccurrenceCreate) SetWorkspaceID(v int) *RecurrenceOccurrenceCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetRuleID sets the "rule_id" field.
+func (_c *RecurrenceOccurrenceCreate) SetRuleID(v int) *RecurrenceOccurrenceCreate {
+	_c.mutation.SetRuleID(v)
+	return _c
+}
+
+// SetDueOn sets the "due_on" field.
+func (_c *RecurrenceOccurrenceCreate) SetDueOn(v time.Time) *RecurrenceOccurrenceCreate {
+	_c.mutation.SetDueOn(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *RecurrenceOccurrenceCreate) SetStatus(v recurrenceoccurrence.Status) *RecurrenceOccurrenceCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *RecurrenceOccurrenceCreate) SetNillableStatus(v *recurrenceoccurrence.Status) *RecurrenceOccurrenceCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_c *RecurrenceOccurrenceCreate) SetTransactionID(v int) *RecurrenceOccurrenceCreate {
+	_c.mutation.SetTransactionID(v)
+	return _c
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_c *RecurrenceOccurrenceCreate) SetNillableTransactionID(v *int) *RecurrenceOccurrenceCreate {
+	if v != nil {
+		_c.SetTransactionID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RecurrenceOccurrenceCreate) SetCreatedAt(v time.Time) *RecurrenceOccurrenceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RecurrenceOccurrenceCreate) SetNillableCreatedAt(v *time.Time) *RecurrenceOccurrenceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RecurrenceOccurrenceCreate) SetUpdatedAt(v time.Time) *RecurrenceOccurrenceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RecurrenceOccurrenceCreate) SetNillableUpdatedAt(v *time.Time) *RecurrenceOccurrenceCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_c *RecurrenceOccurrenceCreate) SetWorkspace(v *Workspace) *RecurrenceOccurrenceCreate {
+	return _c.SetWorkspaceID(v.ID)
+}
+
+// SetRule sets the "rule" edge to the RecurrenceRule entity.
+func (_c *RecurrenceOccurrenceCreate) SetRule(v *RecurrenceRule) *RecurrenceOccurrenceCreate {
+	return _c.SetRuleID(v.ID)
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_c *RecurrenceOccurrenceCreate) SetTransaction(v *Transaction) *RecurrenceOccurrenceCreate {
+	return _c.SetTransactionID(v.ID)
+}
+
+// Mutation returns the RecurrenceOccurrenceMutation object of the builder.
+func (_c *RecurrenceOccurrenceCreate) Mutation() *RecurrenceOccurrenceMutation {
+	return _c.mutation
+}
+
+// Save creates the RecurrenceOccurrence in the database.
+func (_c *RecurrenceOccurrenceCreate) Save(ctx context.Context) (*RecurrenceOccurrence, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RecurrenceOccurrenceCreate) SaveX(ctx context.Context) *RecurrenceOccurrence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecurrenceOccurrenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecurrenceOccurrenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RecurrenceOccurrenceCreate) defaults() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := recurrenceoccurrence.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if recurrenceoccurrence.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized recurrenceoccurrence.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := recurrenceoccurrence.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if recurrenceoccurrence.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized recurrenceoccurrence.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := recurrenceoccurrence.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RecurrenceOccurrenceCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "RecurrenceOccurrence.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.RuleID(); !ok {
+		return &ValidationError{Name: "rule_id", err: errors.New(`ent: missing required field "RecurrenceOccurrence.rule_id"`)}
+	}
+	if _, ok := _c.mutation.DueOn(); !ok {
+		return &ValidationError{Name: "due_on", err: errors.New(`ent: missing required field "RecurrenceOccurrence.due_on"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "RecurrenceOccurrence.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := recurrenceoccurrence.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RecurrenceOccurrence.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RecurrenceOccurrence.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RecurrenceOccurrence.updated_at"`)}
+	}
+	if len(_c.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "RecurrenceOccurrence.workspace"`)}
+	}
+	if len(_c.mutation.RuleIDs()) == 0 {
+		return &ValidationError{Name: "rule", err: errors.New(`ent: missing required edge "RecurrenceOccurrence.rule"`)}
+	}
+	return nil
+}
+
+func (_c *RecurrenceOccurrenceCreate) sqlSave(ctx context.Context) (*RecurrenceOccurrence, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RecurrenceOccurrenceCreate) createSpec() (*RecurrenceOccurrence, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RecurrenceOccurrence{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(recurrenceoccurrence.Table, sqlgraph.NewFieldSpec(recurrenceoccurrence.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.DueOn(); ok {
+		_spec.SetField(recurrenceoccurrence.FieldDueOn, field.TypeTime, value)
+		_node.DueOn = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(recurrenceoccurrence.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(recurrenceoccurrence.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(recurrenceoccurrence.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrenceoccurrence.WorkspaceTable,
+			Columns: []string{recurrenceoccurrence.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrenceoccurrence.RuleTable,
+			Columns: []string{recurrenceoccurrence.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RuleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   recurrenceoccurrence.TransactionTable,
+			Columns: []string{recurrenceoccurrence.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TransactionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RecurrenceOccurrenceCreateBulk is the builder for creating many RecurrenceOccurrence entities in bulk.
+type RecurrenceOccurrenceCreateBulk struct {
+	config
+	err      error
+	builders []*RecurrenceOccurrenceCreate
+}
+
+// Save creates the RecurrenceOccurrence entities in the database.
+func (_c *RecurrenceOccurrenceCreateBulk) Save(ctx context.Context) ([]*RecurrenceOccurrence, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RecurrenceOccurrence, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RecurrenceOccurrenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RecurrenceOccurrenceCreateBulk) SaveX(ctx context.Context) []*RecurrenceOccurrence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecurrenceOccurrenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecurrenceOccurrenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/recurrenceoccurrence"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RecurrenceOccurrenceDelete is the builder for deleting a RecurrenceOccurrence entity.
+type RecurrenceOccurrenceDelete struct {
+	config
+	hooks    []Hook
+	mutation *RecurrenceOccurrenceMutation
+}
+
+// Where appends a list predicates to the RecurrenceOccurrenceDelete builder.
+func (_d *RecurrenceOccurrenceDelete) Where(ps ...predicate.RecurrenceOccurrence) *RecurrenceOccurrenceDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *RecurrenceOccurrenceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RecurrenceOccurrenceDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *RecurrenceOccurrenceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(recurrenceoccurrence.Table, sqlgraph.NewFieldSpec(recurrenceoccurrence.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// RecurrenceOccurrenceDeleteOne is the builder for deleting a single RecurrenceOccurrence entity.
+type RecurrenceOccurrenceDeleteOne struct {
+	_d *RecurrenceOccurrenceDelete
+}
+
+// Where appends a list predicates to the RecurrenceOccurrenceDelete builder.
+func (_d *RecurrenceOccurrenceDeleteOne) Where(ps ...predicate.RecurrenceOccurrence) *RecurrenceOccurrenceDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *RecurrenceOccurrenceDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{recurrenceoccurrence.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RecurrenceOccurrenceDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
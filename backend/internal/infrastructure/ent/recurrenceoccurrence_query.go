@@ -0,0 +1,789 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/recurrenceoccurrence"
+	"backend/internal/infrastructure/ent/recurrencerule"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/workspace"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RecurrenceOccurrenceQuery is the builder for querying RecurrenceOccurrence entities.
+type RecurrenceOccurrenceQuery struct {
+	config
+	ctx             *QueryContext
+	order           []recurrenceoccurrence.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.RecurrenceOccurrence
+	withWorkspace   *WorkspaceQuery
+	withRule        *RecurrenceRuleQuery
+	withTransaction *TransactionQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RecurrenceOccurrenceQuery builder.
+func (_q *RecurrenceOccurrenceQuery) Where(ps ...predicate.RecurrenceOccurrence) *RecurrenceOccurrenceQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *RecurrenceOccurrenceQuery) Limit(limit int) *RecurrenceOccurrenceQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *RecurrenceOccurrenceQuery) Offset(offset int) *RecurrenceOccurrenceQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *RecurrenceOccurrenceQuery) Unique(unique bool) *RecurrenceOccurrenceQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *RecurrenceOccurrenceQuery) Order(o ...recurrenceoccurrence.OrderOption) *RecurrenceOccurrenceQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryWorkspace chains the current query on the "workspace" edge.
+func (_q *RecurrenceOccurrenceQuery) QueryWorkspace() *WorkspaceQuery {
+	query := (&WorkspaceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recurrenceoccurrence.Table, recurrenceoccurrence.FieldID, selector),
+			sqlgraph.To(workspace.Table, workspace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recurrenceoccurrence.WorkspaceTable, recurrenceoccurrence.WorkspaceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRule chains the current query on the "rule" edge.
+func (_q *RecurrenceOccurrenceQuery) QueryRule() *RecurrenceRuleQuery {
+	query := (&RecurrenceRuleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recurrenceoccurrence.Table, recurrenceoccurrence.FieldID, selector),
+			sqlgraph.To(recurrencerule.Table, recurrencerule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recurrenceoccurrence.RuleTable, recurrenceoccurrence.RuleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransaction chains the current query on the "transaction" edge.
+func (_q *RecurrenceOccurrenceQuery) QueryTransaction() *TransactionQuery {
+	query := (&TransactionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recurrenceoccurrence.Table, recurrenceoccurrence.FieldID, selector),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, recurrenceoccurrence.TransactionTable, recurrenceoccurrence.TransactionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RecurrenceOccurrence entity from the query.
+// Returns a *NotFoundError when no RecurrenceOccurrence was found.
+func (_q *RecurrenceOccurrenceQuery) First(ctx context.Context) (*RecurrenceOccurrence, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{recurrenceoccurrence.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *RecurrenceOccurrenceQuery) FirstX(ctx context.Context) *RecurrenceOccurrence {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RecurrenceOccurrence ID from the query.
+// Returns a *NotFoundError when no RecurrenceOccurrence ID was found.
+func (_q *RecurrenceOccurrenceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{recurrenceoccurrence.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *RecurrenceOccurrenceQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RecurrenceOccurrence entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RecurrenceOccurrence entity is found.
+// Returns a *NotFoundError when no RecurrenceOccurrence entities are found.
+func (_q *RecurrenceOccurrenceQuery) Only(ctx context.Context) (*RecurrenceOccurrence, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{recurrenceoccurrence.Label}
+	default:
+		return nil, &NotSingularError{recurrenceoccurrence.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *RecurrenceOccurrenceQuery) OnlyX(ctx context.Context) *RecurrenceOccurrence {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RecurrenceOccurrence ID in the query.
+// Returns a *NotSingularError when more than one RecurrenceOccurrence ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *RecurrenceOccurrenceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{recurrenceoccurrence.Label}
+	default:
+		err = &NotSingularError{recurrenceoccurrence.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *RecurrenceOccurrenceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RecurrenceOccurrences.
+func (_q *RecurrenceOccurrenceQuery) All(ctx context.Context) ([]*RecurrenceOccurrence, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RecurrenceOccurrence, *RecurrenceOccurrenceQuery]()
+	return withInterceptors[[]*RecurrenceOccurrence](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *RecurrenceOccurrenceQuery) AllX(ctx context.Context) []*RecurrenceOccurrence {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RecurrenceOccurrence IDs.
+func (_q *RecurrenceOccurrenceQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(recurrenceoccurrence.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *RecurrenceOccurrenceQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *RecurrenceOccurrenceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*RecurrenceOccurrenceQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *RecurrenceOccurrenceQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *RecurrenceOccurrenceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *RecurrenceOccurrenceQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RecurrenceOccurrenceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *RecurrenceOccurrenceQuery) Clone() *RecurrenceOccurrenceQuery {
+	if _q == nil {
+		return nil
+	}
+	return &RecurrenceOccurrenceQuery{
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]recurrenceoccurrence.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.RecurrenceOccurrence{}, _q.predicates...),
+		withWorkspace:   _q.withWorkspace.Clone(),
+		withRule:        _q.withRule.Clone(),
+		withTransaction: _q.withTransaction.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithWorkspace tells the query-builder to eager-load the nodes that are connected to
+// the "workspace" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RecurrenceOccurrenceQuery) WithWorkspace(opts ...func(*WorkspaceQuery)) *RecurrenceOccurrenceQuery {
+	query := (&WorkspaceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWorkspace = query
+	return _q
+}
+
+// WithRule tells the query-builder to eager-load the nodes that are connected to
+// the "rule" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RecurrenceOccurrenceQuery) WithRule(opts ...func(*RecurrenceRuleQuery)) *RecurrenceOccurrenceQuery {
+	query := (&RecurrenceRuleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRule = query
+	return _q
+}
+
+// WithTransaction tells the query-builder to eager-load the nodes that are connected to
+// the "transaction" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RecurrenceOccurrenceQuery) WithTransaction(opts ...func(*TransactionQuery)) *RecurrenceOccurrenceQuery {
+	query := (&TransactionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTransaction = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		WorkspaceID int `json:"workspace_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RecurrenceOccurrence.Query().
+//		GroupBy(recurrenceoccurrence.FieldWorkspaceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *RecurrenceOccurrenceQuery) GroupBy(field string, fields ...string) *RecurrenceOccurrenceGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RecurrenceOccurrenceGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = recurrenceoccurrence.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		WorkspaceID int `json:"workspace_id,omitempty"`
+//	}
+//
+//	client.RecurrenceOccurrence.Query().
+//		Select(recurrenceoccurrence.FieldWorkspaceID).
+//		Scan(ctx, &v)
+func (_q *RecurrenceOccurrenceQuery) Select(fields ...string) *RecurrenceOccurrenceSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &RecurrenceOccurrenceSelect{RecurrenceOccurrenceQuery: _q}
+	sbuild.label = recurrenceoccurrence.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RecurrenceOccurrenceSelect configured with the given aggregations.
+func (_q *RecurrenceOccurrenceQuery) Aggregate(fns ...AggregateFunc) *RecurrenceOccurrenceSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *RecurrenceOccurrenceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !recurrenceoccurrence.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	if recurrenceoccurrence.Policy == nil {
+		return errors.New("ent: uninitialized recurrenceoccurrence.Policy (forgotten import ent/runtime?)")
+	}
+	if err := recurrenceoccurrence.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (_q *RecurrenceOccurrenceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RecurrenceOccurrence, error) {
+	var (
+		nodes       = []*RecurrenceOccurrence{}
+		_spec       = _q.querySpec()
+		loadedTypes = [3]bool{
+			_q.withWorkspace != nil,
+			_q.withRule != nil,
+			_q.withTransaction != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RecurrenceOccurrence).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RecurrenceOccurrence{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withWorkspace; query != nil {
+		if err := _q.loadWorkspace(ctx, query, nodes, nil,
+			func(n *RecurrenceOccurrence, e *Workspace) { n.Edges.Workspace = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRule; query != nil {
+		if err := _q.loadRule(ctx, query, nodes, nil,
+			func(n *RecurrenceOccurrence, e *RecurrenceRule) { n.Edges.Rule = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTransaction; query != nil {
+		if err := _q.loadTransaction(ctx, query, nodes, nil,
+			func(n *RecurrenceOccurrence, e *Transaction) { n.Edges.Transaction = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *RecurrenceOccurrenceQuery) loadWorkspace(ctx context.Context, query *WorkspaceQuery, nodes []*RecurrenceOccurrence, init func(*RecurrenceOccurrence), assign func(*RecurrenceOccurrence, *Workspace)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RecurrenceOccurrence)
+	for i := range nodes {
+		fk := nodes[i].WorkspaceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(workspace.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "workspace_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *RecurrenceOccurrenceQuery) loadRule(ctx context.Context, query *RecurrenceRuleQuery, nodes []*RecurrenceOccurrence, init func(*RecurrenceOccurrence), assign func(*RecurrenceOccurrence, *RecurrenceRule)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RecurrenceOccurrence)
+	for i := range nodes {
+		fk := nodes[i].RuleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(recurrencerule.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "rule_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *RecurrenceOccurrenceQuery) loadTransaction(ctx context.Context, query *TransactionQuery, nodes []*RecurrenceOccurrence, init func(*RecurrenceOccurrence), assign func(*RecurrenceOccurrence, *Transaction)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RecurrenceOccurrence)
+	for i := range nodes {
+		if nodes[i].TransactionID == nil {
+			continue
+		}
+		fk := *nodes[i].TransactionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(transaction.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "transaction_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *RecurrenceOccurrenceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *RecurrenceOccurrenceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(recurrenceoccurrence.Table, recurrenceoccurrence.Columns, sqlgraph.NewFieldSpec(recurrenceoccurrence.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, recurrenceoccurrence.FieldID)
+		for i := range fields {
+			if fields[i] != recurrenceoccurrence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withWorkspace != nil {
+			_spec.Node.AddColumnOnce(recurrenceoccurrence.FieldWorkspaceID)
+		}
+		if _q.withRule != nil {
+			_spec.Node.AddColumnOnce(recurrenceoccurrence.FieldRuleID)
+		}
+		if _q.withTransaction != nil {
+			_spec.Node.AddColumnOnce(recurrenceoccurrence.FieldTransactionID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *RecurrenceOccurrenceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(recurrenceoccurrence.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = recurrenceoccurrence.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *RecurrenceOccurrenceQuery) Modify(modifiers ...func(s *sql.Selector)) *RecurrenceOccurrenceSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// RecurrenceOccurrenceGroupBy is the group-by builder for RecurrenceOccurrence entities.
+type RecurrenceOccurrenceGroupBy struct {
+	selector
+	build *RecurrenceOccurrenceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *RecurrenceOccurrenceGroupBy) Aggregate(fns ...AggregateFunc) *RecurrenceOccurrenceGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *RecurrenceOccurrenceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RecurrenceOccurrenceQuery, *RecurrenceOccurrenceGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *RecurrenceOccurrenceGroupBy) sqlScan(ctx context.Context, root *RecurrenceOccurrenceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RecurrenceOccurrenceSelect is the builder for selecting fields of RecurrenceOccurrence entities.
+type RecurrenceOccurrenceSelect struct {
+	*RecurrenceOccurrenceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *RecurrenceOccurrenceSelect) Aggregate(fns ...AggregateFunc) *RecurrenceOccurrenceSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *RecurrenceOccurrenceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RecurrenceOccurrenceQuery, *RecurrenceOccurrenceSelect](ctx, _s.RecurrenceOccurrenceQuery, _s, _s.inters, v)
+}
+
+func (_s *RecurrenceOccurrenceSelect) sqlScan(ctx context.Context, root *RecurrenceOccurrenceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *RecurrenceOccurrenceSelect) Modify(modifiers ...func(s *sql.Selector)) *RecurrenceOccurrenceSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
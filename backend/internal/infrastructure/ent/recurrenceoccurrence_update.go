@@ -0,0 +1,433 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/recurrenceoccurrence"
+	"backend/internal/infrastructure/ent/transaction"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RecurrenceOccurrenceUpdate is the builder for updating RecurrenceOccurrence entities.
+type RecurrenceOccurrenceUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RecurrenceOccurrenceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RecurrenceOccurrenceUpdate builder.
+func (_u *RecurrenceOccurrenceUpdate) Where(ps ...predicate.RecurrenceOccurrence) *RecurrenceOccurrenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *RecurrenceOccurrenceUpdate) SetStatus(v recurrenceoccurrence.Status) *RecurrenceOccurrenceUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *RecurrenceOccurrenceUpdate) SetNillableStatus(v *recurrenceoccurrence.Status) *RecurrenceOccurrenceUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_u *RecurrenceOccurrenceUpdate) SetTransactionID(v int) *RecurrenceOccurrenceUpdate {
+	_u.mutation.SetTransactionID(v)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_u *RecurrenceOccurrenceUpdate) SetNillableTransactionID(v *int) *RecurrenceOccurrenceUpdate {
+	if v != nil {
+		_u.SetTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (_u *RecurrenceOccurrenceUpdate) ClearTransactionID() *RecurrenceOccurrenceUpdate {
+	_u.mutation.ClearTransactionID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RecurrenceOccurrenceUpdate) SetUpdatedAt(v time.Time) *RecurrenceOccurrenceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *RecurrenceOccurrenceUpdate) SetTransaction(v *Transaction) *RecurrenceOccurrenceUpdate {
+	return _u.SetTransactionID(v.ID)
+}
+
+// Mutation returns the RecurrenceOccurrenceMutation object of the builder.
+func (_u *RecurrenceOccurrenceUpdate) Mutation() *RecurrenceOccurrenceMutation {
+	return _u.mutation
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *RecurrenceOccurrenceUpdate) ClearTransaction() *RecurrenceOccurrenceUpdate {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RecurrenceOccurrenceUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RecurrenceOccurrenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RecurrenceOccurrenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RecurrenceOccurrenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RecurrenceOccurrenceUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if recurrenceoccurrence.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized recurrenceoccurrence.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := recurrenceoccurrence.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RecurrenceOccurrenceUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := recurrenceoccurrence.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RecurrenceOccurrence.status": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RecurrenceOccurrence.workspace"`)
+	}
+	if _u.mutation.RuleCleared() && len(_u.mutation.RuleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RecurrenceOccurrence.rule"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RecurrenceOccurrenceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RecurrenceOccurrenceUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RecurrenceOccurrenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recurrenceoccurrence.Table, recurrenceoccurrence.Columns, sqlgraph.NewFieldSpec(recurrenceoccurrence.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(recurrenceoccurrence.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(recurrenceoccurrence.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   recurrenceoccurrence.TransactionTable,
+			Columns: []string{recurrenceoccurrence.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   recurrenceoccurrence.TransactionTable,
+			Columns: []string{recurrenceoccurrence.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recurrenceoccurrence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RecurrenceOccurrenceUpdateOne is the builder for updating a single RecurrenceOccurrence entity.
+type RecurrenceOccurrenceUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RecurrenceOccurrenceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStatus sets the "status" field.
+func (_u *RecurrenceOccurrenceUpdateOne) SetStatus(v recurrenceoccurrence.Status) *RecurrenceOccurrenceUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *RecurrenceOccurrenceUpdateOne) SetNillableStatus(v *recurrenceoccurrence.Status) *RecurrenceOccurrenceUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_u *RecurrenceOccurrenceUpdateOne) SetTransactionID(v int) *RecurrenceOccurrenceUpdateOne {
+	_u.mutation.SetTransactionID(v)
+	return _u
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (_u *RecurrenceOccurrenceUpdateOne) SetNillableTransactionID(v *int) *RecurrenceOccurrenceUpdateOne {
+	if v != nil {
+		_u.SetTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (_u *RecurrenceOccurrenceUpdateOne) ClearTransactionID() *RecurrenceOccurrenceUpdateOne {
+	_u.mutation.ClearTransactionID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RecurrenceOccurrenceUpdateOne) SetUpdatedAt(v time.Time) *RecurrenceOccurrenceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (_u *RecurrenceOccurrenceUpdateOne) SetTransaction(v *Transaction) *RecurrenceOccurrenceUpdateOne {
+	return _u.SetTransactionID(v.ID)
+}
+
+// Mutation returns the RecurrenceOccurrenceMutation object of the builder.
+func (_u *RecurrenceOccurrenceUpdateOne) Mutation() *RecurrenceOccurrenceMutation {
+	return _u.mutation
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (_u *RecurrenceOccurrenceUpdateOne) ClearTransaction() *RecurrenceOccurrenceUpdateOne {
+	_u.mutation.ClearTransaction()
+	return _u
+}
+
+// Where appends a list predicates to the RecurrenceOccurrenceUpdate builder.
+func (_u *RecurrenceOccurrenceUpdateOne) Where(ps ...predicate.RecurrenceOccurrence) *RecurrenceOccurrenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RecurrenceOccurrenceUpdateOne) Select(field string, fields ...string) *RecurrenceOccurrenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RecurrenceOccurrence entity.
+func (_u *RecurrenceOccurrenceUpdateOne) Save(ctx context.Context) (*RecurrenceOccurrence, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RecurrenceOccurrenceUpdateOne) SaveX(ctx context.Context) *RecurrenceOccurrence {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RecurrenceOccurrenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RecurrenceOccurrenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RecurrenceOccurrenceUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if recurrenceoccurrence.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized recurrenceoccurrence.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := recurrenceoccurrence.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RecurrenceOccurrenceUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := recurrenceoccurrence.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RecurrenceOccurrence.status": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RecurrenceOccurrence.workspace"`)
+	}
+	if _u.mutation.RuleCleared() && len(_u.mutation.RuleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RecurrenceOccurrence.rule"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RecurrenceOccurrenceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RecurrenceOccurrenceUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RecurrenceOccurrenceUpdateOne) sqlSave(ctx context.Context) (_node *RecurrenceOccurrence, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recurrenceoccurrence.Table, recurrenceoccurrence.Columns, sqlgraph.NewFieldSpec(recurrenceoccurrence.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RecurrenceOccurrence.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, recurrenceoccurrence.FieldID)
+		for _, f := range fields {
+			if !recurrenceoccurrence.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != recurrenceoccurrence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(recurrenceoccurrence.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(recurrenceoccurrence.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   recurrenceoccurrence.TransactionTable,
+			Columns: []string{recurrenceoccurrence.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   recurrenceoccurrence.TransactionTable,
+			Columns: []string{recurrenceoccurrence.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &RecurrenceOccurrence{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recurrenceoccurrence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/ent/payee"
+	"backend/internal/infrastructure/ent/recurrencerule"
+	"backend/internal/infrastructure/ent/workspace"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// RecurrenceRule is the model entity for the RecurrenceRule schema.
+type RecurrenceRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID int `json:"account_id,omitempty"`
+	// CategoryID holds the value of the "category_id" field.
+	CategoryID *int `json:"category_id,omitempty"`
+	// PayeeID holds the value of the "payee_id" field.
+	PayeeID *int `json:"payee_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount model.Decimal `json:"amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Payee holds the value of the "payee" field.
+	Payee string `json:"payee,omitempty"`
+	// Memo holds the value of the "memo" field.
+	Memo string `json:"memo,omitempty"`
+	// Frequency holds the value of the "frequency" field.
+	Frequency recurrencerule.Frequency `json:"frequency,omitempty"`
+	// Interval holds the value of the "interval" field.
+	Interval int `json:"interval,omitempty"`
+	// ByDay holds the value of the "by_day" field.
+	ByDay []string `json:"by_day,omitempty"`
+	// StartsOn holds the value of the "starts_on" field.
+	StartsOn time.Time `json:"starts_on,omitempty"`
+	// EndsOn holds the value of the "ends_on" field.
+	EndsOn *time.Time `json:"ends_on,omitempty"`
+	// ScheduledFrom holds the value of the "scheduled_from" field.
+	ScheduledFrom time.Time `json:"scheduled_from,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RecurrenceRuleQuery when eager-loading is set.
+	Edges        RecurrenceRuleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RecurrenceRuleEdges holds the relations/edges for other nodes in the graph.
+type RecurrenceRuleEdges struct {
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// Category holds the value of the category edge.
+	Category *Category `json:"category,omitempty"`
+	// LinkedPayee holds the value of the linked_payee edge.
+	LinkedPayee *Payee `json:"linked_payee,omitempty"`
+	// Occurrences holds the value of the occurrences edge.
+	Occurrences []*RecurrenceOccurrence `json:"occurrences,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RecurrenceRuleEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RecurrenceRuleEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// CategoryOrErr returns the Category value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RecurrenceRuleEdges) CategoryOrErr() (*Category, error) {
+	if e.Category != nil {
+		return e.Category, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: category.Label}
+	}
+	return nil, &NotLoadedError{edge: "category"}
+}
+
+// LinkedPayeeOrErr returns the LinkedPayee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RecurrenceRuleEdges) LinkedPayeeOrErr() (*Payee, error) {
+	if e.LinkedPayee != nil {
+		return e.LinkedPayee, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: payee.Label}
+	}
+	return nil, &NotLoadedError{edge: "linked_payee"}
+}
+
+// OccurrencesOrErr returns the Occurrences value or an error if the edge
+// was not loaded in eager-loading.
+func (e RecurrenceRuleEdges) OccurrencesOrErr() ([]*RecurrenceOccurrence, error) {
+	if e.loadedTypes[4] {
+		return e.Occurrences, nil
+	}
+	return nil, &NotLoadedError{edge: "occurrences"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RecurrenceRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case recurrencerule.FieldByDay:
+			values[i] = new([]byte)
+		case recurrencerule.FieldAmount:
+			values[i] = new(model.Decimal)
+		case recurrencerule.FieldID, recurrencerule.FieldWorkspaceID, recurrencerule.FieldAccountID, recurrencerule.FieldCategoryID, recurrencerule.FieldPayeeID, recurrencerule.FieldInterval:
+			values[i] = new(sql.NullInt64)
+		case recurrencerule.FieldCurrency, recurrencerule.FieldPayee, recurrencerule.FieldMemo, recurrencerule.FieldFrequency:
+			values[i] = new(sql.NullString)
+		case recurrencerule.FieldStartsOn, recurrencerule.FieldEndsOn, recurrencerule.FieldScheduledFrom, recurrencerule.FieldCreatedAt, recurrencerule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RecurrenceRule fields.
+func (_m *RecurrenceRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case recurrencerule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case recurrencerule.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				_m.WorkspaceID = int(value.Int64)
+			}
+		case recurrencerule.FieldAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = int(value.Int64)
+			}
+		case recurrencerule.FieldCategoryID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
+			} else if value.Valid {
+				_m.CategoryID = new(int)
+				*_m.CategoryID = int(value.Int64)
+			}
+		case recurrencerule.FieldPayeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field payee_id", values[i])
+			} else if value.Valid {
+				_m.PayeeID = new(int)
+				*_m.PayeeID = int(value.Int64)
+			}
+		case recurrencerule.FieldAmount:
+			if value, ok := values[i].(*model.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case recurrencerule.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case recurrencerule.FieldPayee:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payee", values[i])
+			} else if value.Valid {
+				_m.Payee = value.String
+			}
+		case recurrencerule.FieldMemo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field memo", values[i])
+			} else if value.Valid {
+				_m.Memo = value.String
+			}
+		case recurrencerule.FieldFrequency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field frequency", values[i])
+			} else if value.Valid {
+				_m.Frequency = recurrencerule.Frequency(value.String)
+			}
+		case recurrencerule.FieldInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field interval", values[i])
+			} else if value.Valid {
+				_m.Interval = int(value.Int64)
+			}
+		case recurrencerule.FieldByDay:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field by_day", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ByDay); err != nil {
+					return fmt.Errorf("unmarshal field by_day: %w", err)
+				}
+			}
+		case recurrencerule.FieldStartsOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_on", values[i])
+			} else if value.Valid {
+				_m.StartsOn = value.Time
+			}
+		case recurrencerule.FieldEndsOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_on", values[i])
+			} else if value.Valid {
+				_m.EndsOn = new(time.Time)
+				*_m.EndsOn = value.Time
+			}
+		case recurrencerule.FieldScheduledFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_from", values[i])
+			} else if value.Valid {
+				_m.ScheduledFrom = value.Time
+			}
+		case recurrencerule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case recurrencerule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RecurrenceRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *RecurrenceRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWorkspace queries the "workspace" edge of the RecurrenceRule entity.
+func (_m *RecurrenceRule) QueryWorkspace() *WorkspaceQuery {
+	return NewRecurrenceRuleClient(_m.config).QueryWorkspace(_m)
+}
+
+// QueryAccount queries the "account" edge of the RecurrenceRule entity.
+func (_m *RecurrenceRule) QueryAccount() *AccountQuery {
+	return NewRecurrenceRuleClient(_m.config).QueryAccount(_m)
+}
+
+// QueryCategory queries the "category" edge of the RecurrenceRule entity.
+func (_m *RecurrenceRule) QueryCategory() *CategoryQuery {
+	return NewRecurrenceRuleClient(_m.config).QueryCategory(_m)
+}
+
+// QueryLinkedPayee queries the "linked_payee" edge of the RecurrenceRule entity.
+func (_m *RecurrenceRule) QueryLinkedPayee() *PayeeQuery {
+	return NewRecurrenceRuleClient(_m.config).QueryLinkedPayee(_m)
+}
+
+// QueryOccurrences queries the "occurrences" edge of the RecurrenceRule entity.
+func (_m *RecurrenceRule) QueryOccurrences() *RecurrenceOccurrenceQuery {
+	return NewRecurrenceRuleClient(_m.config).QueryOccurrences(_m)
+}
+
+// Update returns a builder for updating this RecurrenceRule.
+// Note that you need to call RecurrenceRule.Unwrap() before calling this method if this RecurrenceRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RecurrenceRule) Update() *RecurrenceRuleUpdateOne {
+	return NewRecurrenceRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RecurrenceRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RecurrenceRule) Unwrap() *RecurrenceRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RecurrenceRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RecurrenceRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("RecurrenceRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	if v := _m.CategoryID; v != nil {
+		builder.WriteString("category_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.PayeeID; v != nil {
+		builder.WriteString("payee_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("payee=")
+	builder.WriteString(_m.Payee)
+	builder.WriteString(", ")
+	builder.WriteString("memo=")
+	builder.WriteString(_m.Memo)
+	builder.WriteString(", ")
+	builder.WriteString("frequency=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Frequency))
+	builder.WriteString(", ")
+	builder.WriteString("interval=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Interval))
+	builder.WriteString(", ")
+	builder.WriteString("by_day=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ByDay))
+	builder.WriteString(", ")
+	builder.WriteString("starts_on=")
+	builder.WriteString(_m.StartsOn.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.EndsOn; v != nil {
+		builder.WriteString("ends_on=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("scheduled_from=")
+	builder.WriteString(_m.ScheduledFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RecurrenceRules is a parsable slice of RecurrenceRule.
+type RecurrenceRules []*RecurrenceRule
@@ -0,0 +1,338 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recurrencerule
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the recurrencerule type in the database.
+	Label = "recurrence_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWorkspaceID holds the string denoting the workspace_id field in the database.
+	FieldWorkspaceID = "workspace_id"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldCategoryID holds the string denoting the category_id field in the database.
+	FieldCategoryID = "category_id"
+	// FieldPayeeID holds the string denoting the payee_id field in the database.
+	FieldPayeeID = "payee_id"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldPayee holds the string denoting the payee field in the database.
+	FieldPayee = "payee"
+	// FieldMemo holds the string denoting the memo field in the database.
+	FieldMemo = "memo"
+	// FieldFrequency holds the string denoting the frequency field in the database.
+	FieldFrequency = "frequency"
+	// FieldInterval holds the string denoting the interval field in the database.
+	FieldInterval = "interval"
+	// FieldByDay holds the string denoting the by_day field in the database.
+	FieldByDay = "by_day"
+	// FieldStartsOn holds the string denoting the starts_on field in the database.
+	FieldStartsOn = "starts_on"
+	// FieldEndsOn holds the string denoting the ends_on field in the database.
+	FieldEndsOn = "ends_on"
+	// FieldScheduledFrom holds the string denoting the scheduled_from field in the database.
+	FieldScheduledFrom = "scheduled_from"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeWorkspace holds the string denoting the workspace edge name in mutations.
+	EdgeWorkspace = "workspace"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// EdgeCategory holds the string denoting the category edge name in mutations.
+	EdgeCategory = "category"
+	// EdgeLinkedPayee holds the string denoting the linked_payee edge name in mutations.
+	EdgeLinkedPayee = "linked_payee"
+	// EdgeOccurrences holds the string denoting the occurrences edge name in mutations.
+	EdgeOccurrences = "occurrences"
+	// Table holds the table name of the recurrencerule in the database.
+	Table = "recurrence_rules"
+	// WorkspaceTable is the table that holds the workspace relation/edge.
+	WorkspaceTable = "recurrence_rules"
+	// WorkspaceInverseTable is the table name for the Workspace entity.
+	// It exists in this package in order to avoid circular dependency with the "workspace" package.
+	WorkspaceInverseTable = "workspaces"
+	// WorkspaceColumn is the table column denoting the workspace relation/edge.
+	WorkspaceColumn = "workspace_id"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "recurrence_rules"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+	// CategoryTable is the table that holds the category relation/edge.
+	CategoryTable = "recurrence_rules"
+	// CategoryInverseTable is the table name for the Category entity.
+	// It exists in this package in order to avoid circular dependency with the "category" package.
+	CategoryInverseTable = "categories"
+	// CategoryColumn is the table column denoting the category relation/edge.
+	CategoryColumn = "category_id"
+	// LinkedPayeeTable is the table that holds the linked_payee relation/edge.
+	LinkedPayeeTable = "recurrence_rules"
+	// LinkedPayeeInverseTable is the table name for the Payee entity.
+	// It exists in this package in order to avoid circular dependency with the "payee" package.
+	LinkedPayeeInverseTable = "payees"
+	// LinkedPayeeColumn is the table column denoting the linked_payee relation/edge.
+	LinkedPayeeColumn = "payee_id"
+	// OccurrencesTable is the table that holds the occurrences relation/edge.
+	OccurrencesTable = "recurrence_occurrences"
+	// OccurrencesInverseTable is the table name for the RecurrenceOccurrence entity.
+	// It exists in this package in order to avoid circular dependency with the "recurrenceoccurrence" package.
+	OccurrencesInverseTable = "recurrence_occurrences"
+	// OccurrencesColumn is the table column denoting the occurrences relation/edge.
+	OccurrencesColumn = "rule_id"
+)
+
+// Columns holds all SQL columns for recurrencerule fields.
+var Columns = []string{
+	FieldID,
+	FieldWorkspaceID,
+	FieldAccountID,
+	FieldCategoryID,
+	FieldPayeeID,
+	FieldAmount,
+	FieldCurrency,
+	FieldPayee,
+	FieldMemo,
+	FieldFrequency,
+	FieldInterval,
+	FieldByDay,
+	FieldStartsOn,
+	FieldEndsOn,
+	FieldScheduledFrom,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "backend/internal/infrastructure/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// DefaultInterval holds the default value on creation for the "interval" field.
+	DefaultInterval int
+	// IntervalValidator is a validator for the "interval" field. It is called by the builders before save.
+	IntervalValidator func(int) error
+	// DefaultScheduledFrom holds the default value on creation for the "scheduled_from" field.
+	DefaultScheduledFrom func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Frequency defines the type for the "frequency" enum field.
+type Frequency string
+
+// Frequency values.
+const (
+	FrequencyDaily   Frequency = "daily"
+	FrequencyWeekly  Frequency = "weekly"
+	FrequencyMonthly Frequency = "monthly"
+	FrequencyYearly  Frequency = "yearly"
+)
+
+func (f Frequency) String() string {
+	return string(f)
+}
+
+// FrequencyValidator is a validator for the "frequency" field enum values. It is called by the builders before save.
+func FrequencyValidator(f Frequency) error {
+	switch f {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+		return nil
+	default:
+		return fmt.Errorf("recurrencerule: invalid enum value for frequency field: %q", f)
+	}
+}
+
+// OrderOption defines the ordering options for the RecurrenceRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByWorkspaceID orders the results by the workspace_id field.
+func ByWorkspaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkspaceID, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByCategoryID orders the results by the category_id field.
+func ByCategoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoryID, opts...).ToFunc()
+}
+
+// ByPayeeID orders the results by the payee_id field.
+func ByPayeeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayeeID, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByPayee orders the results by the payee field.
+func ByPayee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayee, opts...).ToFunc()
+}
+
+// ByMemo orders the results by the memo field.
+func ByMemo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMemo, opts...).ToFunc()
+}
+
+// ByFrequency orders the results by the frequency field.
+func ByFrequency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFrequency, opts...).ToFunc()
+}
+
+// ByInterval orders the results by the interval field.
+func ByInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInterval, opts...).ToFunc()
+}
+
+// ByStartsOn orders the results by the starts_on field.
+func ByStartsOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartsOn, opts...).ToFunc()
+}
+
+// ByEndsOn orders the results by the ends_on field.
+func ByEndsOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndsOn, opts...).ToFunc()
+}
+
+// ByScheduledFrom orders the results by the scheduled_from field.
+func ByScheduledFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScheduledFrom, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByWorkspaceField orders the results by workspace field.
+func ByWorkspaceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorkspaceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCategoryField orders the results by category field.
+func ByCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCategoryStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLinkedPayeeField orders the results by linked_payee field.
+func ByLinkedPayeeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinkedPayeeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByOccurrencesCount orders the results by occurrences count.
+func ByOccurrencesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOccurrencesStep(), opts...)
+	}
+}
+
+// ByOccurrences orders the results by occurrences terms.
+func ByOccurrences(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOccurrencesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newWorkspaceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorkspaceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, WorkspaceTable, WorkspaceColumn),
+	)
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+	)
+}
+func newCategoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CategoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
+	)
+}
+func newLinkedPayeeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinkedPayeeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LinkedPayeeTable, LinkedPayeeColumn),
+	)
+}
+func newOccurrencesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OccurrencesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OccurrencesTable, OccurrencesColumn),
+	)
+}
@@ -0,0 +1,892 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recurrencerule
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLTE(FieldID, id))
+}
+
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldAccountID, v))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// PayeeID applies equality check predicate on the "payee_id" field. It's identical to PayeeIDEQ.
+func PayeeID(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldPayeeID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v model.Decimal) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldAmount, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Payee applies equality check predicate on the "payee" field. It's identical to PayeeEQ.
+func Payee(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldPayee, v))
+}
+
+// Memo applies equality check predicate on the "memo" field. It's identical to MemoEQ.
+func Memo(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldMemo, v))
+}
+
+// Interval applies equality check predicate on the "interval" field. It's identical to IntervalEQ.
+func Interval(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldInterval, v))
+}
+
+// StartsOn applies equality check predicate on the "starts_on" field. It's identical to StartsOnEQ.
+func StartsOn(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldStartsOn, v))
+}
+
+// EndsOn applies equality check predicate on the "ends_on" field. It's identical to EndsOnEQ.
+func EndsOn(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldEndsOn, v))
+}
+
+// ScheduledFrom applies equality check predicate on the "scheduled_from" field. It's identical to ScheduledFromEQ.
+func ScheduledFrom(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldScheduledFrom, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDIsNil applies the IsNil predicate on the "category_id" field.
+func CategoryIDIsNil() predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIsNull(FieldCategoryID))
+}
+
+// CategoryIDNotNil applies the NotNil predicate on the "category_id" field.
+func CategoryIDNotNil() predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotNull(FieldCategoryID))
+}
+
+// PayeeIDEQ applies the EQ predicate on the "payee_id" field.
+func PayeeIDEQ(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldPayeeID, v))
+}
+
+// PayeeIDNEQ applies the NEQ predicate on the "payee_id" field.
+func PayeeIDNEQ(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNEQ(FieldPayeeID, v))
+}
+
+// PayeeIDIn applies the In predicate on the "payee_id" field.
+func PayeeIDIn(vs ...int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIn(FieldPayeeID, vs...))
+}
+
+// PayeeIDNotIn applies the NotIn predicate on the "payee_id" field.
+func PayeeIDNotIn(vs ...int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotIn(FieldPayeeID, vs...))
+}
+
+// PayeeIDIsNil applies the IsNil predicate on the "payee_id" field.
+func PayeeIDIsNil() predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIsNull(FieldPayeeID))
+}
+
+// PayeeIDNotNil applies the NotNil predicate on the "payee_id" field.
+func PayeeIDNotNil() predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotNull(FieldPayeeID))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v model.Decimal) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v model.Decimal) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...model.Decimal) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...model.Decimal) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v model.Decimal) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v model.Decimal) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v model.Decimal) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v model.Decimal) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLTE(FieldAmount, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// PayeeEQ applies the EQ predicate on the "payee" field.
+func PayeeEQ(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldPayee, v))
+}
+
+// PayeeNEQ applies the NEQ predicate on the "payee" field.
+func PayeeNEQ(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNEQ(FieldPayee, v))
+}
+
+// PayeeIn applies the In predicate on the "payee" field.
+func PayeeIn(vs ...string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIn(FieldPayee, vs...))
+}
+
+// PayeeNotIn applies the NotIn predicate on the "payee" field.
+func PayeeNotIn(vs ...string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotIn(FieldPayee, vs...))
+}
+
+// PayeeGT applies the GT predicate on the "payee" field.
+func PayeeGT(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGT(FieldPayee, v))
+}
+
+// PayeeGTE applies the GTE predicate on the "payee" field.
+func PayeeGTE(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGTE(FieldPayee, v))
+}
+
+// PayeeLT applies the LT predicate on the "payee" field.
+func PayeeLT(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLT(FieldPayee, v))
+}
+
+// PayeeLTE applies the LTE predicate on the "payee" field.
+func PayeeLTE(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLTE(FieldPayee, v))
+}
+
+// PayeeContains applies the Contains predicate on the "payee" field.
+func PayeeContains(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldContains(FieldPayee, v))
+}
+
+// PayeeHasPrefix applies the HasPrefix predicate on the "payee" field.
+func PayeeHasPrefix(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldHasPrefix(FieldPayee, v))
+}
+
+// PayeeHasSuffix applies the HasSuffix predicate on the "payee" field.
+func PayeeHasSuffix(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldHasSuffix(FieldPayee, v))
+}
+
+// PayeeIsNil applies the IsNil predicate on the "payee" field.
+func PayeeIsNil() predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIsNull(FieldPayee))
+}
+
+// PayeeNotNil applies the NotNil predicate on the "payee" field.
+func PayeeNotNil() predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotNull(FieldPayee))
+}
+
+// PayeeEqualFold applies the EqualFold predicate on the "payee" field.
+func PayeeEqualFold(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEqualFold(FieldPayee, v))
+}
+
+// PayeeContainsFold applies the ContainsFold predicate on the "payee" field.
+func PayeeContainsFold(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldContainsFold(FieldPayee, v))
+}
+
+// MemoEQ applies the EQ predicate on the "memo" field.
+func MemoEQ(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldMemo, v))
+}
+
+// MemoNEQ applies the NEQ predicate on the "memo" field.
+func MemoNEQ(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNEQ(FieldMemo, v))
+}
+
+// MemoIn applies the In predicate on the "memo" field.
+func MemoIn(vs ...string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIn(FieldMemo, vs...))
+}
+
+// MemoNotIn applies the NotIn predicate on the "memo" field.
+func MemoNotIn(vs ...string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotIn(FieldMemo, vs...))
+}
+
+// MemoGT applies the GT predicate on the "memo" field.
+func MemoGT(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGT(FieldMemo, v))
+}
+
+// MemoGTE applies the GTE predicate on the "memo" field.
+func MemoGTE(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGTE(FieldMemo, v))
+}
+
+// MemoLT applies the LT predicate on the "memo" field.
+func MemoLT(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLT(FieldMemo, v))
+}
+
+// MemoLTE applies the LTE predicate on the "memo" field.
+func MemoLTE(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLTE(FieldMemo, v))
+}
+
+// MemoContains applies the Contains predicate on the "memo" field.
+func MemoContains(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldContains(FieldMemo, v))
+}
+
+// MemoHasPrefix applies the HasPrefix predicate on the "memo" field.
+func MemoHasPrefix(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldHasPrefix(FieldMemo, v))
+}
+
+// MemoHasSuffix applies the HasSuffix predicate on the "memo" field.
+func MemoHasSuffix(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldHasSuffix(FieldMemo, v))
+}
+
+// MemoIsNil applies the IsNil predicate on the "memo" field.
+func MemoIsNil() predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIsNull(FieldMemo))
+}
+
+// MemoNotNil applies the NotNil predicate on the "memo" field.
+func MemoNotNil() predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotNull(FieldMemo))
+}
+
+// MemoEqualFold applies the EqualFold predicate on the "memo" field.
+func MemoEqualFold(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEqualFold(FieldMemo, v))
+}
+
+// MemoContainsFold applies the ContainsFold predicate on the "memo" field.
+func MemoContainsFold(v string) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldContainsFold(FieldMemo, v))
+}
+
+// FrequencyEQ applies the EQ predicate on the "frequency" field.
+func FrequencyEQ(v Frequency) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldFrequency, v))
+}
+
+// FrequencyNEQ applies the NEQ predicate on the "frequency" field.
+func FrequencyNEQ(v Frequency) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNEQ(FieldFrequency, v))
+}
+
+// FrequencyIn applies the In predicate on the "frequency" field.
+func FrequencyIn(vs ...Frequency) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIn(FieldFrequency, vs...))
+}
+
+// FrequencyNotIn applies the NotIn predicate on the "frequency" field.
+func FrequencyNotIn(vs ...Frequency) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotIn(FieldFrequency, vs...))
+}
+
+// IntervalEQ applies the EQ predicate on the "interval" field.
+func IntervalEQ(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldInterval, v))
+}
+
+// IntervalNEQ applies the NEQ predicate on the "interval" field.
+func IntervalNEQ(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNEQ(FieldInterval, v))
+}
+
+// IntervalIn applies the In predicate on the "interval" field.
+func IntervalIn(vs ...int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIn(FieldInterval, vs...))
+}
+
+// IntervalNotIn applies the NotIn predicate on the "interval" field.
+func IntervalNotIn(vs ...int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotIn(FieldInterval, vs...))
+}
+
+// IntervalGT applies the GT predicate on the "interval" field.
+func IntervalGT(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGT(FieldInterval, v))
+}
+
+// IntervalGTE applies the GTE predicate on the "interval" field.
+func IntervalGTE(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGTE(FieldInterval, v))
+}
+
+// IntervalLT applies the LT predicate on the "interval" field.
+func IntervalLT(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLT(FieldInterval, v))
+}
+
+// IntervalLTE applies the LTE predicate on the "interval" field.
+func IntervalLTE(v int) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLTE(FieldInterval, v))
+}
+
+// ByDayIsNil applies the IsNil predicate on the "by_day" field.
+func ByDayIsNil() predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIsNull(FieldByDay))
+}
+
+// ByDayNotNil applies the NotNil predicate on the "by_day" field.
+func ByDayNotNil() predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotNull(FieldByDay))
+}
+
+// StartsOnEQ applies the EQ predicate on the "starts_on" field.
+func StartsOnEQ(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldStartsOn, v))
+}
+
+// StartsOnNEQ applies the NEQ predicate on the "starts_on" field.
+func StartsOnNEQ(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNEQ(FieldStartsOn, v))
+}
+
+// StartsOnIn applies the In predicate on the "starts_on" field.
+func StartsOnIn(vs ...time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIn(FieldStartsOn, vs...))
+}
+
+// StartsOnNotIn applies the NotIn predicate on the "starts_on" field.
+func StartsOnNotIn(vs ...time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotIn(FieldStartsOn, vs...))
+}
+
+// StartsOnGT applies the GT predicate on the "starts_on" field.
+func StartsOnGT(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGT(FieldStartsOn, v))
+}
+
+// StartsOnGTE applies the GTE predicate on the "starts_on" field.
+func StartsOnGTE(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGTE(FieldStartsOn, v))
+}
+
+// StartsOnLT applies the LT predicate on the "starts_on" field.
+func StartsOnLT(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLT(FieldStartsOn, v))
+}
+
+// StartsOnLTE applies the LTE predicate on the "starts_on" field.
+func StartsOnLTE(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLTE(FieldStartsOn, v))
+}
+
+// EndsOnEQ applies the EQ predicate on the "ends_on" field.
+func EndsOnEQ(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldEndsOn, v))
+}
+
+// EndsOnNEQ applies the NEQ predicate on the "ends_on" field.
+func EndsOnNEQ(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNEQ(FieldEndsOn, v))
+}
+
+// EndsOnIn applies the In predicate on the "ends_on" field.
+func EndsOnIn(vs ...time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIn(FieldEndsOn, vs...))
+}
+
+// EndsOnNotIn applies the NotIn predicate on the "ends_on" field.
+func EndsOnNotIn(vs ...time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotIn(FieldEndsOn, vs...))
+}
+
+// EndsOnGT applies the GT predicate on the "ends_on" field.
+func EndsOnGT(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGT(FieldEndsOn, v))
+}
+
+// EndsOnGTE applies the GTE predicate on the "ends_on" field.
+func EndsOnGTE(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGTE(FieldEndsOn, v))
+}
+
+// EndsOnLT applies the LT predicate on the "ends_on" field.
+func EndsOnLT(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLT(FieldEndsOn, v))
+}
+
+// EndsOnLTE applies the LTE predicate on the "ends_on" field.
+func EndsOnLTE(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLTE(FieldEndsOn, v))
+}
+
+// EndsOnIsNil applies the IsNil predicate on the "ends_on" field.
+func EndsOnIsNil() predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIsNull(FieldEndsOn))
+}
+
+// EndsOnNotNil applies the NotNil predicate on the "ends_on" field.
+func EndsOnNotNil() predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotNull(FieldEndsOn))
+}
+
+// ScheduledFromEQ applies the EQ predicate on the "scheduled_from" field.
+func ScheduledFromEQ(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldScheduledFrom, v))
+}
+
+// ScheduledFromNEQ applies the NEQ predicate on the "scheduled_from" field.
+func ScheduledFromNEQ(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNEQ(FieldScheduledFrom, v))
+}
+
+// ScheduledFromIn applies the In predicate on the "scheduled_from" field.
+func ScheduledFromIn(vs ...time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIn(FieldScheduledFrom, vs...))
+}
+
+// ScheduledFromNotIn applies the NotIn predicate on the "scheduled_from" field.
+func ScheduledFromNotIn(vs ...time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotIn(FieldScheduledFrom, vs...))
+}
+
+// ScheduledFromGT applies the GT predicate on the "scheduled_from" field.
+func ScheduledFromGT(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGT(FieldScheduledFrom, v))
+}
+
+// ScheduledFromGTE applies the GTE predicate on the "scheduled_from" field.
+func ScheduledFromGTE(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGTE(FieldScheduledFrom, v))
+}
+
+// ScheduledFromLT applies the LT predicate on the "scheduled_from" field.
+func ScheduledFromLT(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLT(FieldScheduledFrom, v))
+}
+
+// ScheduledFromLTE applies the LTE predicate on the "scheduled_from" field.
+func ScheduledFromLTE(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLTE(FieldScheduledFrom, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasWorkspace applies the HasEdge predicate on the "workspace" edge.
+func HasWorkspace() predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WorkspaceTable, WorkspaceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkspaceWith applies the HasEdge predicate on the "workspace" edge with a given conditions (other predicates).
+func HasWorkspaceWith(preds ...predicate.Workspace) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(func(s *sql.Selector) {
+		step := newWorkspaceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCategory applies the HasEdge predicate on the "category" edge.
+func HasCategory() predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCategoryWith applies the HasEdge predicate on the "category" edge with a given conditions (other predicates).
+func HasCategoryWith(preds ...predicate.Category) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(func(s *sql.Selector) {
+		step := newCategoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLinkedPayee applies the HasEdge predicate on the "linked_payee" edge.
+func HasLinkedPayee() predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LinkedPayeeTable, LinkedPayeeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinkedPayeeWith applies the HasEdge predicate on the "linked_payee" edge with a given conditions (other predicates).
+func HasLinkedPayeeWith(preds ...predicate.Payee) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(func(s *sql.Selector) {
+		step := newLinkedPayeeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOccurrences applies the HasEdge predicate on the "occurrences" edge.
+func HasOccurrences() predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OccurrencesTable, OccurrencesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOccurrencesWith applies the HasEdge predicate on the "occurrences" edge with a given conditions (other predicates).
+func HasOccurrencesWith(preds ...predicate.RecurrenceOccurrence) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(func(s *sql.Selector) {
+		step := newOccurrencesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RecurrenceRule) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RecurrenceRule) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RecurrenceRule) predicate.RecurrenceRule {
+	return predicate.RecurrenceRule(sql.NotPredicates(p))
+}
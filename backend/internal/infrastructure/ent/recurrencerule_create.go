@@ -0,0 +1,611 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/ent/payee"
+	"backend/internal/infrastructure/ent/recurrenceoccurrence"
+	"backend/internal/infrastructure/ent/recurrencerule"
+	"backend/internal/infrastructure/ent/workspace"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RecurrenceRuleCreate is the builder for creating a RecurrenceRule entity.
+type RecurrenceRuleCreate struct {
+	config
+	mutation *RecurrenceRuleMutation
+	hooks    []Hook
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *RecurrenceRuleCreate) SetWorkspaceID(v int) *RecurrenceRuleCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *RecurrenceRuleCreate) SetAccountID(v int) *RecurrenceRuleCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *RecurrenceRuleCreate) SetCategoryID(v int) *RecurrenceRuleCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_c *RecurrenceRuleCreate) SetNillableCategoryID(v *int) *RecurrenceRuleCreate {
+	if v != nil {
+		_c.SetCategoryID(*v)
+	}
+	return _c
+}
+
+// SetPayeeID sets the "payee_id" field.
+func (_c *RecurrenceRuleCreate) SetPayeeID(v int) *RecurrenceRuleCreate {
+	_c.mutation.SetPayeeID(v)
+	return _c
+}
+
+// SetNillablePayeeID sets the "payee_id" field if the given value is not nil.
+func (_c *RecurrenceRuleCreate) SetNillablePayeeID(v *int) *RecurrenceRuleCreate {
+	if v != nil {
+		_c.SetPayeeID(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *RecurrenceRuleCreate) SetAmount(v model.Decimal) *RecurrenceRuleCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *RecurrenceRuleCreate) SetCurrency(v string) *RecurrenceRuleCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetPayee sets the "payee" field.
+func (_c *RecurrenceRuleCreate) SetPayee(v string) *RecurrenceRuleCreate {
+	_c.mutation.SetPayee(v)
+	return _c
+}
+
+// SetNillablePayee sets the "payee" field if the given value is not nil.
+func (_c *RecurrenceRuleCreate) SetNillablePayee(v *string) *RecurrenceRuleCreate {
+	if v != nil {
+		_c.SetPayee(*v)
+	}
+	return _c
+}
+
+// SetMemo sets the "memo" field.
+func (_c *RecurrenceRuleCreate) SetMemo(v string) *RecurrenceRuleCreate {
+	_c.mutation.SetMemo(v)
+	return _c
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_c *RecurrenceRuleCreate) SetNillableMemo(v *string) *RecurrenceRuleCreate {
+	if v != nil {
+		_c.SetMemo(*v)
+	}
+	return _c
+}
+
+// SetFrequency sets the "frequency" field.
+func (_c *RecurrenceRuleCreate) SetFrequency(v recurrencerule.Frequency) *RecurrenceRuleCreate {
+	_c.mutation.SetFrequency(v)
+	return _c
+}
+
+// SetInterval sets the "interval" field.
+func (_c *RecurrenceRuleCreate) SetInterval(v int) *RecurrenceRuleCreate {
+	_c.mutation.SetInterval(v)
+	return _c
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (_c *RecurrenceRuleCreate) SetNillableInterval(v *int) *RecurrenceRuleCreate {
+	if v != nil {
+		_c.SetInterval(*v)
+	}
+	return _c
+}
+
+// SetByDay sets the "by_day" field.
+func (_c *RecurrenceRuleCreate) SetByDay(v []string) *RecurrenceRuleCreate {
+	_c.mutation.SetByDay(v)
+	return _c
+}
+
+// SetStartsOn sets the "starts_on" field.
+func (_c *RecurrenceRuleCreate) SetStartsOn(v time.Time) *RecurrenceRuleCreate {
+	_c.mutation.SetStartsOn(v)
+	return _c
+}
+
+// SetEndsOn sets the "ends_on" field.
+func (_c *RecurrenceRuleCreate) SetEndsOn(v time.Time) *RecurrenceRuleCreate {
+	_c.mutation.SetEndsOn(v)
+	return _c
+}
+
+// SetNillableEndsOn sets the "ends_on" field if the given value is not nil.
+func (_c *RecurrenceRuleCreate) SetNillableEndsOn(v *time.Time) *RecurrenceRuleCreate {
+	if v != nil {
+		_c.SetEndsOn(*v)
+	}
+	return _c
+}
+
+// SetScheduledFrom sets the "scheduled_from" field.
+func (_c *RecurrenceRuleCreate) SetScheduledFrom(v time.Time) *RecurrenceRuleCreate {
+	_c.mutation.SetScheduledFrom(v)
+	return _c
+}
+
+// SetNillableScheduledFrom sets the "scheduled_from" field if the given value is not nil.
+func (_c *RecurrenceRuleCreate) SetNillableScheduledFrom(v *time.Time) *RecurrenceRuleCreate {
+	if v != nil {
+		_c.SetScheduledFrom(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RecurrenceRuleCreate) SetCreatedAt(v time.Time) *RecurrenceRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RecurrenceRuleCreate) SetNillableCreatedAt(v *time.Time) *RecurrenceRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RecurrenceRuleCreate) SetUpdatedAt(v time.Time) *RecurrenceRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RecurrenceRuleCreate) SetNillableUpdatedAt(v *time.Time) *RecurrenceRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_c *RecurrenceRuleCreate) SetWorkspace(v *Workspace) *RecurrenceRuleCreate {
+	return _c.SetWorkspaceID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *RecurrenceRuleCreate) SetAccount(v *Account) *RecurrenceRuleCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_c *RecurrenceRuleCreate) SetCategory(v *Category) *RecurrenceRuleCreate {
+	return _c.SetCategoryID(v.ID)
+}
+
+// SetLinkedPayeeID sets the "linked_payee" edge to the Payee entity by ID.
+func (_c *RecurrenceRuleCreate) SetLinkedPayeeID(id int) *RecurrenceRuleCreate {
+	_c.mutation.SetLinkedPayeeID(id)
+	return _c
+}
+
+// SetNillableLinkedPayeeID sets the "linked_payee" edge to the Payee entity by ID if the given value is not nil.
+func (_c *RecurrenceRuleCreate) SetNillableLinkedPayeeID(id *int) *RecurrenceRuleCreate {
+	if id != nil {
+		_c = _c.SetLinkedPayeeID(*id)
+	}
+	return _c
+}
+
+// SetLinkedPayee sets the "linked_payee" edge to the Payee entity.
+func (_c *RecurrenceRuleCreate) SetLinkedPayee(v *Payee) *RecurrenceRuleCreate {
+	return _c.SetLinkedPayeeID(v.ID)
+}
+
+// AddOccurrenceIDs adds the "occurrences" edge to the RecurrenceOccurrence entity by IDs.
+func (_c *RecurrenceRuleCreate) AddOccurrenceIDs(ids ...int) *RecurrenceRuleCreate {
+	_c.mutation.AddOccurrenceIDs(ids...)
+	return _c
+}
+
+// AddOccurrences adds the "occurrences" edges to the RecurrenceOccurrence entity.
+func (_c *RecurrenceRuleCreate) AddOccurrences(v ...*RecurrenceOccurrence) *RecurrenceRuleCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOccurrenceIDs(ids...)
+}
+
+// Mutation returns the RecurrenceRuleMutation object of the builder.
+func (_c *RecurrenceRuleCreate) Mutation() *RecurrenceRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the RecurrenceRule in the database.
+func (_c *RecurrenceRuleCreate) Save(ctx context.Context) (*RecurrenceRule, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RecurrenceRuleCreate) SaveX(ctx context.Context) *RecurrenceRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecurrenceRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecurrenceRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RecurrenceRuleCreate) defaults() error {
+	if _, ok := _c.mutation.Interval(); !ok {
+		v := recurrencerule.DefaultInterval
+		_c.mutation.SetInterval(v)
+	}
+	if _, ok := _c.mutation.ScheduledFrom(); !ok {
+		if recurrencerule.DefaultScheduledFrom == nil {
+			return fmt.Errorf("ent: uninitialized recurrencerule.DefaultScheduledFrom (forgotten import ent/runtime?)")
+		}
+		v := recurrencerule.DefaultScheduledFrom()
+		_c.mutation.SetScheduledFrom(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if recurrencerule.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized recurrencerule.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := recurrencerule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if recurrencerule.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized recurrencerule.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := recurrencerule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RecurrenceRuleCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "RecurrenceRule.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "RecurrenceRule.account_id"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "RecurrenceRule.amount"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "RecurrenceRule.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := recurrencerule.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "RecurrenceRule.currency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Frequency(); !ok {
+		return &ValidationError{Name: "frequency", err: errors.New(`ent: missing required field "RecurrenceRule.frequency"`)}
+	}
+	if v, ok := _c.mutation.Frequency(); ok {
+		if err := recurrencerule.FrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "frequency", err: fmt.Errorf(`ent: validator failed for field "RecurrenceRule.frequency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Interval(); !ok {
+		return &ValidationError{Name: "interval", err: errors.New(`ent: missing required field "RecurrenceRule.interval"`)}
+	}
+	if v, ok := _c.mutation.Interval(); ok {
+		if err := recurrencerule.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "RecurrenceRule.interval": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartsOn(); !ok {
+		return &ValidationError{Name: "starts_on", err: errors.New(`ent: missing required field "RecurrenceRule.starts_on"`)}
+	}
+	if _, ok := _c.mutation.ScheduledFrom(); !ok {
+		return &ValidationError{Name: "scheduled_from", err: errors.New(`ent: missing required field "RecurrenceRule.scheduled_from"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RecurrenceRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RecurrenceRule.updated_at"`)}
+	}
+	if len(_c.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "RecurrenceRule.workspace"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "RecurrenceRule.account"`)}
+	}
+	return nil
+}
+
+func (_c *RecurrenceRuleCreate) sqlSave(ctx context.Context) (*RecurrenceRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RecurrenceRuleCreate) createSpec() (*RecurrenceRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RecurrenceRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(recurrencerule.Table, sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(recurrencerule.FieldAmount, field.TypeOther, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(recurrencerule.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Payee(); ok {
+		_spec.SetField(recurrencerule.FieldPayee, field.TypeString, value)
+		_node.Payee = value
+	}
+	if value, ok := _c.mutation.Memo(); ok {
+		_spec.SetField(recurrencerule.FieldMemo, field.TypeString, value)
+		_node.Memo = value
+	}
+	if value, ok := _c.mutation.Frequency(); ok {
+		_spec.SetField(recurrencerule.FieldFrequency, field.TypeEnum, value)
+		_node.Frequency = value
+	}
+	if value, ok := _c.mutation.Interval(); ok {
+		_spec.SetField(recurrencerule.FieldInterval, field.TypeInt, value)
+		_node.Interval = value
+	}
+	if value, ok := _c.mutation.ByDay(); ok {
+		_spec.SetField(recurrencerule.FieldByDay, field.TypeJSON, value)
+		_node.ByDay = value
+	}
+	if value, ok := _c.mutation.StartsOn(); ok {
+		_spec.SetField(recurrencerule.FieldStartsOn, field.TypeTime, value)
+		_node.StartsOn = value
+	}
+	if value, ok := _c.mutation.EndsOn(); ok {
+		_spec.SetField(recurrencerule.FieldEndsOn, field.TypeTime, value)
+		_node.EndsOn = &value
+	}
+	if value, ok := _c.mutation.ScheduledFrom(); ok {
+		_spec.SetField(recurrencerule.FieldScheduledFrom, field.TypeTime, value)
+		_node.ScheduledFrom = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(recurrencerule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(recurrencerule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.WorkspaceTable,
+			Columns: []string{recurrencerule.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.AccountTable,
+			Columns: []string{recurrencerule.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.CategoryTable,
+			Columns: []string{recurrencerule.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CategoryID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LinkedPayeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.LinkedPayeeTable,
+			Columns: []string{recurrencerule.LinkedPayeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PayeeID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OccurrencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recurrencerule.OccurrencesTable,
+			Columns: []string{recurrencerule.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrenceoccurrence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RecurrenceRuleCreateBulk is the builder for creating many RecurrenceRule entities in bulk.
+type RecurrenceRuleCreateBulk struct {
+	config
+	err      error
+	builders []*RecurrenceRuleCreate
+}
+
+// Save creates the RecurrenceRule entities in the database.
+func (_c *RecurrenceRuleCreateBulk) Save(ctx context.Context) ([]*RecurrenceRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RecurrenceRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RecurrenceRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RecurrenceRuleCreateBulk) SaveX(ctx context.Context) []*RecurrenceRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecurrenceRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecurrenceRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,942 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/ent/payee"
+	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/recurrenceoccurrence"
+	"backend/internal/infrastructure/ent/recurrencerule"
+	"backend/internal/infrastructure/ent/workspace"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RecurrenceRuleQuery is the builder for querying RecurrenceRule entities.
+type RecurrenceRuleQuery struct {
+	config
+	ctx             *QueryContext
+	order           []recurrencerule.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.RecurrenceRule
+	withWorkspace   *WorkspaceQuery
+	withAccount     *AccountQuery
+	withCategory    *CategoryQuery
+	withLinkedPayee *PayeeQuery
+	withOccurrences *RecurrenceOccurrenceQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RecurrenceRuleQuery builder.
+func (_q *RecurrenceRuleQuery) Where(ps ...predicate.RecurrenceRule) *RecurrenceRuleQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *RecurrenceRuleQuery) Limit(limit int) *RecurrenceRuleQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *RecurrenceRuleQuery) Offset(offset int) *RecurrenceRuleQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *RecurrenceRuleQuery) Unique(unique bool) *RecurrenceRuleQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *RecurrenceRuleQuery) Order(o ...recurrencerule.OrderOption) *RecurrenceRuleQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryWorkspace chains the current query on the "workspace" edge.
+func (_q *RecurrenceRuleQuery) QueryWorkspace() *WorkspaceQuery {
+	query := (&WorkspaceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recurrencerule.Table, recurrencerule.FieldID, selector),
+			sqlgraph.To(workspace.Table, workspace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recurrencerule.WorkspaceTable, recurrencerule.WorkspaceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccount chains the current query on the "account" edge.
+func (_q *RecurrenceRuleQuery) QueryAccount() *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recurrencerule.Table, recurrencerule.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recurrencerule.AccountTable, recurrencerule.AccountColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCategory chains the current query on the "category" edge.
+func (_q *RecurrenceRuleQuery) QueryCategory() *CategoryQuery {
+	query := (&CategoryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recurrencerule.Table, recurrencerule.FieldID, selector),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recurrencerule.CategoryTable, recurrencerule.CategoryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLinkedPayee chains the current query on the "linked_payee" edge.
+func (_q *RecurrenceRuleQuery) QueryLinkedPayee() *PayeeQuery {
+	query := (&PayeeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recurrencerule.Table, recurrencerule.FieldID, selector),
+			sqlgraph.To(payee.Table, payee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recurrencerule.LinkedPayeeTable, recurrencerule.LinkedPayeeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOccurrences chains the current query on the "occurrences" edge.
+func (_q *RecurrenceRuleQuery) QueryOccurrences() *RecurrenceOccurrenceQuery {
+	query := (&RecurrenceOccurrenceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recurrencerule.Table, recurrencerule.FieldID, selector),
+			sqlgraph.To(recurrenceoccurrence.Table, recurrenceoccurrence.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, recurrencerule.OccurrencesTable, recurrencerule.OccurrencesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RecurrenceRule entity from the query.
+// Returns a *NotFoundError when no RecurrenceRule was found.
+func (_q *RecurrenceRuleQuery) First(ctx context.Context) (*RecurrenceRule, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{recurrencerule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *RecurrenceRuleQuery) FirstX(ctx context.Context) *RecurrenceRule {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RecurrenceRule ID from the query.
+// Returns a *NotFoundError when no RecurrenceRule ID was found.
+func (_q *RecurrenceRuleQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{recurrencerule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *RecurrenceRuleQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RecurrenceRule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RecurrenceRule entity is found.
+// Returns a *NotFoundError when no RecurrenceRule entities are found.
+func (_q *RecurrenceRuleQuery) Only(ctx context.Context) (*RecurrenceRule, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{recurrencerule.Label}
+	default:
+		return nil, &NotSingularError{recurrencerule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *RecurrenceRuleQuery) OnlyX(ctx context.Context) *RecurrenceRule {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RecurrenceRule ID in the query.
+// Returns a *NotSingularError when more than one RecurrenceRule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *RecurrenceRuleQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{recurrencerule.Label}
+	default:
+		err = &NotSingularError{recurrencerule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *RecurrenceRuleQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RecurrenceRules.
+func (_q *RecurrenceRuleQuery) All(ctx context.Context) ([]*RecurrenceRule, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RecurrenceRule, *RecurrenceRuleQuery]()
+	return withInterceptors[[]*RecurrenceRule](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *RecurrenceRuleQuery) AllX(ctx context.Context) []*RecurrenceRule {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RecurrenceRule IDs.
+func (_q *RecurrenceRuleQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(recurrencerule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *RecurrenceRuleQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *RecurrenceRuleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*RecurrenceRuleQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *RecurrenceRuleQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *RecurrenceRuleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *RecurrenceRuleQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RecurrenceRuleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *RecurrenceRuleQuery) Clone() *RecurrenceRuleQuery {
+	if _q == nil {
+		return nil
+	}
+	return &RecurrenceRuleQuery{
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]recurrencerule.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.RecurrenceRule{}, _q.predicates...),
+		withWorkspace:   _q.withWorkspace.Clone(),
+		withAccount:     _q.withAccount.Clone(),
+		withCategory:    _q.withCategory.Clone(),
+		withLinkedPayee: _q.withLinkedPayee.Clone(),
+		withOccurrences: _q.withOccurrences.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithWorkspace tells the query-builder to eager-load the nodes that are connected to
+// the "workspace" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RecurrenceRuleQuery) WithWorkspace(opts ...func(*WorkspaceQuery)) *RecurrenceRuleQuery {
+	query := (&WorkspaceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWorkspace = query
+	return _q
+}
+
+// WithAccount tells the query-builder to eager-load the nodes that are connected to
+// the "account" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RecurrenceRuleQuery) WithAccount(opts ...func(*AccountQuery)) *RecurrenceRuleQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAccount = query
+	return _q
+}
+
+// WithCategory tells the query-builder to eager-load the nodes that are connected to
+// the "category" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RecurrenceRuleQuery) WithCategory(opts ...func(*CategoryQuery)) *RecurrenceRuleQuery {
+	query := (&CategoryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCategory = query
+	return _q
+}
+
+// WithLinkedPayee tells the query-builder to eager-load the nodes that are connected to
+// the "linked_payee" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RecurrenceRuleQuery) WithLinkedPayee(opts ...func(*PayeeQuery)) *RecurrenceRuleQuery {
+	query := (&PayeeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLinkedPayee = query
+	return _q
+}
+
+// WithOccurrences tells the query-builder to eager-load the nodes that are connected to
+// the "occurrences" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RecurrenceRuleQuery) WithOccurrences(opts ...func(*RecurrenceOccurrenceQuery)) *RecurrenceRuleQuery {
+	query := (&RecurrenceOccurrenceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOccurrences = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		WorkspaceID int `json:"workspace_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RecurrenceRule.Query().
+//		GroupBy(recurrencerule.FieldWorkspaceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *RecurrenceRuleQuery) GroupBy(field string, fields ...string) *RecurrenceRuleGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RecurrenceRuleGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = recurrencerule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		WorkspaceID int `json:"workspace_id,omitempty"`
+//	}
+//
+//	client.RecurrenceRule.Query().
+//		Select(recurrencerule.FieldWorkspaceID).
+//		Scan(ctx, &v)
+func (_q *RecurrenceRuleQuery) Select(fields ...string) *RecurrenceRuleSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &RecurrenceRuleSelect{RecurrenceRuleQuery: _q}
+	sbuild.label = recurrencerule.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RecurrenceRuleSelect configured with the given aggregations.
+func (_q *RecurrenceRuleQuery) Aggregate(fns ...AggregateFunc) *RecurrenceRuleSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *RecurrenceRuleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !recurrencerule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	if recurrencerule.Policy == nil {
+		return errors.New("ent: uninitialized recurrencerule.Policy (forgotten import ent/runtime?)")
+	}
+	if err := recurrencerule.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (_q *RecurrenceRuleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RecurrenceRule, error) {
+	var (
+		nodes       = []*RecurrenceRule{}
+		_spec       = _q.querySpec()
+		loadedTypes = [5]bool{
+			_q.withWorkspace != nil,
+			_q.withAccount != nil,
+			_q.withCategory != nil,
+			_q.withLinkedPayee != nil,
+			_q.withOccurrences != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RecurrenceRule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RecurrenceRule{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withWorkspace; query != nil {
+		if err := _q.loadWorkspace(ctx, query, nodes, nil,
+			func(n *RecurrenceRule, e *Workspace) { n.Edges.Workspace = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAccount; query != nil {
+		if err := _q.loadAccount(ctx, query, nodes, nil,
+			func(n *RecurrenceRule, e *Account) { n.Edges.Account = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCategory; query != nil {
+		if err := _q.loadCategory(ctx, query, nodes, nil,
+			func(n *RecurrenceRule, e *Category) { n.Edges.Category = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLinkedPayee; query != nil {
+		if err := _q.loadLinkedPayee(ctx, query, nodes, nil,
+			func(n *RecurrenceRule, e *Payee) { n.Edges.LinkedPayee = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOccurrences; query != nil {
+		if err := _q.loadOccurrences(ctx, query, nodes,
+			func(n *RecurrenceRule) { n.Edges.Occurrences = []*RecurrenceOccurrence{} },
+			func(n *RecurrenceRule, e *RecurrenceOccurrence) { n.Edges.Occurrences = append(n.Edges.Occurrences, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *RecurrenceRuleQuery) loadWorkspace(ctx context.Context, query *WorkspaceQuery, nodes []*RecurrenceRule, init func(*RecurrenceRule), assign func(*RecurrenceRule, *Workspace)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RecurrenceRule)
+	for i := range nodes {
+		fk := nodes[i].WorkspaceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(workspace.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "workspace_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *RecurrenceRuleQuery) loadAccount(ctx context.Context, query *AccountQuery, nodes []*RecurrenceRule, init func(*RecurrenceRule), assign func(*RecurrenceRule, *Account)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RecurrenceRule)
+	for i := range nodes {
+		fk := nodes[i].AccountID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(account.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "account_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *RecurrenceRuleQuery) loadCategory(ctx context.Context, query *CategoryQuery, nodes []*RecurrenceRule, init func(*RecurrenceRule), assign func(*RecurrenceRule, *Category)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RecurrenceRule)
+	for i := range nodes {
+		if nodes[i].CategoryID == nil {
+			continue
+		}
+		fk := *nodes[i].CategoryID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(category.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "category_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *RecurrenceRuleQuery) loadLinkedPayee(ctx context.Context, query *PayeeQuery, nodes []*RecurrenceRule, init func(*RecurrenceRule), assign func(*RecurrenceRule, *Payee)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RecurrenceRule)
+	for i := range nodes {
+		if nodes[i].PayeeID == nil {
+			continue
+		}
+		fk := *nodes[i].PayeeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(payee.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "payee_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *RecurrenceRuleQuery) loadOccurrences(ctx context.Context, query *RecurrenceOccurrenceQuery, nodes []*RecurrenceRule, init func(*RecurrenceRule), assign func(*RecurrenceRule, *RecurrenceOccurrence)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*RecurrenceRule)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(recurrenceoccurrence.FieldRuleID)
+	}
+	query.Where(predicate.RecurrenceOccurrence(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(recurrencerule.OccurrencesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RuleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "rule_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *RecurrenceRuleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *RecurrenceRuleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(recurrencerule.Table, recurrencerule.Columns, sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, recurrencerule.FieldID)
+		for i := range fields {
+			if fields[i] != recurrencerule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withWorkspace != nil {
+			_spec.Node.AddColumnOnce(recurrencerule.FieldWorkspaceID)
+		}
+		if _q.withAccount != nil {
+			_spec.Node.AddColumnOnce(recurrencerule.FieldAccountID)
+		}
+		if _q.withCategory != nil {
+			_spec.Node.AddColumnOnce(recurrencerule.FieldCategoryID)
+		}
+		if _q.withLinkedPayee != nil {
+			_spec.Node.AddColumnOnce(recurrencerule.FieldPayeeID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *RecurrenceRuleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(recurrencerule.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = recurrencerule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *RecurrenceRuleQuery) Modify(modifiers ...func(s *sql.Selector)) *RecurrenceRuleSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// RecurrenceRuleGroupBy is the group-by builder for RecurrenceRule entities.
+type RecurrenceRuleGroupBy struct {
+	selector
+	build *RecurrenceRuleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *RecurrenceRuleGroupBy) Aggregate(fns ...AggregateFunc) *RecurrenceRuleGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *RecurrenceRuleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RecurrenceRuleQuery, *RecurrenceRuleGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *RecurrenceRuleGroupBy) sqlScan(ctx context.Context, root *RecurrenceRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RecurrenceRuleSelect is the builder for selecting fields of RecurrenceRule entities.
+type RecurrenceRuleSelect struct {
+	*RecurrenceRuleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *RecurrenceRuleSelect) Aggregate(fns ...AggregateFunc) *RecurrenceRuleSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *RecurrenceRuleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RecurrenceRuleQuery, *RecurrenceRuleSelect](ctx, _s.RecurrenceRuleQuery, _s, _s.inters, v)
+}
+
+func (_s *RecurrenceRuleSelect) sqlScan(ctx context.Context, root *RecurrenceRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *RecurrenceRuleSelect) Modify(modifiers ...func(s *sql.Selector)) *RecurrenceRuleSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -0,0 +1,1280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/ent/payee"
+	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/recurrenceoccurrence"
+	"backend/internal/infrastructure/ent/recurrencerule"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// RecurrenceRuleUpdate is the builder for updating RecurrenceRule entities.
+type RecurrenceRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RecurrenceRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RecurrenceRuleUpdate builder.
+func (_u *RecurrenceRuleUpdate) Where(ps ...predicate.RecurrenceRule) *RecurrenceRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *RecurrenceRuleUpdate) SetAccountID(v int) *RecurrenceRuleUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdate) SetNillableAccountID(v *int) *RecurrenceRuleUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *RecurrenceRuleUpdate) SetCategoryID(v int) *RecurrenceRuleUpdate {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdate) SetNillableCategoryID(v *int) *RecurrenceRuleUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *RecurrenceRuleUpdate) ClearCategoryID() *RecurrenceRuleUpdate {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetPayeeID sets the "payee_id" field.
+func (_u *RecurrenceRuleUpdate) SetPayeeID(v int) *RecurrenceRuleUpdate {
+	_u.mutation.SetPayeeID(v)
+	return _u
+}
+
+// SetNillablePayeeID sets the "payee_id" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdate) SetNillablePayeeID(v *int) *RecurrenceRuleUpdate {
+	if v != nil {
+		_u.SetPayeeID(*v)
+	}
+	return _u
+}
+
+// ClearPayeeID clears the value of the "payee_id" field.
+func (_u *RecurrenceRuleUpdate) ClearPayeeID() *RecurrenceRuleUpdate {
+	_u.mutation.ClearPayeeID()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *RecurrenceRuleUpdate) SetAmount(v model.Decimal) *RecurrenceRuleUpdate {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdate) SetNillableAmount(v *model.Decimal) *RecurrenceRuleUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *RecurrenceRuleUpdate) SetCurrency(v string) *RecurrenceRuleUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdate) SetNillableCurrency(v *string) *RecurrenceRuleUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetPayee sets the "payee" field.
+func (_u *RecurrenceRuleUpdate) SetPayee(v string) *RecurrenceRuleUpdate {
+	_u.mutation.SetPayee(v)
+	return _u
+}
+
+// SetNillablePayee sets the "payee" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdate) SetNillablePayee(v *string) *RecurrenceRuleUpdate {
+	if v != nil {
+		_u.SetPayee(*v)
+	}
+	return _u
+}
+
+// ClearPayee clears the value of the "payee" field.
+func (_u *RecurrenceRuleUpdate) ClearPayee() *RecurrenceRuleUpdate {
+	_u.mutation.ClearPayee()
+	return _u
+}
+
+// SetMemo sets the "memo" field.
+func (_u *RecurrenceRuleUpdate) SetMemo(v string) *RecurrenceRuleUpdate {
+	_u.mutation.SetMemo(v)
+	return _u
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdate) SetNillableMemo(v *string) *RecurrenceRuleUpdate {
+	if v != nil {
+		_u.SetMemo(*v)
+	}
+	return _u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (_u *RecurrenceRuleUpdate) ClearMemo() *RecurrenceRuleUpdate {
+	_u.mutation.ClearMemo()
+	return _u
+}
+
+// SetFrequency sets the "frequency" field.
+func (_u *RecurrenceRuleUpdate) SetFrequency(v recurrencerule.Frequency) *RecurrenceRuleUpdate {
+	_u.mutation.SetFrequency(v)
+	return _u
+}
+
+// SetNillableFrequency sets the "frequency" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdate) SetNillableFrequency(v *recurrencerule.Frequency) *RecurrenceRuleUpdate {
+	if v != nil {
+		_u.SetFrequency(*v)
+	}
+	return _u
+}
+
+// SetInterval sets the "interval" field.
+func (_u *RecurrenceRuleUpdate) SetInterval(v int) *RecurrenceRuleUpdate {
+	_u.mutation.ResetInterval()
+	_u.mutation.SetInterval(v)
+	return _u
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdate) SetNillableInterval(v *int) *RecurrenceRuleUpdate {
+	if v != nil {
+		_u.SetInterval(*v)
+	}
+	return _u
+}
+
+// AddInterval adds value to the "interval" field.
+func (_u *RecurrenceRuleUpdate) AddInterval(v int) *RecurrenceRuleUpdate {
+	_u.mutation.AddInterval(v)
+	return _u
+}
+
+// SetByDay sets the "by_day" field.
+func (_u *RecurrenceRuleUpdate) SetByDay(v []string) *RecurrenceRuleUpdate {
+	_u.mutation.SetByDay(v)
+	return _u
+}
+
+// AppendByDay appends value to the "by_day" field.
+func (_u *RecurrenceRuleUpdate) AppendByDay(v []string) *RecurrenceRuleUpdate {
+	_u.mutation.AppendByDay(v)
+	return _u
+}
+
+// ClearByDay clears the value of the "by_day" field.
+func (_u *RecurrenceRuleUpdate) ClearByDay() *RecurrenceRuleUpdate {
+	_u.mutation.ClearByDay()
+	return _u
+}
+
+// SetStartsOn sets the "starts_on" field.
+func (_u *RecurrenceRuleUpdate) SetStartsOn(v time.Time) *RecurrenceRuleUpdate {
+	_u.mutation.SetStartsOn(v)
+	return _u
+}
+
+// SetNillableStartsOn sets the "starts_on" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdate) SetNillableStartsOn(v *time.Time) *RecurrenceRuleUpdate {
+	if v != nil {
+		_u.SetStartsOn(*v)
+	}
+	return _u
+}
+
+// SetEndsOn sets the "ends_on" field.
+func (_u *RecurrenceRuleUpdate) SetEndsOn(v time.Time) *RecurrenceRuleUpdate {
+	_u.mutation.SetEndsOn(v)
+	return _u
+}
+
+// SetNillableEndsOn sets the "ends_on" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdate) SetNillableEndsOn(v *time.Time) *RecurrenceRuleUpdate {
+	if v != nil {
+		_u.SetEndsOn(*v)
+	}
+	return _u
+}
+
+// ClearEndsOn clears the value of the "ends_on" field.
+func (_u *RecurrenceRuleUpdate) ClearEndsOn() *RecurrenceRuleUpdate {
+	_u.mutation.ClearEndsOn()
+	return _u
+}
+
+// SetScheduledFrom sets the "scheduled_from" field.
+func (_u *RecurrenceRuleUpdate) SetScheduledFrom(v time.Time) *RecurrenceRuleUpdate {
+	_u.mutation.SetScheduledFrom(v)
+	return _u
+}
+
+// SetNillableScheduledFrom sets the "scheduled_from" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdate) SetNillableScheduledFrom(v *time.Time) *RecurrenceRuleUpdate {
+	if v != nil {
+		_u.SetScheduledFrom(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RecurrenceRuleUpdate) SetUpdatedAt(v time.Time) *RecurrenceRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *RecurrenceRuleUpdate) SetAccount(v *Account) *RecurrenceRuleUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *RecurrenceRuleUpdate) SetCategory(v *Category) *RecurrenceRuleUpdate {
+	return _u.SetCategoryID(v.ID)
+}
+
+// SetLinkedPayeeID sets the "linked_payee" edge to the Payee entity by ID.
+func (_u *RecurrenceRuleUpdate) SetLinkedPayeeID(id int) *RecurrenceRuleUpdate {
+	_u.mutation.SetLinkedPayeeID(id)
+	return _u
+}
+
+// SetNillableLinkedPayeeID sets the "linked_payee" edge to the Payee entity by ID if the given value is not nil.
+func (_u *RecurrenceRuleUpdate) SetNillableLinkedPayeeID(id *int) *RecurrenceRuleUpdate {
+	if id != nil {
+		_u = _u.SetLinkedPayeeID(*id)
+	}
+	return _u
+}
+
+// SetLinkedPayee sets the "linked_payee" edge to the Payee entity.
+func (_u *RecurrenceRuleUpdate) SetLinkedPayee(v *Payee) *RecurrenceRuleUpdate {
+	return _u.SetLinkedPayeeID(v.ID)
+}
+
+// AddOccurrenceIDs adds the "occurrences" edge to the RecurrenceOccurrence entity by IDs.
+func (_u *RecurrenceRuleUpdate) AddOccurrenceIDs(ids ...int) *RecurrenceRuleUpdate {
+	_u.mutation.AddOccurrenceIDs(ids...)
+	return _u
+}
+
+// AddOccurrences adds the "occurrences" edges to the RecurrenceOccurrence entity.
+func (_u *RecurrenceRuleUpdate) AddOccurrences(v ...*RecurrenceOccurrence) *RecurrenceRuleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOccurrenceIDs(ids...)
+}
+
+// Mutation returns the RecurrenceRuleMutation object of the builder.
+func (_u *RecurrenceRuleUpdate) Mutation() *RecurrenceRuleMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *RecurrenceRuleUpdate) ClearAccount() *RecurrenceRuleUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *RecurrenceRuleUpdate) ClearCategory() *RecurrenceRuleUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// ClearLinkedPayee clears the "linked_payee" edge to the Payee entity.
+func (_u *RecurrenceRuleUpdate) ClearLinkedPayee() *RecurrenceRuleUpdate {
+	_u.mutation.ClearLinkedPayee()
+	return _u
+}
+
+// ClearOccurrences clears all "occurrences" edges to the RecurrenceOccurrence entity.
+func (_u *RecurrenceRuleUpdate) ClearOccurrences() *RecurrenceRuleUpdate {
+	_u.mutation.ClearOccurrences()
+	return _u
+}
+
+// RemoveOccurrenceIDs removes the "occurrences" edge to RecurrenceOccurrence entities by IDs.
+func (_u *RecurrenceRuleUpdate) RemoveOccurrenceIDs(ids ...int) *RecurrenceRuleUpdate {
+	_u.mutation.RemoveOccurrenceIDs(ids...)
+	return _u
+}
+
+// RemoveOccurrences removes "occurrences" edges to RecurrenceOccurrence entities.
+func (_u *RecurrenceRuleUpdate) RemoveOccurrences(v ...*RecurrenceOccurrence) *RecurrenceRuleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOccurrenceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RecurrenceRuleUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RecurrenceRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RecurrenceRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RecurrenceRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RecurrenceRuleUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if recurrencerule.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized recurrencerule.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := recurrencerule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RecurrenceRuleUpdate) check() error {
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := recurrencerule.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "RecurrenceRule.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Frequency(); ok {
+		if err := recurrencerule.FrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "frequency", err: fmt.Errorf(`ent: validator failed for field "RecurrenceRule.frequency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Interval(); ok {
+		if err := recurrencerule.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "RecurrenceRule.interval": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RecurrenceRule.workspace"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RecurrenceRule.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RecurrenceRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RecurrenceRuleUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RecurrenceRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recurrencerule.Table, recurrencerule.Columns, sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(recurrencerule.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(recurrencerule.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payee(); ok {
+		_spec.SetField(recurrencerule.FieldPayee, field.TypeString, value)
+	}
+	if _u.mutation.PayeeCleared() {
+		_spec.ClearField(recurrencerule.FieldPayee, field.TypeString)
+	}
+	if value, ok := _u.mutation.Memo(); ok {
+		_spec.SetField(recurrencerule.FieldMemo, field.TypeString, value)
+	}
+	if _u.mutation.MemoCleared() {
+		_spec.ClearField(recurrencerule.FieldMemo, field.TypeString)
+	}
+	if value, ok := _u.mutation.Frequency(); ok {
+		_spec.SetField(recurrencerule.FieldFrequency, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Interval(); ok {
+		_spec.SetField(recurrencerule.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInterval(); ok {
+		_spec.AddField(recurrencerule.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ByDay(); ok {
+		_spec.SetField(recurrencerule.FieldByDay, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedByDay(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, recurrencerule.FieldByDay, value)
+		})
+	}
+	if _u.mutation.ByDayCleared() {
+		_spec.ClearField(recurrencerule.FieldByDay, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.StartsOn(); ok {
+		_spec.SetField(recurrencerule.FieldStartsOn, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndsOn(); ok {
+		_spec.SetField(recurrencerule.FieldEndsOn, field.TypeTime, value)
+	}
+	if _u.mutation.EndsOnCleared() {
+		_spec.ClearField(recurrencerule.FieldEndsOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ScheduledFrom(); ok {
+		_spec.SetField(recurrencerule.FieldScheduledFrom, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(recurrencerule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.AccountTable,
+			Columns: []string{recurrencerule.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.AccountTable,
+			Columns: []string{recurrencerule.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.CategoryTable,
+			Columns: []string{recurrencerule.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.CategoryTable,
+			Columns: []string{recurrencerule.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinkedPayeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.LinkedPayeeTable,
+			Columns: []string{recurrencerule.LinkedPayeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinkedPayeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.LinkedPayeeTable,
+			Columns: []string{recurrencerule.LinkedPayeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OccurrencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recurrencerule.OccurrencesTable,
+			Columns: []string{recurrencerule.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrenceoccurrence.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOccurrencesIDs(); len(nodes) > 0 && !_u.mutation.OccurrencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recurrencerule.OccurrencesTable,
+			Columns: []string{recurrencerule.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrenceoccurrence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OccurrencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recurrencerule.OccurrencesTable,
+			Columns: []string{recurrencerule.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrenceoccurrence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recurrencerule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RecurrenceRuleUpdateOne is the builder for updating a single RecurrenceRule entity.
+type RecurrenceRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RecurrenceRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *RecurrenceRuleUpdateOne) SetAccountID(v int) *RecurrenceRuleUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdateOne) SetNillableAccountID(v *int) *RecurrenceRuleUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *RecurrenceRuleUpdateOne) SetCategoryID(v int) *RecurrenceRuleUpdateOne {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdateOne) SetNillableCategoryID(v *int) *RecurrenceRuleUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *RecurrenceRuleUpdateOne) ClearCategoryID() *RecurrenceRuleUpdateOne {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetPayeeID sets the "payee_id" field.
+func (_u *RecurrenceRuleUpdateOne) SetPayeeID(v int) *RecurrenceRuleUpdateOne {
+	_u.mutation.SetPayeeID(v)
+	return _u
+}
+
+// SetNillablePayeeID sets the "payee_id" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdateOne) SetNillablePayeeID(v *int) *RecurrenceRuleUpdateOne {
+	if v != nil {
+		_u.SetPayeeID(*v)
+	}
+	return _u
+}
+
+// ClearPayeeID clears the value of the "payee_id" field.
+func (_u *RecurrenceRuleUpdateOne) ClearPayeeID() *RecurrenceRuleUpdateOne {
+	_u.mutation.ClearPayeeID()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *RecurrenceRuleUpdateOne) SetAmount(v model.Decimal) *RecurrenceRuleUpdateOne {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdateOne) SetNillableAmount(v *model.Decimal) *RecurrenceRuleUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *RecurrenceRuleUpdateOne) SetCurrency(v string) *RecurrenceRuleUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdateOne) SetNillableCurrency(v *string) *RecurrenceRuleUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetPayee sets the "payee" field.
+func (_u *RecurrenceRuleUpdateOne) SetPayee(v string) *RecurrenceRuleUpdateOne {
+	_u.mutation.SetPayee(v)
+	return _u
+}
+
+// SetNillablePayee sets the "payee" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdateOne) SetNillablePayee(v *string) *RecurrenceRuleUpdateOne {
+	if v != nil {
+		_u.SetPayee(*v)
+	}
+	return _u
+}
+
+// ClearPayee clears the value of the "payee" field.
+func (_u *RecurrenceRuleUpdateOne) ClearPayee() *RecurrenceRuleUpdateOne {
+	_u.mutation.ClearPayee()
+	return _u
+}
+
+// SetMemo sets the "memo" field.
+func (_u *RecurrenceRuleUpdateOne) SetMemo(v string) *RecurrenceRuleUpdateOne {
+	_u.mutation.SetMemo(v)
+	return _u
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdateOne) SetNillableMemo(v *string) *RecurrenceRuleUpdateOne {
+	if v != nil {
+		_u.SetMemo(*v)
+	}
+	return _u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (_u *RecurrenceRuleUpdateOne) ClearMemo() *RecurrenceRuleUpdateOne {
+	_u.mutation.ClearMemo()
+	return _u
+}
+
+// SetFrequency sets the "frequency" field.
+func (_u *RecurrenceRuleUpdateOne) SetFrequency(v recurrencerule.Frequency) *RecurrenceRuleUpdateOne {
+	_u.mutation.SetFrequency(v)
+	return _u
+}
+
+// SetNillableFrequency sets the "frequency" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdateOne) SetNillableFrequency(v *recurrencerule.Frequency) *RecurrenceRuleUpdateOne {
+	if v != nil {
+		_u.SetFrequency(*v)
+	}
+	return _u
+}
+
+// SetInterval sets the "interval" field.
+func (_u *RecurrenceRuleUpdateOne) SetInterval(v int) *RecurrenceRuleUpdateOne {
+	_u.mutation.ResetInterval()
+	_u.mutation.SetInterval(v)
+	return _u
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdateOne) SetNillableInterval(v *int) *RecurrenceRuleUpdateOne {
+	if v != nil {
+		_u.SetInterval(*v)
+	}
+	return _u
+}
+
+// AddInterval adds value to the "interval" field.
+func (_u *RecurrenceRuleUpdateOne) AddInterval(v int) *RecurrenceRuleUpdateOne {
+	_u.mutation.AddInterval(v)
+	return _u
+}
+
+// SetByDay sets the "by_day" field.
+func (_u *RecurrenceRuleUpdateOne) SetByDay(v []string) *RecurrenceRuleUpdateOne {
+	_u.mutation.SetByDay(v)
+	return _u
+}
+
+// AppendByDay appends value to the "by_day" field.
+func (_u *RecurrenceRuleUpdateOne) AppendByDay(v []string) *RecurrenceRuleUpdateOne {
+	_u.mutation.AppendByDay(v)
+	return _u
+}
+
+// ClearByDay clears the value of the "by_day" field.
+func (_u *RecurrenceRuleUpdateOne) ClearByDay() *RecurrenceRuleUpdateOne {
+	_u.mutation.ClearByDay()
+	return _u
+}
+
+// SetStartsOn sets the "starts_on" field.
+func (_u *RecurrenceRuleUpdateOne) SetStartsOn(v time.Time) *RecurrenceRuleUpdateOne {
+	_u.mutation.SetStartsOn(v)
+	return _u
+}
+
+// SetNillableStartsOn sets the "starts_on" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdateOne) SetNillableStartsOn(v *time.Time) *RecurrenceRuleUpdateOne {
+	if v != nil {
+		_u.SetStartsOn(*v)
+	}
+	return _u
+}
+
+// SetEndsOn sets the "ends_on" field.
+func (_u *RecurrenceRuleUpdateOne) SetEndsOn(v time.Time) *RecurrenceRuleUpdateOne {
+	_u.mutation.SetEndsOn(v)
+	return _u
+}
+
+// SetNillableEndsOn sets the "ends_on" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdateOne) SetNillableEndsOn(v *time.Time) *RecurrenceRuleUpdateOne {
+	if v != nil {
+		_u.SetEndsOn(*v)
+	}
+	return _u
+}
+
+// ClearEndsOn clears the value of the "ends_on" field.
+func (_u *RecurrenceRuleUpdateOne) ClearEndsOn() *RecurrenceRuleUpdateOne {
+	_u.mutation.ClearEndsOn()
+	return _u
+}
+
+// SetScheduledFrom sets the "scheduled_from" field.
+func (_u *RecurrenceRuleUpdateOne) SetScheduledFrom(v time.Time) *RecurrenceRuleUpdateOne {
+	_u.mutation.SetScheduledFrom(v)
+	return _u
+}
+
+// SetNillableScheduledFrom sets the "scheduled_from" field if the given value is not nil.
+func (_u *RecurrenceRuleUpdateOne) SetNillableScheduledFrom(v *time.Time) *RecurrenceRuleUpdateOne {
+	if v != nil {
+		_u.SetScheduledFrom(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RecurrenceRuleUpdateOne) SetUpdatedAt(v time.Time) *RecurrenceRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *RecurrenceRuleUpdateOne) SetAccount(v *Account) *RecurrenceRuleUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *RecurrenceRuleUpdateOne) SetCategory(v *Category) *RecurrenceRuleUpdateOne {
+	return _u.SetCategoryID(v.ID)
+}
+
+// SetLinkedPayeeID sets the "linked_payee" edge to the Payee entity by ID.
+func (_u *RecurrenceRuleUpdateOne) SetLinkedPayeeID(id int) *RecurrenceRuleUpdateOne {
+	_u.mutation.SetLinkedPayeeID(id)
+	return _u
+}
+
+// SetNillableLinkedPayeeID sets the "linked_payee" edge to the Payee entity by ID if the given value is not nil.
+func (_u *RecurrenceRuleUpdateOne) SetNillableLinkedPayeeID(id *int) *RecurrenceRuleUpdateOne {
+	if id != nil {
+		_u = _u.SetLinkedPayeeID(*id)
+	}
+	return _u
+}
+
+// SetLinkedPayee sets the "linked_payee" edge to the Payee entity.
+func (_u *RecurrenceRuleUpdateOne) SetLinkedPayee(v *Payee) *RecurrenceRuleUpdateOne {
+	return _u.SetLinkedPayeeID(v.ID)
+}
+
+// AddOccurrenceIDs adds the "occurrences" edge to the RecurrenceOccurrence entity by IDs.
+func (_u *RecurrenceRuleUpdateOne) AddOccurrenceIDs(ids ...int) *RecurrenceRuleUpdateOne {
+	_u.mutation.AddOccurrenceIDs(ids...)
+	return _u
+}
+
+// AddOccurrences adds the "occurrences" edges to the RecurrenceOccurrence entity.
+func (_u *RecurrenceRuleUpdateOne) AddOccurrences(v ...*RecurrenceOccurrence) *RecurrenceRuleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOccurrenceIDs(ids...)
+}
+
+// Mutation returns the RecurrenceRuleMutation object of the builder.
+func (_u *RecurrenceRuleUpdateOne) Mutation() *RecurrenceRuleMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *RecurrenceRuleUpdateOne) ClearAccount() *RecurrenceRuleUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *RecurrenceRuleUpdateOne) ClearCategory() *RecurrenceRuleUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// ClearLinkedPayee clears the "linked_payee" edge to the Payee entity.
+func (_u *RecurrenceRuleUpdateOne) ClearLinkedPayee() *RecurrenceRuleUpdateOne {
+	_u.mutation.ClearLinkedPayee()
+	return _u
+}
+
+// ClearOccurrences clears all "occurrences" edges to the RecurrenceOccurrence entity.
+func (_u *RecurrenceRuleUpdateOne) ClearOccurrences() *RecurrenceRuleUpdateOne {
+	_u.mutation.ClearOccurrences()
+	return _u
+}
+
+// RemoveOccurrenceIDs removes the "occurrences" edge to RecurrenceOccurrence entities by IDs.
+func (_u *RecurrenceRuleUpdateOne) RemoveOccurrenceIDs(ids ...int) *RecurrenceRuleUpdateOne {
+	_u.mutation.RemoveOccurrenceIDs(ids...)
+	return _u
+}
+
+// RemoveOccurrences removes "occurrences" edges to RecurrenceOccurrence entities.
+func (_u *RecurrenceRuleUpdateOne) RemoveOccurrences(v ...*RecurrenceOccurrence) *RecurrenceRuleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOccurrenceIDs(ids...)
+}
+
+// Where appends a list predicates to the RecurrenceRuleUpdate builder.
+func (_u *RecurrenceRuleUpdateOne) Where(ps ...predicate.RecurrenceRule) *RecurrenceRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RecurrenceRuleUpdateOne) Select(field string, fields ...string) *RecurrenceRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RecurrenceRule entity.
+func (_u *RecurrenceRuleUpdateOne) Save(ctx context.Context) (*RecurrenceRule, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RecurrenceRuleUpdateOne) SaveX(ctx context.Context) *RecurrenceRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RecurrenceRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RecurrenceRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RecurrenceRuleUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if recurrencerule.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized recurrencerule.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := recurrencerule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RecurrenceRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := recurrencerule.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "RecurrenceRule.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Frequency(); ok {
+		if err := recurrencerule.FrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "frequency", err: fmt.Errorf(`ent: validator failed for field "RecurrenceRule.frequency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Interval(); ok {
+		if err := recurrencerule.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "RecurrenceRule.interval": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RecurrenceRule.workspace"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RecurrenceRule.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RecurrenceRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RecurrenceRuleUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RecurrenceRuleUpdateOne) sqlSave(ctx context.Context) (_node *RecurrenceRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recurrencerule.Table, recurrencerule.Columns, sqlgraph.NewFieldSpec(recurrencerule.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RecurrenceRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, recurrencerule.FieldID)
+		for _, f := range fields {
+			if !recurrencerule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != recurrencerule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(recurrencerule.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(recurrencerule.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payee(); ok {
+		_spec.SetField(recurrencerule.FieldPayee, field.TypeString, value)
+	}
+	if _u.mutation.PayeeCleared() {
+		_spec.ClearField(recurrencerule.FieldPayee, field.TypeString)
+	}
+	if value, ok := _u.mutation.Memo(); ok {
+		_spec.SetField(recurrencerule.FieldMemo, field.TypeString, value)
+	}
+	if _u.mutation.MemoCleared() {
+		_spec.ClearField(recurrencerule.FieldMemo, field.TypeString)
+	}
+	if value, ok := _u.mutation.Frequency(); ok {
+		_spec.SetField(recurrencerule.FieldFrequency, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Interval(); ok {
+		_spec.SetField(recurrencerule.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInterval(); ok {
+		_spec.AddField(recurrencerule.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ByDay(); ok {
+		_spec.SetField(recurrencerule.FieldByDay, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedByDay(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, recurrencerule.FieldByDay, value)
+		})
+	}
+	if _u.mutation.ByDayCleared() {
+		_spec.ClearField(recurrencerule.FieldByDay, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.StartsOn(); ok {
+		_spec.SetField(recurrencerule.FieldStartsOn, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndsOn(); ok {
+		_spec.SetField(recurrencerule.FieldEndsOn, field.TypeTime, value)
+	}
+	if _u.mutation.EndsOnCleared() {
+		_spec.ClearField(recurrencerule.FieldEndsOn, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ScheduledFrom(); ok {
+		_spec.SetField(recurrencerule.FieldScheduledFrom, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(recurrencerule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.AccountTable,
+			Columns: []string{recurrencerule.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.AccountTable,
+			Columns: []string{recurrencerule.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.CategoryTable,
+			Columns: []string{recurrencerule.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.CategoryTable,
+			Columns: []string{recurrencerule.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinkedPayeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.LinkedPayeeTable,
+			Columns: []string{recurrencerule.LinkedPayeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinkedPayeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurrencerule.LinkedPayeeTable,
+			Columns: []string{recurrencerule.LinkedPayeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OccurrencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recurrencerule.OccurrencesTable,
+			Columns: []string{recurrencerule.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrenceoccurrence.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOccurrencesIDs(); len(nodes) > 0 && !_u.mutation.OccurrencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recurrencerule.OccurrencesTable,
+			Columns: []string{recurrencerule.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrenceoccurrence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OccurrencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   recurrencerule.OccurrencesTable,
+			Columns: []string{recurrencerule.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrenceoccurrence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &RecurrenceRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recurrencerule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
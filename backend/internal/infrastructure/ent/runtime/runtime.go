@@ -3,16 +3,460 @@
 package runtime
 
 import (
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/ent/emailverificationtoken"
+	"backend/internal/infrastructure/ent/importdecision"
+	"backend/internal/infrastructure/ent/importprofile"
+	"backend/internal/infrastructure/ent/journalentry"
+	"backend/internal/infrastructure/ent/membership"
+	"backend/internal/infrastructure/ent/passwordresettoken"
+	"backend/internal/infrastructure/ent/payee"
+	"backend/internal/infrastructure/ent/posting"
+	"backend/internal/infrastructure/ent/recoverycode"
+	"backend/internal/infrastructure/ent/recurrenceoccurrence"
+	"backend/internal/infrastructure/ent/recurrencerule"
 	"backend/internal/infrastructure/ent/schema"
+	"backend/internal/infrastructure/ent/session"
+	"backend/internal/infrastructure/ent/statementimport"
+	"backend/internal/infrastructure/ent/tag"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/transactionsplit"
+	"backend/internal/infrastructure/ent/transfer"
 	"backend/internal/infrastructure/ent/user"
 	"backend/internal/infrastructure/ent/workspace"
+	"backend/internal/infrastructure/ent/workspaceinvitation"
+	"context"
 	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accountMixin := schema.Account{}.Mixin()
+	account.Policy = privacy.NewPolicies(accountMixin[0], schema.Account{})
+	account.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := account.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	accountFields := schema.Account{}.Fields()
+	_ = accountFields
+	// accountDescName is the schema descriptor for name field.
+	accountDescName := accountFields[0].Descriptor()
+	// account.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	account.NameValidator = accountDescName.Validators[0].(func(string) error)
+	// accountDescCurrency is the schema descriptor for currency field.
+	accountDescCurrency := accountFields[3].Descriptor()
+	// account.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	account.CurrencyValidator = accountDescCurrency.Validators[0].(func(string) error)
+	// accountDescArchived is the schema descriptor for archived field.
+	accountDescArchived := accountFields[7].Descriptor()
+	// account.DefaultArchived holds the default value on creation for the archived field.
+	account.DefaultArchived = accountDescArchived.Default.(bool)
+	// accountDescCreatedAt is the schema descriptor for created_at field.
+	accountDescCreatedAt := accountFields[8].Descriptor()
+	// account.DefaultCreatedAt holds the default value on creation for the created_at field.
+	account.DefaultCreatedAt = accountDescCreatedAt.Default.(func() time.Time)
+	// accountDescUpdatedAt is the schema descriptor for updated_at field.
+	accountDescUpdatedAt := accountFields[9].Descriptor()
+	// account.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	account.DefaultUpdatedAt = accountDescUpdatedAt.Default.(func() time.Time)
+	// account.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	account.UpdateDefaultUpdatedAt = accountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	categoryMixin := schema.Category{}.Mixin()
+	category.Policy = privacy.NewPolicies(categoryMixin[0], schema.Category{})
+	category.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := category.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	categoryFields := schema.Category{}.Fields()
+	_ = categoryFields
+	// categoryDescName is the schema descriptor for name field.
+	categoryDescName := categoryFields[1].Descriptor()
+	// category.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	category.NameValidator = categoryDescName.Validators[0].(func(string) error)
+	// categoryDescColor is the schema descriptor for color field.
+	categoryDescColor := categoryFields[3].Descriptor()
+	// category.ColorValidator is a validator for the "color" field. It is called by the builders before save.
+	category.ColorValidator = categoryDescColor.Validators[0].(func(string) error)
+	// categoryDescArchived is the schema descriptor for archived field.
+	categoryDescArchived := categoryFields[5].Descriptor()
+	// category.DefaultArchived holds the default value on creation for the archived field.
+	category.DefaultArchived = categoryDescArchived.Default.(bool)
+	// categoryDescCreatedAt is the schema descriptor for created_at field.
+	categoryDescCreatedAt := categoryFields[6].Descriptor()
+	// category.DefaultCreatedAt holds the default value on creation for the created_at field.
+	category.DefaultCreatedAt = categoryDescCreatedAt.Default.(func() time.Time)
+	// categoryDescUpdatedAt is the schema descriptor for updated_at field.
+	categoryDescUpdatedAt := categoryFields[7].Descriptor()
+	// category.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	category.DefaultUpdatedAt = categoryDescUpdatedAt.Default.(func() time.Time)
+	// category.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	category.UpdateDefaultUpdatedAt = categoryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	emailverificationtokenFields := schema.EmailVerificationToken{}.Fields()
+	_ = emailverificationtokenFields
+	// emailverificationtokenDescTokenHash is the schema descriptor for token_hash field.
+	emailverificationtokenDescTokenHash := emailverificationtokenFields[0].Descriptor()
+	// emailverificationtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	emailverificationtoken.TokenHashValidator = emailverificationtokenDescTokenHash.Validators[0].(func(string) error)
+	// emailverificationtokenDescCreatedAt is the schema descriptor for created_at field.
+	emailverificationtokenDescCreatedAt := emailverificationtokenFields[4].Descriptor()
+	// emailverificationtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailverificationtoken.DefaultCreatedAt = emailverificationtokenDescCreatedAt.Default.(func() time.Time)
+	importdecisionMixin := schema.ImportDecision{}.Mixin()
+	importdecision.Policy = privacy.NewPolicies(importdecisionMixin[0], schema.ImportDecision{})
+	importdecision.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := importdecision.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	importdecisionFields := schema.ImportDecision{}.Fields()
+	_ = importdecisionFields
+	// importdecisionDescFingerprint is the schema descriptor for fingerprint field.
+	importdecisionDescFingerprint := importdecisionFields[2].Descriptor()
+	// importdecision.FingerprintValidator is a validator for the "fingerprint" field. It is called by the builders before save.
+	importdecision.FingerprintValidator = importdecisionDescFingerprint.Validators[0].(func(string) error)
+	// importdecisionDescCreatedAt is the schema descriptor for created_at field.
+	importdecisionDescCreatedAt := importdecisionFields[5].Descriptor()
+	// importdecision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	importdecision.DefaultCreatedAt = importdecisionDescCreatedAt.Default.(func() time.Time)
+	importprofileMixin := schema.ImportProfile{}.Mixin()
+	importprofile.Policy = privacy.NewPolicies(importprofileMixin[0], schema.ImportProfile{})
+	importprofile.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := importprofile.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	importprofileFields := schema.ImportProfile{}.Fields()
+	_ = importprofileFields
+	// importprofileDescName is the schema descriptor for name field.
+	importprofileDescName := importprofileFields[1].Descriptor()
+	// importprofile.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	importprofile.NameValidator = importprofileDescName.Validators[0].(func(string) error)
+	// importprofileDescCreatedAt is the schema descriptor for created_at field.
+	importprofileDescCreatedAt := importprofileFields[3].Descriptor()
+	// importprofile.DefaultCreatedAt holds the default value on creation for the created_at field.
+	importprofile.DefaultCreatedAt = importprofileDescCreatedAt.Default.(func() time.Time)
+	// importprofileDescUpdatedAt is the schema descriptor for updated_at field.
+	importprofileDescUpdatedAt := importprofileFields[4].Descriptor()
+	// importprofile.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	importprofile.DefaultUpdatedAt = importprofileDescUpdatedAt.Default.(func() time.Time)
+	// importprofile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	importprofile.UpdateDefaultUpdatedAt = importprofileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	journalentryMixin := schema.JournalEntry{}.Mixin()
+	journalentry.Policy = privacy.NewPolicies(journalentryMixin[0], schema.JournalEntry{})
+	journalentry.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := journalentry.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	journalentryFields := schema.JournalEntry{}.Fields()
+	_ = journalentryFields
+	// journalentryDescCreatedAt is the schema descriptor for created_at field.
+	journalentryDescCreatedAt := journalentryFields[3].Descriptor()
+	// journalentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	journalentry.DefaultCreatedAt = journalentryDescCreatedAt.Default.(func() time.Time)
+	membershipFields := schema.Membership{}.Fields()
+	_ = membershipFields
+	// membershipDescJoinedAt is the schema descriptor for joined_at field.
+	membershipDescJoinedAt := membershipFields[3].Descriptor()
+	// membership.DefaultJoinedAt holds the default value on creation for the joined_at field.
+	membership.DefaultJoinedAt = membershipDescJoinedAt.Default.(func() time.Time)
+	passwordresettokenFields := schema.PasswordResetToken{}.Fields()
+	_ = passwordresettokenFields
+	// passwordresettokenDescTokenHash is the schema descriptor for token_hash field.
+	passwordresettokenDescTokenHash := passwordresettokenFields[0].Descriptor()
+	// passwordresettoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	passwordresettoken.TokenHashValidator = passwordresettokenDescTokenHash.Validators[0].(func(string) error)
+	// passwordresettokenDescCreatedAt is the schema descriptor for created_at field.
+	passwordresettokenDescCreatedAt := passwordresettokenFields[4].Descriptor()
+	// passwordresettoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passwordresettoken.DefaultCreatedAt = passwordresettokenDescCreatedAt.Default.(func() time.Time)
+	payeeMixin := schema.Payee{}.Mixin()
+	payee.Policy = privacy.NewPolicies(payeeMixin[0], schema.Payee{})
+	payee.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := payee.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	payeeFields := schema.Payee{}.Fields()
+	_ = payeeFields
+	// payeeDescName is the schema descriptor for name field.
+	payeeDescName := payeeFields[0].Descriptor()
+	// payee.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	payee.NameValidator = payeeDescName.Validators[0].(func(string) error)
+	// payeeDescCreatedAt is the schema descriptor for created_at field.
+	payeeDescCreatedAt := payeeFields[5].Descriptor()
+	// payee.DefaultCreatedAt holds the default value on creation for the created_at field.
+	payee.DefaultCreatedAt = payeeDescCreatedAt.Default.(func() time.Time)
+	// payeeDescUpdatedAt is the schema descriptor for updated_at field.
+	payeeDescUpdatedAt := payeeFields[6].Descriptor()
+	// payee.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	payee.DefaultUpdatedAt = payeeDescUpdatedAt.Default.(func() time.Time)
+	// payee.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	payee.UpdateDefaultUpdatedAt = payeeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	postingMixin := schema.Posting{}.Mixin()
+	posting.Policy = privacy.NewPolicies(postingMixin[0], schema.Posting{})
+	posting.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := posting.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	postingFields := schema.Posting{}.Fields()
+	_ = postingFields
+	// postingDescCurrency is the schema descriptor for currency field.
+	postingDescCurrency := postingFields[3].Descriptor()
+	// posting.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	posting.CurrencyValidator = postingDescCurrency.Validators[0].(func(string) error)
+	recoverycodeFields := schema.RecoveryCode{}.Fields()
+	_ = recoverycodeFields
+	// recoverycodeDescCodeHash is the schema descriptor for code_hash field.
+	recoverycodeDescCodeHash := recoverycodeFields[0].Descriptor()
+	// recoverycode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	recoverycode.CodeHashValidator = recoverycodeDescCodeHash.Validators[0].(func(string) error)
+	// recoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	recoverycodeDescCreatedAt := recoverycodeFields[3].Descriptor()
+	// recoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recoverycode.DefaultCreatedAt = recoverycodeDescCreatedAt.Default.(func() time.Time)
+	recurrenceoccurrenceMixin := schema.RecurrenceOccurrence{}.Mixin()
+	recurrenceoccurrence.Policy = privacy.NewPolicies(recurrenceoccurrenceMixin[0], schema.RecurrenceOccurrence{})
+	recurrenceoccurrence.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := recurrenceoccurrence.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	recurrenceoccurrenceFields := schema.RecurrenceOccurrence{}.Fields()
+	_ = recurrenceoccurrenceFields
+	// recurrenceoccurrenceDescCreatedAt is the schema descriptor for created_at field.
+	recurrenceoccurrenceDescCreatedAt := recurrenceoccurrenceFields[4].Descriptor()
+	// recurrenceoccurrence.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recurrenceoccurrence.DefaultCreatedAt = recurrenceoccurrenceDescCreatedAt.Default.(func() time.Time)
+	// recurrenceoccurrenceDescUpdatedAt is the schema descriptor for updated_at field.
+	recurrenceoccurrenceDescUpdatedAt := recurrenceoccurrenceFields[5].Descriptor()
+	// recurrenceoccurrence.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	recurrenceoccurrence.DefaultUpdatedAt = recurrenceoccurrenceDescUpdatedAt.Default.(func() time.Time)
+	// recurrenceoccurrence.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	recurrenceoccurrence.UpdateDefaultUpdatedAt = recurrenceoccurrenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	recurrenceruleMixin := schema.RecurrenceRule{}.Mixin()
+	recurrencerule.Policy = privacy.NewPolicies(recurrenceruleMixin[0], schema.RecurrenceRule{})
+	recurrencerule.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := recurrencerule.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	recurrenceruleFields := schema.RecurrenceRule{}.Fields()
+	_ = recurrenceruleFields
+	// recurrenceruleDescCurrency is the schema descriptor for currency field.
+	recurrenceruleDescCurrency := recurrenceruleFields[4].Descriptor()
+	// recurrencerule.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	recurrencerule.CurrencyValidator = recurrenceruleDescCurrency.Validators[0].(func(string) error)
+	// recurrenceruleDescInterval is the schema descriptor for interval field.
+	recurrenceruleDescInterval := recurrenceruleFields[8].Descriptor()
+	// recurrencerule.DefaultInterval holds the default value on creation for the interval field.
+	recurrencerule.DefaultInterval = recurrenceruleDescInterval.Default.(int)
+	// recurrencerule.IntervalValidator is a validator for the "interval" field. It is called by the builders before save.
+	recurrencerule.IntervalValidator = recurrenceruleDescInterval.Validators[0].(func(int) error)
+	// recurrenceruleDescScheduledFrom is the schema descriptor for scheduled_from field.
+	recurrenceruleDescScheduledFrom := recurrenceruleFields[12].Descriptor()
+	// recurrencerule.DefaultScheduledFrom holds the default value on creation for the scheduled_from field.
+	recurrencerule.DefaultScheduledFrom = recurrenceruleDescScheduledFrom.Default.(func() time.Time)
+	// recurrenceruleDescCreatedAt is the schema descriptor for created_at field.
+	recurrenceruleDescCreatedAt := recurrenceruleFields[13].Descriptor()
+	// recurrencerule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recurrencerule.DefaultCreatedAt = recurrenceruleDescCreatedAt.Default.(func() time.Time)
+	// recurrenceruleDescUpdatedAt is the schema descriptor for updated_at field.
+	recurrenceruleDescUpdatedAt := recurrenceruleFields[14].Descriptor()
+	// recurrencerule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	recurrencerule.DefaultUpdatedAt = recurrenceruleDescUpdatedAt.Default.(func() time.Time)
+	// recurrencerule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	recurrencerule.UpdateDefaultUpdatedAt = recurrenceruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescTokenHash is the schema descriptor for token_hash field.
+	sessionDescTokenHash := sessionFields[0].Descriptor()
+	// session.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	session.TokenHashValidator = sessionDescTokenHash.Validators[0].(func(string) error)
+	// sessionDescUserAgent is the schema descriptor for user_agent field.
+	sessionDescUserAgent := sessionFields[3].Descriptor()
+	// session.DefaultUserAgent holds the default value on creation for the user_agent field.
+	session.DefaultUserAgent = sessionDescUserAgent.Default.(string)
+	// sessionDescIPAddress is the schema descriptor for ip_address field.
+	sessionDescIPAddress := sessionFields[4].Descriptor()
+	// session.DefaultIPAddress holds the default value on creation for the ip_address field.
+	session.DefaultIPAddress = sessionDescIPAddress.Default.(string)
+	// sessionDescLastSeenAt is the schema descriptor for last_seen_at field.
+	sessionDescLastSeenAt := sessionFields[5].Descriptor()
+	// session.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	session.DefaultLastSeenAt = sessionDescLastSeenAt.Default.(func() time.Time)
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[7].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	// sessionDescUpdatedAt is the schema descriptor for updated_at field.
+	sessionDescUpdatedAt := sessionFields[8].Descriptor()
+	// session.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	session.DefaultUpdatedAt = sessionDescUpdatedAt.Default.(func() time.Time)
+	// session.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	session.UpdateDefaultUpdatedAt = sessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	statementimportMixin := schema.StatementImport{}.Mixin()
+	statementimport.Policy = privacy.NewPolicies(statementimportMixin[0], schema.StatementImport{})
+	statementimport.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := statementimport.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	statementimportFields := schema.StatementImport{}.Fields()
+	_ = statementimportFields
+	// statementimportDescCreatedCount is the schema descriptor for created_count field.
+	statementimportDescCreatedCount := statementimportFields[5].Descriptor()
+	// statementimport.DefaultCreatedCount holds the default value on creation for the created_count field.
+	statementimport.DefaultCreatedCount = statementimportDescCreatedCount.Default.(int)
+	// statementimportDescSkippedCount is the schema descriptor for skipped_count field.
+	statementimportDescSkippedCount := statementimportFields[6].Descriptor()
+	// statementimport.DefaultSkippedCount holds the default value on creation for the skipped_count field.
+	statementimport.DefaultSkippedCount = statementimportDescSkippedCount.Default.(int)
+	// statementimportDescMergedCount is the schema descriptor for merged_count field.
+	statementimportDescMergedCount := statementimportFields[7].Descriptor()
+	// statementimport.DefaultMergedCount holds the default value on creation for the merged_count field.
+	statementimport.DefaultMergedCount = statementimportDescMergedCount.Default.(int)
+	// statementimportDescFailedCount is the schema descriptor for failed_count field.
+	statementimportDescFailedCount := statementimportFields[8].Descriptor()
+	// statementimport.DefaultFailedCount holds the default value on creation for the failed_count field.
+	statementimport.DefaultFailedCount = statementimportDescFailedCount.Default.(int)
+	// statementimportDescCreatedAt is the schema descriptor for created_at field.
+	statementimportDescCreatedAt := statementimportFields[10].Descriptor()
+	// statementimport.DefaultCreatedAt holds the default value on creation for the created_at field.
+	statementimport.DefaultCreatedAt = statementimportDescCreatedAt.Default.(func() time.Time)
+	// statementimportDescUpdatedAt is the schema descriptor for updated_at field.
+	statementimportDescUpdatedAt := statementimportFields[11].Descriptor()
+	// statementimport.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	statementimport.DefaultUpdatedAt = statementimportDescUpdatedAt.Default.(func() time.Time)
+	// statementimport.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	statementimport.UpdateDefaultUpdatedAt = statementimportDescUpdatedAt.UpdateDefault.(func() time.Time)
+	tagMixin := schema.Tag{}.Mixin()
+	tag.Policy = privacy.NewPolicies(tagMixin[0], schema.Tag{})
+	tag.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := tag.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[0].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
+	// tagDescColor is the schema descriptor for color field.
+	tagDescColor := tagFields[1].Descriptor()
+	// tag.ColorValidator is a validator for the "color" field. It is called by the builders before save.
+	tag.ColorValidator = tagDescColor.Validators[0].(func(string) error)
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagFields[2].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
+	// tagDescUpdatedAt is the schema descriptor for updated_at field.
+	tagDescUpdatedAt := tagFields[3].Descriptor()
+	// tag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tag.DefaultUpdatedAt = tagDescUpdatedAt.Default.(func() time.Time)
+	// tag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tag.UpdateDefaultUpdatedAt = tagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	transactionMixin := schema.Transaction{}.Mixin()
+	transaction.Policy = privacy.NewPolicies(transactionMixin[0], schema.Transaction{})
+	transaction.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := transaction.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	transactionFields := schema.Transaction{}.Fields()
+	_ = transactionFields
+	// transactionDescCurrency is the schema descriptor for currency field.
+	transactionDescCurrency := transactionFields[5].Descriptor()
+	// transaction.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	transaction.CurrencyValidator = transactionDescCurrency.Validators[0].(func(string) error)
+	// transactionDescCreatedAt is the schema descriptor for created_at field.
+	transactionDescCreatedAt := transactionFields[13].Descriptor()
+	// transaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	transaction.DefaultCreatedAt = transactionDescCreatedAt.Default.(func() time.Time)
+	// transactionDescUpdatedAt is the schema descriptor for updated_at field.
+	transactionDescUpdatedAt := transactionFields[14].Descriptor()
+	// transaction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	transaction.DefaultUpdatedAt = transactionDescUpdatedAt.Default.(func() time.Time)
+	// transaction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	transaction.UpdateDefaultUpdatedAt = transactionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	transactionsplitMixin := schema.TransactionSplit{}.Mixin()
+	transactionsplit.Policy = privacy.NewPolicies(transactionsplitMixin[0], schema.TransactionSplit{})
+	transactionsplit.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := transactionsplit.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	transactionsplitFields := schema.TransactionSplit{}.Fields()
+	_ = transactionsplitFields
+	// transactionsplitDescCurrency is the schema descriptor for currency field.
+	transactionsplitDescCurrency := transactionsplitFields[3].Descriptor()
+	// transactionsplit.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	transactionsplit.CurrencyValidator = transactionsplitDescCurrency.Validators[0].(func(string) error)
+	transferMixin := schema.Transfer{}.Mixin()
+	transfer.Policy = privacy.NewPolicies(transferMixin[0], schema.Transfer{})
+	transfer.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := transfer.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	transferFields := schema.Transfer{}.Fields()
+	_ = transferFields
+	// transferDescCreatedAt is the schema descriptor for created_at field.
+	transferDescCreatedAt := transferFields[4].Descriptor()
+	// transfer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	transfer.DefaultCreatedAt = transferDescCreatedAt.Default.(func() time.Time)
 	userHooks := schema.User{}.Hooks()
 	user.Hooks[0] = userHooks[0]
 	userFields := schema.User{}.Fields()
@@ -39,12 +483,18 @@ func init() {
 	userDescPasswordHash := userFields[1].Descriptor()
 	// user.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
 	user.PasswordHashValidator = userDescPasswordHash.Validators[0].(func(string) error)
+	// userDescTwoFactorFailedAttempts is the schema descriptor for two_factor_failed_attempts field.
+	userDescTwoFactorFailedAttempts := userFields[6].Descriptor()
+	// user.DefaultTwoFactorFailedAttempts holds the default value on creation for the two_factor_failed_attempts field.
+	user.DefaultTwoFactorFailedAttempts = userDescTwoFactorFailedAttempts.Default.(int)
+	// user.TwoFactorFailedAttemptsValidator is a validator for the "two_factor_failed_attempts" field. It is called by the builders before save.
+	user.TwoFactorFailedAttemptsValidator = userDescTwoFactorFailedAttempts.Validators[0].(func(int) error)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[2].Descriptor()
+	userDescCreatedAt := userFields[8].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[3].Descriptor()
+	userDescUpdatedAt := userFields[9].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -55,16 +505,47 @@ func init() {
 	workspaceDescName := workspaceFields[0].Descriptor()
 	// workspace.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	workspace.NameValidator = workspaceDescName.Validators[0].(func(string) error)
+	// workspaceDescRequireVerifiedEmail is the schema descriptor for require_verified_email field.
+	workspaceDescRequireVerifiedEmail := workspaceFields[1].Descriptor()
+	// workspace.DefaultRequireVerifiedEmail holds the default value on creation for the require_verified_email field.
+	workspace.DefaultRequireVerifiedEmail = workspaceDescRequireVerifiedEmail.Default.(bool)
 	// workspaceDescCreatedAt is the schema descriptor for created_at field.
-	workspaceDescCreatedAt := workspaceFields[1].Descriptor()
+	workspaceDescCreatedAt := workspaceFields[2].Descriptor()
 	// workspace.DefaultCreatedAt holds the default value on creation for the created_at field.
 	workspace.DefaultCreatedAt = workspaceDescCreatedAt.Default.(func() time.Time)
 	// workspaceDescUpdatedAt is the schema descriptor for updated_at field.
-	workspaceDescUpdatedAt := workspaceFields[2].Descriptor()
+	workspaceDescUpdatedAt := workspaceFields[3].Descriptor()
 	// workspace.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	workspace.DefaultUpdatedAt = workspaceDescUpdatedAt.Default.(func() time.Time)
 	// workspace.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	workspace.UpdateDefaultUpdatedAt = workspaceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	workspaceinvitationMixin := schema.WorkspaceInvitation{}.Mixin()
+	workspaceinvitation.Policy = privacy.NewPolicies(workspaceinvitationMixin[0], schema.WorkspaceInvitation{})
+	workspaceinvitation.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := workspaceinvitation.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	workspaceinvitationHooks := schema.WorkspaceInvitation{}.Hooks()
+
+	workspaceinvitation.Hooks[1] = workspaceinvitationHooks[0]
+	workspaceinvitationFields := schema.WorkspaceInvitation{}.Fields()
+	_ = workspaceinvitationFields
+	// workspaceinvitationDescEmail is the schema descriptor for email field.
+	workspaceinvitationDescEmail := workspaceinvitationFields[0].Descriptor()
+	// workspaceinvitation.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	workspaceinvitation.EmailValidator = workspaceinvitationDescEmail.Validators[0].(func(string) error)
+	// workspaceinvitationDescTokenHash is the schema descriptor for token_hash field.
+	workspaceinvitationDescTokenHash := workspaceinvitationFields[2].Descriptor()
+	// workspaceinvitation.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	workspaceinvitation.TokenHashValidator = workspaceinvitationDescTokenHash.Validators[0].(func(string) error)
+	// workspaceinvitationDescCreatedAt is the schema descriptor for created_at field.
+	workspaceinvitationDescCreatedAt := workspaceinvitationFields[8].Descriptor()
+	// workspaceinvitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	workspaceinvitation.DefaultCreatedAt = workspaceinvitationDescCreatedAt.Default.(func() time.Time)
 }
 
 const (
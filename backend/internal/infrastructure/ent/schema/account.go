@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"time"
+
+	"backend/internal/domain/model"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Account holds the schema definition for the Account entity.
+// It is a bank account, credit card, cash wallet, loan or investment account of a workspace,
+// or one of the income, expense and transfer accounts that balance transactions in the journal.
+type Account struct {
+	ent.Schema
+}
+
+// Mixin of the Account.
+func (Account) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceOwnedMixin{},
+	}
+}
+
+// Fields of the Account.
+func (Account) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty(),
+		field.Enum("type").
+			Values("bank", "credit_card", "cash", "loan", "investment", "income", "expense", "transfer"),
+		field.String("institution").
+			Optional(), // Bank or broker name; empty for cash
+		field.String("currency").
+			Match(currencyCodePattern).
+			Immutable(), // ISO 4217 code; balances are never converted
+		field.Other("opening_balance", model.Decimal{}).
+			SchemaType(amountSchemaType), // In major units of the currency
+		field.Time("opened_on").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "date"}),
+		field.Time("closed_on").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "date"}),
+		field.Bool("archived").
+			Default(false), // Hidden from pickers but kept for history
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Account.
+func (Account) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).
+			Ref("accounts").
+			Field("workspace_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.To("transactions", Transaction.Type), // Accounts with transactions cannot be deleted
+		edge.To("postings", Posting.Type),
+		edge.To("recurrence_rules", RecurrenceRule.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("statement_imports", StatementImport.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("import_profiles", ImportProfile.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("import_decisions", ImportDecision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Account.
+func (Account) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "archived"),
+		// One income, one expense and one transfer account per currency
+		index.Fields("workspace_id", "type", "currency").
+			Unique().
+			Annotations(entsql.IndexWhere("type IN ('income', 'expense', 'transfer')")),
+	}
+}
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"regexp"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// colorPattern matches #RRGGBB colors
+var colorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// Category holds the schema definition for the Category entity.
+// Categories form a tree per workspace, e.g. Food > Groceries > Organic.
+type Category struct {
+	ent.Schema
+}
+
+// Mixin of the Category.
+func (Category) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceOwnedMixin{},
+	}
+}
+
+// Fields of the Category.
+func (Category) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("parent_id").
+			Optional().
+			Nillable(), // Empty for top-level categories
+		field.String("name").
+			NotEmpty(),
+		field.Enum("kind").
+			Values("income", "expense", "transfer").
+			Immutable(), // Always the same as the parent's
+		field.String("color").
+			Optional().
+			Match(colorPattern),
+		field.String("icon").
+			Optional(),
+		field.Bool("archived").
+			Default(false), // Hidden from pickers but kept for history
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Category.
+func (Category) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).
+			Ref("categories").
+			Field("workspace_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.To("children", Category.Type).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+		edge.To("transactions", Transaction.Type),
+		edge.To("splits", TransactionSplit.Type),
+		edge.To("payees", Payee.Type),
+		edge.To("recurrence_rules", RecurrenceRule.Type),
+	}
+}
+
+// Indexes of the Category.
+func (Category) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "parent_id"),
+	}
+}
@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ImportDecision holds the schema definition for the ImportDecision entity.
+// It records what became of a statement row on commit, imported as a new transaction or merged
+// into an existing one, so that importing the same row again creates nothing.
+type ImportDecision struct {
+	ent.Schema
+}
+
+// Mixin of the ImportDecision.
+func (ImportDecision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceOwnedMixin{},
+	}
+}
+
+// Fields of the ImportDecision.
+func (ImportDecision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("account_id").
+			Immutable(),
+		field.Int("statement_import_id").
+			Optional().
+			Nillable(), // Cleared when the statement import is deleted
+		field.String("fingerprint").
+			NotEmpty().
+			Immutable(), // Hash of the row's fields, see service.StatementRowFingerprints
+		field.Enum("action").
+			Values("imported", "merged").
+			Immutable(),
+		field.Int("transaction_id").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ImportDecision.
+func (ImportDecision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).
+			Ref("import_decisions").
+			Field("workspace_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("account", Account.Type).
+			Ref("import_decisions").
+			Field("account_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("statement_import", StatementImport.Type).
+			Ref("decisions").
+			Field("statement_import_id").
+			Unique(),
+		edge.From("transaction", Transaction.Type).
+			Ref("import_decisions").
+			Field("transaction_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the ImportDecision.
+func (ImportDecision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "fingerprint").
+			Unique(),
+		index.Fields("transaction_id"),
+	}
+}
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"backend/internal/domain/model"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ImportProfile holds the schema definition for the ImportProfile entity.
+// It is a saved CSV column mapping of an account, so that statements of the same bank
+// import without mapping the columns again.
+type ImportProfile struct {
+	ent.Schema
+}
+
+// Mixin of the ImportProfile.
+func (ImportProfile) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceOwnedMixin{},
+	}
+}
+
+// Fields of the ImportProfile.
+func (ImportProfile) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("account_id").
+			Immutable(),
+		field.String("name").
+			NotEmpty(),
+		field.JSON("mapping", model.CSVMapping{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ImportProfile.
+func (ImportProfile) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).
+			Ref("import_profiles").
+			Field("workspace_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("account", Account.Type).
+			Ref("import_profiles").
+			Field("account_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the ImportProfile.
+func (ImportProfile) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "name").
+			Unique(),
+	}
+}
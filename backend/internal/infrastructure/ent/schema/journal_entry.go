@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// JournalEntry holds the schema definition for the JournalEntry entity.
+// It is a balanced group of postings recorded together in the double-entry journal.
+type JournalEntry struct {
+	ent.Schema
+}
+
+// Mixin of the JournalEntry.
+func (JournalEntry) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceOwnedMixin{},
+	}
+}
+
+// Fields of the JournalEntry.
+func (JournalEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("transaction_id").
+			Optional().
+			Nillable(), // Transaction the entry was recorded for
+		field.Time("posted_on").
+			SchemaType(map[string]string{dialect.Postgres: "date"}),
+		field.String("memo").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the JournalEntry.
+func (JournalEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).
+			Ref("journal_entries").
+			Field("workspace_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("transaction", Transaction.Type).
+			Ref("journal_entry").
+			Field("transaction_id").
+			Unique(),
+		edge.To("postings", Posting.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the JournalEntry.
+func (JournalEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("transaction_id").
+			Unique(),
+	}
+}
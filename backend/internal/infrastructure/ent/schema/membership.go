@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Membership holds the schema definition for the Membership entity.
+// It is the edge schema between Workspace and User and carries the member's role.
+type Membership struct {
+	ent.Schema
+}
+
+// Fields of the Membership.
+func (Membership) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("workspace_id"),
+		field.Int("user_id"),
+		field.Enum("role").
+			Values("owner", "admin", "editor", "viewer").
+			Default("viewer"), // Least privilege unless set explicitly
+		field.Time("joined_at").
+			Default(time.Now).
+			Immutable(),
+		field.Int("invited_by_id").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Membership.
+func (Membership) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("workspace", Workspace.Type).
+			Field("workspace_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("user", User.Type).
+			Field("user_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("invited_by", User.Type).
+			Field("invited_by_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Membership.
+func (Membership) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "user_id").
+			Unique(),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PasswordResetToken holds the schema definition for the PasswordResetToken entity.
+type PasswordResetToken struct {
+	ent.Schema
+}
+
+// Fields of the PasswordResetToken.
+func (PasswordResetToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token_hash").
+			Unique().
+			NotEmpty().
+			Immutable().
+			Sensitive(), // SHA-256 of the emailed token, never the token itself
+		field.Int("user_id").
+			Immutable(),
+		field.Time("expires_at").
+			Immutable(),
+		field.Time("used_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PasswordResetToken.
+func (PasswordResetToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("password_reset_tokens").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the PasswordResetToken.
+func (PasswordResetToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
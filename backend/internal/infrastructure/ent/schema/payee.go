@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Payee holds the schema definition for the Payee entity.
+// A payee is the counterparty of transactions; its alias patterns resolve raw bank descriptions
+// such as "AMZN MKTP US*2K3" and "Amazon.com" to the same payee.
+type Payee struct {
+	ent.Schema
+}
+
+// Mixin of the Payee.
+func (Payee) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceOwnedMixin{},
+	}
+}
+
+// Fields of the Payee.
+func (Payee) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty(),
+		field.Strings("aliases").
+			Optional(), // Normalized patterns; * matches any run of characters
+		field.Int("default_category_id").
+			Optional().
+			Nillable(), // Assigned to new uncategorized transactions of the payee
+		field.String("logo_url").
+			Optional(),
+		field.String("website").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Payee.
+func (Payee) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).
+			Ref("payees").
+			Field("workspace_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("default_category", Category.Type).
+			Ref("payees").
+			Field("default_category_id").
+			Unique(),
+		edge.To("transactions", Transaction.Type),
+		edge.To("recurrence_rules", RecurrenceRule.Type),
+	}
+}
+
+// Indexes of the Payee.
+func (Payee) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "name").
+			Unique(),
+	}
+}
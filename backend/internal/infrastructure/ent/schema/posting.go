@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"backend/internal/domain/model"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Posting holds the schema definition for the Posting entity.
+// It is one line of a journal entry moving an amount into or out of an account.
+type Posting struct {
+	ent.Schema
+}
+
+// Mixin of the Posting.
+func (Posting) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceOwnedMixin{},
+	}
+}
+
+// Fields of the Posting.
+func (Posting) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("journal_entry_id").
+			Immutable(),
+		field.Int("account_id").
+			Immutable(),
+		field.Other("amount", model.Decimal{}).
+			SchemaType(amountSchemaType). // In major units; positive for debits, negative for credits
+			Immutable(),
+		field.String("currency").
+			Match(currencyCodePattern). // Copied from the account
+			Immutable(),
+	}
+}
+
+// Edges of the Posting.
+func (Posting) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).
+			Ref("postings").
+			Field("workspace_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("journal_entry", JournalEntry.Type).
+			Ref("postings").
+			Field("journal_entry_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("account", Account.Type).
+			Ref("postings").
+			Field("account_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the Posting.
+func (Posting) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("journal_entry_id"),
+		index.Fields("account_id"),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RecoveryCode holds the schema definition for the RecoveryCode entity.
+// Each code can replace a TOTP code once when the authenticator device is unavailable.
+type RecoveryCode struct {
+	ent.Schema
+}
+
+// Fields of the RecoveryCode.
+func (RecoveryCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code_hash").
+			NotEmpty().
+			Immutable().
+			Sensitive(), // SHA-256 of the recovery code, never the code itself
+		field.Int("user_id").
+			Immutable(),
+		field.Time("used_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the RecoveryCode.
+func (RecoveryCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("recovery_codes").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the RecoveryCode.
+func (RecoveryCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "code_hash").
+			Unique(),
+	}
+}
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RecurrenceOccurrence holds the schema definition for the RecurrenceOccurrence entity.
+// It is one due date of a recurrence rule; the unique (rule_id, due_on) index keeps materialization
+// idempotent, and skipped or posted occurrences are never scheduled again.
+type RecurrenceOccurrence struct {
+	ent.Schema
+}
+
+// Mixin of the RecurrenceOccurrence.
+func (RecurrenceOccurrence) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceOwnedMixin{},
+	}
+}
+
+// Fields of the RecurrenceOccurrence.
+func (RecurrenceOccurrence) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("rule_id").
+			Immutable(),
+		field.Time("due_on").
+			Immutable().
+			SchemaType(map[string]string{dialect.Postgres: "date"}),
+		field.Enum("status").
+			Values("scheduled", "posted", "skipped").
+			Default("scheduled"),
+		field.Int("transaction_id").
+			Optional().
+			Nillable(), // Set once posted; cleared if the transaction is deleted
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the RecurrenceOccurrence.
+func (RecurrenceOccurrence) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).
+			Ref("recurrence_occurrences").
+			Field("workspace_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("rule", RecurrenceRule.Type).
+			Ref("occurrences").
+			Field("rule_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("transaction", Transaction.Type).
+			Ref("recurrence_occurrence").
+			Field("transaction_id").
+			Unique(),
+	}
+}
+
+// Indexes of the RecurrenceOccurrence.
+func (RecurrenceOccurrence) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("rule_id", "due_on").
+			Unique(),
+		index.Fields("workspace_id", "status", "due_on"),
+	}
+}
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"time"
+
+	"backend/internal/domain/model"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// RecurrenceRule holds the schema definition for the RecurrenceRule entity.
+// It describes a transaction that repeats on an RRULE-style schedule, such as rent on the 1st
+// of every month; its occurrences are materialized ahead of time and posted when due.
+type RecurrenceRule struct {
+	ent.Schema
+}
+
+// Mixin of the RecurrenceRule.
+func (RecurrenceRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceOwnedMixin{},
+	}
+}
+
+// Fields of the RecurrenceRule.
+func (RecurrenceRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("account_id"),
+		field.Int("category_id").
+			Optional().
+			Nillable(),
+		field.Int("payee_id").
+			Optional().
+			Nillable(),
+		field.Other("amount", model.Decimal{}).
+			SchemaType(amountSchemaType), // In major units; negative for outflows
+		field.String("currency").
+			Match(currencyCodePattern), // Copied from the account
+		field.String("payee").
+			Optional(),
+		field.String("memo").
+			Optional(),
+		field.Enum("frequency").
+			Values("daily", "weekly", "monthly", "yearly"),
+		field.Int("interval").
+			Positive().
+			Default(1),
+		field.Strings("by_day").
+			Optional(), // e.g. ["MO", "TH"] weekly or ["-1FR"] monthly
+		field.Time("starts_on").
+			SchemaType(map[string]string{dialect.Postgres: "date"}),
+		field.Time("ends_on").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "date"}), // Inclusive
+		field.Time("scheduled_from").
+			Default(time.Now).
+			SchemaType(map[string]string{dialect.Postgres: "date"}), // Day the rule was created or last edited; nothing earlier is scheduled
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the RecurrenceRule.
+func (RecurrenceRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).
+			Ref("recurrence_rules").
+			Field("workspace_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("account", Account.Type).
+			Ref("recurrence_rules").
+			Field("account_id").
+			Unique().
+			Required(),
+		edge.From("category", Category.Type).
+			Ref("recurrence_rules").
+			Field("category_id").
+			Unique(),
+		edge.From("linked_payee", Payee.Type).
+			Ref("recurrence_rules").
+			Field("payee_id").
+			Unique(),
+		edge.To("occurrences", RecurrenceOccurrence.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
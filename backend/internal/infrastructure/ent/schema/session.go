@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Session holds the schema definition for the Session entity.
+// Only the opaque token travels in the cookie; everything else stays server-side.
+type Session struct {
+	ent.Schema
+}
+
+// Fields of the Session.
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token_hash").
+			Unique().
+			NotEmpty().
+			Immutable().
+			Sensitive(), // SHA-256 of the cookie token, never the token itself
+		field.Int("user_id").
+			Optional().
+			Nillable(),
+		field.Bytes("data"),
+		field.String("user_agent").
+			Default(""),
+		field.String("ip_address").
+			Default(""),
+		field.Time("last_seen_at").
+			Default(time.Now),
+		field.Time("expires_at"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Session.
+func (Session) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("sessions").
+			Field("user_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Session.
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// StatementImport holds the schema definition for the StatementImport entity.
+// It is a bank statement file uploaded for an account. The file is kept until the import is
+// committed so that it can be previewed with different column mappings first.
+type StatementImport struct {
+	ent.Schema
+}
+
+// Mixin of the StatementImport.
+func (StatementImport) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceOwnedMixin{},
+	}
+}
+
+// Fields of the StatementImport.
+func (StatementImport) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("account_id").
+			Immutable(),
+		field.Enum("format").
+			Values("csv", "ofx", "camt053", "mt940").
+			Immutable(),
+		field.String("filename").
+			Optional().
+			Immutable(),
+		field.Bytes("content").
+			Immutable(),
+		field.Enum("status").
+			Values("pending", "committed").
+			Default("pending"),
+		field.Int("created_count").
+			Default(0), // Transactions created on commit
+		field.Int("skipped_count").
+			Default(0), // Rows skipped on commit because they were imported before
+		field.Int("merged_count").
+			Default(0), // Rows merged on commit into transactions the account already had
+		field.Int("failed_count").
+			Default(0), // Rows rejected on commit
+		field.Time("committed_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the StatementImport.
+func (StatementImport) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).
+			Ref("statement_imports").
+			Field("workspace_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("account", Account.Type).
+			Ref("statement_imports").
+			Field("account_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.To("decisions", ImportDecision.Type),
+	}
+}
+
+// Indexes of the StatementImport.
+func (StatementImport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "created_at"),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Tag holds the schema definition for the Tag entity.
+// Tags are free-form labels such as "vacation-2026" or "reimbursable" that cut across categories;
+// a transaction can carry any number of them.
+type Tag struct {
+	ent.Schema
+}
+
+// Mixin of the Tag.
+func (Tag) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceOwnedMixin{},
+	}
+}
+
+// Fields of the Tag.
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty(),
+		field.String("color").
+			Optional().
+			Match(colorPattern),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Tag.
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).
+			Ref("tags").
+			Field("workspace_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.To("transactions", Transaction.Type),
+		edge.To("splits", TransactionSplit.Type),
+	}
+}
+
+// Indexes of the Tag.
+func (Tag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "name").
+			Unique(),
+	}
+}
@@ -0,0 +1,124 @@
+package schema
+
+import (
+	"time"
+
+	"backend/internal/domain/model"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Transaction holds the schema definition for the Transaction entity.
+// It is a single money movement on an account.
+type Transaction struct {
+	ent.Schema
+}
+
+// Mixin of the Transaction.
+func (Transaction) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceOwnedMixin{},
+	}
+}
+
+// Fields of the Transaction.
+func (Transaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("account_id"),
+		field.Int("category_id").
+			Optional().
+			Nillable(),
+		field.Int("payee_id").
+			Optional().
+			Nillable(),
+		field.Time("posted_on").
+			SchemaType(map[string]string{dialect.Postgres: "date"}),
+		field.Other("amount", model.Decimal{}).
+			SchemaType(amountSchemaType), // In major units; negative for outflows
+		field.String("currency").
+			Match(currencyCodePattern), // Copied from the account
+		field.String("payee").
+			Optional(), // Description as entered or imported
+		field.String("memo").
+			Optional(),
+		field.String("external_id").
+			Optional().
+			Nillable(), // Identifier the bank gave the transaction, e.g. the OFX FITID
+		field.Time("value_date").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "date"}), // As stated by the imported statement
+		field.String("counterparty_iban").
+			Optional(),
+		field.String("end_to_end_id").
+			Optional(), // Reference the payer gave a SEPA payment
+		field.Enum("status").
+			Values("uncleared", "cleared", "reconciled").
+			Default("uncleared"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Transaction.
+func (Transaction) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).
+			Ref("transactions").
+			Field("workspace_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("account", Account.Type).
+			Ref("transactions").
+			Field("account_id").
+			Unique().
+			Required(),
+		edge.From("category", Category.Type).
+			Ref("transactions").
+			Field("category_id").
+			Unique(),
+		edge.From("linked_payee", Payee.Type).
+			Ref("transactions").
+			Field("payee_id").
+			Unique(),
+		edge.From("tags", Tag.Type).
+			Ref("transactions"),
+		edge.To("splits", TransactionSplit.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("journal_entry", JournalEntry.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("outgoing_transfer", Transfer.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("incoming_transfer", Transfer.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("recurrence_occurrence", RecurrenceOccurrence.Type).
+			Unique(),
+		edge.To("import_decisions", ImportDecision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)), // Deleted transactions are imported again
+	}
+}
+
+// Indexes of the Transaction.
+func (Transaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "posted_on"),
+		index.Fields("account_id", "posted_on"),
+		index.Fields("category_id"),
+		index.Fields("payee_id"),
+		index.Fields("account_id", "external_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"backend/internal/domain/model"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TransactionSplit holds the schema definition for the TransactionSplit entity.
+// It is one line of a transaction divided across several categories; the lines sum to the transaction amount.
+type TransactionSplit struct {
+	ent.Schema
+}
+
+// Mixin of the TransactionSplit.
+func (TransactionSplit) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceOwnedMixin{},
+	}
+}
+
+// Fields of the TransactionSplit.
+func (TransactionSplit) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("transaction_id").
+			Immutable(),
+		field.Int("category_id").
+			Optional().
+			Nillable(),
+		field.Other("amount", model.Decimal{}).
+			SchemaType(amountSchemaType), // In major units; negative for outflows
+		field.String("currency").
+			Match(currencyCodePattern), // Copied from the transaction
+		field.String("memo").
+			Optional(),
+	}
+}
+
+// Edges of the TransactionSplit.
+func (TransactionSplit) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).
+			Ref("transaction_splits").
+			Field("workspace_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("transaction", Transaction.Type).
+			Ref("splits").
+			Field("transaction_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("category", Category.Type).
+			Ref("splits").
+			Field("category_id").
+			Unique(),
+		edge.From("tags", Tag.Type).
+			Ref("splits"), // Apply to the split's amount only, in addition to the transaction's tags
+	}
+}
+
+// Indexes of the TransactionSplit.
+func (TransactionSplit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("transaction_id"),
+		index.Fields("category_id"),
+	}
+}
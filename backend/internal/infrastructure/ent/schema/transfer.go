@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"backend/internal/domain/model"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Transfer holds the schema definition for the Transfer entity.
+// It links the transaction moving money out of one account of a workspace to the transaction
+// bringing it into another, so that neither counts as income or expense.
+type Transfer struct {
+	ent.Schema
+}
+
+// Mixin of the Transfer.
+func (Transfer) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceOwnedMixin{},
+	}
+}
+
+// Fields of the Transfer.
+func (Transfer) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("from_transaction_id").
+			Unique(),
+		field.Int("to_transaction_id").
+			Unique(),
+		field.Other("from_fee", model.Decimal{}).
+			SchemaType(amountSchemaType), // Charged by the sending account, in its currency; part of the outgoing amount
+		field.Other("to_fee", model.Decimal{}).
+			SchemaType(amountSchemaType), // Charged by the receiving account, in its currency; deducted before the incoming amount
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Transfer.
+func (Transfer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).
+			Ref("transfers").
+			Field("workspace_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("from_transaction", Transaction.Type).
+			Ref("outgoing_transfer").
+			Field("from_transaction_id").
+			Unique().
+			Required(),
+		edge.From("to_transaction", Transaction.Type).
+			Ref("incoming_transfer").
+			Field("to_transaction_id").
+			Unique().
+			Required(),
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -33,6 +34,25 @@ func (User) Fields() []ent.Field {
 		field.String("password_hash").
 			NotEmpty().
 			Sensitive(), // Prevents password hash from being logged
+		field.Time("email_verified_at").
+			Optional().
+			Nillable(),
+		field.String("totp_secret").
+			Optional().
+			Nillable().
+			Sensitive(), // Base32 TOTP shared secret, set during enrollment
+		field.Int64("totp_last_used_step").
+			Optional().
+			Nillable(), // Last accepted TOTP time step, to reject replayed codes
+		field.Time("two_factor_enabled_at").
+			Optional().
+			Nillable(),
+		field.Int("two_factor_failed_attempts").
+			NonNegative().
+			Default(0), // Wrong second-factor codes in a row, across logins
+		field.Time("two_factor_locked_until").
+			Optional().
+			Nillable(), // Second-factor codes are refused until then after too many wrong ones
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -46,19 +66,28 @@ func (User) Fields() []ent.Field {
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("workspaces", Workspace.Type).
-			Ref("users"),
+			Ref("users").
+			Through("memberships", Membership.Type),
+		edge.To("sessions", Session.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("password_reset_tokens", PasswordResetToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("email_verification_tokens", EmailVerificationToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("recovery_codes", RecoveryCode.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
 // Hooks of the User.
 func (User) Hooks() []ent.Hook {
 	return []ent.Hook{
-		// Hook to normalize email to lowercase
+		// Hook to normalize email to trimmed lowercase, as service.NormalizeEmail does
 		func(next ent.Mutator) ent.Mutator {
 			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
 				if email, exists := m.Field("email"); exists {
 					emailStr := email.(string)
-					if err := m.SetField("email", strings.ToLower(emailStr)); err != nil {
+					if err := m.SetField("email", strings.ToLower(strings.TrimSpace(emailStr))); err != nil {
 						return nil, err
 					}
 				}
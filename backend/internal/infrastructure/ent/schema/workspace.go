@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -18,6 +19,8 @@ func (Workspace) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").
 			NotEmpty(),
+		field.Bool("require_verified_email").
+			Default(false), // Members must verify their email before accessing financial data
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -30,6 +33,37 @@ func (Workspace) Fields() []ent.Field {
 // Edges of the Workspace.
 func (Workspace) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("users", User.Type),
+		edge.To("users", User.Type).
+			Through("memberships", Membership.Type),
+		edge.To("invitations", WorkspaceInvitation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("accounts", Account.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("transactions", Transaction.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("transaction_splits", TransactionSplit.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("transfers", Transfer.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("categories", Category.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("payees", Payee.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("recurrence_rules", RecurrenceRule.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("recurrence_occurrences", RecurrenceOccurrence.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("statement_imports", StatementImport.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("import_profiles", ImportProfile.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("import_decisions", ImportDecision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("tags", Tag.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("journal_entries", JournalEntry.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("postings", Posting.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,102 @@
+package schema
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WorkspaceInvitation holds the schema definition for the WorkspaceInvitation entity.
+type WorkspaceInvitation struct {
+	ent.Schema
+}
+
+// Mixin of the WorkspaceInvitation.
+func (WorkspaceInvitation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		WorkspaceOwnedMixin{},
+	}
+}
+
+// Fields of the WorkspaceInvitation.
+func (WorkspaceInvitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").
+			NotEmpty().
+			Immutable(),
+		field.Enum("role").
+			Values("admin", "editor", "viewer"). // Ownership cannot be granted by invitation
+			Immutable(),
+		field.String("token_hash").
+			Unique().
+			NotEmpty().
+			Immutable().
+			Sensitive(), // SHA-256 of the emailed token, never the token itself
+		field.Enum("status").
+			Values("pending", "accepted", "revoked").
+			Default("pending"),
+		field.Time("expires_at").
+			Immutable(),
+		field.Int("invited_by_id").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Int("accepted_by_id").
+			Optional().
+			Nillable(),
+		field.Time("responded_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the WorkspaceInvitation.
+func (WorkspaceInvitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workspace", Workspace.Type).
+			Ref("invitations").
+			Field("workspace_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.To("invited_by", User.Type).
+			Field("invited_by_id").
+			Unique().
+			Immutable(),
+		edge.To("accepted_by", User.Type).
+			Field("accepted_by_id").
+			Unique(),
+	}
+}
+
+// Indexes of the WorkspaceInvitation.
+func (WorkspaceInvitation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "status"),
+	}
+}
+
+// Hooks of the WorkspaceInvitation.
+func (WorkspaceInvitation) Hooks() []ent.Hook {
+	return []ent.Hook{
+		// Hook to normalize email to trimmed lowercase, matching User
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				if email, exists := m.Field("email"); exists {
+					if err := m.SetField("email", strings.ToLower(strings.TrimSpace(email.(string)))); err != nil {
+						return nil, err
+					}
+				}
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"regexp"
+
+	"backend/internal/infrastructure/tenant"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// currencyCodePattern matches ISO 4217 alphabetic currency codes
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// amountSchemaType stores model.Decimal amounts exactly; four decimals cover every ISO 4217 currency
+var amountSchemaType = map[string]string{
+	dialect.Postgres: "numeric(24,4)",
+	dialect.SQLite:   "numeric",
+}
+
+// WorkspaceOwnedMixin adds the workspace_id field to entities that belong to a single workspace
+// and attaches the tenant privacy policy, so every query and mutation is scoped to the workspace
+// in the context. Entities using it must declare the workspace edge on the workspace_id field.
+type WorkspaceOwnedMixin struct {
+	mixin.Schema
+}
+
+// Fields of the WorkspaceOwnedMixin.
+func (WorkspaceOwnedMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("workspace_id").
+			Immutable(),
+	}
+}
+
+// Policy of the WorkspaceOwnedMixin.
+func (WorkspaceOwnedMixin) Policy() ent.Policy {
+	return tenant.Policy()
+}
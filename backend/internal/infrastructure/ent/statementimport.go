@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/statementimport"
+	"backend/internal/infrastructure/ent/workspace"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// StatementImport is the model entity for the StatementImport schema.
+type StatementImport struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID int `json:"account_id,omitempty"`
+	// Format holds the value of the "format" field.
+	Format statementimport.Format `json:"format,omitempty"`
+	// Filename holds the value of the "filename" field.
+	Filename string `json:"filename,omitempty"`
+	// Content holds the value of the "content" field.
+	Content []byte `json:"content,omitempty"`
+	// Status holds the value of the "status" field.
+	Status statementimport.Status `json:"status,omitempty"`
+	// CreatedCount holds the value of the "created_count" field.
+	CreatedCount int `json:"created_count,omitempty"`
+	// SkippedCount holds the value of the "skipped_count" field.
+	SkippedCount int `json:"skipped_count,omitempty"`
+	// MergedCount holds the value of the "merged_count" field.
+	MergedCount int `json:"merged_count,omitempty"`
+	// FailedCount holds the value of the "failed_count" field.
+	FailedCount int `json:"failed_count,omitempty"`
+	// CommittedAt holds the value of the "committed_at" field.
+	CommittedAt *time.Time `json:"committed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StatementImportQuery when eager-loading is set.
+	Edges        StatementImportEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// StatementImportEdges holds the relations/edges for other nodes in the graph.
+type StatementImportEdges struct {
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// Decisions holds the value of the decisions edge.
+	Decisions []*ImportDecision `json:"decisions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StatementImportEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StatementImportEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// DecisionsOrErr returns the Decisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e StatementImportEdges) DecisionsOrErr() ([]*ImportDecision, error) {
+	if e.loadedTypes[2] {
+		return e.Decisions, nil
+	}
+	return nil, &NotLoadedError{edge: "decisions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StatementImport) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case statementimport.FieldContent:
+			values[i] = new([]byte)
+		case statementimport.FieldID, statementimport.FieldWorkspaceID, statementimport.FieldAccountID, statementimport.FieldCreatedCount, statementimport.FieldSkippedCount, statementimport.FieldMergedCount, statementimport.FieldFailedCount:
+			values[i] = new(sql.NullInt64)
+		case statementimport.FieldFormat, statementimport.FieldFilename, statementimport.FieldStatus:
+			values[i] = new(sql.NullString)
+		case statementimport.FieldCommittedAt, statementimport.FieldCreatedAt, statementimport.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StatementImport fields.
+func (_m *StatementImport) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case statementimport.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case statementimport.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				_m.WorkspaceID = int(value.Int64)
+			}
+		case statementimport.FieldAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = int(value.Int64)
+			}
+		case statementimport.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = statementimport.Format(value.String)
+			}
+		case statementimport.FieldFilename:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field filename", values[i])
+			} else if value.Valid {
+				_m.Filename = value.String
+			}
+		case statementimport.FieldContent:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value != nil {
+				_m.Content = *value
+			}
+		case statementimport.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = statementimport.Status(value.String)
+			}
+		case statementimport.FieldCreatedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_count", values[i])
+			} else if value.Valid {
+				_m.CreatedCount = int(value.Int64)
+			}
+		case statementimport.FieldSkippedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field skipped_count", values[i])
+			} else if value.Valid {
+				_m.SkippedCount = int(value.Int64)
+			}
+		case statementimport.FieldMergedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field merged_count", values[i])
+			} else if value.Valid {
+				_m.MergedCount = int(value.Int64)
+			}
+		case statementimport.FieldFailedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_count", values[i])
+			} else if value.Valid {
+				_m.FailedCount = int(value.Int64)
+			}
+		case statementimport.FieldCommittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field committed_at", values[i])
+			} else if value.Valid {
+				_m.CommittedAt = new(time.Time)
+				*_m.CommittedAt = value.Time
+			}
+		case statementimport.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case statementimport.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StatementImport.
+// This includes values selected through modifiers, order, etc.
+func (_m *StatementImport) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWorkspace queries the "workspace" edge of the StatementImport entity.
+func (_m *StatementImport) QueryWorkspace() *WorkspaceQuery {
+	return NewStatementImportClient(_m.config).QueryWorkspace(_m)
+}
+
+// QueryAccount queries the "account" edge of the StatementImport entity.
+func (_m *StatementImport) QueryAccount() *AccountQuery {
+	return NewStatementImportClient(_m.config).QueryAccount(_m)
+}
+
+// QueryDecisions queries the "decisions" edge of the StatementImport entity.
+func (_m *StatementImport) QueryDecisions() *ImportDecisionQuery {
+	return NewStatementImportClient(_m.config).QueryDecisions(_m)
+}
+
+// Update returns a builder for updating this StatementImport.
+// Note that you need to call StatementImport.Unwrap() before calling this method if this StatementImport
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *StatementImport) Update() *StatementImportUpdateOne {
+	return NewStatementImportClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the StatementImport entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *StatementImport) Unwrap() *StatementImport {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StatementImport is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *StatementImport) String() string {
+	var builder strings.Builder
+	builder.WriteString("StatementImport(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Format))
+	builder.WriteString(", ")
+	builder.WriteString("filename=")
+	builder.WriteString(_m.Filename)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Content))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("created_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedCount))
+	builder.WriteString(", ")
+	builder.WriteString("skipped_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SkippedCount))
+	builder.WriteString(", ")
+	builder.WriteString("merged_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MergedCount))
+	builder.WriteString(", ")
+	builder.WriteString("failed_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FailedCount))
+	builder.WriteString(", ")
+	if v := _m.CommittedAt; v != nil {
+		builder.WriteString("committed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StatementImports is a parsable slice of StatementImport.
+type StatementImports []*StatementImport
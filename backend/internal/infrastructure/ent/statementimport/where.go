@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package statementimport
+
+import (
+	"backend/internal/infrastructure/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLTE(FieldID, id))
+}
+
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Filename applies equality check predicate on the "filename" field. It's identical to FilenameEQ.
+func Filename(v string) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldFilename, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v []byte) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldContent, v))
+}
+
+// CreatedCount applies equality check predicate on the "created_count" field. It's identical to CreatedCountEQ.
+func CreatedCount(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldCreatedCount, v))
+}
+
+// SkippedCount applies equality check predicate on the "skipped_count" field. It's identical to SkippedCountEQ.
+func SkippedCount(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldSkippedCount, v))
+}
+
+// MergedCount applies equality check predicate on the "merged_count" field. It's identical to MergedCountEQ.
+func MergedCount(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldMergedCount, v))
+}
+
+// FailedCount applies equality check predicate on the "failed_count" field. It's identical to FailedCountEQ.
+func FailedCount(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldFailedCount, v))
+}
+
+// CommittedAt applies equality check predicate on the "committed_at" field. It's identical to CommittedAtEQ.
+func CommittedAt(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldCommittedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v Format) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v Format) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...Format) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...Format) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// FilenameEQ applies the EQ predicate on the "filename" field.
+func FilenameEQ(v string) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldFilename, v))
+}
+
+// FilenameNEQ applies the NEQ predicate on the "filename" field.
+func FilenameNEQ(v string) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNEQ(FieldFilename, v))
+}
+
+// FilenameIn applies the In predicate on the "filename" field.
+func FilenameIn(vs ...string) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldIn(FieldFilename, vs...))
+}
+
+// FilenameNotIn applies the NotIn predicate on the "filename" field.
+func FilenameNotIn(vs ...string) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNotIn(FieldFilename, vs...))
+}
+
+// FilenameGT applies the GT predicate on the "filename" field.
+func FilenameGT(v string) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGT(FieldFilename, v))
+}
+
+// FilenameGTE applies the GTE predicate on the "filename" field.
+func FilenameGTE(v string) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGTE(FieldFilename, v))
+}
+
+// FilenameLT applies the LT predicate on the "filename" field.
+func FilenameLT(v string) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLT(FieldFilename, v))
+}
+
+// FilenameLTE applies the LTE predicate on the "filename" field.
+func FilenameLTE(v string) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLTE(FieldFilename, v))
+}
+
+// FilenameContains applies the Contains predicate on the "filename" field.
+func FilenameContains(v string) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldContains(FieldFilename, v))
+}
+
+// FilenameHasPrefix applies the HasPrefix predicate on the "filename" field.
+func FilenameHasPrefix(v string) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldHasPrefix(FieldFilename, v))
+}
+
+// FilenameHasSuffix applies the HasSuffix predicate on the "filename" field.
+func FilenameHasSuffix(v string) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldHasSuffix(FieldFilename, v))
+}
+
+// FilenameIsNil applies the IsNil predicate on the "filename" field.
+func FilenameIsNil() predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldIsNull(FieldFilename))
+}
+
+// FilenameNotNil applies the NotNil predicate on the "filename" field.
+func FilenameNotNil() predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNotNull(FieldFilename))
+}
+
+// FilenameEqualFold applies the EqualFold predicate on the "filename" field.
+func FilenameEqualFold(v string) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEqualFold(FieldFilename, v))
+}
+
+// FilenameContainsFold applies the ContainsFold predicate on the "filename" field.
+func FilenameContainsFold(v string) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldContainsFold(FieldFilename, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v []byte) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v []byte) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...[]byte) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...[]byte) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v []byte) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v []byte) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v []byte) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v []byte) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLTE(FieldContent, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// CreatedCountEQ applies the EQ predicate on the "created_count" field.
+func CreatedCountEQ(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldCreatedCount, v))
+}
+
+// CreatedCountNEQ applies the NEQ predicate on the "created_count" field.
+func CreatedCountNEQ(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNEQ(FieldCreatedCount, v))
+}
+
+// CreatedCountIn applies the In predicate on the "created_count" field.
+func CreatedCountIn(vs ...int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldIn(FieldCreatedCount, vs...))
+}
+
+// CreatedCountNotIn applies the NotIn predicate on the "created_count" field.
+func CreatedCountNotIn(vs ...int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNotIn(FieldCreatedCount, vs...))
+}
+
+// CreatedCountGT applies the GT predicate on the "created_count" field.
+func CreatedCountGT(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGT(FieldCreatedCount, v))
+}
+
+// CreatedCountGTE applies the GTE predicate on the "created_count" field.
+func CreatedCountGTE(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGTE(FieldCreatedCount, v))
+}
+
+// CreatedCountLT applies the LT predicate on the "created_count" field.
+func CreatedCountLT(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLT(FieldCreatedCount, v))
+}
+
+// CreatedCountLTE applies the LTE predicate on the "created_count" field.
+func CreatedCountLTE(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLTE(FieldCreatedCount, v))
+}
+
+// SkippedCountEQ applies the EQ predicate on the "skipped_count" field.
+func SkippedCountEQ(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldSkippedCount, v))
+}
+
+// SkippedCountNEQ applies the NEQ predicate on the "skipped_count" field.
+func SkippedCountNEQ(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNEQ(FieldSkippedCount, v))
+}
+
+// SkippedCountIn applies the In predicate on the "skipped_count" field.
+func SkippedCountIn(vs ...int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldIn(FieldSkippedCount, vs...))
+}
+
+// SkippedCountNotIn applies the NotIn predicate on the "skipped_count" field.
+func SkippedCountNotIn(vs ...int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNotIn(FieldSkippedCount, vs...))
+}
+
+// SkippedCountGT applies the GT predicate on the "skipped_count" field.
+func SkippedCountGT(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGT(FieldSkippedCount, v))
+}
+
+// SkippedCountGTE applies the GTE predicate on the "skipped_count" field.
+func SkippedCountGTE(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGTE(FieldSkippedCount, v))
+}
+
+// SkippedCountLT applies the LT predicate on the "skipped_count" field.
+func SkippedCountLT(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLT(FieldSkippedCount, v))
+}
+
+// SkippedCountLTE applies the LTE predicate on the "skipped_count" field.
+func SkippedCountLTE(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLTE(FieldSkippedCount, v))
+}
+
+// MergedCountEQ applies the EQ predicate on the "merged_count" field.
+func MergedCountEQ(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldMergedCount, v))
+}
+
+// MergedCountNEQ applies the NEQ predicate on the "merged_count" field.
+func MergedCountNEQ(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNEQ(FieldMergedCount, v))
+}
+
+// MergedCountIn applies the In predicate on the "merged_count" field.
+func MergedCountIn(vs ...int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldIn(FieldMergedCount, vs...))
+}
+
+// MergedCountNotIn applies the NotIn predicate on the "merged_count" field.
+func MergedCountNotIn(vs ...int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNotIn(FieldMergedCount, vs...))
+}
+
+// MergedCountGT applies the GT predicate on the "merged_count" field.
+func MergedCountGT(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGT(FieldMergedCount, v))
+}
+
+// MergedCountGTE applies the GTE predicate on the "merged_count" field.
+func MergedCountGTE(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGTE(FieldMergedCount, v))
+}
+
+// MergedCountLT applies the LT predicate on the "merged_count" field.
+func MergedCountLT(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLT(FieldMergedCount, v))
+}
+
+// MergedCountLTE applies the LTE predicate on the "merged_count" field.
+func MergedCountLTE(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLTE(FieldMergedCount, v))
+}
+
+// FailedCountEQ applies the EQ predicate on the "failed_count" field.
+func FailedCountEQ(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldFailedCount, v))
+}
+
+// FailedCountNEQ applies the NEQ predicate on the "failed_count" field.
+func FailedCountNEQ(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNEQ(FieldFailedCount, v))
+}
+
+// FailedCountIn applies the In predicate on the "failed_count" field.
+func FailedCountIn(vs ...int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldIn(FieldFailedCount, vs...))
+}
+
+// FailedCountNotIn applies the NotIn predicate on the "failed_count" field.
+func FailedCountNotIn(vs ...int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNotIn(FieldFailedCount, vs...))
+}
+
+// FailedCountGT applies the GT predicate on the "failed_count" field.
+func FailedCountGT(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGT(FieldFailedCount, v))
+}
+
+// FailedCountGTE applies the GTE predicate on the "failed_count" field.
+func FailedCountGTE(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGTE(FieldFailedCount, v))
+}
+
+// FailedCountLT applies the LT predicate on the "failed_count" field.
+func FailedCountLT(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLT(FieldFailedCount, v))
+}
+
+// FailedCountLTE applies the LTE predicate on the "failed_count" field.
+func FailedCountLTE(v int) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLTE(FieldFailedCount, v))
+}
+
+// CommittedAtEQ applies the EQ predicate on the "committed_at" field.
+func CommittedAtEQ(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldCommittedAt, v))
+}
+
+// CommittedAtNEQ applies the NEQ predicate on the "committed_at" field.
+func CommittedAtNEQ(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNEQ(FieldCommittedAt, v))
+}
+
+// CommittedAtIn applies the In predicate on the "committed_at" field.
+func CommittedAtIn(vs ...time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldIn(FieldCommittedAt, vs...))
+}
+
+// CommittedAtNotIn applies the NotIn predicate on the "committed_at" field.
+func CommittedAtNotIn(vs ...time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNotIn(FieldCommittedAt, vs...))
+}
+
+// CommittedAtGT applies the GT predicate on the "committed_at" field.
+func CommittedAtGT(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGT(FieldCommittedAt, v))
+}
+
+// CommittedAtGTE applies the GTE predicate on the "committed_at" field.
+func CommittedAtGTE(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGTE(FieldCommittedAt, v))
+}
+
+// CommittedAtLT applies the LT predicate on the "committed_at" field.
+func CommittedAtLT(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLT(FieldCommittedAt, v))
+}
+
+// CommittedAtLTE applies the LTE predicate on the "committed_at" field.
+func CommittedAtLTE(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLTE(FieldCommittedAt, v))
+}
+
+// CommittedAtIsNil applies the IsNil predicate on the "committed_at" field.
+func CommittedAtIsNil() predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldIsNull(FieldCommittedAt))
+}
+
+// CommittedAtNotNil applies the NotNil predicate on the "committed_at" field.
+func CommittedAtNotNil() predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNotNull(FieldCommittedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.StatementImport {
+	return predicate.StatementImport(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasWorkspace applies the HasEdge predicate on the "workspace" edge.
+func HasWorkspace() predicate.StatementImport {
+	return predicate.StatementImport(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WorkspaceTable, WorkspaceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkspaceWith applies the HasEdge predicate on the "workspace" edge with a given conditions (other predicates).
+func HasWorkspaceWith(preds ...predicate.Workspace) predicate.StatementImport {
+	return predicate.StatementImport(func(s *sql.Selector) {
+		step := newWorkspaceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.StatementImport {
+	return predicate.StatementImport(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.StatementImport {
+	return predicate.StatementImport(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDecisions applies the HasEdge predicate on the "decisions" edge.
+func HasDecisions() predicate.StatementImport {
+	return predicate.StatementImport(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DecisionsTable, DecisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDecisionsWith applies the HasEdge predicate on the "decisions" edge with a given conditions (other predicates).
+func HasDecisionsWith(preds ...predicate.ImportDecision) predicate.StatementImport {
+	return predicate.StatementImport(func(s *sql.Selector) {
+		step := newDecisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StatementImport) predicate.StatementImport {
+	return predicate.StatementImport(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StatementImport) predicate.StatementImport {
+	return predicate.StatementImport(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StatementImport) predicate.StatementImport {
+	return predicate.StatementImport(sql.NotPredicates(p))
+}
@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/importdecision"
+	"backend/internal/infrastructure/ent/statementimport"
+	"backend/internal/infrastructure/ent/workspace"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StatementImportCreate is the builder for creating a StatementImport entity.
+type StatementImportCreate struct {
+	config
+	mutation *StatementImportMutation
+	hooks    []Hook
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *StatementImportCreate) SetWorkspaceID(v int) *StatementImportCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *StatementImportCreate) SetAccountID(v int) *StatementImportCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetFormat sets the "format" field.
+func (_c *StatementImportCreate) SetFormat(v statementimport.Format) *StatementImportCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetFilename sets the "filename" field.
+func (_c *StatementImportCreate) SetFilename(v string) *StatementImportCreate {
+	_c.mutation.SetFilename(v)
+	return _c
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_c *StatementImportCreate) SetNillableFilename(v *string) *StatementImportCreate {
+	if v != nil {
+		_c.SetFilename(*v)
+	}
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *StatementImportCreate) SetContent(v []byte) *StatementImportCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *StatementImportCreate) SetStatus(v statementimport.Status) *StatementImportCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *StatementImportCreate) SetNillableStatus(v *statementimport.Status) *StatementImportCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCreatedCount sets the "created_count" field.
+func (_c *StatementImportCreate) SetCreatedCount(v int) *StatementImportCreate {
+	_c.mutation.SetCreatedCount(v)
+	return _c
+}
+
+// SetNillableCreatedCount sets the "created_count" field if the given value is not nil.
+func (_c *StatementImportCreate) SetNillableCreatedCount(v *int) *StatementImportCreate {
+	if v != nil {
+		_c.SetCreatedCount(*v)
+	}
+	return _c
+}
+
+// SetSkippedCount sets the "skipped_count" field.
+func (_c *StatementImportCreate) SetSkippedCount(v int) *StatementImportCreate {
+	_c.mutation.SetSkippedCount(v)
+	return _c
+}
+
+// SetNillableSkippedCount sets the "skipped_count" field if the given value is not nil.
+func (_c *StatementImportCreate) SetNillableSkippedCount(v *int) *StatementImportCreate {
+	if v != nil {
+		_c.SetSkippedCount(*v)
+	}
+	return _c
+}
+
+// SetMergedCount sets the "merged_count" field.
+func (_c *StatementImportCreate) SetMergedCount(v int) *StatementImportCreate {
+	_c.mutation.SetMergedCount(v)
+	return _c
+}
+
+// SetNillableMergedCount sets the "merged_count" field if the given value is not nil.
+func (_c *StatementImportCreate) SetNillableMergedCount(v *int) *StatementImportCreate {
+	if v != nil {
+		_c.SetMergedCount(*v)
+	}
+	return _c
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (_c *StatementImportCreate) SetFailedCount(v int) *StatementImportCreate {
+	_c.mutation.SetFailedCount(v)
+	return _c
+}
+
+// SetNillableFailedCount sets the "failed_count" field if the given value is not nil.
+func (_c *StatementImportCreate) SetNillableFailedCount(v *int) *StatementImportCreate {
+	if v != nil {
+		_c.SetFailedCount(*v)
+	}
+	return _c
+}
+
+// SetCommittedAt sets the "committed_at" field.
+func (_c *StatementImportCreate) SetCommittedAt(v time.Time) *StatementImportCreate {
+	_c.mutation.SetCommittedAt(v)
+	return _c
+}
+
+// SetNillableCommittedAt sets the "committed_at" field if the given value is not nil.
+func (_c *StatementImportCreate) SetNillableCommittedAt(v *time.Time) *StatementImportCreate {
+	if v != nil {
+		_c.SetCommittedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *StatementImportCreate) SetCreatedAt(v time.Time) *StatementImportCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *StatementImportCreate) SetNillableCreatedAt(v *time.Time) *StatementImportCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *StatementImportCreate) SetUpdatedAt(v time.Time) *StatementImportCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *StatementImportCreate) SetNillableUpdatedAt(v *time.Time) *StatementImportCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_c *StatementImportCreate) SetWorkspace(v *Workspace) *StatementImportCreate {
+	return _c.SetWorkspaceID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *StatementImportCreate) SetAccount(v *Account) *StatementImportCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// AddDecisionIDs adds the "decisions" edge to the ImportDecision entity by IDs.
+func (_c *StatementImportCreate) AddDecisionIDs(ids ...int) *StatementImportCreate {
+	_c.mutation.AddDecisionIDs(ids...)
+	return _c
+}
+
+// AddDecisions adds the "decisions" edges to the ImportDecision entity.
+func (_c *StatementImportCreate) AddDecisions(v ...*ImportDecision) *StatementImportCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDecisionIDs(ids...)
+}
+
+// Mutation returns the StatementImportMutation object of the builder.
+func (_c *StatementImportCreate) Mutation() *StatementImportMutation {
+	return _c.mutation
+}
+
+// Save creates the StatementImport in the database.
+func (_c *StatementImportCreate) Save(ctx context.Context) (*StatementImport, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StatementImportCreate) SaveX(ctx context.Context) *StatementImport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StatementImportCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StatementImportCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StatementImportCreate) defaults() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := statementimport.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedCount(); !ok {
+		v := statementimport.DefaultCreatedCount
+		_c.mutation.SetCreatedCount(v)
+	}
+	if _, ok := _c.mutation.SkippedCount(); !ok {
+		v := statementimport.DefaultSkippedCount
+		_c.mutation.SetSkippedCount(v)
+	}
+	if _, ok := _c.mutation.MergedCount(); !ok {
+		v := statementimport.DefaultMergedCount
+		_c.mutation.SetMergedCount(v)
+	}
+	if _, ok := _c.mutation.FailedCount(); !ok {
+		v := statementimport.DefaultFailedCount
+		_c.mutation.SetFailedCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if statementimport.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized statementimport.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := statementimport.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if statementimport.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized statementimport.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := statementimport.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StatementImportCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "StatementImport.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "StatementImport.account_id"`)}
+	}
+	if _, ok := _c.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "StatementImport.format"`)}
+	}
+	if v, ok := _c.mutation.Format(); ok {
+		if err := statementimport.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "StatementImport.format": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "StatementImport.content"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "StatementImport.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := statementimport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "StatementImport.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedCount(); !ok {
+		return &ValidationError{Name: "created_count", err: errors.New(`ent: missing required field "StatementImport.created_count"`)}
+	}
+	if _, ok := _c.mutation.SkippedCount(); !ok {
+		return &ValidationError{Name: "skipped_count", err: errors.New(`ent: missing required field "StatementImport.skipped_count"`)}
+	}
+	if _, ok := _c.mutation.MergedCount(); !ok {
+		return &ValidationError{Name: "merged_count", err: errors.New(`ent: missing required field "StatementImport.merged_count"`)}
+	}
+	if _, ok := _c.mutation.FailedCount(); !ok {
+		return &ValidationError{Name: "failed_count", err: errors.New(`ent: missing required field "StatementImport.failed_count"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StatementImport.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "StatementImport.updated_at"`)}
+	}
+	if len(_c.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "StatementImport.workspace"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "StatementImport.account"`)}
+	}
+	return nil
+}
+
+func (_c *StatementImportCreate) sqlSave(ctx context.Context) (*StatementImport, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StatementImportCreate) createSpec() (*StatementImport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StatementImport{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(statementimport.Table, sqlgraph.NewFieldSpec(statementimport.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(statementimport.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.Filename(); ok {
+		_spec.SetField(statementimport.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(statementimport.FieldContent, field.TypeBytes, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(statementimport.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CreatedCount(); ok {
+		_spec.SetField(statementimport.FieldCreatedCount, field.TypeInt, value)
+		_node.CreatedCount = value
+	}
+	if value, ok := _c.mutation.SkippedCount(); ok {
+		_spec.SetField(statementimport.FieldSkippedCount, field.TypeInt, value)
+		_node.SkippedCount = value
+	}
+	if value, ok := _c.mutation.MergedCount(); ok {
+		_spec.SetField(statementimport.FieldMergedCount, field.TypeInt, value)
+		_node.MergedCount = value
+	}
+	if value, ok := _c.mutation.FailedCount(); ok {
+		_spec.SetField(statementimport.FieldFailedCount, field.TypeInt, value)
+		_node.FailedCount = value
+	}
+	if value, ok := _c.mutation.CommittedAt(); ok {
+		_spec.SetField(statementimport.FieldCommittedAt, field.TypeTime, value)
+		_node.CommittedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(statementimport.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(statementimport.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   statementimport.WorkspaceTable,
+			Columns: []string{statementimport.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   statementimport.AccountTable,
+			Columns: []string{statementimport.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DecisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   statementimport.DecisionsTable,
+			Columns: []string{statementimport.DecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StatementImportCreateBulk is the builder for creating many StatementImport entities in bulk.
+type StatementImportCreateBulk struct {
+	config
+	err      error
+	builders []*StatementImportCreate
+}
+
+// Save creates the StatementImport entities in the database.
+func (_c *StatementImportCreateBulk) Save(ctx context.Context) ([]*StatementImport, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StatementImport, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StatementImportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StatementImportCreateBulk) SaveX(ctx context.Context) []*StatementImport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StatementImportCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StatementImportCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
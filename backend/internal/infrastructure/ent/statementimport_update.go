@@ -0,0 +1,749 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/importdecision"
+	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/statementimport"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StatementImportUpdate is the builder for updating StatementImport entities.
+type StatementImportUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *StatementImportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the StatementImportUpdate builder.
+func (_u *StatementImportUpdate) Where(ps ...predicate.StatementImport) *StatementImportUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *StatementImportUpdate) SetStatus(v statementimport.Status) *StatementImportUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *StatementImportUpdate) SetNillableStatus(v *statementimport.Status) *StatementImportUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCreatedCount sets the "created_count" field.
+func (_u *StatementImportUpdate) SetCreatedCount(v int) *StatementImportUpdate {
+	_u.mutation.ResetCreatedCount()
+	_u.mutation.SetCreatedCount(v)
+	return _u
+}
+
+// SetNillableCreatedCount sets the "created_count" field if the given value is not nil.
+func (_u *StatementImportUpdate) SetNillableCreatedCount(v *int) *StatementImportUpdate {
+	if v != nil {
+		_u.SetCreatedCount(*v)
+	}
+	return _u
+}
+
+// AddCreatedCount adds value to the "created_count" field.
+func (_u *StatementImportUpdate) AddCreatedCount(v int) *StatementImportUpdate {
+	_u.mutation.AddCreatedCount(v)
+	return _u
+}
+
+// SetSkippedCount sets the "skipped_count" field.
+func (_u *StatementImportUpdate) SetSkippedCount(v int) *StatementImportUpdate {
+	_u.mutation.ResetSkippedCount()
+	_u.mutation.SetSkippedCount(v)
+	return _u
+}
+
+// SetNillableSkippedCount sets the "skipped_count" field if the given value is not nil.
+func (_u *StatementImportUpdate) SetNillableSkippedCount(v *int) *StatementImportUpdate {
+	if v != nil {
+		_u.SetSkippedCount(*v)
+	}
+	return _u
+}
+
+// AddSkippedCount adds value to the "skipped_count" field.
+func (_u *StatementImportUpdate) AddSkippedCount(v int) *StatementImportUpdate {
+	_u.mutation.AddSkippedCount(v)
+	return _u
+}
+
+// SetMergedCount sets the "merged_count" field.
+func (_u *StatementImportUpdate) SetMergedCount(v int) *StatementImportUpdate {
+	_u.mutation.ResetMergedCount()
+	_u.mutation.SetMergedCount(v)
+	return _u
+}
+
+// SetNillableMergedCount sets the "merged_count" field if the given value is not nil.
+func (_u *StatementImportUpdate) SetNillableMergedCount(v *int) *StatementImportUpdate {
+	if v != nil {
+		_u.SetMergedCount(*v)
+	}
+	return _u
+}
+
+// AddMergedCount adds value to the "merged_count" field.
+func (_u *StatementImportUpdate) AddMergedCount(v int) *StatementImportUpdate {
+	_u.mutation.AddMergedCount(v)
+	return _u
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (_u *StatementImportUpdate) SetFailedCount(v int) *StatementImportUpdate {
+	_u.mutation.ResetFailedCount()
+	_u.mutation.SetFailedCount(v)
+	return _u
+}
+
+// SetNillableFailedCount sets the "failed_count" field if the given value is not nil.
+func (_u *StatementImportUpdate) SetNillableFailedCount(v *int) *StatementImportUpdate {
+	if v != nil {
+		_u.SetFailedCount(*v)
+	}
+	return _u
+}
+
+// AddFailedCount adds value to the "failed_count" field.
+func (_u *StatementImportUpdate) AddFailedCount(v int) *StatementImportUpdate {
+	_u.mutation.AddFailedCount(v)
+	return _u
+}
+
+// SetCommittedAt sets the "committed_at" field.
+func (_u *StatementImportUpdate) SetCommittedAt(v time.Time) *StatementImportUpdate {
+	_u.mutation.SetCommittedAt(v)
+	return _u
+}
+
+// SetNillableCommittedAt sets the "committed_at" field if the given value is not nil.
+func (_u *StatementImportUpdate) SetNillableCommittedAt(v *time.Time) *StatementImportUpdate {
+	if v != nil {
+		_u.SetCommittedAt(*v)
+	}
+	return _u
+}
+
+// ClearCommittedAt clears the value of the "committed_at" field.
+func (_u *StatementImportUpdate) ClearCommittedAt() *StatementImportUpdate {
+	_u.mutation.ClearCommittedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StatementImportUpdate) SetUpdatedAt(v time.Time) *StatementImportUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddDecisionIDs adds the "decisions" edge to the ImportDecision entity by IDs.
+func (_u *StatementImportUpdate) AddDecisionIDs(ids ...int) *StatementImportUpdate {
+	_u.mutation.AddDecisionIDs(ids...)
+	return _u
+}
+
+// AddDecisions adds the "decisions" edges to the ImportDecision entity.
+func (_u *StatementImportUpdate) AddDecisions(v ...*ImportDecision) *StatementImportUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDecisionIDs(ids...)
+}
+
+// Mutation returns the StatementImportMutation object of the builder.
+func (_u *StatementImportUpdate) Mutation() *StatementImportMutation {
+	return _u.mutation
+}
+
+// ClearDecisions clears all "decisions" edges to the ImportDecision entity.
+func (_u *StatementImportUpdate) ClearDecisions() *StatementImportUpdate {
+	_u.mutation.ClearDecisions()
+	return _u
+}
+
+// RemoveDecisionIDs removes the "decisions" edge to ImportDecision entities by IDs.
+func (_u *StatementImportUpdate) RemoveDecisionIDs(ids ...int) *StatementImportUpdate {
+	_u.mutation.RemoveDecisionIDs(ids...)
+	return _u
+}
+
+// RemoveDecisions removes "decisions" edges to ImportDecision entities.
+func (_u *StatementImportUpdate) RemoveDecisions(v ...*ImportDecision) *StatementImportUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDecisionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StatementImportUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StatementImportUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StatementImportUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StatementImportUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StatementImportUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if statementimport.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized statementimport.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := statementimport.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StatementImportUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := statementimport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "StatementImport.status": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StatementImport.workspace"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StatementImport.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *StatementImportUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StatementImportUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *StatementImportUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(statementimport.Table, statementimport.Columns, sqlgraph.NewFieldSpec(statementimport.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.FilenameCleared() {
+		_spec.ClearField(statementimport.FieldFilename, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(statementimport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CreatedCount(); ok {
+		_spec.SetField(statementimport.FieldCreatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedCount(); ok {
+		_spec.AddField(statementimport.FieldCreatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SkippedCount(); ok {
+		_spec.SetField(statementimport.FieldSkippedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSkippedCount(); ok {
+		_spec.AddField(statementimport.FieldSkippedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MergedCount(); ok {
+		_spec.SetField(statementimport.FieldMergedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMergedCount(); ok {
+		_spec.AddField(statementimport.FieldMergedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailedCount(); ok {
+		_spec.SetField(statementimport.FieldFailedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedCount(); ok {
+		_spec.AddField(statementimport.FieldFailedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CommittedAt(); ok {
+		_spec.SetField(statementimport.FieldCommittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CommittedAtCleared() {
+		_spec.ClearField(statementimport.FieldCommittedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(statementimport.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   statementimport.DecisionsTable,
+			Columns: []string{statementimport.DecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDecisionsIDs(); len(nodes) > 0 && !_u.mutation.DecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   statementimport.DecisionsTable,
+			Columns: []string{statementimport.DecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DecisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   statementimport.DecisionsTable,
+			Columns: []string{statementimport.DecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{statementimport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StatementImportUpdateOne is the builder for updating a single StatementImport entity.
+type StatementImportUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *StatementImportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStatus sets the "status" field.
+func (_u *StatementImportUpdateOne) SetStatus(v statementimport.Status) *StatementImportUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *StatementImportUpdateOne) SetNillableStatus(v *statementimport.Status) *StatementImportUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCreatedCount sets the "created_count" field.
+func (_u *StatementImportUpdateOne) SetCreatedCount(v int) *StatementImportUpdateOne {
+	_u.mutation.ResetCreatedCount()
+	_u.mutation.SetCreatedCount(v)
+	return _u
+}
+
+// SetNillableCreatedCount sets the "created_count" field if the given value is not nil.
+func (_u *StatementImportUpdateOne) SetNillableCreatedCount(v *int) *StatementImportUpdateOne {
+	if v != nil {
+		_u.SetCreatedCount(*v)
+	}
+	return _u
+}
+
+// AddCreatedCount adds value to the "created_count" field.
+func (_u *StatementImportUpdateOne) AddCreatedCount(v int) *StatementImportUpdateOne {
+	_u.mutation.AddCreatedCount(v)
+	return _u
+}
+
+// SetSkippedCount sets the "skipped_count" field.
+func (_u *StatementImportUpdateOne) SetSkippedCount(v int) *StatementImportUpdateOne {
+	_u.mutation.ResetSkippedCount()
+	_u.mutation.SetSkippedCount(v)
+	return _u
+}
+
+// SetNillableSkippedCount sets the "skipped_count" field if the given value is not nil.
+func (_u *StatementImportUpdateOne) SetNillableSkippedCount(v *int) *StatementImportUpdateOne {
+	if v != nil {
+		_u.SetSkippedCount(*v)
+	}
+	return _u
+}
+
+// AddSkippedCount adds value to the "skipped_count" field.
+func (_u *StatementImportUpdateOne) AddSkippedCount(v int) *StatementImportUpdateOne {
+	_u.mutation.AddSkippedCount(v)
+	return _u
+}
+
+// SetMergedCount sets the "merged_count" field.
+func (_u *StatementImportUpdateOne) SetMergedCount(v int) *StatementImportUpdateOne {
+	_u.mutation.ResetMergedCount()
+	_u.mutation.SetMergedCount(v)
+	return _u
+}
+
+// SetNillableMergedCount sets the "merged_count" field if the given value is not nil.
+func (_u *StatementImportUpdateOne) SetNillableMergedCount(v *int) *StatementImportUpdateOne {
+	if v != nil {
+		_u.SetMergedCount(*v)
+	}
+	return _u
+}
+
+// AddMergedCount adds value to the "merged_count" field.
+func (_u *StatementImportUpdateOne) AddMergedCount(v int) *StatementImportUpdateOne {
+	_u.mutation.AddMergedCount(v)
+	return _u
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (_u *StatementImportUpdateOne) SetFailedCount(v int) *StatementImportUpdateOne {
+	_u.mutation.ResetFailedCount()
+	_u.mutation.SetFailedCount(v)
+	return _u
+}
+
+// SetNillableFailedCount sets the "failed_count" field if the given value is not nil.
+func (_u *StatementImportUpdateOne) SetNillableFailedCount(v *int) *StatementImportUpdateOne {
+	if v != nil {
+		_u.SetFailedCount(*v)
+	}
+	return _u
+}
+
+// AddFailedCount adds value to the "failed_count" field.
+func (_u *StatementImportUpdateOne) AddFailedCount(v int) *StatementImportUpdateOne {
+	_u.mutation.AddFailedCount(v)
+	return _u
+}
+
+// SetCommittedAt sets the "committed_at" field.
+func (_u *StatementImportUpdateOne) SetCommittedAt(v time.Time) *StatementImportUpdateOne {
+	_u.mutation.SetCommittedAt(v)
+	return _u
+}
+
+// SetNillableCommittedAt sets the "committed_at" field if the given value is not nil.
+func (_u *StatementImportUpdateOne) SetNillableCommittedAt(v *time.Time) *StatementImportUpdateOne {
+	if v != nil {
+		_u.SetCommittedAt(*v)
+	}
+	return _u
+}
+
+// ClearCommittedAt clears the value of the "committed_at" field.
+func (_u *StatementImportUpdateOne) ClearCommittedAt() *StatementImportUpdateOne {
+	_u.mutation.ClearCommittedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StatementImportUpdateOne) SetUpdatedAt(v time.Time) *StatementImportUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddDecisionIDs adds the "decisions" edge to the ImportDecision entity by IDs.
+func (_u *StatementImportUpdateOne) AddDecisionIDs(ids ...int) *StatementImportUpdateOne {
+	_u.mutation.AddDecisionIDs(ids...)
+	return _u
+}
+
+// AddDecisions adds the "decisions" edges to the ImportDecision entity.
+func (_u *StatementImportUpdateOne) AddDecisions(v ...*ImportDecision) *StatementImportUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDecisionIDs(ids...)
+}
+
+// Mutation returns the StatementImportMutation object of the builder.
+func (_u *StatementImportUpdateOne) Mutation() *StatementImportMutation {
+	return _u.mutation
+}
+
+// ClearDecisions clears all "decisions" edges to the ImportDecision entity.
+func (_u *StatementImportUpdateOne) ClearDecisions() *StatementImportUpdateOne {
+	_u.mutation.ClearDecisions()
+	return _u
+}
+
+// RemoveDecisionIDs removes the "decisions" edge to ImportDecision entities by IDs.
+func (_u *StatementImportUpdateOne) RemoveDecisionIDs(ids ...int) *StatementImportUpdateOne {
+	_u.mutation.RemoveDecisionIDs(ids...)
+	return _u
+}
+
+// RemoveDecisions removes "decisions" edges to ImportDecision entities.
+func (_u *StatementImportUpdateOne) RemoveDecisions(v ...*ImportDecision) *StatementImportUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDecisionIDs(ids...)
+}
+
+// Where appends a list predicates to the StatementImportUpdate builder.
+func (_u *StatementImportUpdateOne) Where(ps ...predicate.StatementImport) *StatementImportUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StatementImportUpdateOne) Select(field string, fields ...string) *StatementImportUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StatementImport entity.
+func (_u *StatementImportUpdateOne) Save(ctx context.Context) (*StatementImport, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StatementImportUpdateOne) SaveX(ctx context.Context) *StatementImport {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StatementImportUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StatementImportUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StatementImportUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if statementimport.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized statementimport.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := statementimport.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StatementImportUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := statementimport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "StatementImport.status": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StatementImport.workspace"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StatementImport.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *StatementImportUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StatementImportUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *StatementImportUpdateOne) sqlSave(ctx context.Context) (_node *StatementImport, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(statementimport.Table, statementimport.Columns, sqlgraph.NewFieldSpec(statementimport.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StatementImport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, statementimport.FieldID)
+		for _, f := range fields {
+			if !statementimport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != statementimport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.FilenameCleared() {
+		_spec.ClearField(statementimport.FieldFilename, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(statementimport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CreatedCount(); ok {
+		_spec.SetField(statementimport.FieldCreatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedCount(); ok {
+		_spec.AddField(statementimport.FieldCreatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SkippedCount(); ok {
+		_spec.SetField(statementimport.FieldSkippedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSkippedCount(); ok {
+		_spec.AddField(statementimport.FieldSkippedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MergedCount(); ok {
+		_spec.SetField(statementimport.FieldMergedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMergedCount(); ok {
+		_spec.AddField(statementimport.FieldMergedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailedCount(); ok {
+		_spec.SetField(statementimport.FieldFailedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedCount(); ok {
+		_spec.AddField(statementimport.FieldFailedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CommittedAt(); ok {
+		_spec.SetField(statementimport.FieldCommittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CommittedAtCleared() {
+		_spec.ClearField(statementimport.FieldCommittedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(statementimport.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   statementimport.DecisionsTable,
+			Columns: []string{statementimport.DecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDecisionsIDs(); len(nodes) > 0 && !_u.mutation.DecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   statementimport.DecisionsTable,
+			Columns: []string{statementimport.DecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DecisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   statementimport.DecisionsTable,
+			Columns: []string{statementimport.DecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &StatementImport{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{statementimport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,495 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/ent/journalentry"
+	"backend/internal/infrastructure/ent/payee"
+	"backend/internal/infrastructure/ent/recurrenceoccurrence"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/transfer"
+	"backend/internal/infrastructure/ent/workspace"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Transaction is the model entity for the Transaction schema.
+type Transaction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID int `json:"account_id,omitempty"`
+	// CategoryID holds the value of the "category_id" field.
+	CategoryID *int `json:"category_id,omitempty"`
+	// PayeeID holds the value of the "payee_id" field.
+	PayeeID *int `json:"payee_id,omitempty"`
+	// PostedOn holds the value of the "posted_on" field.
+	PostedOn time.Time `json:"posted_on,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount model.Decimal `json:"amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Payee holds the value of the "payee" field.
+	Payee string `json:"payee,omitempty"`
+	// Memo holds the value of the "memo" field.
+	Memo string `json:"memo,omitempty"`
+	// ExternalID holds the value of the "external_id" field.
+	ExternalID *string `json:"external_id,omitempty"`
+	// ValueDate holds the value of the "value_date" field.
+	ValueDate *time.Time `json:"value_date,omitempty"`
+	// CounterpartyIban holds the value of the "counterparty_iban" field.
+	CounterpartyIban string `json:"counterparty_iban,omitempty"`
+	// EndToEndID holds the value of the "end_to_end_id" field.
+	EndToEndID string `json:"end_to_end_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status transaction.Status `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TransactionQuery when eager-loading is set.
+	Edges        TransactionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TransactionEdges holds the relations/edges for other nodes in the graph.
+type TransactionEdges struct {
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// Category holds the value of the category edge.
+	Category *Category `json:"category,omitempty"`
+	// LinkedPayee holds the value of the linked_payee edge.
+	LinkedPayee *Payee `json:"linked_payee,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Splits holds the value of the splits edge.
+	Splits []*TransactionSplit `json:"splits,omitempty"`
+	// JournalEntry holds the value of the journal_entry edge.
+	JournalEntry *JournalEntry `json:"journal_entry,omitempty"`
+	// OutgoingTransfer holds the value of the outgoing_transfer edge.
+	OutgoingTransfer *Transfer `json:"outgoing_transfer,omitempty"`
+	// IncomingTransfer holds the value of the incoming_transfer edge.
+	IncomingTransfer *Transfer `json:"incoming_transfer,omitempty"`
+	// RecurrenceOccurrence holds the value of the recurrence_occurrence edge.
+	RecurrenceOccurrence *RecurrenceOccurrence `json:"recurrence_occurrence,omitempty"`
+	// ImportDecisions holds the value of the import_decisions edge.
+	ImportDecisions []*ImportDecision `json:"import_decisions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [11]bool
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// CategoryOrErr returns the Category value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) CategoryOrErr() (*Category, error) {
+	if e.Category != nil {
+		return e.Category, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: category.Label}
+	}
+	return nil, &NotLoadedError{edge: "category"}
+}
+
+// LinkedPayeeOrErr returns the LinkedPayee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) LinkedPayeeOrErr() (*Payee, error) {
+	if e.LinkedPayee != nil {
+		return e.LinkedPayee, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: payee.Label}
+	}
+	return nil, &NotLoadedError{edge: "linked_payee"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransactionEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[4] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// SplitsOrErr returns the Splits value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransactionEdges) SplitsOrErr() ([]*TransactionSplit, error) {
+	if e.loadedTypes[5] {
+		return e.Splits, nil
+	}
+	return nil, &NotLoadedError{edge: "splits"}
+}
+
+// JournalEntryOrErr returns the JournalEntry value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) JournalEntryOrErr() (*JournalEntry, error) {
+	if e.JournalEntry != nil {
+		return e.JournalEntry, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: journalentry.Label}
+	}
+	return nil, &NotLoadedError{edge: "journal_entry"}
+}
+
+// OutgoingTransferOrErr returns the OutgoingTransfer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) OutgoingTransferOrErr() (*Transfer, error) {
+	if e.OutgoingTransfer != nil {
+		return e.OutgoingTransfer, nil
+	} else if e.loadedTypes[7] {
+		return nil, &NotFoundError{label: transfer.Label}
+	}
+	return nil, &NotLoadedError{edge: "outgoing_transfer"}
+}
+
+// IncomingTransferOrErr returns the IncomingTransfer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) IncomingTransferOrErr() (*Transfer, error) {
+	if e.IncomingTransfer != nil {
+		return e.IncomingTransfer, nil
+	} else if e.loadedTypes[8] {
+		return nil, &NotFoundError{label: transfer.Label}
+	}
+	return nil, &NotLoadedError{edge: "incoming_transfer"}
+}
+
+// RecurrenceOccurrenceOrErr returns the RecurrenceOccurrence value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) RecurrenceOccurrenceOrErr() (*RecurrenceOccurrence, error) {
+	if e.RecurrenceOccurrence != nil {
+		return e.RecurrenceOccurrence, nil
+	} else if e.loadedTypes[9] {
+		return nil, &NotFoundError{label: recurrenceoccurrence.Label}
+	}
+	return nil, &NotLoadedError{edge: "recurrence_occurrence"}
+}
+
+// ImportDecisionsOrErr returns the ImportDecisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransactionEdges) ImportDecisionsOrErr() ([]*ImportDecision, error) {
+	if e.loadedTypes[10] {
+		return e.ImportDecisions, nil
+	}
+	return nil, &NotLoadedError{edge: "import_decisions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Transaction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case transaction.FieldAmount:
+			values[i] = new(model.Decimal)
+		case transaction.FieldID, transaction.FieldWorkspaceID, transaction.FieldAccountID, transaction.FieldCategoryID, transaction.FieldPayeeID:
+			values[i] = new(sql.NullInt64)
+		case transaction.FieldCurrency, transaction.FieldPayee, transaction.FieldMemo, transaction.FieldExternalID, transaction.FieldCounterpartyIban, transaction.FieldEndToEndID, transaction.FieldStatus:
+			values[i] = new(sql.NullString)
+		case transaction.FieldPostedOn, transaction.FieldValueDate, transaction.FieldCreatedAt, transaction.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Transaction fields.
+func (_m *Transaction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case transaction.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case transaction.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				_m.WorkspaceID = int(value.Int64)
+			}
+		case transaction.FieldAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = int(value.Int64)
+			}
+		case transaction.FieldCategoryID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
+			} else if value.Valid {
+				_m.CategoryID = new(int)
+				*_m.CategoryID = int(value.Int64)
+			}
+		case transaction.FieldPayeeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field payee_id", values[i])
+			} else if value.Valid {
+				_m.PayeeID = new(int)
+				*_m.PayeeID = int(value.Int64)
+			}
+		case transaction.FieldPostedOn:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field posted_on", values[i])
+			} else if value.Valid {
+				_m.PostedOn = value.Time
+			}
+		case transaction.FieldAmount:
+			if value, ok := values[i].(*model.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case transaction.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case transaction.FieldPayee:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payee", values[i])
+			} else if value.Valid {
+				_m.Payee = value.String
+			}
+		case transaction.FieldMemo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field memo", values[i])
+			} else if value.Valid {
+				_m.Memo = value.String
+			}
+		case transaction.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
+			} else if value.Valid {
+				_m.ExternalID = new(string)
+				*_m.ExternalID = value.String
+			}
+		case transaction.FieldValueDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field value_date", values[i])
+			} else if value.Valid {
+				_m.ValueDate = new(time.Time)
+				*_m.ValueDate = value.Time
+			}
+		case transaction.FieldCounterpartyIban:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field counterparty_iban", values[i])
+			} else if value.Valid {
+				_m.CounterpartyIban = value.String
+			}
+		case transaction.FieldEndToEndID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field end_to_end_id", values[i])
+			} else if value.Valid {
+				_m.EndToEndID = value.String
+			}
+		case transaction.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = transaction.Status(value.String)
+			}
+		case transaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case transaction.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Transaction.
+// This includes values selected through modifiers, order, etc.
+func (_m *Transaction) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWorkspace queries the "workspace" edge of the Transaction entity.
+func (_m *Transaction) QueryWorkspace() *WorkspaceQuery {
+	return NewTransactionClient(_m.config).QueryWorkspace(_m)
+}
+
+// QueryAccount queries the "account" edge of the Transaction entity.
+func (_m *Transaction) QueryAccount() *AccountQuery {
+	return NewTransactionClient(_m.config).QueryAccount(_m)
+}
+
+// QueryCategory queries the "category" edge of the Transaction entity.
+func (_m *Transaction) QueryCategory() *CategoryQuery {
+	return NewTransactionClient(_m.config).QueryCategory(_m)
+}
+
+// QueryLinkedPayee queries the "linked_payee" edge of the Transaction entity.
+func (_m *Transaction) QueryLinkedPayee() *PayeeQuery {
+	return NewTransactionClient(_m.config).QueryLinkedPayee(_m)
+}
+
+// QueryTags queries the "tags" edge of the Transaction entity.
+func (_m *Transaction) QueryTags() *TagQuery {
+	return NewTransactionClient(_m.config).QueryTags(_m)
+}
+
+// QuerySplits queries the "splits" edge of the Transaction entity.
+func (_m *Transaction) QuerySplits() *TransactionSplitQuery {
+	return NewTransactionClient(_m.config).QuerySplits(_m)
+}
+
+// QueryJournalEntry queries the "journal_entry" edge of the Transaction entity.
+func (_m *Transaction) QueryJournalEntry() *JournalEntryQuery {
+	return NewTransactionClient(_m.config).QueryJournalEntry(_m)
+}
+
+// QueryOutgoingTransfer queries the "outgoing_transfer" edge of the Transaction entity.
+func (_m *Transaction) QueryOutgoingTransfer() *TransferQuery {
+	return NewTransactionClient(_m.config).QueryOutgoingTransfer(_m)
+}
+
+// QueryIncomingTransfer queries the "incoming_transfer" edge of the Transaction entity.
+func (_m *Transaction) QueryIncomingTransfer() *TransferQuery {
+	return NewTransactionClient(_m.config).QueryIncomingTransfer(_m)
+}
+
+// QueryRecurrenceOccurrence queries the "recurrence_occurrence" edge of the Transaction entity.
+func (_m *Transaction) QueryRecurrenceOccurrence() *RecurrenceOccurrenceQuery {
+	return NewTransactionClient(_m.config).QueryRecurrenceOccurrence(_m)
+}
+
+// QueryImportDecisions queries the "import_decisions" edge of the Transaction entity.
+func (_m *Transaction) QueryImportDecisions() *ImportDecisionQuery {
+	return NewTransactionClient(_m.config).QueryImportDecisions(_m)
+}
+
+// Update returns a builder for updating this Transaction.
+// Note that you need to call Transaction.Unwrap() before calling this method if this Transaction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Transaction) Update() *TransactionUpdateOne {
+	return NewTransactionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Transaction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Transaction) Unwrap() *Transaction {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Transaction is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Transaction) String() string {
+	var builder strings.Builder
+	builder.WriteString("Transaction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	if v := _m.CategoryID; v != nil {
+		builder.WriteString("category_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.PayeeID; v != nil {
+		builder.WriteString("payee_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("posted_on=")
+	builder.WriteString(_m.PostedOn.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("payee=")
+	builder.WriteString(_m.Payee)
+	builder.WriteString(", ")
+	builder.WriteString("memo=")
+	builder.WriteString(_m.Memo)
+	builder.WriteString(", ")
+	if v := _m.ExternalID; v != nil {
+		builder.WriteString("external_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ValueDate; v != nil {
+		builder.WriteString("value_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("counterparty_iban=")
+	builder.WriteString(_m.CounterpartyIban)
+	builder.WriteString(", ")
+	builder.WriteString("end_to_end_id=")
+	builder.WriteString(_m.EndToEndID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Transactions is a parsable slice of Transaction.
+type Transactions []*Transaction
@@ -0,0 +1,495 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transaction
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the transaction type in the database.
+	Label = "transaction"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWorkspaceID holds the string denoting the workspace_id field in the database.
+	FieldWorkspaceID = "workspace_id"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldCategoryID holds the string denoting the category_id field in the database.
+	FieldCategoryID = "category_id"
+	// FieldPayeeID holds the string denoting the payee_id field in the database.
+	FieldPayeeID = "payee_id"
+	// FieldPostedOn holds the string denoting the posted_on field in the database.
+	FieldPostedOn = "posted_on"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldPayee holds the string denoting the payee field in the database.
+	FieldPayee = "payee"
+	// FieldMemo holds the string denoting the memo field in the database.
+	FieldMemo = "memo"
+	// FieldExternalID holds the string denoting the external_id field in the database.
+	FieldExternalID = "external_id"
+	// FieldValueDate holds the string denoting the value_date field in the database.
+	FieldValueDate = "value_date"
+	// FieldCounterpartyIban holds the string denoting the counterparty_iban field in the database.
+	FieldCounterpartyIban = "counterparty_iban"
+	// FieldEndToEndID holds the string denoting the end_to_end_id field in the database.
+	FieldEndToEndID = "end_to_end_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeWorkspace holds the string denoting the workspace edge name in mutations.
+	EdgeWorkspace = "workspace"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// EdgeCategory holds the string denoting the category edge name in mutations.
+	EdgeCategory = "category"
+	// EdgeLinkedPayee holds the string denoting the linked_payee edge name in mutations.
+	EdgeLinkedPayee = "linked_payee"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeSplits holds the string denoting the splits edge name in mutations.
+	EdgeSplits = "splits"
+	// EdgeJournalEntry holds the string denoting the journal_entry edge name in mutations.
+	EdgeJournalEntry = "journal_entry"
+	// EdgeOutgoingTransfer holds the string denoting the outgoing_transfer edge name in mutations.
+	EdgeOutgoingTransfer = "outgoing_transfer"
+	// EdgeIncomingTransfer holds the string denoting the incoming_transfer edge name in mutations.
+	EdgeIncomingTransfer = "incoming_transfer"
+	// EdgeRecurrenceOccurrence holds the string denoting the recurrence_occurrence edge name in mutations.
+	EdgeRecurrenceOccurrence = "recurrence_occurrence"
+	// EdgeImportDecisions holds the string denoting the import_decisions edge name in mutations.
+	EdgeImportDecisions = "import_decisions"
+	// Table holds the table name of the transaction in the database.
+	Table = "transactions"
+	// WorkspaceTable is the table that holds the workspace relation/edge.
+	WorkspaceTable = "transactions"
+	// WorkspaceInverseTable is the table name for the Workspace entity.
+	// It exists in this package in order to avoid circular dependency with the "workspace" package.
+	WorkspaceInverseTable = "workspaces"
+	// WorkspaceColumn is the table column denoting the workspace relation/edge.
+	WorkspaceColumn = "workspace_id"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "transactions"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+	// CategoryTable is the table that holds the category relation/edge.
+	CategoryTable = "transactions"
+	// CategoryInverseTable is the table name for the Category entity.
+	// It exists in this package in order to avoid circular dependency with the "category" package.
+	CategoryInverseTable = "categories"
+	// CategoryColumn is the table column denoting the category relation/edge.
+	CategoryColumn = "category_id"
+	// LinkedPayeeTable is the table that holds the linked_payee relation/edge.
+	LinkedPayeeTable = "transactions"
+	// LinkedPayeeInverseTable is the table name for the Payee entity.
+	// It exists in this package in order to avoid circular dependency with the "payee" package.
+	LinkedPayeeInverseTable = "payees"
+	// LinkedPayeeColumn is the table column denoting the linked_payee relation/edge.
+	LinkedPayeeColumn = "payee_id"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "tag_transactions"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+	// SplitsTable is the table that holds the splits relation/edge.
+	SplitsTable = "transaction_splits"
+	// SplitsInverseTable is the table name for the TransactionSplit entity.
+	// It exists in this package in order to avoid circular dependency with the "transactionsplit" package.
+	SplitsInverseTable = "transaction_splits"
+	// SplitsColumn is the table column denoting the splits relation/edge.
+	SplitsColumn = "transaction_id"
+	// JournalEntryTable is the table that holds the journal_entry relation/edge.
+	JournalEntryTable = "journal_entries"
+	// JournalEntryInverseTable is the table name for the JournalEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "journalentry" package.
+	JournalEntryInverseTable = "journal_entries"
+	// JournalEntryColumn is the table column denoting the journal_entry relation/edge.
+	JournalEntryColumn = "transaction_id"
+	// OutgoingTransferTable is the table that holds the outgoing_transfer relation/edge.
+	OutgoingTransferTable = "transfers"
+	// OutgoingTransferInverseTable is the table name for the Transfer entity.
+	// It exists in this package in order to avoid circular dependency with the "transfer" package.
+	OutgoingTransferInverseTable = "transfers"
+	// OutgoingTransferColumn is the table column denoting the outgoing_transfer relation/edge.
+	OutgoingTransferColumn = "from_transaction_id"
+	// IncomingTransferTable is the table that holds the incoming_transfer relation/edge.
+	IncomingTransferTable = "transfers"
+	// IncomingTransferInverseTable is the table name for the Transfer entity.
+	// It exists in this package in order to avoid circular dependency with the "transfer" package.
+	IncomingTransferInverseTable = "transfers"
+	// IncomingTransferColumn is the table column denoting the incoming_transfer relation/edge.
+	IncomingTransferColumn = "to_transaction_id"
+	// RecurrenceOccurrenceTable is the table that holds the recurrence_occurrence relation/edge.
+	RecurrenceOccurrenceTable = "recurrence_occurrences"
+	// RecurrenceOccurrenceInverseTable is the table name for the RecurrenceOccurrence entity.
+	// It exists in this package in order to avoid circular dependency with the "recurrenceoccurrence" package.
+	RecurrenceOccurrenceInverseTable = "recurrence_occurrences"
+	// RecurrenceOccurrenceColumn is the table column denoting the recurrence_occurrence relation/edge.
+	RecurrenceOccurrenceColumn = "transaction_id"
+	// ImportDecisionsTable is the table that holds the import_decisions relation/edge.
+	ImportDecisionsTable = "import_decisions"
+	// ImportDecisionsInverseTable is the table name for the ImportDecision entity.
+	// It exists in this package in order to avoid circular dependency with the "importdecision" package.
+	ImportDecisionsInverseTable = "import_decisions"
+	// ImportDecisionsColumn is the table column denoting the import_decisions relation/edge.
+	ImportDecisionsColumn = "transaction_id"
+)
+
+// Columns holds all SQL columns for transaction fields.
+var Columns = []string{
+	FieldID,
+	FieldWorkspaceID,
+	FieldAccountID,
+	FieldCategoryID,
+	FieldPayeeID,
+	FieldPostedOn,
+	FieldAmount,
+	FieldCurrency,
+	FieldPayee,
+	FieldMemo,
+	FieldExternalID,
+	FieldValueDate,
+	FieldCounterpartyIban,
+	FieldEndToEndID,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+var (
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"tag_id", "transaction_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "backend/internal/infrastructure/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusUncleared is the default value of the Status enum.
+const DefaultStatus = StatusUncleared
+
+// Status values.
+const (
+	StatusUncleared  Status = "uncleared"
+	StatusCleared    Status = "cleared"
+	StatusReconciled Status = "reconciled"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusUncleared, StatusCleared, StatusReconciled:
+		return nil
+	default:
+		return fmt.Errorf("transaction: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Transaction queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByWorkspaceID orders the results by the workspace_id field.
+func ByWorkspaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkspaceID, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByCategoryID orders the results by the category_id field.
+func ByCategoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoryID, opts...).ToFunc()
+}
+
+// ByPayeeID orders the results by the payee_id field.
+func ByPayeeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayeeID, opts...).ToFunc()
+}
+
+// ByPostedOn orders the results by the posted_on field.
+func ByPostedOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPostedOn, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByPayee orders the results by the payee field.
+func ByPayee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayee, opts...).ToFunc()
+}
+
+// ByMemo orders the results by the memo field.
+func ByMemo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMemo, opts...).ToFunc()
+}
+
+// ByExternalID orders the results by the external_id field.
+func ByExternalID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExternalID, opts...).ToFunc()
+}
+
+// ByValueDate orders the results by the value_date field.
+func ByValueDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValueDate, opts...).ToFunc()
+}
+
+// ByCounterpartyIban orders the results by the counterparty_iban field.
+func ByCounterpartyIban(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCounterpartyIban, opts...).ToFunc()
+}
+
+// ByEndToEndID orders the results by the end_to_end_id field.
+func ByEndToEndID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndToEndID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByWorkspaceField orders the results by workspace field.
+func ByWorkspaceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorkspaceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCategoryField orders the results by category field.
+func ByCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCategoryStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLinkedPayeeField orders the results by linked_payee field.
+func ByLinkedPayeeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinkedPayeeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySplitsCount orders the results by splits count.
+func BySplitsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSplitsStep(), opts...)
+	}
+}
+
+// BySplits orders the results by splits terms.
+func BySplits(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSplitsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByJournalEntryField orders the results by journal_entry field.
+func ByJournalEntryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJournalEntryStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByOutgoingTransferField orders the results by outgoing_transfer field.
+func ByOutgoingTransferField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOutgoingTransferStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByIncomingTransferField orders the results by incoming_transfer field.
+func ByIncomingTransferField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIncomingTransferStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRecurrenceOccurrenceField orders the results by recurrence_occurrence field.
+func ByRecurrenceOccurrenceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecurrenceOccurrenceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByImportDecisionsCount orders the results by import_decisions count.
+func ByImportDecisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newImportDecisionsStep(), opts...)
+	}
+}
+
+// ByImportDecisions orders the results by import_decisions terms.
+func ByImportDecisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newImportDecisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newWorkspaceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorkspaceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, WorkspaceTable, WorkspaceColumn),
+	)
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+	)
+}
+func newCategoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CategoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
+	)
+}
+func newLinkedPayeeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinkedPayeeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LinkedPayeeTable, LinkedPayeeColumn),
+	)
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+	)
+}
+func newSplitsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SplitsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SplitsTable, SplitsColumn),
+	)
+}
+func newJournalEntryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JournalEntryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, JournalEntryTable, JournalEntryColumn),
+	)
+}
+func newOutgoingTransferStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OutgoingTransferInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, OutgoingTransferTable, OutgoingTransferColumn),
+	)
+}
+func newIncomingTransferStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IncomingTransferInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, IncomingTransferTable, IncomingTransferColumn),
+	)
+}
+func newRecurrenceOccurrenceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecurrenceOccurrenceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, RecurrenceOccurrenceTable, RecurrenceOccurrenceColumn),
+	)
+}
+func newImportDecisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ImportDecisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ImportDecisionsTable, ImportDecisionsColumn),
+	)
+}
@@ -0,0 +1,1170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transaction
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldID, id))
+}
+
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldAccountID, v))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// PayeeID applies equality check predicate on the "payee_id" field. It's identical to PayeeIDEQ.
+func PayeeID(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldPayeeID, v))
+}
+
+// PostedOn applies equality check predicate on the "posted_on" field. It's identical to PostedOnEQ.
+func PostedOn(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldPostedOn, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v model.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldAmount, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Payee applies equality check predicate on the "payee" field. It's identical to PayeeEQ.
+func Payee(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldPayee, v))
+}
+
+// Memo applies equality check predicate on the "memo" field. It's identical to MemoEQ.
+func Memo(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldMemo, v))
+}
+
+// ExternalID applies equality check predicate on the "external_id" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExternalID, v))
+}
+
+// ValueDate applies equality check predicate on the "value_date" field. It's identical to ValueDateEQ.
+func ValueDate(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldValueDate, v))
+}
+
+// CounterpartyIban applies equality check predicate on the "counterparty_iban" field. It's identical to CounterpartyIbanEQ.
+func CounterpartyIban(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCounterpartyIban, v))
+}
+
+// EndToEndID applies equality check predicate on the "end_to_end_id" field. It's identical to EndToEndIDEQ.
+func EndToEndID(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldEndToEndID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDIsNil applies the IsNil predicate on the "category_id" field.
+func CategoryIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldCategoryID))
+}
+
+// CategoryIDNotNil applies the NotNil predicate on the "category_id" field.
+func CategoryIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldCategoryID))
+}
+
+// PayeeIDEQ applies the EQ predicate on the "payee_id" field.
+func PayeeIDEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldPayeeID, v))
+}
+
+// PayeeIDNEQ applies the NEQ predicate on the "payee_id" field.
+func PayeeIDNEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldPayeeID, v))
+}
+
+// PayeeIDIn applies the In predicate on the "payee_id" field.
+func PayeeIDIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldPayeeID, vs...))
+}
+
+// PayeeIDNotIn applies the NotIn predicate on the "payee_id" field.
+func PayeeIDNotIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldPayeeID, vs...))
+}
+
+// PayeeIDIsNil applies the IsNil predicate on the "payee_id" field.
+func PayeeIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldPayeeID))
+}
+
+// PayeeIDNotNil applies the NotNil predicate on the "payee_id" field.
+func PayeeIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldPayeeID))
+}
+
+// PostedOnEQ applies the EQ predicate on the "posted_on" field.
+func PostedOnEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldPostedOn, v))
+}
+
+// PostedOnNEQ applies the NEQ predicate on the "posted_on" field.
+func PostedOnNEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldPostedOn, v))
+}
+
+// PostedOnIn applies the In predicate on the "posted_on" field.
+func PostedOnIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldPostedOn, vs...))
+}
+
+// PostedOnNotIn applies the NotIn predicate on the "posted_on" field.
+func PostedOnNotIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldPostedOn, vs...))
+}
+
+// PostedOnGT applies the GT predicate on the "posted_on" field.
+func PostedOnGT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldPostedOn, v))
+}
+
+// PostedOnGTE applies the GTE predicate on the "posted_on" field.
+func PostedOnGTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldPostedOn, v))
+}
+
+// PostedOnLT applies the LT predicate on the "posted_on" field.
+func PostedOnLT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldPostedOn, v))
+}
+
+// PostedOnLTE applies the LTE predicate on the "posted_on" field.
+func PostedOnLTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldPostedOn, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v model.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v model.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...model.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...model.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v model.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v model.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v model.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v model.Decimal) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldAmount, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// PayeeEQ applies the EQ predicate on the "payee" field.
+func PayeeEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldPayee, v))
+}
+
+// PayeeNEQ applies the NEQ predicate on the "payee" field.
+func PayeeNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldPayee, v))
+}
+
+// PayeeIn applies the In predicate on the "payee" field.
+func PayeeIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldPayee, vs...))
+}
+
+// PayeeNotIn applies the NotIn predicate on the "payee" field.
+func PayeeNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldPayee, vs...))
+}
+
+// PayeeGT applies the GT predicate on the "payee" field.
+func PayeeGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldPayee, v))
+}
+
+// PayeeGTE applies the GTE predicate on the "payee" field.
+func PayeeGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldPayee, v))
+}
+
+// PayeeLT applies the LT predicate on the "payee" field.
+func PayeeLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldPayee, v))
+}
+
+// PayeeLTE applies the LTE predicate on the "payee" field.
+func PayeeLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldPayee, v))
+}
+
+// PayeeContains applies the Contains predicate on the "payee" field.
+func PayeeContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldPayee, v))
+}
+
+// PayeeHasPrefix applies the HasPrefix predicate on the "payee" field.
+func PayeeHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldPayee, v))
+}
+
+// PayeeHasSuffix applies the HasSuffix predicate on the "payee" field.
+func PayeeHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldPayee, v))
+}
+
+// PayeeIsNil applies the IsNil predicate on the "payee" field.
+func PayeeIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldPayee))
+}
+
+// PayeeNotNil applies the NotNil predicate on the "payee" field.
+func PayeeNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldPayee))
+}
+
+// PayeeEqualFold applies the EqualFold predicate on the "payee" field.
+func PayeeEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldPayee, v))
+}
+
+// PayeeContainsFold applies the ContainsFold predicate on the "payee" field.
+func PayeeContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldPayee, v))
+}
+
+// MemoEQ applies the EQ predicate on the "memo" field.
+func MemoEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldMemo, v))
+}
+
+// MemoNEQ applies the NEQ predicate on the "memo" field.
+func MemoNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldMemo, v))
+}
+
+// MemoIn applies the In predicate on the "memo" field.
+func MemoIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldMemo, vs...))
+}
+
+// MemoNotIn applies the NotIn predicate on the "memo" field.
+func MemoNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldMemo, vs...))
+}
+
+// MemoGT applies the GT predicate on the "memo" field.
+func MemoGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldMemo, v))
+}
+
+// MemoGTE applies the GTE predicate on the "memo" field.
+func MemoGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldMemo, v))
+}
+
+// MemoLT applies the LT predicate on the "memo" field.
+func MemoLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldMemo, v))
+}
+
+// MemoLTE applies the LTE predicate on the "memo" field.
+func MemoLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldMemo, v))
+}
+
+// MemoContains applies the Contains predicate on the "memo" field.
+func MemoContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldMemo, v))
+}
+
+// MemoHasPrefix applies the HasPrefix predicate on the "memo" field.
+func MemoHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldMemo, v))
+}
+
+// MemoHasSuffix applies the HasSuffix predicate on the "memo" field.
+func MemoHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldMemo, v))
+}
+
+// MemoIsNil applies the IsNil predicate on the "memo" field.
+func MemoIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldMemo))
+}
+
+// MemoNotNil applies the NotNil predicate on the "memo" field.
+func MemoNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldMemo))
+}
+
+// MemoEqualFold applies the EqualFold predicate on the "memo" field.
+func MemoEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldMemo, v))
+}
+
+// MemoContainsFold applies the ContainsFold predicate on the "memo" field.
+func MemoContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldMemo, v))
+}
+
+// ExternalIDEQ applies the EQ predicate on the "external_id" field.
+func ExternalIDEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExternalID, v))
+}
+
+// ExternalIDNEQ applies the NEQ predicate on the "external_id" field.
+func ExternalIDNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldExternalID, v))
+}
+
+// ExternalIDIn applies the In predicate on the "external_id" field.
+func ExternalIDIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldExternalID, vs...))
+}
+
+// ExternalIDNotIn applies the NotIn predicate on the "external_id" field.
+func ExternalIDNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldExternalID, vs...))
+}
+
+// ExternalIDGT applies the GT predicate on the "external_id" field.
+func ExternalIDGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldExternalID, v))
+}
+
+// ExternalIDGTE applies the GTE predicate on the "external_id" field.
+func ExternalIDGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldExternalID, v))
+}
+
+// ExternalIDLT applies the LT predicate on the "external_id" field.
+func ExternalIDLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldExternalID, v))
+}
+
+// ExternalIDLTE applies the LTE predicate on the "external_id" field.
+func ExternalIDLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldExternalID, v))
+}
+
+// ExternalIDContains applies the Contains predicate on the "external_id" field.
+func ExternalIDContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldExternalID, v))
+}
+
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "external_id" field.
+func ExternalIDHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldExternalID, v))
+}
+
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "external_id" field.
+func ExternalIDHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldExternalID, v))
+}
+
+// ExternalIDIsNil applies the IsNil predicate on the "external_id" field.
+func ExternalIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldExternalID))
+}
+
+// ExternalIDNotNil applies the NotNil predicate on the "external_id" field.
+func ExternalIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldExternalID))
+}
+
+// ExternalIDEqualFold applies the EqualFold predicate on the "external_id" field.
+func ExternalIDEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldExternalID, v))
+}
+
+// ExternalIDContainsFold applies the ContainsFold predicate on the "external_id" field.
+func ExternalIDContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldExternalID, v))
+}
+
+// ValueDateEQ applies the EQ predicate on the "value_date" field.
+func ValueDateEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldValueDate, v))
+}
+
+// ValueDateNEQ applies the NEQ predicate on the "value_date" field.
+func ValueDateNEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldValueDate, v))
+}
+
+// ValueDateIn applies the In predicate on the "value_date" field.
+func ValueDateIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldValueDate, vs...))
+}
+
+// ValueDateNotIn applies the NotIn predicate on the "value_date" field.
+func ValueDateNotIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldValueDate, vs...))
+}
+
+// ValueDateGT applies the GT predicate on the "value_date" field.
+func ValueDateGT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldValueDate, v))
+}
+
+// ValueDateGTE applies the GTE predicate on the "value_date" field.
+func ValueDateGTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldValueDate, v))
+}
+
+// ValueDateLT applies the LT predicate on the "value_date" field.
+func ValueDateLT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldValueDate, v))
+}
+
+// ValueDateLTE applies the LTE predicate on the "value_date" field.
+func ValueDateLTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldValueDate, v))
+}
+
+// ValueDateIsNil applies the IsNil predicate on the "value_date" field.
+func ValueDateIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldValueDate))
+}
+
+// ValueDateNotNil applies the NotNil predicate on the "value_date" field.
+func ValueDateNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldValueDate))
+}
+
+// CounterpartyIbanEQ applies the EQ predicate on the "counterparty_iban" field.
+func CounterpartyIbanEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCounterpartyIban, v))
+}
+
+// CounterpartyIbanNEQ applies the NEQ predicate on the "counterparty_iban" field.
+func CounterpartyIbanNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldCounterpartyIban, v))
+}
+
+// CounterpartyIbanIn applies the In predicate on the "counterparty_iban" field.
+func CounterpartyIbanIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldCounterpartyIban, vs...))
+}
+
+// CounterpartyIbanNotIn applies the NotIn predicate on the "counterparty_iban" field.
+func CounterpartyIbanNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldCounterpartyIban, vs...))
+}
+
+// CounterpartyIbanGT applies the GT predicate on the "counterparty_iban" field.
+func CounterpartyIbanGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldCounterpartyIban, v))
+}
+
+// CounterpartyIbanGTE applies the GTE predicate on the "counterparty_iban" field.
+func CounterpartyIbanGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldCounterpartyIban, v))
+}
+
+// CounterpartyIbanLT applies the LT predicate on the "counterparty_iban" field.
+func CounterpartyIbanLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldCounterpartyIban, v))
+}
+
+// CounterpartyIbanLTE applies the LTE predicate on the "counterparty_iban" field.
+func CounterpartyIbanLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldCounterpartyIban, v))
+}
+
+// CounterpartyIbanContains applies the Contains predicate on the "counterparty_iban" field.
+func CounterpartyIbanContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldCounterpartyIban, v))
+}
+
+// CounterpartyIbanHasPrefix applies the HasPrefix predicate on the "counterparty_iban" field.
+func CounterpartyIbanHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldCounterpartyIban, v))
+}
+
+// CounterpartyIbanHasSuffix applies the HasSuffix predicate on the "counterparty_iban" field.
+func CounterpartyIbanHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldCounterpartyIban, v))
+}
+
+// CounterpartyIbanIsNil applies the IsNil predicate on the "counterparty_iban" field.
+func CounterpartyIbanIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldCounterpartyIban))
+}
+
+// CounterpartyIbanNotNil applies the NotNil predicate on the "counterparty_iban" field.
+func CounterpartyIbanNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldCounterpartyIban))
+}
+
+// CounterpartyIbanEqualFold applies the EqualFold predicate on the "counterparty_iban" field.
+func CounterpartyIbanEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldCounterpartyIban, v))
+}
+
+// CounterpartyIbanContainsFold applies the ContainsFold predicate on the "counterparty_iban" field.
+func CounterpartyIbanContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldCounterpartyIban, v))
+}
+
+// EndToEndIDEQ applies the EQ predicate on the "end_to_end_id" field.
+func EndToEndIDEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldEndToEndID, v))
+}
+
+// EndToEndIDNEQ applies the NEQ predicate on the "end_to_end_id" field.
+func EndToEndIDNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldEndToEndID, v))
+}
+
+// EndToEndIDIn applies the In predicate on the "end_to_end_id" field.
+func EndToEndIDIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldEndToEndID, vs...))
+}
+
+// EndToEndIDNotIn applies the NotIn predicate on the "end_to_end_id" field.
+func EndToEndIDNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldEndToEndID, vs...))
+}
+
+// EndToEndIDGT applies the GT predicate on the "end_to_end_id" field.
+func EndToEndIDGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldEndToEndID, v))
+}
+
+// EndToEndIDGTE applies the GTE predicate on the "end_to_end_id" field.
+func EndToEndIDGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldEndToEndID, v))
+}
+
+// EndToEndIDLT applies the LT predicate on the "end_to_end_id" field.
+func EndToEndIDLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldEndToEndID, v))
+}
+
+// EndToEndIDLTE applies the LTE predicate on the "end_to_end_id" field.
+func EndToEndIDLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldEndToEndID, v))
+}
+
+// EndToEndIDContains applies the Contains predicate on the "end_to_end_id" field.
+func EndToEndIDContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldEndToEndID, v))
+}
+
+// EndToEndIDHasPrefix applies the HasPrefix predicate on the "end_to_end_id" field.
+func EndToEndIDHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldEndToEndID, v))
+}
+
+// EndToEndIDHasSuffix applies the HasSuffix predicate on the "end_to_end_id" field.
+func EndToEndIDHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldEndToEndID, v))
+}
+
+// EndToEndIDIsNil applies the IsNil predicate on the "end_to_end_id" field.
+func EndToEndIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldEndToEndID))
+}
+
+// EndToEndIDNotNil applies the NotNil predicate on the "end_to_end_id" field.
+func EndToEndIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldEndToEndID))
+}
+
+// EndToEndIDEqualFold applies the EqualFold predicate on the "end_to_end_id" field.
+func EndToEndIDEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldEndToEndID, v))
+}
+
+// EndToEndIDContainsFold applies the ContainsFold predicate on the "end_to_end_id" field.
+func EndToEndIDContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldEndToEndID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasWorkspace applies the HasEdge predicate on the "workspace" edge.
+func HasWorkspace() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WorkspaceTable, WorkspaceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkspaceWith applies the HasEdge predicate on the "workspace" edge with a given conditions (other predicates).
+func HasWorkspaceWith(preds ...predicate.Workspace) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newWorkspaceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCategory applies the HasEdge predicate on the "category" edge.
+func HasCategory() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCategoryWith applies the HasEdge predicate on the "category" edge with a given conditions (other predicates).
+func HasCategoryWith(preds ...predicate.Category) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newCategoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLinkedPayee applies the HasEdge predicate on the "linked_payee" edge.
+func HasLinkedPayee() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LinkedPayeeTable, LinkedPayeeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinkedPayeeWith applies the HasEdge predicate on the "linked_payee" edge with a given conditions (other predicates).
+func HasLinkedPayeeWith(preds ...predicate.Payee) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newLinkedPayeeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSplits applies the HasEdge predicate on the "splits" edge.
+func HasSplits() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SplitsTable, SplitsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSplitsWith applies the HasEdge predicate on the "splits" edge with a given conditions (other predicates).
+func HasSplitsWith(preds ...predicate.TransactionSplit) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newSplitsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasJournalEntry applies the HasEdge predicate on the "journal_entry" edge.
+func HasJournalEntry() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, JournalEntryTable, JournalEntryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJournalEntryWith applies the HasEdge predicate on the "journal_entry" edge with a given conditions (other predicates).
+func HasJournalEntryWith(preds ...predicate.JournalEntry) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newJournalEntryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOutgoingTransfer applies the HasEdge predicate on the "outgoing_transfer" edge.
+func HasOutgoingTransfer() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, OutgoingTransferTable, OutgoingTransferColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOutgoingTransferWith applies the HasEdge predicate on the "outgoing_transfer" edge with a given conditions (other predicates).
+func HasOutgoingTransferWith(preds ...predicate.Transfer) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newOutgoingTransferStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIncomingTransfer applies the HasEdge predicate on the "incoming_transfer" edge.
+func HasIncomingTransfer() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, IncomingTransferTable, IncomingTransferColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIncomingTransferWith applies the HasEdge predicate on the "incoming_transfer" edge with a given conditions (other predicates).
+func HasIncomingTransferWith(preds ...predicate.Transfer) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newIncomingTransferStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecurrenceOccurrence applies the HasEdge predicate on the "recurrence_occurrence" edge.
+func HasRecurrenceOccurrence() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, RecurrenceOccurrenceTable, RecurrenceOccurrenceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecurrenceOccurrenceWith applies the HasEdge predicate on the "recurrence_occurrence" edge with a given conditions (other predicates).
+func HasRecurrenceOccurrenceWith(preds ...predicate.RecurrenceOccurrence) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newRecurrenceOccurrenceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasImportDecisions applies the HasEdge predicate on the "import_decisions" edge.
+func HasImportDecisions() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ImportDecisionsTable, ImportDecisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasImportDecisionsWith applies the HasEdge predicate on the "import_decisions" edge with a given conditions (other predicates).
+func HasImportDecisionsWith(preds ...predicate.ImportDecision) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newImportDecisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Transaction) predicate.Transaction {
+	return predicate.Transaction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Transaction) predicate.Transaction {
+	return predicate.Transaction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Transaction) predicate.Transaction {
+	return predicate.Transaction(sql.NotPredicates(p))
+}
@@ -0,0 +1,816 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/ent/importdecision"
+	"backend/internal/infrastructure/ent/journalentry"
+	"backend/internal/infrastructure/ent/payee"
+	"backend/internal/infrastructure/ent/recurrenceoccurrence"
+	"backend/internal/infrastructure/ent/tag"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/transactionsplit"
+	"backend/internal/infrastructure/ent/transfer"
+	"backend/internal/infrastructure/ent/workspace"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TransactionCreate is the builder for creating a Transaction entity.
+type TransactionCreate struct {
+	config
+	mutation *TransactionMutation
+	hooks    []Hook
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *TransactionCreate) SetWorkspaceID(v int) *TransactionCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *TransactionCreate) SetAccountID(v int) *TransactionCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *TransactionCreate) SetCategoryID(v int) *TransactionCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableCategoryID(v *int) *TransactionCreate {
+	if v != nil {
+		_c.SetCategoryID(*v)
+	}
+	return _c
+}
+
+// SetPayeeID sets the "payee_id" field.
+func (_c *TransactionCreate) SetPayeeID(v int) *TransactionCreate {
+	_c.mutation.SetPayeeID(v)
+	return _c
+}
+
+// SetNillablePayeeID sets the "payee_id" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillablePayeeID(v *int) *TransactionCreate {
+	if v != nil {
+		_c.SetPayeeID(*v)
+	}
+	return _c
+}
+
+// SetPostedOn sets the "posted_on" field.
+func (_c *TransactionCreate) SetPostedOn(v time.Time) *TransactionCreate {
+	_c.mutation.SetPostedOn(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *TransactionCreate) SetAmount(v model.Decimal) *TransactionCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *TransactionCreate) SetCurrency(v string) *TransactionCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetPayee sets the "payee" field.
+func (_c *TransactionCreate) SetPayee(v string) *TransactionCreate {
+	_c.mutation.SetPayee(v)
+	return _c
+}
+
+// SetNillablePayee sets the "payee" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillablePayee(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetPayee(*v)
+	}
+	return _c
+}
+
+// SetMemo sets the "memo" field.
+func (_c *TransactionCreate) SetMemo(v string) *TransactionCreate {
+	_c.mutation.SetMemo(v)
+	return _c
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableMemo(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetMemo(*v)
+	}
+	return _c
+}
+
+// SetExternalID sets the "external_id" field.
+func (_c *TransactionCreate) SetExternalID(v string) *TransactionCreate {
+	_c.mutation.SetExternalID(v)
+	return _c
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableExternalID(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetExternalID(*v)
+	}
+	return _c
+}
+
+// SetValueDate sets the "value_date" field.
+func (_c *TransactionCreate) SetValueDate(v time.Time) *TransactionCreate {
+	_c.mutation.SetValueDate(v)
+	return _c
+}
+
+// SetNillableValueDate sets the "value_date" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableValueDate(v *time.Time) *TransactionCreate {
+	if v != nil {
+		_c.SetValueDate(*v)
+	}
+	return _c
+}
+
+// SetCounterpartyIban sets the "counterparty_iban" field.
+func (_c *TransactionCreate) SetCounterpartyIban(v string) *TransactionCreate {
+	_c.mutation.SetCounterpartyIban(v)
+	return _c
+}
+
+// SetNillableCounterpartyIban sets the "counterparty_iban" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableCounterpartyIban(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetCounterpartyIban(*v)
+	}
+	return _c
+}
+
+// SetEndToEndID sets the "end_to_end_id" field.
+func (_c *TransactionCreate) SetEndToEndID(v string) *TransactionCreate {
+	_c.mutation.SetEndToEndID(v)
+	return _c
+}
+
+// SetNillableEndToEndID sets the "end_to_end_id" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableEndToEndID(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetEndToEndID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *TransactionCreate) SetStatus(v transaction.Status) *TransactionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableStatus(v *transaction.Status) *TransactionCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TransactionCreate) SetCreatedAt(v time.Time) *TransactionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableCreatedAt(v *time.Time) *TransactionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TransactionCreate) SetUpdatedAt(v time.Time) *TransactionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableUpdatedAt(v *time.Time) *TransactionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_c *TransactionCreate) SetWorkspace(v *Workspace) *TransactionCreate {
+	return _c.SetWorkspaceID(v.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_c *TransactionCreate) SetAccount(v *Account) *TransactionCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_c *TransactionCreate) SetCategory(v *Category) *TransactionCreate {
+	return _c.SetCategoryID(v.ID)
+}
+
+// SetLinkedPayeeID sets the "linked_payee" edge to the Payee entity by ID.
+func (_c *TransactionCreate) SetLinkedPayeeID(id int) *TransactionCreate {
+	_c.mutation.SetLinkedPayeeID(id)
+	return _c
+}
+
+// SetNillableLinkedPayeeID sets the "linked_payee" edge to the Payee entity by ID if the given value is not nil.
+func (_c *TransactionCreate) SetNillableLinkedPayeeID(id *int) *TransactionCreate {
+	if id != nil {
+		_c = _c.SetLinkedPayeeID(*id)
+	}
+	return _c
+}
+
+// SetLinkedPayee sets the "linked_payee" edge to the Payee entity.
+func (_c *TransactionCreate) SetLinkedPayee(v *Payee) *TransactionCreate {
+	return _c.SetLinkedPayeeID(v.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_c *TransactionCreate) AddTagIDs(ids ...int) *TransactionCreate {
+	_c.mutation.AddTagIDs(ids...)
+	return _c
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_c *TransactionCreate) AddTags(v ...*Tag) *TransactionCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagIDs(ids...)
+}
+
+// AddSplitIDs adds the "splits" edge to the TransactionSplit entity by IDs.
+func (_c *TransactionCreate) AddSplitIDs(ids ...int) *TransactionCreate {
+	_c.mutation.AddSplitIDs(ids...)
+	return _c
+}
+
+// AddSplits adds the "splits" edges to the TransactionSplit entity.
+func (_c *TransactionCreate) AddSplits(v ...*TransactionSplit) *TransactionCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSplitIDs(ids...)
+}
+
+// SetJournalEntryID sets the "journal_entry" edge to the JournalEntry entity by ID.
+func (_c *TransactionCreate) SetJournalEntryID(id int) *TransactionCreate {
+	_c.mutation.SetJournalEntryID(id)
+	return _c
+}
+
+// SetNillableJournalEntryID sets the "journal_entry" edge to the JournalEntry entity by ID if the given value is not nil.
+func (_c *TransactionCreate) SetNillableJournalEntryID(id *int) *TransactionCreate {
+	if id != nil {
+		_c = _c.SetJournalEntryID(*id)
+	}
+	return _c
+}
+
+// SetJournalEntry sets the "journal_entry" edge to the JournalEntry entity.
+func (_c *TransactionCreate) SetJournalEntry(v *JournalEntry) *TransactionCreate {
+	return _c.SetJournalEntryID(v.ID)
+}
+
+// SetOutgoingTransferID sets the "outgoing_transfer" edge to the Transfer entity by ID.
+func (_c *TransactionCreate) SetOutgoingTransferID(id int) *TransactionCreate {
+	_c.mutation.SetOutgoingTransferID(id)
+	return _c
+}
+
+// SetNillableOutgoingTransferID sets the "outgoing_transfer" edge to the Transfer entity by ID if the given value is not nil.
+func (_c *TransactionCreate) SetNillableOutgoingTransferID(id *int) *TransactionCreate {
+	if id != nil {
+		_c = _c.SetOutgoingTransferID(*id)
+	}
+	return _c
+}
+
+// SetOutgoingTransfer sets the "outgoing_transfer" edge to the Transfer entity.
+func (_c *TransactionCreate) SetOutgoingTransfer(v *Transfer) *TransactionCreate {
+	return _c.SetOutgoingTransferID(v.ID)
+}
+
+// SetIncomingTransferID sets the "incoming_transfer" edge to the Transfer entity by ID.
+func (_c *TransactionCreate) SetIncomingTransferID(id int) *TransactionCreate {
+	_c.mutation.SetIncomingTransferID(id)
+	return _c
+}
+
+// SetNillableIncomingTransferID sets the "incoming_transfer" edge to the Transfer entity by ID if the given value is not nil.
+func (_c *TransactionCreate) SetNillableIncomingTransferID(id *int) *TransactionCreate {
+	if id != nil {
+		_c = _c.SetIncomingTransferID(*id)
+	}
+	return _c
+}
+
+// SetIncomingTransfer sets the "incoming_transfer" edge to the Transfer entity.
+func (_c *TransactionCreate) SetIncomingTransfer(v *Transfer) *TransactionCreate {
+	return _c.SetIncomingTransferID(v.ID)
+}
+
+// SetRecurrenceOccurrenceID sets the "recurrence_occurrence" edge to the RecurrenceOccurrence entity by ID.
+func (_c *TransactionCreate) SetRecurrenceOccurrenceID(id int) *TransactionCreate {
+	_c.mutation.SetRecurrenceOccurrenceID(id)
+	return _c
+}
+
+// SetNillableRecurrenceOccurrenceID sets the "recurrence_occurrence" edge to the RecurrenceOccurrence entity by ID if the given value is not nil.
+func (_c *TransactionCreate) SetNillableRecurrenceOccurrenceID(id *int) *TransactionCreate {
+	if id != nil {
+		_c = _c.SetRecurrenceOccurrenceID(*id)
+	}
+	return _c
+}
+
+// SetRecurrenceOccurrence sets the "recurrence_occurrence" edge to the RecurrenceOccurrence entity.
+func (_c *TransactionCreate) SetRecurrenceOccurrence(v *RecurrenceOccurrence) *TransactionCreate {
+	return _c.SetRecurrenceOccurrenceID(v.ID)
+}
+
+// AddImportDecisionIDs adds the "import_decisions" edge to the ImportDecision entity by IDs.
+func (_c *TransactionCreate) AddImportDecisionIDs(ids ...int) *TransactionCreate {
+	_c.mutation.AddImportDecisionIDs(ids...)
+	return _c
+}
+
+// AddImportDecisions adds the "import_decisions" edges to the ImportDecision entity.
+func (_c *TransactionCreate) AddImportDecisions(v ...*ImportDecision) *TransactionCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddImportDecisionIDs(ids...)
+}
+
+// Mutation returns the TransactionMutation object of the builder.
+func (_c *TransactionCreate) Mutation() *TransactionMutation {
+	return _c.mutation
+}
+
+// Save creates the Transaction in the database.
+func (_c *TransactionCreate) Save(ctx context.Context) (*Transaction, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TransactionCreate) SaveX(ctx context.Context) *Transaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransactionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransactionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TransactionCreate) defaults() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := transaction.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if transaction.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized transaction.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := transaction.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if transaction.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized transaction.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := transaction.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TransactionCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "Transaction.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "Transaction.account_id"`)}
+	}
+	if _, ok := _c.mutation.PostedOn(); !ok {
+		return &ValidationError{Name: "posted_on", err: errors.New(`ent: missing required field "Transaction.posted_on"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Transaction.amount"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Transaction.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := transaction.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Transaction.currency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Transaction.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := transaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Transaction.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Transaction.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Transaction.updated_at"`)}
+	}
+	if len(_c.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "Transaction.workspace"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "Transaction.account"`)}
+	}
+	return nil
+}
+
+func (_c *TransactionCreate) sqlSave(ctx context.Context) (*Transaction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Transaction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(transaction.Table, sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.PostedOn(); ok {
+		_spec.SetField(transaction.FieldPostedOn, field.TypeTime, value)
+		_node.PostedOn = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(transaction.FieldAmount, field.TypeOther, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(transaction.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Payee(); ok {
+		_spec.SetField(transaction.FieldPayee, field.TypeString, value)
+		_node.Payee = value
+	}
+	if value, ok := _c.mutation.Memo(); ok {
+		_spec.SetField(transaction.FieldMemo, field.TypeString, value)
+		_node.Memo = value
+	}
+	if value, ok := _c.mutation.ExternalID(); ok {
+		_spec.SetField(transaction.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = &value
+	}
+	if value, ok := _c.mutation.ValueDate(); ok {
+		_spec.SetField(transaction.FieldValueDate, field.TypeTime, value)
+		_node.ValueDate = &value
+	}
+	if value, ok := _c.mutation.CounterpartyIban(); ok {
+		_spec.SetField(transaction.FieldCounterpartyIban, field.TypeString, value)
+		_node.CounterpartyIban = value
+	}
+	if value, ok := _c.mutation.EndToEndID(); ok {
+		_spec.SetField(transaction.FieldEndToEndID, field.TypeString, value)
+		_node.EndToEndID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(transaction.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(transaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(transaction.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.WorkspaceTable,
+			Columns: []string{transaction.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.AccountTable,
+			Columns: []string{transaction.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.CategoryTable,
+			Columns: []string{transaction.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CategoryID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LinkedPayeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.LinkedPayeeTable,
+			Columns: []string{transaction.LinkedPayeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PayeeID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   transaction.TagsTable,
+			Columns: transaction.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SplitsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.SplitsTable,
+			Columns: []string{transaction.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.JournalEntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.JournalEntryTable,
+			Columns: []string{transaction.JournalEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OutgoingTransferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.OutgoingTransferTable,
+			Columns: []string{transaction.OutgoingTransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.IncomingTransferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.IncomingTransferTable,
+			Columns: []string{transaction.IncomingTransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RecurrenceOccurrenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.RecurrenceOccurrenceTable,
+			Columns: []string{transaction.RecurrenceOccurrenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrenceoccurrence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ImportDecisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ImportDecisionsTable,
+			Columns: []string{transaction.ImportDecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TransactionCreateBulk is the builder for creating many Transaction entities in bulk.
+type TransactionCreateBulk struct {
+	config
+	err      error
+	builders []*TransactionCreate
+}
+
+// Save creates the Transaction entities in the database.
+func (_c *TransactionCreateBulk) Save(ctx context.Context) ([]*Transaction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Transaction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TransactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TransactionCreateBulk) SaveX(ctx context.Context) []*Transaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransactionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,2040 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/ent/importdecision"
+	"backend/internal/infrastructure/ent/journalentry"
+	"backend/internal/infrastructure/ent/payee"
+	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/recurrenceoccurrence"
+	"backend/internal/infrastructure/ent/tag"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/transactionsplit"
+	"backend/internal/infrastructure/ent/transfer"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TransactionUpdate is the builder for updating Transaction entities.
+type TransactionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TransactionUpdate builder.
+func (_u *TransactionUpdate) Where(ps ...predicate.Transaction) *TransactionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *TransactionUpdate) SetAccountID(v int) *TransactionUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableAccountID(v *int) *TransactionUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *TransactionUpdate) SetCategoryID(v int) *TransactionUpdate {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableCategoryID(v *int) *TransactionUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *TransactionUpdate) ClearCategoryID() *TransactionUpdate {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetPayeeID sets the "payee_id" field.
+func (_u *TransactionUpdate) SetPayeeID(v int) *TransactionUpdate {
+	_u.mutation.SetPayeeID(v)
+	return _u
+}
+
+// SetNillablePayeeID sets the "payee_id" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillablePayeeID(v *int) *TransactionUpdate {
+	if v != nil {
+		_u.SetPayeeID(*v)
+	}
+	return _u
+}
+
+// ClearPayeeID clears the value of the "payee_id" field.
+func (_u *TransactionUpdate) ClearPayeeID() *TransactionUpdate {
+	_u.mutation.ClearPayeeID()
+	return _u
+}
+
+// SetPostedOn sets the "posted_on" field.
+func (_u *TransactionUpdate) SetPostedOn(v time.Time) *TransactionUpdate {
+	_u.mutation.SetPostedOn(v)
+	return _u
+}
+
+// SetNillablePostedOn sets the "posted_on" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillablePostedOn(v *time.Time) *TransactionUpdate {
+	if v != nil {
+		_u.SetPostedOn(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *TransactionUpdate) SetAmount(v model.Decimal) *TransactionUpdate {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableAmount(v *model.Decimal) *TransactionUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *TransactionUpdate) SetCurrency(v string) *TransactionUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableCurrency(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetPayee sets the "payee" field.
+func (_u *TransactionUpdate) SetPayee(v string) *TransactionUpdate {
+	_u.mutation.SetPayee(v)
+	return _u
+}
+
+// SetNillablePayee sets the "payee" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillablePayee(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetPayee(*v)
+	}
+	return _u
+}
+
+// ClearPayee clears the value of the "payee" field.
+func (_u *TransactionUpdate) ClearPayee() *TransactionUpdate {
+	_u.mutation.ClearPayee()
+	return _u
+}
+
+// SetMemo sets the "memo" field.
+func (_u *TransactionUpdate) SetMemo(v string) *TransactionUpdate {
+	_u.mutation.SetMemo(v)
+	return _u
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableMemo(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetMemo(*v)
+	}
+	return _u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (_u *TransactionUpdate) ClearMemo() *TransactionUpdate {
+	_u.mutation.ClearMemo()
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *TransactionUpdate) SetExternalID(v string) *TransactionUpdate {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableExternalID(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (_u *TransactionUpdate) ClearExternalID() *TransactionUpdate {
+	_u.mutation.ClearExternalID()
+	return _u
+}
+
+// SetValueDate sets the "value_date" field.
+func (_u *TransactionUpdate) SetValueDate(v time.Time) *TransactionUpdate {
+	_u.mutation.SetValueDate(v)
+	return _u
+}
+
+// SetNillableValueDate sets the "value_date" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableValueDate(v *time.Time) *TransactionUpdate {
+	if v != nil {
+		_u.SetValueDate(*v)
+	}
+	return _u
+}
+
+// ClearValueDate clears the value of the "value_date" field.
+func (_u *TransactionUpdate) ClearValueDate() *TransactionUpdate {
+	_u.mutation.ClearValueDate()
+	return _u
+}
+
+// SetCounterpartyIban sets the "counterparty_iban" field.
+func (_u *TransactionUpdate) SetCounterpartyIban(v string) *TransactionUpdate {
+	_u.mutation.SetCounterpartyIban(v)
+	return _u
+}
+
+// SetNillableCounterpartyIban sets the "counterparty_iban" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableCounterpartyIban(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetCounterpartyIban(*v)
+	}
+	return _u
+}
+
+// ClearCounterpartyIban clears the value of the "counterparty_iban" field.
+func (_u *TransactionUpdate) ClearCounterpartyIban() *TransactionUpdate {
+	_u.mutation.ClearCounterpartyIban()
+	return _u
+}
+
+// SetEndToEndID sets the "end_to_end_id" field.
+func (_u *TransactionUpdate) SetEndToEndID(v string) *TransactionUpdate {
+	_u.mutation.SetEndToEndID(v)
+	return _u
+}
+
+// SetNillableEndToEndID sets the "end_to_end_id" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableEndToEndID(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetEndToEndID(*v)
+	}
+	return _u
+}
+
+// ClearEndToEndID clears the value of the "end_to_end_id" field.
+func (_u *TransactionUpdate) ClearEndToEndID() *TransactionUpdate {
+	_u.mutation.ClearEndToEndID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TransactionUpdate) SetStatus(v transaction.Status) *TransactionUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableStatus(v *transaction.Status) *TransactionUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TransactionUpdate) SetUpdatedAt(v time.Time) *TransactionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *TransactionUpdate) SetAccount(v *Account) *TransactionUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *TransactionUpdate) SetCategory(v *Category) *TransactionUpdate {
+	return _u.SetCategoryID(v.ID)
+}
+
+// SetLinkedPayeeID sets the "linked_payee" edge to the Payee entity by ID.
+func (_u *TransactionUpdate) SetLinkedPayeeID(id int) *TransactionUpdate {
+	_u.mutation.SetLinkedPayeeID(id)
+	return _u
+}
+
+// SetNillableLinkedPayeeID sets the "linked_payee" edge to the Payee entity by ID if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableLinkedPayeeID(id *int) *TransactionUpdate {
+	if id != nil {
+		_u = _u.SetLinkedPayeeID(*id)
+	}
+	return _u
+}
+
+// SetLinkedPayee sets the "linked_payee" edge to the Payee entity.
+func (_u *TransactionUpdate) SetLinkedPayee(v *Payee) *TransactionUpdate {
+	return _u.SetLinkedPayeeID(v.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *TransactionUpdate) AddTagIDs(ids ...int) *TransactionUpdate {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *TransactionUpdate) AddTags(v ...*Tag) *TransactionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// AddSplitIDs adds the "splits" edge to the TransactionSplit entity by IDs.
+func (_u *TransactionUpdate) AddSplitIDs(ids ...int) *TransactionUpdate {
+	_u.mutation.AddSplitIDs(ids...)
+	return _u
+}
+
+// AddSplits adds the "splits" edges to the TransactionSplit entity.
+func (_u *TransactionUpdate) AddSplits(v ...*TransactionSplit) *TransactionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSplitIDs(ids...)
+}
+
+// SetJournalEntryID sets the "journal_entry" edge to the JournalEntry entity by ID.
+func (_u *TransactionUpdate) SetJournalEntryID(id int) *TransactionUpdate {
+	_u.mutation.SetJournalEntryID(id)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry" edge to the JournalEntry entity by ID if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableJournalEntryID(id *int) *TransactionUpdate {
+	if id != nil {
+		_u = _u.SetJournalEntryID(*id)
+	}
+	return _u
+}
+
+// SetJournalEntry sets the "journal_entry" edge to the JournalEntry entity.
+func (_u *TransactionUpdate) SetJournalEntry(v *JournalEntry) *TransactionUpdate {
+	return _u.SetJournalEntryID(v.ID)
+}
+
+// SetOutgoingTransferID sets the "outgoing_transfer" edge to the Transfer entity by ID.
+func (_u *TransactionUpdate) SetOutgoingTransferID(id int) *TransactionUpdate {
+	_u.mutation.SetOutgoingTransferID(id)
+	return _u
+}
+
+// SetNillableOutgoingTransferID sets the "outgoing_transfer" edge to the Transfer entity by ID if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableOutgoingTransferID(id *int) *TransactionUpdate {
+	if id != nil {
+		_u = _u.SetOutgoingTransferID(*id)
+	}
+	return _u
+}
+
+// SetOutgoingTransfer sets the "outgoing_transfer" edge to the Transfer entity.
+func (_u *TransactionUpdate) SetOutgoingTransfer(v *Transfer) *TransactionUpdate {
+	return _u.SetOutgoingTransferID(v.ID)
+}
+
+// SetIncomingTransferID sets the "incoming_transfer" edge to the Transfer entity by ID.
+func (_u *TransactionUpdate) SetIncomingTransferID(id int) *TransactionUpdate {
+	_u.mutation.SetIncomingTransferID(id)
+	return _u
+}
+
+// SetNillableIncomingTransferID sets the "incoming_transfer" edge to the Transfer entity by ID if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableIncomingTransferID(id *int) *TransactionUpdate {
+	if id != nil {
+		_u = _u.SetIncomingTransferID(*id)
+	}
+	return _u
+}
+
+// SetIncomingTransfer sets the "incoming_transfer" edge to the Transfer entity.
+func (_u *TransactionUpdate) SetIncomingTransfer(v *Transfer) *TransactionUpdate {
+	return _u.SetIncomingTransferID(v.ID)
+}
+
+// SetRecurrenceOccurrenceID sets the "recurrence_occurrence" edge to the RecurrenceOccurrence entity by ID.
+func (_u *TransactionUpdate) SetRecurrenceOccurrenceID(id int) *TransactionUpdate {
+	_u.mutation.SetRecurrenceOccurrenceID(id)
+	return _u
+}
+
+// SetNillableRecurrenceOccurrenceID sets the "recurrence_occurrence" edge to the RecurrenceOccurrence entity by ID if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableRecurrenceOccurrenceID(id *int) *TransactionUpdate {
+	if id != nil {
+		_u = _u.SetRecurrenceOccurrenceID(*id)
+	}
+	return _u
+}
+
+// SetRecurrenceOccurrence sets the "recurrence_occurrence" edge to the RecurrenceOccurrence entity.
+func (_u *TransactionUpdate) SetRecurrenceOccurrence(v *RecurrenceOccurrence) *TransactionUpdate {
+	return _u.SetRecurrenceOccurrenceID(v.ID)
+}
+
+// AddImportDecisionIDs adds the "import_decisions" edge to the ImportDecision entity by IDs.
+func (_u *TransactionUpdate) AddImportDecisionIDs(ids ...int) *TransactionUpdate {
+	_u.mutation.AddImportDecisionIDs(ids...)
+	return _u
+}
+
+// AddImportDecisions adds the "import_decisions" edges to the ImportDecision entity.
+func (_u *TransactionUpdate) AddImportDecisions(v ...*ImportDecision) *TransactionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddImportDecisionIDs(ids...)
+}
+
+// Mutation returns the TransactionMutation object of the builder.
+func (_u *TransactionUpdate) Mutation() *TransactionMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *TransactionUpdate) ClearAccount() *TransactionUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *TransactionUpdate) ClearCategory() *TransactionUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// ClearLinkedPayee clears the "linked_payee" edge to the Payee entity.
+func (_u *TransactionUpdate) ClearLinkedPayee() *TransactionUpdate {
+	_u.mutation.ClearLinkedPayee()
+	return _u
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *TransactionUpdate) ClearTags() *TransactionUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *TransactionUpdate) RemoveTagIDs(ids ...int) *TransactionUpdate {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *TransactionUpdate) RemoveTags(v ...*Tag) *TransactionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearSplits clears all "splits" edges to the TransactionSplit entity.
+func (_u *TransactionUpdate) ClearSplits() *TransactionUpdate {
+	_u.mutation.ClearSplits()
+	return _u
+}
+
+// RemoveSplitIDs removes the "splits" edge to TransactionSplit entities by IDs.
+func (_u *TransactionUpdate) RemoveSplitIDs(ids ...int) *TransactionUpdate {
+	_u.mutation.RemoveSplitIDs(ids...)
+	return _u
+}
+
+// RemoveSplits removes "splits" edges to TransactionSplit entities.
+func (_u *TransactionUpdate) RemoveSplits(v ...*TransactionSplit) *TransactionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSplitIDs(ids...)
+}
+
+// ClearJournalEntry clears the "journal_entry" edge to the JournalEntry entity.
+func (_u *TransactionUpdate) ClearJournalEntry() *TransactionUpdate {
+	_u.mutation.ClearJournalEntry()
+	return _u
+}
+
+// ClearOutgoingTransfer clears the "outgoing_transfer" edge to the Transfer entity.
+func (_u *TransactionUpdate) ClearOutgoingTransfer() *TransactionUpdate {
+	_u.mutation.ClearOutgoingTransfer()
+	return _u
+}
+
+// ClearIncomingTransfer clears the "incoming_transfer" edge to the Transfer entity.
+func (_u *TransactionUpdate) ClearIncomingTransfer() *TransactionUpdate {
+	_u.mutation.ClearIncomingTransfer()
+	return _u
+}
+
+// ClearRecurrenceOccurrence clears the "recurrence_occurrence" edge to the RecurrenceOccurrence entity.
+func (_u *TransactionUpdate) ClearRecurrenceOccurrence() *TransactionUpdate {
+	_u.mutation.ClearRecurrenceOccurrence()
+	return _u
+}
+
+// ClearImportDecisions clears all "import_decisions" edges to the ImportDecision entity.
+func (_u *TransactionUpdate) ClearImportDecisions() *TransactionUpdate {
+	_u.mutation.ClearImportDecisions()
+	return _u
+}
+
+// RemoveImportDecisionIDs removes the "import_decisions" edge to ImportDecision entities by IDs.
+func (_u *TransactionUpdate) RemoveImportDecisionIDs(ids ...int) *TransactionUpdate {
+	_u.mutation.RemoveImportDecisionIDs(ids...)
+	return _u
+}
+
+// RemoveImportDecisions removes "import_decisions" edges to ImportDecision entities.
+func (_u *TransactionUpdate) RemoveImportDecisions(v ...*ImportDecision) *TransactionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveImportDecisionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TransactionUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TransactionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransactionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TransactionUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if transaction.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized transaction.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := transaction.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransactionUpdate) check() error {
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := transaction.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Transaction.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := transaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Transaction.status": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Transaction.workspace"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Transaction.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TransactionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransactionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transaction.Table, transaction.Columns, sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PostedOn(); ok {
+		_spec.SetField(transaction.FieldPostedOn, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(transaction.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(transaction.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payee(); ok {
+		_spec.SetField(transaction.FieldPayee, field.TypeString, value)
+	}
+	if _u.mutation.PayeeCleared() {
+		_spec.ClearField(transaction.FieldPayee, field.TypeString)
+	}
+	if value, ok := _u.mutation.Memo(); ok {
+		_spec.SetField(transaction.FieldMemo, field.TypeString, value)
+	}
+	if _u.mutation.MemoCleared() {
+		_spec.ClearField(transaction.FieldMemo, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(transaction.FieldExternalID, field.TypeString, value)
+	}
+	if _u.mutation.ExternalIDCleared() {
+		_spec.ClearField(transaction.FieldExternalID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ValueDate(); ok {
+		_spec.SetField(transaction.FieldValueDate, field.TypeTime, value)
+	}
+	if _u.mutation.ValueDateCleared() {
+		_spec.ClearField(transaction.FieldValueDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CounterpartyIban(); ok {
+		_spec.SetField(transaction.FieldCounterpartyIban, field.TypeString, value)
+	}
+	if _u.mutation.CounterpartyIbanCleared() {
+		_spec.ClearField(transaction.FieldCounterpartyIban, field.TypeString)
+	}
+	if value, ok := _u.mutation.EndToEndID(); ok {
+		_spec.SetField(transaction.FieldEndToEndID, field.TypeString, value)
+	}
+	if _u.mutation.EndToEndIDCleared() {
+		_spec.ClearField(transaction.FieldEndToEndID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(transaction.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(transaction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.AccountTable,
+			Columns: []string{transaction.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.AccountTable,
+			Columns: []string{transaction.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.CategoryTable,
+			Columns: []string{transaction.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.CategoryTable,
+			Columns: []string{transaction.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinkedPayeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.LinkedPayeeTable,
+			Columns: []string{transaction.LinkedPayeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinkedPayeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.LinkedPayeeTable,
+			Columns: []string{transaction.LinkedPayeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   transaction.TagsTable,
+			Columns: transaction.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   transaction.TagsTable,
+			Columns: transaction.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   transaction.TagsTable,
+			Columns: transaction.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SplitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.SplitsTable,
+			Columns: []string{transaction.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSplitsIDs(); len(nodes) > 0 && !_u.mutation.SplitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.SplitsTable,
+			Columns: []string{transaction.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SplitsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.SplitsTable,
+			Columns: []string{transaction.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.JournalEntryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.JournalEntryTable,
+			Columns: []string{transaction.JournalEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JournalEntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.JournalEntryTable,
+			Columns: []string{transaction.JournalEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OutgoingTransferCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.OutgoingTransferTable,
+			Columns: []string{transaction.OutgoingTransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OutgoingTransferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.OutgoingTransferTable,
+			Columns: []string{transaction.OutgoingTransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.IncomingTransferCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.IncomingTransferTable,
+			Columns: []string{transaction.IncomingTransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IncomingTransferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.IncomingTransferTable,
+			Columns: []string{transaction.IncomingTransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecurrenceOccurrenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.RecurrenceOccurrenceTable,
+			Columns: []string{transaction.RecurrenceOccurrenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrenceoccurrence.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecurrenceOccurrenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.RecurrenceOccurrenceTable,
+			Columns: []string{transaction.RecurrenceOccurrenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrenceoccurrence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ImportDecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ImportDecisionsTable,
+			Columns: []string{transaction.ImportDecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedImportDecisionsIDs(); len(nodes) > 0 && !_u.mutation.ImportDecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ImportDecisionsTable,
+			Columns: []string{transaction.ImportDecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImportDecisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ImportDecisionsTable,
+			Columns: []string{transaction.ImportDecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TransactionUpdateOne is the builder for updating a single Transaction entity.
+type TransactionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *TransactionUpdateOne) SetAccountID(v int) *TransactionUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableAccountID(v *int) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *TransactionUpdateOne) SetCategoryID(v int) *TransactionUpdateOne {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableCategoryID(v *int) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *TransactionUpdateOne) ClearCategoryID() *TransactionUpdateOne {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetPayeeID sets the "payee_id" field.
+func (_u *TransactionUpdateOne) SetPayeeID(v int) *TransactionUpdateOne {
+	_u.mutation.SetPayeeID(v)
+	return _u
+}
+
+// SetNillablePayeeID sets the "payee_id" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillablePayeeID(v *int) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetPayeeID(*v)
+	}
+	return _u
+}
+
+// ClearPayeeID clears the value of the "payee_id" field.
+func (_u *TransactionUpdateOne) ClearPayeeID() *TransactionUpdateOne {
+	_u.mutation.ClearPayeeID()
+	return _u
+}
+
+// SetPostedOn sets the "posted_on" field.
+func (_u *TransactionUpdateOne) SetPostedOn(v time.Time) *TransactionUpdateOne {
+	_u.mutation.SetPostedOn(v)
+	return _u
+}
+
+// SetNillablePostedOn sets the "posted_on" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillablePostedOn(v *time.Time) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetPostedOn(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *TransactionUpdateOne) SetAmount(v model.Decimal) *TransactionUpdateOne {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableAmount(v *model.Decimal) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *TransactionUpdateOne) SetCurrency(v string) *TransactionUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableCurrency(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetPayee sets the "payee" field.
+func (_u *TransactionUpdateOne) SetPayee(v string) *TransactionUpdateOne {
+	_u.mutation.SetPayee(v)
+	return _u
+}
+
+// SetNillablePayee sets the "payee" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillablePayee(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetPayee(*v)
+	}
+	return _u
+}
+
+// ClearPayee clears the value of the "payee" field.
+func (_u *TransactionUpdateOne) ClearPayee() *TransactionUpdateOne {
+	_u.mutation.ClearPayee()
+	return _u
+}
+
+// SetMemo sets the "memo" field.
+func (_u *TransactionUpdateOne) SetMemo(v string) *TransactionUpdateOne {
+	_u.mutation.SetMemo(v)
+	return _u
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableMemo(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetMemo(*v)
+	}
+	return _u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (_u *TransactionUpdateOne) ClearMemo() *TransactionUpdateOne {
+	_u.mutation.ClearMemo()
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *TransactionUpdateOne) SetExternalID(v string) *TransactionUpdateOne {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableExternalID(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (_u *TransactionUpdateOne) ClearExternalID() *TransactionUpdateOne {
+	_u.mutation.ClearExternalID()
+	return _u
+}
+
+// SetValueDate sets the "value_date" field.
+func (_u *TransactionUpdateOne) SetValueDate(v time.Time) *TransactionUpdateOne {
+	_u.mutation.SetValueDate(v)
+	return _u
+}
+
+// SetNillableValueDate sets the "value_date" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableValueDate(v *time.Time) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetValueDate(*v)
+	}
+	return _u
+}
+
+// ClearValueDate clears the value of the "value_date" field.
+func (_u *TransactionUpdateOne) ClearValueDate() *TransactionUpdateOne {
+	_u.mutation.ClearValueDate()
+	return _u
+}
+
+// SetCounterpartyIban sets the "counterparty_iban" field.
+func (_u *TransactionUpdateOne) SetCounterpartyIban(v string) *TransactionUpdateOne {
+	_u.mutation.SetCounterpartyIban(v)
+	return _u
+}
+
+// SetNillableCounterpartyIban sets the "counterparty_iban" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableCounterpartyIban(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetCounterpartyIban(*v)
+	}
+	return _u
+}
+
+// ClearCounterpartyIban clears the value of the "counterparty_iban" field.
+func (_u *TransactionUpdateOne) ClearCounterpartyIban() *TransactionUpdateOne {
+	_u.mutation.ClearCounterpartyIban()
+	return _u
+}
+
+// SetEndToEndID sets the "end_to_end_id" field.
+func (_u *TransactionUpdateOne) SetEndToEndID(v string) *TransactionUpdateOne {
+	_u.mutation.SetEndToEndID(v)
+	return _u
+}
+
+// SetNillableEndToEndID sets the "end_to_end_id" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableEndToEndID(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetEndToEndID(*v)
+	}
+	return _u
+}
+
+// ClearEndToEndID clears the value of the "end_to_end_id" field.
+func (_u *TransactionUpdateOne) ClearEndToEndID() *TransactionUpdateOne {
+	_u.mutation.ClearEndToEndID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TransactionUpdateOne) SetStatus(v transaction.Status) *TransactionUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableStatus(v *transaction.Status) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TransactionUpdateOne) SetUpdatedAt(v time.Time) *TransactionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (_u *TransactionUpdateOne) SetAccount(v *Account) *TransactionUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *TransactionUpdateOne) SetCategory(v *Category) *TransactionUpdateOne {
+	return _u.SetCategoryID(v.ID)
+}
+
+// SetLinkedPayeeID sets the "linked_payee" edge to the Payee entity by ID.
+func (_u *TransactionUpdateOne) SetLinkedPayeeID(id int) *TransactionUpdateOne {
+	_u.mutation.SetLinkedPayeeID(id)
+	return _u
+}
+
+// SetNillableLinkedPayeeID sets the "linked_payee" edge to the Payee entity by ID if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableLinkedPayeeID(id *int) *TransactionUpdateOne {
+	if id != nil {
+		_u = _u.SetLinkedPayeeID(*id)
+	}
+	return _u
+}
+
+// SetLinkedPayee sets the "linked_payee" edge to the Payee entity.
+func (_u *TransactionUpdateOne) SetLinkedPayee(v *Payee) *TransactionUpdateOne {
+	return _u.SetLinkedPayeeID(v.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *TransactionUpdateOne) AddTagIDs(ids ...int) *TransactionUpdateOne {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *TransactionUpdateOne) AddTags(v ...*Tag) *TransactionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// AddSplitIDs adds the "splits" edge to the TransactionSplit entity by IDs.
+func (_u *TransactionUpdateOne) AddSplitIDs(ids ...int) *TransactionUpdateOne {
+	_u.mutation.AddSplitIDs(ids...)
+	return _u
+}
+
+// AddSplits adds the "splits" edges to the TransactionSplit entity.
+func (_u *TransactionUpdateOne) AddSplits(v ...*TransactionSplit) *TransactionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSplitIDs(ids...)
+}
+
+// SetJournalEntryID sets the "journal_entry" edge to the JournalEntry entity by ID.
+func (_u *TransactionUpdateOne) SetJournalEntryID(id int) *TransactionUpdateOne {
+	_u.mutation.SetJournalEntryID(id)
+	return _u
+}
+
+// SetNillableJournalEntryID sets the "journal_entry" edge to the JournalEntry entity by ID if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableJournalEntryID(id *int) *TransactionUpdateOne {
+	if id != nil {
+		_u = _u.SetJournalEntryID(*id)
+	}
+	return _u
+}
+
+// SetJournalEntry sets the "journal_entry" edge to the JournalEntry entity.
+func (_u *TransactionUpdateOne) SetJournalEntry(v *JournalEntry) *TransactionUpdateOne {
+	return _u.SetJournalEntryID(v.ID)
+}
+
+// SetOutgoingTransferID sets the "outgoing_transfer" edge to the Transfer entity by ID.
+func (_u *TransactionUpdateOne) SetOutgoingTransferID(id int) *TransactionUpdateOne {
+	_u.mutation.SetOutgoingTransferID(id)
+	return _u
+}
+
+// SetNillableOutgoingTransferID sets the "outgoing_transfer" edge to the Transfer entity by ID if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableOutgoingTransferID(id *int) *TransactionUpdateOne {
+	if id != nil {
+		_u = _u.SetOutgoingTransferID(*id)
+	}
+	return _u
+}
+
+// SetOutgoingTransfer sets the "outgoing_transfer" edge to the Transfer entity.
+func (_u *TransactionUpdateOne) SetOutgoingTransfer(v *Transfer) *TransactionUpdateOne {
+	return _u.SetOutgoingTransferID(v.ID)
+}
+
+// SetIncomingTransferID sets the "incoming_transfer" edge to the Transfer entity by ID.
+func (_u *TransactionUpdateOne) SetIncomingTransferID(id int) *TransactionUpdateOne {
+	_u.mutation.SetIncomingTransferID(id)
+	return _u
+}
+
+// SetNillableIncomingTransferID sets the "incoming_transfer" edge to the Transfer entity by ID if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableIncomingTransferID(id *int) *TransactionUpdateOne {
+	if id != nil {
+		_u = _u.SetIncomingTransferID(*id)
+	}
+	return _u
+}
+
+// SetIncomingTransfer sets the "incoming_transfer" edge to the Transfer entity.
+func (_u *TransactionUpdateOne) SetIncomingTransfer(v *Transfer) *TransactionUpdateOne {
+	return _u.SetIncomingTransferID(v.ID)
+}
+
+// SetRecurrenceOccurrenceID sets the "recurrence_occurrence" edge to the RecurrenceOccurrence entity by ID.
+func (_u *TransactionUpdateOne) SetRecurrenceOccurrenceID(id int) *TransactionUpdateOne {
+	_u.mutation.SetRecurrenceOccurrenceID(id)
+	return _u
+}
+
+// SetNillableRecurrenceOccurrenceID sets the "recurrence_occurrence" edge to the RecurrenceOccurrence entity by ID if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableRecurrenceOccurrenceID(id *int) *TransactionUpdateOne {
+	if id != nil {
+		_u = _u.SetRecurrenceOccurrenceID(*id)
+	}
+	return _u
+}
+
+// SetRecurrenceOccurrence sets the "recurrence_occurrence" edge to the RecurrenceOccurrence entity.
+func (_u *TransactionUpdateOne) SetRecurrenceOccurrence(v *RecurrenceOccurrence) *TransactionUpdateOne {
+	return _u.SetRecurrenceOccurrenceID(v.ID)
+}
+
+// AddImportDecisionIDs adds the "import_decisions" edge to the ImportDecision entity by IDs.
+func (_u *TransactionUpdateOne) AddImportDecisionIDs(ids ...int) *TransactionUpdateOne {
+	_u.mutation.AddImportDecisionIDs(ids...)
+	return _u
+}
+
+// AddImportDecisions adds the "import_decisions" edges to the ImportDecision entity.
+func (_u *TransactionUpdateOne) AddImportDecisions(v ...*ImportDecision) *TransactionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddImportDecisionIDs(ids...)
+}
+
+// Mutation returns the TransactionMutation object of the builder.
+func (_u *TransactionUpdateOne) Mutation() *TransactionMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (_u *TransactionUpdateOne) ClearAccount() *TransactionUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *TransactionUpdateOne) ClearCategory() *TransactionUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// ClearLinkedPayee clears the "linked_payee" edge to the Payee entity.
+func (_u *TransactionUpdateOne) ClearLinkedPayee() *TransactionUpdateOne {
+	_u.mutation.ClearLinkedPayee()
+	return _u
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *TransactionUpdateOne) ClearTags() *TransactionUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *TransactionUpdateOne) RemoveTagIDs(ids ...int) *TransactionUpdateOne {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *TransactionUpdateOne) RemoveTags(v ...*Tag) *TransactionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearSplits clears all "splits" edges to the TransactionSplit entity.
+func (_u *TransactionUpdateOne) ClearSplits() *TransactionUpdateOne {
+	_u.mutation.ClearSplits()
+	return _u
+}
+
+// RemoveSplitIDs removes the "splits" edge to TransactionSplit entities by IDs.
+func (_u *TransactionUpdateOne) RemoveSplitIDs(ids ...int) *TransactionUpdateOne {
+	_u.mutation.RemoveSplitIDs(ids...)
+	return _u
+}
+
+// RemoveSplits removes "splits" edges to TransactionSplit entities.
+func (_u *TransactionUpdateOne) RemoveSplits(v ...*TransactionSplit) *TransactionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSplitIDs(ids...)
+}
+
+// ClearJournalEntry clears the "journal_entry" edge to the JournalEntry entity.
+func (_u *TransactionUpdateOne) ClearJournalEntry() *TransactionUpdateOne {
+	_u.mutation.ClearJournalEntry()
+	return _u
+}
+
+// ClearOutgoingTransfer clears the "outgoing_transfer" edge to the Transfer entity.
+func (_u *TransactionUpdateOne) ClearOutgoingTransfer() *TransactionUpdateOne {
+	_u.mutation.ClearOutgoingTransfer()
+	return _u
+}
+
+// ClearIncomingTransfer clears the "incoming_transfer" edge to the Transfer entity.
+func (_u *TransactionUpdateOne) ClearIncomingTransfer() *TransactionUpdateOne {
+	_u.mutation.ClearIncomingTransfer()
+	return _u
+}
+
+// ClearRecurrenceOccurrence clears the "recurrence_occurrence" edge to the RecurrenceOccurrence entity.
+func (_u *TransactionUpdateOne) ClearRecurrenceOccurrence() *TransactionUpdateOne {
+	_u.mutation.ClearRecurrenceOccurrence()
+	return _u
+}
+
+// ClearImportDecisions clears all "import_decisions" edges to the ImportDecision entity.
+func (_u *TransactionUpdateOne) ClearImportDecisions() *TransactionUpdateOne {
+	_u.mutation.ClearImportDecisions()
+	return _u
+}
+
+// RemoveImportDecisionIDs removes the "import_decisions" edge to ImportDecision entities by IDs.
+func (_u *TransactionUpdateOne) RemoveImportDecisionIDs(ids ...int) *TransactionUpdateOne {
+	_u.mutation.RemoveImportDecisionIDs(ids...)
+	return _u
+}
+
+// RemoveImportDecisions removes "import_decisions" edges to ImportDecision entities.
+func (_u *TransactionUpdateOne) RemoveImportDecisions(v ...*ImportDecision) *TransactionUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveImportDecisionIDs(ids...)
+}
+
+// Where appends a list predicates to the TransactionUpdate builder.
+func (_u *TransactionUpdateOne) Where(ps ...predicate.Transaction) *TransactionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TransactionUpdateOne) Select(field string, fields ...string) *TransactionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Transaction entity.
+func (_u *TransactionUpdateOne) Save(ctx context.Context) (*Transaction, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransactionUpdateOne) SaveX(ctx context.Context) *Transaction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TransactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransactionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TransactionUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if transaction.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized transaction.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := transaction.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransactionUpdateOne) check() error {
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := transaction.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Transaction.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := transaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Transaction.status": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Transaction.workspace"`)
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Transaction.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TransactionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransactionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transaction.Table, transaction.Columns, sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Transaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transaction.FieldID)
+		for _, f := range fields {
+			if !transaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != transaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PostedOn(); ok {
+		_spec.SetField(transaction.FieldPostedOn, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(transaction.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(transaction.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payee(); ok {
+		_spec.SetField(transaction.FieldPayee, field.TypeString, value)
+	}
+	if _u.mutation.PayeeCleared() {
+		_spec.ClearField(transaction.FieldPayee, field.TypeString)
+	}
+	if value, ok := _u.mutation.Memo(); ok {
+		_spec.SetField(transaction.FieldMemo, field.TypeString, value)
+	}
+	if _u.mutation.MemoCleared() {
+		_spec.ClearField(transaction.FieldMemo, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(transaction.FieldExternalID, field.TypeString, value)
+	}
+	if _u.mutation.ExternalIDCleared() {
+		_spec.ClearField(transaction.FieldExternalID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ValueDate(); ok {
+		_spec.SetField(transaction.FieldValueDate, field.TypeTime, value)
+	}
+	if _u.mutation.ValueDateCleared() {
+		_spec.ClearField(transaction.FieldValueDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CounterpartyIban(); ok {
+		_spec.SetField(transaction.FieldCounterpartyIban, field.TypeString, value)
+	}
+	if _u.mutation.CounterpartyIbanCleared() {
+		_spec.ClearField(transaction.FieldCounterpartyIban, field.TypeString)
+	}
+	if value, ok := _u.mutation.EndToEndID(); ok {
+		_spec.SetField(transaction.FieldEndToEndID, field.TypeString, value)
+	}
+	if _u.mutation.EndToEndIDCleared() {
+		_spec.ClearField(transaction.FieldEndToEndID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(transaction.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(transaction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.AccountTable,
+			Columns: []string{transaction.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.AccountTable,
+			Columns: []string{transaction.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.CategoryTable,
+			Columns: []string{transaction.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.CategoryTable,
+			Columns: []string{transaction.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinkedPayeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.LinkedPayeeTable,
+			Columns: []string{transaction.LinkedPayeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinkedPayeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.LinkedPayeeTable,
+			Columns: []string{transaction.LinkedPayeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   transaction.TagsTable,
+			Columns: transaction.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   transaction.TagsTable,
+			Columns: transaction.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   transaction.TagsTable,
+			Columns: transaction.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SplitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.SplitsTable,
+			Columns: []string{transaction.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSplitsIDs(); len(nodes) > 0 && !_u.mutation.SplitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.SplitsTable,
+			Columns: []string{transaction.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SplitsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.SplitsTable,
+			Columns: []string{transaction.SplitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.JournalEntryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.JournalEntryTable,
+			Columns: []string{transaction.JournalEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JournalEntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.JournalEntryTable,
+			Columns: []string{transaction.JournalEntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OutgoingTransferCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.OutgoingTransferTable,
+			Columns: []string{transaction.OutgoingTransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OutgoingTransferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.OutgoingTransferTable,
+			Columns: []string{transaction.OutgoingTransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.IncomingTransferCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.IncomingTransferTable,
+			Columns: []string{transaction.IncomingTransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IncomingTransferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.IncomingTransferTable,
+			Columns: []string{transaction.IncomingTransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecurrenceOccurrenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.RecurrenceOccurrenceTable,
+			Columns: []string{transaction.RecurrenceOccurrenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrenceoccurrence.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecurrenceOccurrenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.RecurrenceOccurrenceTable,
+			Columns: []string{transaction.RecurrenceOccurrenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurrenceoccurrence.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ImportDecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ImportDecisionsTable,
+			Columns: []string{transaction.ImportDecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedImportDecisionsIDs(); len(nodes) > 0 && !_u.mutation.ImportDecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ImportDecisionsTable,
+			Columns: []string{transaction.ImportDecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImportDecisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ImportDecisionsTable,
+			Columns: []string{transaction.ImportDecisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importdecision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Transaction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
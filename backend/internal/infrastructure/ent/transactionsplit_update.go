@@ -0,0 +1,634 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/tag"
+	"backend/internal/infrastructure/ent/transactionsplit"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TransactionSplitUpdate is the builder for updating TransactionSplit entities.
+type TransactionSplitUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TransactionSplitMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TransactionSplitUpdate builder.
+func (_u *TransactionSplitUpdate) Where(ps ...predicate.TransactionSplit) *TransactionSplitUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *TransactionSplitUpdate) SetCategoryID(v int) *TransactionSplitUpdate {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *TransactionSplitUpdate) SetNillableCategoryID(v *int) *TransactionSplitUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *TransactionSplitUpdate) ClearCategoryID() *TransactionSplitUpdate {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *TransactionSplitUpdate) SetAmount(v model.Decimal) *TransactionSplitUpdate {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *TransactionSplitUpdate) SetNillableAmount(v *model.Decimal) *TransactionSplitUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *TransactionSplitUpdate) SetCurrency(v string) *TransactionSplitUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *TransactionSplitUpdate) SetNillableCurrency(v *string) *TransactionSplitUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetMemo sets the "memo" field.
+func (_u *TransactionSplitUpdate) SetMemo(v string) *TransactionSplitUpdate {
+	_u.mutation.SetMemo(v)
+	return _u
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_u *TransactionSplitUpdate) SetNillableMemo(v *string) *TransactionSplitUpdate {
+	if v != nil {
+		_u.SetMemo(*v)
+	}
+	return _u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (_u *TransactionSplitUpdate) ClearMemo() *TransactionSplitUpdate {
+	_u.mutation.ClearMemo()
+	return _u
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *TransactionSplitUpdate) SetCategory(v *Category) *TransactionSplitUpdate {
+	return _u.SetCategoryID(v.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *TransactionSplitUpdate) AddTagIDs(ids ...int) *TransactionSplitUpdate {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *TransactionSplitUpdate) AddTags(v ...*Tag) *TransactionSplitUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// Mutation returns the TransactionSplitMutation object of the builder.
+func (_u *TransactionSplitUpdate) Mutation() *TransactionSplitMutation {
+	return _u.mutation
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *TransactionSplitUpdate) ClearCategory() *TransactionSplitUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *TransactionSplitUpdate) ClearTags() *TransactionSplitUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *TransactionSplitUpdate) RemoveTagIDs(ids ...int) *TransactionSplitUpdate {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *TransactionSplitUpdate) RemoveTags(v ...*Tag) *TransactionSplitUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TransactionSplitUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransactionSplitUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TransactionSplitUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransactionSplitUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransactionSplitUpdate) check() error {
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := transactionsplit.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "TransactionSplit.currency": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransactionSplit.workspace"`)
+	}
+	if _u.mutation.TransactionCleared() && len(_u.mutation.TransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransactionSplit.transaction"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TransactionSplitUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransactionSplitUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TransactionSplitUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transactionsplit.Table, transactionsplit.Columns, sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(transactionsplit.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(transactionsplit.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Memo(); ok {
+		_spec.SetField(transactionsplit.FieldMemo, field.TypeString, value)
+	}
+	if _u.mutation.MemoCleared() {
+		_spec.ClearField(transactionsplit.FieldMemo, field.TypeString)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transactionsplit.CategoryTable,
+			Columns: []string{transactionsplit.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transactionsplit.CategoryTable,
+			Columns: []string{transactionsplit.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   transactionsplit.TagsTable,
+			Columns: transactionsplit.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   transactionsplit.TagsTable,
+			Columns: transactionsplit.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   transactionsplit.TagsTable,
+			Columns: transactionsplit.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transactionsplit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TransactionSplitUpdateOne is the builder for updating a single TransactionSplit entity.
+type TransactionSplitUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TransactionSplitMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *TransactionSplitUpdateOne) SetCategoryID(v int) *TransactionSplitUpdateOne {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *TransactionSplitUpdateOne) SetNillableCategoryID(v *int) *TransactionSplitUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *TransactionSplitUpdateOne) ClearCategoryID() *TransactionSplitUpdateOne {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *TransactionSplitUpdateOne) SetAmount(v model.Decimal) *TransactionSplitUpdateOne {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *TransactionSplitUpdateOne) SetNillableAmount(v *model.Decimal) *TransactionSplitUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *TransactionSplitUpdateOne) SetCurrency(v string) *TransactionSplitUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *TransactionSplitUpdateOne) SetNillableCurrency(v *string) *TransactionSplitUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetMemo sets the "memo" field.
+func (_u *TransactionSplitUpdateOne) SetMemo(v string) *TransactionSplitUpdateOne {
+	_u.mutation.SetMemo(v)
+	return _u
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_u *TransactionSplitUpdateOne) SetNillableMemo(v *string) *TransactionSplitUpdateOne {
+	if v != nil {
+		_u.SetMemo(*v)
+	}
+	return _u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (_u *TransactionSplitUpdateOne) ClearMemo() *TransactionSplitUpdateOne {
+	_u.mutation.ClearMemo()
+	return _u
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *TransactionSplitUpdateOne) SetCategory(v *Category) *TransactionSplitUpdateOne {
+	return _u.SetCategoryID(v.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *TransactionSplitUpdateOne) AddTagIDs(ids ...int) *TransactionSplitUpdateOne {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *TransactionSplitUpdateOne) AddTags(v ...*Tag) *TransactionSplitUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// Mutation returns the TransactionSplitMutation object of the builder.
+func (_u *TransactionSplitUpdateOne) Mutation() *TransactionSplitMutation {
+	return _u.mutation
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *TransactionSplitUpdateOne) ClearCategory() *TransactionSplitUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *TransactionSplitUpdateOne) ClearTags() *TransactionSplitUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *TransactionSplitUpdateOne) RemoveTagIDs(ids ...int) *TransactionSplitUpdateOne {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *TransactionSplitUpdateOne) RemoveTags(v ...*Tag) *TransactionSplitUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// Where appends a list predicates to the TransactionSplitUpdate builder.
+func (_u *TransactionSplitUpdateOne) Where(ps ...predicate.TransactionSplit) *TransactionSplitUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TransactionSplitUpdateOne) Select(field string, fields ...string) *TransactionSplitUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TransactionSplit entity.
+func (_u *TransactionSplitUpdateOne) Save(ctx context.Context) (*TransactionSplit, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransactionSplitUpdateOne) SaveX(ctx context.Context) *TransactionSplit {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TransactionSplitUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransactionSplitUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransactionSplitUpdateOne) check() error {
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := transactionsplit.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "TransactionSplit.currency": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransactionSplit.workspace"`)
+	}
+	if _u.mutation.TransactionCleared() && len(_u.mutation.TransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TransactionSplit.transaction"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TransactionSplitUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransactionSplitUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TransactionSplitUpdateOne) sqlSave(ctx context.Context) (_node *TransactionSplit, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transactionsplit.Table, transactionsplit.Columns, sqlgraph.NewFieldSpec(transactionsplit.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TransactionSplit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transactionsplit.FieldID)
+		for _, f := range fields {
+			if !transactionsplit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != transactionsplit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(transactionsplit.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(transactionsplit.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Memo(); ok {
+		_spec.SetField(transactionsplit.FieldMemo, field.TypeString, value)
+	}
+	if _u.mutation.MemoCleared() {
+		_spec.ClearField(transactionsplit.FieldMemo, field.TypeString)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transactionsplit.CategoryTable,
+			Columns: []string{transactionsplit.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transactionsplit.CategoryTable,
+			Columns: []string{transactionsplit.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   transactionsplit.TagsTable,
+			Columns: transactionsplit.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   transactionsplit.TagsTable,
+			Columns: transactionsplit.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   transactionsplit.TagsTable,
+			Columns: transactionsplit.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TransactionSplit{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transactionsplit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
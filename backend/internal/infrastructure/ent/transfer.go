@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/transfer"
+	"backend/internal/infrastructure/ent/workspace"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Transfer is the model entity for the Transfer schema.
+type Transfer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+	// FromTransactionID holds the value of the "from_transaction_id" field.
+	FromTransactionID int `json:"from_transaction_id,omitempty"`
+	// ToTransactionID holds the value of the "to_transaction_id" field.
+	ToTransactionID int `json:"to_transaction_id,omitempty"`
+	// FromFee holds the value of the "from_fee" field.
+	FromFee model.Decimal `json:"from_fee,omitempty"`
+	// ToFee holds the value of the "to_fee" field.
+	ToFee model.Decimal `json:"to_fee,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TransferQuery when eager-loading is set.
+	Edges        TransferEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TransferEdges holds the relations/edges for other nodes in the graph.
+type TransferEdges struct {
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// FromTransaction holds the value of the from_transaction edge.
+	FromTransaction *Transaction `json:"from_transaction,omitempty"`
+	// ToTransaction holds the value of the to_transaction edge.
+	ToTransaction *Transaction `json:"to_transaction,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransferEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// FromTransactionOrErr returns the FromTransaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransferEdges) FromTransactionOrErr() (*Transaction, error) {
+	if e.FromTransaction != nil {
+		return e.FromTransaction, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "from_transaction"}
+}
+
+// ToTransactionOrErr returns the ToTransaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransferEdges) ToTransactionOrErr() (*Transaction, error) {
+	if e.ToTransaction != nil {
+		return e.ToTransaction, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "to_transaction"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Transfer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case transfer.FieldFromFee, transfer.FieldToFee:
+			values[i] = new(model.Decimal)
+		case transfer.FieldID, transfer.FieldWorkspaceID, transfer.FieldFromTransactionID, transfer.FieldToTransactionID:
+			values[i] = new(sql.NullInt64)
+		case transfer.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Transfer fields.
+func (_m *Transfer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case transfer.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case transfer.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				_m.WorkspaceID = int(value.Int64)
+			}
+		case transfer.FieldFromTransactionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field from_transaction_id", values[i])
+			} else if value.Valid {
+				_m.FromTransactionID = int(value.Int64)
+			}
+		case transfer.FieldToTransactionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field to_transaction_id", values[i])
+			} else if value.Valid {
+				_m.ToTransactionID = int(value.Int64)
+			}
+		case transfer.FieldFromFee:
+			if value, ok := values[i].(*model.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field from_fee", values[i])
+			} else if value != nil {
+				_m.FromFee = *value
+			}
+		case transfer.FieldToFee:
+			if value, ok := values[i].(*model.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field to_fee", values[i])
+			} else if value != nil {
+				_m.ToFee = *value
+			}
+		case transfer.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Transfer.
+// This includes values selected through modifiers, order, etc.
+func (_m *Transfer) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWorkspace queries the "workspace" edge of the Transfer entity.
+func (_m *Transfer) QueryWorkspace() *WorkspaceQuery {
+	return NewTransferClient(_m.config).QueryWorkspace(_m)
+}
+
+// QueryFromTransaction queries the "from_transaction" edge of the Transfer entity.
+func (_m *Transfer) QueryFromTransaction() *TransactionQuery {
+	return NewTransferClient(_m.config).QueryFromTransaction(_m)
+}
+
+// QueryToTransaction queries the "to_transaction" edge of the Transfer entity.
+func (_m *Transfer) QueryToTransaction() *TransactionQuery {
+	return NewTransferClient(_m.config).QueryToTransaction(_m)
+}
+
+// Update returns a builder for updating this Transfer.
+// Note that you need to call Transfer.Unwrap() before calling this method if this Transfer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Transfer) Update() *TransferUpdateOne {
+	return NewTransferClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Transfer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Transfer) Unwrap() *Transfer {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Transfer is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Transfer) String() string {
+	var builder strings.Builder
+	builder.WriteString("Transfer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("from_transaction_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FromTransactionID))
+	builder.WriteString(", ")
+	builder.WriteString("to_transaction_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ToTransactionID))
+	builder.WriteString(", ")
+	builder.WriteString("from_fee=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FromFee))
+	builder.WriteString(", ")
+	builder.WriteString("to_fee=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ToFee))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Transfers is a parsable slice of Transfer.
+type Transfers []*Transfer
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transfer
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the transfer type in the database.
+	Label = "transfer"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWorkspaceID holds the string denoting the workspace_id field in the database.
+	FieldWorkspaceID = "workspace_id"
+	// FieldFromTransactionID holds the string denoting the from_transaction_id field in the database.
+	FieldFromTransactionID = "from_transaction_id"
+	// FieldToTransactionID holds the string denoting the to_transaction_id field in the database.
+	FieldToTransactionID = "to_transaction_id"
+	// FieldFromFee holds the string denoting the from_fee field in the database.
+	FieldFromFee = "from_fee"
+	// FieldToFee holds the string denoting the to_fee field in the database.
+	FieldToFee = "to_fee"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeWorkspace holds the string denoting the workspace edge name in mutations.
+	EdgeWorkspace = "workspace"
+	// EdgeFromTransaction holds the string denoting the from_transaction edge name in mutations.
+	EdgeFromTransaction = "from_transaction"
+	// EdgeToTransaction holds the string denoting the to_transaction edge name in mutations.
+	EdgeToTransaction = "to_transaction"
+	// Table holds the table name of the transfer in the database.
+	Table = "transfers"
+	// WorkspaceTable is the table that holds the workspace relation/edge.
+	WorkspaceTable = "transfers"
+	// WorkspaceInverseTable is the table name for the Workspace entity.
+	// It exists in this package in order to avoid circular dependency with the "workspace" package.
+	WorkspaceInverseTable = "workspaces"
+	// WorkspaceColumn is the table column denoting the workspace relation/edge.
+	WorkspaceColumn = "workspace_id"
+	// FromTransactionTable is the table that holds the from_transaction relation/edge.
+	FromTransactionTable = "transfers"
+	// FromTransactionInverseTable is the table name for the Transaction entity.
+	// It exists in this package in order to avoid circular dependency with the "transaction" package.
+	FromTransactionInverseTable = "transactions"
+	// FromTransactionColumn is the table column denoting the from_transaction relation/edge.
+	FromTransactionColumn = "from_transaction_id"
+	// ToTransactionTable is the table that holds the to_transaction relation/edge.
+	ToTransactionTable = "transfers"
+	// ToTransactionInverseTable is the table name for the Transaction entity.
+	// It exists in this package in order to avoid circular dependency with the "transaction" package.
+	ToTransactionInverseTable = "transactions"
+	// ToTransactionColumn is the table column denoting the to_transaction relation/edge.
+	ToTransactionColumn = "to_transaction_id"
+)
+
+// Columns holds all SQL columns for transfer fields.
+var Columns = []string{
+	FieldID,
+	FieldWorkspaceID,
+	FieldFromTransactionID,
+	FieldToTransactionID,
+	FieldFromFee,
+	FieldToFee,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "backend/internal/infrastructure/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Transfer queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByWorkspaceID orders the results by the workspace_id field.
+func ByWorkspaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkspaceID, opts...).ToFunc()
+}
+
+// ByFromTransactionID orders the results by the from_transaction_id field.
+func ByFromTransactionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromTransactionID, opts...).ToFunc()
+}
+
+// ByToTransactionID orders the results by the to_transaction_id field.
+func ByToTransactionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToTransactionID, opts...).ToFunc()
+}
+
+// ByFromFee orders the results by the from_fee field.
+func ByFromFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromFee, opts...).ToFunc()
+}
+
+// ByToFee orders the results by the to_fee field.
+func ByToFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToFee, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByWorkspaceField orders the results by workspace field.
+func ByWorkspaceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorkspaceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByFromTransactionField orders the results by from_transaction field.
+func ByFromTransactionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFromTransactionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByToTransactionField orders the results by to_transaction field.
+func ByToTransactionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newToTransactionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newWorkspaceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorkspaceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, WorkspaceTable, WorkspaceColumn),
+	)
+}
+func newFromTransactionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FromTransactionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, FromTransactionTable, FromTransactionColumn),
+	)
+}
+func newToTransactionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ToTransactionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, ToTransactionTable, ToTransactionColumn),
+	)
+}
@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transfer
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldID, id))
+}
+
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// FromTransactionID applies equality check predicate on the "from_transaction_id" field. It's identical to FromTransactionIDEQ.
+func FromTransactionID(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldFromTransactionID, v))
+}
+
+// ToTransactionID applies equality check predicate on the "to_transaction_id" field. It's identical to ToTransactionIDEQ.
+func ToTransactionID(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldToTransactionID, v))
+}
+
+// FromFee applies equality check predicate on the "from_fee" field. It's identical to FromFeeEQ.
+func FromFee(v model.Decimal) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldFromFee, v))
+}
+
+// ToFee applies equality check predicate on the "to_fee" field. It's identical to ToFeeEQ.
+func ToFee(v model.Decimal) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldToFee, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// FromTransactionIDEQ applies the EQ predicate on the "from_transaction_id" field.
+func FromTransactionIDEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldFromTransactionID, v))
+}
+
+// FromTransactionIDNEQ applies the NEQ predicate on the "from_transaction_id" field.
+func FromTransactionIDNEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldFromTransactionID, v))
+}
+
+// FromTransactionIDIn applies the In predicate on the "from_transaction_id" field.
+func FromTransactionIDIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldFromTransactionID, vs...))
+}
+
+// FromTransactionIDNotIn applies the NotIn predicate on the "from_transaction_id" field.
+func FromTransactionIDNotIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldFromTransactionID, vs...))
+}
+
+// ToTransactionIDEQ applies the EQ predicate on the "to_transaction_id" field.
+func ToTransactionIDEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldToTransactionID, v))
+}
+
+// ToTransactionIDNEQ applies the NEQ predicate on the "to_transaction_id" field.
+func ToTransactionIDNEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldToTransactionID, v))
+}
+
+// ToTransactionIDIn applies the In predicate on the "to_transaction_id" field.
+func ToTransactionIDIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldToTransactionID, vs...))
+}
+
+// ToTransactionIDNotIn applies the NotIn predicate on the "to_transaction_id" field.
+func ToTransactionIDNotIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldToTransactionID, vs...))
+}
+
+// FromFeeEQ applies the EQ predicate on the "from_fee" field.
+func FromFeeEQ(v model.Decimal) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldFromFee, v))
+}
+
+// FromFeeNEQ applies the NEQ predicate on the "from_fee" field.
+func FromFeeNEQ(v model.Decimal) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldFromFee, v))
+}
+
+// FromFeeIn applies the In predicate on the "from_fee" field.
+func FromFeeIn(vs ...model.Decimal) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldFromFee, vs...))
+}
+
+// FromFeeNotIn applies the NotIn predicate on the "from_fee" field.
+func FromFeeNotIn(vs ...model.Decimal) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldFromFee, vs...))
+}
+
+// FromFeeGT applies the GT predicate on the "from_fee" field.
+func FromFeeGT(v model.Decimal) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldFromFee, v))
+}
+
+// FromFeeGTE applies the GTE predicate on the "from_fee" field.
+func FromFeeGTE(v model.Decimal) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldFromFee, v))
+}
+
+// FromFeeLT applies the LT predicate on the "from_fee" field.
+func FromFeeLT(v model.Decimal) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldFromFee, v))
+}
+
+// FromFeeLTE applies the LTE predicate on the "from_fee" field.
+func FromFeeLTE(v model.Decimal) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldFromFee, v))
+}
+
+// ToFeeEQ applies the EQ predicate on the "to_fee" field.
+func ToFeeEQ(v model.Decimal) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldToFee, v))
+}
+
+// ToFeeNEQ applies the NEQ predicate on the "to_fee" field.
+func ToFeeNEQ(v model.Decimal) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldToFee, v))
+}
+
+// ToFeeIn applies the In predicate on the "to_fee" field.
+func ToFeeIn(vs ...model.Decimal) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldToFee, vs...))
+}
+
+// ToFeeNotIn applies the NotIn predicate on the "to_fee" field.
+func ToFeeNotIn(vs ...model.Decimal) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldToFee, vs...))
+}
+
+// ToFeeGT applies the GT predicate on the "to_fee" field.
+func ToFeeGT(v model.Decimal) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldToFee, v))
+}
+
+// ToFeeGTE applies the GTE predicate on the "to_fee" field.
+func ToFeeGTE(v model.Decimal) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldToFee, v))
+}
+
+// ToFeeLT applies the LT predicate on the "to_fee" field.
+func ToFeeLT(v model.Decimal) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldToFee, v))
+}
+
+// ToFeeLTE applies the LTE predicate on the "to_fee" field.
+func ToFeeLTE(v model.Decimal) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldToFee, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasWorkspace applies the HasEdge predicate on the "workspace" edge.
+func HasWorkspace() predicate.Transfer {
+	return predicate.Transfer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WorkspaceTable, WorkspaceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkspaceWith applies the HasEdge predicate on the "workspace" edge with a given conditions (other predicates).
+func HasWorkspaceWith(preds ...predicate.Workspace) predicate.Transfer {
+	return predicate.Transfer(func(s *sql.Selector) {
+		step := newWorkspaceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFromTransaction applies the HasEdge predicate on the "from_transaction" edge.
+func HasFromTransaction() predicate.Transfer {
+	return predicate.Transfer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, FromTransactionTable, FromTransactionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFromTransactionWith applies the HasEdge predicate on the "from_transaction" edge with a given conditions (other predicates).
+func HasFromTransactionWith(preds ...predicate.Transaction) predicate.Transfer {
+	return predicate.Transfer(func(s *sql.Selector) {
+		step := newFromTransactionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasToTransaction applies the HasEdge predicate on the "to_transaction" edge.
+func HasToTransaction() predicate.Transfer {
+	return predicate.Transfer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ToTransactionTable, ToTransactionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasToTransactionWith applies the HasEdge predicate on the "to_transaction" edge with a given conditions (other predicates).
+func HasToTransactionWith(preds ...predicate.Transaction) predicate.Transfer {
+	return predicate.Transfer(func(s *sql.Selector) {
+		step := newToTransactionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Transfer) predicate.Transfer {
+	return predicate.Transfer(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Transfer) predicate.Transfer {
+	return predicate.Transfer(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Transfer) predicate.Transfer {
+	return predicate.Transfer(sql.NotPredicates(p))
+}
@@ -0,0 +1,339 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/transfer"
+	"backend/internal/infrastructure/ent/workspace"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TransferCreate is the builder for creating a Transfer entity.
+type TransferCreate struct {
+	config
+	mutation *TransferMutation
+	hooks    []Hook
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *TransferCreate) SetWorkspaceID(v int) *TransferCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetFromTransactionID sets the "from_transaction_id" field.
+func (_c *TransferCreate) SetFromTransactionID(v int) *TransferCreate {
+	_c.mutation.SetFromTransactionID(v)
+	return _c
+}
+
+// SetToTransactionID sets the "to_transaction_id" field.
+func (_c *TransferCreate) SetToTransactionID(v int) *TransferCreate {
+	_c.mutation.SetToTransactionID(v)
+	return _c
+}
+
+// SetFromFee sets the "from_fee" field.
+func (_c *TransferCreate) SetFromFee(v model.Decimal) *TransferCreate {
+	_c.mutation.SetFromFee(v)
+	return _c
+}
+
+// SetToFee sets the "to_fee" field.
+func (_c *TransferCreate) SetToFee(v model.Decimal) *TransferCreate {
+	_c.mutation.SetToFee(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TransferCreate) SetCreatedAt(v time.Time) *TransferCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TransferCreate) SetNillableCreatedAt(v *time.Time) *TransferCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_c *TransferCreate) SetWorkspace(v *Workspace) *TransferCreate {
+	return _c.SetWorkspaceID(v.ID)
+}
+
+// SetFromTransaction sets the "from_transaction" edge to the Transaction entity.
+func (_c *TransferCreate) SetFromTransaction(v *Transaction) *TransferCreate {
+	return _c.SetFromTransactionID(v.ID)
+}
+
+// SetToTransaction sets the "to_transaction" edge to the Transaction entity.
+func (_c *TransferCreate) SetToTransaction(v *Transaction) *TransferCreate {
+	return _c.SetToTransactionID(v.ID)
+}
+
+// Mutation returns the TransferMutation object of the builder.
+func (_c *TransferCreate) Mutation() *TransferMutation {
+	return _c.mutation
+}
+
+// Save creates the Transfer in the database.
+func (_c *TransferCreate) Save(ctx context.Context) (*Transfer, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TransferCreate) SaveX(ctx context.Context) *Transfer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransferCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransferCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TransferCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if transfer.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized transfer.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := transfer.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TransferCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "Transfer.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.FromTransactionID(); !ok {
+		return &ValidationError{Name: "from_transaction_id", err: errors.New(`ent: missing required field "Transfer.from_transaction_id"`)}
+	}
+	if _, ok := _c.mutation.ToTransactionID(); !ok {
+		return &ValidationError{Name: "to_transaction_id", err: errors.New(`ent: missing required field "Transfer.to_transaction_id"`)}
+	}
+	if _, ok := _c.mutation.FromFee(); !ok {
+		return &ValidationError{Name: "from_fee", err: errors.New(`ent: missing required field "Transfer.from_fee"`)}
+	}
+	if _, ok := _c.mutation.ToFee(); !ok {
+		return &ValidationError{Name: "to_fee", err: errors.New(`ent: missing required field "Transfer.to_fee"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Transfer.created_at"`)}
+	}
+	if len(_c.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "Transfer.workspace"`)}
+	}
+	if len(_c.mutation.FromTransactionIDs()) == 0 {
+		return &ValidationError{Name: "from_transaction", err: errors.New(`ent: missing required edge "Transfer.from_transaction"`)}
+	}
+	if len(_c.mutation.ToTransactionIDs()) == 0 {
+		return &ValidationError{Name: "to_transaction", err: errors.New(`ent: missing required edge "Transfer.to_transaction"`)}
+	}
+	return nil
+}
+
+func (_c *TransferCreate) sqlSave(ctx context.Context) (*Transfer, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TransferCreate) createSpec() (*Transfer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Transfer{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(transfer.Table, sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.FromFee(); ok {
+		_spec.SetField(transfer.FieldFromFee, field.TypeOther, value)
+		_node.FromFee = value
+	}
+	if value, ok := _c.mutation.ToFee(); ok {
+		_spec.SetField(transfer.FieldToFee, field.TypeOther, value)
+		_node.ToFee = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(transfer.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transfer.WorkspaceTable,
+			Columns: []string{transfer.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FromTransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   transfer.FromTransactionTable,
+			Columns: []string{transfer.FromTransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FromTransactionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ToTransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   transfer.ToTransactionTable,
+			Columns: []string{transfer.ToTransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ToTransactionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TransferCreateBulk is the builder for creating many Transfer entities in bulk.
+type TransferCreateBulk struct {
+	config
+	err      error
+	builders []*TransferCreate
+}
+
+// Save creates the Transfer entities in the database.
+func (_c *TransferCreateBulk) Save(ctx context.Context) ([]*Transfer, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Transfer, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TransferMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TransferCreateBulk) SaveX(ctx context.Context) []*Transfer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransferCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransferCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,511 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/transfer"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TransferUpdate is the builder for updating Transfer entities.
+type TransferUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TransferMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TransferUpdate builder.
+func (_u *TransferUpdate) Where(ps ...predicate.Transfer) *TransferUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetFromTransactionID sets the "from_transaction_id" field.
+func (_u *TransferUpdate) SetFromTransactionID(v int) *TransferUpdate {
+	_u.mutation.SetFromTransactionID(v)
+	return _u
+}
+
+// SetNillableFromTransactionID sets the "from_transaction_id" field if the given value is not nil.
+func (_u *TransferUpdate) SetNillableFromTransactionID(v *int) *TransferUpdate {
+	if v != nil {
+		_u.SetFromTransactionID(*v)
+	}
+	return _u
+}
+
+// SetToTransactionID sets the "to_transaction_id" field.
+func (_u *TransferUpdate) SetToTransactionID(v int) *TransferUpdate {
+	_u.mutation.SetToTransactionID(v)
+	return _u
+}
+
+// SetNillableToTransactionID sets the "to_transaction_id" field if the given value is not nil.
+func (_u *TransferUpdate) SetNillableToTransactionID(v *int) *TransferUpdate {
+	if v != nil {
+		_u.SetToTransactionID(*v)
+	}
+	return _u
+}
+
+// SetFromFee sets the "from_fee" field.
+func (_u *TransferUpdate) SetFromFee(v model.Decimal) *TransferUpdate {
+	_u.mutation.SetFromFee(v)
+	return _u
+}
+
+// SetNillableFromFee sets the "from_fee" field if the given value is not nil.
+func (_u *TransferUpdate) SetNillableFromFee(v *model.Decimal) *TransferUpdate {
+	if v != nil {
+		_u.SetFromFee(*v)
+	}
+	return _u
+}
+
+// SetToFee sets the "to_fee" field.
+func (_u *TransferUpdate) SetToFee(v model.Decimal) *TransferUpdate {
+	_u.mutation.SetToFee(v)
+	return _u
+}
+
+// SetNillableToFee sets the "to_fee" field if the given value is not nil.
+func (_u *TransferUpdate) SetNillableToFee(v *model.Decimal) *TransferUpdate {
+	if v != nil {
+		_u.SetToFee(*v)
+	}
+	return _u
+}
+
+// SetFromTransaction sets the "from_transaction" edge to the Transaction entity.
+func (_u *TransferUpdate) SetFromTransaction(v *Transaction) *TransferUpdate {
+	return _u.SetFromTransactionID(v.ID)
+}
+
+// SetToTransaction sets the "to_transaction" edge to the Transaction entity.
+func (_u *TransferUpdate) SetToTransaction(v *Transaction) *TransferUpdate {
+	return _u.SetToTransactionID(v.ID)
+}
+
+// Mutation returns the TransferMutation object of the builder.
+func (_u *TransferUpdate) Mutation() *TransferMutation {
+	return _u.mutation
+}
+
+// ClearFromTransaction clears the "from_transaction" edge to the Transaction entity.
+func (_u *TransferUpdate) ClearFromTransaction() *TransferUpdate {
+	_u.mutation.ClearFromTransaction()
+	return _u
+}
+
+// ClearToTransaction clears the "to_transaction" edge to the Transaction entity.
+func (_u *TransferUpdate) ClearToTransaction() *TransferUpdate {
+	_u.mutation.ClearToTransaction()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TransferUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransferUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TransferUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransferUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransferUpdate) check() error {
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Transfer.workspace"`)
+	}
+	if _u.mutation.FromTransactionCleared() && len(_u.mutation.FromTransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Transfer.from_transaction"`)
+	}
+	if _u.mutation.ToTransactionCleared() && len(_u.mutation.ToTransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Transfer.to_transaction"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TransferUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransferUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TransferUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transfer.Table, transfer.Columns, sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FromFee(); ok {
+		_spec.SetField(transfer.FieldFromFee, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ToFee(); ok {
+		_spec.SetField(transfer.FieldToFee, field.TypeOther, value)
+	}
+	if _u.mutation.FromTransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   transfer.FromTransactionTable,
+			Columns: []string{transfer.FromTransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FromTransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   transfer.FromTransactionTable,
+			Columns: []string{transfer.FromTransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ToTransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   transfer.ToTransactionTable,
+			Columns: []string{transfer.ToTransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ToTransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   transfer.ToTransactionTable,
+			Columns: []string{transfer.ToTransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TransferUpdateOne is the builder for updating a single Transfer entity.
+type TransferUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TransferMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetFromTransactionID sets the "from_transaction_id" field.
+func (_u *TransferUpdateOne) SetFromTransactionID(v int) *TransferUpdateOne {
+	_u.mutation.SetFromTransactionID(v)
+	return _u
+}
+
+// SetNillableFromTransactionID sets the "from_transaction_id" field if the given value is not nil.
+func (_u *TransferUpdateOne) SetNillableFromTransactionID(v *int) *TransferUpdateOne {
+	if v != nil {
+		_u.SetFromTransactionID(*v)
+	}
+	return _u
+}
+
+// SetToTransactionID sets the "to_transaction_id" field.
+func (_u *TransferUpdateOne) SetToTransactionID(v int) *TransferUpdateOne {
+	_u.mutation.SetToTransactionID(v)
+	return _u
+}
+
+// SetNillableToTransactionID sets the "to_transaction_id" field if the given value is not nil.
+func (_u *TransferUpdateOne) SetNillableToTransactionID(v *int) *TransferUpdateOne {
+	if v != nil {
+		_u.SetToTransactionID(*v)
+	}
+	return _u
+}
+
+// SetFromFee sets the "from_fee" field.
+func (_u *TransferUpdateOne) SetFromFee(v model.Decimal) *TransferUpdateOne {
+	_u.mutation.SetFromFee(v)
+	return _u
+}
+
+// SetNillableFromFee sets the "from_fee" field if the given value is not nil.
+func (_u *TransferUpdateOne) SetNillableFromFee(v *model.Decimal) *TransferUpdateOne {
+	if v != nil {
+		_u.SetFromFee(*v)
+	}
+	return _u
+}
+
+// SetToFee sets the "to_fee" field.
+func (_u *TransferUpdateOne) SetToFee(v model.Decimal) *TransferUpdateOne {
+	_u.mutation.SetToFee(v)
+	return _u
+}
+
+// SetNillableToFee sets the "to_fee" field if the given value is not nil.
+func (_u *TransferUpdateOne) SetNillableToFee(v *model.Decimal) *TransferUpdateOne {
+	if v != nil {
+		_u.SetToFee(*v)
+	}
+	return _u
+}
+
+// SetFromTransaction sets the "from_transaction" edge to the Transaction entity.
+func (_u *TransferUpdateOne) SetFromTransaction(v *Transaction) *TransferUpdateOne {
+	return _u.SetFromTransactionID(v.ID)
+}
+
+// SetToTransaction sets the "to_transaction" edge to the Transaction entity.
+func (_u *TransferUpdateOne) SetToTransaction(v *Transaction) *TransferUpdateOne {
+	return _u.SetToTransactionID(v.ID)
+}
+
+// Mutation returns the TransferMutation object of the builder.
+func (_u *TransferUpdateOne) Mutation() *TransferMutation {
+	return _u.mutation
+}
+
+// ClearFromTransaction clears the "from_transaction" edge to the Transaction entity.
+func (_u *TransferUpdateOne) ClearFromTransaction() *TransferUpdateOne {
+	_u.mutation.ClearFromTransaction()
+	return _u
+}
+
+// ClearToTransaction clears the "to_transaction" edge to the Transaction entity.
+func (_u *TransferUpdateOne) ClearToTransaction() *TransferUpdateOne {
+	_u.mutation.ClearToTransaction()
+	return _u
+}
+
+// Where appends a list predicates to the TransferUpdate builder.
+func (_u *TransferUpdateOne) Where(ps ...predicate.Transfer) *TransferUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TransferUpdateOne) Select(field string, fields ...string) *TransferUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Transfer entity.
+func (_u *TransferUpdateOne) Save(ctx context.Context) (*Transfer, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransferUpdateOne) SaveX(ctx context.Context) *Transfer {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TransferUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransferUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransferUpdateOne) check() error {
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Transfer.workspace"`)
+	}
+	if _u.mutation.FromTransactionCleared() && len(_u.mutation.FromTransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Transfer.from_transaction"`)
+	}
+	if _u.mutation.ToTransactionCleared() && len(_u.mutation.ToTransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Transfer.to_transaction"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TransferUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransferUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TransferUpdateOne) sqlSave(ctx context.Context) (_node *Transfer, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transfer.Table, transfer.Columns, sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Transfer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transfer.FieldID)
+		for _, f := range fields {
+			if !transfer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != transfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FromFee(); ok {
+		_spec.SetField(transfer.FieldFromFee, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ToFee(); ok {
+		_spec.SetField(transfer.FieldToFee, field.TypeOther, value)
+	}
+	if _u.mutation.FromTransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   transfer.FromTransactionTable,
+			Columns: []string{transfer.FromTransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FromTransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   transfer.FromTransactionTable,
+			Columns: []string{transfer.FromTransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ToTransactionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   transfer.ToTransactionTable,
+			Columns: []string{transfer.ToTransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ToTransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   transfer.ToTransactionTable,
+			Columns: []string{transfer.ToTransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Transfer{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,10 +12,50 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Account is the client for interacting with the Account builders.
+	Account *AccountClient
+	// Category is the client for interacting with the Category builders.
+	Category *CategoryClient
+	// EmailVerificationToken is the client for interacting with the EmailVerificationToken builders.
+	EmailVerificationToken *EmailVerificationTokenClient
+	// ImportDecision is the client for interacting with the ImportDecision builders.
+	ImportDecision *ImportDecisionClient
+	// ImportProfile is the client for interacting with the ImportProfile builders.
+	ImportProfile *ImportProfileClient
+	// JournalEntry is the client for interacting with the JournalEntry builders.
+	JournalEntry *JournalEntryClient
+	// Membership is the client for interacting with the Membership builders.
+	Membership *MembershipClient
+	// PasswordResetToken is the client for interacting with the PasswordResetToken builders.
+	PasswordResetToken *PasswordResetTokenClient
+	// Payee is the client for interacting with the Payee builders.
+	Payee *PayeeClient
+	// Posting is the client for interacting with the Posting builders.
+	Posting *PostingClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
+	// RecurrenceOccurrence is the client for interacting with the RecurrenceOccurrence builders.
+	RecurrenceOccurrence *RecurrenceOccurrenceClient
+	// RecurrenceRule is the client for interacting with the RecurrenceRule builders.
+	RecurrenceRule *RecurrenceRuleClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// StatementImport is the client for interacting with the StatementImport builders.
+	StatementImport *StatementImportClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
+	// Transaction is the client for interacting with the Transaction builders.
+	Transaction *TransactionClient
+	// TransactionSplit is the client for interacting with the TransactionSplit builders.
+	TransactionSplit *TransactionSplitClient
+	// Transfer is the client for interacting with the Transfer builders.
+	Transfer *TransferClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// Workspace is the client for interacting with the Workspace builders.
 	Workspace *WorkspaceClient
+	// WorkspaceInvitation is the client for interacting with the WorkspaceInvitation builders.
+	WorkspaceInvitation *WorkspaceInvitationClient
 
 	// lazily loaded.
 	client     *Client
@@ -147,8 +187,28 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Account = NewAccountClient(tx.config)
+	tx.Category = NewCategoryClient(tx.config)
+	tx.EmailVerificationToken = NewEmailVerificationTokenClient(tx.config)
+	tx.ImportDecision = NewImportDecisionClient(tx.config)
+	tx.ImportProfile = NewImportProfileClient(tx.config)
+	tx.JournalEntry = NewJournalEntryClient(tx.config)
+	tx.Membership = NewMembershipClient(tx.config)
+	tx.PasswordResetToken = NewPasswordResetTokenClient(tx.config)
+	tx.Payee = NewPayeeClient(tx.config)
+	tx.Posting = NewPostingClient(tx.config)
+	tx.RecoveryCode = NewRecoveryCodeClient(tx.config)
+	tx.RecurrenceOccurrence = NewRecurrenceOccurrenceClient(tx.config)
+	tx.RecurrenceRule = NewRecurrenceRuleClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
+	tx.StatementImport = NewStatementImportClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
+	tx.Transaction = NewTransactionClient(tx.config)
+	tx.TransactionSplit = NewTransactionSplitClient(tx.config)
+	tx.Transfer = NewTransferClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.Workspace = NewWorkspaceClient(tx.config)
+	tx.WorkspaceInvitation = NewWorkspaceInvitationClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -158,7 +218,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: User.QueryXXX(), the query will be executed
+// applies a query, for example: Account.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
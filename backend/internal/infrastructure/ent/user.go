@@ -21,6 +21,18 @@ type User struct {
 	Email string `json:"email,omitempty"`
 	// PasswordHash holds the value of the "password_hash" field.
 	PasswordHash string `json:"-"`
+	// EmailVerifiedAt holds the value of the "email_verified_at" field.
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	// TotpSecret holds the value of the "totp_secret" field.
+	TotpSecret *string `json:"-"`
+	// TotpLastUsedStep holds the value of the "totp_last_used_step" field.
+	TotpLastUsedStep *int64 `json:"totp_last_used_step,omitempty"`
+	// TwoFactorEnabledAt holds the value of the "two_factor_enabled_at" field.
+	TwoFactorEnabledAt *time.Time `json:"two_factor_enabled_at,omitempty"`
+	// TwoFactorFailedAttempts holds the value of the "two_factor_failed_attempts" field.
+	TwoFactorFailedAttempts int `json:"two_factor_failed_attempts,omitempty"`
+	// TwoFactorLockedUntil holds the value of the "two_factor_locked_until" field.
+	TwoFactorLockedUntil *time.Time `json:"two_factor_locked_until,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -35,9 +47,19 @@ type User struct {
 type UserEdges struct {
 	// Workspaces holds the value of the workspaces edge.
 	Workspaces []*Workspace `json:"workspaces,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
+	// PasswordResetTokens holds the value of the password_reset_tokens edge.
+	PasswordResetTokens []*PasswordResetToken `json:"password_reset_tokens,omitempty"`
+	// EmailVerificationTokens holds the value of the email_verification_tokens edge.
+	EmailVerificationTokens []*EmailVerificationToken `json:"email_verification_tokens,omitempty"`
+	// RecoveryCodes holds the value of the recovery_codes edge.
+	RecoveryCodes []*RecoveryCode `json:"recovery_codes,omitempty"`
+	// Memberships holds the value of the memberships edge.
+	Memberships []*Membership `json:"memberships,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [6]bool
 }
 
 // WorkspacesOrErr returns the Workspaces value or an error if the edge
@@ -49,16 +71,61 @@ func (e UserEdges) WorkspacesOrErr() ([]*Workspace, error) {
 	return nil, &NotLoadedError{edge: "workspaces"}
 }
 
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[1] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// PasswordResetTokensOrErr returns the PasswordResetTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PasswordResetTokensOrErr() ([]*PasswordResetToken, error) {
+	if e.loadedTypes[2] {
+		return e.PasswordResetTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "password_reset_tokens"}
+}
+
+// EmailVerificationTokensOrErr returns the EmailVerificationTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) EmailVerificationTokensOrErr() ([]*EmailVerificationToken, error) {
+	if e.loadedTypes[3] {
+		return e.EmailVerificationTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "email_verification_tokens"}
+}
+
+// RecoveryCodesOrErr returns the RecoveryCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RecoveryCodesOrErr() ([]*RecoveryCode, error) {
+	if e.loadedTypes[4] {
+		return e.RecoveryCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "recovery_codes"}
+}
+
+// MembershipsOrErr returns the Memberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MembershipsOrErr() ([]*Membership, error) {
+	if e.loadedTypes[5] {
+		return e.Memberships, nil
+	}
+	return nil, &NotLoadedError{edge: "memberships"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID:
+		case user.FieldID, user.FieldTotpLastUsedStep, user.FieldTwoFactorFailedAttempts:
 			values[i] = new(sql.NullInt64)
-		case user.FieldEmail, user.FieldPasswordHash:
+		case user.FieldEmail, user.FieldPasswordHash, user.FieldTotpSecret:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldEmailVerifiedAt, user.FieldTwoFactorEnabledAt, user.FieldTwoFactorLockedUntil, user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -93,6 +160,47 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.PasswordHash = value.String
 			}
+		case user.FieldEmailVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified_at", values[i])
+			} else if value.Valid {
+				_m.EmailVerifiedAt = new(time.Time)
+				*_m.EmailVerifiedAt = value.Time
+			}
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
+			} else if value.Valid {
+				_m.TotpSecret = new(string)
+				*_m.TotpSecret = value.String
+			}
+		case user.FieldTotpLastUsedStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_last_used_step", values[i])
+			} else if value.Valid {
+				_m.TotpLastUsedStep = new(int64)
+				*_m.TotpLastUsedStep = value.Int64
+			}
+		case user.FieldTwoFactorEnabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field two_factor_enabled_at", values[i])
+			} else if value.Valid {
+				_m.TwoFactorEnabledAt = new(time.Time)
+				*_m.TwoFactorEnabledAt = value.Time
+			}
+		case user.FieldTwoFactorFailedAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field two_factor_failed_attempts", values[i])
+			} else if value.Valid {
+				_m.TwoFactorFailedAttempts = int(value.Int64)
+			}
+		case user.FieldTwoFactorLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field two_factor_locked_until", values[i])
+			} else if value.Valid {
+				_m.TwoFactorLockedUntil = new(time.Time)
+				*_m.TwoFactorLockedUntil = value.Time
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -123,6 +231,31 @@ func (_m *User) QueryWorkspaces() *WorkspaceQuery {
 	return NewUserClient(_m.config).QueryWorkspaces(_m)
 }
 
+// QuerySessions queries the "sessions" edge of the User entity.
+func (_m *User) QuerySessions() *SessionQuery {
+	return NewUserClient(_m.config).QuerySessions(_m)
+}
+
+// QueryPasswordResetTokens queries the "password_reset_tokens" edge of the User entity.
+func (_m *User) QueryPasswordResetTokens() *PasswordResetTokenQuery {
+	return NewUserClient(_m.config).QueryPasswordResetTokens(_m)
+}
+
+// QueryEmailVerificationTokens queries the "email_verification_tokens" edge of the User entity.
+func (_m *User) QueryEmailVerificationTokens() *EmailVerificationTokenQuery {
+	return NewUserClient(_m.config).QueryEmailVerificationTokens(_m)
+}
+
+// QueryRecoveryCodes queries the "recovery_codes" edge of the User entity.
+func (_m *User) QueryRecoveryCodes() *RecoveryCodeQuery {
+	return NewUserClient(_m.config).QueryRecoveryCodes(_m)
+}
+
+// QueryMemberships queries the "memberships" edge of the User entity.
+func (_m *User) QueryMemberships() *MembershipQuery {
+	return NewUserClient(_m.config).QueryMemberships(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -151,6 +284,31 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("password_hash=<sensitive>")
 	builder.WriteString(", ")
+	if v := _m.EmailVerifiedAt; v != nil {
+		builder.WriteString("email_verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("totp_secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.TotpLastUsedStep; v != nil {
+		builder.WriteString("totp_last_used_step=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TwoFactorEnabledAt; v != nil {
+		builder.WriteString("two_factor_enabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("two_factor_failed_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TwoFactorFailedAttempts))
+	builder.WriteString(", ")
+	if v := _m.TwoFactorLockedUntil; v != nil {
+		builder.WriteString("two_factor_locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
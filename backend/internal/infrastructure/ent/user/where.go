@@ -65,6 +65,36 @@ func PasswordHash(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldPasswordHash, v))
 }
 
+// EmailVerifiedAt applies equality check predicate on the "email_verified_at" field. It's identical to EmailVerifiedAtEQ.
+func EmailVerifiedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailVerifiedAt, v))
+}
+
+// TotpSecret applies equality check predicate on the "totp_secret" field. It's identical to TotpSecretEQ.
+func TotpSecret(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpSecret, v))
+}
+
+// TotpLastUsedStep applies equality check predicate on the "totp_last_used_step" field. It's identical to TotpLastUsedStepEQ.
+func TotpLastUsedStep(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpLastUsedStep, v))
+}
+
+// TwoFactorEnabledAt applies equality check predicate on the "two_factor_enabled_at" field. It's identical to TwoFactorEnabledAtEQ.
+func TwoFactorEnabledAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTwoFactorEnabledAt, v))
+}
+
+// TwoFactorFailedAttempts applies equality check predicate on the "two_factor_failed_attempts" field. It's identical to TwoFactorFailedAttemptsEQ.
+func TwoFactorFailedAttempts(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTwoFactorFailedAttempts, v))
+}
+
+// TwoFactorLockedUntil applies equality check predicate on the "two_factor_locked_until" field. It's identical to TwoFactorLockedUntilEQ.
+func TwoFactorLockedUntil(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTwoFactorLockedUntil, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -205,6 +235,321 @@ func PasswordHashContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldPasswordHash, v))
 }
 
+// EmailVerifiedAtEQ applies the EQ predicate on the "email_verified_at" field.
+func EmailVerifiedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtNEQ applies the NEQ predicate on the "email_verified_at" field.
+func EmailVerifiedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtIn applies the In predicate on the "email_verified_at" field.
+func EmailVerifiedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldEmailVerifiedAt, vs...))
+}
+
+// EmailVerifiedAtNotIn applies the NotIn predicate on the "email_verified_at" field.
+func EmailVerifiedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldEmailVerifiedAt, vs...))
+}
+
+// EmailVerifiedAtGT applies the GT predicate on the "email_verified_at" field.
+func EmailVerifiedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtGTE applies the GTE predicate on the "email_verified_at" field.
+func EmailVerifiedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtLT applies the LT predicate on the "email_verified_at" field.
+func EmailVerifiedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtLTE applies the LTE predicate on the "email_verified_at" field.
+func EmailVerifiedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldEmailVerifiedAt, v))
+}
+
+// EmailVerifiedAtIsNil applies the IsNil predicate on the "email_verified_at" field.
+func EmailVerifiedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldEmailVerifiedAt))
+}
+
+// EmailVerifiedAtNotNil applies the NotNil predicate on the "email_verified_at" field.
+func EmailVerifiedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldEmailVerifiedAt))
+}
+
+// TotpSecretEQ applies the EQ predicate on the "totp_secret" field.
+func TotpSecretEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpSecret, v))
+}
+
+// TotpSecretNEQ applies the NEQ predicate on the "totp_secret" field.
+func TotpSecretNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTotpSecret, v))
+}
+
+// TotpSecretIn applies the In predicate on the "totp_secret" field.
+func TotpSecretIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldTotpSecret, vs...))
+}
+
+// TotpSecretNotIn applies the NotIn predicate on the "totp_secret" field.
+func TotpSecretNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldTotpSecret, vs...))
+}
+
+// TotpSecretGT applies the GT predicate on the "totp_secret" field.
+func TotpSecretGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldTotpSecret, v))
+}
+
+// TotpSecretGTE applies the GTE predicate on the "totp_secret" field.
+func TotpSecretGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldTotpSecret, v))
+}
+
+// TotpSecretLT applies the LT predicate on the "totp_secret" field.
+func TotpSecretLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldTotpSecret, v))
+}
+
+// TotpSecretLTE applies the LTE predicate on the "totp_secret" field.
+func TotpSecretLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldTotpSecret, v))
+}
+
+// TotpSecretContains applies the Contains predicate on the "totp_secret" field.
+func TotpSecretContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldTotpSecret, v))
+}
+
+// TotpSecretHasPrefix applies the HasPrefix predicate on the "totp_secret" field.
+func TotpSecretHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldTotpSecret, v))
+}
+
+// TotpSecretHasSuffix applies the HasSuffix predicate on the "totp_secret" field.
+func TotpSecretHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldTotpSecret, v))
+}
+
+// TotpSecretIsNil applies the IsNil predicate on the "totp_secret" field.
+func TotpSecretIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldTotpSecret))
+}
+
+// TotpSecretNotNil applies the NotNil predicate on the "totp_secret" field.
+func TotpSecretNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldTotpSecret))
+}
+
+// TotpSecretEqualFold applies the EqualFold predicate on the "totp_secret" field.
+func TotpSecretEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldTotpSecret, v))
+}
+
+// TotpSecretContainsFold applies the ContainsFold predicate on the "totp_secret" field.
+func TotpSecretContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldTotpSecret, v))
+}
+
+// TotpLastUsedStepEQ applies the EQ predicate on the "totp_last_used_step" field.
+func TotpLastUsedStepEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpLastUsedStep, v))
+}
+
+// TotpLastUsedStepNEQ applies the NEQ predicate on the "totp_last_used_step" field.
+func TotpLastUsedStepNEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTotpLastUsedStep, v))
+}
+
+// TotpLastUsedStepIn applies the In predicate on the "totp_last_used_step" field.
+func TotpLastUsedStepIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldIn(FieldTotpLastUsedStep, vs...))
+}
+
+// TotpLastUsedStepNotIn applies the NotIn predicate on the "totp_last_used_step" field.
+func TotpLastUsedStepNotIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldTotpLastUsedStep, vs...))
+}
+
+// TotpLastUsedStepGT applies the GT predicate on the "totp_last_used_step" field.
+func TotpLastUsedStepGT(v int64) predicate.User {
+	return predicate.User(sql.FieldGT(FieldTotpLastUsedStep, v))
+}
+
+// TotpLastUsedStepGTE applies the GTE predicate on the "totp_last_used_step" field.
+func TotpLastUsedStepGTE(v int64) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldTotpLastUsedStep, v))
+}
+
+// TotpLastUsedStepLT applies the LT predicate on the "totp_last_used_step" field.
+func TotpLastUsedStepLT(v int64) predicate.User {
+	return predicate.User(sql.FieldLT(FieldTotpLastUsedStep, v))
+}
+
+// TotpLastUsedStepLTE applies the LTE predicate on the "totp_last_used_step" field.
+func TotpLastUsedStepLTE(v int64) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldTotpLastUsedStep, v))
+}
+
+// TotpLastUsedStepIsNil applies the IsNil predicate on the "totp_last_used_step" field.
+func TotpLastUsedStepIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldTotpLastUsedStep))
+}
+
+// TotpLastUsedStepNotNil applies the NotNil predicate on the "totp_last_used_step" field.
+func TotpLastUsedStepNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldTotpLastUsedStep))
+}
+
+// TwoFactorEnabledAtEQ applies the EQ predicate on the "two_factor_enabled_at" field.
+func TwoFactorEnabledAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTwoFactorEnabledAt, v))
+}
+
+// TwoFactorEnabledAtNEQ applies the NEQ predicate on the "two_factor_enabled_at" field.
+func TwoFactorEnabledAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTwoFactorEnabledAt, v))
+}
+
+// TwoFactorEnabledAtIn applies the In predicate on the "two_factor_enabled_at" field.
+func TwoFactorEnabledAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldTwoFactorEnabledAt, vs...))
+}
+
+// TwoFactorEnabledAtNotIn applies the NotIn predicate on the "two_factor_enabled_at" field.
+func TwoFactorEnabledAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldTwoFactorEnabledAt, vs...))
+}
+
+// TwoFactorEnabledAtGT applies the GT predicate on the "two_factor_enabled_at" field.
+func TwoFactorEnabledAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldTwoFactorEnabledAt, v))
+}
+
+// TwoFactorEnabledAtGTE applies the GTE predicate on the "two_factor_enabled_at" field.
+func TwoFactorEnabledAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldTwoFactorEnabledAt, v))
+}
+
+// TwoFactorEnabledAtLT applies the LT predicate on the "two_factor_enabled_at" field.
+func TwoFactorEnabledAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldTwoFactorEnabledAt, v))
+}
+
+// TwoFactorEnabledAtLTE applies the LTE predicate on the "two_factor_enabled_at" field.
+func TwoFactorEnabledAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldTwoFactorEnabledAt, v))
+}
+
+// TwoFactorEnabledAtIsNil applies the IsNil predicate on the "two_factor_enabled_at" field.
+func TwoFactorEnabledAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldTwoFactorEnabledAt))
+}
+
+// TwoFactorEnabledAtNotNil applies the NotNil predicate on the "two_factor_enabled_at" field.
+func TwoFactorEnabledAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldTwoFactorEnabledAt))
+}
+
+// TwoFactorFailedAttemptsEQ applies the EQ predicate on the "two_factor_failed_attempts" field.
+func TwoFactorFailedAttemptsEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTwoFactorFailedAttempts, v))
+}
+
+// TwoFactorFailedAttemptsNEQ applies the NEQ predicate on the "two_factor_failed_attempts" field.
+func TwoFactorFailedAttemptsNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTwoFactorFailedAttempts, v))
+}
+
+// TwoFactorFailedAttemptsIn applies the In predicate on the "two_factor_failed_attempts" field.
+func TwoFactorFailedAttemptsIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldTwoFactorFailedAttempts, vs...))
+}
+
+// TwoFactorFailedAttemptsNotIn applies the NotIn predicate on the "two_factor_failed_attempts" field.
+func TwoFactorFailedAttemptsNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldTwoFactorFailedAttempts, vs...))
+}
+
+// TwoFactorFailedAttemptsGT applies the GT predicate on the "two_factor_failed_attempts" field.
+func TwoFactorFailedAttemptsGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldTwoFactorFailedAttempts, v))
+}
+
+// TwoFactorFailedAttemptsGTE applies the GTE predicate on the "two_factor_failed_attempts" field.
+func TwoFactorFailedAttemptsGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldTwoFactorFailedAttempts, v))
+}
+
+// TwoFactorFailedAttemptsLT applies the LT predicate on the "two_factor_failed_attempts" field.
+func TwoFactorFailedAttemptsLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldTwoFactorFailedAttempts, v))
+}
+
+// TwoFactorFailedAttemptsLTE applies the LTE predicate on the "two_factor_failed_attempts" field.
+func TwoFactorFailedAttemptsLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldTwoFactorFailedAttempts, v))
+}
+
+// TwoFactorLockedUntilEQ applies the EQ predicate on the "two_factor_locked_until" field.
+func TwoFactorLockedUntilEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTwoFactorLockedUntil, v))
+}
+
+// TwoFactorLockedUntilNEQ applies the NEQ predicate on the "two_factor_locked_until" field.
+func TwoFactorLockedUntilNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTwoFactorLockedUntil, v))
+}
+
+// TwoFactorLockedUntilIn applies the In predicate on the "two_factor_locked_until" field.
+func TwoFactorLockedUntilIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldTwoFactorLockedUntil, vs...))
+}
+
+// TwoFactorLockedUntilNotIn applies the NotIn predicate on the "two_factor_locked_until" field.
+func TwoFactorLockedUntilNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldTwoFactorLockedUntil, vs...))
+}
+
+// TwoFactorLockedUntilGT applies the GT predicate on the "two_factor_locked_until" field.
+func TwoFactorLockedUntilGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldTwoFactorLockedUntil, v))
+}
+
+// TwoFactorLockedUntilGTE applies the GTE predicate on the "two_factor_locked_until" field.
+func TwoFactorLockedUntilGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldTwoFactorLockedUntil, v))
+}
+
+// TwoFactorLockedUntilLT applies the LT predicate on the "two_factor_locked_until" field.
+func TwoFactorLockedUntilLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldTwoFactorLockedUntil, v))
+}
+
+// TwoFactorLockedUntilLTE applies the LTE predicate on the "two_factor_locked_until" field.
+func TwoFactorLockedUntilLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldTwoFactorLockedUntil, v))
+}
+
+// TwoFactorLockedUntilIsNil applies the IsNil predicate on the "two_factor_locked_until" field.
+func TwoFactorLockedUntilIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldTwoFactorLockedUntil))
+}
+
+// TwoFactorLockedUntilNotNil applies the NotNil predicate on the "two_factor_locked_until" field.
+func TwoFactorLockedUntilNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldTwoFactorLockedUntil))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -308,6 +653,121 @@ func HasWorkspacesWith(preds ...predicate.Workspace) predicate.User {
 	})
 }
 
+// HasSessions applies the HasEdge predicate on the "sessions" edge.
+func HasSessions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSessionsWith applies the HasEdge predicate on the "sessions" edge with a given conditions (other predicates).
+func HasSessionsWith(preds ...predicate.Session) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPasswordResetTokens applies the HasEdge predicate on the "password_reset_tokens" edge.
+func HasPasswordResetTokens() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PasswordResetTokensTable, PasswordResetTokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPasswordResetTokensWith applies the HasEdge predicate on the "password_reset_tokens" edge with a given conditions (other predicates).
+func HasPasswordResetTokensWith(preds ...predicate.PasswordResetToken) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newPasswordResetTokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEmailVerificationTokens applies the HasEdge predicate on the "email_verification_tokens" edge.
+func HasEmailVerificationTokens() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EmailVerificationTokensTable, EmailVerificationTokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmailVerificationTokensWith applies the HasEdge predicate on the "email_verification_tokens" edge with a given conditions (other predicates).
+func HasEmailVerificationTokensWith(preds ...predicate.EmailVerificationToken) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newEmailVerificationTokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecoveryCodes applies the HasEdge predicate on the "recovery_codes" edge.
+func HasRecoveryCodes() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RecoveryCodesTable, RecoveryCodesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecoveryCodesWith applies the HasEdge predicate on the "recovery_codes" edge with a given conditions (other predicates).
+func HasRecoveryCodesWith(preds ...predicate.RecoveryCode) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newRecoveryCodesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMemberships applies the HasEdge predicate on the "memberships" edge.
+func HasMemberships() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, MembershipsTable, MembershipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMembershipsWith applies the HasEdge predicate on the "memberships" edge with a given conditions (other predicates).
+func HasMembershipsWith(preds ...predicate.Membership) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMembershipsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -19,6 +19,8 @@ type Workspace struct {
 	ID int `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// RequireVerifiedEmail holds the value of the "require_verified_email" field.
+	RequireVerifiedEmail bool `json:"require_verified_email,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -33,9 +35,41 @@ type Workspace struct {
 type WorkspaceEdges struct {
 	// Users holds the value of the users edge.
 	Users []*User `json:"users,omitempty"`
+	// Invitations holds the value of the invitations edge.
+	Invitations []*WorkspaceInvitation `json:"invitations,omitempty"`
+	// Accounts holds the value of the accounts edge.
+	Accounts []*Account `json:"accounts,omitempty"`
+	// Transactions holds the value of the transactions edge.
+	Transactions []*Transaction `json:"transactions,omitempty"`
+	// TransactionSplits holds the value of the transaction_splits edge.
+	TransactionSplits []*TransactionSplit `json:"transaction_splits,omitempty"`
+	// Transfers holds the value of the transfers edge.
+	Transfers []*Transfer `json:"transfers,omitempty"`
+	// Categories holds the value of the categories edge.
+	Categories []*Category `json:"categories,omitempty"`
+	// Payees holds the value of the payees edge.
+	Payees []*Payee `json:"payees,omitempty"`
+	// RecurrenceRules holds the value of the recurrence_rules edge.
+	RecurrenceRules []*RecurrenceRule `json:"recurrence_rules,omitempty"`
+	// RecurrenceOccurrences holds the value of the recurrence_occurrences edge.
+	RecurrenceOccurrences []*RecurrenceOccurrence `json:"recurrence_occurrences,omitempty"`
+	// StatementImports holds the value of the statement_imports edge.
+	StatementImports []*StatementImport `json:"statement_imports,omitempty"`
+	// ImportProfiles holds the value of the import_profiles edge.
+	ImportProfiles []*ImportProfile `json:"import_profiles,omitempty"`
+	// ImportDecisions holds the value of the import_decisions edge.
+	ImportDecisions []*ImportDecision `json:"import_decisions,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// JournalEntries holds the value of the journal_entries edge.
+	JournalEntries []*JournalEntry `json:"journal_entries,omitempty"`
+	// Postings holds the value of the postings edge.
+	Postings []*Posting `json:"postings,omitempty"`
+	// Memberships holds the value of the memberships edge.
+	Memberships []*Membership `json:"memberships,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [17]bool
 }
 
 // UsersOrErr returns the Users value or an error if the edge
@@ -47,11 +81,157 @@ func (e WorkspaceEdges) UsersOrErr() ([]*User, error) {
 	return nil, &NotLoadedError{edge: "users"}
 }
 
+// InvitationsOrErr returns the Invitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) InvitationsOrErr() ([]*WorkspaceInvitation, error) {
+	if e.loadedTypes[1] {
+		return e.Invitations, nil
+	}
+	return nil, &NotLoadedError{edge: "invitations"}
+}
+
+// AccountsOrErr returns the Accounts value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) AccountsOrErr() ([]*Account, error) {
+	if e.loadedTypes[2] {
+		return e.Accounts, nil
+	}
+	return nil, &NotLoadedError{edge: "accounts"}
+}
+
+// TransactionsOrErr returns the Transactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) TransactionsOrErr() ([]*Transaction, error) {
+	if e.loadedTypes[3] {
+		return e.Transactions, nil
+	}
+	return nil, &NotLoadedError{edge: "transactions"}
+}
+
+// TransactionSplitsOrErr returns the TransactionSplits value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) TransactionSplitsOrErr() ([]*TransactionSplit, error) {
+	if e.loadedTypes[4] {
+		return e.TransactionSplits, nil
+	}
+	return nil, &NotLoadedError{edge: "transaction_splits"}
+}
+
+// TransfersOrErr returns the Transfers value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) TransfersOrErr() ([]*Transfer, error) {
+	if e.loadedTypes[5] {
+		return e.Transfers, nil
+	}
+	return nil, &NotLoadedError{edge: "transfers"}
+}
+
+// CategoriesOrErr returns the Categories value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) CategoriesOrErr() ([]*Category, error) {
+	if e.loadedTypes[6] {
+		return e.Categories, nil
+	}
+	return nil, &NotLoadedError{edge: "categories"}
+}
+
+// PayeesOrErr returns the Payees value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) PayeesOrErr() ([]*Payee, error) {
+	if e.loadedTypes[7] {
+		return e.Payees, nil
+	}
+	return nil, &NotLoadedError{edge: "payees"}
+}
+
+// RecurrenceRulesOrErr returns the RecurrenceRules value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) RecurrenceRulesOrErr() ([]*RecurrenceRule, error) {
+	if e.loadedTypes[8] {
+		return e.RecurrenceRules, nil
+	}
+	return nil, &NotLoadedError{edge: "recurrence_rules"}
+}
+
+// RecurrenceOccurrencesOrErr returns the RecurrenceOccurrences value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) RecurrenceOccurrencesOrErr() ([]*RecurrenceOccurrence, error) {
+	if e.loadedTypes[9] {
+		return e.RecurrenceOccurrences, nil
+	}
+	return nil, &NotLoadedError{edge: "recurrence_occurrences"}
+}
+
+// StatementImportsOrErr returns the StatementImports value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) StatementImportsOrErr() ([]*StatementImport, error) {
+	if e.loadedTypes[10] {
+		return e.StatementImports, nil
+	}
+	return nil, &NotLoadedError{edge: "statement_imports"}
+}
+
+// ImportProfilesOrErr returns the ImportProfiles value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) ImportProfilesOrErr() ([]*ImportProfile, error) {
+	if e.loadedTypes[11] {
+		return e.ImportProfiles, nil
+	}
+	return nil, &NotLoadedError{edge: "import_profiles"}
+}
+
+// ImportDecisionsOrErr returns the ImportDecisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) ImportDecisionsOrErr() ([]*ImportDecision, error) {
+	if e.loadedTypes[12] {
+		return e.ImportDecisions, nil
+	}
+	return nil, &NotLoadedError{edge: "import_decisions"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[13] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// JournalEntriesOrErr returns the JournalEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) JournalEntriesOrErr() ([]*JournalEntry, error) {
+	if e.loadedTypes[14] {
+		return e.JournalEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "journal_entries"}
+}
+
+// PostingsOrErr returns the Postings value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) PostingsOrErr() ([]*Posting, error) {
+	if e.loadedTypes[15] {
+		return e.Postings, nil
+	}
+	return nil, &NotLoadedError{edge: "postings"}
+}
+
+// MembershipsOrErr returns the Memberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) MembershipsOrErr() ([]*Membership, error) {
+	if e.loadedTypes[16] {
+		return e.Memberships, nil
+	}
+	return nil, &NotLoadedError{edge: "memberships"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Workspace) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case workspace.FieldRequireVerifiedEmail:
+			values[i] = new(sql.NullBool)
 		case workspace.FieldID:
 			values[i] = new(sql.NullInt64)
 		case workspace.FieldName:
@@ -85,6 +265,12 @@ func (_m *Workspace) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case workspace.FieldRequireVerifiedEmail:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_verified_email", values[i])
+			} else if value.Valid {
+				_m.RequireVerifiedEmail = value.Bool
+			}
 		case workspace.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -115,6 +301,86 @@ func (_m *Workspace) QueryUsers() *UserQuery {
 	return NewWorkspaceClient(_m.config).QueryUsers(_m)
 }
 
+// QueryInvitations queries the "invitations" edge of the Workspace entity.
+func (_m *Workspace) QueryInvitations() *WorkspaceInvitationQuery {
+	return NewWorkspaceClient(_m.config).QueryInvitations(_m)
+}
+
+// QueryAccounts queries the "accounts" edge of the Workspace entity.
+func (_m *Workspace) QueryAccounts() *AccountQuery {
+	return NewWorkspaceClient(_m.config).QueryAccounts(_m)
+}
+
+// QueryTransactions queries the "transactions" edge of the Workspace entity.
+func (_m *Workspace) QueryTransactions() *TransactionQuery {
+	return NewWorkspaceClient(_m.config).QueryTransactions(_m)
+}
+
+// QueryTransactionSplits queries the "transaction_splits" edge of the Workspace entity.
+func (_m *Workspace) QueryTransactionSplits() *TransactionSplitQuery {
+	return NewWorkspaceClient(_m.config).QueryTransactionSplits(_m)
+}
+
+// QueryTransfers queries the "transfers" edge of the Workspace entity.
+func (_m *Workspace) QueryTransfers() *TransferQuery {
+	return NewWorkspaceClient(_m.config).QueryTransfers(_m)
+}
+
+// QueryCategories queries the "categories" edge of the Workspace entity.
+func (_m *Workspace) QueryCategories() *CategoryQuery {
+	return NewWorkspaceClient(_m.config).QueryCategories(_m)
+}
+
+// QueryPayees queries the "payees" edge of the Workspace entity.
+func (_m *Workspace) QueryPayees() *PayeeQuery {
+	return NewWorkspaceClient(_m.config).QueryPayees(_m)
+}
+
+// QueryRecurrenceRules queries the "recurrence_rules" edge of the Workspace entity.
+func (_m *Workspace) QueryRecurrenceRules() *RecurrenceRuleQuery {
+	return NewWorkspaceClient(_m.config).QueryRecurrenceRules(_m)
+}
+
+// QueryRecurrenceOccurrences queries the "recurrence_occurrences" edge of the Workspace entity.
+func (_m *Workspace) QueryRecurrenceOccurrences() *RecurrenceOccurrenceQuery {
+	return NewWorkspaceClient(_m.config).QueryRecurrenceOccurrences(_m)
+}
+
+// QueryStatementImports queries the "statement_imports" edge of the Workspace entity.
+func (_m *Workspace) QueryStatementImports() *StatementImportQuery {
+	return NewWorkspaceClient(_m.config).QueryStatementImports(_m)
+}
+
+// QueryImportProfiles queries the "import_profiles" edge of the Workspace entity.
+func (_m *Workspace) QueryImportProfiles() *ImportProfileQuery {
+	return NewWorkspaceClient(_m.config).QueryImportProfiles(_m)
+}
+
+// QueryImportDecisions queries the "import_decisions" edge of the Workspace entity.
+func (_m *Workspace) QueryImportDecisions() *ImportDecisionQuery {
+	return NewWorkspaceClient(_m.config).QueryImportDecisions(_m)
+}
+
+// QueryTags queries the "tags" edge of the Workspace entity.
+func (_m *Workspace) QueryTags() *TagQuery {
+	return NewWorkspaceClient(_m.config).QueryTags(_m)
+}
+
+// QueryJournalEntries queries the "journal_entries" edge of the Workspace entity.
+func (_m *Workspace) QueryJournalEntries() *JournalEntryQuery {
+	return NewWorkspaceClient(_m.config).QueryJournalEntries(_m)
+}
+
+// QueryPostings queries the "postings" edge of the Workspace entity.
+func (_m *Workspace) QueryPostings() *PostingQuery {
+	return NewWorkspaceClient(_m.config).QueryPostings(_m)
+}
+
+// QueryMemberships queries the "memberships" edge of the Workspace entity.
+func (_m *Workspace) QueryMemberships() *MembershipQuery {
+	return NewWorkspaceClient(_m.config).QueryMemberships(_m)
+}
+
 // Update returns a builder for updating this Workspace.
 // Note that you need to call Workspace.Unwrap() before calling this method if this Workspace
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -141,6 +407,9 @@ func (_m *Workspace) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
+	builder.WriteString("require_verified_email=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequireVerifiedEmail))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
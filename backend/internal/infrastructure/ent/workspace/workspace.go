@@ -16,25 +16,172 @@ const (
 	FieldID = "id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldRequireVerifiedEmail holds the string denoting the require_verified_email field in the database.
+	FieldRequireVerifiedEmail = "require_verified_email"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeUsers holds the string denoting the users edge name in mutations.
 	EdgeUsers = "users"
+	// EdgeInvitations holds the string denoting the invitations edge name in mutations.
+	EdgeInvitations = "invitations"
+	// EdgeAccounts holds the string denoting the accounts edge name in mutations.
+	EdgeAccounts = "accounts"
+	// EdgeTransactions holds the string denoting the transactions edge name in mutations.
+	EdgeTransactions = "transactions"
+	// EdgeTransactionSplits holds the string denoting the transaction_splits edge name in mutations.
+	EdgeTransactionSplits = "transaction_splits"
+	// EdgeTransfers holds the string denoting the transfers edge name in mutations.
+	EdgeTransfers = "transfers"
+	// EdgeCategories holds the string denoting the categories edge name in mutations.
+	EdgeCategories = "categories"
+	// EdgePayees holds the string denoting the payees edge name in mutations.
+	EdgePayees = "payees"
+	// EdgeRecurrenceRules holds the string denoting the recurrence_rules edge name in mutations.
+	EdgeRecurrenceRules = "recurrence_rules"
+	// EdgeRecurrenceOccurrences holds the string denoting the recurrence_occurrences edge name in mutations.
+	EdgeRecurrenceOccurrences = "recurrence_occurrences"
+	// EdgeStatementImports holds the string denoting the statement_imports edge name in mutations.
+	EdgeStatementImports = "statement_imports"
+	// EdgeImportProfiles holds the string denoting the import_profiles edge name in mutations.
+	EdgeImportProfiles = "import_profiles"
+	// EdgeImportDecisions holds the string denoting the import_decisions edge name in mutations.
+	EdgeImportDecisions = "import_decisions"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeJournalEntries holds the string denoting the journal_entries edge name in mutations.
+	EdgeJournalEntries = "journal_entries"
+	// EdgePostings holds the string denoting the postings edge name in mutations.
+	EdgePostings = "postings"
+	// EdgeMemberships holds the string denoting the memberships edge name in mutations.
+	EdgeMemberships = "memberships"
 	// Table holds the table name of the workspace in the database.
 	Table = "workspaces"
 	// UsersTable is the table that holds the users relation/edge. The primary key declared below.
-	UsersTable = "workspace_users"
+	UsersTable = "memberships"
 	// UsersInverseTable is the table name for the User entity.
 	// It exists in this package in order to avoid circular dependency with the "user" package.
 	UsersInverseTable = "users"
+	// InvitationsTable is the table that holds the invitations relation/edge.
+	InvitationsTable = "workspace_invitations"
+	// InvitationsInverseTable is the table name for the WorkspaceInvitation entity.
+	// It exists in this package in order to avoid circular dependency with the "workspaceinvitation" package.
+	InvitationsInverseTable = "workspace_invitations"
+	// InvitationsColumn is the table column denoting the invitations relation/edge.
+	InvitationsColumn = "workspace_id"
+	// AccountsTable is the table that holds the accounts relation/edge.
+	AccountsTable = "accounts"
+	// AccountsInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountsInverseTable = "accounts"
+	// AccountsColumn is the table column denoting the accounts relation/edge.
+	AccountsColumn = "workspace_id"
+	// TransactionsTable is the table that holds the transactions relation/edge.
+	TransactionsTable = "transactions"
+	// TransactionsInverseTable is the table name for the Transaction entity.
+	// It exists in this package in order to avoid circular dependency with the "transaction" package.
+	TransactionsInverseTable = "transactions"
+	// TransactionsColumn is the table column denoting the transactions relation/edge.
+	TransactionsColumn = "workspace_id"
+	// TransactionSplitsTable is the table that holds the transaction_splits relation/edge.
+	TransactionSplitsTable = "transaction_splits"
+	// TransactionSplitsInverseTable is the table name for the TransactionSplit entity.
+	// It exists in this package in order to avoid circular dependency with the "transactionsplit" package.
+	TransactionSplitsInverseTable = "transaction_splits"
+	// TransactionSplitsColumn is the table column denoting the transaction_splits relation/edge.
+	TransactionSplitsColumn = "workspace_id"
+	// TransfersTable is the table that holds the transfers relation/edge.
+	TransfersTable = "transfers"
+	// TransfersInverseTable is the table name for the Transfer entity.
+	// It exists in this package in order to avoid circular dependency with the "transfer" package.
+	TransfersInverseTable = "transfers"
+	// TransfersColumn is the table column denoting the transfers relation/edge.
+	TransfersColumn = "workspace_id"
+	// CategoriesTable is the table that holds the categories relation/edge.
+	CategoriesTable = "categories"
+	// CategoriesInverseTable is the table name for the Category entity.
+	// It exists in this package in order to avoid circular dependency with the "category" package.
+	CategoriesInverseTable = "categories"
+	// CategoriesColumn is the table column denoting the categories relation/edge.
+	CategoriesColumn = "workspace_id"
+	// PayeesTable is the table that holds the payees relation/edge.
+	PayeesTable = "payees"
+	// PayeesInverseTable is the table name for the Payee entity.
+	// It exists in this package in order to avoid circular dependency with the "payee" package.
+	PayeesInverseTable = "payees"
+	// PayeesColumn is the table column denoting the payees relation/edge.
+	PayeesColumn = "workspace_id"
+	// RecurrenceRulesTable is the table that holds the recurrence_rules relation/edge.
+	RecurrenceRulesTable = "recurrence_rules"
+	// RecurrenceRulesInverseTable is the table name for the RecurrenceRule entity.
+	// It exists in this package in order to avoid circular dependency with the "recurrencerule" package.
+	RecurrenceRulesInverseTable = "recurrence_rules"
+	// RecurrenceRulesColumn is the table column denoting the recurrence_rules relation/edge.
+	RecurrenceRulesColumn = "workspace_id"
+	// RecurrenceOccurrencesTable is the table that holds the recurrence_occurrences relation/edge.
+	RecurrenceOccurrencesTable = "recurrence_occurrences"
+	// RecurrenceOccurrencesInverseTable is the table name for the RecurrenceOccurrence entity.
+	// It exists in this package in order to avoid circular dependency with the "recurrenceoccurrence" package.
+	RecurrenceOccurrencesInverseTable = "recurrence_occurrences"
+	// RecurrenceOccurrencesColumn is the table column denoting the recurrence_occurrences relation/edge.
+	RecurrenceOccurrencesColumn = "workspace_id"
+	// StatementImportsTable is the table that holds the statement_imports relation/edge.
+	StatementImportsTable = "statement_imports"
+	// StatementImportsInverseTable is the table name for the StatementImport entity.
+	// It exists in this package in order to avoid circular dependency with the "statementimport" package.
+	StatementImportsInverseTable = "statement_imports"
+	// StatementImportsColumn is the table column denoting the statement_imports relation/edge.
+	StatementImportsColumn = "workspace_id"
+	// ImportProfilesTable is the table that holds the import_profiles relation/edge.
+	ImportProfilesTable = "import_profiles"
+	// ImportProfilesInverseTable is the table name for the ImportProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "importprofile" package.
+	ImportProfilesInverseTable = "import_profiles"
+	// ImportProfilesColumn is the table column denoting the import_profiles relation/edge.
+	ImportProfilesColumn = "workspace_id"
+	// ImportDecisionsTable is the table that holds the import_decisions relation/edge.
+	ImportDecisionsTable = "import_decisions"
+	// ImportDecisionsInverseTable is the table name for the ImportDecision entity.
+	// It exists in this package in order to avoid circular dependency with the "importdecision" package.
+	ImportDecisionsInverseTable = "import_decisions"
+	// ImportDecisionsColumn is the table column denoting the import_decisions relation/edge.
+	ImportDecisionsColumn = "workspace_id"
+	// TagsTable is the table that holds the tags relation/edge.
+	TagsTable = "tags"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+	// TagsColumn is the table column denoting the tags relation/edge.
+	TagsColumn = "workspace_id"
+	// JournalEntriesTable is the table that holds the journal_entries relation/edge.
+	JournalEntriesTable = "journal_entries"
+	// JournalEntriesInverseTable is the table name for the JournalEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "journalentry" package.
+	JournalEntriesInverseTable = "journal_entries"
+	// JournalEntriesColumn is the table column denoting the journal_entries relation/edge.
+	JournalEntriesColumn = "workspace_id"
+	// PostingsTable is the table that holds the postings relation/edge.
+	PostingsTable = "postings"
+	// PostingsInverseTable is the table name for the Posting entity.
+	// It exists in this package in order to avoid circular dependency with the "posting" package.
+	PostingsInverseTable = "postings"
+	// PostingsColumn is the table column denoting the postings relation/edge.
+	PostingsColumn = "workspace_id"
+	// MembershipsTable is the table that holds the memberships relation/edge.
+	MembershipsTable = "memberships"
+	// MembershipsInverseTable is the table name for the Membership entity.
+	// It exists in this package in order to avoid circular dependency with the "membership" package.
+	MembershipsInverseTable = "memberships"
+	// MembershipsColumn is the table column denoting the memberships relation/edge.
+	MembershipsColumn = "workspace_id"
 )
 
 // Columns holds all SQL columns for workspace fields.
 var Columns = []string{
 	FieldID,
 	FieldName,
+	FieldRequireVerifiedEmail,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -58,6 +205,8 @@ func ValidColumn(column string) bool {
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultRequireVerifiedEmail holds the default value on creation for the "require_verified_email" field.
+	DefaultRequireVerifiedEmail bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -79,6 +228,11 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByRequireVerifiedEmail orders the results by the require_verified_email field.
+func ByRequireVerifiedEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireVerifiedEmail, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -102,6 +256,230 @@ func ByUsers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUsersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByInvitationsCount orders the results by invitations count.
+func ByInvitationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInvitationsStep(), opts...)
+	}
+}
+
+// ByInvitations orders the results by invitations terms.
+func ByInvitations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvitationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAccountsCount orders the results by accounts count.
+func ByAccountsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAccountsStep(), opts...)
+	}
+}
+
+// ByAccounts orders the results by accounts terms.
+func ByAccounts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTransactionsCount orders the results by transactions count.
+func ByTransactionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTransactionsStep(), opts...)
+	}
+}
+
+// ByTransactions orders the results by transactions terms.
+func ByTransactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTransactionSplitsCount orders the results by transaction_splits count.
+func ByTransactionSplitsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTransactionSplitsStep(), opts...)
+	}
+}
+
+// ByTransactionSplits orders the results by transaction_splits terms.
+func ByTransactionSplits(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransactionSplitsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTransfersCount orders the results by transfers count.
+func ByTransfersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTransfersStep(), opts...)
+	}
+}
+
+// ByTransfers orders the results by transfers terms.
+func ByTransfers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransfersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCategoriesCount orders the results by categories count.
+func ByCategoriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCategoriesStep(), opts...)
+	}
+}
+
+// ByCategories orders the results by categories terms.
+func ByCategories(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCategoriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPayeesCount orders the results by payees count.
+func ByPayeesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPayeesStep(), opts...)
+	}
+}
+
+// ByPayees orders the results by payees terms.
+func ByPayees(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPayeesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRecurrenceRulesCount orders the results by recurrence_rules count.
+func ByRecurrenceRulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecurrenceRulesStep(), opts...)
+	}
+}
+
+// ByRecurrenceRules orders the results by recurrence_rules terms.
+func ByRecurrenceRules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecurrenceRulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRecurrenceOccurrencesCount orders the results by recurrence_occurrences count.
+func ByRecurrenceOccurrencesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecurrenceOccurrencesStep(), opts...)
+	}
+}
+
+// ByRecurrenceOccurrences orders the results by recurrence_occurrences terms.
+func ByRecurrenceOccurrences(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecurrenceOccurrencesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStatementImportsCount orders the results by statement_imports count.
+func ByStatementImportsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStatementImportsStep(), opts...)
+	}
+}
+
+// ByStatementImports orders the results by statement_imports terms.
+func ByStatementImports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatementImportsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByImportProfilesCount orders the results by import_profiles count.
+func ByImportProfilesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newImportProfilesStep(), opts...)
+	}
+}
+
+// ByImportProfiles orders the results by import_profiles terms.
+func ByImportProfiles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newImportProfilesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByImportDecisionsCount orders the results by import_decisions count.
+func ByImportDecisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newImportDecisionsStep(), opts...)
+	}
+}
+
+// ByImportDecisions orders the results by import_decisions terms.
+func ByImportDecisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newImportDecisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByJournalEntriesCount orders the results by journal_entries count.
+func ByJournalEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newJournalEntriesStep(), opts...)
+	}
+}
+
+// ByJournalEntries orders the results by journal_entries terms.
+func ByJournalEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJournalEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPostingsCount orders the results by postings count.
+func ByPostingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPostingsStep(), opts...)
+	}
+}
+
+// ByPostings orders the results by postings terms.
+func ByPostings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPostingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMembershipsCount orders the results by memberships count.
+func ByMembershipsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMembershipsStep(), opts...)
+	}
+}
+
+// ByMemberships orders the results by memberships terms.
+func ByMemberships(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMembershipsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUsersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -109,3 +487,115 @@ func newUsersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, UsersTable, UsersPrimaryKey...),
 	)
 }
+func newInvitationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvitationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InvitationsTable, InvitationsColumn),
+	)
+}
+func newAccountsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AccountsTable, AccountsColumn),
+	)
+}
+func newTransactionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransactionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TransactionsTable, TransactionsColumn),
+	)
+}
+func newTransactionSplitsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransactionSplitsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TransactionSplitsTable, TransactionSplitsColumn),
+	)
+}
+func newTransfersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransfersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TransfersTable, TransfersColumn),
+	)
+}
+func newCategoriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CategoriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CategoriesTable, CategoriesColumn),
+	)
+}
+func newPayeesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PayeesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PayeesTable, PayeesColumn),
+	)
+}
+func newRecurrenceRulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecurrenceRulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RecurrenceRulesTable, RecurrenceRulesColumn),
+	)
+}
+func newRecurrenceOccurrencesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecurrenceOccurrencesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RecurrenceOccurrencesTable, RecurrenceOccurrencesColumn),
+	)
+}
+func newStatementImportsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatementImportsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StatementImportsTable, StatementImportsColumn),
+	)
+}
+func newImportProfilesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ImportProfilesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ImportProfilesTable, ImportProfilesColumn),
+	)
+}
+func newImportDecisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ImportDecisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ImportDecisionsTable, ImportDecisionsColumn),
+	)
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
+	)
+}
+func newJournalEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JournalEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, JournalEntriesTable, JournalEntriesColumn),
+	)
+}
+func newPostingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PostingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PostingsTable, PostingsColumn),
+	)
+}
+func newMembershipsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MembershipsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, MembershipsTable, MembershipsColumn),
+	)
+}
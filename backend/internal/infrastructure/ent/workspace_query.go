@@ -3,9 +3,25 @@
 package ent
 
 import (
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/ent/importdecision"
+	"backend/internal/infrastructure/ent/importprofile"
+	"backend/internal/infrastructure/ent/journalentry"
+	"backend/internal/infrastructure/ent/membership"
+	"backend/internal/infrastructure/ent/payee"
+	"backend/internal/infrastructure/ent/posting"
 	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/recurrenceoccurrence"
+	"backend/internal/infrastructure/ent/recurrencerule"
+	"backend/internal/infrastructure/ent/statementimport"
+	"backend/internal/infrastructure/ent/tag"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/transactionsplit"
+	"backend/internal/infrastructure/ent/transfer"
 	"backend/internal/infrastructure/ent/user"
 	"backend/internal/infrastructure/ent/workspace"
+	"backend/internal/infrastructure/ent/workspaceinvitation"
 	"context"
 	"database/sql/driver"
 	"fmt"
@@ -20,11 +36,28 @@ import (
 // WorkspaceQuery is the builder for querying Workspace entities.
 type WorkspaceQuery struct {
 	config
-	ctx        *QueryContext
-	order      []workspace.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Workspace
-	withUsers  *UserQuery
+	ctx                       *QueryContext
+	order                     []workspace.OrderOption
+	inters                    []Interceptor
+	predicates                []predicate.Workspace
+	withUsers                 *UserQuery
+	withInvitations           *WorkspaceInvitationQuery
+	withAccounts              *AccountQuery
+	withTransactions          *TransactionQuery
+	withTransactionSplits     *TransactionSplitQuery
+	withTransfers             *TransferQuery
+	withCategories            *CategoryQuery
+	withPayees                *PayeeQuery
+	withRecurrenceRules       *RecurrenceRuleQuery
+	withRecurrenceOccurrences *RecurrenceOccurrenceQuery
+	withStatementImports      *StatementImportQuery
+	withImportProfiles        *ImportProfileQuery
+	withImportDecisions       *ImportDecisionQuery
+	withTags                  *TagQuery
+	withJournalEntries        *JournalEntryQuery
+	withPostings              *PostingQuery
+	withMemberships           *MembershipQuery
+	modifiers                 []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +116,358 @@ func (_q *WorkspaceQuery) QueryUsers() *UserQuery {
 	return query
 }
 
+// QueryInvitations chains the current query on the "invitations" edge.
+func (_q *WorkspaceQuery) QueryInvitations() *WorkspaceInvitationQuery {
+	query := (&WorkspaceInvitationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, selector),
+			sqlgraph.To(workspaceinvitation.Table, workspaceinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.InvitationsTable, workspace.InvitationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccounts chains the current query on the "accounts" edge.
+func (_q *WorkspaceQuery) QueryAccounts() *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.AccountsTable, workspace.AccountsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransactions chains the current query on the "transactions" edge.
+func (_q *WorkspaceQuery) QueryTransactions() *TransactionQuery {
+	query := (&TransactionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, selector),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.TransactionsTable, workspace.TransactionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransactionSplits chains the current query on the "transaction_splits" edge.
+func (_q *WorkspaceQuery) QueryTransactionSplits() *TransactionSplitQuery {
+	query := (&TransactionSplitClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, selector),
+			sqlgraph.To(transactionsplit.Table, transactionsplit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.TransactionSplitsTable, workspace.TransactionSplitsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransfers chains the current query on the "transfers" edge.
+func (_q *WorkspaceQuery) QueryTransfers() *TransferQuery {
+	query := (&TransferClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, selector),
+			sqlgraph.To(transfer.Table, transfer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.TransfersTable, workspace.TransfersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCategories chains the current query on the "categories" edge.
+func (_q *WorkspaceQuery) QueryCategories() *CategoryQuery {
+	query := (&CategoryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, selector),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.CategoriesTable, workspace.CategoriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPayees chains the current query on the "payees" edge.
+func (_q *WorkspaceQuery) QueryPayees() *PayeeQuery {
+	query := (&PayeeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, selector),
+			sqlgraph.To(payee.Table, payee.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.PayeesTable, workspace.PayeesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRecurrenceRules chains the current query on the "recurrence_rules" edge.
+func (_q *WorkspaceQuery) QueryRecurrenceRules() *RecurrenceRuleQuery {
+	query := (&RecurrenceRuleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, selector),
+			sqlgraph.To(recurrencerule.Table, recurrencerule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.RecurrenceRulesTable, workspace.RecurrenceRulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRecurrenceOccurrences chains the current query on the "recurrence_occurrences" edge.
+func (_q *WorkspaceQuery) QueryRecurrenceOccurrences() *RecurrenceOccurrenceQuery {
+	query := (&RecurrenceOccurrenceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, selector),
+			sqlgraph.To(recurrenceoccurrence.Table, recurrenceoccurrence.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.RecurrenceOccurrencesTable, workspace.RecurrenceOccurrencesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStatementImports chains the current query on the "statement_imports" edge.
+func (_q *WorkspaceQuery) QueryStatementImports() *StatementImportQuery {
+	query := (&StatementImportClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, selector),
+			sqlgraph.To(statementimport.Table, statementimport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.StatementImportsTable, workspace.StatementImportsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryImportProfiles chains the current query on the "import_profiles" edge.
+func (_q *WorkspaceQuery) QueryImportProfiles() *ImportProfileQuery {
+	query := (&ImportProfileClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, selector),
+			sqlgraph.To(importprofile.Table, importprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.ImportProfilesTable, workspace.ImportProfilesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryImportDecisions chains the current query on the "import_decisions" edge.
+func (_q *WorkspaceQuery) QueryImportDecisions() *ImportDecisionQuery {
+	query := (&ImportDecisionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, selector),
+			sqlgraph.To(importdecision.Table, importdecision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.ImportDecisionsTable, workspace.ImportDecisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (_q *WorkspaceQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.TagsTable, workspace.TagsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryJournalEntries chains the current query on the "journal_entries" edge.
+func (_q *WorkspaceQuery) QueryJournalEntries() *JournalEntryQuery {
+	query := (&JournalEntryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, selector),
+			sqlgraph.To(journalentry.Table, journalentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.JournalEntriesTable, workspace.JournalEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPostings chains the current query on the "postings" edge.
+func (_q *WorkspaceQuery) QueryPostings() *PostingQuery {
+	query := (&PostingClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, selector),
+			sqlgraph.To(posting.Table, posting.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workspace.PostingsTable, workspace.PostingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMemberships chains the current query on the "memberships" edge.
+func (_q *WorkspaceQuery) QueryMemberships() *MembershipQuery {
+	query := (&MembershipClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspace.Table, workspace.FieldID, selector),
+			sqlgraph.To(membership.Table, membership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, workspace.MembershipsTable, workspace.MembershipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Workspace entity from the query.
 // Returns a *NotFoundError when no Workspace was found.
 func (_q *WorkspaceQuery) First(ctx context.Context) (*Workspace, error) {
@@ -270,15 +655,32 @@ func (_q *WorkspaceQuery) Clone() *WorkspaceQuery {
 		return nil
 	}
 	return &WorkspaceQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]workspace.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Workspace{}, _q.predicates...),
-		withUsers:  _q.withUsers.Clone(),
+		config:                    _q.config,
+		ctx:                       _q.ctx.Clone(),
+		order:                     append([]workspace.OrderOption{}, _q.order...),
+		inters:                    append([]Interceptor{}, _q.inters...),
+		predicates:                append([]predicate.Workspace{}, _q.predicates...),
+		withUsers:                 _q.withUsers.Clone(),
+		withInvitations:           _q.withInvitations.Clone(),
+		withAccounts:              _q.withAccounts.Clone(),
+		withTransactions:          _q.withTransactions.Clone(),
+		withTransactionSplits:     _q.withTransactionSplits.Clone(),
+		withTransfers:             _q.withTransfers.Clone(),
+		withCategories:            _q.withCategories.Clone(),
+		withPayees:                _q.withPayees.Clone(),
+		withRecurrenceRules:       _q.withRecurrenceRules.Clone(),
+		withRecurrenceOccurrences: _q.withRecurrenceOccurrences.Clone(),
+		withStatementImports:      _q.withStatementImports.Clone(),
+		withImportProfiles:        _q.withImportProfiles.Clone(),
+		withImportDecisions:       _q.withImportDecisions.Clone(),
+		withTags:                  _q.withTags.Clone(),
+		withJournalEntries:        _q.withJournalEntries.Clone(),
+		withPostings:              _q.withPostings.Clone(),
+		withMemberships:           _q.withMemberships.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -293,6 +695,182 @@ func (_q *WorkspaceQuery) WithUsers(opts ...func(*UserQuery)) *WorkspaceQuery {
 	return _q
 }
 
+// WithInvitations tells the query-builder to eager-load the nodes that are connected to
+// the "invitations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceQuery) WithInvitations(opts ...func(*WorkspaceInvitationQuery)) *WorkspaceQuery {
+	query := (&WorkspaceInvitationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withInvitations = query
+	return _q
+}
+
+// WithAccounts tells the query-builder to eager-load the nodes that are connected to
+// the "accounts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceQuery) WithAccounts(opts ...func(*AccountQuery)) *WorkspaceQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAccounts = query
+	return _q
+}
+
+// WithTransactions tells the query-builder to eager-load the nodes that are connected to
+// the "transactions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceQuery) WithTransactions(opts ...func(*TransactionQuery)) *WorkspaceQuery {
+	query := (&TransactionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTransactions = query
+	return _q
+}
+
+// WithTransactionSplits tells the query-builder to eager-load the nodes that are connected to
+// the "transaction_splits" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceQuery) WithTransactionSplits(opts ...func(*TransactionSplitQuery)) *WorkspaceQuery {
+	query := (&TransactionSplitClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTransactionSplits = query
+	return _q
+}
+
+// WithTransfers tells the query-builder to eager-load the nodes that are connected to
+// the "transfers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceQuery) WithTransfers(opts ...func(*TransferQuery)) *WorkspaceQuery {
+	query := (&TransferClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTransfers = query
+	return _q
+}
+
+// WithCategories tells the query-builder to eager-load the nodes that are connected to
+// the "categories" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceQuery) WithCategories(opts ...func(*CategoryQuery)) *WorkspaceQuery {
+	query := (&CategoryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCategories = query
+	return _q
+}
+
+// WithPayees tells the query-builder to eager-load the nodes that are connected to
+// the "payees" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceQuery) WithPayees(opts ...func(*PayeeQuery)) *WorkspaceQuery {
+	query := (&PayeeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPayees = query
+	return _q
+}
+
+// WithRecurrenceRules tells the query-builder to eager-load the nodes that are connected to
+// the "recurrence_rules" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceQuery) WithRecurrenceRules(opts ...func(*RecurrenceRuleQuery)) *WorkspaceQuery {
+	query := (&RecurrenceRuleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRecurrenceRules = query
+	return _q
+}
+
+// WithRecurrenceOccurrences tells the query-builder to eager-load the nodes that are connected to
+// the "recurrence_occurrences" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceQuery) WithRecurrenceOccurrences(opts ...func(*RecurrenceOccurrenceQuery)) *WorkspaceQuery {
+	query := (&RecurrenceOccurrenceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRecurrenceOccurrences = query
+	return _q
+}
+
+// WithStatementImports tells the query-builder to eager-load the nodes that are connected to
+// the "statement_imports" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceQuery) WithStatementImports(opts ...func(*StatementImportQuery)) *WorkspaceQuery {
+	query := (&StatementImportClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStatementImports = query
+	return _q
+}
+
+// WithImportProfiles tells the query-builder to eager-load the nodes that are connected to
+// the "import_profiles" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceQuery) WithImportProfiles(opts ...func(*ImportProfileQuery)) *WorkspaceQuery {
+	query := (&ImportProfileClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withImportProfiles = query
+	return _q
+}
+
+// WithImportDecisions tells the query-builder to eager-load the nodes that are connected to
+// the "import_decisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceQuery) WithImportDecisions(opts ...func(*ImportDecisionQuery)) *WorkspaceQuery {
+	query := (&ImportDecisionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withImportDecisions = query
+	return _q
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceQuery) WithTags(opts ...func(*TagQuery)) *WorkspaceQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTags = query
+	return _q
+}
+
+// WithJournalEntries tells the query-builder to eager-load the nodes that are connected to
+// the "journal_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceQuery) WithJournalEntries(opts ...func(*JournalEntryQuery)) *WorkspaceQuery {
+	query := (&JournalEntryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withJournalEntries = query
+	return _q
+}
+
+// WithPostings tells the query-builder to eager-load the nodes that are connected to
+// the "postings" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceQuery) WithPostings(opts ...func(*PostingQuery)) *WorkspaceQuery {
+	query := (&PostingClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPostings = query
+	return _q
+}
+
+// WithMemberships tells the query-builder to eager-load the nodes that are connected to
+// the "memberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceQuery) WithMemberships(opts ...func(*MembershipQuery)) *WorkspaceQuery {
+	query := (&MembershipClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMemberships = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -357,46 +935,181 @@ func (_q *WorkspaceQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
-	if _q.path != nil {
-		prev, err := _q.path(ctx)
-		if err != nil {
-			return err
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *WorkspaceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Workspace, error) {
+	var (
+		nodes       = []*Workspace{}
+		_spec       = _q.querySpec()
+		loadedTypes = [17]bool{
+			_q.withUsers != nil,
+			_q.withInvitations != nil,
+			_q.withAccounts != nil,
+			_q.withTransactions != nil,
+			_q.withTransactionSplits != nil,
+			_q.withTransfers != nil,
+			_q.withCategories != nil,
+			_q.withPayees != nil,
+			_q.withRecurrenceRules != nil,
+			_q.withRecurrenceOccurrences != nil,
+			_q.withStatementImports != nil,
+			_q.withImportProfiles != nil,
+			_q.withImportDecisions != nil,
+			_q.withTags != nil,
+			_q.withJournalEntries != nil,
+			_q.withPostings != nil,
+			_q.withMemberships != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Workspace).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Workspace{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withUsers; query != nil {
+		if err := _q.loadUsers(ctx, query, nodes,
+			func(n *Workspace) { n.Edges.Users = []*User{} },
+			func(n *Workspace, e *User) { n.Edges.Users = append(n.Edges.Users, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withInvitations; query != nil {
+		if err := _q.loadInvitations(ctx, query, nodes,
+			func(n *Workspace) { n.Edges.Invitations = []*WorkspaceInvitation{} },
+			func(n *Workspace, e *WorkspaceInvitation) { n.Edges.Invitations = append(n.Edges.Invitations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAccounts; query != nil {
+		if err := _q.loadAccounts(ctx, query, nodes,
+			func(n *Workspace) { n.Edges.Accounts = []*Account{} },
+			func(n *Workspace, e *Account) { n.Edges.Accounts = append(n.Edges.Accounts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTransactions; query != nil {
+		if err := _q.loadTransactions(ctx, query, nodes,
+			func(n *Workspace) { n.Edges.Transactions = []*Transaction{} },
+			func(n *Workspace, e *Transaction) { n.Edges.Transactions = append(n.Edges.Transactions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTransactionSplits; query != nil {
+		if err := _q.loadTransactionSplits(ctx, query, nodes,
+			func(n *Workspace) { n.Edges.TransactionSplits = []*TransactionSplit{} },
+			func(n *Workspace, e *TransactionSplit) {
+				n.Edges.TransactionSplits = append(n.Edges.TransactionSplits, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTransfers; query != nil {
+		if err := _q.loadTransfers(ctx, query, nodes,
+			func(n *Workspace) { n.Edges.Transfers = []*Transfer{} },
+			func(n *Workspace, e *Transfer) { n.Edges.Transfers = append(n.Edges.Transfers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCategories; query != nil {
+		if err := _q.loadCategories(ctx, query, nodes,
+			func(n *Workspace) { n.Edges.Categories = []*Category{} },
+			func(n *Workspace, e *Category) { n.Edges.Categories = append(n.Edges.Categories, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPayees; query != nil {
+		if err := _q.loadPayees(ctx, query, nodes,
+			func(n *Workspace) { n.Edges.Payees = []*Payee{} },
+			func(n *Workspace, e *Payee) { n.Edges.Payees = append(n.Edges.Payees, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRecurrenceRules; query != nil {
+		if err := _q.loadRecurrenceRules(ctx, query, nodes,
+			func(n *Workspace) { n.Edges.RecurrenceRules = []*RecurrenceRule{} },
+			func(n *Workspace, e *RecurrenceRule) { n.Edges.RecurrenceRules = append(n.Edges.RecurrenceRules, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRecurrenceOccurrences; query != nil {
+		if err := _q.loadRecurrenceOccurrences(ctx, query, nodes,
+			func(n *Workspace) { n.Edges.RecurrenceOccurrences = []*RecurrenceOccurrence{} },
+			func(n *Workspace, e *RecurrenceOccurrence) {
+				n.Edges.RecurrenceOccurrences = append(n.Edges.RecurrenceOccurrences, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStatementImports; query != nil {
+		if err := _q.loadStatementImports(ctx, query, nodes,
+			func(n *Workspace) { n.Edges.StatementImports = []*StatementImport{} },
+			func(n *Workspace, e *StatementImport) { n.Edges.StatementImports = append(n.Edges.StatementImports, e) }); err != nil {
+			return nil, err
 		}
-		_q.sql = prev
 	}
-	return nil
-}
-
-func (_q *WorkspaceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Workspace, error) {
-	var (
-		nodes       = []*Workspace{}
-		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
-			_q.withUsers != nil,
+	if query := _q.withImportProfiles; query != nil {
+		if err := _q.loadImportProfiles(ctx, query, nodes,
+			func(n *Workspace) { n.Edges.ImportProfiles = []*ImportProfile{} },
+			func(n *Workspace, e *ImportProfile) { n.Edges.ImportProfiles = append(n.Edges.ImportProfiles, e) }); err != nil {
+			return nil, err
 		}
-	)
-	_spec.ScanValues = func(columns []string) ([]any, error) {
-		return (*Workspace).scanValues(nil, columns)
 	}
-	_spec.Assign = func(columns []string, values []any) error {
-		node := &Workspace{config: _q.config}
-		nodes = append(nodes, node)
-		node.Edges.loadedTypes = loadedTypes
-		return node.assignValues(columns, values)
+	if query := _q.withImportDecisions; query != nil {
+		if err := _q.loadImportDecisions(ctx, query, nodes,
+			func(n *Workspace) { n.Edges.ImportDecisions = []*ImportDecision{} },
+			func(n *Workspace, e *ImportDecision) { n.Edges.ImportDecisions = append(n.Edges.ImportDecisions, e) }); err != nil {
+			return nil, err
+		}
 	}
-	for i := range hooks {
-		hooks[i](ctx, _spec)
+	if query := _q.withTags; query != nil {
+		if err := _q.loadTags(ctx, query, nodes,
+			func(n *Workspace) { n.Edges.Tags = []*Tag{} },
+			func(n *Workspace, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
 	}
-	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
-		return nil, err
+	if query := _q.withJournalEntries; query != nil {
+		if err := _q.loadJournalEntries(ctx, query, nodes,
+			func(n *Workspace) { n.Edges.JournalEntries = []*JournalEntry{} },
+			func(n *Workspace, e *JournalEntry) { n.Edges.JournalEntries = append(n.Edges.JournalEntries, e) }); err != nil {
+			return nil, err
+		}
 	}
-	if len(nodes) == 0 {
-		return nodes, nil
+	if query := _q.withPostings; query != nil {
+		if err := _q.loadPostings(ctx, query, nodes,
+			func(n *Workspace) { n.Edges.Postings = []*Posting{} },
+			func(n *Workspace, e *Posting) { n.Edges.Postings = append(n.Edges.Postings, e) }); err != nil {
+			return nil, err
+		}
 	}
-	if query := _q.withUsers; query != nil {
-		if err := _q.loadUsers(ctx, query, nodes,
-			func(n *Workspace) { n.Edges.Users = []*User{} },
-			func(n *Workspace, e *User) { n.Edges.Users = append(n.Edges.Users, e) }); err != nil {
+	if query := _q.withMemberships; query != nil {
+		if err := _q.loadMemberships(ctx, query, nodes,
+			func(n *Workspace) { n.Edges.Memberships = []*Membership{} },
+			func(n *Workspace, e *Membership) { n.Edges.Memberships = append(n.Edges.Memberships, e) }); err != nil {
 			return nil, err
 		}
 	}
@@ -464,9 +1177,492 @@ func (_q *WorkspaceQuery) loadUsers(ctx context.Context, query *UserQuery, nodes
 	}
 	return nil
 }
+func (_q *WorkspaceQuery) loadInvitations(ctx context.Context, query *WorkspaceInvitationQuery, nodes []*Workspace, init func(*Workspace), assign func(*Workspace, *WorkspaceInvitation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Workspace)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(workspaceinvitation.FieldWorkspaceID)
+	}
+	query.Where(predicate.WorkspaceInvitation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workspace.InvitationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkspaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workspace_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WorkspaceQuery) loadAccounts(ctx context.Context, query *AccountQuery, nodes []*Workspace, init func(*Workspace), assign func(*Workspace, *Account)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Workspace)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(account.FieldWorkspaceID)
+	}
+	query.Where(predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workspace.AccountsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkspaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workspace_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WorkspaceQuery) loadTransactions(ctx context.Context, query *TransactionQuery, nodes []*Workspace, init func(*Workspace), assign func(*Workspace, *Transaction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Workspace)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(transaction.FieldWorkspaceID)
+	}
+	query.Where(predicate.Transaction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workspace.TransactionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkspaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workspace_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WorkspaceQuery) loadTransactionSplits(ctx context.Context, query *TransactionSplitQuery, nodes []*Workspace, init func(*Workspace), assign func(*Workspace, *TransactionSplit)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Workspace)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(transactionsplit.FieldWorkspaceID)
+	}
+	query.Where(predicate.TransactionSplit(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workspace.TransactionSplitsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkspaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workspace_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WorkspaceQuery) loadTransfers(ctx context.Context, query *TransferQuery, nodes []*Workspace, init func(*Workspace), assign func(*Workspace, *Transfer)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Workspace)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(transfer.FieldWorkspaceID)
+	}
+	query.Where(predicate.Transfer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workspace.TransfersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkspaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workspace_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WorkspaceQuery) loadCategories(ctx context.Context, query *CategoryQuery, nodes []*Workspace, init func(*Workspace), assign func(*Workspace, *Category)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Workspace)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(category.FieldWorkspaceID)
+	}
+	query.Where(predicate.Category(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workspace.CategoriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkspaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workspace_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WorkspaceQuery) loadPayees(ctx context.Context, query *PayeeQuery, nodes []*Workspace, init func(*Workspace), assign func(*Workspace, *Payee)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Workspace)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(payee.FieldWorkspaceID)
+	}
+	query.Where(predicate.Payee(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workspace.PayeesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkspaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workspace_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WorkspaceQuery) loadRecurrenceRules(ctx context.Context, query *RecurrenceRuleQuery, nodes []*Workspace, init func(*Workspace), assign func(*Workspace, *RecurrenceRule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Workspace)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(recurrencerule.FieldWorkspaceID)
+	}
+	query.Where(predicate.RecurrenceRule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workspace.RecurrenceRulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkspaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workspace_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WorkspaceQuery) loadRecurrenceOccurrences(ctx context.Context, query *RecurrenceOccurrenceQuery, nodes []*Workspace, init func(*Workspace), assign func(*Workspace, *RecurrenceOccurrence)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Workspace)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(recurrenceoccurrence.FieldWorkspaceID)
+	}
+	query.Where(predicate.RecurrenceOccurrence(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workspace.RecurrenceOccurrencesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkspaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workspace_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WorkspaceQuery) loadStatementImports(ctx context.Context, query *StatementImportQuery, nodes []*Workspace, init func(*Workspace), assign func(*Workspace, *StatementImport)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Workspace)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(statementimport.FieldWorkspaceID)
+	}
+	query.Where(predicate.StatementImport(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workspace.StatementImportsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkspaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workspace_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WorkspaceQuery) loadImportProfiles(ctx context.Context, query *ImportProfileQuery, nodes []*Workspace, init func(*Workspace), assign func(*Workspace, *ImportProfile)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Workspace)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(importprofile.FieldWorkspaceID)
+	}
+	query.Where(predicate.ImportProfile(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workspace.ImportProfilesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkspaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workspace_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WorkspaceQuery) loadImportDecisions(ctx context.Context, query *ImportDecisionQuery, nodes []*Workspace, init func(*Workspace), assign func(*Workspace, *ImportDecision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Workspace)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(importdecision.FieldWorkspaceID)
+	}
+	query.Where(predicate.ImportDecision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workspace.ImportDecisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkspaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workspace_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WorkspaceQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Workspace, init func(*Workspace), assign func(*Workspace, *Tag)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Workspace)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tag.FieldWorkspaceID)
+	}
+	query.Where(predicate.Tag(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workspace.TagsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkspaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workspace_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WorkspaceQuery) loadJournalEntries(ctx context.Context, query *JournalEntryQuery, nodes []*Workspace, init func(*Workspace), assign func(*Workspace, *JournalEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Workspace)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(journalentry.FieldWorkspaceID)
+	}
+	query.Where(predicate.JournalEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workspace.JournalEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkspaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workspace_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WorkspaceQuery) loadPostings(ctx context.Context, query *PostingQuery, nodes []*Workspace, init func(*Workspace), assign func(*Workspace, *Posting)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Workspace)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(posting.FieldWorkspaceID)
+	}
+	query.Where(predicate.Posting(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workspace.PostingsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkspaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workspace_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WorkspaceQuery) loadMemberships(ctx context.Context, query *MembershipQuery, nodes []*Workspace, init func(*Workspace), assign func(*Workspace, *Membership)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Workspace)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(membership.FieldWorkspaceID)
+	}
+	query.Where(predicate.Membership(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workspace.MembershipsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkspaceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workspace_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *WorkspaceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -529,6 +1725,9 @@ func (_q *WorkspaceQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -546,6 +1745,12 @@ func (_q *WorkspaceQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *WorkspaceQuery) Modify(modifiers ...func(s *sql.Selector)) *WorkspaceSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // WorkspaceGroupBy is the group-by builder for Workspace entities.
 type WorkspaceGroupBy struct {
 	selector
@@ -635,3 +1840,9 @@ func (_s *WorkspaceSelect) sqlScan(ctx context.Context, root *WorkspaceQuery, v
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *WorkspaceSelect) Modify(modifiers ...func(s *sql.Selector)) *WorkspaceSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/user"
+	"backend/internal/infrastructure/ent/workspace"
+	"backend/internal/infrastructure/ent/workspaceinvitation"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WorkspaceInvitation is the model entity for the WorkspaceInvitation schema.
+type WorkspaceInvitation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// WorkspaceID holds the value of the "workspace_id" field.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Role holds the value of the "role" field.
+	Role workspaceinvitation.Role `json:"role,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"-"`
+	// Status holds the value of the "status" field.
+	Status workspaceinvitation.Status `json:"status,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// InvitedByID holds the value of the "invited_by_id" field.
+	InvitedByID *int `json:"invited_by_id,omitempty"`
+	// AcceptedByID holds the value of the "accepted_by_id" field.
+	AcceptedByID *int `json:"accepted_by_id,omitempty"`
+	// RespondedAt holds the value of the "responded_at" field.
+	RespondedAt *time.Time `json:"responded_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WorkspaceInvitationQuery when eager-loading is set.
+	Edges        WorkspaceInvitationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WorkspaceInvitationEdges holds the relations/edges for other nodes in the graph.
+type WorkspaceInvitationEdges struct {
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// InvitedBy holds the value of the invited_by edge.
+	InvitedBy *User `json:"invited_by,omitempty"`
+	// AcceptedBy holds the value of the accepted_by edge.
+	AcceptedBy *User `json:"accepted_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorkspaceInvitationEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// InvitedByOrErr returns the InvitedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorkspaceInvitationEdges) InvitedByOrErr() (*User, error) {
+	if e.InvitedBy != nil {
+		return e.InvitedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "invited_by"}
+}
+
+// AcceptedByOrErr returns the AcceptedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorkspaceInvitationEdges) AcceptedByOrErr() (*User, error) {
+	if e.AcceptedBy != nil {
+		return e.AcceptedBy, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "accepted_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WorkspaceInvitation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case workspaceinvitation.FieldID, workspaceinvitation.FieldWorkspaceID, workspaceinvitation.FieldInvitedByID, workspaceinvitation.FieldAcceptedByID:
+			values[i] = new(sql.NullInt64)
+		case workspaceinvitation.FieldEmail, workspaceinvitation.FieldRole, workspaceinvitation.FieldTokenHash, workspaceinvitation.FieldStatus:
+			values[i] = new(sql.NullString)
+		case workspaceinvitation.FieldExpiresAt, workspaceinvitation.FieldRespondedAt, workspaceinvitation.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WorkspaceInvitation fields.
+func (_m *WorkspaceInvitation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case workspaceinvitation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case workspaceinvitation.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				_m.WorkspaceID = int(value.Int64)
+			}
+		case workspaceinvitation.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = value.String
+			}
+		case workspaceinvitation.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = workspaceinvitation.Role(value.String)
+			}
+		case workspaceinvitation.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				_m.TokenHash = value.String
+			}
+		case workspaceinvitation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = workspaceinvitation.Status(value.String)
+			}
+		case workspaceinvitation.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case workspaceinvitation.FieldInvitedByID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invited_by_id", values[i])
+			} else if value.Valid {
+				_m.InvitedByID = new(int)
+				*_m.InvitedByID = int(value.Int64)
+			}
+		case workspaceinvitation.FieldAcceptedByID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_by_id", values[i])
+			} else if value.Valid {
+				_m.AcceptedByID = new(int)
+				*_m.AcceptedByID = int(value.Int64)
+			}
+		case workspaceinvitation.FieldRespondedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field responded_at", values[i])
+			} else if value.Valid {
+				_m.RespondedAt = new(time.Time)
+				*_m.RespondedAt = value.Time
+			}
+		case workspaceinvitation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WorkspaceInvitation.
+// This includes values selected through modifiers, order, etc.
+func (_m *WorkspaceInvitation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWorkspace queries the "workspace" edge of the WorkspaceInvitation entity.
+func (_m *WorkspaceInvitation) QueryWorkspace() *WorkspaceQuery {
+	return NewWorkspaceInvitationClient(_m.config).QueryWorkspace(_m)
+}
+
+// QueryInvitedBy queries the "invited_by" edge of the WorkspaceInvitation entity.
+func (_m *WorkspaceInvitation) QueryInvitedBy() *UserQuery {
+	return NewWorkspaceInvitationClient(_m.config).QueryInvitedBy(_m)
+}
+
+// QueryAcceptedBy queries the "accepted_by" edge of the WorkspaceInvitation entity.
+func (_m *WorkspaceInvitation) QueryAcceptedBy() *UserQuery {
+	return NewWorkspaceInvitationClient(_m.config).QueryAcceptedBy(_m)
+}
+
+// Update returns a builder for updating this WorkspaceInvitation.
+// Note that you need to call WorkspaceInvitation.Unwrap() before calling this method if this WorkspaceInvitation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WorkspaceInvitation) Update() *WorkspaceInvitationUpdateOne {
+	return NewWorkspaceInvitationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WorkspaceInvitation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WorkspaceInvitation) Unwrap() *WorkspaceInvitation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WorkspaceInvitation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WorkspaceInvitation) String() string {
+	var builder strings.Builder
+	builder.WriteString("WorkspaceInvitation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("workspace_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkspaceID))
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(_m.Email)
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.InvitedByID; v != nil {
+		builder.WriteString("invited_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AcceptedByID; v != nil {
+		builder.WriteString("accepted_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RespondedAt; v != nil {
+		builder.WriteString("responded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WorkspaceInvitations is a parsable slice of WorkspaceInvitation.
+type WorkspaceInvitations []*WorkspaceInvitation
@@ -0,0 +1,560 @@
+// Code generated by ent, DO NOT EDIT.
+
+package workspaceinvitation
+
+import (
+	"backend/internal/infrastructure/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldLTE(FieldID, id))
+}
+
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldEmail, v))
+}
+
+// TokenHash applies equality check predicate on the "token_hash" field. It's identical to TokenHashEQ.
+func TokenHash(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// InvitedByID applies equality check predicate on the "invited_by_id" field. It's identical to InvitedByIDEQ.
+func InvitedByID(v int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldInvitedByID, v))
+}
+
+// AcceptedByID applies equality check predicate on the "accepted_by_id" field. It's identical to AcceptedByIDEQ.
+func AcceptedByID(v int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldAcceptedByID, v))
+}
+
+// RespondedAt applies equality check predicate on the "responded_at" field. It's identical to RespondedAtEQ.
+func RespondedAt(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldRespondedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// TokenHashEQ applies the EQ predicate on the "token_hash" field.
+func TokenHashEQ(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// TokenHashNEQ applies the NEQ predicate on the "token_hash" field.
+func TokenHashNEQ(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNEQ(FieldTokenHash, v))
+}
+
+// TokenHashIn applies the In predicate on the "token_hash" field.
+func TokenHashIn(vs ...string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldIn(FieldTokenHash, vs...))
+}
+
+// TokenHashNotIn applies the NotIn predicate on the "token_hash" field.
+func TokenHashNotIn(vs ...string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNotIn(FieldTokenHash, vs...))
+}
+
+// TokenHashGT applies the GT predicate on the "token_hash" field.
+func TokenHashGT(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldGT(FieldTokenHash, v))
+}
+
+// TokenHashGTE applies the GTE predicate on the "token_hash" field.
+func TokenHashGTE(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldGTE(FieldTokenHash, v))
+}
+
+// TokenHashLT applies the LT predicate on the "token_hash" field.
+func TokenHashLT(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldLT(FieldTokenHash, v))
+}
+
+// TokenHashLTE applies the LTE predicate on the "token_hash" field.
+func TokenHashLTE(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldLTE(FieldTokenHash, v))
+}
+
+// TokenHashContains applies the Contains predicate on the "token_hash" field.
+func TokenHashContains(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldContains(FieldTokenHash, v))
+}
+
+// TokenHashHasPrefix applies the HasPrefix predicate on the "token_hash" field.
+func TokenHashHasPrefix(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldHasPrefix(FieldTokenHash, v))
+}
+
+// TokenHashHasSuffix applies the HasSuffix predicate on the "token_hash" field.
+func TokenHashHasSuffix(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldHasSuffix(FieldTokenHash, v))
+}
+
+// TokenHashEqualFold applies the EqualFold predicate on the "token_hash" field.
+func TokenHashEqualFold(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEqualFold(FieldTokenHash, v))
+}
+
+// TokenHashContainsFold applies the ContainsFold predicate on the "token_hash" field.
+func TokenHashContainsFold(v string) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldContainsFold(FieldTokenHash, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// InvitedByIDEQ applies the EQ predicate on the "invited_by_id" field.
+func InvitedByIDEQ(v int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldInvitedByID, v))
+}
+
+// InvitedByIDNEQ applies the NEQ predicate on the "invited_by_id" field.
+func InvitedByIDNEQ(v int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNEQ(FieldInvitedByID, v))
+}
+
+// InvitedByIDIn applies the In predicate on the "invited_by_id" field.
+func InvitedByIDIn(vs ...int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldIn(FieldInvitedByID, vs...))
+}
+
+// InvitedByIDNotIn applies the NotIn predicate on the "invited_by_id" field.
+func InvitedByIDNotIn(vs ...int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNotIn(FieldInvitedByID, vs...))
+}
+
+// InvitedByIDIsNil applies the IsNil predicate on the "invited_by_id" field.
+func InvitedByIDIsNil() predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldIsNull(FieldInvitedByID))
+}
+
+// InvitedByIDNotNil applies the NotNil predicate on the "invited_by_id" field.
+func InvitedByIDNotNil() predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNotNull(FieldInvitedByID))
+}
+
+// AcceptedByIDEQ applies the EQ predicate on the "accepted_by_id" field.
+func AcceptedByIDEQ(v int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldAcceptedByID, v))
+}
+
+// AcceptedByIDNEQ applies the NEQ predicate on the "accepted_by_id" field.
+func AcceptedByIDNEQ(v int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNEQ(FieldAcceptedByID, v))
+}
+
+// AcceptedByIDIn applies the In predicate on the "accepted_by_id" field.
+func AcceptedByIDIn(vs ...int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldIn(FieldAcceptedByID, vs...))
+}
+
+// AcceptedByIDNotIn applies the NotIn predicate on the "accepted_by_id" field.
+func AcceptedByIDNotIn(vs ...int) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNotIn(FieldAcceptedByID, vs...))
+}
+
+// AcceptedByIDIsNil applies the IsNil predicate on the "accepted_by_id" field.
+func AcceptedByIDIsNil() predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldIsNull(FieldAcceptedByID))
+}
+
+// AcceptedByIDNotNil applies the NotNil predicate on the "accepted_by_id" field.
+func AcceptedByIDNotNil() predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNotNull(FieldAcceptedByID))
+}
+
+// RespondedAtEQ applies the EQ predicate on the "responded_at" field.
+func RespondedAtEQ(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldRespondedAt, v))
+}
+
+// RespondedAtNEQ applies the NEQ predicate on the "responded_at" field.
+func RespondedAtNEQ(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNEQ(FieldRespondedAt, v))
+}
+
+// RespondedAtIn applies the In predicate on the "responded_at" field.
+func RespondedAtIn(vs ...time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldIn(FieldRespondedAt, vs...))
+}
+
+// RespondedAtNotIn applies the NotIn predicate on the "responded_at" field.
+func RespondedAtNotIn(vs ...time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNotIn(FieldRespondedAt, vs...))
+}
+
+// RespondedAtGT applies the GT predicate on the "responded_at" field.
+func RespondedAtGT(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldGT(FieldRespondedAt, v))
+}
+
+// RespondedAtGTE applies the GTE predicate on the "responded_at" field.
+func RespondedAtGTE(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldGTE(FieldRespondedAt, v))
+}
+
+// RespondedAtLT applies the LT predicate on the "responded_at" field.
+func RespondedAtLT(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldLT(FieldRespondedAt, v))
+}
+
+// RespondedAtLTE applies the LTE predicate on the "responded_at" field.
+func RespondedAtLTE(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldLTE(FieldRespondedAt, v))
+}
+
+// RespondedAtIsNil applies the IsNil predicate on the "responded_at" field.
+func RespondedAtIsNil() predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldIsNull(FieldRespondedAt))
+}
+
+// RespondedAtNotNil applies the NotNil predicate on the "responded_at" field.
+func RespondedAtNotNil() predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNotNull(FieldRespondedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasWorkspace applies the HasEdge predicate on the "workspace" edge.
+func HasWorkspace() predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WorkspaceTable, WorkspaceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkspaceWith applies the HasEdge predicate on the "workspace" edge with a given conditions (other predicates).
+func HasWorkspaceWith(preds ...predicate.Workspace) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(func(s *sql.Selector) {
+		step := newWorkspaceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvitedBy applies the HasEdge predicate on the "invited_by" edge.
+func HasInvitedBy() predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, InvitedByTable, InvitedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvitedByWith applies the HasEdge predicate on the "invited_by" edge with a given conditions (other predicates).
+func HasInvitedByWith(preds ...predicate.User) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(func(s *sql.Selector) {
+		step := newInvitedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAcceptedBy applies the HasEdge predicate on the "accepted_by" edge.
+func HasAcceptedBy() predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AcceptedByTable, AcceptedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAcceptedByWith applies the HasEdge predicate on the "accepted_by" edge with a given conditions (other predicates).
+func HasAcceptedByWith(preds ...predicate.User) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(func(s *sql.Selector) {
+		step := newAcceptedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WorkspaceInvitation) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WorkspaceInvitation) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WorkspaceInvitation) predicate.WorkspaceInvitation {
+	return predicate.WorkspaceInvitation(sql.NotPredicates(p))
+}
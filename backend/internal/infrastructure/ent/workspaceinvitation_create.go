@@ -0,0 +1,431 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/user"
+	"backend/internal/infrastructure/ent/workspace"
+	"backend/internal/infrastructure/ent/workspaceinvitation"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WorkspaceInvitationCreate is the builder for creating a WorkspaceInvitation entity.
+type WorkspaceInvitationCreate struct {
+	config
+	mutation *WorkspaceInvitationMutation
+	hooks    []Hook
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (_c *WorkspaceInvitationCreate) SetWorkspaceID(v int) *WorkspaceInvitationCreate {
+	_c.mutation.SetWorkspaceID(v)
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *WorkspaceInvitationCreate) SetEmail(v string) *WorkspaceInvitationCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *WorkspaceInvitationCreate) SetRole(v workspaceinvitation.Role) *WorkspaceInvitationCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *WorkspaceInvitationCreate) SetTokenHash(v string) *WorkspaceInvitationCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *WorkspaceInvitationCreate) SetStatus(v workspaceinvitation.Status) *WorkspaceInvitationCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *WorkspaceInvitationCreate) SetNillableStatus(v *workspaceinvitation.Status) *WorkspaceInvitationCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *WorkspaceInvitationCreate) SetExpiresAt(v time.Time) *WorkspaceInvitationCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetInvitedByID sets the "invited_by_id" field.
+func (_c *WorkspaceInvitationCreate) SetInvitedByID(v int) *WorkspaceInvitationCreate {
+	_c.mutation.SetInvitedByID(v)
+	return _c
+}
+
+// SetNillableInvitedByID sets the "invited_by_id" field if the given value is not nil.
+func (_c *WorkspaceInvitationCreate) SetNillableInvitedByID(v *int) *WorkspaceInvitationCreate {
+	if v != nil {
+		_c.SetInvitedByID(*v)
+	}
+	return _c
+}
+
+// SetAcceptedByID sets the "accepted_by_id" field.
+func (_c *WorkspaceInvitationCreate) SetAcceptedByID(v int) *WorkspaceInvitationCreate {
+	_c.mutation.SetAcceptedByID(v)
+	return _c
+}
+
+// SetNillableAcceptedByID sets the "accepted_by_id" field if the given value is not nil.
+func (_c *WorkspaceInvitationCreate) SetNillableAcceptedByID(v *int) *WorkspaceInvitationCreate {
+	if v != nil {
+		_c.SetAcceptedByID(*v)
+	}
+	return _c
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_c *WorkspaceInvitationCreate) SetRespondedAt(v time.Time) *WorkspaceInvitationCreate {
+	_c.mutation.SetRespondedAt(v)
+	return _c
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_c *WorkspaceInvitationCreate) SetNillableRespondedAt(v *time.Time) *WorkspaceInvitationCreate {
+	if v != nil {
+		_c.SetRespondedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WorkspaceInvitationCreate) SetCreatedAt(v time.Time) *WorkspaceInvitationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WorkspaceInvitationCreate) SetNillableCreatedAt(v *time.Time) *WorkspaceInvitationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_c *WorkspaceInvitationCreate) SetWorkspace(v *Workspace) *WorkspaceInvitationCreate {
+	return _c.SetWorkspaceID(v.ID)
+}
+
+// SetInvitedBy sets the "invited_by" edge to the User entity.
+func (_c *WorkspaceInvitationCreate) SetInvitedBy(v *User) *WorkspaceInvitationCreate {
+	return _c.SetInvitedByID(v.ID)
+}
+
+// SetAcceptedBy sets the "accepted_by" edge to the User entity.
+func (_c *WorkspaceInvitationCreate) SetAcceptedBy(v *User) *WorkspaceInvitationCreate {
+	return _c.SetAcceptedByID(v.ID)
+}
+
+// Mutation returns the WorkspaceInvitationMutation object of the builder.
+func (_c *WorkspaceInvitationCreate) Mutation() *WorkspaceInvitationMutation {
+	return _c.mutation
+}
+
+// Save creates the WorkspaceInvitation in the database.
+func (_c *WorkspaceInvitationCreate) Save(ctx context.Context) (*WorkspaceInvitation, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WorkspaceInvitationCreate) SaveX(ctx context.Context) *WorkspaceInvitation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WorkspaceInvitationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WorkspaceInvitationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WorkspaceInvitationCreate) defaults() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := workspaceinvitation.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if workspaceinvitation.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized workspaceinvitation.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := workspaceinvitation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WorkspaceInvitationCreate) check() error {
+	if _, ok := _c.mutation.WorkspaceID(); !ok {
+		return &ValidationError{Name: "workspace_id", err: errors.New(`ent: missing required field "WorkspaceInvitation.workspace_id"`)}
+	}
+	if _, ok := _c.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "WorkspaceInvitation.email"`)}
+	}
+	if v, ok := _c.mutation.Email(); ok {
+		if err := workspaceinvitation.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "WorkspaceInvitation.email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "WorkspaceInvitation.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := workspaceinvitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "WorkspaceInvitation.role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "WorkspaceInvitation.token_hash"`)}
+	}
+	if v, ok := _c.mutation.TokenHash(); ok {
+		if err := workspaceinvitation.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "WorkspaceInvitation.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WorkspaceInvitation.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := workspaceinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WorkspaceInvitation.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "WorkspaceInvitation.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WorkspaceInvitation.created_at"`)}
+	}
+	if len(_c.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "WorkspaceInvitation.workspace"`)}
+	}
+	return nil
+}
+
+func (_c *WorkspaceInvitationCreate) sqlSave(ctx context.Context) (*WorkspaceInvitation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WorkspaceInvitationCreate) createSpec() (*WorkspaceInvitation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WorkspaceInvitation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(workspaceinvitation.Table, sqlgraph.NewFieldSpec(workspaceinvitation.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(workspaceinvitation.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(workspaceinvitation.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(workspaceinvitation.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(workspaceinvitation.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(workspaceinvitation.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.RespondedAt(); ok {
+		_spec.SetField(workspaceinvitation.FieldRespondedAt, field.TypeTime, value)
+		_node.RespondedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(workspaceinvitation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workspaceinvitation.WorkspaceTable,
+			Columns: []string{workspaceinvitation.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkspaceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvitedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   workspaceinvitation.InvitedByTable,
+			Columns: []string{workspaceinvitation.InvitedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InvitedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AcceptedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   workspaceinvitation.AcceptedByTable,
+			Columns: []string{workspaceinvitation.AcceptedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AcceptedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WorkspaceInvitationCreateBulk is the builder for creating many WorkspaceInvitation entities in bulk.
+type WorkspaceInvitationCreateBulk struct {
+	config
+	err      error
+	builders []*WorkspaceInvitationCreate
+}
+
+// Save creates the WorkspaceInvitation entities in the database.
+func (_c *WorkspaceInvitationCreateBulk) Save(ctx context.Context) ([]*WorkspaceInvitation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WorkspaceInvitation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkspaceInvitationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WorkspaceInvitationCreateBulk) SaveX(ctx context.Context) []*WorkspaceInvitation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WorkspaceInvitationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WorkspaceInvitationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
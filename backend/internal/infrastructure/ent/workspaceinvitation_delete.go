@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/workspaceinvitation"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WorkspaceInvitationDelete is the builder for deleting a WorkspaceInvitation entity.
+type WorkspaceInvitationDelete struct {
+	config
+	hooks    []Hook
+	mutation *WorkspaceInvitationMutation
+}
+
+// Where appends a list predicates to the WorkspaceInvitationDelete builder.
+func (_d *WorkspaceInvitationDelete) Where(ps ...predicate.WorkspaceInvitation) *WorkspaceInvitationDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *WorkspaceInvitationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *WorkspaceInvitationDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *WorkspaceInvitationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(workspaceinvitation.Table, sqlgraph.NewFieldSpec(workspaceinvitation.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// WorkspaceInvitationDeleteOne is the builder for deleting a single WorkspaceInvitation entity.
+type WorkspaceInvitationDeleteOne struct {
+	_d *WorkspaceInvitationDelete
+}
+
+// Where appends a list predicates to the WorkspaceInvitationDelete builder.
+func (_d *WorkspaceInvitationDeleteOne) Where(ps ...predicate.WorkspaceInvitation) *WorkspaceInvitationDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *WorkspaceInvitationDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{workspaceinvitation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *WorkspaceInvitationDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
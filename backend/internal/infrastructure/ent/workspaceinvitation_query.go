@@ -0,0 +1,791 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/user"
+	"backend/internal/infrastructure/ent/workspace"
+	"backend/internal/infrastructure/ent/workspaceinvitation"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WorkspaceInvitationQuery is the builder for querying WorkspaceInvitation entities.
+type WorkspaceInvitationQuery struct {
+	config
+	ctx            *QueryContext
+	order          []workspaceinvitation.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.WorkspaceInvitation
+	withWorkspace  *WorkspaceQuery
+	withInvitedBy  *UserQuery
+	withAcceptedBy *UserQuery
+	modifiers      []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WorkspaceInvitationQuery builder.
+func (_q *WorkspaceInvitationQuery) Where(ps ...predicate.WorkspaceInvitation) *WorkspaceInvitationQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *WorkspaceInvitationQuery) Limit(limit int) *WorkspaceInvitationQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *WorkspaceInvitationQuery) Offset(offset int) *WorkspaceInvitationQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *WorkspaceInvitationQuery) Unique(unique bool) *WorkspaceInvitationQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *WorkspaceInvitationQuery) Order(o ...workspaceinvitation.OrderOption) *WorkspaceInvitationQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryWorkspace chains the current query on the "workspace" edge.
+func (_q *WorkspaceInvitationQuery) QueryWorkspace() *WorkspaceQuery {
+	query := (&WorkspaceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspaceinvitation.Table, workspaceinvitation.FieldID, selector),
+			sqlgraph.To(workspace.Table, workspace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, workspaceinvitation.WorkspaceTable, workspaceinvitation.WorkspaceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvitedBy chains the current query on the "invited_by" edge.
+func (_q *WorkspaceInvitationQuery) QueryInvitedBy() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspaceinvitation.Table, workspaceinvitation.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, workspaceinvitation.InvitedByTable, workspaceinvitation.InvitedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAcceptedBy chains the current query on the "accepted_by" edge.
+func (_q *WorkspaceInvitationQuery) QueryAcceptedBy() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workspaceinvitation.Table, workspaceinvitation.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, workspaceinvitation.AcceptedByTable, workspaceinvitation.AcceptedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WorkspaceInvitation entity from the query.
+// Returns a *NotFoundError when no WorkspaceInvitation was found.
+func (_q *WorkspaceInvitationQuery) First(ctx context.Context) (*WorkspaceInvitation, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{workspaceinvitation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *WorkspaceInvitationQuery) FirstX(ctx context.Context) *WorkspaceInvitation {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WorkspaceInvitation ID from the query.
+// Returns a *NotFoundError when no WorkspaceInvitation ID was found.
+func (_q *WorkspaceInvitationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{workspaceinvitation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *WorkspaceInvitationQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WorkspaceInvitation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WorkspaceInvitation entity is found.
+// Returns a *NotFoundError when no WorkspaceInvitation entities are found.
+func (_q *WorkspaceInvitationQuery) Only(ctx context.Context) (*WorkspaceInvitation, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{workspaceinvitation.Label}
+	default:
+		return nil, &NotSingularError{workspaceinvitation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *WorkspaceInvitationQuery) OnlyX(ctx context.Context) *WorkspaceInvitation {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WorkspaceInvitation ID in the query.
+// Returns a *NotSingularError when more than one WorkspaceInvitation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *WorkspaceInvitationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{workspaceinvitation.Label}
+	default:
+		err = &NotSingularError{workspaceinvitation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *WorkspaceInvitationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WorkspaceInvitations.
+func (_q *WorkspaceInvitationQuery) All(ctx context.Context) ([]*WorkspaceInvitation, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WorkspaceInvitation, *WorkspaceInvitationQuery]()
+	return withInterceptors[[]*WorkspaceInvitation](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *WorkspaceInvitationQuery) AllX(ctx context.Context) []*WorkspaceInvitation {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WorkspaceInvitation IDs.
+func (_q *WorkspaceInvitationQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(workspaceinvitation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *WorkspaceInvitationQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *WorkspaceInvitationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*WorkspaceInvitationQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *WorkspaceInvitationQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *WorkspaceInvitationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *WorkspaceInvitationQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WorkspaceInvitationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *WorkspaceInvitationQuery) Clone() *WorkspaceInvitationQuery {
+	if _q == nil {
+		return nil
+	}
+	return &WorkspaceInvitationQuery{
+		config:         _q.config,
+		ctx:            _q.ctx.Clone(),
+		order:          append([]workspaceinvitation.OrderOption{}, _q.order...),
+		inters:         append([]Interceptor{}, _q.inters...),
+		predicates:     append([]predicate.WorkspaceInvitation{}, _q.predicates...),
+		withWorkspace:  _q.withWorkspace.Clone(),
+		withInvitedBy:  _q.withInvitedBy.Clone(),
+		withAcceptedBy: _q.withAcceptedBy.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithWorkspace tells the query-builder to eager-load the nodes that are connected to
+// the "workspace" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceInvitationQuery) WithWorkspace(opts ...func(*WorkspaceQuery)) *WorkspaceInvitationQuery {
+	query := (&WorkspaceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWorkspace = query
+	return _q
+}
+
+// WithInvitedBy tells the query-builder to eager-load the nodes that are connected to
+// the "invited_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceInvitationQuery) WithInvitedBy(opts ...func(*UserQuery)) *WorkspaceInvitationQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withInvitedBy = query
+	return _q
+}
+
+// WithAcceptedBy tells the query-builder to eager-load the nodes that are connected to
+// the "accepted_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkspaceInvitationQuery) WithAcceptedBy(opts ...func(*UserQuery)) *WorkspaceInvitationQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAcceptedBy = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		WorkspaceID int `json:"workspace_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WorkspaceInvitation.Query().
+//		GroupBy(workspaceinvitation.FieldWorkspaceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *WorkspaceInvitationQuery) GroupBy(field string, fields ...string) *WorkspaceInvitationGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WorkspaceInvitationGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = workspaceinvitation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		WorkspaceID int `json:"workspace_id,omitempty"`
+//	}
+//
+//	client.WorkspaceInvitation.Query().
+//		Select(workspaceinvitation.FieldWorkspaceID).
+//		Scan(ctx, &v)
+func (_q *WorkspaceInvitationQuery) Select(fields ...string) *WorkspaceInvitationSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &WorkspaceInvitationSelect{WorkspaceInvitationQuery: _q}
+	sbuild.label = workspaceinvitation.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WorkspaceInvitationSelect configured with the given aggregations.
+func (_q *WorkspaceInvitationQuery) Aggregate(fns ...AggregateFunc) *WorkspaceInvitationSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *WorkspaceInvitationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !workspaceinvitation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	if workspaceinvitation.Policy == nil {
+		return errors.New("ent: uninitialized workspaceinvitation.Policy (forgotten import ent/runtime?)")
+	}
+	if err := workspaceinvitation.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (_q *WorkspaceInvitationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WorkspaceInvitation, error) {
+	var (
+		nodes       = []*WorkspaceInvitation{}
+		_spec       = _q.querySpec()
+		loadedTypes = [3]bool{
+			_q.withWorkspace != nil,
+			_q.withInvitedBy != nil,
+			_q.withAcceptedBy != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WorkspaceInvitation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WorkspaceInvitation{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withWorkspace; query != nil {
+		if err := _q.loadWorkspace(ctx, query, nodes, nil,
+			func(n *WorkspaceInvitation, e *Workspace) { n.Edges.Workspace = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withInvitedBy; query != nil {
+		if err := _q.loadInvitedBy(ctx, query, nodes, nil,
+			func(n *WorkspaceInvitation, e *User) { n.Edges.InvitedBy = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAcceptedBy; query != nil {
+		if err := _q.loadAcceptedBy(ctx, query, nodes, nil,
+			func(n *WorkspaceInvitation, e *User) { n.Edges.AcceptedBy = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *WorkspaceInvitationQuery) loadWorkspace(ctx context.Context, query *WorkspaceQuery, nodes []*WorkspaceInvitation, init func(*WorkspaceInvitation), assign func(*WorkspaceInvitation, *Workspace)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*WorkspaceInvitation)
+	for i := range nodes {
+		fk := nodes[i].WorkspaceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(workspace.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "workspace_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *WorkspaceInvitationQuery) loadInvitedBy(ctx context.Context, query *UserQuery, nodes []*WorkspaceInvitation, init func(*WorkspaceInvitation), assign func(*WorkspaceInvitation, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*WorkspaceInvitation)
+	for i := range nodes {
+		if nodes[i].InvitedByID == nil {
+			continue
+		}
+		fk := *nodes[i].InvitedByID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "invited_by_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *WorkspaceInvitationQuery) loadAcceptedBy(ctx context.Context, query *UserQuery, nodes []*WorkspaceInvitation, init func(*WorkspaceInvitation), assign func(*WorkspaceInvitation, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*WorkspaceInvitation)
+	for i := range nodes {
+		if nodes[i].AcceptedByID == nil {
+			continue
+		}
+		fk := *nodes[i].AcceptedByID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "accepted_by_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *WorkspaceInvitationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *WorkspaceInvitationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(workspaceinvitation.Table, workspaceinvitation.Columns, sqlgraph.NewFieldSpec(workspaceinvitation.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workspaceinvitation.FieldID)
+		for i := range fields {
+			if fields[i] != workspaceinvitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withWorkspace != nil {
+			_spec.Node.AddColumnOnce(workspaceinvitation.FieldWorkspaceID)
+		}
+		if _q.withInvitedBy != nil {
+			_spec.Node.AddColumnOnce(workspaceinvitation.FieldInvitedByID)
+		}
+		if _q.withAcceptedBy != nil {
+			_spec.Node.AddColumnOnce(workspaceinvitation.FieldAcceptedByID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *WorkspaceInvitationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(workspaceinvitation.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = workspaceinvitation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *WorkspaceInvitationQuery) Modify(modifiers ...func(s *sql.Selector)) *WorkspaceInvitationSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// WorkspaceInvitationGroupBy is the group-by builder for WorkspaceInvitation entities.
+type WorkspaceInvitationGroupBy struct {
+	selector
+	build *WorkspaceInvitationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *WorkspaceInvitationGroupBy) Aggregate(fns ...AggregateFunc) *WorkspaceInvitationGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *WorkspaceInvitationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WorkspaceInvitationQuery, *WorkspaceInvitationGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *WorkspaceInvitationGroupBy) sqlScan(ctx context.Context, root *WorkspaceInvitationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WorkspaceInvitationSelect is the builder for selecting fields of WorkspaceInvitation entities.
+type WorkspaceInvitationSelect struct {
+	*WorkspaceInvitationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *WorkspaceInvitationSelect) Aggregate(fns ...AggregateFunc) *WorkspaceInvitationSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *WorkspaceInvitationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WorkspaceInvitationQuery, *WorkspaceInvitationSelect](ctx, _s.WorkspaceInvitationQuery, _s, _s.inters, v)
+}
+
+func (_s *WorkspaceInvitationSelect) sqlScan(ctx context.Context, root *WorkspaceInvitationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *WorkspaceInvitationSelect) Modify(modifiers ...func(s *sql.Selector)) *WorkspaceInvitationSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
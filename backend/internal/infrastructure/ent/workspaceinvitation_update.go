@@ -0,0 +1,431 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/user"
+	"backend/internal/infrastructure/ent/workspaceinvitation"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WorkspaceInvitationUpdate is the builder for updating WorkspaceInvitation entities.
+type WorkspaceInvitationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WorkspaceInvitationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WorkspaceInvitationUpdate builder.
+func (_u *WorkspaceInvitationUpdate) Where(ps ...predicate.WorkspaceInvitation) *WorkspaceInvitationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WorkspaceInvitationUpdate) SetStatus(v workspaceinvitation.Status) *WorkspaceInvitationUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WorkspaceInvitationUpdate) SetNillableStatus(v *workspaceinvitation.Status) *WorkspaceInvitationUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAcceptedByID sets the "accepted_by_id" field.
+func (_u *WorkspaceInvitationUpdate) SetAcceptedByID(v int) *WorkspaceInvitationUpdate {
+	_u.mutation.SetAcceptedByID(v)
+	return _u
+}
+
+// SetNillableAcceptedByID sets the "accepted_by_id" field if the given value is not nil.
+func (_u *WorkspaceInvitationUpdate) SetNillableAcceptedByID(v *int) *WorkspaceInvitationUpdate {
+	if v != nil {
+		_u.SetAcceptedByID(*v)
+	}
+	return _u
+}
+
+// ClearAcceptedByID clears the value of the "accepted_by_id" field.
+func (_u *WorkspaceInvitationUpdate) ClearAcceptedByID() *WorkspaceInvitationUpdate {
+	_u.mutation.ClearAcceptedByID()
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *WorkspaceInvitationUpdate) SetRespondedAt(v time.Time) *WorkspaceInvitationUpdate {
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *WorkspaceInvitationUpdate) SetNillableRespondedAt(v *time.Time) *WorkspaceInvitationUpdate {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *WorkspaceInvitationUpdate) ClearRespondedAt() *WorkspaceInvitationUpdate {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// SetAcceptedBy sets the "accepted_by" edge to the User entity.
+func (_u *WorkspaceInvitationUpdate) SetAcceptedBy(v *User) *WorkspaceInvitationUpdate {
+	return _u.SetAcceptedByID(v.ID)
+}
+
+// Mutation returns the WorkspaceInvitationMutation object of the builder.
+func (_u *WorkspaceInvitationUpdate) Mutation() *WorkspaceInvitationMutation {
+	return _u.mutation
+}
+
+// ClearAcceptedBy clears the "accepted_by" edge to the User entity.
+func (_u *WorkspaceInvitationUpdate) ClearAcceptedBy() *WorkspaceInvitationUpdate {
+	_u.mutation.ClearAcceptedBy()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WorkspaceInvitationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WorkspaceInvitationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WorkspaceInvitationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WorkspaceInvitationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WorkspaceInvitationUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := workspaceinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WorkspaceInvitation.status": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkspaceInvitation.workspace"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WorkspaceInvitationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkspaceInvitationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *WorkspaceInvitationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workspaceinvitation.Table, workspaceinvitation.Columns, sqlgraph.NewFieldSpec(workspaceinvitation.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(workspaceinvitation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(workspaceinvitation.FieldRespondedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(workspaceinvitation.FieldRespondedAt, field.TypeTime)
+	}
+	if _u.mutation.AcceptedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   workspaceinvitation.AcceptedByTable,
+			Columns: []string{workspaceinvitation.AcceptedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AcceptedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   workspaceinvitation.AcceptedByTable,
+			Columns: []string{workspaceinvitation.AcceptedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workspaceinvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WorkspaceInvitationUpdateOne is the builder for updating a single WorkspaceInvitation entity.
+type WorkspaceInvitationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WorkspaceInvitationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStatus sets the "status" field.
+func (_u *WorkspaceInvitationUpdateOne) SetStatus(v workspaceinvitation.Status) *WorkspaceInvitationUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WorkspaceInvitationUpdateOne) SetNillableStatus(v *workspaceinvitation.Status) *WorkspaceInvitationUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAcceptedByID sets the "accepted_by_id" field.
+func (_u *WorkspaceInvitationUpdateOne) SetAcceptedByID(v int) *WorkspaceInvitationUpdateOne {
+	_u.mutation.SetAcceptedByID(v)
+	return _u
+}
+
+// SetNillableAcceptedByID sets the "accepted_by_id" field if the given value is not nil.
+func (_u *WorkspaceInvitationUpdateOne) SetNillableAcceptedByID(v *int) *WorkspaceInvitationUpdateOne {
+	if v != nil {
+		_u.SetAcceptedByID(*v)
+	}
+	return _u
+}
+
+// ClearAcceptedByID clears the value of the "accepted_by_id" field.
+func (_u *WorkspaceInvitationUpdateOne) ClearAcceptedByID() *WorkspaceInvitationUpdateOne {
+	_u.mutation.ClearAcceptedByID()
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *WorkspaceInvitationUpdateOne) SetRespondedAt(v time.Time) *WorkspaceInvitationUpdateOne {
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *WorkspaceInvitationUpdateOne) SetNillableRespondedAt(v *time.Time) *WorkspaceInvitationUpdateOne {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *WorkspaceInvitationUpdateOne) ClearRespondedAt() *WorkspaceInvitationUpdateOne {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// SetAcceptedBy sets the "accepted_by" edge to the User entity.
+func (_u *WorkspaceInvitationUpdateOne) SetAcceptedBy(v *User) *WorkspaceInvitationUpdateOne {
+	return _u.SetAcceptedByID(v.ID)
+}
+
+// Mutation returns the WorkspaceInvitationMutation object of the builder.
+func (_u *WorkspaceInvitationUpdateOne) Mutation() *WorkspaceInvitationMutation {
+	return _u.mutation
+}
+
+// ClearAcceptedBy clears the "accepted_by" edge to the User entity.
+func (_u *WorkspaceInvitationUpdateOne) ClearAcceptedBy() *WorkspaceInvitationUpdateOne {
+	_u.mutation.ClearAcceptedBy()
+	return _u
+}
+
+// Where appends a list predicates to the WorkspaceInvitationUpdate builder.
+func (_u *WorkspaceInvitationUpdateOne) Where(ps ...predicate.WorkspaceInvitation) *WorkspaceInvitationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WorkspaceInvitationUpdateOne) Select(field string, fields ...string) *WorkspaceInvitationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WorkspaceInvitation entity.
+func (_u *WorkspaceInvitationUpdateOne) Save(ctx context.Context) (*WorkspaceInvitation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WorkspaceInvitationUpdateOne) SaveX(ctx context.Context) *WorkspaceInvitation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WorkspaceInvitationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WorkspaceInvitationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WorkspaceInvitationUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := workspaceinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WorkspaceInvitation.status": %w`, err)}
+		}
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkspaceInvitation.workspace"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WorkspaceInvitationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkspaceInvitationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *WorkspaceInvitationUpdateOne) sqlSave(ctx context.Context) (_node *WorkspaceInvitation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workspaceinvitation.Table, workspaceinvitation.Columns, sqlgraph.NewFieldSpec(workspaceinvitation.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WorkspaceInvitation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workspaceinvitation.FieldID)
+		for _, f := range fields {
+			if !workspaceinvitation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != workspaceinvitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(workspaceinvitation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(workspaceinvitation.FieldRespondedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(workspaceinvitation.FieldRespondedAt, field.TypeTime)
+	}
+	if _u.mutation.AcceptedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   workspaceinvitation.AcceptedByTable,
+			Columns: []string{workspaceinvitation.AcceptedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AcceptedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   workspaceinvitation.AcceptedByTable,
+			Columns: []string{workspaceinvitation.AcceptedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &WorkspaceInvitation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workspaceinvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,346 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"backend/internal/application/usecase"
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AccountHandler struct {
+	listAccountsUseCase  *usecase.ListAccountsUseCase
+	getAccountUseCase    *usecase.GetAccountUseCase
+	createAccountUseCase *usecase.CreateAccountUseCase
+	updateAccountUseCase *usecase.UpdateAccountUseCase
+	deleteAccountUseCase *usecase.DeleteAccountUseCase
+}
+
+func NewAccountHandler(
+	listAccountsUseCase *usecase.ListAccountsUseCase,
+	getAccountUseCase *usecase.GetAccountUseCase,
+	createAccountUseCase *usecase.CreateAccountUseCase,
+	updateAccountUseCase *usecase.UpdateAccountUseCase,
+	deleteAccountUseCase *usecase.DeleteAccountUseCase,
+) *AccountHandler {
+	return &AccountHandler{
+		listAccountsUseCase:  listAccountsUseCase,
+		getAccountUseCase:    getAccountUseCase,
+		createAccountUseCase: createAccountUseCase,
+		updateAccountUseCase: updateAccountUseCase,
+		deleteAccountUseCase: deleteAccountUseCase,
+	}
+}
+
+// AccountRequest is the body of account create and update requests; currency is ignored on update
+type AccountRequest struct {
+	Name           string        `json:"name" binding:"required"`
+	Type           string        `json:"type" binding:"required"`
+	Institution    string        `json:"institution"`
+	Currency       string        `json:"currency"`
+	OpeningBalance model.Decimal `json:"openingBalance"` // Decimal string in the currency, e.g. "-12.50"
+	OpenedOn       *string       `json:"openedOn"`       // YYYY-MM-DD
+	ClosedOn       *string       `json:"closedOn"`       // YYYY-MM-DD
+	Archived       bool          `json:"archived"`
+}
+
+type AccountResponse struct {
+	ID             int     `json:"id"`
+	WorkspaceID    int     `json:"workspaceId"`
+	Name           string  `json:"name"`
+	Type           string  `json:"type"`
+	Institution    string  `json:"institution"`
+	Currency       string  `json:"currency"`
+	OpeningBalance string  `json:"openingBalance"`
+	Balance        string  `json:"balance"` // Opening balance plus all postings
+	OpenedOn       *string `json:"openedOn"`
+	ClosedOn       *string `json:"closedOn"`
+	Archived       bool    `json:"archived"`
+	CreatedAt      string  `json:"createdAt"`
+	UpdatedAt      string  `json:"updatedAt"`
+}
+
+type ListAccountsResponse struct {
+	Accounts []AccountResponse `json:"accounts"`
+}
+
+func newAccountResponse(account *model.Account) AccountResponse {
+	return AccountResponse{
+		ID:             account.ID,
+		WorkspaceID:    account.WorkspaceID,
+		Name:           account.Name,
+		Type:           string(account.Type),
+		Institution:    account.Institution,
+		Currency:       account.Currency,
+		OpeningBalance: account.OpeningBalance.Amount(),
+		Balance:        account.Balance.Amount(),
+		OpenedOn:       formatDate(account.OpenedOn),
+		ClosedOn:       formatDate(account.ClosedOn),
+		Archived:       account.Archived,
+		CreatedAt:      account.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:      account.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// ListAccounts returns the accounts of a workspace; archived accounts are included with ?archived=true
+func (h *AccountHandler) ListAccounts(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	includeArchived := c.Query("archived") == "true"
+
+	accounts, err := h.listAccountsUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, includeArchived)
+	if err != nil {
+		respondAccountError(c, err, "Failed to list accounts")
+		return
+	}
+
+	response := ListAccountsResponse{Accounts: make([]AccountResponse, 0, len(accounts))}
+	for _, account := range accounts {
+		response.Accounts = append(response.Accounts, newAccountResponse(account))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetAccount returns a single account
+func (h *AccountHandler) GetAccount(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	accountID, ok := accountIDParam(c)
+	if !ok {
+		return
+	}
+
+	account, err := h.getAccountUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, accountID)
+	if err != nil {
+		respondAccountError(c, err, "Failed to get account")
+		return
+	}
+
+	c.JSON(http.StatusOK, newAccountResponse(account))
+}
+
+// CreateAccount creates an account in a workspace
+func (h *AccountHandler) CreateAccount(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindAccountRequest(c)
+	if !ok {
+		return
+	}
+
+	account, err := h.createAccountUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, input)
+	if err != nil {
+		respondAccountError(c, err, "Failed to create account")
+		return
+	}
+
+	c.JSON(http.StatusCreated, newAccountResponse(account))
+}
+
+// UpdateAccount replaces the editable fields of an account
+func (h *AccountHandler) UpdateAccount(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	accountID, ok := accountIDParam(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindAccountRequest(c)
+	if !ok {
+		return
+	}
+
+	account, err := h.updateAccountUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, accountID, input)
+	if err != nil {
+		respondAccountError(c, err, "Failed to update account")
+		return
+	}
+
+	c.JSON(http.StatusOK, newAccountResponse(account))
+}
+
+// DeleteAccount deletes an account
+func (h *AccountHandler) DeleteAccount(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	accountID, ok := accountIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.deleteAccountUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, accountID); err != nil {
+		respondAccountError(c, err, "Failed to delete account")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// bindAccountRequest parses an account request body, responding with 400 when it is malformed
+func bindAccountRequest(c *gin.Context) (usecase.AccountInput, bool) {
+	var req AccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return usecase.AccountInput{}, false
+	}
+
+	openedOn, ok := parseDateField(c, "openedOn", req.OpenedOn)
+	if !ok {
+		return usecase.AccountInput{}, false
+	}
+	closedOn, ok := parseDateField(c, "closedOn", req.ClosedOn)
+	if !ok {
+		return usecase.AccountInput{}, false
+	}
+
+	return usecase.AccountInput{
+		Name:           req.Name,
+		Type:           model.AccountType(req.Type),
+		Institution:    req.Institution,
+		Currency:       req.Currency,
+		OpeningBalance: req.OpeningBalance,
+		OpenedOn:       openedOn,
+		ClosedOn:       closedOn,
+		Archived:       req.Archived,
+	}, true
+}
+
+// accountIDParam parses the :accountId path parameter, responding with 400 when it is malformed
+func accountIDParam(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("accountId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid account ID",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "accountId",
+			},
+		})
+		return 0, false
+	}
+	return id, true
+}
+
+// parseDateField parses an optional YYYY-MM-DD field, responding with 400 when it is malformed
+func parseDateField(c *gin.Context, field string, value *string) (*time.Time, bool) {
+	if value == nil || *value == "" {
+		return nil, true
+	}
+	date, err := time.Parse("2006-01-02", *value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid date",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field":   field,
+				"message": "Dates must be formatted as YYYY-MM-DD",
+			},
+		})
+		return nil, false
+	}
+	return &date, true
+}
+
+// formatDate formats an optional date as YYYY-MM-DD
+func formatDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+	formatted := date.Format("2006-01-02")
+	return &formatted
+}
+
+// accountValidationFields maps account validation errors to the request field they concern
+var accountValidationFields = map[error]string{
+	service.ErrAccountNameRequired: "name",
+	service.ErrInvalidAccountType:  "type",
+	service.ErrInvalidCurrency:     "currency",
+	service.ErrInvalidAccountDates: "closedOn",
+	model.ErrAmountPrecision:       "openingBalance",
+	model.ErrAmountOverflow:        "openingBalance",
+}
+
+// respondAccountError maps account usecase errors to responses
+func respondAccountError(c *gin.Context, err error, message string) {
+	for validationErr, field := range accountValidationFields {
+		if errors.Is(err, validationErr) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: validationErr.Error(),
+				Code:  "VALIDATION_ERROR",
+				Details: map[string]interface{}{
+					"field": field,
+				},
+			})
+			return
+		}
+	}
+
+	if errors.Is(err, usecase.ErrAccountNotFound) {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "Account not found",
+			Code:  "NOT_FOUND",
+		})
+		return
+	}
+	if errors.Is(err, usecase.ErrAccountHasTransactions) {
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error: "Account has transactions",
+			Code:  "ACCOUNT_HAS_TRANSACTIONS",
+			Details: map[string]interface{}{
+				"message": "Archive the account instead of deleting it",
+			},
+		})
+		return
+	}
+
+	respondWorkspaceError(c, err, message)
+}
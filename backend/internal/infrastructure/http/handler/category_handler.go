@@ -0,0 +1,275 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/internal/application/usecase"
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CategoryHandler struct {
+	listCategoriesUseCase  *usecase.ListCategoriesUseCase
+	createCategoryUseCase  *usecase.CreateCategoryUseCase
+	updateCategoryUseCase  *usecase.UpdateCategoryUseCase
+	mergeCategoriesUseCase *usecase.MergeCategoriesUseCase
+}
+
+func NewCategoryHandler(
+	listCategoriesUseCase *usecase.ListCategoriesUseCase,
+	createCategoryUseCase *usecase.CreateCategoryUseCase,
+	updateCategoryUseCase *usecase.UpdateCategoryUseCase,
+	mergeCategoriesUseCase *usecase.MergeCategoriesUseCase,
+) *CategoryHandler {
+	return &CategoryHandler{
+		listCategoriesUseCase:  listCategoriesUseCase,
+		createCategoryUseCase:  createCategoryUseCase,
+		updateCategoryUseCase:  updateCategoryUseCase,
+		mergeCategoriesUseCase: mergeCategoriesUseCase,
+	}
+}
+
+// CategoryRequest is the body of category create and update requests; kind is ignored on update
+type CategoryRequest struct {
+	ParentID *int   `json:"parentId"` // Null for a top-level category
+	Name     string `json:"name" binding:"required"`
+	Kind     string `json:"kind"` // income, expense or transfer
+	Color    string `json:"color"`
+	Icon     string `json:"icon"`
+	Archived bool   `json:"archived"`
+}
+
+type MergeCategoryRequest struct {
+	TargetID int `json:"targetId" binding:"required"`
+}
+
+type CategoryResponse struct {
+	ID        int    `json:"id"`
+	ParentID  *int   `json:"parentId"`
+	Name      string `json:"name"`
+	Kind      string `json:"kind"`
+	Color     string `json:"color"`
+	Icon      string `json:"icon"`
+	Archived  bool   `json:"archived"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type ListCategoriesResponse struct {
+	Categories []CategoryResponse `json:"categories"`
+}
+
+func newCategoryResponse(category *model.Category) CategoryResponse {
+	return CategoryResponse{
+		ID:        category.ID,
+		ParentID:  category.ParentID,
+		Name:      category.Name,
+		Kind:      string(category.Kind),
+		Color:     category.Color,
+		Icon:      category.Icon,
+		Archived:  category.Archived,
+		CreatedAt: category.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt: category.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// ListCategories returns the categories of a workspace as a flat list; clients build the tree from parentId.
+// Archived categories are included with ?archived=true.
+func (h *CategoryHandler) ListCategories(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	includeArchived := c.Query("archived") == "true"
+
+	categories, err := h.listCategoriesUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, includeArchived)
+	if err != nil {
+		respondCategoryError(c, err, "Failed to list categories")
+		return
+	}
+
+	response := ListCategoriesResponse{Categories: make([]CategoryResponse, 0, len(categories))}
+	for _, category := range categories {
+		response.Categories = append(response.Categories, newCategoryResponse(category))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateCategory creates a category in a workspace
+func (h *CategoryHandler) CreateCategory(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindCategoryRequest(c)
+	if !ok {
+		return
+	}
+
+	category, err := h.createCategoryUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, input)
+	if err != nil {
+		respondCategoryError(c, err, "Failed to create category")
+		return
+	}
+
+	c.JSON(http.StatusCreated, newCategoryResponse(category))
+}
+
+// UpdateCategory replaces the editable fields of a category; a new parentId moves its subtree
+func (h *CategoryHandler) UpdateCategory(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	categoryID, ok := categoryIDParam(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindCategoryRequest(c)
+	if !ok {
+		return
+	}
+
+	category, err := h.updateCategoryUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, categoryID, input)
+	if err != nil {
+		respondCategoryError(c, err, "Failed to update category")
+		return
+	}
+
+	c.JSON(http.StatusOK, newCategoryResponse(category))
+}
+
+// MergeCategory merges a category into the target category and returns the target
+func (h *CategoryHandler) MergeCategory(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	categoryID, ok := categoryIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req MergeCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return
+	}
+
+	target, err := h.mergeCategoriesUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, categoryID, req.TargetID)
+	if err != nil {
+		respondCategoryError(c, err, "Failed to merge categories")
+		return
+	}
+
+	c.JSON(http.StatusOK, newCategoryResponse(target))
+}
+
+// bindCategoryRequest parses a category request body, responding with 400 when it is malformed
+func bindCategoryRequest(c *gin.Context) (usecase.CategoryInput, bool) {
+	var req CategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return usecase.CategoryInput{}, false
+	}
+
+	return usecase.CategoryInput{
+		ParentID: req.ParentID,
+		Name:     req.Name,
+		Kind:     model.CategoryKind(req.Kind),
+		Color:    req.Color,
+		Icon:     req.Icon,
+		Archived: req.Archived,
+	}, true
+}
+
+// categoryIDParam parses the :categoryId path parameter, responding with 400 when it is malformed
+func categoryIDParam(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("categoryId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid category ID",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "categoryId",
+			},
+		})
+		return 0, false
+	}
+	return id, true
+}
+
+// categoryValidationFields maps category validation errors to the request field they concern
+var categoryValidationFields = map[error]string{
+	service.ErrCategoryNameRequired:   "name",
+	service.ErrInvalidCategoryKind:    "kind",
+	service.ErrInvalidCategoryColor:   "color",
+	service.ErrCategoryKindMismatch:   "parentId",
+	service.ErrCategoryCycle:          "parentId",
+	usecase.ErrParentCategoryNotFound: "parentId",
+}
+
+// respondCategoryError maps category usecase errors to responses
+func respondCategoryError(c *gin.Context, err error, message string) {
+	for validationErr, field := range categoryValidationFields {
+		if errors.Is(err, validationErr) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: validationErr.Error(),
+				Code:  "VALIDATION_ERROR",
+				Details: map[string]interface{}{
+					"field": field,
+				},
+			})
+			return
+		}
+	}
+
+	if errors.Is(err, usecase.ErrCategoryNotFound) {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "Category not found",
+			Code:  "NOT_FOUND",
+		})
+		return
+	}
+
+	respondWorkspaceError(c, err, message)
+}
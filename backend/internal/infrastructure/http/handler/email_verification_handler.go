@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"backend/internal/application/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EmailVerificationHandler struct {
+	sendEmailVerificationUseCase *usecase.SendEmailVerificationUseCase
+	verifyEmailUseCase           *usecase.VerifyEmailUseCase
+}
+
+func NewEmailVerificationHandler(
+	sendEmailVerificationUseCase *usecase.SendEmailVerificationUseCase,
+	verifyEmailUseCase *usecase.VerifyEmailUseCase,
+) *EmailVerificationHandler {
+	return &EmailVerificationHandler{
+		sendEmailVerificationUseCase: sendEmailVerificationUseCase,
+		verifyEmailUseCase:           verifyEmailUseCase,
+	}
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// VerifyEmail confirms the email address of the user the token was issued to
+func (h *EmailVerificationHandler) VerifyEmail(c *gin.Context) {
+	var req VerifyEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return
+	}
+
+	if err := h.verifyEmailUseCase.Execute(c.Request.Context(), req.Token); err != nil {
+		if errors.Is(err, usecase.ErrInvalidVerificationToken) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Verification link is invalid or has expired",
+				Code:  "INVALID_VERIFICATION_TOKEN",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Failed to verify email",
+			Code:  "INTERNAL_ERROR",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{
+		Message: "Email address verified",
+	})
+}
+
+// ResendVerification sends a new verification link to the current user
+func (h *EmailVerificationHandler) ResendVerification(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	if err := h.sendEmailVerificationUseCase.Execute(c.Request.Context(), principal.UserID()); err != nil {
+		if errors.Is(err, usecase.ErrEmailAlreadyVerified) {
+			c.JSON(http.StatusConflict, ErrorResponse{
+				Error: "Email address is already verified",
+				Code:  "EMAIL_ALREADY_VERIFIED",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Failed to send verification email",
+			Code:  "INTERNAL_ERROR",
+		})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, MessageResponse{
+		Message: "Verification email sent",
+	})
+}
@@ -0,0 +1,761 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"backend/internal/application/usecase"
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ImportHandler struct {
+	uploadStatementUseCase        *usecase.UploadStatementUseCase
+	listStatementImportsUseCase   *usecase.ListStatementImportsUseCase
+	deleteStatementImportUseCase  *usecase.DeleteStatementImportUseCase
+	previewStatementImportUseCase *usecase.PreviewStatementImportUseCase
+	commitStatementImportUseCase  *usecase.CommitStatementImportUseCase
+	listImportProfilesUseCase     *usecase.ListImportProfilesUseCase
+	createImportProfileUseCase    *usecase.CreateImportProfileUseCase
+	updateImportProfileUseCase    *usecase.UpdateImportProfileUseCase
+	deleteImportProfileUseCase    *usecase.DeleteImportProfileUseCase
+}
+
+func NewImportHandler(
+	uploadStatementUseCase *usecase.UploadStatementUseCase,
+	listStatementImportsUseCase *usecase.ListStatementImportsUseCase,
+	deleteStatementImportUseCase *usecase.DeleteStatementImportUseCase,
+	previewStatementImportUseCase *usecase.PreviewStatementImportUseCase,
+	commitStatementImportUseCase *usecase.CommitStatementImportUseCase,
+	listImportProfilesUseCase *usecase.ListImportProfilesUseCase,
+	createImportProfileUseCase *usecase.CreateImportProfileUseCase,
+	updateImportProfileUseCase *usecase.UpdateImportProfileUseCase,
+	deleteImportProfileUseCase *usecase.DeleteImportProfileUseCase,
+) *ImportHandler {
+	return &ImportHandler{
+		uploadStatementUseCase:        uploadStatementUseCase,
+		listStatementImportsUseCase:   listStatementImportsUseCase,
+		deleteStatementImportUseCase:  deleteStatementImportUseCase,
+		previewStatementImportUseCase: previewStatementImportUseCase,
+		commitStatementImportUseCase:  commitStatementImportUseCase,
+		listImportProfilesUseCase:     listImportProfilesUseCase,
+		createImportProfileUseCase:    createImportProfileUseCase,
+		updateImportProfileUseCase:    updateImportProfileUseCase,
+		deleteImportProfileUseCase:    deleteImportProfileUseCase,
+	}
+}
+
+// StatementMappingRequest is the body of preview requests, choosing how the statement is read.
+// With neither field set, the account's most recently used profile is applied or the mapping is guessed.
+type StatementMappingRequest struct {
+	ProfileID *int              `json:"profileId"`
+	Mapping   *model.CSVMapping `json:"mapping"` // Takes precedence over profileId
+}
+
+// CommitImportRequest is the body of commit requests
+type CommitImportRequest struct {
+	StatementMappingRequest
+	Merges        []RowMergeRequest `json:"merges" binding:"dive"` // Rows confirmed as duplicates of existing transactions
+	SaveProfileAs string            `json:"saveProfileAs"`         // Saves the mapping as a profile of the account, replacing one of that name
+}
+
+// RowMergeRequest merges the statement row at line into one of the transactions the preview listed as its possible duplicates
+type RowMergeRequest struct {
+	Line          int `json:"line" binding:"required"`
+	TransactionID int `json:"transactionId" binding:"required"`
+}
+
+// ImportProfileRequest is the body of import profile create and update requests; accountId is ignored on update
+type ImportProfileRequest struct {
+	AccountID int               `json:"accountId"`
+	Name      string            `json:"name" binding:"required"`
+	Mapping   *model.CSVMapping `json:"mapping" binding:"required"`
+}
+
+type StatementImportResponse struct {
+	ID           int     `json:"id"`
+	AccountID    int     `json:"accountId"`
+	Format       string  `json:"format"`
+	Filename     string  `json:"filename"`
+	Status       string  `json:"status"`       // pending or committed
+	CreatedCount int     `json:"createdCount"` // Transactions created on commit
+	SkippedCount int     `json:"skippedCount"` // Rows imported before, skipped on commit
+	MergedCount  int     `json:"mergedCount"`  // Rows merged on commit into existing transactions
+	FailedCount  int     `json:"failedCount"`  // Rows rejected on commit
+	CommittedAt  *string `json:"committedAt"`
+	CreatedAt    string  `json:"createdAt"`
+}
+
+type ListStatementImportsResponse struct {
+	Imports []StatementImportResponse `json:"imports"`
+}
+
+// StatementRowResponse is one row of a preview; postedOn and amount are null when the row cannot be read
+type StatementRowResponse struct {
+	Line             int                      `json:"line"`
+	PostedOn         *string                  `json:"postedOn"`
+	Amount           *string                  `json:"amount"`
+	Payee            string                   `json:"payee"`
+	Memo             string                   `json:"memo"`
+	ValueDate        *string                  `json:"valueDate"`
+	CounterpartyIBAN string                   `json:"counterpartyIban"`
+	EndToEndID       string                   `json:"endToEndId"`
+	ExternalID       *string                  `json:"externalId"`
+	DuplicateOf      *int                     `json:"duplicateOf"` // Transaction the row was imported as or merged into before; it will be skipped
+	Matches          []DuplicateMatchResponse `json:"matches"`     // Transactions the row may duplicate, best first
+	Error            *string                  `json:"error"`
+}
+
+// DuplicateMatchResponse is an existing transaction a statement row may duplicate. Likely matches are
+// still imported as new transactions unless the merge is confirmed on commit.
+type DuplicateMatchResponse struct {
+	TransactionID int  `json:"transactionId"`
+	Score         int  `json:"score"` // 0 to 100
+	Likely        bool `json:"likely"`
+}
+
+// StatementBalanceResponse compares the closing balance of a statement with the account balance on the same day
+type StatementBalanceResponse struct {
+	AsOf             string `json:"asOf"`
+	StatementBalance string `json:"statementBalance"`
+	AccountBalance   string `json:"accountBalance"` // After the import; projected in previews
+	Difference       string `json:"difference"`
+	Matches          bool   `json:"matches"`
+}
+
+type StatementPreviewResponse struct {
+	Import       StatementImportResponse   `json:"import"`
+	Mapping      *model.CSVMapping         `json:"mapping"`      // Null for formats other than CSV
+	ProfileID    *int                      `json:"profileId"`    // Profile the mapping was taken from
+	Guessed      bool                      `json:"guessed"`      // Whether the mapping was guessed from the file
+	MappingError *string                   `json:"mappingError"` // Why the guessed mapping cannot be used as is
+	Columns      []string                  `json:"columns"`
+	Cells        [][]string                `json:"cells"`
+	Rows         []StatementRowResponse    `json:"rows"`
+	TotalRows    int                       `json:"totalRows"`
+	Duplicates   []StatementRowResponse    `json:"duplicates"` // Every row with possible duplicates, including those past rows
+	Balance      *StatementBalanceResponse `json:"balance"`    // Null when the statement states no closing balance
+}
+
+type ImportedRowResponse struct {
+	Line          int     `json:"line"`
+	TransactionID *int    `json:"transactionId"`
+	DuplicateOf   *int    `json:"duplicateOf"` // Set when the row was skipped because it was imported before
+	MergedInto    *int    `json:"mergedInto"`  // Set when the row was merged into an existing transaction
+	Error         *string `json:"error"`
+}
+
+type CommitImportResponse struct {
+	Import  StatementImportResponse   `json:"import"`
+	Profile *ImportProfileResponse    `json:"profile"` // Set when the mapping was saved
+	Balance *StatementBalanceResponse `json:"balance"` // Null when the statement states no closing balance
+	Rows    []ImportedRowResponse     `json:"rows"`
+}
+
+type ImportProfileResponse struct {
+	ID        int              `json:"id"`
+	AccountID int              `json:"accountId"`
+	Name      string           `json:"name"`
+	Mapping   model.CSVMapping `json:"mapping"`
+	CreatedAt string           `json:"createdAt"`
+	UpdatedAt string           `json:"updatedAt"`
+}
+
+type ListImportProfilesResponse struct {
+	Profiles []ImportProfileResponse `json:"profiles"`
+}
+
+func newStatementImportResponse(statementImport *model.StatementImport) StatementImportResponse {
+	var committedAt *string
+	if statementImport.CommittedAt != nil {
+		formatted := statementImport.CommittedAt.Format("2006-01-02T15:04:05Z")
+		committedAt = &formatted
+	}
+
+	return StatementImportResponse{
+		ID:           statementImport.ID,
+		AccountID:    statementImport.AccountID,
+		Format:       string(statementImport.Format),
+		Filename:     statementImport.Filename,
+		Status:       string(statementImport.Status),
+		CreatedCount: statementImport.CreatedCount,
+		SkippedCount: statementImport.SkippedCount,
+		MergedCount:  statementImport.MergedCount,
+		FailedCount:  statementImport.FailedCount,
+		CommittedAt:  committedAt,
+		CreatedAt:    statementImport.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+func newImportProfileResponse(profile *model.ImportProfile) ImportProfileResponse {
+	return ImportProfileResponse{
+		ID:        profile.ID,
+		AccountID: profile.AccountID,
+		Name:      profile.Name,
+		Mapping:   profile.Mapping,
+		CreatedAt: profile.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt: profile.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+func newStatementBalanceResponse(check *model.StatementBalanceCheck) *StatementBalanceResponse {
+	if check == nil {
+		return nil
+	}
+	return &StatementBalanceResponse{
+		AsOf:             check.AsOf.Format("2006-01-02"),
+		StatementBalance: check.StatementBalance.Amount(),
+		AccountBalance:   check.AccountBalance.Amount(),
+		Difference:       check.Difference.Amount(),
+		Matches:          check.Difference.IsZero(),
+	}
+}
+
+func newStatementPreviewResponse(preview *usecase.StatementPreview) StatementPreviewResponse {
+	columns := preview.Columns
+	if columns == nil {
+		columns = []string{}
+	}
+	response := StatementPreviewResponse{
+		Import:       newStatementImportResponse(preview.Import),
+		Mapping:      preview.Mapping,
+		ProfileID:    preview.ProfileID,
+		Guessed:      preview.Guessed,
+		MappingError: errorMessage(preview.MappingErr),
+		Columns:      columns,
+		Cells:        preview.Cells,
+		Rows:         make([]StatementRowResponse, 0, len(preview.Rows)),
+		TotalRows:    preview.TotalRows,
+		Duplicates:   make([]StatementRowResponse, 0, len(preview.Duplicates)),
+		Balance:      newStatementBalanceResponse(preview.Balance),
+	}
+	for _, row := range preview.Rows {
+		response.Rows = append(response.Rows, newStatementRowResponse(row))
+	}
+	for _, row := range preview.Duplicates {
+		response.Duplicates = append(response.Duplicates, newStatementRowResponse(row))
+	}
+	return response
+}
+
+func newStatementRowResponse(row usecase.PreviewRow) StatementRowResponse {
+	response := StatementRowResponse{
+		Line:             row.Line,
+		Payee:            row.Payee,
+		Memo:             row.Memo,
+		CounterpartyIBAN: row.CounterpartyIBAN,
+		EndToEndID:       row.EndToEndID,
+		DuplicateOf:      row.DuplicateOf,
+		Matches:          make([]DuplicateMatchResponse, 0, len(row.Matches)),
+		Error:            errorMessage(row.Err),
+	}
+	if row.ExternalID != "" {
+		externalID := row.ExternalID
+		response.ExternalID = &externalID
+	}
+	if row.Err == nil {
+		amount := row.Amount.String()
+		response.PostedOn = formatDate(&row.PostedOn)
+		response.ValueDate = formatDate(row.ValueDate)
+		response.Amount = &amount
+	}
+	for _, match := range row.Matches {
+		response.Matches = append(response.Matches, DuplicateMatchResponse{
+			TransactionID: match.TransactionID,
+			Score:         match.Score,
+			Likely:        match.Likely,
+		})
+	}
+	return response
+}
+
+// ListImports returns the statement imports of a workspace, newest first
+func (h *ImportHandler) ListImports(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	imports, err := h.listStatementImportsUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID)
+	if err != nil {
+		respondImportError(c, err, "Failed to list statement imports")
+		return
+	}
+
+	response := ListStatementImportsResponse{Imports: make([]StatementImportResponse, 0, len(imports))}
+	for _, statementImport := range imports {
+		response.Imports = append(response.Imports, newStatementImportResponse(statementImport))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UploadStatement stores a statement file for import. It expects a multipart form with the file
+// in "file" and the account to import into in "accountId".
+func (h *ImportHandler) UploadStatement(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	accountID, err := strconv.Atoi(c.PostForm("accountId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid account ID",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "accountId",
+			},
+		})
+		return
+	}
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Statement file is required",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "file",
+			},
+		})
+		return
+	}
+	if fileHeader.Size > usecase.MaxStatementSize {
+		respondImportError(c, usecase.ErrStatementTooLarge, "Failed to upload statement")
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		respondImportError(c, err, "Failed to upload statement")
+		return
+	}
+	defer file.Close()
+	content, err := io.ReadAll(io.LimitReader(file, usecase.MaxStatementSize+1))
+	if err != nil {
+		respondImportError(c, err, "Failed to upload statement")
+		return
+	}
+
+	statementImport, err := h.uploadStatementUseCase.Execute(
+		c.Request.Context(),
+		principal.UserID(),
+		workspaceID,
+		accountID,
+		fileHeader.Filename,
+		content,
+	)
+	if err != nil {
+		respondImportError(c, err, "Failed to upload statement")
+		return
+	}
+
+	c.JSON(http.StatusCreated, newStatementImportResponse(statementImport))
+}
+
+// DeleteImport deletes a statement import and its file; transactions it created are kept
+func (h *ImportHandler) DeleteImport(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	importID, ok := importIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.deleteStatementImportUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, importID); err != nil {
+		respondImportError(c, err, "Failed to delete statement import")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// PreviewImport shows the first rows of a pending statement as they would be imported; the body is optional
+func (h *ImportHandler) PreviewImport(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	importID, ok := importIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req StatementMappingRequest
+	if !bindOptionalJSON(c, &req) {
+		return
+	}
+
+	preview, err := h.previewStatementImportUseCase.Execute(
+		c.Request.Context(),
+		principal.UserID(),
+		workspaceID,
+		importID,
+		usecase.CSVMappingSelection{ProfileID: req.ProfileID, Mapping: req.Mapping},
+	)
+	if err != nil {
+		respondImportError(c, err, "Failed to preview statement import")
+		return
+	}
+
+	c.JSON(http.StatusOK, newStatementPreviewResponse(preview))
+}
+
+// CommitImport creates the transactions of a pending statement and reports the outcome of every row.
+// Rows imported before are skipped, so committing an overlapping statement creates only the new transactions.
+// Rows listed in merges are merged into the existing transactions the preview matched them with instead.
+func (h *ImportHandler) CommitImport(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	importID, ok := importIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req CommitImportRequest
+	if !bindOptionalJSON(c, &req) {
+		return
+	}
+
+	merges := make([]usecase.RowMerge, 0, len(req.Merges))
+	for _, merge := range req.Merges {
+		merges = append(merges, usecase.RowMerge{Line: merge.Line, TransactionID: merge.TransactionID})
+	}
+
+	result, err := h.commitStatementImportUseCase.Execute(
+		c.Request.Context(),
+		principal.UserID(),
+		workspaceID,
+		importID,
+		usecase.CSVMappingSelection{ProfileID: req.ProfileID, Mapping: req.Mapping},
+		merges,
+		req.SaveProfileAs,
+	)
+	if err != nil {
+		respondImportError(c, err, "Failed to commit statement import")
+		return
+	}
+
+	response := CommitImportResponse{
+		Import:  newStatementImportResponse(result.Import),
+		Balance: newStatementBalanceResponse(result.Balance),
+		Rows:    make([]ImportedRowResponse, 0, len(result.Rows)),
+	}
+	if result.Profile != nil {
+		profile := newImportProfileResponse(result.Profile)
+		response.Profile = &profile
+	}
+	for _, row := range result.Rows {
+		response.Rows = append(response.Rows, ImportedRowResponse{
+			Line:          row.Line,
+			TransactionID: row.TransactionID,
+			DuplicateOf:   row.DuplicateOf,
+			MergedInto:    row.MergedInto,
+			Error:         errorMessage(row.Err),
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListImportProfiles returns the import profiles of a workspace, most recently used first.
+// Supported query parameters: accountId.
+func (h *ImportHandler) ListImportProfiles(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	var accountID *int
+	if value := c.Query("accountId"); value != "" {
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			respondInvalidQuery(c, "accountId")
+			return
+		}
+		accountID = &id
+	}
+
+	profiles, err := h.listImportProfilesUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, accountID)
+	if err != nil {
+		respondImportError(c, err, "Failed to list import profiles")
+		return
+	}
+
+	response := ListImportProfilesResponse{Profiles: make([]ImportProfileResponse, 0, len(profiles))}
+	for _, profile := range profiles {
+		response.Profiles = append(response.Profiles, newImportProfileResponse(profile))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateImportProfile saves a CSV mapping as an import profile of an account
+func (h *ImportHandler) CreateImportProfile(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindImportProfileRequest(c)
+	if !ok {
+		return
+	}
+
+	profile, err := h.createImportProfileUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, input)
+	if err != nil {
+		respondImportError(c, err, "Failed to create import profile")
+		return
+	}
+
+	c.JSON(http.StatusCreated, newImportProfileResponse(profile))
+}
+
+// UpdateImportProfile replaces the name and mapping of an import profile
+func (h *ImportHandler) UpdateImportProfile(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	profileID, ok := importProfileIDParam(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindImportProfileRequest(c)
+	if !ok {
+		return
+	}
+
+	profile, err := h.updateImportProfileUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, profileID, input)
+	if err != nil {
+		respondImportError(c, err, "Failed to update import profile")
+		return
+	}
+
+	c.JSON(http.StatusOK, newImportProfileResponse(profile))
+}
+
+// DeleteImportProfile deletes an import profile
+func (h *ImportHandler) DeleteImportProfile(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	profileID, ok := importProfileIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.deleteImportProfileUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, profileID); err != nil {
+		respondImportError(c, err, "Failed to delete import profile")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// bindImportProfileRequest parses an import profile request body, responding with 400 when it is malformed
+func bindImportProfileRequest(c *gin.Context) (usecase.ImportProfileInput, bool) {
+	var req ImportProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return usecase.ImportProfileInput{}, false
+	}
+
+	return usecase.ImportProfileInput{
+		AccountID: req.AccountID,
+		Name:      req.Name,
+		Mapping:   *req.Mapping,
+	}, true
+}
+
+// bindOptionalJSON parses a request body that may be omitted, responding with 400 when it is malformed
+func bindOptionalJSON(c *gin.Context, req interface{}) bool {
+	if c.Request.ContentLength == 0 {
+		return true
+	}
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return false
+	}
+	return true
+}
+
+// importIDParam parses the :importId path parameter, responding with 400 when it is malformed
+func importIDParam(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("importId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid statement import ID",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "importId",
+			},
+		})
+		return 0, false
+	}
+	return id, true
+}
+
+// importProfileIDParam parses the :profileId path parameter, responding with 400 when it is malformed
+func importProfileIDParam(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("profileId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid import profile ID",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "profileId",
+			},
+		})
+		return 0, false
+	}
+	return id, true
+}
+
+// errorMessage returns the message of an optional error
+func errorMessage(err error) *string {
+	if err == nil {
+		return nil
+	}
+	message := err.Error()
+	return &message
+}
+
+// importValidationFields maps import validation errors to the request field they concern
+var importValidationFields = map[error]string{
+	service.ErrUnsupportedStatementFormat: "file",
+	service.ErrMalformedStatement:         "file",
+	service.ErrEmptyStatement:             "file",
+	service.ErrUnbalancedStatement:        "file",
+	service.ErrInvalidCSVEncoding:         "mapping.encoding",
+	service.ErrInvalidCSVDelimiter:        "mapping.delimiter",
+	service.ErrInvalidCSVDateFormat:       "mapping.dateFormat",
+	service.ErrInvalidCSVColumn:           "mapping",
+	service.ErrCSVAmountColumns:           "mapping.amountColumn",
+	service.ErrInvalidDecimalSeparator:    "mapping.decimalSeparator",
+	service.ErrImportProfileNameRequired:  "name",
+	usecase.ErrInvalidRowMerge:            "merges",
+}
+
+// respondImportError maps statement import usecase errors to responses
+func respondImportError(c *gin.Context, err error, message string) {
+	for validationErr, field := range importValidationFields {
+		if errors.Is(err, validationErr) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: err.Error(),
+				Code:  "VALIDATION_ERROR",
+				Details: map[string]interface{}{
+					"field": field,
+				},
+			})
+			return
+		}
+	}
+
+	switch {
+	case errors.Is(err, usecase.ErrStatementImportNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "Statement import not found",
+			Code:  "NOT_FOUND",
+		})
+	case errors.Is(err, usecase.ErrImportProfileNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "Import profile not found",
+			Code:  "NOT_FOUND",
+		})
+	case errors.Is(err, usecase.ErrStatementCurrencyMismatch):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Statement currency does not match the account currency",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "accountId",
+			},
+		})
+	case errors.Is(err, usecase.ErrImportAlreadyCommitted):
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error: "Statement import has already been committed",
+			Code:  "IMPORT_COMMITTED",
+		})
+	case errors.Is(err, usecase.ErrImportProfileNameTaken):
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error: "An import profile with this name already exists for the account",
+			Code:  "IMPORT_PROFILE_EXISTS",
+		})
+	case errors.Is(err, usecase.ErrStatementTooLarge):
+		c.JSON(http.StatusRequestEntityTooLarge, ErrorResponse{
+			Error: "Statement file is too large",
+			Code:  "STATEMENT_TOO_LARGE",
+			Details: map[string]interface{}{
+				"maxBytes": usecase.MaxStatementSize,
+			},
+		})
+	default:
+		respondTransactionError(c, err, message)
+	}
+}
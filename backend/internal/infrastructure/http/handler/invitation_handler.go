@@ -0,0 +1,278 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"backend/internal/application/usecase"
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/session"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InvitationHandler struct {
+	createInvitationUseCase *usecase.CreateInvitationUseCase
+	listInvitationsUseCase  *usecase.ListInvitationsUseCase
+	revokeInvitationUseCase *usecase.RevokeInvitationUseCase
+	acceptInvitationUseCase *usecase.AcceptInvitationUseCase
+}
+
+func NewInvitationHandler(
+	createInvitationUseCase *usecase.CreateInvitationUseCase,
+	listInvitationsUseCase *usecase.ListInvitationsUseCase,
+	revokeInvitationUseCase *usecase.RevokeInvitationUseCase,
+	acceptInvitationUseCase *usecase.AcceptInvitationUseCase,
+) *InvitationHandler {
+	return &InvitationHandler{
+		createInvitationUseCase: createInvitationUseCase,
+		listInvitationsUseCase:  listInvitationsUseCase,
+		revokeInvitationUseCase: revokeInvitationUseCase,
+		acceptInvitationUseCase: acceptInvitationUseCase,
+	}
+}
+
+type CreateInvitationRequest struct {
+	Email string `json:"email" binding:"required"`
+	Role  string `json:"role" binding:"required"`
+}
+
+type AcceptInvitationRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type InvitationResponse struct {
+	ID          int     `json:"id"`
+	WorkspaceID int     `json:"workspaceId"`
+	Email       string  `json:"email"`
+	Role        string  `json:"role"`
+	Status      string  `json:"status"`
+	ExpiresAt   string  `json:"expiresAt"`
+	RespondedAt *string `json:"respondedAt"`
+	CreatedAt   string  `json:"createdAt"`
+}
+
+type ListInvitationsResponse struct {
+	Invitations []InvitationResponse `json:"invitations"`
+}
+
+type AcceptInvitationResponse struct {
+	Workspace WorkspaceResponse `json:"workspace"`
+	Message   string            `json:"message"`
+}
+
+func newInvitationResponse(invitation *model.WorkspaceInvitation, now time.Time) InvitationResponse {
+	response := InvitationResponse{
+		ID:          invitation.ID,
+		WorkspaceID: invitation.WorkspaceID,
+		Email:       invitation.Email,
+		Role:        string(invitation.Role),
+		Status:      string(invitation.EffectiveStatus(now)),
+		ExpiresAt:   invitation.ExpiresAt.Format("2006-01-02T15:04:05Z"),
+		CreatedAt:   invitation.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+	if invitation.RespondedAt != nil {
+		respondedAt := invitation.RespondedAt.Format("2006-01-02T15:04:05Z")
+		response.RespondedAt = &respondedAt
+	}
+	return response
+}
+
+// CreateInvitation invites an email address to a workspace
+func (h *InvitationHandler) CreateInvitation(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req CreateInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return
+	}
+
+	invitation, err := h.createInvitationUseCase.Execute(
+		c.Request.Context(),
+		principal.UserID(),
+		workspaceID,
+		req.Email,
+		model.Role(req.Role),
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidRole):
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid role",
+				Code:  "VALIDATION_ERROR",
+				Details: map[string]interface{}{
+					"field":   "role",
+					"message": "Role must be one of admin, editor or viewer",
+				},
+			})
+		case errors.Is(err, service.ErrInvalidEmail):
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: err.Error(),
+				Code:  "VALIDATION_ERROR",
+				Details: map[string]interface{}{
+					"field": "email",
+				},
+			})
+		case errors.Is(err, usecase.ErrAlreadyMember):
+			c.JSON(http.StatusConflict, ErrorResponse{
+				Error: "User is already a member of this workspace",
+				Code:  "ALREADY_MEMBER",
+			})
+		default:
+			respondWorkspaceError(c, err, "Failed to create invitation")
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, newInvitationResponse(invitation, time.Now()))
+}
+
+// ListInvitations returns the invitations of a workspace
+func (h *InvitationHandler) ListInvitations(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	invitations, err := h.listInvitationsUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID)
+	if err != nil {
+		respondWorkspaceError(c, err, "Failed to list invitations")
+		return
+	}
+
+	now := time.Now()
+	response := ListInvitationsResponse{Invitations: make([]InvitationResponse, 0, len(invitations))}
+	for _, invitation := range invitations {
+		response.Invitations = append(response.Invitations, newInvitationResponse(invitation, now))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RevokeInvitation revokes a pending invitation
+func (h *InvitationHandler) RevokeInvitation(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	invitationID, err := strconv.Atoi(c.Param("invitationId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid invitation ID",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "invitationId",
+			},
+		})
+		return
+	}
+
+	if err := h.revokeInvitationUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, invitationID); err != nil {
+		if errors.Is(err, usecase.ErrInvitationNotFound) {
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error: "Invitation not found",
+				Code:  "NOT_FOUND",
+			})
+			return
+		}
+
+		respondWorkspaceError(c, err, "Failed to revoke invitation")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// AcceptInvitation adds the signed-in user to the invited workspace and switches the session to it
+func (h *InvitationHandler) AcceptInvitation(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	var req AcceptInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return
+	}
+
+	workspace, err := h.acceptInvitationUseCase.Execute(c.Request.Context(), principal.UserID(), req.Token)
+	if err != nil {
+		respondInvitationTokenError(c, err, "Failed to accept invitation")
+		return
+	}
+
+	if err := session.SetSession(c, principal.UserID(), principal.User.Email, workspace.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Failed to update session",
+			Code:  "INTERNAL_ERROR",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, AcceptInvitationResponse{
+		Workspace: newWorkspaceResponse(workspace),
+		Message:   "Invitation accepted",
+	})
+}
+
+// respondInvitationTokenError maps errors from consuming an invitation token to responses
+func respondInvitationTokenError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, usecase.ErrInvitationNotFound):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invitation is invalid or has expired",
+			Code:  "INVALID_INVITATION",
+		})
+	case errors.Is(err, usecase.ErrInvitationEmailMismatch):
+		c.JSON(http.StatusForbidden, ErrorResponse{
+			Error: "Invitation was sent to a different email address",
+			Code:  "INVITATION_EMAIL_MISMATCH",
+		})
+	case errors.Is(err, usecase.ErrAlreadyMember):
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error: "You are already a member of this workspace",
+			Code:  "ALREADY_MEMBER",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: message,
+			Code:  "INTERNAL_ERROR",
+		})
+	}
+}
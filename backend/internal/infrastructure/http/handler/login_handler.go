@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"backend/internal/application/usecase"
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/session"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LoginHandler struct {
+	loginUseCase                *usecase.LoginUseCase
+	verifyTwoFactorLoginUseCase *usecase.VerifyTwoFactorLoginUseCase
+}
+
+func NewLoginHandler(
+	loginUseCase *usecase.LoginUseCase,
+	verifyTwoFactorLoginUseCase *usecase.VerifyTwoFactorLoginUseCase,
+) *LoginHandler {
+	return &LoginHandler{
+		loginUseCase:                loginUseCase,
+		verifyTwoFactorLoginUseCase: verifyTwoFactorLoginUseCase,
+	}
+}
+
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+type LoginResponse struct {
+	User      UserResponse       `json:"user"`
+	Workspace *WorkspaceResponse `json:"workspace"` // null when the user belongs to no workspace
+	Message   string             `json:"message"`
+}
+
+type TwoFactorRequiredResponse struct {
+	TwoFactorRequired bool   `json:"twoFactorRequired"`
+	Message           string `json:"message"`
+}
+
+type VerifyTwoFactorLoginRequest struct {
+	Code string `json:"code" binding:"required"` // TOTP code or recovery code
+}
+
+// Login handles email/password login requests
+func (h *LoginHandler) Login(c *gin.Context) {
+	var req LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return
+	}
+
+	// Execute login usecase
+	user, workspace, err := h.loginUseCase.Execute(
+		c.Request.Context(),
+		req.Email,
+		req.Password,
+	)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidCredentials) {
+			c.JSON(http.StatusUnauthorized, ErrorResponse{
+				Error: "Invalid email or password",
+				Code:  "INVALID_CREDENTIALS",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Failed to log in",
+			Code:  "INTERNAL_ERROR",
+		})
+		return
+	}
+
+	// Users with two-factor authentication only get a partially authenticated session here
+	if user.IsTwoFactorEnabled() {
+		workspaceID := 0
+		if workspace != nil {
+			workspaceID = workspace.ID
+		}
+		if err := session.SetPendingTwoFactor(c, user.ID, workspaceID); err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error: "Failed to create session",
+				Code:  "INTERNAL_ERROR",
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, TwoFactorRequiredResponse{
+			TwoFactorRequired: true,
+			Message:           "Enter the code from your authenticator app",
+		})
+		return
+	}
+
+	h.completeLogin(c, user, workspace)
+}
+
+// VerifyTwoFactor completes a login with a TOTP or recovery code
+func (h *LoginHandler) VerifyTwoFactor(c *gin.Context) {
+	var req VerifyTwoFactorLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return
+	}
+
+	userID, workspaceID, err := session.GetPendingTwoFactor(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Not authenticated",
+			Code:  "UNAUTHENTICATED",
+			Details: map[string]interface{}{
+				"message": "No pending login found; log in with your password again",
+			},
+		})
+		return
+	}
+
+	user, workspace, err := h.verifyTwoFactorLoginUseCase.Execute(c.Request.Context(), userID, workspaceID, req.Code)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidTwoFactorCode) {
+			canRetry, sessErr := session.RecordFailedTwoFactorAttempt(c)
+			if sessErr != nil {
+				c.JSON(http.StatusInternalServerError, ErrorResponse{
+					Error: "Failed to update session",
+					Code:  "INTERNAL_ERROR",
+				})
+				return
+			}
+			c.JSON(http.StatusUnauthorized, ErrorResponse{
+				Error: "Invalid two-factor code",
+				Code:  "INVALID_TWO_FACTOR_CODE",
+				Details: map[string]interface{}{
+					"canRetry": canRetry,
+				},
+			})
+			return
+		}
+		if errors.Is(err, usecase.ErrTwoFactorLocked) {
+			// The login has to start over once the lock has expired
+			if err := session.ClearSession(c); err != nil {
+				c.JSON(http.StatusInternalServerError, ErrorResponse{
+					Error: "Failed to clear session",
+					Code:  "INTERNAL_ERROR",
+				})
+				return
+			}
+			c.JSON(http.StatusTooManyRequests, ErrorResponse{
+				Error: "Too many invalid two-factor codes; try again later",
+				Code:  "TWO_FACTOR_LOCKED",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Failed to verify two-factor code",
+			Code:  "INTERNAL_ERROR",
+		})
+		return
+	}
+
+	h.completeLogin(c, user, workspace)
+}
+
+// completeLogin issues the fully authenticated session and writes the login response.
+// Without a workspace the session has no active one until the user creates a workspace or is invited.
+func (h *LoginHandler) completeLogin(c *gin.Context, user *model.User, workspace *model.Workspace) {
+	workspaceID := 0
+	var workspaceResponse *WorkspaceResponse
+	if workspace != nil {
+		workspaceID = workspace.ID
+		response := newWorkspaceResponse(workspace)
+		workspaceResponse = &response
+	}
+
+	// Set session
+	if err := session.SetSession(c, user.ID, user.Email, workspaceID); err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Failed to create session",
+			Code:  "INTERNAL_ERROR",
+		})
+		return
+	}
+
+	// Return success response
+	c.JSON(http.StatusOK, LoginResponse{
+		User:      newUserResponse(user),
+		Workspace: workspaceResponse,
+		Message:   "Logged in successfully",
+	})
+}
+
+// Logout clears the current session. It runs without authentication so that a stale or
+// invalid session cookie is always removed.
+func (h *LoginHandler) Logout(c *gin.Context) {
+	if err := session.ClearSession(c); err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Failed to clear session",
+			Code:  "INTERNAL_ERROR",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
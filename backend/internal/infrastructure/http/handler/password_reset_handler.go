@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"backend/internal/application/usecase"
+	"backend/internal/domain/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PasswordResetHandler struct {
+	requestPasswordResetUseCase *usecase.RequestPasswordResetUseCase
+	resetPasswordUseCase        *usecase.ResetPasswordUseCase
+}
+
+func NewPasswordResetHandler(
+	requestPasswordResetUseCase *usecase.RequestPasswordResetUseCase,
+	resetPasswordUseCase *usecase.ResetPasswordUseCase,
+) *PasswordResetHandler {
+	return &PasswordResetHandler{
+		requestPasswordResetUseCase: requestPasswordResetUseCase,
+		resetPasswordUseCase:        resetPasswordUseCase,
+	}
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+type MessageResponse struct {
+	Message string `json:"message"`
+}
+
+// ForgotPassword sends a password reset link to the given email address
+func (h *PasswordResetHandler) ForgotPassword(c *gin.Context) {
+	var req ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return
+	}
+
+	if err := h.requestPasswordResetUseCase.Execute(c.Request.Context(), req.Email); err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Failed to request password reset",
+			Code:  "INTERNAL_ERROR",
+		})
+		return
+	}
+
+	// The response is the same whether or not the email is registered
+	c.JSON(http.StatusAccepted, MessageResponse{
+		Message: "If an account exists for this email, a reset link has been sent",
+	})
+}
+
+// ResetPassword sets a new password using a reset token
+func (h *PasswordResetHandler) ResetPassword(c *gin.Context) {
+	var req ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return
+	}
+
+	if err := h.resetPasswordUseCase.Execute(c.Request.Context(), req.Token, req.Password); err != nil {
+		if errors.Is(err, usecase.ErrInvalidResetToken) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Reset link is invalid or has expired",
+				Code:  "INVALID_RESET_TOKEN",
+			})
+			return
+		}
+		if errors.Is(err, service.ErrPasswordTooShort) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: err.Error(),
+				Code:  "VALIDATION_ERROR",
+				Details: map[string]interface{}{
+					"field": "password",
+				},
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Failed to reset password",
+			Code:  "INTERNAL_ERROR",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{
+		Message: "Password has been reset. Please log in with your new password",
+	})
+}
@@ -0,0 +1,355 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/internal/application/usecase"
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PayeeHandler struct {
+	listPayeesUseCase   *usecase.ListPayeesUseCase
+	createPayeeUseCase  *usecase.CreatePayeeUseCase
+	updatePayeeUseCase  *usecase.UpdatePayeeUseCase
+	deletePayeeUseCase  *usecase.DeletePayeeUseCase
+	mergePayeesUseCase  *usecase.MergePayeesUseCase
+	resolvePayeeUseCase *usecase.ResolvePayeeUseCase
+}
+
+func NewPayeeHandler(
+	listPayeesUseCase *usecase.ListPayeesUseCase,
+	createPayeeUseCase *usecase.CreatePayeeUseCase,
+	updatePayeeUseCase *usecase.UpdatePayeeUseCase,
+	deletePayeeUseCase *usecase.DeletePayeeUseCase,
+	mergePayeesUseCase *usecase.MergePayeesUseCase,
+	resolvePayeeUseCase *usecase.ResolvePayeeUseCase,
+) *PayeeHandler {
+	return &PayeeHandler{
+		listPayeesUseCase:   listPayeesUseCase,
+		createPayeeUseCase:  createPayeeUseCase,
+		updatePayeeUseCase:  updatePayeeUseCase,
+		deletePayeeUseCase:  deletePayeeUseCase,
+		mergePayeesUseCase:  mergePayeesUseCase,
+		resolvePayeeUseCase: resolvePayeeUseCase,
+	}
+}
+
+// PayeeRequest is the body of payee create and update requests
+type PayeeRequest struct {
+	Name              string   `json:"name" binding:"required"`
+	Aliases           []string `json:"aliases"` // Matched case-insensitively; * matches any run of characters
+	DefaultCategoryID *int     `json:"defaultCategoryId"`
+	LogoURL           string   `json:"logoUrl"`
+	Website           string   `json:"website"`
+}
+
+type MergePayeeRequest struct {
+	TargetID int `json:"targetId" binding:"required"`
+}
+
+type ResolvePayeeRequest struct {
+	Description string `json:"description" binding:"required"` // Raw description, e.g. from a bank statement
+}
+
+type PayeeResponse struct {
+	ID                int      `json:"id"`
+	Name              string   `json:"name"`
+	Aliases           []string `json:"aliases"`
+	DefaultCategoryID *int     `json:"defaultCategoryId"`
+	LogoURL           string   `json:"logoUrl"`
+	Website           string   `json:"website"`
+	CreatedAt         string   `json:"createdAt"`
+	UpdatedAt         string   `json:"updatedAt"`
+}
+
+type ListPayeesResponse struct {
+	Payees []PayeeResponse `json:"payees"`
+}
+
+type ResolvePayeeResponse struct {
+	Normalized string         `json:"normalized"` // The description as alias patterns see it
+	Payee      *PayeeResponse `json:"payee"`      // Null when no payee matches
+}
+
+func newPayeeResponse(payee *model.Payee) PayeeResponse {
+	return PayeeResponse{
+		ID:                payee.ID,
+		Name:              payee.Name,
+		Aliases:           append([]string{}, payee.Aliases...),
+		DefaultCategoryID: payee.DefaultCategoryID,
+		LogoURL:           payee.LogoURL,
+		Website:           payee.Website,
+		CreatedAt:         payee.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:         payee.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// ListPayees returns the payee directory of a workspace ordered by name
+func (h *PayeeHandler) ListPayees(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	payees, err := h.listPayeesUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID)
+	if err != nil {
+		respondPayeeError(c, err, "Failed to list payees")
+		return
+	}
+
+	response := ListPayeesResponse{Payees: make([]PayeeResponse, 0, len(payees))}
+	for _, payee := range payees {
+		response.Payees = append(response.Payees, newPayeeResponse(payee))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CreatePayee adds a payee to the directory of a workspace
+func (h *PayeeHandler) CreatePayee(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindPayeeRequest(c)
+	if !ok {
+		return
+	}
+
+	payee, err := h.createPayeeUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, input)
+	if err != nil {
+		respondPayeeError(c, err, "Failed to create payee")
+		return
+	}
+
+	c.JSON(http.StatusCreated, newPayeeResponse(payee))
+}
+
+// UpdatePayee replaces the editable fields of a payee
+func (h *PayeeHandler) UpdatePayee(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	payeeID, ok := payeeIDParam(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindPayeeRequest(c)
+	if !ok {
+		return
+	}
+
+	payee, err := h.updatePayeeUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, payeeID, input)
+	if err != nil {
+		respondPayeeError(c, err, "Failed to update payee")
+		return
+	}
+
+	c.JSON(http.StatusOK, newPayeeResponse(payee))
+}
+
+// DeletePayee deletes a payee; its transactions keep their descriptions
+func (h *PayeeHandler) DeletePayee(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	payeeID, ok := payeeIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.deletePayeeUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, payeeID); err != nil {
+		respondPayeeError(c, err, "Failed to delete payee")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// MergePayee merges a duplicate payee into the target payee and returns the target
+func (h *PayeeHandler) MergePayee(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	payeeID, ok := payeeIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req MergePayeeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return
+	}
+
+	target, err := h.mergePayeesUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, payeeID, req.TargetID)
+	if err != nil {
+		respondPayeeError(c, err, "Failed to merge payees")
+		return
+	}
+
+	c.JSON(http.StatusOK, newPayeeResponse(target))
+}
+
+// ResolvePayee returns the payee a raw description resolves to, e.g. to preview an import
+func (h *PayeeHandler) ResolvePayee(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req ResolvePayeeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return
+	}
+
+	payee, err := h.resolvePayeeUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, req.Description)
+	if err != nil {
+		respondPayeeError(c, err, "Failed to resolve payee")
+		return
+	}
+
+	response := ResolvePayeeResponse{Normalized: service.NormalizePayeeDescription(req.Description)}
+	if payee != nil {
+		payeeResponse := newPayeeResponse(payee)
+		response.Payee = &payeeResponse
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// bindPayeeRequest parses a payee request body, responding with 400 when it is malformed
+func bindPayeeRequest(c *gin.Context) (usecase.PayeeInput, bool) {
+	var req PayeeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return usecase.PayeeInput{}, false
+	}
+
+	return usecase.PayeeInput{
+		Name:              req.Name,
+		Aliases:           req.Aliases,
+		DefaultCategoryID: req.DefaultCategoryID,
+		LogoURL:           req.LogoURL,
+		Website:           req.Website,
+	}, true
+}
+
+// payeeIDParam parses the :payeeId path parameter, responding with 400 when it is malformed
+func payeeIDParam(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("payeeId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid payee ID",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "payeeId",
+			},
+		})
+		return 0, false
+	}
+	return id, true
+}
+
+// payeeValidationFields maps payee validation errors to the request field they concern
+var payeeValidationFields = map[error]string{
+	service.ErrPayeeNameRequired:       "name",
+	service.ErrInvalidPayeeAlias:       "aliases",
+	service.ErrPayeeAliasConflict:      "aliases",
+	service.ErrInvalidPayeeLogoURL:     "logoUrl",
+	service.ErrInvalidPayeeWebsite:     "website",
+	service.ErrPayeeMergeSelf:          "targetId",
+	usecase.ErrDefaultCategoryNotFound: "defaultCategoryId",
+}
+
+// respondPayeeError maps payee usecase errors to responses
+func respondPayeeError(c *gin.Context, err error, message string) {
+	for validationErr, field := range payeeValidationFields {
+		if errors.Is(err, validationErr) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: validationErr.Error(),
+				Code:  "VALIDATION_ERROR",
+				Details: map[string]interface{}{
+					"field": field,
+				},
+			})
+			return
+		}
+	}
+
+	if errors.Is(err, usecase.ErrPayeeNotFound) {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "Payee not found",
+			Code:  "NOT_FOUND",
+		})
+		return
+	}
+	if errors.Is(err, usecase.ErrPayeeNameTaken) {
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error: "A payee with this name already exists",
+			Code:  "PAYEE_EXISTS",
+		})
+		return
+	}
+
+	respondWorkspaceError(c, err, message)
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"net/http"
+
+	"backend/internal/application/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+// currentPrincipal returns the principal set by middleware.RequireAuth.
+// It responds with 401 and returns false when the route was not protected by the middleware.
+func currentPrincipal(c *gin.Context) (*auth.Principal, bool) {
+	principal, ok := auth.PrincipalFromContext(c.Request.Context())
+	if !ok {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Not authenticated",
+			Code:  "UNAUTHENTICATED",
+			Details: map[string]interface{}{
+				"message": "No active session found",
+			},
+		})
+		return nil, false
+	}
+	return principal, true
+}
+
+// respondForbidden responds with 403 for members whose role lacks a permission
+func respondForbidden(c *gin.Context) {
+	c.JSON(http.StatusForbidden, ErrorResponse{
+		Error: "Your role in this workspace does not allow this action",
+		Code:  "FORBIDDEN",
+	})
+}
@@ -0,0 +1,271 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"backend/internal/application/usecase"
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type QIFHandler struct {
+	importQIFUseCase *usecase.ImportQIFUseCase
+	exportQIFUseCase *usecase.ExportQIFUseCase
+}
+
+func NewQIFHandler(
+	importQIFUseCase *usecase.ImportQIFUseCase,
+	exportQIFUseCase *usecase.ExportQIFUseCase,
+) *QIFHandler {
+	return &QIFHandler{
+		importQIFUseCase: importQIFUseCase,
+		exportQIFUseCase: exportQIFUseCase,
+	}
+}
+
+type QIFImportedRowResponse struct {
+	Line           int     `json:"line"`
+	TransactionID  *int    `json:"transactionId"`
+	TransferID     *int    `json:"transferId"`  // Set when the transaction was linked with its other side
+	DuplicateOf    *int    `json:"duplicateOf"` // Set when the transaction was skipped because it was imported before
+	OpeningBalance bool    `json:"openingBalance"`
+	Error          *string `json:"error"`
+}
+
+type QIFImportedAccountResponse struct {
+	ID           *int                     `json:"id"` // Null for accounts a dry run would create
+	Name         string                   `json:"name"`
+	Type         string                   `json:"type"`
+	Currency     string                   `json:"currency"`
+	Created      bool                     `json:"created"`
+	CreatedCount int                      `json:"createdCount"`
+	SkippedCount int                      `json:"skippedCount"` // Transactions imported before
+	FailedCount  int                      `json:"failedCount"`
+	Rows         []QIFImportedRowResponse `json:"rows"`
+}
+
+type QIFImportedCategoryResponse struct {
+	ID       *int   `json:"id"`       // Null for categories a dry run would create
+	ParentID *int   `json:"parentId"` // Null for top-level categories and for parents a dry run would create
+	Name     string `json:"name"`
+	Path     string `json:"path"` // Names from the top-level category down, joined by ":"
+	Kind     string `json:"kind"`
+}
+
+type QIFImportResponse struct {
+	DryRun     bool                          `json:"dryRun"`
+	Accounts   []QIFImportedAccountResponse  `json:"accounts"`
+	Categories []QIFImportedCategoryResponse `json:"categories"` // Categories the import created
+}
+
+// optionalID returns nil for the zero ID of records that were not saved
+func optionalID(id int) *int {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
+
+func newQIFImportResponse(result *usecase.QIFImportResult) QIFImportResponse {
+	response := QIFImportResponse{
+		DryRun:     result.DryRun,
+		Accounts:   make([]QIFImportedAccountResponse, 0, len(result.Accounts)),
+		Categories: make([]QIFImportedCategoryResponse, 0, len(result.Categories)),
+	}
+	for _, imported := range result.Accounts {
+		account := QIFImportedAccountResponse{
+			ID:       optionalID(imported.Account.ID),
+			Name:     imported.Account.Name,
+			Type:     string(imported.Account.Type),
+			Currency: imported.Account.Currency,
+			Created:  imported.Created,
+			Rows:     make([]QIFImportedRowResponse, 0, len(imported.Rows)),
+		}
+		for _, row := range imported.Rows {
+			switch {
+			case row.Err != nil:
+				account.FailedCount++
+			case row.DuplicateOf != nil:
+				account.SkippedCount++
+			case !row.OpeningBalance:
+				account.CreatedCount++
+			}
+			account.Rows = append(account.Rows, QIFImportedRowResponse{
+				Line:           row.Line,
+				TransactionID:  row.TransactionID,
+				TransferID:     row.TransferID,
+				DuplicateOf:    row.DuplicateOf,
+				OpeningBalance: row.OpeningBalance,
+				Error:          errorMessage(row.Err),
+			})
+		}
+		response.Accounts = append(response.Accounts, account)
+	}
+	for _, imported := range result.Categories {
+		response.Categories = append(response.Categories, QIFImportedCategoryResponse{
+			ID:       optionalID(imported.Category.ID),
+			ParentID: imported.Category.ParentID,
+			Name:     imported.Category.Name,
+			Path:     strings.Join(imported.Path, ":"),
+			Kind:     string(imported.Category.Kind),
+		})
+	}
+	return response
+}
+
+// ImportQIF imports the accounts, categories and transactions of a QIF file. It expects a multipart form with
+// the file in "file" and optionally "accountId" (the account a file without account headers belongs to),
+// "currency" (of the accounts to create), "dateFormat" (guessed when omitted) and "dryRun" ("true" to only
+// report what the import would do). Transactions imported before are skipped, so re-importing a file is safe.
+func (h *QIFHandler) ImportQIF(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	input := usecase.QIFImportInput{
+		DateFormat: model.QIFDateFormat(c.PostForm("dateFormat")),
+		Currency:   c.PostForm("currency"),
+	}
+	if value := c.PostForm("accountId"); value != "" {
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid account ID",
+				Code:  "VALIDATION_ERROR",
+				Details: map[string]interface{}{
+					"field": "accountId",
+				},
+			})
+			return
+		}
+		input.AccountID = &id
+	}
+	if value := c.PostForm("dryRun"); value != "" {
+		dryRun, err := strconv.ParseBool(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Invalid dry run flag",
+				Code:  "VALIDATION_ERROR",
+				Details: map[string]interface{}{
+					"field": "dryRun",
+				},
+			})
+			return
+		}
+		input.DryRun = dryRun
+	}
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "QIF file is required",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "file",
+			},
+		})
+		return
+	}
+	if fileHeader.Size > usecase.MaxStatementSize {
+		respondQIFError(c, usecase.ErrStatementTooLarge, "Failed to import QIF file")
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		respondQIFError(c, err, "Failed to import QIF file")
+		return
+	}
+	defer file.Close()
+	content, err := io.ReadAll(io.LimitReader(file, usecase.MaxStatementSize+1))
+	if err != nil {
+		respondQIFError(c, err, "Failed to import QIF file")
+		return
+	}
+
+	result, err := h.importQIFUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, content, input)
+	if err != nil {
+		respondQIFError(c, err, "Failed to import QIF file")
+		return
+	}
+
+	status := http.StatusCreated
+	if result.DryRun {
+		status = http.StatusOK
+	}
+	c.JSON(status, newQIFImportResponse(result))
+}
+
+// ExportQIF downloads the ledger of a workspace as a QIF file.
+// Supported query parameters: accountId, from, to (YYYY-MM-DD), dateFormat (default MM/DD/YYYY).
+func (h *QIFHandler) ExportQIF(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	input := usecase.QIFExportInput{DateFormat: model.QIFDateFormat(c.Query("dateFormat"))}
+	if value := c.Query("accountId"); value != "" {
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			respondInvalidQuery(c, "accountId")
+			return
+		}
+		input.AccountID = &id
+	}
+	if input.From, input.To, ok = parseDateRangeQuery(c); !ok {
+		return
+	}
+
+	content, err := h.exportQIFUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, input)
+	if err != nil {
+		respondQIFError(c, err, "Failed to export QIF file")
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="finsight-ledger.qif"`)
+	c.Data(http.StatusOK, "application/qif; charset=utf-8", content)
+}
+
+// qifValidationFields maps QIF import and export validation errors to the request field they concern
+var qifValidationFields = map[error]string{
+	service.ErrInvalidQIFDateFormat: "dateFormat",
+	service.ErrMalformedQIF:         "file",
+	service.ErrEmptyQIF:             "file",
+	service.ErrInvalidCurrency:      "currency",
+	usecase.ErrQIFAccountRequired:   "accountId",
+	usecase.ErrQIFCurrencyRequired:  "currency",
+}
+
+// respondQIFError maps QIF usecase errors to responses
+func respondQIFError(c *gin.Context, err error, message string) {
+	for validationErr, field := range qifValidationFields {
+		if errors.Is(err, validationErr) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: err.Error(),
+				Code:  "VALIDATION_ERROR",
+				Details: map[string]interface{}{
+					"field": field,
+				},
+			})
+			return
+		}
+	}
+
+	respondImportError(c, err, message)
+}
@@ -0,0 +1,467 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/internal/application/usecase"
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RecurrenceHandler struct {
+	listRecurrenceRulesUseCase     *usecase.ListRecurrenceRulesUseCase
+	getRecurrenceRuleUseCase       *usecase.GetRecurrenceRuleUseCase
+	createRecurrenceRuleUseCase    *usecase.CreateRecurrenceRuleUseCase
+	updateRecurrenceRuleUseCase    *usecase.UpdateRecurrenceRuleUseCase
+	deleteRecurrenceRuleUseCase    *usecase.DeleteRecurrenceRuleUseCase
+	listUpcomingOccurrencesUseCase *usecase.ListUpcomingOccurrencesUseCase
+	skipOccurrenceUseCase          *usecase.SkipOccurrenceUseCase
+	postOccurrenceUseCase          *usecase.PostOccurrenceUseCase
+}
+
+func NewRecurrenceHandler(
+	listRecurrenceRulesUseCase *usecase.ListRecurrenceRulesUseCase,
+	getRecurrenceRuleUseCase *usecase.GetRecurrenceRuleUseCase,
+	createRecurrenceRuleUseCase *usecase.CreateRecurrenceRuleUseCase,
+	updateRecurrenceRuleUseCase *usecase.UpdateRecurrenceRuleUseCase,
+	deleteRecurrenceRuleUseCase *usecase.DeleteRecurrenceRuleUseCase,
+	listUpcomingOccurrencesUseCase *usecase.ListUpcomingOccurrencesUseCase,
+	skipOccurrenceUseCase *usecase.SkipOccurrenceUseCase,
+	postOccurrenceUseCase *usecase.PostOccurrenceUseCase,
+) *RecurrenceHandler {
+	return &RecurrenceHandler{
+		listRecurrenceRulesUseCase:     listRecurrenceRulesUseCase,
+		getRecurrenceRuleUseCase:       getRecurrenceRuleUseCase,
+		createRecurrenceRuleUseCase:    createRecurrenceRuleUseCase,
+		updateRecurrenceRuleUseCase:    updateRecurrenceRuleUseCase,
+		deleteRecurrenceRuleUseCase:    deleteRecurrenceRuleUseCase,
+		listUpcomingOccurrencesUseCase: listUpcomingOccurrencesUseCase,
+		skipOccurrenceUseCase:          skipOccurrenceUseCase,
+		postOccurrenceUseCase:          postOccurrenceUseCase,
+	}
+}
+
+// RecurrenceRuleRequest is the body of recurrence rule create and update requests
+type RecurrenceRuleRequest struct {
+	AccountID  int            `json:"accountId" binding:"required"`
+	Amount     *model.Decimal `json:"amount" binding:"required"` // Decimal string in the account currency; negative for bills
+	Payee      string         `json:"payee"`
+	PayeeID    *int           `json:"payeeId"` // Resolved from payee when each occurrence is posted when omitted
+	Memo       string         `json:"memo"`
+	CategoryID *int           `json:"categoryId"`
+	Frequency  string         `json:"frequency" binding:"required"` // daily, weekly, monthly or yearly
+	Interval   int            `json:"interval"`                     // Every n periods; defaults to 1
+	ByDay      []string       `json:"byDay"`                        // RRULE BYDAY values such as MO or -1FR (monthly only)
+	StartsOn   string         `json:"startsOn" binding:"required"`  // YYYY-MM-DD
+	EndsOn     *string        `json:"endsOn"`                       // YYYY-MM-DD; null repeats forever
+}
+
+type RecurrenceRuleResponse struct {
+	ID         int      `json:"id"`
+	AccountID  int      `json:"accountId"`
+	Amount     string   `json:"amount"`
+	Currency   string   `json:"currency"`
+	Payee      string   `json:"payee"`
+	PayeeID    *int     `json:"payeeId"`
+	Memo       string   `json:"memo"`
+	CategoryID *int     `json:"categoryId"`
+	Frequency  string   `json:"frequency"`
+	Interval   int      `json:"interval"`
+	ByDay      []string `json:"byDay"`
+	StartsOn   string   `json:"startsOn"`
+	EndsOn     *string  `json:"endsOn"`
+	CreatedAt  string   `json:"createdAt"`
+	UpdatedAt  string   `json:"updatedAt"`
+}
+
+type ListRecurrenceRulesResponse struct {
+	Rules []RecurrenceRuleResponse `json:"rules"`
+}
+
+type OccurrenceResponse struct {
+	ID            int    `json:"id"`
+	RuleID        int    `json:"ruleId"`
+	DueOn         string `json:"dueOn"`
+	Status        string `json:"status"`
+	TransactionID *int   `json:"transactionId"` // Set once posted
+}
+
+// UpcomingOccurrenceResponse is a scheduled occurrence with the rule it will be posted from
+type UpcomingOccurrenceResponse struct {
+	OccurrenceResponse
+	Rule RecurrenceRuleResponse `json:"rule"`
+}
+
+type ListUpcomingOccurrencesResponse struct {
+	Occurrences []UpcomingOccurrenceResponse `json:"occurrences"`
+}
+
+func newRecurrenceRuleResponse(rule *model.RecurrenceRule) RecurrenceRuleResponse {
+	return RecurrenceRuleResponse{
+		ID:         rule.ID,
+		AccountID:  rule.AccountID,
+		Amount:     rule.Amount.Amount(),
+		Currency:   rule.Amount.Currency(),
+		Payee:      rule.Payee,
+		PayeeID:    rule.PayeeID,
+		Memo:       rule.Memo,
+		CategoryID: rule.CategoryID,
+		Frequency:  string(rule.Frequency),
+		Interval:   rule.Interval,
+		ByDay:      append([]string{}, rule.ByDay...),
+		StartsOn:   rule.StartsOn.Format("2006-01-02"),
+		EndsOn:     formatDate(rule.EndsOn),
+		CreatedAt:  rule.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:  rule.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+func newOccurrenceResponse(occurrence *model.RecurrenceOccurrence) OccurrenceResponse {
+	return OccurrenceResponse{
+		ID:            occurrence.ID,
+		RuleID:        occurrence.RuleID,
+		DueOn:         occurrence.DueOn.Format("2006-01-02"),
+		Status:        string(occurrence.Status),
+		TransactionID: occurrence.TransactionID,
+	}
+}
+
+// ListRecurrenceRules returns the recurrence rules of a workspace
+func (h *RecurrenceHandler) ListRecurrenceRules(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	rules, err := h.listRecurrenceRulesUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID)
+	if err != nil {
+		respondRecurrenceError(c, err, "Failed to list recurrence rules")
+		return
+	}
+
+	response := ListRecurrenceRulesResponse{Rules: make([]RecurrenceRuleResponse, 0, len(rules))}
+	for _, rule := range rules {
+		response.Rules = append(response.Rules, newRecurrenceRuleResponse(rule))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetRecurrenceRule returns a single recurrence rule
+func (h *RecurrenceHandler) GetRecurrenceRule(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	ruleID, ok := recurrenceRuleIDParam(c)
+	if !ok {
+		return
+	}
+
+	rule, err := h.getRecurrenceRuleUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, ruleID)
+	if err != nil {
+		respondRecurrenceError(c, err, "Failed to get recurrence rule")
+		return
+	}
+
+	c.JSON(http.StatusOK, newRecurrenceRuleResponse(rule))
+}
+
+// CreateRecurrenceRule creates a recurrence rule in a workspace
+func (h *RecurrenceHandler) CreateRecurrenceRule(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindRecurrenceRuleRequest(c)
+	if !ok {
+		return
+	}
+
+	rule, err := h.createRecurrenceRuleUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, input)
+	if err != nil {
+		respondRecurrenceError(c, err, "Failed to create recurrence rule")
+		return
+	}
+
+	c.JSON(http.StatusCreated, newRecurrenceRuleResponse(rule))
+}
+
+// UpdateRecurrenceRule replaces the editable fields of a recurrence rule and reschedules its pending occurrences
+func (h *RecurrenceHandler) UpdateRecurrenceRule(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	ruleID, ok := recurrenceRuleIDParam(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindRecurrenceRuleRequest(c)
+	if !ok {
+		return
+	}
+
+	rule, err := h.updateRecurrenceRuleUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, ruleID, input)
+	if err != nil {
+		respondRecurrenceError(c, err, "Failed to update recurrence rule")
+		return
+	}
+
+	c.JSON(http.StatusOK, newRecurrenceRuleResponse(rule))
+}
+
+// DeleteRecurrenceRule deletes a recurrence rule; transactions it already posted are kept
+func (h *RecurrenceHandler) DeleteRecurrenceRule(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	ruleID, ok := recurrenceRuleIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.deleteRecurrenceRuleUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, ruleID); err != nil {
+		respondRecurrenceError(c, err, "Failed to delete recurrence rule")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListUpcomingOccurrences returns the bills and other occurrences still to be posted, overdue ones first.
+// Supported query parameters: days (1 to 366, default 30).
+func (h *RecurrenceHandler) ListUpcomingOccurrences(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	days := 0
+	if value := c.Query("days"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			respondInvalidQuery(c, "days")
+			return
+		}
+		days = n
+	}
+
+	upcoming, err := h.listUpcomingOccurrencesUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, days)
+	if err != nil {
+		respondRecurrenceError(c, err, "Failed to list upcoming occurrences")
+		return
+	}
+
+	response := ListUpcomingOccurrencesResponse{Occurrences: make([]UpcomingOccurrenceResponse, 0, len(upcoming))}
+	for _, item := range upcoming {
+		response.Occurrences = append(response.Occurrences, UpcomingOccurrenceResponse{
+			OccurrenceResponse: newOccurrenceResponse(item.Occurrence),
+			Rule:               newRecurrenceRuleResponse(item.Rule),
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// SkipOccurrence marks a scheduled occurrence skipped so that it is never posted
+func (h *RecurrenceHandler) SkipOccurrence(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	occurrenceID, ok := occurrenceIDParam(c)
+	if !ok {
+		return
+	}
+
+	occurrence, err := h.skipOccurrenceUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, occurrenceID)
+	if err != nil {
+		respondRecurrenceError(c, err, "Failed to skip occurrence")
+		return
+	}
+
+	c.JSON(http.StatusOK, newOccurrenceResponse(occurrence))
+}
+
+// PostOccurrence posts a scheduled occurrence ahead of its due date and returns the created transaction
+func (h *RecurrenceHandler) PostOccurrence(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	occurrenceID, ok := occurrenceIDParam(c)
+	if !ok {
+		return
+	}
+
+	transaction, err := h.postOccurrenceUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, occurrenceID)
+	if err != nil {
+		respondRecurrenceError(c, err, "Failed to post occurrence")
+		return
+	}
+
+	c.JSON(http.StatusCreated, newTransactionResponse(transaction))
+}
+
+// bindRecurrenceRuleRequest parses a recurrence rule request body, responding with 400 when it is malformed
+func bindRecurrenceRuleRequest(c *gin.Context) (usecase.RecurrenceRuleInput, bool) {
+	var req RecurrenceRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return usecase.RecurrenceRuleInput{}, false
+	}
+
+	startsOn, ok := parseDateField(c, "startsOn", &req.StartsOn)
+	if !ok {
+		return usecase.RecurrenceRuleInput{}, false
+	}
+	endsOn, ok := parseDateField(c, "endsOn", req.EndsOn)
+	if !ok {
+		return usecase.RecurrenceRuleInput{}, false
+	}
+
+	return usecase.RecurrenceRuleInput{
+		AccountID:  req.AccountID,
+		Amount:     *req.Amount,
+		Payee:      req.Payee,
+		PayeeID:    req.PayeeID,
+		Memo:       req.Memo,
+		CategoryID: req.CategoryID,
+		Frequency:  model.RecurrenceFrequency(req.Frequency),
+		Interval:   req.Interval,
+		ByDay:      req.ByDay,
+		StartsOn:   *startsOn,
+		EndsOn:     endsOn,
+	}, true
+}
+
+// recurrenceRuleIDParam parses the :ruleId path parameter, responding with 400 when it is malformed
+func recurrenceRuleIDParam(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("ruleId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid recurrence rule ID",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "ruleId",
+			},
+		})
+		return 0, false
+	}
+	return id, true
+}
+
+// occurrenceIDParam parses the :occurrenceId path parameter, responding with 400 when it is malformed
+func occurrenceIDParam(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("occurrenceId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid occurrence ID",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "occurrenceId",
+			},
+		})
+		return 0, false
+	}
+	return id, true
+}
+
+// recurrenceValidationFields maps recurrence validation errors to the request field they concern
+var recurrenceValidationFields = map[error]string{
+	service.ErrInvalidRecurrenceFrequency: "frequency",
+	service.ErrInvalidRecurrenceInterval:  "interval",
+	service.ErrInvalidRecurrenceByDay:     "byDay",
+	service.ErrRecurrenceEndsBeforeStart:  "endsOn",
+	usecase.ErrInvalidUpcomingDays:        "days",
+}
+
+// respondRecurrenceError maps recurrence usecase errors to responses
+func respondRecurrenceError(c *gin.Context, err error, message string) {
+	for validationErr, field := range recurrenceValidationFields {
+		if errors.Is(err, validationErr) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: validationErr.Error(),
+				Code:  "VALIDATION_ERROR",
+				Details: map[string]interface{}{
+					"field": field,
+				},
+			})
+			return
+		}
+	}
+
+	switch {
+	case errors.Is(err, usecase.ErrRecurrenceRuleNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "Recurrence rule not found",
+			Code:  "NOT_FOUND",
+		})
+	case errors.Is(err, usecase.ErrOccurrenceNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "Occurrence not found",
+			Code:  "NOT_FOUND",
+		})
+	case errors.Is(err, usecase.ErrOccurrenceNotScheduled):
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error: "Occurrence has already been posted or skipped",
+			Code:  "OCCURRENCE_NOT_SCHEDULED",
+		})
+	default:
+		respondTransactionError(c, err, message)
+	}
+}
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"net/http"
+
+	"backend/internal/application/usecase"
+	"backend/internal/domain/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReportHandler struct {
+	categoryReportUseCase *usecase.CategoryReportUseCase
+	tagReportUseCase      *usecase.TagReportUseCase
+}
+
+func NewReportHandler(
+	categoryReportUseCase *usecase.CategoryReportUseCase,
+	tagReportUseCase *usecase.TagReportUseCase,
+) *ReportHandler {
+	return &ReportHandler{
+		categoryReportUseCase: categoryReportUseCase,
+		tagReportUseCase:      tagReportUseCase,
+	}
+}
+
+type CategoryTotalResponse struct {
+	CategoryID *int   `json:"categoryId"` // Null for uncategorized amounts
+	Amount     string `json:"amount"`
+	Currency   string `json:"currency"`
+}
+
+type CategoryReportResponse struct {
+	Totals []CategoryTotalResponse `json:"totals"`
+}
+
+type TagTotalResponse struct {
+	TagID    int    `json:"tagId"`
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+type TagReportResponse struct {
+	Totals []TagTotalResponse `json:"totals"`
+}
+
+func newCategoryTotalResponse(total model.CategoryTotal) CategoryTotalResponse {
+	return CategoryTotalResponse{
+		CategoryID: total.CategoryID,
+		Amount:     total.Amount.Amount(),
+		Currency:   total.Amount.Currency(),
+	}
+}
+
+func newTagTotalResponse(total model.TagTotal) TagTotalResponse {
+	return TagTotalResponse{
+		TagID:    total.TagID,
+		Amount:   total.Amount.Amount(),
+		Currency: total.Amount.Currency(),
+	}
+}
+
+// CategoryReport returns the totals per category of a workspace.
+// Supported query parameters: from, to (YYYY-MM-DD), tagId (repeatable), tagMatch (any or all).
+func (h *ReportHandler) CategoryReport(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	filter, ok := parseReportFilter(c)
+	if !ok {
+		return
+	}
+
+	totals, err := h.categoryReportUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, filter)
+	if err != nil {
+		respondTransactionError(c, err, "Failed to build category report")
+		return
+	}
+
+	response := CategoryReportResponse{Totals: make([]CategoryTotalResponse, 0, len(totals))}
+	for _, total := range totals {
+		response.Totals = append(response.Totals, newCategoryTotalResponse(total))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// TagReport returns the totals per tag of a workspace.
+// Supported query parameters: from, to (YYYY-MM-DD), tagId (repeatable), tagMatch (any or all).
+func (h *ReportHandler) TagReport(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	filter, ok := parseReportFilter(c)
+	if !ok {
+		return
+	}
+
+	totals, err := h.tagReportUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, filter)
+	if err != nil {
+		respondTransactionError(c, err, "Failed to build tag report")
+		return
+	}
+
+	response := TagReportResponse{Totals: make([]TagTotalResponse, 0, len(totals))}
+	for _, total := range totals {
+		response.Totals = append(response.Totals, newTagTotalResponse(total))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// parseReportFilter reads the report query parameters, responding with 400 when they are malformed
+func parseReportFilter(c *gin.Context) (model.ReportFilter, bool) {
+	var filter model.ReportFilter
+	var ok bool
+	if filter.From, filter.To, ok = parseDateRangeQuery(c); !ok {
+		return filter, false
+	}
+	if filter.TagIDs, filter.TagMatch, ok = parseTagQuery(c); !ok {
+		return filter, false
+	}
+	return filter, true
+}
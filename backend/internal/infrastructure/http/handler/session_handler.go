@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/internal/application/usecase"
+	"backend/internal/infrastructure/session"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SessionHandler struct {
+	listSessionsUseCase  *usecase.ListSessionsUseCase
+	revokeSessionUseCase *usecase.RevokeSessionUseCase
+}
+
+func NewSessionHandler(
+	listSessionsUseCase *usecase.ListSessionsUseCase,
+	revokeSessionUseCase *usecase.RevokeSessionUseCase,
+) *SessionHandler {
+	return &SessionHandler{
+		listSessionsUseCase:  listSessionsUseCase,
+		revokeSessionUseCase: revokeSessionUseCase,
+	}
+}
+
+type SessionResponse struct {
+	ID         int    `json:"id"`
+	UserAgent  string `json:"userAgent"`
+	IPAddress  string `json:"ipAddress"`
+	LastSeenAt string `json:"lastSeenAt"`
+	ExpiresAt  string `json:"expiresAt"`
+	CreatedAt  string `json:"createdAt"`
+	Current    bool   `json:"current"`
+}
+
+type ListSessionsResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+}
+
+// ListSessions returns the signed-in devices of the current user
+func (h *SessionHandler) ListSessions(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+	currentID, _ := session.CurrentSessionID(c)
+
+	sessions, err := h.listSessionsUseCase.Execute(c.Request.Context(), principal.UserID())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Failed to list sessions",
+			Code:  "INTERNAL_ERROR",
+		})
+		return
+	}
+
+	response := ListSessionsResponse{Sessions: make([]SessionResponse, 0, len(sessions))}
+	for _, s := range sessions {
+		response.Sessions = append(response.Sessions, SessionResponse{
+			ID:         s.ID,
+			UserAgent:  s.UserAgent,
+			IPAddress:  s.IPAddress,
+			LastSeenAt: s.LastSeenAt.Format("2006-01-02T15:04:05Z"),
+			ExpiresAt:  s.ExpiresAt.Format("2006-01-02T15:04:05Z"),
+			CreatedAt:  s.CreatedAt.Format("2006-01-02T15:04:05Z"),
+			Current:    s.ID == currentID,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RevokeSession signs out one of the current user's sessions
+func (h *SessionHandler) RevokeSession(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	sessionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid session ID",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "id",
+			},
+		})
+		return
+	}
+	currentID, _ := session.CurrentSessionID(c)
+
+	if err := h.revokeSessionUseCase.Execute(c.Request.Context(), principal.UserID(), sessionID); err != nil {
+		if errors.Is(err, usecase.ErrSessionNotFound) {
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error: "Session not found",
+				Code:  "NOT_FOUND",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Failed to revoke session",
+			Code:  "INTERNAL_ERROR",
+		})
+		return
+	}
+
+	// Revoking the session this request came from also expires its cookie
+	if sessionID == currentID {
+		if err := session.ClearSession(c); err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error: "Failed to clear session",
+				Code:  "INTERNAL_ERROR",
+			})
+			return
+		}
+	}
+
+	c.Status(http.StatusNoContent)
+}
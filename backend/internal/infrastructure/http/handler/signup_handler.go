@@ -1,40 +1,78 @@
 package handler
 
 import (
+	"errors"
+	"log"
 	"net/http"
 
 	"backend/internal/application/usecase"
+	"backend/internal/domain/model"
 	"backend/internal/infrastructure/session"
 
 	"github.com/gin-gonic/gin"
 )
 
 type SignupHandler struct {
-	signupUseCase *usecase.SignupUseCase
+	signupUseCase                *usecase.SignupUseCase
+	signupWithInvitationUseCase  *usecase.SignupWithInvitationUseCase
+	sendEmailVerificationUseCase *usecase.SendEmailVerificationUseCase
 }
 
-func NewSignupHandler(signupUseCase *usecase.SignupUseCase) *SignupHandler {
-	return &SignupHandler{signupUseCase: signupUseCase}
+func NewSignupHandler(
+	signupUseCase *usecase.SignupUseCase,
+	signupWithInvitationUseCase *usecase.SignupWithInvitationUseCase,
+	sendEmailVerificationUseCase *usecase.SendEmailVerificationUseCase,
+) *SignupHandler {
+	return &SignupHandler{
+		signupUseCase:                signupUseCase,
+		signupWithInvitationUseCase:  signupWithInvitationUseCase,
+		sendEmailVerificationUseCase: sendEmailVerificationUseCase,
+	}
 }
 
 type SignupRequest struct {
-	Email         string `json:"email" binding:"required"`
-	Password      string `json:"password" binding:"required"`
-	WorkspaceName string `json:"workspaceName" binding:"required"`
+	Email           string `json:"email" binding:"required"`
+	Password        string `json:"password" binding:"required"`
+	WorkspaceName   string `json:"workspaceName"`   // Required unless InvitationToken is set
+	InvitationToken string `json:"invitationToken"` // Joins the invited workspace instead of creating one
 }
 
 type UserResponse struct {
-	ID        int    `json:"id"`
-	Email     string `json:"email"`
-	CreatedAt string `json:"createdAt"`
-	UpdatedAt string `json:"updatedAt"`
+	ID               int    `json:"id"`
+	Email            string `json:"email"`
+	EmailVerified    bool   `json:"emailVerified"`
+	TwoFactorEnabled bool   `json:"twoFactorEnabled"`
+	CreatedAt        string `json:"createdAt"`
+	UpdatedAt        string `json:"updatedAt"`
 }
 
 type WorkspaceResponse struct {
-	ID        int    `json:"id"`
-	Name      string `json:"name"`
-	CreatedAt string `json:"createdAt"`
-	UpdatedAt string `json:"updatedAt"`
+	ID                   int    `json:"id"`
+	Name                 string `json:"name"`
+	RequireVerifiedEmail bool   `json:"requireVerifiedEmail"`
+	CreatedAt            string `json:"createdAt"`
+	UpdatedAt            string `json:"updatedAt"`
+}
+
+func newUserResponse(user *model.User) UserResponse {
+	return UserResponse{
+		ID:               user.ID,
+		Email:            user.Email,
+		EmailVerified:    user.IsEmailVerified(),
+		TwoFactorEnabled: user.IsTwoFactorEnabled(),
+		CreatedAt:        user.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:        user.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+func newWorkspaceResponse(workspace *model.Workspace) WorkspaceResponse {
+	return WorkspaceResponse{
+		ID:                   workspace.ID,
+		Name:                 workspace.Name,
+		RequireVerifiedEmail: workspace.RequireVerifiedEmail,
+		CreatedAt:            workspace.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:            workspace.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
 }
 
 type SignupResponse struct {
@@ -64,15 +102,35 @@ func (h *SignupHandler) Signup(c *gin.Context) {
 	}
 
 	// Execute signup usecase
-	user, workspace, err := h.signupUseCase.Execute(
-		c.Request.Context(),
-		req.Email,
-		req.Password,
-		req.WorkspaceName,
+	var (
+		user      *model.User
+		workspace *model.Workspace
+		err       error
 	)
+	if req.InvitationToken != "" {
+		user, workspace, err = h.signupWithInvitationUseCase.Execute(
+			c.Request.Context(),
+			req.Email,
+			req.Password,
+			req.InvitationToken,
+		)
+	} else {
+		user, workspace, err = h.signupUseCase.Execute(
+			c.Request.Context(),
+			req.Email,
+			req.Password,
+			req.WorkspaceName,
+		)
+	}
 	if err != nil {
+		// Check for invitation errors
+		if errors.Is(err, usecase.ErrInvitationNotFound) || errors.Is(err, usecase.ErrInvitationEmailMismatch) {
+			respondInvitationTokenError(c, err, "Failed to create account")
+			return
+		}
+
 		// Check for duplicate email error
-		if err.Error() == "email already registered" {
+		if errors.Is(err, usecase.ErrEmailAlreadyRegistered) {
 			c.JSON(http.StatusConflict, ErrorResponse{
 				Error: "Email already registered",
 				Code:  "EMAIL_EXISTS",
@@ -101,48 +159,39 @@ func (h *SignupHandler) Signup(c *gin.Context) {
 		return
 	}
 
+	// Send verification email; the account is usable without it, so a failure is only logged
+	if !user.IsEmailVerified() {
+		if err := h.sendEmailVerificationUseCase.Execute(c.Request.Context(), user.ID); err != nil {
+			log.Printf("Failed to send verification email to user %d: %v", user.ID, err)
+		}
+	}
+
 	// Return success response
 	c.JSON(http.StatusCreated, SignupResponse{
-		User: UserResponse{
-			ID:        user.ID,
-			Email:     user.Email,
-			CreatedAt: user.CreatedAt.Format("2006-01-02T15:04:05Z"),
-			UpdatedAt: user.UpdatedAt.Format("2006-01-02T15:04:05Z"),
-		},
-		Workspace: WorkspaceResponse{
-			ID:        workspace.ID,
-			Name:      workspace.Name,
-			CreatedAt: workspace.CreatedAt.Format("2006-01-02T15:04:05Z"),
-			UpdatedAt: workspace.UpdatedAt.Format("2006-01-02T15:04:05Z"),
-		},
-		Message: "Account created successfully",
+		User:      newUserResponse(user),
+		Workspace: newWorkspaceResponse(workspace),
+		Message:   "Account created successfully",
 	})
 }
 
 // GetSession returns the current session information
 func (h *SignupHandler) GetSession(c *gin.Context) {
-	userID, email, workspaceID, err := session.GetSession(c)
-	if err != nil {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{
-			Error: "Not authenticated",
-			Code:  "UNAUTHENTICATED",
-			Details: map[string]interface{}{
-				"message": "No active session found",
-			},
-		})
+	principal, ok := currentPrincipal(c)
+	if !ok {
 		return
 	}
 
-	// In a real implementation, you would fetch user and workspace from the database
-	// For now, return basic session info
+	// A user left without any workspace has no active one until they create a workspace or are invited
+	var workspace *WorkspaceResponse
+	if principal.HasWorkspace() {
+		response := newWorkspaceResponse(principal.Workspace)
+		workspace = &response
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"authenticated": true,
-		"user": gin.H{
-			"id":    userID,
-			"email": email,
-		},
-		"workspace": gin.H{
-			"id": workspaceID,
-		},
+		"user":          newUserResponse(principal.User),
+		"workspace":     workspace,
+		"role":          principal.Role(),
 	})
 }
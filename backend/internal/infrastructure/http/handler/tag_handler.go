@@ -0,0 +1,258 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/internal/application/usecase"
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TagHandler struct {
+	listTagsUseCase  *usecase.ListTagsUseCase
+	createTagUseCase *usecase.CreateTagUseCase
+	updateTagUseCase *usecase.UpdateTagUseCase
+	deleteTagUseCase *usecase.DeleteTagUseCase
+}
+
+func NewTagHandler(
+	listTagsUseCase *usecase.ListTagsUseCase,
+	createTagUseCase *usecase.CreateTagUseCase,
+	updateTagUseCase *usecase.UpdateTagUseCase,
+	deleteTagUseCase *usecase.DeleteTagUseCase,
+) *TagHandler {
+	return &TagHandler{
+		listTagsUseCase:  listTagsUseCase,
+		createTagUseCase: createTagUseCase,
+		updateTagUseCase: updateTagUseCase,
+		deleteTagUseCase: deleteTagUseCase,
+	}
+}
+
+// TagRequest is the body of tag create and update requests
+type TagRequest struct {
+	Name  string `json:"name" binding:"required"`
+	Color string `json:"color"` // #RRGGBB
+}
+
+type TagResponse struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Color     string `json:"color"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type ListTagsResponse struct {
+	Tags []TagResponse `json:"tags"`
+}
+
+func newTagResponse(tag *model.Tag) TagResponse {
+	return TagResponse{
+		ID:        tag.ID,
+		Name:      tag.Name,
+		Color:     tag.Color,
+		CreatedAt: tag.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt: tag.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// ListTags returns the tags of a workspace ordered by name
+func (h *TagHandler) ListTags(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	tags, err := h.listTagsUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID)
+	if err != nil {
+		respondTagError(c, err, "Failed to list tags")
+		return
+	}
+
+	response := ListTagsResponse{Tags: make([]TagResponse, 0, len(tags))}
+	for _, tag := range tags {
+		response.Tags = append(response.Tags, newTagResponse(tag))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateTag creates a tag in a workspace
+func (h *TagHandler) CreateTag(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindTagRequest(c)
+	if !ok {
+		return
+	}
+
+	tag, err := h.createTagUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, input)
+	if err != nil {
+		respondTagError(c, err, "Failed to create tag")
+		return
+	}
+
+	c.JSON(http.StatusCreated, newTagResponse(tag))
+}
+
+// UpdateTag renames or recolors a tag
+func (h *TagHandler) UpdateTag(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	tagID, ok := tagIDParam(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindTagRequest(c)
+	if !ok {
+		return
+	}
+
+	tag, err := h.updateTagUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, tagID, input)
+	if err != nil {
+		respondTagError(c, err, "Failed to update tag")
+		return
+	}
+
+	c.JSON(http.StatusOK, newTagResponse(tag))
+}
+
+// DeleteTag deletes a tag and removes it from its transactions
+func (h *TagHandler) DeleteTag(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	tagID, ok := tagIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.deleteTagUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, tagID); err != nil {
+		respondTagError(c, err, "Failed to delete tag")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// bindTagRequest parses a tag request body, responding with 400 when it is malformed
+func bindTagRequest(c *gin.Context) (usecase.TagInput, bool) {
+	var req TagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return usecase.TagInput{}, false
+	}
+
+	return usecase.TagInput{
+		Name:  req.Name,
+		Color: req.Color,
+	}, true
+}
+
+// tagIDParam parses the :tagId path parameter, responding with 400 when it is malformed
+func tagIDParam(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("tagId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid tag ID",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "tagId",
+			},
+		})
+		return 0, false
+	}
+	return id, true
+}
+
+// parseTagQuery parses the repeatable tagId query parameter and tagMatch (any or all),
+// responding with 400 when a tag ID is malformed
+func parseTagQuery(c *gin.Context) ([]int, model.TagMatch, bool) {
+	var tagIDs []int
+	for _, value := range c.QueryArray("tagId") {
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			respondInvalidQuery(c, "tagId")
+			return nil, "", false
+		}
+		tagIDs = append(tagIDs, id)
+	}
+	return tagIDs, model.TagMatch(c.Query("tagMatch")), true
+}
+
+// tagValidationFields maps tag validation errors to the request field they concern
+var tagValidationFields = map[error]string{
+	service.ErrTagNameRequired: "name",
+	service.ErrInvalidTagColor: "color",
+}
+
+// respondTagError maps tag usecase errors to responses
+func respondTagError(c *gin.Context, err error, message string) {
+	for validationErr, field := range tagValidationFields {
+		if errors.Is(err, validationErr) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: validationErr.Error(),
+				Code:  "VALIDATION_ERROR",
+				Details: map[string]interface{}{
+					"field": field,
+				},
+			})
+			return
+		}
+	}
+
+	if errors.Is(err, usecase.ErrTagNotFound) {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "Tag not found",
+			Code:  "NOT_FOUND",
+		})
+		return
+	}
+	if errors.Is(err, usecase.ErrTagNameTaken) {
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error: "A tag with this name already exists",
+			Code:  "TAG_EXISTS",
+		})
+		return
+	}
+
+	respondWorkspaceError(c, err, message)
+}
@@ -0,0 +1,488 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"backend/internal/application/usecase"
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TransactionHandler struct {
+	listTransactionsUseCase  *usecase.ListTransactionsUseCase
+	createTransactionUseCase *usecase.CreateTransactionUseCase
+	updateTransactionUseCase *usecase.UpdateTransactionUseCase
+	deleteTransactionUseCase *usecase.DeleteTransactionUseCase
+}
+
+func NewTransactionHandler(
+	listTransactionsUseCase *usecase.ListTransactionsUseCase,
+	createTransactionUseCase *usecase.CreateTransactionUseCase,
+	updateTransactionUseCase *usecase.UpdateTransactionUseCase,
+	deleteTransactionUseCase *usecase.DeleteTransactionUseCase,
+) *TransactionHandler {
+	return &TransactionHandler{
+		listTransactionsUseCase:  listTransactionsUseCase,
+		createTransactionUseCase: createTransactionUseCase,
+		updateTransactionUseCase: updateTransactionUseCase,
+		deleteTransactionUseCase: deleteTransactionUseCase,
+	}
+}
+
+type TransactionRequest struct {
+	AccountID  int                       `json:"accountId" binding:"required"`
+	PostedOn   string                    `json:"postedOn" binding:"required"` // YYYY-MM-DD
+	Amount     *model.Decimal            `json:"amount" binding:"required"`   // Decimal string in the account currency; negative for outflows
+	Payee      string                    `json:"payee"`
+	PayeeID    *int                      `json:"payeeId"` // Resolved from payee on creation when omitted
+	Memo       string                    `json:"memo"`
+	CategoryID *int                      `json:"categoryId"`
+	Splits     []TransactionSplitRequest `json:"splits" binding:"dive"` // Omit categoryId when splitting
+	TagIDs     []int                     `json:"tagIds"`
+	Status     string                    `json:"status"` // uncleared (default), cleared or reconciled
+}
+
+type TransactionSplitRequest struct {
+	CategoryID *int           `json:"categoryId"`
+	Amount     *model.Decimal `json:"amount" binding:"required"` // The splits sum to the transaction amount
+	Memo       string         `json:"memo"`
+	TagIDs     []int          `json:"tagIds"` // In addition to the transaction's tags
+}
+
+type TransactionResponse struct {
+	ID               int                        `json:"id"`
+	AccountID        int                        `json:"accountId"`
+	PostedOn         string                     `json:"postedOn"`
+	Amount           string                     `json:"amount"`
+	Currency         string                     `json:"currency"`
+	Payee            string                     `json:"payee"`
+	PayeeID          *int                       `json:"payeeId"`
+	Memo             string                     `json:"memo"`
+	CategoryID       *int                       `json:"categoryId"`
+	Splits           []TransactionSplitResponse `json:"splits"`
+	TransferID       *int                       `json:"transferId"` // Set when the transaction is one side of a transfer
+	TagIDs           []int                      `json:"tagIds"`
+	ExternalID       *string                    `json:"externalId"` // Identifier the bank gave the transaction, for imported transactions
+	ValueDate        *string                    `json:"valueDate"`
+	CounterpartyIBAN string                     `json:"counterpartyIban"`
+	EndToEndID       string                     `json:"endToEndId"`
+	Status           string                     `json:"status"`
+	CreatedAt        string                     `json:"createdAt"`
+	UpdatedAt        string                     `json:"updatedAt"`
+}
+
+type TransactionSplitResponse struct {
+	ID         int    `json:"id"`
+	CategoryID *int   `json:"categoryId"`
+	Amount     string `json:"amount"`
+	Memo       string `json:"memo"`
+	TagIDs     []int  `json:"tagIds"`
+}
+
+type ListTransactionsResponse struct {
+	Transactions []TransactionResponse `json:"transactions"`
+}
+
+func newTransactionResponse(transaction *model.Transaction) TransactionResponse {
+	splits := make([]TransactionSplitResponse, 0, len(transaction.Splits))
+	for _, split := range transaction.Splits {
+		splits = append(splits, TransactionSplitResponse{
+			ID:         split.ID,
+			CategoryID: split.CategoryID,
+			Amount:     split.Amount.Amount(),
+			Memo:       split.Memo,
+			TagIDs:     append([]int{}, split.TagIDs...),
+		})
+	}
+
+	return TransactionResponse{
+		ID:               transaction.ID,
+		AccountID:        transaction.AccountID,
+		PostedOn:         transaction.PostedOn.Format("2006-01-02"),
+		Amount:           transaction.Amount.Amount(),
+		Currency:         transaction.Amount.Currency(),
+		Payee:            transaction.Payee,
+		PayeeID:          transaction.PayeeID,
+		Memo:             transaction.Memo,
+		CategoryID:       transaction.CategoryID,
+		Splits:           splits,
+		TransferID:       transaction.TransferID,
+		TagIDs:           append([]int{}, transaction.TagIDs...),
+		ExternalID:       transaction.ExternalID,
+		ValueDate:        formatDate(transaction.ValueDate),
+		CounterpartyIBAN: transaction.CounterpartyIBAN,
+		EndToEndID:       transaction.EndToEndID,
+		Status:           string(transaction.Status),
+		CreatedAt:        transaction.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:        transaction.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// ListTransactions returns the transactions of a workspace.
+// Supported query parameters: accountId, payeeId, categoryId (including subcategories), from, to (YYYY-MM-DD),
+// tagId (repeatable), tagMatch (any or all), minAmount, maxAmount, limit, offset.
+func (h *TransactionHandler) ListTransactions(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	filter, ok := parseTransactionFilter(c)
+	if !ok {
+		return
+	}
+
+	transactions, err := h.listTransactionsUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, filter)
+	if err != nil {
+		respondTransactionError(c, err, "Failed to list transactions")
+		return
+	}
+
+	response := ListTransactionsResponse{Transactions: make([]TransactionResponse, 0, len(transactions))}
+	for _, transaction := range transactions {
+		response.Transactions = append(response.Transactions, newTransactionResponse(transaction))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateTransaction records a transaction in a workspace
+func (h *TransactionHandler) CreateTransaction(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindTransactionRequest(c)
+	if !ok {
+		return
+	}
+
+	transaction, err := h.createTransactionUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, input)
+	if err != nil {
+		respondTransactionError(c, err, "Failed to create transaction")
+		return
+	}
+
+	c.JSON(http.StatusCreated, newTransactionResponse(transaction))
+}
+
+// UpdateTransaction replaces the editable fields of a transaction
+func (h *TransactionHandler) UpdateTransaction(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	transactionID, ok := transactionIDParam(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindTransactionRequest(c)
+	if !ok {
+		return
+	}
+
+	transaction, err := h.updateTransactionUseCase.Execute(
+		c.Request.Context(),
+		principal.UserID(),
+		workspaceID,
+		transactionID,
+		input,
+	)
+	if err != nil {
+		respondTransactionError(c, err, "Failed to update transaction")
+		return
+	}
+
+	c.JSON(http.StatusOK, newTransactionResponse(transaction))
+}
+
+// DeleteTransaction deletes a transaction
+func (h *TransactionHandler) DeleteTransaction(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	transactionID, ok := transactionIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.deleteTransactionUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, transactionID); err != nil {
+		respondTransactionError(c, err, "Failed to delete transaction")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// bindTransactionRequest parses a transaction request body, responding with 400 when it is malformed
+func bindTransactionRequest(c *gin.Context) (usecase.TransactionInput, bool) {
+	var req TransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return usecase.TransactionInput{}, false
+	}
+
+	postedOn, ok := parseDateField(c, "postedOn", &req.PostedOn)
+	if !ok {
+		return usecase.TransactionInput{}, false
+	}
+
+	splits := make([]usecase.TransactionSplitInput, 0, len(req.Splits))
+	for _, split := range req.Splits {
+		splits = append(splits, usecase.TransactionSplitInput{
+			CategoryID: split.CategoryID,
+			Amount:     *split.Amount,
+			Memo:       split.Memo,
+			TagIDs:     split.TagIDs,
+		})
+	}
+
+	return usecase.TransactionInput{
+		AccountID:  req.AccountID,
+		PostedOn:   *postedOn,
+		Amount:     *req.Amount,
+		Payee:      req.Payee,
+		PayeeID:    req.PayeeID,
+		Memo:       req.Memo,
+		CategoryID: req.CategoryID,
+		Splits:     splits,
+		TagIDs:     req.TagIDs,
+		Status:     model.TransactionStatus(req.Status),
+	}, true
+}
+
+// parseTransactionFilter reads the listing filter from the query string, responding with 400 when it is malformed
+func parseTransactionFilter(c *gin.Context) (model.TransactionFilter, bool) {
+	var filter model.TransactionFilter
+
+	intParams := map[string]*int{"limit": &filter.Limit, "offset": &filter.Offset}
+	for name, dest := range intParams {
+		if value := c.Query(name); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				respondInvalidQuery(c, name)
+				return filter, false
+			}
+			*dest = n
+		}
+	}
+
+	if value := c.Query("accountId"); value != "" {
+		accountID, err := strconv.Atoi(value)
+		if err != nil {
+			respondInvalidQuery(c, "accountId")
+			return filter, false
+		}
+		filter.AccountID = &accountID
+	}
+	if value := c.Query("payeeId"); value != "" {
+		payeeID, err := strconv.Atoi(value)
+		if err != nil {
+			respondInvalidQuery(c, "payeeId")
+			return filter, false
+		}
+		filter.PayeeID = &payeeID
+	}
+	if value := c.Query("categoryId"); value != "" {
+		categoryID, err := strconv.Atoi(value)
+		if err != nil {
+			respondInvalidQuery(c, "categoryId")
+			return filter, false
+		}
+		filter.CategoryIDs = []int{categoryID}
+	}
+
+	from, to, ok := parseDateRangeQuery(c)
+	if !ok {
+		return filter, false
+	}
+	filter.From, filter.To = from, to
+	if filter.TagIDs, filter.TagMatch, ok = parseTagQuery(c); !ok {
+		return filter, false
+	}
+
+	amountParams := map[string]**model.Decimal{"minAmount": &filter.MinAmount, "maxAmount": &filter.MaxAmount}
+	for name, dest := range amountParams {
+		if value := c.Query(name); value != "" {
+			amount, err := model.ParseDecimal(value)
+			if err != nil {
+				respondInvalidQuery(c, name)
+				return filter, false
+			}
+			*dest = &amount
+		}
+	}
+
+	return filter, true
+}
+
+// respondInvalidQuery responds with 400 for a malformed query parameter
+func respondInvalidQuery(c *gin.Context, name string) {
+	c.JSON(http.StatusBadRequest, ErrorResponse{
+		Error: "Invalid query parameter",
+		Code:  "VALIDATION_ERROR",
+		Details: map[string]interface{}{
+			"field": name,
+		},
+	})
+}
+
+// parseDateRangeQuery parses the optional from and to query parameters (YYYY-MM-DD),
+// responding with 400 when either is malformed
+func parseDateRangeQuery(c *gin.Context) (*time.Time, *time.Time, bool) {
+	var from, to *time.Time
+	dateParams := map[string]**time.Time{"from": &from, "to": &to}
+	for name, dest := range dateParams {
+		if value := c.Query(name); value != "" {
+			date, err := time.Parse("2006-01-02", value)
+			if err != nil {
+				respondInvalidQuery(c, name)
+				return nil, nil, false
+			}
+			*dest = &date
+		}
+	}
+	return from, to, true
+}
+
+// transactionIDParam parses the :transactionId path parameter, responding with 400 when it is malformed
+func transactionIDParam(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("transactionId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid transaction ID",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "transactionId",
+			},
+		})
+		return 0, false
+	}
+	return id, true
+}
+
+// respondTransactionError maps transaction usecase errors to responses
+func respondTransactionError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, service.ErrInvalidTransactionStatus):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: err.Error(),
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "status",
+			},
+		})
+	case errors.Is(err, model.ErrAmountPrecision), errors.Is(err, model.ErrAmountOverflow):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: err.Error(),
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "amount",
+			},
+		})
+	case errors.Is(err, service.ErrTooFewSplits),
+		errors.Is(err, service.ErrSplitsAmountMismatch),
+		errors.Is(err, service.ErrSplitTransactionCategory):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: err.Error(),
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "splits",
+			},
+		})
+	case errors.Is(err, service.ErrInvalidTagMatch):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: err.Error(),
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "tagMatch",
+			},
+		})
+	case errors.Is(err, service.ErrInvalidTransactionFilter):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: err.Error(),
+			Code:  "VALIDATION_ERROR",
+		})
+	case errors.Is(err, usecase.ErrAccountNotFound):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Account not found",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "accountId",
+			},
+		})
+	case errors.Is(err, usecase.ErrCategoryNotFound):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Category not found",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "categoryId",
+			},
+		})
+	case errors.Is(err, usecase.ErrPayeeNotFound):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Payee not found",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "payeeId",
+			},
+		})
+	case errors.Is(err, usecase.ErrTagNotFound):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Tag not found",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "tagIds",
+			},
+		})
+	case errors.Is(err, usecase.ErrTransactionNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "Transaction not found",
+			Code:  "NOT_FOUND",
+		})
+	case errors.Is(err, usecase.ErrTransactionInTransfer):
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error: "Transaction is part of a transfer",
+			Code:  "TRANSACTION_IN_TRANSFER",
+			Details: map[string]interface{}{
+				"message": "Edit, unlink or delete the transfer instead",
+			},
+		})
+	default:
+		respondWorkspaceError(c, err, message)
+	}
+}
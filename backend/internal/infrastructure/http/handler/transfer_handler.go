@@ -0,0 +1,434 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/internal/application/usecase"
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TransferHandler struct {
+	listTransfersUseCase  *usecase.ListTransfersUseCase
+	getTransferUseCase    *usecase.GetTransferUseCase
+	createTransferUseCase *usecase.CreateTransferUseCase
+	updateTransferUseCase *usecase.UpdateTransferUseCase
+	linkTransferUseCase   *usecase.LinkTransferUseCase
+	matchTransfersUseCase *usecase.MatchTransfersUseCase
+	unlinkTransferUseCase *usecase.UnlinkTransferUseCase
+	deleteTransferUseCase *usecase.DeleteTransferUseCase
+}
+
+func NewTransferHandler(
+	listTransfersUseCase *usecase.ListTransfersUseCase,
+	getTransferUseCase *usecase.GetTransferUseCase,
+	createTransferUseCase *usecase.CreateTransferUseCase,
+	updateTransferUseCase *usecase.UpdateTransferUseCase,
+	linkTransferUseCase *usecase.LinkTransferUseCase,
+	matchTransfersUseCase *usecase.MatchTransfersUseCase,
+	unlinkTransferUseCase *usecase.UnlinkTransferUseCase,
+	deleteTransferUseCase *usecase.DeleteTransferUseCase,
+) *TransferHandler {
+	return &TransferHandler{
+		listTransfersUseCase:  listTransfersUseCase,
+		getTransferUseCase:    getTransferUseCase,
+		createTransferUseCase: createTransferUseCase,
+		updateTransferUseCase: updateTransferUseCase,
+		linkTransferUseCase:   linkTransferUseCase,
+		matchTransfersUseCase: matchTransfersUseCase,
+		unlinkTransferUseCase: unlinkTransferUseCase,
+		deleteTransferUseCase: deleteTransferUseCase,
+	}
+}
+
+// TransferRequest is the body of transfer create and update requests
+type TransferRequest struct {
+	FromAccountID  int            `json:"fromAccountId" binding:"required"`
+	ToAccountID    int            `json:"toAccountId" binding:"required"`
+	PostedOn       string         `json:"postedOn" binding:"required"` // YYYY-MM-DD
+	ReceivedOn     *string        `json:"receivedOn"`                  // YYYY-MM-DD; defaults to postedOn
+	Amount         *model.Decimal `json:"amount" binding:"required"`   // Positive decimal string leaving the sending account, fee included
+	ReceivedAmount *model.Decimal `json:"receivedAmount"`              // Reaching the receiving account; required across currencies
+	FromFee        model.Decimal  `json:"fromFee"`                     // In the sending account currency
+	ToFee          model.Decimal  `json:"toFee"`                       // In the receiving account currency
+	Memo           string         `json:"memo"`
+	Status         string         `json:"status"` // Of both sides: uncleared (default), cleared or reconciled
+}
+
+// LinkTransferRequest is the body of requests linking two existing transactions as a transfer
+type LinkTransferRequest struct {
+	FromTransactionID int           `json:"fromTransactionId" binding:"required"`
+	ToTransactionID   int           `json:"toTransactionId" binding:"required"`
+	FromFee           model.Decimal `json:"fromFee"`
+	ToFee             model.Decimal `json:"toFee"`
+}
+
+type TransferResponse struct {
+	ID        int                 `json:"id"`
+	From      TransactionResponse `json:"from"`
+	To        TransactionResponse `json:"to"`
+	FromFee   string              `json:"fromFee"`
+	ToFee     string              `json:"toFee"`
+	CreatedAt string              `json:"createdAt"`
+}
+
+type ListTransfersResponse struct {
+	Transfers []TransferResponse `json:"transfers"`
+}
+
+func newTransferResponse(transfer *model.Transfer) TransferResponse {
+	return TransferResponse{
+		ID:        transfer.ID,
+		From:      newTransactionResponse(transfer.From),
+		To:        newTransactionResponse(transfer.To),
+		FromFee:   transfer.FromFee.Amount(),
+		ToFee:     transfer.ToFee.Amount(),
+		CreatedAt: transfer.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+func newListTransfersResponse(transfers []*model.Transfer) ListTransfersResponse {
+	response := ListTransfersResponse{Transfers: make([]TransferResponse, 0, len(transfers))}
+	for _, transfer := range transfers {
+		response.Transfers = append(response.Transfers, newTransferResponse(transfer))
+	}
+	return response
+}
+
+// ListTransfers returns the transfers of a workspace, newest first.
+// Supported query parameters: from, to (YYYY-MM-DD, on the sending side).
+func (h *TransferHandler) ListTransfers(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	from, to, ok := parseDateRangeQuery(c)
+	if !ok {
+		return
+	}
+
+	transfers, err := h.listTransfersUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, from, to)
+	if err != nil {
+		respondTransferError(c, err, "Failed to list transfers")
+		return
+	}
+
+	c.JSON(http.StatusOK, newListTransfersResponse(transfers))
+}
+
+// GetTransfer returns a single transfer with both of its transactions
+func (h *TransferHandler) GetTransfer(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	transferID, ok := transferIDParam(c)
+	if !ok {
+		return
+	}
+
+	transfer, err := h.getTransferUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, transferID)
+	if err != nil {
+		respondTransferError(c, err, "Failed to get transfer")
+		return
+	}
+
+	c.JSON(http.StatusOK, newTransferResponse(transfer))
+}
+
+// CreateTransfer records a transfer between two accounts of a workspace
+func (h *TransferHandler) CreateTransfer(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindTransferRequest(c)
+	if !ok {
+		return
+	}
+
+	transfer, err := h.createTransferUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, input)
+	if err != nil {
+		respondTransferError(c, err, "Failed to create transfer")
+		return
+	}
+
+	c.JSON(http.StatusCreated, newTransferResponse(transfer))
+}
+
+// UpdateTransfer replaces the editable fields of both sides of a transfer
+func (h *TransferHandler) UpdateTransfer(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	transferID, ok := transferIDParam(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindTransferRequest(c)
+	if !ok {
+		return
+	}
+
+	transfer, err := h.updateTransferUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, transferID, input)
+	if err != nil {
+		respondTransferError(c, err, "Failed to update transfer")
+		return
+	}
+
+	c.JSON(http.StatusOK, newTransferResponse(transfer))
+}
+
+// LinkTransfer links two existing transactions as the sides of a transfer
+func (h *TransferHandler) LinkTransfer(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req LinkTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return
+	}
+
+	transfer, err := h.linkTransferUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, usecase.LinkTransferInput{
+		FromTransactionID: req.FromTransactionID,
+		ToTransactionID:   req.ToTransactionID,
+		FromFee:           req.FromFee,
+		ToFee:             req.ToFee,
+	})
+	if err != nil {
+		respondTransferError(c, err, "Failed to link transfer")
+		return
+	}
+
+	c.JSON(http.StatusCreated, newTransferResponse(transfer))
+}
+
+// MatchTransfers links the unlinked transactions of a workspace that look like transfers
+// and returns the transfers it created.
+// Supported query parameters: from, to (YYYY-MM-DD), windowDays (0-31, default 3).
+func (h *TransferHandler) MatchTransfers(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	var input usecase.MatchTransfersInput
+	if input.From, input.To, ok = parseDateRangeQuery(c); !ok {
+		return
+	}
+	if value := c.Query("windowDays"); value != "" {
+		windowDays, err := strconv.Atoi(value)
+		if err != nil {
+			respondInvalidQuery(c, "windowDays")
+			return
+		}
+		input.WindowDays = &windowDays
+	}
+
+	transfers, err := h.matchTransfersUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, input)
+	if err != nil {
+		respondTransferError(c, err, "Failed to match transfers")
+		return
+	}
+
+	c.JSON(http.StatusOK, newListTransfersResponse(transfers))
+}
+
+// UnlinkTransfer removes the link between the two transactions of a transfer and returns them
+func (h *TransferHandler) UnlinkTransfer(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	transferID, ok := transferIDParam(c)
+	if !ok {
+		return
+	}
+
+	transactions, err := h.unlinkTransferUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, transferID)
+	if err != nil {
+		respondTransferError(c, err, "Failed to unlink transfer")
+		return
+	}
+
+	response := ListTransactionsResponse{Transactions: make([]TransactionResponse, 0, len(transactions))}
+	for _, transaction := range transactions {
+		response.Transactions = append(response.Transactions, newTransactionResponse(transaction))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteTransfer deletes a transfer together with both of its transactions
+func (h *TransferHandler) DeleteTransfer(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+	transferID, ok := transferIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.deleteTransferUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID, transferID); err != nil {
+		respondTransferError(c, err, "Failed to delete transfer")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// bindTransferRequest parses a transfer request body, responding with 400 when it is malformed
+func bindTransferRequest(c *gin.Context) (usecase.TransferInput, bool) {
+	var req TransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return usecase.TransferInput{}, false
+	}
+
+	postedOn, ok := parseDateField(c, "postedOn", &req.PostedOn)
+	if !ok {
+		return usecase.TransferInput{}, false
+	}
+	receivedOn, ok := parseDateField(c, "receivedOn", req.ReceivedOn)
+	if !ok {
+		return usecase.TransferInput{}, false
+	}
+
+	return usecase.TransferInput{
+		FromAccountID:  req.FromAccountID,
+		ToAccountID:    req.ToAccountID,
+		PostedOn:       *postedOn,
+		ReceivedOn:     receivedOn,
+		Amount:         *req.Amount,
+		ReceivedAmount: req.ReceivedAmount,
+		FromFee:        req.FromFee,
+		ToFee:          req.ToFee,
+		Memo:           req.Memo,
+		Status:         model.TransactionStatus(req.Status),
+	}, true
+}
+
+// transferIDParam parses the :transferId path parameter, responding with 400 when it is malformed
+func transferIDParam(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("transferId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid transfer ID",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "transferId",
+			},
+		})
+		return 0, false
+	}
+	return id, true
+}
+
+// transferValidationFields maps transfer validation errors to the request field they concern
+var transferValidationFields = map[error]string{
+	service.ErrTransferSameAccount:        "toAccountId",
+	service.ErrInvalidTransferDirection:   "amount",
+	service.ErrSplitTransfer:              "fromTransactionId",
+	service.ErrNegativeTransferFee:        "fromFee",
+	service.ErrTransferFeeTooLarge:        "fromFee",
+	service.ErrTransferAmountMismatch:     "receivedAmount",
+	usecase.ErrReceivedAmountRequired:     "receivedAmount",
+	usecase.ErrInvalidTransferMatchWindow: "windowDays",
+}
+
+// respondTransferError maps transfer usecase errors to responses
+func respondTransferError(c *gin.Context, err error, message string) {
+	for validationErr, field := range transferValidationFields {
+		if errors.Is(err, validationErr) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: validationErr.Error(),
+				Code:  "VALIDATION_ERROR",
+				Details: map[string]interface{}{
+					"field": field,
+				},
+			})
+			return
+		}
+	}
+
+	if errors.Is(err, usecase.ErrTransferNotFound) {
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "Transfer not found",
+			Code:  "NOT_FOUND",
+		})
+		return
+	}
+	if errors.Is(err, usecase.ErrTransactionInTransfer) {
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error: "Transaction is already part of a transfer",
+			Code:  "TRANSACTION_IN_TRANSFER",
+		})
+		return
+	}
+
+	respondTransactionError(c, err, message)
+}
@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+
+	"backend/internal/application/usecase"
+
+	"github.com/gin-gonic/gin"
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// qrCodeSize is the width and height of the enrollment QR code in pixels
+const qrCodeSize = 256
+
+type TwoFactorHandler struct {
+	setupTwoFactorUseCase   *usecase.SetupTwoFactorUseCase
+	enableTwoFactorUseCase  *usecase.EnableTwoFactorUseCase
+	disableTwoFactorUseCase *usecase.DisableTwoFactorUseCase
+}
+
+func NewTwoFactorHandler(
+	setupTwoFactorUseCase *usecase.SetupTwoFactorUseCase,
+	enableTwoFactorUseCase *usecase.EnableTwoFactorUseCase,
+	disableTwoFactorUseCase *usecase.DisableTwoFactorUseCase,
+) *TwoFactorHandler {
+	return &TwoFactorHandler{
+		setupTwoFactorUseCase:   setupTwoFactorUseCase,
+		enableTwoFactorUseCase:  enableTwoFactorUseCase,
+		disableTwoFactorUseCase: disableTwoFactorUseCase,
+	}
+}
+
+type TwoFactorSetupResponse struct {
+	Secret     string `json:"secret"`     // For manual entry in the authenticator app
+	OtpauthURI string `json:"otpauthUri"` // otpauth:// URI encoded in the QR code
+	QRCode     string `json:"qrCode"`     // PNG data URI of the QR code
+}
+
+type EnableTwoFactorRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type EnableTwoFactorResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+	Message       string   `json:"message"`
+}
+
+type DisableTwoFactorRequest struct {
+	Password string `json:"password" binding:"required"`
+	Code     string `json:"code" binding:"required"` // TOTP code or recovery code
+}
+
+// Setup starts two-factor enrollment for the current user
+func (h *TwoFactorHandler) Setup(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	setup, err := h.setupTwoFactorUseCase.Execute(c.Request.Context(), principal.UserID())
+	if err != nil {
+		respondTwoFactorError(c, err, "Failed to set up two-factor authentication")
+		return
+	}
+
+	png, err := qrcode.Encode(setup.URI, qrcode.Medium, qrCodeSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Failed to generate QR code",
+			Code:  "INTERNAL_ERROR",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, TwoFactorSetupResponse{
+		Secret:     setup.Secret,
+		OtpauthURI: setup.URI,
+		QRCode:     "data:image/png;base64," + base64.StdEncoding.EncodeToString(png),
+	})
+}
+
+// Enable confirms enrollment with a code from the authenticator app
+func (h *TwoFactorHandler) Enable(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	var req EnableTwoFactorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return
+	}
+
+	recoveryCodes, err := h.enableTwoFactorUseCase.Execute(c.Request.Context(), principal.UserID(), req.Code)
+	if err != nil {
+		respondTwoFactorError(c, err, "Failed to enable two-factor authentication")
+		return
+	}
+
+	c.JSON(http.StatusOK, EnableTwoFactorResponse{
+		RecoveryCodes: recoveryCodes,
+		Message:       "Two-factor authentication enabled. Store the recovery codes somewhere safe",
+	})
+}
+
+// Disable turns two-factor authentication off for the current user
+func (h *TwoFactorHandler) Disable(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	var req DisableTwoFactorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return
+	}
+
+	err := h.disableTwoFactorUseCase.Execute(c.Request.Context(), principal.UserID(), req.Password, req.Code)
+	if err != nil {
+		respondTwoFactorError(c, err, "Failed to disable two-factor authentication")
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{
+		Message: "Two-factor authentication disabled",
+	})
+}
+
+// respondTwoFactorError maps two-factor usecase errors to responses
+func respondTwoFactorError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidCredentials):
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Invalid password",
+			Code:  "INVALID_CREDENTIALS",
+		})
+	case errors.Is(err, usecase.ErrInvalidTwoFactorCode):
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid two-factor code",
+			Code:  "INVALID_TWO_FACTOR_CODE",
+		})
+	case errors.Is(err, usecase.ErrTwoFactorLocked):
+		c.JSON(http.StatusTooManyRequests, ErrorResponse{
+			Error: "Too many invalid two-factor codes; try again later",
+			Code:  "TWO_FACTOR_LOCKED",
+		})
+	case errors.Is(err, usecase.ErrTwoFactorAlreadyEnabled):
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error: "Two-factor authentication is already enabled",
+			Code:  "TWO_FACTOR_ALREADY_ENABLED",
+		})
+	case errors.Is(err, usecase.ErrTwoFactorNotSetUp):
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error: "Two-factor authentication has not been set up",
+			Code:  "TWO_FACTOR_NOT_SET_UP",
+		})
+	case errors.Is(err, usecase.ErrTwoFactorNotEnabled):
+		c.JSON(http.StatusConflict, ErrorResponse{
+			Error: "Two-factor authentication is not enabled",
+			Code:  "TWO_FACTOR_NOT_ENABLED",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: message,
+			Code:  "INTERNAL_ERROR",
+		})
+	}
+}
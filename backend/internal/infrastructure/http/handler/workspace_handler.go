@@ -0,0 +1,316 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"backend/internal/application/usecase"
+	"backend/internal/domain/model"
+	"backend/internal/domain/service"
+	"backend/internal/infrastructure/session"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WorkspaceHandler struct {
+	listWorkspacesUseCase          *usecase.ListWorkspacesUseCase
+	createWorkspaceUseCase         *usecase.CreateWorkspaceUseCase
+	switchWorkspaceUseCase         *usecase.SwitchWorkspaceUseCase
+	updateWorkspaceSettingsUseCase *usecase.UpdateWorkspaceSettingsUseCase
+	deleteWorkspaceUseCase         *usecase.DeleteWorkspaceUseCase
+	listMembersUseCase             *usecase.ListMembersUseCase
+}
+
+func NewWorkspaceHandler(
+	listWorkspacesUseCase *usecase.ListWorkspacesUseCase,
+	createWorkspaceUseCase *usecase.CreateWorkspaceUseCase,
+	switchWorkspaceUseCase *usecase.SwitchWorkspaceUseCase,
+	updateWorkspaceSettingsUseCase *usecase.UpdateWorkspaceSettingsUseCase,
+	deleteWorkspaceUseCase *usecase.DeleteWorkspaceUseCase,
+	listMembersUseCase *usecase.ListMembersUseCase,
+) *WorkspaceHandler {
+	return &WorkspaceHandler{
+		listWorkspacesUseCase:          listWorkspacesUseCase,
+		createWorkspaceUseCase:         createWorkspaceUseCase,
+		switchWorkspaceUseCase:         switchWorkspaceUseCase,
+		updateWorkspaceSettingsUseCase: updateWorkspaceSettingsUseCase,
+		deleteWorkspaceUseCase:         deleteWorkspaceUseCase,
+		listMembersUseCase:             listMembersUseCase,
+	}
+}
+
+type CreateWorkspaceRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+type UpdateWorkspaceSettingsRequest struct {
+	RequireVerifiedEmail *bool `json:"requireVerifiedEmail" binding:"required"`
+}
+
+type WorkspaceMembershipResponse struct {
+	Workspace WorkspaceResponse `json:"workspace"`
+	Role      string            `json:"role"`
+	Current   bool              `json:"current"`
+}
+
+type ListWorkspacesResponse struct {
+	Workspaces []WorkspaceMembershipResponse `json:"workspaces"`
+}
+
+type MemberResponse struct {
+	UserID           int    `json:"userId"`
+	Email            string `json:"email"`
+	Role             string `json:"role"`
+	JoinedAt         string `json:"joinedAt"`
+	TwoFactorEnabled bool   `json:"twoFactorEnabled"`
+}
+
+type ListMembersResponse struct {
+	Members []MemberResponse `json:"members"`
+}
+
+// ListWorkspaces returns the workspaces the current user belongs to
+func (h *WorkspaceHandler) ListWorkspaces(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	memberships, err := h.listWorkspacesUseCase.Execute(c.Request.Context(), principal.UserID())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Failed to list workspaces",
+			Code:  "INTERNAL_ERROR",
+		})
+		return
+	}
+
+	response := ListWorkspacesResponse{Workspaces: make([]WorkspaceMembershipResponse, 0, len(memberships))}
+	for _, m := range memberships {
+		response.Workspaces = append(response.Workspaces, WorkspaceMembershipResponse{
+			Workspace: newWorkspaceResponse(m.Workspace),
+			Role:      string(m.Role),
+			Current:   m.WorkspaceID == principal.WorkspaceID(),
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateWorkspace creates another workspace owned by the current user
+func (h *WorkspaceHandler) CreateWorkspace(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	var req CreateWorkspaceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return
+	}
+
+	workspace, err := h.createWorkspaceUseCase.Execute(c.Request.Context(), principal.UserID(), req.Name)
+	if err != nil {
+		if errors.Is(err, usecase.ErrWorkspaceNameRequired) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "Workspace name is required",
+				Code:  "VALIDATION_ERROR",
+				Details: map[string]interface{}{
+					"field": "name",
+				},
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Failed to create workspace",
+			Code:  "INTERNAL_ERROR",
+		})
+		return
+	}
+
+	// A session without an active workspace opens the one just created
+	if !principal.HasWorkspace() {
+		if err := session.SwitchWorkspace(c, workspace.ID); err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error: "Failed to switch workspace",
+				Code:  "INTERNAL_ERROR",
+			})
+			return
+		}
+	}
+
+	c.JSON(http.StatusCreated, WorkspaceMembershipResponse{
+		Workspace: newWorkspaceResponse(workspace),
+		Role:      string(model.RoleOwner),
+		Current:   !principal.HasWorkspace(),
+	})
+}
+
+// SwitchWorkspace makes another workspace of the current user the session's active workspace
+func (h *WorkspaceHandler) SwitchWorkspace(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	workspace, membership, err := h.switchWorkspaceUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID)
+	if err != nil {
+		respondWorkspaceError(c, err, "Failed to switch workspace")
+		return
+	}
+
+	if err := session.SwitchWorkspace(c, workspace.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: "Failed to update session",
+			Code:  "INTERNAL_ERROR",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, WorkspaceMembershipResponse{
+		Workspace: newWorkspaceResponse(workspace),
+		Role:      string(membership.Role),
+		Current:   true,
+	})
+}
+
+// UpdateSettings updates the settings of a workspace
+func (h *WorkspaceHandler) UpdateSettings(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req UpdateWorkspaceSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid request body",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"message": err.Error(),
+			},
+		})
+		return
+	}
+
+	workspace, err := h.updateWorkspaceSettingsUseCase.Execute(
+		c.Request.Context(),
+		principal.UserID(),
+		workspaceID,
+		*req.RequireVerifiedEmail,
+	)
+	if err != nil {
+		respondWorkspaceError(c, err, "Failed to update workspace")
+		return
+	}
+
+	c.JSON(http.StatusOK, newWorkspaceResponse(workspace))
+}
+
+// DeleteWorkspace deletes a workspace
+func (h *WorkspaceHandler) DeleteWorkspace(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.deleteWorkspaceUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID); err != nil {
+		respondWorkspaceError(c, err, "Failed to delete workspace")
+		return
+	}
+
+	// A session whose active workspace was deleted moves on to another of the user's workspaces
+	// on its next request, see middleware.RequireAuth
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListMembers returns the members of a workspace with their two-factor authentication status
+func (h *WorkspaceHandler) ListMembers(c *gin.Context) {
+	principal, ok := currentPrincipal(c)
+	if !ok {
+		return
+	}
+
+	workspaceID, ok := workspaceIDParam(c)
+	if !ok {
+		return
+	}
+
+	memberships, err := h.listMembersUseCase.Execute(c.Request.Context(), principal.UserID(), workspaceID)
+	if err != nil {
+		respondWorkspaceError(c, err, "Failed to list members")
+		return
+	}
+
+	response := ListMembersResponse{Members: make([]MemberResponse, 0, len(memberships))}
+	for _, m := range memberships {
+		response.Members = append(response.Members, MemberResponse{
+			UserID:           m.UserID,
+			Email:            m.User.Email,
+			Role:             string(m.Role),
+			JoinedAt:         m.JoinedAt.Format("2006-01-02T15:04:05Z"),
+			TwoFactorEnabled: m.User.IsTwoFactorEnabled(),
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// workspaceIDParam parses the :id path parameter, responding with 400 when it is malformed
+func workspaceIDParam(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: "Invalid workspace ID",
+			Code:  "VALIDATION_ERROR",
+			Details: map[string]interface{}{
+				"field": "id",
+			},
+		})
+		return 0, false
+	}
+	return id, true
+}
+
+// respondWorkspaceError maps workspace usecase errors to responses
+func respondWorkspaceError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, usecase.ErrWorkspaceNotFound):
+		c.JSON(http.StatusNotFound, ErrorResponse{
+			Error: "Workspace not found",
+			Code:  "NOT_FOUND",
+		})
+	case errors.Is(err, service.ErrPermissionDenied):
+		respondForbidden(c)
+	default:
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: message,
+			Code:  "INTERNAL_ERROR",
+		})
+	}
+}
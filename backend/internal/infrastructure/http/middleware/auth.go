@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"net/http"
+
+	"backend/internal/application/auth"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/http/handler"
+	"backend/internal/infrastructure/repositories"
+	"backend/internal/infrastructure/session"
+	"backend/internal/infrastructure/tenant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireAuth は認証済みのリクエストのみを通すミドルウェアを返す
+// セッションのユーザーとワークスペースをDBから読み込み、auth.Principal としてリクエストのコンテキストに格納する
+// セッションのワークスペースにアクセスできなくなった場合は、ユーザーの別のワークスペース（なければなし）に切り替える
+func RequireAuth(
+	userRepo *repositories.UserRepository,
+	workspaceRepo *repositories.WorkspaceRepository,
+	membershipRepo *repositories.MembershipRepository,
+) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, _, workspaceID, err := session.GetSession(c)
+		if err != nil {
+			abortUnauthenticated(c, "No active session found")
+			return
+		}
+
+		ctx := c.Request.Context()
+
+		user, err := userRepo.GetUserByID(ctx, userID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				abortUnauthenticated(c, "Session user no longer exists")
+				return
+			}
+			abortInternalError(c)
+			return
+		}
+
+		// The user must still be a member of the workspace stored in the session. When the workspace was
+		// deleted or the membership removed, the session moves on to another workspace of the user, or to
+		// none at all, so that the user can still list, create and switch workspaces or log out.
+		membership, err := membershipRepo.GetMembership(ctx, workspaceID, user.ID)
+		if err != nil && !ent.IsNotFound(err) {
+			abortInternalError(c)
+			return
+		}
+		if membership == nil {
+			memberships, err := membershipRepo.ListMembershipsByUserID(ctx, user.ID)
+			if err != nil {
+				abortInternalError(c)
+				return
+			}
+			workspaceID = 0
+			if len(memberships) > 0 {
+				membership = memberships[0]
+				workspaceID = membership.WorkspaceID
+			}
+			if err := session.SwitchWorkspace(c, workspaceID); err != nil {
+				abortInternalError(c)
+				return
+			}
+		}
+
+		principal := &auth.Principal{User: user}
+		if membership != nil {
+			workspace, err := workspaceRepo.GetWorkspaceByID(ctx, workspaceID)
+			if err != nil {
+				abortInternalError(c)
+				return
+			}
+			principal.Workspace = workspace
+			principal.Membership = membership
+			// ワークスペース所有のエンティティはセッションのワークスペースに限定される
+			ctx = tenant.WithWorkspace(ctx, workspace.ID)
+		}
+		ctx = auth.WithPrincipal(ctx, principal)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
+
+func abortUnauthenticated(c *gin.Context, message string) {
+	c.AbortWithStatusJSON(http.StatusUnauthorized, handler.ErrorResponse{
+		Error: "Not authenticated",
+		Code:  "UNAUTHENTICATED",
+		Details: map[string]interface{}{
+			"message": message,
+		},
+	})
+}
+
+func abortInternalError(c *gin.Context) {
+	c.AbortWithStatusJSON(http.StatusInternalServerError, handler.ErrorResponse{
+		Error: "Failed to authenticate request",
+		Code:  "INTERNAL_ERROR",
+	})
+}
+
+// RequireVerifiedEmail は財務データへのアクセスを制御するミドルウェアを返す
+// ワークスペースがメールアドレス確認を必須にしている場合、未確認のユーザーを 403 で拒否する
+// RequireAuth の後に適用すること
+func RequireVerifiedEmail() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := auth.PrincipalFromContext(c.Request.Context())
+		if !ok {
+			abortUnauthenticated(c, "No active session found")
+			return
+		}
+
+		if !principal.HasWorkspace() || !principal.Workspace.CanAccessFinancialData(principal.User) {
+			c.AbortWithStatusJSON(http.StatusForbidden, handler.ErrorResponse{
+				Error: "Email address must be verified to access this workspace's data",
+				Code:  "EMAIL_NOT_VERIFIED",
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+
+	"backend/internal/application/auth"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/http/handler"
+	"backend/internal/infrastructure/repositories"
+	"backend/internal/infrastructure/tenant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireWorkspaceMember はパスの :id のワークスペースのメンバーのみを通すミドルウェアを返す
+// auth.Principal をそのワークスペースのものに差し替えるため、後続の RequireVerifiedEmail はパスのワークスペースの設定で判定される
+// RequireAuth の後に適用すること
+func RequireWorkspaceMember(
+	workspaceRepo *repositories.WorkspaceRepository,
+	membershipRepo *repositories.MembershipRepository,
+) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			abortUnauthenticated(c, "No active session found")
+			return
+		}
+
+		workspaceID, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, handler.ErrorResponse{
+				Error: "Invalid workspace ID",
+				Code:  "VALIDATION_ERROR",
+				Details: map[string]interface{}{
+					"field": "id",
+				},
+			})
+			return
+		}
+
+		// 非メンバーにはワークスペースの存在自体を明かさない
+		membership, err := membershipRepo.GetMembership(ctx, workspaceID, principal.UserID())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.AbortWithStatusJSON(http.StatusNotFound, handler.ErrorResponse{
+					Error: "Workspace not found",
+					Code:  "NOT_FOUND",
+				})
+				return
+			}
+			abortInternalError(c)
+			return
+		}
+
+		workspace, err := workspaceRepo.GetWorkspaceByID(ctx, workspaceID)
+		if err != nil {
+			abortInternalError(c)
+			return
+		}
+
+		scoped := &auth.Principal{User: principal.User, Workspace: workspace, Membership: membership}
+		ctx = auth.WithPrincipal(ctx, scoped)
+		ctx = tenant.WithWorkspace(ctx, workspace.ID)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
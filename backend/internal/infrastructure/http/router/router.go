@@ -7,7 +7,28 @@ import (
 )
 
 // SetupRouter はGinルーターをセットアップする
-func SetupRouter(signupHandler *handler.SignupHandler) *gin.Engine {
+func SetupRouter(
+	signupHandler *handler.SignupHandler,
+	loginHandler *handler.LoginHandler,
+	sessionHandler *handler.SessionHandler,
+	passwordResetHandler *handler.PasswordResetHandler,
+	emailVerificationHandler *handler.EmailVerificationHandler,
+	workspaceHandler *handler.WorkspaceHandler,
+	twoFactorHandler *handler.TwoFactorHandler,
+	invitationHandler *handler.InvitationHandler,
+	accountHandler *handler.AccountHandler,
+	transactionHandler *handler.TransactionHandler,
+	categoryHandler *handler.CategoryHandler,
+	tagHandler *handler.TagHandler,
+	payeeHandler *handler.PayeeHandler,
+	reportHandler *handler.ReportHandler,
+	transferHandler *handler.TransferHandler,
+	recurrenceHandler *handler.RecurrenceHandler,
+	importHandler *handler.ImportHandler,
+	qifHandler *handler.QIFHandler,
+	requireAuth gin.HandlerFunc,
+	requireWorkspaceMember gin.HandlerFunc,
+) *gin.Engine {
 	// 1. Ginエンジンの初期化
 	r := gin.Default()
 
@@ -17,10 +38,115 @@ func SetupRouter(signupHandler *handler.SignupHandler) *gin.Engine {
 	// 3. APIエンドポイントの登録
 	api := r.Group("/api")
 	{
+		// 認証不要のエンドポイント
 		auth := api.Group("/auth")
 		{
 			auth.POST("/signup", signupHandler.Signup)
-			auth.GET("/session", signupHandler.GetSession)
+			auth.POST("/login", loginHandler.Login)
+			auth.POST("/login/2fa", loginHandler.VerifyTwoFactor)
+			auth.POST("/password/forgot", passwordResetHandler.ForgotPassword)
+			auth.POST("/password/reset", passwordResetHandler.ResetPassword)
+			auth.POST("/verify-email", emailVerificationHandler.VerifyEmail)
+			// セッションが無効になっていても常にCookieを削除できるよう、認証なしで受け付ける
+			auth.POST("/logout", loginHandler.Logout)
+		}
+
+		// 認証必須のエンドポイント
+		authed := api.Group("", requireAuth)
+		{
+			authedAuth := authed.Group("/auth")
+			{
+				authedAuth.GET("/session", signupHandler.GetSession)
+				authedAuth.GET("/sessions", sessionHandler.ListSessions)
+				authedAuth.DELETE("/sessions/:id", sessionHandler.RevokeSession)
+				authedAuth.POST("/verify-email/resend", emailVerificationHandler.ResendVerification)
+				authedAuth.POST("/2fa/setup", twoFactorHandler.Setup)
+				authedAuth.POST("/2fa/enable", twoFactorHandler.Enable)
+				authedAuth.POST("/2fa/disable", twoFactorHandler.Disable)
+			}
+
+			workspaces := authed.Group("/workspaces")
+			{
+				workspaces.GET("", workspaceHandler.ListWorkspaces)
+				workspaces.POST("", workspaceHandler.CreateWorkspace)
+				workspaces.POST("/:id/switch", workspaceHandler.SwitchWorkspace)
+				workspaces.PATCH("/:id", workspaceHandler.UpdateSettings)
+				workspaces.DELETE("/:id", workspaceHandler.DeleteWorkspace)
+				workspaces.GET("/:id/members", workspaceHandler.ListMembers)
+				workspaces.POST("/:id/invitations", invitationHandler.CreateInvitation)
+				workspaces.GET("/:id/invitations", invitationHandler.ListInvitations)
+				workspaces.DELETE("/:id/invitations/:invitationId", invitationHandler.RevokeInvitation)
+
+				// 財務データのエンドポイント（ワークスペースのメール確認設定に従う）
+				// 対象のワークスペースは常にパスの :id で指定し、セッションのアクティブなワークスペースには依存しない
+				financial := workspaces.Group("/:id", requireWorkspaceMember, middleware.RequireVerifiedEmail())
+				{
+					financial.GET("/accounts", accountHandler.ListAccounts)
+					financial.POST("/accounts", accountHandler.CreateAccount)
+					financial.GET("/accounts/:accountId", accountHandler.GetAccount)
+					financial.PUT("/accounts/:accountId", accountHandler.UpdateAccount)
+					financial.DELETE("/accounts/:accountId", accountHandler.DeleteAccount)
+					financial.GET("/categories", categoryHandler.ListCategories)
+					financial.POST("/categories", categoryHandler.CreateCategory)
+					financial.PUT("/categories/:categoryId", categoryHandler.UpdateCategory)
+					financial.POST("/categories/:categoryId/merge", categoryHandler.MergeCategory)
+					financial.GET("/tags", tagHandler.ListTags)
+					financial.POST("/tags", tagHandler.CreateTag)
+					financial.PUT("/tags/:tagId", tagHandler.UpdateTag)
+					financial.DELETE("/tags/:tagId", tagHandler.DeleteTag)
+					financial.GET("/payees", payeeHandler.ListPayees)
+					financial.POST("/payees", payeeHandler.CreatePayee)
+					financial.POST("/payees/resolve", payeeHandler.ResolvePayee)
+					financial.PUT("/payees/:payeeId", payeeHandler.UpdatePayee)
+					financial.DELETE("/payees/:payeeId", payeeHandler.DeletePayee)
+					financial.POST("/payees/:payeeId/merge", payeeHandler.MergePayee)
+
+					financial.GET("/transactions", transactionHandler.ListTransactions)
+					financial.POST("/transactions", transactionHandler.CreateTransaction)
+					financial.PUT("/transactions/:transactionId", transactionHandler.UpdateTransaction)
+					financial.DELETE("/transactions/:transactionId", transactionHandler.DeleteTransaction)
+
+					// 口座間の振替（出金側と入金側の取引をリンク）
+					financial.GET("/transfers", transferHandler.ListTransfers)
+					financial.POST("/transfers", transferHandler.CreateTransfer)
+					financial.POST("/transfers/link", transferHandler.LinkTransfer)
+					financial.POST("/transfers/match", transferHandler.MatchTransfers)
+					financial.GET("/transfers/:transferId", transferHandler.GetTransfer)
+					financial.PUT("/transfers/:transferId", transferHandler.UpdateTransfer)
+					financial.DELETE("/transfers/:transferId", transferHandler.DeleteTransfer)
+					financial.POST("/transfers/:transferId/unlink", transferHandler.UnlinkTransfer)
+
+					// 定期的な取引と支払予定（発生分はスケジューラーが自動で記帳）
+					financial.GET("/recurrences", recurrenceHandler.ListRecurrenceRules)
+					financial.POST("/recurrences", recurrenceHandler.CreateRecurrenceRule)
+					financial.GET("/recurrences/upcoming", recurrenceHandler.ListUpcomingOccurrences)
+					financial.POST("/recurrences/occurrences/:occurrenceId/skip", recurrenceHandler.SkipOccurrence)
+					financial.POST("/recurrences/occurrences/:occurrenceId/post", recurrenceHandler.PostOccurrence)
+					financial.GET("/recurrences/:ruleId", recurrenceHandler.GetRecurrenceRule)
+					financial.PUT("/recurrences/:ruleId", recurrenceHandler.UpdateRecurrenceRule)
+					financial.DELETE("/recurrences/:ruleId", recurrenceHandler.DeleteRecurrenceRule)
+
+					// 明細ファイルの取り込み（CSVの列マッピングは口座ごとにプロファイルとして保存）
+					financial.GET("/imports", importHandler.ListImports)
+					financial.POST("/imports", importHandler.UploadStatement)
+					financial.GET("/imports/profiles", importHandler.ListImportProfiles)
+					financial.POST("/imports/profiles", importHandler.CreateImportProfile)
+					financial.PUT("/imports/profiles/:profileId", importHandler.UpdateImportProfile)
+					financial.DELETE("/imports/profiles/:profileId", importHandler.DeleteImportProfile)
+					financial.POST("/imports/:importId/preview", importHandler.PreviewImport)
+					financial.POST("/imports/:importId/commit", importHandler.CommitImport)
+					financial.DELETE("/imports/:importId", importHandler.DeleteImport)
+					financial.POST("/imports/qif", qifHandler.ImportQIF)
+
+					// 旧来の家計簿ソフト向けのQIF形式での書き出し
+					financial.GET("/exports/qif", qifHandler.ExportQIF)
+
+					financial.GET("/reports/categories", reportHandler.CategoryReport)
+					financial.GET("/reports/tags", reportHandler.TagReport)
+				}
+			}
+
+			authed.POST("/invitations/accept", invitationHandler.AcceptInvitation)
 		}
 	}
 
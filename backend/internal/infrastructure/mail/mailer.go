@@ -0,0 +1,15 @@
+package mail
+
+import "context"
+
+// Message is a plain-text email
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers emails. SMTPMailer is used in production and OutboxMailer for local development and tests.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
@@ -0,0 +1,46 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// OutboxMailer writes each email as an .eml file into a directory instead of sending it.
+// It is meant for local development and tests, where the files can be opened or inspected.
+type OutboxMailer struct {
+	dir  string
+	from string
+
+	mu  sync.Mutex
+	seq int
+}
+
+// NewOutboxMailer creates an OutboxMailer writing into dir
+func NewOutboxMailer(dir, from string) *OutboxMailer {
+	return &OutboxMailer{dir: dir, from: from}
+}
+
+// Send writes the message to the outbox directory
+func (m *OutboxMailer) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create outbox directory: %w", err)
+	}
+
+	m.mu.Lock()
+	m.seq++
+	name := fmt.Sprintf("%s-%04d.eml", time.Now().Format("20060102T150405"), m.seq)
+	m.mu.Unlock()
+
+	path := filepath.Join(m.dir, name)
+	if err := os.WriteFile(path, formatMessage(m.from, msg), 0o600); err != nil {
+		return fmt.Errorf("failed to write mail to outbox: %w", err)
+	}
+	return nil
+}
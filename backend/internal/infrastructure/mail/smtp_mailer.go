@@ -0,0 +1,57 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// SMTPMailer sends emails through an SMTP server
+type SMTPMailer struct {
+	addr string
+	host string
+	from string
+	auth smtp.Auth
+}
+
+// NewSMTPMailer creates an SMTPMailer. Authentication is skipped when username is empty.
+func NewSMTPMailer(host, port, username, password, from string) *SMTPMailer {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &SMTPMailer{
+		addr: net.JoinHostPort(host, port),
+		host: host,
+		from: from,
+		auth: auth,
+	}
+}
+
+// Send delivers the message via SMTP
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := smtp.SendMail(m.addr, m.auth, m.from, []string{msg.To}, formatMessage(m.from, msg)); err != nil {
+		return fmt.Errorf("failed to send mail via %s: %w", m.host, err)
+	}
+	return nil
+}
+
+// formatMessage renders the message as an RFC 5322 document
+func formatMessage(from string, msg Message) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
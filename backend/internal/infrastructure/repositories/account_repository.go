@@ -0,0 +1,308 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/journalentry"
+	"backend/internal/infrastructure/ent/posting"
+)
+
+// nominalAccountTypes are the account types maintained by the journal rather than by users
+var nominalAccountTypes = []account.Type{account.TypeIncome, account.TypeExpense, account.TypeTransfer}
+
+// nominalAccountNames are the names given to income, expense and transfer accounts when they are created
+var nominalAccountNames = map[model.AccountType]string{
+	model.AccountTypeIncome:   "Income",
+	model.AccountTypeExpense:  "Expenses",
+	model.AccountTypeTransfer: "Transfers in transit",
+}
+
+type AccountRepository struct {
+	client *ent.Client
+}
+
+func NewAccountRepository(client *ent.Client) *AccountRepository {
+	return &AccountRepository{client: client}
+}
+
+// CreateAccount stores a new account in the account's workspace
+func (r *AccountRepository) CreateAccount(ctx context.Context, a *model.Account) (*model.Account, error) {
+	entAccount, err := r.client.Account.
+		Create().
+		SetWorkspaceID(a.WorkspaceID).
+		SetName(a.Name).
+		SetType(account.Type(a.Type)).
+		SetInstitution(a.Institution).
+		SetCurrency(a.Currency).
+		SetOpeningBalance(a.OpeningBalance.Decimal()).
+		SetNillableOpenedOn(a.OpenedOn).
+		SetNillableClosedOn(a.ClosedOn).
+		SetArchived(a.Archived).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := toAccountModel(entAccount)
+	if err != nil {
+		return nil, err
+	}
+	created.Balance = created.OpeningBalance
+	return created, nil
+}
+
+// GetAccount retrieves an account of the workspace by ID, with its balance.
+// Income, expense and transfer accounts are maintained by the journal and never returned.
+func (r *AccountRepository) GetAccount(ctx context.Context, workspaceID, id int) (*model.Account, error) {
+	entAccount, err := r.client.Account.
+		Query().
+		Where(
+			account.ID(id),
+			account.WorkspaceID(workspaceID),
+			account.TypeNotIn(nominalAccountTypes...),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toAccountModelWithBalance(ctx, entAccount)
+}
+
+// GetOrCreateNominalAccount returns the income, expense or transfer account of the workspace in the currency,
+// creating it on first use
+func (r *AccountRepository) GetOrCreateNominalAccount(
+	ctx context.Context,
+	workspaceID int,
+	accountType model.AccountType,
+	currency string,
+) (*model.Account, error) {
+	entAccount, err := r.client.Account.
+		Query().
+		Where(
+			account.WorkspaceID(workspaceID),
+			account.TypeEQ(account.Type(accountType)),
+			account.Currency(currency),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		entAccount, err = r.client.Account.
+			Create().
+			SetWorkspaceID(workspaceID).
+			SetName(nominalAccountNames[accountType]).
+			SetType(account.Type(accountType)).
+			SetCurrency(currency).
+			SetOpeningBalance(model.Decimal{}).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toAccountModel(entAccount)
+}
+
+// ListAccounts retrieves the accounts of a workspace with their balances ordered by name,
+// optionally including archived ones. Accounts maintained by the journal are left out.
+func (r *AccountRepository) ListAccounts(ctx context.Context, workspaceID int, includeArchived bool) ([]*model.Account, error) {
+	query := r.client.Account.
+		Query().
+		Where(
+			account.WorkspaceID(workspaceID),
+			account.TypeNotIn(nominalAccountTypes...),
+		)
+	if !includeArchived {
+		query = query.Where(account.Archived(false))
+	}
+
+	entAccounts, err := query.
+		Order(ent.Asc(account.FieldName), ent.Asc(account.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*model.Account, 0, len(entAccounts))
+	for _, entAccount := range entAccounts {
+		a, err := toAccountModel(entAccount)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, a)
+	}
+	if err := r.setBalances(ctx, accounts); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// UpdateAccount overwrites the editable fields of an account; the currency cannot change
+func (r *AccountRepository) UpdateAccount(ctx context.Context, a *model.Account) (*model.Account, error) {
+	update := r.client.Account.
+		UpdateOneID(a.ID).
+		Where(
+			account.WorkspaceID(a.WorkspaceID),
+			account.TypeNotIn(nominalAccountTypes...),
+		).
+		SetName(a.Name).
+		SetType(account.Type(a.Type)).
+		SetInstitution(a.Institution).
+		SetOpeningBalance(a.OpeningBalance.Decimal()).
+		SetArchived(a.Archived)
+	if a.OpenedOn != nil {
+		update.SetOpenedOn(*a.OpenedOn)
+	} else {
+		update.ClearOpenedOn()
+	}
+	if a.ClosedOn != nil {
+		update.SetClosedOn(*a.ClosedOn)
+	} else {
+		update.ClearClosedOn()
+	}
+
+	entAccount, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toAccountModelWithBalance(ctx, entAccount)
+}
+
+// DeleteAccount deletes an account of the workspace and reports whether one was deleted
+func (r *AccountRepository) DeleteAccount(ctx context.Context, workspaceID, id int) (bool, error) {
+	n, err := r.client.Account.
+		Delete().
+		Where(
+			account.ID(id),
+			account.WorkspaceID(workspaceID),
+			account.TypeNotIn(nominalAccountTypes...),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// BalanceAsOf returns the balance of an account at the end of a day: its opening balance plus the postings
+// of journal entries up to that day
+func (r *AccountRepository) BalanceAsOf(ctx context.Context, workspaceID, id int, asOf time.Time) (model.Money, error) {
+	entAccount, err := r.client.Account.
+		Query().
+		Where(
+			account.ID(id),
+			account.WorkspaceID(workspaceID),
+		).
+		Only(ctx)
+	if err != nil {
+		return model.Money{}, err
+	}
+	a, err := toAccountModel(entAccount)
+	if err != nil {
+		return model.Money{}, err
+	}
+
+	var totals []struct {
+		AccountID int           `json:"account_id"`
+		Total     model.Decimal `json:"total"`
+	}
+	err = r.client.Posting.
+		Query().
+		Where(
+			posting.AccountID(id),
+			posting.HasJournalEntryWith(journalentry.PostedOnLTE(asOf)),
+		).
+		GroupBy(posting.FieldAccountID).
+		Aggregate(ent.As(ent.Sum(posting.FieldAmount), "total")).
+		Scan(ctx, &totals)
+	if err != nil {
+		return model.Money{}, fmt.Errorf("failed to sum postings: %w", err)
+	}
+	var total model.Decimal
+	if len(totals) > 0 {
+		total = totals[0].Total
+	}
+
+	totalMoney, err := model.MoneyFromDecimal(total, a.Currency)
+	if err != nil {
+		return model.Money{}, fmt.Errorf("account %d: %w", a.ID, err)
+	}
+	return a.OpeningBalance.Add(totalMoney)
+}
+
+// setBalances derives the balance of each account from its opening balance and its postings
+func (r *AccountRepository) setBalances(ctx context.Context, accounts []*model.Account) error {
+	if len(accounts) == 0 {
+		return nil
+	}
+	ids := make([]int, 0, len(accounts))
+	for _, a := range accounts {
+		ids = append(ids, a.ID)
+	}
+
+	var totals []struct {
+		AccountID int           `json:"account_id"`
+		Total     model.Decimal `json:"total"`
+	}
+	err := r.client.Posting.
+		Query().
+		Where(posting.AccountIDIn(ids...)).
+		GroupBy(posting.FieldAccountID).
+		Aggregate(ent.As(ent.Sum(posting.FieldAmount), "total")).
+		Scan(ctx, &totals)
+	if err != nil {
+		return fmt.Errorf("failed to sum postings: %w", err)
+	}
+	totalByAccount := make(map[int]model.Decimal, len(totals))
+	for _, t := range totals {
+		totalByAccount[t.AccountID] = t.Total
+	}
+
+	for _, a := range accounts {
+		total, err := model.MoneyFromDecimal(totalByAccount[a.ID], a.Currency)
+		if err != nil {
+			return fmt.Errorf("account %d: %w", a.ID, err)
+		}
+		if a.Balance, err = a.OpeningBalance.Add(total); err != nil {
+			return fmt.Errorf("account %d: %w", a.ID, err)
+		}
+	}
+	return nil
+}
+
+// toAccountModelWithBalance converts ent.Account to domain model Account and derives its balance
+func (r *AccountRepository) toAccountModelWithBalance(ctx context.Context, entAccount *ent.Account) (*model.Account, error) {
+	a, err := toAccountModel(entAccount)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.setBalances(ctx, []*model.Account{a}); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// toAccountModel converts ent.Account to domain model Account; the balance is left unset
+func toAccountModel(entAccount *ent.Account) (*model.Account, error) {
+	openingBalance, err := model.MoneyFromDecimal(entAccount.OpeningBalance, entAccount.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("account %d: %w", entAccount.ID, err)
+	}
+
+	return &model.Account{
+		ID:             entAccount.ID,
+		WorkspaceID:    entAccount.WorkspaceID,
+		Name:           entAccount.Name,
+		Type:           model.AccountType(entAccount.Type),
+		Institution:    entAccount.Institution,
+		Currency:       entAccount.Currency,
+		OpeningBalance: openingBalance,
+		OpenedOn:       entAccount.OpenedOn,
+		ClosedOn:       entAccount.ClosedOn,
+		Archived:       entAccount.Archived,
+		CreatedAt:      entAccount.CreatedAt,
+		UpdatedAt:      entAccount.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,139 @@
+package repositories
+
+import (
+	"context"
+
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/category"
+)
+
+type CategoryRepository struct {
+	client *ent.Client
+}
+
+func NewCategoryRepository(client *ent.Client) *CategoryRepository {
+	return &CategoryRepository{client: client}
+}
+
+// CreateCategory stores a new category in the category's workspace
+func (r *CategoryRepository) CreateCategory(ctx context.Context, c *model.Category) (*model.Category, error) {
+	entCategory, err := r.client.Category.
+		Create().
+		SetWorkspaceID(c.WorkspaceID).
+		SetNillableParentID(c.ParentID).
+		SetName(c.Name).
+		SetKind(category.Kind(c.Kind)).
+		SetColor(c.Color).
+		SetIcon(c.Icon).
+		SetArchived(c.Archived).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toCategoryModel(entCategory), nil
+}
+
+// GetCategory retrieves a category of the workspace by ID
+func (r *CategoryRepository) GetCategory(ctx context.Context, workspaceID, id int) (*model.Category, error) {
+	entCategory, err := r.client.Category.
+		Query().
+		Where(
+			category.ID(id),
+			category.WorkspaceID(workspaceID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toCategoryModel(entCategory), nil
+}
+
+// ListCategories retrieves the categories of a workspace ordered by name, optionally including archived ones
+func (r *CategoryRepository) ListCategories(ctx context.Context, workspaceID int, includeArchived bool) ([]*model.Category, error) {
+	query := r.client.Category.
+		Query().
+		Where(category.WorkspaceID(workspaceID))
+	if !includeArchived {
+		query = query.Where(category.Archived(false))
+	}
+
+	entCategories, err := query.
+		Order(ent.Asc(category.FieldName), ent.Asc(category.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]*model.Category, 0, len(entCategories))
+	for _, entCategory := range entCategories {
+		categories = append(categories, toCategoryModel(entCategory))
+	}
+	return categories, nil
+}
+
+// UpdateCategory overwrites the editable fields of a category; the kind cannot change
+func (r *CategoryRepository) UpdateCategory(ctx context.Context, c *model.Category) (*model.Category, error) {
+	update := r.client.Category.
+		UpdateOneID(c.ID).
+		Where(category.WorkspaceID(c.WorkspaceID)).
+		SetName(c.Name).
+		SetColor(c.Color).
+		SetIcon(c.Icon).
+		SetArchived(c.Archived)
+	if c.ParentID != nil {
+		update.SetParentID(*c.ParentID)
+	} else {
+		update.ClearParentID()
+	}
+
+	entCategory, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toCategoryModel(entCategory), nil
+}
+
+// ReparentChildren moves the direct subcategories of a category under another category
+func (r *CategoryRepository) ReparentChildren(ctx context.Context, workspaceID, fromParentID, toParentID int) error {
+	_, err := r.client.Category.
+		Update().
+		Where(
+			category.WorkspaceID(workspaceID),
+			category.ParentID(fromParentID),
+		).
+		SetParentID(toParentID).
+		Save(ctx)
+	return err
+}
+
+// DeleteCategory deletes a category of the workspace and reports whether one was deleted
+func (r *CategoryRepository) DeleteCategory(ctx context.Context, workspaceID, id int) (bool, error) {
+	n, err := r.client.Category.
+		Delete().
+		Where(
+			category.ID(id),
+			category.WorkspaceID(workspaceID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// toCategoryModel converts ent.Category to domain model Category
+func toCategoryModel(entCategory *ent.Category) *model.Category {
+	return &model.Category{
+		ID:          entCategory.ID,
+		WorkspaceID: entCategory.WorkspaceID,
+		ParentID:    entCategory.ParentID,
+		Name:        entCategory.Name,
+		Kind:        model.CategoryKind(entCategory.Kind),
+		Color:       entCategory.Color,
+		Icon:        entCategory.Icon,
+		Archived:    entCategory.Archived,
+		CreatedAt:   entCategory.CreatedAt,
+		UpdatedAt:   entCategory.UpdatedAt,
+	}
+}
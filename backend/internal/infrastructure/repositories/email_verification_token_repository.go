@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/emailverificationtoken"
+)
+
+type EmailVerificationTokenRepository struct {
+	client *ent.Client
+}
+
+func NewEmailVerificationTokenRepository(client *ent.Client) *EmailVerificationTokenRepository {
+	return &EmailVerificationTokenRepository{client: client}
+}
+
+// CreateToken stores the hash of a new verification token for the user
+func (r *EmailVerificationTokenRepository) CreateToken(ctx context.Context, userID int, tokenHash string, expiresAt time.Time) error {
+	return r.client.EmailVerificationToken.
+		Create().
+		SetUserID(userID).
+		SetTokenHash(tokenHash).
+		SetExpiresAt(expiresAt).
+		Exec(ctx)
+}
+
+// InvalidateUnusedTokens marks every outstanding token of the user as used
+func (r *EmailVerificationTokenRepository) InvalidateUnusedTokens(ctx context.Context, userID int) error {
+	return r.client.EmailVerificationToken.
+		Update().
+		Where(
+			emailverificationtoken.UserID(userID),
+			emailverificationtoken.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Exec(ctx)
+}
@@ -0,0 +1,300 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/importdecision"
+	"backend/internal/infrastructure/ent/importprofile"
+	"backend/internal/infrastructure/ent/statementimport"
+)
+
+type ImportRepository struct {
+	client *ent.Client
+}
+
+func NewImportRepository(client *ent.Client) *ImportRepository {
+	return &ImportRepository{client: client}
+}
+
+// CreateImport stores an uploaded statement in the import's workspace
+func (r *ImportRepository) CreateImport(ctx context.Context, statementImport *model.StatementImport) (*model.StatementImport, error) {
+	entImport, err := r.client.StatementImport.
+		Create().
+		SetWorkspaceID(statementImport.WorkspaceID).
+		SetAccountID(statementImport.AccountID).
+		SetFormat(statementimport.Format(statementImport.Format)).
+		SetFilename(statementImport.Filename).
+		SetContent(statementImport.Content).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toStatementImportModel(entImport), nil
+}
+
+// GetImport retrieves a statement import of the workspace by ID, with its file
+func (r *ImportRepository) GetImport(ctx context.Context, workspaceID, id int) (*model.StatementImport, error) {
+	entImport, err := r.client.StatementImport.
+		Query().
+		Where(
+			statementimport.ID(id),
+			statementimport.WorkspaceID(workspaceID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toStatementImportModel(entImport), nil
+}
+
+// ListImports retrieves the statement imports of a workspace without their files, newest first
+func (r *ImportRepository) ListImports(ctx context.Context, workspaceID int) ([]*model.StatementImport, error) {
+	fields := make([]string, 0, len(statementimport.Columns))
+	for _, column := range statementimport.Columns {
+		if column != statementimport.FieldContent {
+			fields = append(fields, column)
+		}
+	}
+
+	entImports, err := r.client.StatementImport.
+		Query().
+		Where(statementimport.WorkspaceID(workspaceID)).
+		Order(ent.Desc(statementimport.FieldCreatedAt), ent.Desc(statementimport.FieldID)).
+		Select(fields...).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	imports := make([]*model.StatementImport, 0, len(entImports))
+	for _, entImport := range entImports {
+		imports = append(imports, toStatementImportModel(entImport))
+	}
+	return imports, nil
+}
+
+// CommitImport marks a pending statement import committed with its row counts and commit time.
+// It reports false when the import is not pending, e.g. because a concurrent request committed it first.
+func (r *ImportRepository) CommitImport(ctx context.Context, i *model.StatementImport) (bool, error) {
+	n, err := r.client.StatementImport.
+		Update().
+		Where(
+			statementimport.ID(i.ID),
+			statementimport.WorkspaceID(i.WorkspaceID),
+			statementimport.StatusEQ(statementimport.StatusPending),
+		).
+		SetStatus(statementimport.StatusCommitted).
+		SetCreatedCount(i.CreatedCount).
+		SetSkippedCount(i.SkippedCount).
+		SetMergedCount(i.MergedCount).
+		SetFailedCount(i.FailedCount).
+		SetNillableCommittedAt(i.CommittedAt).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// DeleteImport deletes a statement import; transactions it created are kept
+func (r *ImportRepository) DeleteImport(ctx context.Context, workspaceID, id int) (bool, error) {
+	n, err := r.client.StatementImport.
+		Delete().
+		Where(
+			statementimport.ID(id),
+			statementimport.WorkspaceID(workspaceID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// CreateProfile stores a new import profile in the profile's workspace
+func (r *ImportRepository) CreateProfile(ctx context.Context, profile *model.ImportProfile) (*model.ImportProfile, error) {
+	entProfile, err := r.client.ImportProfile.
+		Create().
+		SetWorkspaceID(profile.WorkspaceID).
+		SetAccountID(profile.AccountID).
+		SetName(profile.Name).
+		SetMapping(profile.Mapping).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toImportProfileModel(entProfile), nil
+}
+
+// GetProfile retrieves an import profile of the workspace by ID
+func (r *ImportRepository) GetProfile(ctx context.Context, workspaceID, id int) (*model.ImportProfile, error) {
+	entProfile, err := r.client.ImportProfile.
+		Query().
+		Where(
+			importprofile.ID(id),
+			importprofile.WorkspaceID(workspaceID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toImportProfileModel(entProfile), nil
+}
+
+// ListProfiles retrieves the import profiles of a workspace, or of one of its accounts when accountID is set,
+// most recently used first
+func (r *ImportRepository) ListProfiles(ctx context.Context, workspaceID int, accountID *int) ([]*model.ImportProfile, error) {
+	query := r.client.ImportProfile.
+		Query().
+		Where(importprofile.WorkspaceID(workspaceID))
+	if accountID != nil {
+		query.Where(importprofile.AccountID(*accountID))
+	}
+
+	entProfiles, err := query.
+		Order(ent.Desc(importprofile.FieldUpdatedAt), ent.Desc(importprofile.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make([]*model.ImportProfile, 0, len(entProfiles))
+	for _, entProfile := range entProfiles {
+		profiles = append(profiles, toImportProfileModel(entProfile))
+	}
+	return profiles, nil
+}
+
+// UpdateProfile updates the name and mapping of an import profile
+func (r *ImportRepository) UpdateProfile(ctx context.Context, profile *model.ImportProfile) (*model.ImportProfile, error) {
+	entProfile, err := r.client.ImportProfile.
+		UpdateOneID(profile.ID).
+		Where(importprofile.WorkspaceID(profile.WorkspaceID)).
+		SetName(profile.Name).
+		SetMapping(profile.Mapping).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toImportProfileModel(entProfile), nil
+}
+
+// TouchProfile marks an import profile as just used, so that it is offered first for its account
+func (r *ImportRepository) TouchProfile(ctx context.Context, workspaceID, id int) error {
+	return r.client.ImportProfile.
+		Update().
+		Where(
+			importprofile.ID(id),
+			importprofile.WorkspaceID(workspaceID),
+		).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+}
+
+// DeleteProfile deletes an import profile
+func (r *ImportRepository) DeleteProfile(ctx context.Context, workspaceID, id int) (bool, error) {
+	n, err := r.client.ImportProfile.
+		Delete().
+		Where(
+			importprofile.ID(id),
+			importprofile.WorkspaceID(workspaceID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// FindDecisions returns the decisions recorded for any of the fingerprints of the account's statement rows, by fingerprint
+func (r *ImportRepository) FindDecisions(ctx context.Context, workspaceID, accountID int, fingerprints []string) (map[string]*model.ImportDecision, error) {
+	found := make(map[string]*model.ImportDecision)
+	if len(fingerprints) == 0 {
+		return found, nil
+	}
+
+	entDecisions, err := r.client.ImportDecision.
+		Query().
+		Where(
+			importdecision.WorkspaceID(workspaceID),
+			importdecision.AccountID(accountID),
+			importdecision.FingerprintIn(fingerprints...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, entDecision := range entDecisions {
+		found[entDecision.Fingerprint] = toImportDecisionModel(entDecision)
+	}
+	return found, nil
+}
+
+// CreateDecisions records what became of the committed rows of a statement
+func (r *ImportRepository) CreateDecisions(ctx context.Context, decisions []*model.ImportDecision) error {
+	if len(decisions) == 0 {
+		return nil
+	}
+	builders := make([]*ent.ImportDecisionCreate, 0, len(decisions))
+	for _, decision := range decisions {
+		builders = append(builders, r.client.ImportDecision.
+			Create().
+			SetWorkspaceID(decision.WorkspaceID).
+			SetAccountID(decision.AccountID).
+			SetNillableStatementImportID(decision.ImportID).
+			SetFingerprint(decision.Fingerprint).
+			SetAction(importdecision.Action(decision.Action)).
+			SetTransactionID(decision.TransactionID))
+	}
+	return r.client.ImportDecision.CreateBulk(builders...).Exec(ctx)
+}
+
+// toStatementImportModel converts ent.StatementImport to domain model StatementImport
+func toStatementImportModel(entImport *ent.StatementImport) *model.StatementImport {
+	return &model.StatementImport{
+		ID:           entImport.ID,
+		WorkspaceID:  entImport.WorkspaceID,
+		AccountID:    entImport.AccountID,
+		Format:       model.StatementFormat(entImport.Format),
+		Filename:     entImport.Filename,
+		Content:      entImport.Content,
+		Status:       model.StatementImportStatus(entImport.Status),
+		CreatedCount: entImport.CreatedCount,
+		SkippedCount: entImport.SkippedCount,
+		MergedCount:  entImport.MergedCount,
+		FailedCount:  entImport.FailedCount,
+		CommittedAt:  entImport.CommittedAt,
+		CreatedAt:    entImport.CreatedAt,
+		UpdatedAt:    entImport.UpdatedAt,
+	}
+}
+
+// toImportProfileModel converts ent.ImportProfile to domain model ImportProfile
+func toImportProfileModel(entProfile *ent.ImportProfile) *model.ImportProfile {
+	return &model.ImportProfile{
+		ID:          entProfile.ID,
+		WorkspaceID: entProfile.WorkspaceID,
+		AccountID:   entProfile.AccountID,
+		Name:        entProfile.Name,
+		Mapping:     entProfile.Mapping,
+		CreatedAt:   entProfile.CreatedAt,
+		UpdatedAt:   entProfile.UpdatedAt,
+	}
+}
+
+// toImportDecisionModel converts ent.ImportDecision to domain model ImportDecision
+func toImportDecisionModel(entDecision *ent.ImportDecision) *model.ImportDecision {
+	return &model.ImportDecision{
+		ID:            entDecision.ID,
+		WorkspaceID:   entDecision.WorkspaceID,
+		AccountID:     entDecision.AccountID,
+		ImportID:      entDecision.StatementImportID,
+		Fingerprint:   entDecision.Fingerprint,
+		Action:        model.ImportDecisionAction(entDecision.Action),
+		TransactionID: entDecision.TransactionID,
+		CreatedAt:     entDecision.CreatedAt,
+	}
+}
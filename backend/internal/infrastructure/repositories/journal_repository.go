@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/journalentry"
+	"backend/internal/infrastructure/ent/posting"
+)
+
+type JournalRepository struct {
+	client *ent.Client
+}
+
+func NewJournalRepository(client *ent.Client) *JournalRepository {
+	return &JournalRepository{client: client}
+}
+
+// CreateJournalEntry stores a journal entry together with its postings.
+// Callers validate the entry and run this inside a transaction so that no unbalanced entry is ever visible.
+func (r *JournalRepository) CreateJournalEntry(ctx context.Context, e *model.JournalEntry) (*model.JournalEntry, error) {
+	entEntry, err := r.client.JournalEntry.
+		Create().
+		SetWorkspaceID(e.WorkspaceID).
+		SetNillableTransactionID(e.TransactionID).
+		SetPostedOn(e.PostedOn).
+		SetMemo(e.Memo).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builders := make([]*ent.PostingCreate, 0, len(e.Postings))
+	for _, p := range e.Postings {
+		builders = append(builders, r.client.Posting.
+			Create().
+			SetWorkspaceID(e.WorkspaceID).
+			SetJournalEntryID(entEntry.ID).
+			SetAccountID(p.AccountID).
+			SetAmount(p.Amount.Decimal()).
+			SetCurrency(p.Amount.Currency()))
+	}
+	entPostings, err := r.client.Posting.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entEntry.Edges.Postings = entPostings
+	return toJournalEntryModel(entEntry)
+}
+
+// DeleteTransactionJournalEntry deletes the journal entry recorded for a transaction, along with its postings
+func (r *JournalRepository) DeleteTransactionJournalEntry(ctx context.Context, workspaceID, transactionID int) error {
+	_, err := r.client.JournalEntry.
+		Delete().
+		Where(
+			journalentry.WorkspaceID(workspaceID),
+			journalentry.TransactionID(transactionID),
+		).
+		Exec(ctx)
+	return err
+}
+
+// AccountHasPostings reports whether anything was ever posted to the account
+func (r *JournalRepository) AccountHasPostings(ctx context.Context, workspaceID, accountID int) (bool, error) {
+	return r.client.Posting.
+		Query().
+		Where(
+			posting.WorkspaceID(workspaceID),
+			posting.AccountID(accountID),
+		).
+		Exist(ctx)
+}
+
+// toJournalEntryModel converts ent.JournalEntry with its loaded postings to domain model JournalEntry
+func toJournalEntryModel(entEntry *ent.JournalEntry) (*model.JournalEntry, error) {
+	postings := make([]*model.Posting, 0, len(entEntry.Edges.Postings))
+	for _, entPosting := range entEntry.Edges.Postings {
+		amount, err := model.MoneyFromDecimal(entPosting.Amount, entPosting.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("posting %d: %w", entPosting.ID, err)
+		}
+		postings = append(postings, &model.Posting{
+			ID:             entPosting.ID,
+			JournalEntryID: entPosting.JournalEntryID,
+			AccountID:      entPosting.AccountID,
+			Amount:         amount,
+		})
+	}
+
+	return &model.JournalEntry{
+		ID:            entEntry.ID,
+		WorkspaceID:   entEntry.WorkspaceID,
+		TransactionID: entEntry.TransactionID,
+		PostedOn:      entEntry.PostedOn,
+		Memo:          entEntry.Memo,
+		Postings:      postings,
+		CreatedAt:     entEntry.CreatedAt,
+	}, nil
+}
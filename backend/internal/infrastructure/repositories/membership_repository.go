@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"context"
+
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/membership"
+)
+
+type MembershipRepository struct {
+	client *ent.Client
+}
+
+func NewMembershipRepository(client *ent.Client) *MembershipRepository {
+	return &MembershipRepository{client: client}
+}
+
+// GetMembership retrieves the membership of a user in a workspace
+func (r *MembershipRepository) GetMembership(ctx context.Context, workspaceID, userID int) (*model.Membership, error) {
+	entMembership, err := r.client.Membership.
+		Query().
+		Where(
+			membership.WorkspaceID(workspaceID),
+			membership.UserID(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toMembershipModel(entMembership), nil
+}
+
+// ListMembershipsByUserID retrieves the memberships of a user together with their workspaces, oldest first
+func (r *MembershipRepository) ListMembershipsByUserID(ctx context.Context, userID int) ([]*model.Membership, error) {
+	entMemberships, err := r.client.Membership.
+		Query().
+		Where(membership.UserID(userID)).
+		WithWorkspace().
+		Order(ent.Asc(membership.FieldWorkspaceID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships := make([]*model.Membership, 0, len(entMemberships))
+	for _, entMembership := range entMemberships {
+		memberships = append(memberships, toMembershipModel(entMembership))
+	}
+	return memberships, nil
+}
+
+// ListMembershipsByWorkspaceID retrieves the members of a workspace together with their users, in the order they joined
+func (r *MembershipRepository) ListMembershipsByWorkspaceID(ctx context.Context, workspaceID int) ([]*model.Membership, error) {
+	entMemberships, err := r.client.Membership.
+		Query().
+		Where(membership.WorkspaceID(workspaceID)).
+		WithUser().
+		Order(ent.Asc(membership.FieldJoinedAt), ent.Asc(membership.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships := make([]*model.Membership, 0, len(entMemberships))
+	for _, entMembership := range entMemberships {
+		memberships = append(memberships, toMembershipModel(entMembership))
+	}
+	return memberships, nil
+}
+
+// toMembershipModel converts ent.Membership to domain model Membership
+func toMembershipModel(entMembership *ent.Membership) *model.Membership {
+	m := &model.Membership{
+		ID:          entMembership.ID,
+		WorkspaceID: entMembership.WorkspaceID,
+		UserID:      entMembership.UserID,
+		Role:        model.Role(entMembership.Role),
+		JoinedAt:    entMembership.JoinedAt,
+		InvitedByID: entMembership.InvitedByID,
+	}
+	if entMembership.Edges.Workspace != nil {
+		m.Workspace = toWorkspaceModel(entMembership.Edges.Workspace)
+	}
+	if entMembership.Edges.User != nil {
+		m.User = toUserModel(entMembership.Edges.User)
+	}
+	return m
+}
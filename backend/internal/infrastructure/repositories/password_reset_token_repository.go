@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/passwordresettoken"
+)
+
+type PasswordResetTokenRepository struct {
+	client *ent.Client
+}
+
+func NewPasswordResetTokenRepository(client *ent.Client) *PasswordResetTokenRepository {
+	return &PasswordResetTokenRepository{client: client}
+}
+
+// CreateToken stores the hash of a new reset token for the user
+func (r *PasswordResetTokenRepository) CreateToken(ctx context.Context, userID int, tokenHash string, expiresAt time.Time) error {
+	return r.client.PasswordResetToken.
+		Create().
+		SetUserID(userID).
+		SetTokenHash(tokenHash).
+		SetExpiresAt(expiresAt).
+		Exec(ctx)
+}
+
+// InvalidateUnusedTokens marks every outstanding token of the user as used
+func (r *PasswordResetTokenRepository) InvalidateUnusedTokens(ctx context.Context, userID int) error {
+	return r.client.PasswordResetToken.
+		Update().
+		Where(
+			passwordresettoken.UserID(userID),
+			passwordresettoken.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Exec(ctx)
+}
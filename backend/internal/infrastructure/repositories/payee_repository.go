@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"context"
+
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/payee"
+)
+
+type PayeeRepository struct {
+	client *ent.Client
+}
+
+func NewPayeeRepository(client *ent.Client) *PayeeRepository {
+	return &PayeeRepository{client: client}
+}
+
+// CreatePayee stores a new payee in the payee's workspace
+func (r *PayeeRepository) CreatePayee(ctx context.Context, p *model.Payee) (*model.Payee, error) {
+	entPayee, err := r.client.Payee.
+		Create().
+		SetWorkspaceID(p.WorkspaceID).
+		SetName(p.Name).
+		SetAliases(p.Aliases).
+		SetNillableDefaultCategoryID(p.DefaultCategoryID).
+		SetLogoURL(p.LogoURL).
+		SetWebsite(p.Website).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toPayeeModel(entPayee), nil
+}
+
+// GetPayee retrieves a payee of the workspace by ID
+func (r *PayeeRepository) GetPayee(ctx context.Context, workspaceID, id int) (*model.Payee, error) {
+	entPayee, err := r.client.Payee.
+		Query().
+		Where(
+			payee.ID(id),
+			payee.WorkspaceID(workspaceID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toPayeeModel(entPayee), nil
+}
+
+// ListPayees retrieves the payees of a workspace ordered by name
+func (r *PayeeRepository) ListPayees(ctx context.Context, workspaceID int) ([]*model.Payee, error) {
+	entPayees, err := r.client.Payee.
+		Query().
+		Where(payee.WorkspaceID(workspaceID)).
+		Order(ent.Asc(payee.FieldName), ent.Asc(payee.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	payees := make([]*model.Payee, 0, len(entPayees))
+	for _, entPayee := range entPayees {
+		payees = append(payees, toPayeeModel(entPayee))
+	}
+	return payees, nil
+}
+
+// UpdatePayee overwrites the editable fields of a payee
+func (r *PayeeRepository) UpdatePayee(ctx context.Context, p *model.Payee) (*model.Payee, error) {
+	update := r.client.Payee.
+		UpdateOneID(p.ID).
+		Where(payee.WorkspaceID(p.WorkspaceID)).
+		SetName(p.Name).
+		SetAliases(p.Aliases).
+		SetLogoURL(p.LogoURL).
+		SetWebsite(p.Website)
+	if p.DefaultCategoryID != nil {
+		update.SetDefaultCategoryID(*p.DefaultCategoryID)
+	} else {
+		update.ClearDefaultCategoryID()
+	}
+
+	entPayee, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toPayeeModel(entPayee), nil
+}
+
+// ReassignDefaultCategory moves the payees defaulting to a category to another category
+func (r *PayeeRepository) ReassignDefaultCategory(ctx context.Context, workspaceID, fromCategoryID, toCategoryID int) error {
+	_, err := r.client.Payee.
+		Update().
+		Where(
+			payee.WorkspaceID(workspaceID),
+			payee.DefaultCategoryID(fromCategoryID),
+		).
+		SetDefaultCategoryID(toCategoryID).
+		Save(ctx)
+	return err
+}
+
+// DeletePayee deletes a payee of the workspace and reports whether one was deleted.
+// Its transactions keep their descriptions but no longer reference a payee.
+func (r *PayeeRepository) DeletePayee(ctx context.Context, workspaceID, id int) (bool, error) {
+	n, err := r.client.Payee.
+		Delete().
+		Where(
+			payee.ID(id),
+			payee.WorkspaceID(workspaceID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// toPayeeModel converts ent.Payee to domain model Payee
+func toPayeeModel(entPayee *ent.Payee) *model.Payee {
+	aliases := entPayee.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+	return &model.Payee{
+		ID:                entPayee.ID,
+		WorkspaceID:       entPayee.WorkspaceID,
+		Name:              entPayee.Name,
+		Aliases:           aliases,
+		DefaultCategoryID: entPayee.DefaultCategoryID,
+		LogoURL:           entPayee.LogoURL,
+		Website:           entPayee.Website,
+		CreatedAt:         entPayee.CreatedAt,
+		UpdatedAt:         entPayee.UpdatedAt,
+	}
+}
@@ -0,0 +1,34 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/recoverycode"
+)
+
+type RecoveryCodeRepository struct {
+	client *ent.Client
+}
+
+func NewRecoveryCodeRepository(client *ent.Client) *RecoveryCodeRepository {
+	return &RecoveryCodeRepository{client: client}
+}
+
+// ConsumeRecoveryCode marks an unused recovery code of the user as used and reports whether one matched
+func (r *RecoveryCodeRepository) ConsumeRecoveryCode(ctx context.Context, userID int, codeHash string) (bool, error) {
+	n, err := r.client.RecoveryCode.
+		Update().
+		Where(
+			recoverycode.UserID(userID),
+			recoverycode.CodeHash(codeHash),
+			recoverycode.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
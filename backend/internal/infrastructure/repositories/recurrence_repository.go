@@ -0,0 +1,363 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/recurrenceoccurrence"
+	"backend/internal/infrastructure/ent/recurrencerule"
+)
+
+type RecurrenceRepository struct {
+	client *ent.Client
+}
+
+func NewRecurrenceRepository(client *ent.Client) *RecurrenceRepository {
+	return &RecurrenceRepository{client: client}
+}
+
+// CreateRule stores a new recurrence rule in the rule's workspace
+func (r *RecurrenceRepository) CreateRule(ctx context.Context, rule *model.RecurrenceRule) (*model.RecurrenceRule, error) {
+	entRule, err := r.client.RecurrenceRule.
+		Create().
+		SetWorkspaceID(rule.WorkspaceID).
+		SetAccountID(rule.AccountID).
+		SetAmount(rule.Amount.Decimal()).
+		SetCurrency(rule.Amount.Currency()).
+		SetPayee(rule.Payee).
+		SetNillablePayeeID(rule.PayeeID).
+		SetMemo(rule.Memo).
+		SetNillableCategoryID(rule.CategoryID).
+		SetFrequency(recurrencerule.Frequency(rule.Frequency)).
+		SetInterval(rule.Interval).
+		SetByDay(rule.ByDay).
+		SetStartsOn(rule.StartsOn).
+		SetNillableEndsOn(rule.EndsOn).
+		SetScheduledFrom(rule.ScheduledFrom).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toRecurrenceRuleModel(entRule)
+}
+
+// GetRule retrieves a recurrence rule of the workspace by ID
+func (r *RecurrenceRepository) GetRule(ctx context.Context, workspaceID, id int) (*model.RecurrenceRule, error) {
+	entRule, err := r.client.RecurrenceRule.
+		Query().
+		Where(
+			recurrencerule.ID(id),
+			recurrencerule.WorkspaceID(workspaceID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toRecurrenceRuleModel(entRule)
+}
+
+// ListRules retrieves the recurrence rules of a workspace, oldest first
+func (r *RecurrenceRepository) ListRules(ctx context.Context, workspaceID int) ([]*model.RecurrenceRule, error) {
+	entRules, err := r.client.RecurrenceRule.
+		Query().
+		Where(recurrencerule.WorkspaceID(workspaceID)).
+		Order(ent.Asc(recurrencerule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toRecurrenceRuleModels(entRules)
+}
+
+// ListActiveRules retrieves the recurrence rules of every workspace that have not ended before the date.
+// It is meant for the scheduler, which passes a tenant.Bypass context and then acts on each rule's workspace.
+func (r *RecurrenceRepository) ListActiveRules(ctx context.Context, on time.Time) ([]*model.RecurrenceRule, error) {
+	entRules, err := r.client.RecurrenceRule.
+		Query().
+		Where(recurrencerule.Or(
+			recurrencerule.EndsOnIsNil(),
+			recurrencerule.EndsOnGTE(on),
+		)).
+		Order(ent.Asc(recurrencerule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toRecurrenceRuleModels(entRules)
+}
+
+// UpdateRule overwrites the editable fields of a recurrence rule
+func (r *RecurrenceRepository) UpdateRule(ctx context.Context, rule *model.RecurrenceRule) (*model.RecurrenceRule, error) {
+	update := r.client.RecurrenceRule.
+		UpdateOneID(rule.ID).
+		Where(recurrencerule.WorkspaceID(rule.WorkspaceID)).
+		SetAccountID(rule.AccountID).
+		SetAmount(rule.Amount.Decimal()).
+		SetCurrency(rule.Amount.Currency()).
+		SetPayee(rule.Payee).
+		SetMemo(rule.Memo).
+		SetFrequency(recurrencerule.Frequency(rule.Frequency)).
+		SetInterval(rule.Interval).
+		SetByDay(rule.ByDay).
+		SetStartsOn(rule.StartsOn).
+		SetScheduledFrom(rule.ScheduledFrom)
+	if rule.PayeeID != nil {
+		update.SetPayeeID(*rule.PayeeID)
+	} else {
+		update.ClearPayeeID()
+	}
+	if rule.CategoryID != nil {
+		update.SetCategoryID(*rule.CategoryID)
+	} else {
+		update.ClearCategoryID()
+	}
+	if rule.EndsOn != nil {
+		update.SetEndsOn(*rule.EndsOn)
+	} else {
+		update.ClearEndsOn()
+	}
+
+	entRule, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toRecurrenceRuleModel(entRule)
+}
+
+// DeleteRule deletes a recurrence rule of the workspace together with its occurrences,
+// keeping the transactions already posted, and reports whether a rule was deleted
+func (r *RecurrenceRepository) DeleteRule(ctx context.Context, workspaceID, id int) (bool, error) {
+	n, err := r.client.RecurrenceRule.
+		Delete().
+		Where(
+			recurrencerule.ID(id),
+			recurrencerule.WorkspaceID(workspaceID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// ReassignCategory moves the recurrence rules of a category to another category
+func (r *RecurrenceRepository) ReassignCategory(ctx context.Context, workspaceID, fromCategoryID, toCategoryID int) error {
+	_, err := r.client.RecurrenceRule.
+		Update().
+		Where(
+			recurrencerule.WorkspaceID(workspaceID),
+			recurrencerule.CategoryID(fromCategoryID),
+		).
+		SetCategoryID(toCategoryID).
+		Save(ctx)
+	return err
+}
+
+// ReassignPayee moves the recurrence rules of a payee to another payee
+func (r *RecurrenceRepository) ReassignPayee(ctx context.Context, workspaceID, fromPayeeID, toPayeeID int) error {
+	_, err := r.client.RecurrenceRule.
+		Update().
+		Where(
+			recurrencerule.WorkspaceID(workspaceID),
+			recurrencerule.PayeeID(fromPayeeID),
+		).
+		SetPayeeID(toPayeeID).
+		Save(ctx)
+	return err
+}
+
+// CreateOccurrence stores a scheduled occurrence of a rule. It fails with a constraint error
+// when the rule already has an occurrence on that date.
+func (r *RecurrenceRepository) CreateOccurrence(ctx context.Context, o *model.RecurrenceOccurrence) (*model.RecurrenceOccurrence, error) {
+	entOccurrence, err := r.client.RecurrenceOccurrence.
+		Create().
+		SetWorkspaceID(o.WorkspaceID).
+		SetRuleID(o.RuleID).
+		SetDueOn(o.DueOn).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toRecurrenceOccurrenceModel(entOccurrence), nil
+}
+
+// GetOccurrence retrieves an occurrence of the workspace by ID
+func (r *RecurrenceRepository) GetOccurrence(ctx context.Context, workspaceID, id int) (*model.RecurrenceOccurrence, error) {
+	entOccurrence, err := r.client.RecurrenceOccurrence.
+		Query().
+		Where(
+			recurrenceoccurrence.ID(id),
+			recurrenceoccurrence.WorkspaceID(workspaceID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toRecurrenceOccurrenceModel(entOccurrence), nil
+}
+
+// ListOccurrenceDates returns the due dates of a rule's occurrences on or after the date, whatever their status
+func (r *RecurrenceRepository) ListOccurrenceDates(ctx context.Context, workspaceID, ruleID int, from time.Time) ([]time.Time, error) {
+	entOccurrences, err := r.client.RecurrenceOccurrence.
+		Query().
+		Where(
+			recurrenceoccurrence.WorkspaceID(workspaceID),
+			recurrenceoccurrence.RuleID(ruleID),
+			recurrenceoccurrence.DueOnGTE(from),
+		).
+		Select(recurrenceoccurrence.FieldDueOn).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dates := make([]time.Time, 0, len(entOccurrences))
+	for _, entOccurrence := range entOccurrences {
+		dates = append(dates, entOccurrence.DueOn)
+	}
+	return dates, nil
+}
+
+// ListScheduledOccurrences retrieves the workspace's occurrences still to be posted that are due
+// on or before the date, soonest first
+func (r *RecurrenceRepository) ListScheduledOccurrences(ctx context.Context, workspaceID int, through time.Time) ([]*model.RecurrenceOccurrence, error) {
+	entOccurrences, err := r.client.RecurrenceOccurrence.
+		Query().
+		Where(
+			recurrenceoccurrence.WorkspaceID(workspaceID),
+			recurrenceoccurrence.StatusEQ(recurrenceoccurrence.StatusScheduled),
+			recurrenceoccurrence.DueOnLTE(through),
+		).
+		Order(ent.Asc(recurrenceoccurrence.FieldDueOn), ent.Asc(recurrenceoccurrence.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toRecurrenceOccurrenceModels(entOccurrences), nil
+}
+
+// ListDueOccurrences retrieves the occurrences of every workspace still to be posted that are due
+// on or before the date, soonest first. Like ListActiveRules, it is meant for the scheduler.
+func (r *RecurrenceRepository) ListDueOccurrences(ctx context.Context, through time.Time) ([]*model.RecurrenceOccurrence, error) {
+	entOccurrences, err := r.client.RecurrenceOccurrence.
+		Query().
+		Where(
+			recurrenceoccurrence.StatusEQ(recurrenceoccurrence.StatusScheduled),
+			recurrenceoccurrence.DueOnLTE(through),
+		).
+		Order(ent.Asc(recurrenceoccurrence.FieldDueOn), ent.Asc(recurrenceoccurrence.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toRecurrenceOccurrenceModels(entOccurrences), nil
+}
+
+// ResolveOccurrence moves a scheduled occurrence to posted or skipped and reports whether it was
+// still scheduled, so that concurrent attempts post an occurrence at most once
+func (r *RecurrenceRepository) ResolveOccurrence(
+	ctx context.Context,
+	workspaceID int,
+	id int,
+	status model.RecurrenceOccurrenceStatus,
+	transactionID *int,
+) (bool, error) {
+	n, err := r.client.RecurrenceOccurrence.
+		Update().
+		Where(
+			recurrenceoccurrence.ID(id),
+			recurrenceoccurrence.WorkspaceID(workspaceID),
+			recurrenceoccurrence.StatusEQ(recurrenceoccurrence.StatusScheduled),
+		).
+		SetStatus(recurrenceoccurrence.Status(status)).
+		SetNillableTransactionID(transactionID).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// DeleteScheduledOccurrences deletes the occurrences of a rule that are still to be posted,
+// so that they are materialized again from the rule's current schedule
+func (r *RecurrenceRepository) DeleteScheduledOccurrences(ctx context.Context, workspaceID, ruleID int) error {
+	_, err := r.client.RecurrenceOccurrence.
+		Delete().
+		Where(
+			recurrenceoccurrence.WorkspaceID(workspaceID),
+			recurrenceoccurrence.RuleID(ruleID),
+			recurrenceoccurrence.StatusEQ(recurrenceoccurrence.StatusScheduled),
+		).
+		Exec(ctx)
+	return err
+}
+
+// toRecurrenceRuleModels converts ent.RecurrenceRule values to domain model RecurrenceRule values
+func toRecurrenceRuleModels(entRules []*ent.RecurrenceRule) ([]*model.RecurrenceRule, error) {
+	rules := make([]*model.RecurrenceRule, 0, len(entRules))
+	for _, entRule := range entRules {
+		rule, err := toRecurrenceRuleModel(entRule)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// toRecurrenceRuleModel converts ent.RecurrenceRule to domain model RecurrenceRule
+func toRecurrenceRuleModel(entRule *ent.RecurrenceRule) (*model.RecurrenceRule, error) {
+	amount, err := model.MoneyFromDecimal(entRule.Amount, entRule.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("recurrence rule %d: %w", entRule.ID, err)
+	}
+	byDay := entRule.ByDay
+	if byDay == nil {
+		byDay = []string{}
+	}
+
+	return &model.RecurrenceRule{
+		ID:            entRule.ID,
+		WorkspaceID:   entRule.WorkspaceID,
+		AccountID:     entRule.AccountID,
+		Amount:        amount,
+		Payee:         entRule.Payee,
+		PayeeID:       entRule.PayeeID,
+		Memo:          entRule.Memo,
+		CategoryID:    entRule.CategoryID,
+		Frequency:     model.RecurrenceFrequency(entRule.Frequency),
+		Interval:      entRule.Interval,
+		ByDay:         byDay,
+		StartsOn:      entRule.StartsOn,
+		EndsOn:        entRule.EndsOn,
+		ScheduledFrom: entRule.ScheduledFrom,
+		CreatedAt:     entRule.CreatedAt,
+		UpdatedAt:     entRule.UpdatedAt,
+	}, nil
+}
+
+// toRecurrenceOccurrenceModels converts ent.RecurrenceOccurrence values to domain model RecurrenceOccurrence values
+func toRecurrenceOccurrenceModels(entOccurrences []*ent.RecurrenceOccurrence) []*model.RecurrenceOccurrence {
+	occurrences := make([]*model.RecurrenceOccurrence, 0, len(entOccurrences))
+	for _, entOccurrence := range entOccurrences {
+		occurrences = append(occurrences, toRecurrenceOccurrenceModel(entOccurrence))
+	}
+	return occurrences
+}
+
+// toRecurrenceOccurrenceModel converts ent.RecurrenceOccurrence to domain model RecurrenceOccurrence
+func toRecurrenceOccurrenceModel(entOccurrence *ent.RecurrenceOccurrence) *model.RecurrenceOccurrence {
+	return &model.RecurrenceOccurrence{
+		ID:            entOccurrence.ID,
+		WorkspaceID:   entOccurrence.WorkspaceID,
+		RuleID:        entOccurrence.RuleID,
+		DueOn:         entOccurrence.DueOn,
+		Status:        model.RecurrenceOccurrenceStatus(entOccurrence.Status),
+		TransactionID: entOccurrence.TransactionID,
+		CreatedAt:     entOccurrence.CreatedAt,
+		UpdatedAt:     entOccurrence.UpdatedAt,
+	}
+}
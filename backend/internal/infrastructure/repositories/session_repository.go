@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/session"
+)
+
+type SessionRepository struct {
+	client *ent.Client
+}
+
+func NewSessionRepository(client *ent.Client) *SessionRepository {
+	return &SessionRepository{client: client}
+}
+
+// ListActiveSessionsByUserID retrieves the unexpired sessions of a user, most recently used first
+func (r *SessionRepository) ListActiveSessionsByUserID(ctx context.Context, userID int) ([]*model.Session, error) {
+	entSessions, err := r.client.Session.
+		Query().
+		Where(
+			session.UserID(userID),
+			session.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(session.FieldLastSeenAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*model.Session, 0, len(entSessions))
+	for _, entSession := range entSessions {
+		sessions = append(sessions, toSessionModel(entSession))
+	}
+	return sessions, nil
+}
+
+// DeleteSessionByIDAndUserID deletes a session owned by the user and reports whether it existed
+func (r *SessionRepository) DeleteSessionByIDAndUserID(ctx context.Context, id, userID int) (bool, error) {
+	n, err := r.client.Session.
+		Delete().
+		Where(
+			session.ID(id),
+			session.UserID(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// toSessionModel converts ent.Session to domain model Session
+func toSessionModel(entSession *ent.Session) *model.Session {
+	s := &model.Session{
+		ID:         entSession.ID,
+		UserAgent:  entSession.UserAgent,
+		IPAddress:  entSession.IPAddress,
+		LastSeenAt: entSession.LastSeenAt,
+		ExpiresAt:  entSession.ExpiresAt,
+		CreatedAt:  entSession.CreatedAt,
+	}
+	if entSession.UserID != nil {
+		s.UserID = *entSession.UserID
+	}
+	return s
+}
@@ -0,0 +1,117 @@
+package repositories
+
+import (
+	"context"
+
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/tag"
+)
+
+type TagRepository struct {
+	client *ent.Client
+}
+
+func NewTagRepository(client *ent.Client) *TagRepository {
+	return &TagRepository{client: client}
+}
+
+// CreateTag stores a new tag in the tag's workspace
+func (r *TagRepository) CreateTag(ctx context.Context, t *model.Tag) (*model.Tag, error) {
+	entTag, err := r.client.Tag.
+		Create().
+		SetWorkspaceID(t.WorkspaceID).
+		SetName(t.Name).
+		SetColor(t.Color).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toTagModel(entTag), nil
+}
+
+// GetTag retrieves a tag of the workspace by ID
+func (r *TagRepository) GetTag(ctx context.Context, workspaceID, id int) (*model.Tag, error) {
+	entTag, err := r.client.Tag.
+		Query().
+		Where(
+			tag.ID(id),
+			tag.WorkspaceID(workspaceID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toTagModel(entTag), nil
+}
+
+// ListTags retrieves the tags of a workspace ordered by name
+func (r *TagRepository) ListTags(ctx context.Context, workspaceID int) ([]*model.Tag, error) {
+	entTags, err := r.client.Tag.
+		Query().
+		Where(tag.WorkspaceID(workspaceID)).
+		Order(ent.Asc(tag.FieldName), ent.Asc(tag.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*model.Tag, 0, len(entTags))
+	for _, entTag := range entTags {
+		tags = append(tags, toTagModel(entTag))
+	}
+	return tags, nil
+}
+
+// CountTags returns how many of the IDs are tags of the workspace; the IDs must be distinct
+func (r *TagRepository) CountTags(ctx context.Context, workspaceID int, ids []int) (int, error) {
+	return r.client.Tag.
+		Query().
+		Where(
+			tag.IDIn(ids...),
+			tag.WorkspaceID(workspaceID),
+		).
+		Count(ctx)
+}
+
+// UpdateTag overwrites the editable fields of a tag
+func (r *TagRepository) UpdateTag(ctx context.Context, t *model.Tag) (*model.Tag, error) {
+	entTag, err := r.client.Tag.
+		UpdateOneID(t.ID).
+		Where(tag.WorkspaceID(t.WorkspaceID)).
+		SetName(t.Name).
+		SetColor(t.Color).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toTagModel(entTag), nil
+}
+
+// DeleteTag deletes a tag of the workspace, removing it from its transactions,
+// and reports whether one was deleted
+func (r *TagRepository) DeleteTag(ctx context.Context, workspaceID, id int) (bool, error) {
+	n, err := r.client.Tag.
+		Delete().
+		Where(
+			tag.ID(id),
+			tag.WorkspaceID(workspaceID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// toTagModel converts ent.Tag to domain model Tag
+func toTagModel(entTag *ent.Tag) *model.Tag {
+	return &model.Tag{
+		ID:          entTag.ID,
+		WorkspaceID: entTag.WorkspaceID,
+		Name:        entTag.Name,
+		Color:       entTag.Color,
+		CreatedAt:   entTag.CreatedAt,
+		UpdatedAt:   entTag.UpdatedAt,
+	}
+}
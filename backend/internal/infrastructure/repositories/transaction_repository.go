@@ -0,0 +1,706 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/ent/predicate"
+	"backend/internal/infrastructure/ent/tag"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/transactionsplit"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type TransactionRepository struct {
+	client *ent.Client
+}
+
+func NewTransactionRepository(client *ent.Client) *TransactionRepository {
+	return &TransactionRepository{client: client}
+}
+
+// CreateTransaction stores a new transaction in the transaction's workspace together with its splits.
+// Callers run it inside a transaction so that a transaction is never visible without its splits.
+func (r *TransactionRepository) CreateTransaction(ctx context.Context, t *model.Transaction) (*model.Transaction, error) {
+	entTransaction, err := r.client.Transaction.
+		Create().
+		SetWorkspaceID(t.WorkspaceID).
+		SetAccountID(t.AccountID).
+		SetPostedOn(t.PostedOn).
+		SetAmount(t.Amount.Decimal()).
+		SetCurrency(t.Amount.Currency()).
+		SetPayee(t.Payee).
+		SetNillablePayeeID(t.PayeeID).
+		SetMemo(t.Memo).
+		SetNillableCategoryID(t.CategoryID).
+		SetNillableExternalID(t.ExternalID).
+		SetNillableValueDate(t.ValueDate).
+		SetCounterpartyIban(t.CounterpartyIBAN).
+		SetEndToEndID(t.EndToEndID).
+		SetStatus(transaction.Status(t.Status)).
+		AddTagIDs(t.TagIDs...).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if entTransaction.Edges.Splits, err = r.createSplits(ctx, t.WorkspaceID, entTransaction.ID, t.Splits); err != nil {
+		return nil, err
+	}
+
+	created, err := toTransactionModel(entTransaction)
+	if err != nil {
+		return nil, err
+	}
+	setCreatedTagIDs(created, t)
+	return created, nil
+}
+
+// GetTransaction retrieves a transaction of the workspace by ID
+func (r *TransactionRepository) GetTransaction(ctx context.Context, workspaceID, id int) (*model.Transaction, error) {
+	entTransaction, err := r.client.Transaction.
+		Query().
+		Where(
+			transaction.ID(id),
+			transaction.WorkspaceID(workspaceID),
+		).
+		WithSplits(orderSplits).
+		WithTags(selectTagIDs).
+		WithOutgoingTransfer().
+		WithIncomingTransfer().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toTransactionModel(entTransaction)
+}
+
+// ListTransactions retrieves the transactions of a workspace matching the filter, newest first
+func (r *TransactionRepository) ListTransactions(ctx context.Context, workspaceID int, filter model.TransactionFilter) ([]*model.Transaction, error) {
+	query := r.client.Transaction.
+		Query().
+		Where(transaction.WorkspaceID(workspaceID))
+	if filter.AccountID != nil {
+		query = query.Where(transaction.AccountID(*filter.AccountID))
+	}
+	if filter.PayeeID != nil {
+		query = query.Where(transaction.PayeeID(*filter.PayeeID))
+	}
+	if len(filter.CategoryIDs) > 0 {
+		query = query.Where(transaction.Or(
+			transaction.CategoryIDIn(filter.CategoryIDs...),
+			transaction.HasSplitsWith(transactionsplit.CategoryIDIn(filter.CategoryIDs...)),
+		))
+	}
+	query = query.Where(tagPredicates(filter.TagIDs, filter.TagMatch)...)
+	if filter.From != nil {
+		query = query.Where(transaction.PostedOnGTE(*filter.From))
+	}
+	if filter.To != nil {
+		query = query.Where(transaction.PostedOnLTE(*filter.To))
+	}
+	if filter.MinAmount != nil {
+		query = query.Where(transaction.AmountGTE(*filter.MinAmount))
+	}
+	if filter.MaxAmount != nil {
+		query = query.Where(transaction.AmountLTE(*filter.MaxAmount))
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	entTransactions, err := query.
+		WithSplits(orderSplits).
+		WithTags(selectTagIDs).
+		WithOutgoingTransfer().
+		WithIncomingTransfer().
+		Order(ent.Desc(transaction.FieldPostedOn), ent.Desc(transaction.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*model.Transaction, 0, len(entTransactions))
+	for _, entTransaction := range entTransactions {
+		t, err := toTransactionModel(entTransaction)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, t)
+	}
+	return transactions, nil
+}
+
+// ListTransferCandidates retrieves the transactions of a workspace that could be one side of a transfer,
+// oldest first, over an optional posting date range: those that are neither split nor already linked,
+// and are uncategorized or in a transfer category
+func (r *TransactionRepository) ListTransferCandidates(ctx context.Context, workspaceID int, from, to *time.Time) ([]*model.Transaction, error) {
+	query := r.client.Transaction.
+		Query().
+		Where(
+			transaction.WorkspaceID(workspaceID),
+			transaction.Not(transaction.HasSplits()),
+			transaction.Not(transaction.HasOutgoingTransfer()),
+			transaction.Not(transaction.HasIncomingTransfer()),
+			transaction.Or(
+				transaction.CategoryIDIsNil(),
+				transaction.HasCategoryWith(category.KindEQ(category.KindTransfer)),
+			),
+		)
+	if from != nil {
+		query = query.Where(transaction.PostedOnGTE(*from))
+	}
+	if to != nil {
+		query = query.Where(transaction.PostedOnLTE(*to))
+	}
+
+	entTransactions, err := query.
+		Order(ent.Asc(transaction.FieldPostedOn), ent.Asc(transaction.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*model.Transaction, 0, len(entTransactions))
+	for _, entTransaction := range entTransactions {
+		t, err := toTransactionModel(entTransaction)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, t)
+	}
+	return transactions, nil
+}
+
+// UpdateTransaction overwrites the editable fields of a transaction and replaces its splits and tags.
+// Callers run it inside a transaction so that the splits are replaced atomically.
+func (r *TransactionRepository) UpdateTransaction(ctx context.Context, t *model.Transaction) (*model.Transaction, error) {
+	update := r.client.Transaction.
+		UpdateOneID(t.ID).
+		Where(transaction.WorkspaceID(t.WorkspaceID)).
+		SetAccountID(t.AccountID).
+		SetPostedOn(t.PostedOn).
+		SetAmount(t.Amount.Decimal()).
+		SetCurrency(t.Amount.Currency()).
+		SetPayee(t.Payee).
+		SetMemo(t.Memo).
+		SetStatus(transaction.Status(t.Status)).
+		ClearTags().
+		AddTagIDs(t.TagIDs...)
+	if t.CategoryID != nil {
+		update.SetCategoryID(*t.CategoryID)
+	} else {
+		update.ClearCategoryID()
+	}
+	if t.PayeeID != nil {
+		update.SetPayeeID(*t.PayeeID)
+	} else {
+		update.ClearPayeeID()
+	}
+
+	entTransaction, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.client.TransactionSplit.
+		Delete().
+		Where(
+			transactionsplit.WorkspaceID(t.WorkspaceID),
+			transactionsplit.TransactionID(t.ID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if entTransaction.Edges.Splits, err = r.createSplits(ctx, t.WorkspaceID, t.ID, t.Splits); err != nil {
+		return nil, err
+	}
+
+	updated, err := toTransactionModel(entTransaction)
+	if err != nil {
+		return nil, err
+	}
+	setCreatedTagIDs(updated, t)
+	return updated, nil
+}
+
+// UpdateStatementDetails saves the external ID, value date, counterparty account, end-to-end reference and status
+// of a transaction, as filled in from a statement row merged into it
+func (r *TransactionRepository) UpdateStatementDetails(ctx context.Context, t *model.Transaction) error {
+	return r.client.Transaction.
+		UpdateOneID(t.ID).
+		Where(transaction.WorkspaceID(t.WorkspaceID)).
+		SetNillableExternalID(t.ExternalID).
+		SetNillableValueDate(t.ValueDate).
+		SetCounterpartyIban(t.CounterpartyIBAN).
+		SetEndToEndID(t.EndToEndID).
+		SetStatus(transaction.Status(t.Status)).
+		Exec(ctx)
+}
+
+// createSplits stores the splits of a transaction
+func (r *TransactionRepository) createSplits(
+	ctx context.Context,
+	workspaceID int,
+	transactionID int,
+	splits []*model.TransactionSplit,
+) ([]*ent.TransactionSplit, error) {
+	if len(splits) == 0 {
+		return nil, nil
+	}
+	builders := make([]*ent.TransactionSplitCreate, 0, len(splits))
+	for _, s := range splits {
+		builders = append(builders, r.client.TransactionSplit.
+			Create().
+			SetWorkspaceID(workspaceID).
+			SetTransactionID(transactionID).
+			SetNillableCategoryID(s.CategoryID).
+			SetAmount(s.Amount.Decimal()).
+			SetCurrency(s.Amount.Currency()).
+			SetMemo(s.Memo).
+			AddTagIDs(s.TagIDs...))
+	}
+	return r.client.TransactionSplit.CreateBulk(builders...).Save(ctx)
+}
+
+// FindExternalIDs returns the IDs of the account's transactions carrying any of the external IDs, by external ID
+func (r *TransactionRepository) FindExternalIDs(ctx context.Context, workspaceID, accountID int, externalIDs []string) (map[string]int, error) {
+	found := make(map[string]int)
+	if len(externalIDs) == 0 {
+		return found, nil
+	}
+
+	entTransactions, err := r.client.Transaction.
+		Query().
+		Where(
+			transaction.WorkspaceID(workspaceID),
+			transaction.AccountID(accountID),
+			transaction.ExternalIDIn(externalIDs...),
+		).
+		Select(transaction.FieldID, transaction.FieldExternalID).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, entTransaction := range entTransactions {
+		found[*entTransaction.ExternalID] = entTransaction.ID
+	}
+	return found, nil
+}
+
+// DeleteTransaction deletes a transaction of the workspace and reports whether one was deleted
+func (r *TransactionRepository) DeleteTransaction(ctx context.Context, workspaceID, id int) (bool, error) {
+	n, err := r.client.Transaction.
+		Delete().
+		Where(
+			transaction.ID(id),
+			transaction.WorkspaceID(workspaceID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// ReassignCategory moves every transaction and split of a category to another category
+func (r *TransactionRepository) ReassignCategory(ctx context.Context, workspaceID, fromCategoryID, toCategoryID int) error {
+	_, err := r.client.Transaction.
+		Update().
+		Where(
+			transaction.WorkspaceID(workspaceID),
+			transaction.CategoryID(fromCategoryID),
+		).
+		SetCategoryID(toCategoryID).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = r.client.TransactionSplit.
+		Update().
+		Where(
+			transactionsplit.WorkspaceID(workspaceID),
+			transactionsplit.CategoryID(fromCategoryID),
+		).
+		SetCategoryID(toCategoryID).
+		Save(ctx)
+	return err
+}
+
+// ReassignPayee moves every transaction of a payee to another payee
+func (r *TransactionRepository) ReassignPayee(ctx context.Context, workspaceID, fromPayeeID, toPayeeID int) error {
+	_, err := r.client.Transaction.
+		Update().
+		Where(
+			transaction.WorkspaceID(workspaceID),
+			transaction.PayeeID(fromPayeeID),
+		).
+		SetPayeeID(toPayeeID).
+		Save(ctx)
+	return err
+}
+
+// categoryTotalRow is a row of the category aggregations of CategoryTotals
+type categoryTotalRow struct {
+	CategoryID *int          `json:"category_id"`
+	Currency   string        `json:"currency"`
+	Total      model.Decimal `json:"total"`
+}
+
+// CategoryTotals sums the workspace's income and expenses per category and currency over the transactions
+// matching the filter. Split transactions are attributed per split rather than to the transaction's own
+// category, and a tag filter selects the splits carrying the tags themselves or through their transaction.
+// Transfers and amounts in transfer categories move money between accounts and are left out.
+func (r *TransactionRepository) CategoryTotals(ctx context.Context, workspaceID int, filter model.ReportFilter) ([]model.CategoryTotal, error) {
+	reported := reportPredicates(workspaceID, filter)
+
+	var unsplit []categoryTotalRow
+	err := r.client.Transaction.
+		Query().
+		Where(reported...).
+		Where(tagPredicates(filter.TagIDs, filter.TagMatch)...).
+		Where(
+			transaction.Not(transaction.HasSplits()),
+			transaction.Or(
+				transaction.CategoryIDIsNil(),
+				transaction.HasCategoryWith(category.KindNEQ(category.KindTransfer)),
+			),
+		).
+		GroupBy(transaction.FieldCategoryID, transaction.FieldCurrency).
+		Aggregate(ent.As(ent.Sum(transaction.FieldAmount), "total")).
+		Scan(ctx, &unsplit)
+	if err != nil {
+		return nil, err
+	}
+
+	var splits []categoryTotalRow
+	err = r.client.TransactionSplit.
+		Query().
+		Where(
+			transactionsplit.WorkspaceID(workspaceID),
+			transactionsplit.HasTransactionWith(reported...),
+			transactionsplit.Or(
+				transactionsplit.CategoryIDIsNil(),
+				transactionsplit.HasCategoryWith(category.KindNEQ(category.KindTransfer)),
+			),
+		).
+		Where(splitTagPredicates(filter.TagIDs, filter.TagMatch)...).
+		GroupBy(transactionsplit.FieldCategoryID, transactionsplit.FieldCurrency).
+		Aggregate(ent.As(ent.Sum(transactionsplit.FieldAmount), "total")).
+		Scan(ctx, &splits)
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeCategoryTotals(append(unsplit, splits...))
+}
+
+// TagTotals sums the income and expenses of the transactions matching the filter per tag and currency,
+// in ascending order of tag ID. Only the filter's tags are reported when it has some.
+// An unsplit transaction counts in full towards each of its tags. A split transaction counts per split:
+// each split amount towards the tags of the split and of the transaction. Transfers and amounts in
+// transfer categories are left out.
+func (r *TransactionRepository) TagTotals(ctx context.Context, workspaceID int, filter model.ReportFilter) ([]model.TagTotal, error) {
+	reported := append(reportPredicates(workspaceID, filter), tagPredicates(filter.TagIDs, filter.TagMatch)...)
+
+	var unsplit []tagTotalRow
+	err := r.client.Transaction.
+		Query().
+		Where(reported...).
+		Where(
+			transaction.Not(transaction.HasSplits()),
+			transaction.Or(
+				transaction.CategoryIDIsNil(),
+				transaction.HasCategoryWith(category.KindNEQ(category.KindTransfer)),
+			),
+		).
+		Modify(func(s *sql.Selector) {
+			tags := sql.Table(transaction.TagsTable)
+			s.Join(tags).On(s.C(transaction.FieldID), tags.C(transaction.TagsPrimaryKey[1]))
+			if len(filter.TagIDs) > 0 {
+				s.Where(sql.InInts(tags.C(transaction.TagsPrimaryKey[0]), filter.TagIDs...))
+			}
+			s.Select(
+				sql.As(tags.C(transaction.TagsPrimaryKey[0]), "tag_id"),
+				s.C(transaction.FieldCurrency),
+				sql.As(sql.Sum(s.C(transaction.FieldAmount)), "total"),
+			).GroupBy(tags.C(transaction.TagsPrimaryKey[0]), s.C(transaction.FieldCurrency))
+		}).
+		Scan(ctx, &unsplit)
+	if err != nil {
+		return nil, err
+	}
+
+	// A split carries its own tags and those of its transaction; joining the tags table on either
+	// counts a tag on both once
+	var splits []tagTotalRow
+	err = r.client.TransactionSplit.
+		Query().
+		Where(
+			transactionsplit.WorkspaceID(workspaceID),
+			transactionsplit.HasTransactionWith(reported...),
+			transactionsplit.Or(
+				transactionsplit.CategoryIDIsNil(),
+				transactionsplit.HasCategoryWith(category.KindNEQ(category.KindTransfer)),
+			),
+		).
+		Modify(func(s *sql.Selector) {
+			tags := sql.Table(tag.Table)
+			splitTags := sql.Table(transactionsplit.TagsTable)
+			transactionTags := sql.Table(transaction.TagsTable)
+			s.Join(tags).OnP(sql.And(
+				sql.EQ(tags.C(tag.FieldWorkspaceID), workspaceID),
+				sql.Or(
+					sql.In(tags.C(tag.FieldID), sql.Select(splitTags.C(transactionsplit.TagsPrimaryKey[0])).
+						From(splitTags).
+						Where(sql.ColumnsEQ(splitTags.C(transactionsplit.TagsPrimaryKey[1]), s.C(transactionsplit.FieldID)))),
+					sql.In(tags.C(tag.FieldID), sql.Select(transactionTags.C(transaction.TagsPrimaryKey[0])).
+						From(transactionTags).
+						Where(sql.ColumnsEQ(transactionTags.C(transaction.TagsPrimaryKey[1]), s.C(transactionsplit.FieldTransactionID)))),
+				),
+			))
+			if len(filter.TagIDs) > 0 {
+				s.Where(sql.InInts(tags.C(tag.FieldID), filter.TagIDs...))
+			}
+			s.Select(
+				sql.As(tags.C(tag.FieldID), "tag_id"),
+				s.C(transactionsplit.FieldCurrency),
+				sql.As(sql.Sum(s.C(transactionsplit.FieldAmount)), "total"),
+			).GroupBy(tags.C(tag.FieldID), s.C(transactionsplit.FieldCurrency))
+		}).
+		Scan(ctx, &splits)
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeTagTotals(append(unsplit, splits...))
+}
+
+// tagTotalRow is a row of the tag aggregations of TagTotals
+type tagTotalRow struct {
+	TagID    int           `json:"tag_id"`
+	Currency string        `json:"currency"`
+	Total    model.Decimal `json:"total"`
+}
+
+// mergeTagTotals adds up rows of the same tag and currency, ordering the totals by tag ID
+func mergeTagTotals(rows []tagTotalRow) ([]model.TagTotal, error) {
+	type key struct {
+		tagID    int
+		currency string
+	}
+	index := make(map[key]int)
+	var totals []model.TagTotal
+	for _, row := range rows {
+		amount, err := model.MoneyFromDecimal(row.Total, row.Currency)
+		if err != nil {
+			return nil, err
+		}
+		k := key{tagID: row.TagID, currency: row.Currency}
+		i, ok := index[k]
+		if !ok {
+			index[k] = len(totals)
+			totals = append(totals, model.TagTotal{TagID: row.TagID, Amount: amount})
+			continue
+		}
+		if totals[i].Amount, err = totals[i].Amount.Add(amount); err != nil {
+			return nil, err
+		}
+	}
+	sort.SliceStable(totals, func(i, j int) bool {
+		if totals[i].TagID != totals[j].TagID {
+			return totals[i].TagID < totals[j].TagID
+		}
+		return totals[i].Amount.Currency() < totals[j].Amount.Currency()
+	})
+	return totals, nil
+}
+
+// reportPredicates returns the predicates selecting the transactions summed by a report:
+// those of the workspace in the filter's date range, leaving out transfers. The filter's tags
+// are left to the caller, see tagPredicates and splitTagPredicates.
+func reportPredicates(workspaceID int, filter model.ReportFilter) []predicate.Transaction {
+	predicates := []predicate.Transaction{
+		transaction.WorkspaceID(workspaceID),
+		transaction.Not(transaction.HasOutgoingTransfer()),
+		transaction.Not(transaction.HasIncomingTransfer()),
+	}
+	if filter.From != nil {
+		predicates = append(predicates, transaction.PostedOnGTE(*filter.From))
+	}
+	if filter.To != nil {
+		predicates = append(predicates, transaction.PostedOnLTE(*filter.To))
+	}
+	return predicates
+}
+
+// tagPredicates returns the predicates selecting transactions by their tags, or the tags of their splits:
+// carrying any of the tags, or all of them with model.TagMatchAll
+func tagPredicates(tagIDs []int, match model.TagMatch) []predicate.Transaction {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	if match != model.TagMatchAll {
+		return []predicate.Transaction{hasTag(tag.IDIn(tagIDs...))}
+	}
+	predicates := make([]predicate.Transaction, 0, len(tagIDs))
+	for _, id := range tagIDs {
+		predicates = append(predicates, hasTag(tag.ID(id)))
+	}
+	return predicates
+}
+
+// hasTag selects transactions carrying a tag matching the predicate themselves or on one of their splits
+func hasTag(p predicate.Tag) predicate.Transaction {
+	return transaction.Or(
+		transaction.HasTagsWith(p),
+		transaction.HasSplitsWith(transactionsplit.HasTagsWith(p)),
+	)
+}
+
+// splitTagPredicates returns the predicates selecting splits by their own tags or those of their transaction:
+// carrying any of the tags, or all of them with model.TagMatchAll
+func splitTagPredicates(tagIDs []int, match model.TagMatch) []predicate.TransactionSplit {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	if match != model.TagMatchAll {
+		return []predicate.TransactionSplit{splitHasTag(tag.IDIn(tagIDs...))}
+	}
+	predicates := make([]predicate.TransactionSplit, 0, len(tagIDs))
+	for _, id := range tagIDs {
+		predicates = append(predicates, splitHasTag(tag.ID(id)))
+	}
+	return predicates
+}
+
+// splitHasTag selects splits carrying a tag matching the predicate themselves or through their transaction
+func splitHasTag(p predicate.Tag) predicate.TransactionSplit {
+	return transactionsplit.Or(
+		transactionsplit.HasTagsWith(p),
+		transactionsplit.HasTransactionWith(transaction.HasTagsWith(p)),
+	)
+}
+
+// mergeCategoryTotals adds up rows of the same category and currency
+func mergeCategoryTotals(rows []categoryTotalRow) ([]model.CategoryTotal, error) {
+	type key struct {
+		categoryID int // 0 for uncategorized amounts
+		currency   string
+	}
+	index := make(map[key]int)
+	var totals []model.CategoryTotal
+	for _, row := range rows {
+		amount, err := model.MoneyFromDecimal(row.Total, row.Currency)
+		if err != nil {
+			return nil, err
+		}
+		k := key{currency: row.Currency}
+		if row.CategoryID != nil {
+			k.categoryID = *row.CategoryID
+		}
+		i, ok := index[k]
+		if !ok {
+			index[k] = len(totals)
+			totals = append(totals, model.CategoryTotal{CategoryID: row.CategoryID, Amount: amount})
+			continue
+		}
+		if totals[i].Amount, err = totals[i].Amount.Add(amount); err != nil {
+			return nil, err
+		}
+	}
+	return totals, nil
+}
+
+// selectTagIDs loads only the IDs of the tags of a transaction, in ascending order
+func selectTagIDs(q *ent.TagQuery) {
+	q.Select(tag.FieldID).Order(ent.Asc(tag.FieldID))
+}
+
+// orderSplits loads the splits of a transaction in the order they were entered, with the IDs of their tags
+func orderSplits(q *ent.TransactionSplitQuery) {
+	q.Order(ent.Asc(transactionsplit.FieldID)).WithTags(selectTagIDs)
+}
+
+// setCreatedTagIDs copies the tags of a stored transaction and of its splits onto the model returned for it,
+// since saving does not load edges back
+func setCreatedTagIDs(created, t *model.Transaction) {
+	created.TagIDs = t.TagIDs
+	for i, split := range created.Splits {
+		split.TagIDs = t.Splits[i].TagIDs
+	}
+}
+
+// transferID returns the ID of the transfer a transaction is a side of, if its transfer edges were loaded
+func transferID(entTransaction *ent.Transaction) *int {
+	if t := entTransaction.Edges.OutgoingTransfer; t != nil {
+		return &t.ID
+	}
+	if t := entTransaction.Edges.IncomingTransfer; t != nil {
+		return &t.ID
+	}
+	return nil
+}
+
+// toTransactionModel converts ent.Transaction to domain model Transaction
+func toTransactionModel(entTransaction *ent.Transaction) (*model.Transaction, error) {
+	amount, err := model.MoneyFromDecimal(entTransaction.Amount, entTransaction.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("transaction %d: %w", entTransaction.ID, err)
+	}
+
+	splits := make([]*model.TransactionSplit, 0, len(entTransaction.Edges.Splits))
+	for _, entSplit := range entTransaction.Edges.Splits {
+		splitAmount, err := model.MoneyFromDecimal(entSplit.Amount, entSplit.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("transaction split %d: %w", entSplit.ID, err)
+		}
+		splitTagIDs := make([]int, 0, len(entSplit.Edges.Tags))
+		for _, entTag := range entSplit.Edges.Tags {
+			splitTagIDs = append(splitTagIDs, entTag.ID)
+		}
+		splits = append(splits, &model.TransactionSplit{
+			ID:         entSplit.ID,
+			CategoryID: entSplit.CategoryID,
+			Amount:     splitAmount,
+			Memo:       entSplit.Memo,
+			TagIDs:     splitTagIDs,
+		})
+	}
+
+	tagIDs := make([]int, 0, len(entTransaction.Edges.Tags))
+	for _, entTag := range entTransaction.Edges.Tags {
+		tagIDs = append(tagIDs, entTag.ID)
+	}
+
+	return &model.Transaction{
+		ID:               entTransaction.ID,
+		WorkspaceID:      entTransaction.WorkspaceID,
+		AccountID:        entTransaction.AccountID,
+		PostedOn:         entTransaction.PostedOn,
+		Amount:           amount,
+		Payee:            entTransaction.Payee,
+		PayeeID:          entTransaction.PayeeID,
+		Memo:             entTransaction.Memo,
+		CategoryID:       entTransaction.CategoryID,
+		Splits:           splits,
+		TransferID:       transferID(entTransaction),
+		TagIDs:           tagIDs,
+		ExternalID:       entTransaction.ExternalID,
+		ValueDate:        entTransaction.ValueDate,
+		CounterpartyIBAN: entTransaction.CounterpartyIban,
+		EndToEndID:       entTransaction.EndToEndID,
+		Status:           model.TransactionStatus(entTransaction.Status),
+		CreatedAt:        entTransaction.CreatedAt,
+		UpdatedAt:        entTransaction.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/category"
+	"backend/internal/infrastructure/sqlitetest"
+	"backend/internal/infrastructure/tenant"
+)
+
+func TestCategoryTotalsByTag(t *testing.T) {
+	client := sqlitetest.NewClient(t)
+	ctx := context.Background()
+	workspaceID := client.Workspace.Create().SetName("Home").SaveX(ctx).ID
+	ctx = tenant.WithWorkspace(ctx, workspaceID)
+
+	money := func(amount string) model.Money {
+		t.Helper()
+		m, err := model.ParseMoney(amount, "USD")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+	accountID := client.Account.Create().
+		SetWorkspaceID(workspaceID).
+		SetName("Checking").
+		SetType(account.TypeBank).
+		SetCurrency("USD").
+		SetOpeningBalance(money("0").Decimal()).
+		SaveX(ctx).ID
+	groceries := client.Category.Create().SetWorkspaceID(workspaceID).SetName("Groceries").SetKind(category.KindExpense).SaveX(ctx).ID
+	household := client.Category.Create().SetWorkspaceID(workspaceID).SetName("Household").SetKind(category.KindExpense).SaveX(ctx).ID
+	vacation := client.Tag.Create().SetWorkspaceID(workspaceID).SetName("vacation").SaveX(ctx).ID
+
+	repo := NewTransactionRepository(client)
+	for _, tx := range []*model.Transaction{
+		// Only the groceries split is tagged; the household split must not count
+		{Amount: money("-100"), Splits: []*model.TransactionSplit{
+			{CategoryID: &groceries, Amount: money("-30"), TagIDs: []int{vacation}},
+			{CategoryID: &household, Amount: money("-70")},
+		}},
+		// A tag on a split transaction applies to all of its splits
+		{Amount: money("-3"), TagIDs: []int{vacation}, Splits: []*model.TransactionSplit{
+			{CategoryID: &groceries, Amount: money("-1")},
+			{CategoryID: &household, Amount: money("-2")},
+		}},
+		{Amount: money("-10"), CategoryID: &groceries, TagIDs: []int{vacation}},
+		{Amount: money("-5"), CategoryID: &groceries},
+	} {
+		tx.WorkspaceID = workspaceID
+		tx.AccountID = accountID
+		tx.PostedOn = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+		tx.Status = model.TransactionStatusUncleared
+		if _, err := repo.CreateTransaction(ctx, tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, match := range []model.TagMatch{model.TagMatchAny, model.TagMatchAll} {
+		totals, err := repo.CategoryTotals(ctx, workspaceID, model.ReportFilter{TagIDs: []int{vacation}, TagMatch: match})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make(map[int]string)
+		for _, total := range totals {
+			got[*total.CategoryID] = total.Amount.Amount()
+		}
+		want := map[int]string{groceries: "-41.00", household: "-2.00"}
+		if len(got) != len(want) || got[groceries] != want[groceries] || got[household] != want[household] {
+			t.Errorf("totals matching %s = %v, want %v", match, got, want)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/transaction"
+	"backend/internal/infrastructure/ent/transfer"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type TransferRepository struct {
+	client *ent.Client
+}
+
+func NewTransferRepository(client *ent.Client) *TransferRepository {
+	return &TransferRepository{client: client}
+}
+
+// CreateTransfer links the two stored transactions of a transfer; both sides must already have IDs
+func (r *TransferRepository) CreateTransfer(ctx context.Context, t *model.Transfer) (*model.Transfer, error) {
+	entTransfer, err := r.client.Transfer.
+		Create().
+		SetWorkspaceID(t.WorkspaceID).
+		SetFromTransactionID(t.From.ID).
+		SetToTransactionID(t.To.ID).
+		SetFromFee(t.FromFee.Decimal()).
+		SetToFee(t.ToFee.Decimal()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created := *t
+	created.ID = entTransfer.ID
+	created.CreatedAt = entTransfer.CreatedAt
+	created.From.TransferID = &created.ID
+	created.To.TransferID = &created.ID
+	return &created, nil
+}
+
+// GetTransfer retrieves a transfer of the workspace by ID together with its two transactions
+func (r *TransferRepository) GetTransfer(ctx context.Context, workspaceID, id int) (*model.Transfer, error) {
+	entTransfer, err := r.client.Transfer.
+		Query().
+		Where(
+			transfer.ID(id),
+			transfer.WorkspaceID(workspaceID),
+		).
+		WithFromTransaction(withTransactionTags).
+		WithToTransaction(withTransactionTags).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toTransferModel(entTransfer)
+}
+
+// ListTransfers retrieves the transfers of a workspace sent over an optional date range, newest first
+func (r *TransferRepository) ListTransfers(ctx context.Context, workspaceID int, from, to *time.Time) ([]*model.Transfer, error) {
+	query := r.client.Transfer.
+		Query().
+		Where(transfer.WorkspaceID(workspaceID))
+	if from != nil {
+		query = query.Where(transfer.HasFromTransactionWith(transaction.PostedOnGTE(*from)))
+	}
+	if to != nil {
+		query = query.Where(transfer.HasFromTransactionWith(transaction.PostedOnLTE(*to)))
+	}
+
+	entTransfers, err := query.
+		WithFromTransaction(withTransactionTags).
+		WithToTransaction(withTransactionTags).
+		Order(
+			transfer.ByFromTransactionField(transaction.FieldPostedOn, sql.OrderDesc()),
+			transfer.ByID(sql.OrderDesc()),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers := make([]*model.Transfer, 0, len(entTransfers))
+	for _, entTransfer := range entTransfers {
+		t, err := toTransferModel(entTransfer)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, t)
+	}
+	return transfers, nil
+}
+
+// UpdateTransferFees overwrites the fees of a transfer; the linked transactions are updated separately
+func (r *TransferRepository) UpdateTransferFees(ctx context.Context, t *model.Transfer) error {
+	return r.client.Transfer.
+		UpdateOneID(t.ID).
+		Where(transfer.WorkspaceID(t.WorkspaceID)).
+		SetFromFee(t.FromFee.Decimal()).
+		SetToFee(t.ToFee.Decimal()).
+		Exec(ctx)
+}
+
+// DeleteTransfer unlinks the two transactions of a transfer, keeping the transactions,
+// and reports whether a transfer was deleted
+func (r *TransferRepository) DeleteTransfer(ctx context.Context, workspaceID, id int) (bool, error) {
+	n, err := r.client.Transfer.
+		Delete().
+		Where(
+			transfer.ID(id),
+			transfer.WorkspaceID(workspaceID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// withTransactionTags loads the tag IDs of the transactions of a transfer
+func withTransactionTags(q *ent.TransactionQuery) {
+	q.WithTags(selectTagIDs)
+}
+
+// toTransferModel converts ent.Transfer with its loaded transactions to domain model Transfer
+func toTransferModel(entTransfer *ent.Transfer) (*model.Transfer, error) {
+	from, err := toTransactionModel(entTransfer.Edges.FromTransaction)
+	if err != nil {
+		return nil, err
+	}
+	to, err := toTransactionModel(entTransfer.Edges.ToTransaction)
+	if err != nil {
+		return nil, err
+	}
+	from.TransferID = &entTransfer.ID
+	to.TransferID = &entTransfer.ID
+
+	fromFee, err := model.MoneyFromDecimal(entTransfer.FromFee, from.Amount.Currency())
+	if err != nil {
+		return nil, fmt.Errorf("transfer %d: %w", entTransfer.ID, err)
+	}
+	toFee, err := model.MoneyFromDecimal(entTransfer.ToFee, to.Amount.Currency())
+	if err != nil {
+		return nil, fmt.Errorf("transfer %d: %w", entTransfer.ID, err)
+	}
+
+	return &model.Transfer{
+		ID:          entTransfer.ID,
+		WorkspaceID: entTransfer.WorkspaceID,
+		From:        from,
+		To:          to,
+		FromFee:     fromFee,
+		ToFee:       toFee,
+		CreatedAt:   entTransfer.CreatedAt,
+	}, nil
+}
@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"strings"
+	"time"
 
 	"backend/internal/domain/model"
 	"backend/internal/infrastructure/ent"
@@ -30,6 +31,15 @@ func (r *UserRepository) CreateUser(ctx context.Context, email, passwordHash str
 	return toUserModel(entUser), nil
 }
 
+// GetUserByID retrieves a user by ID
+func (r *UserRepository) GetUserByID(ctx context.Context, id int) (*model.User, error) {
+	entUser, err := r.client.User.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toUserModel(entUser), nil
+}
+
 // GetUserByEmail retrieves a user by email (case-insensitive)
 func (r *UserRepository) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
 	entUser, err := r.client.User.
@@ -50,13 +60,85 @@ func (r *UserRepository) EmailExists(ctx context.Context, email string) (bool, e
 		Exist(ctx)
 }
 
+// SetPendingTOTPSecret stores a new TOTP secret while two-factor authentication is not yet enabled
+func (r *UserRepository) SetPendingTOTPSecret(ctx context.Context, id int, secret string) error {
+	return r.client.User.
+		UpdateOneID(id).
+		SetTotpSecret(secret).
+		ClearTotpLastUsedStep().
+		Exec(ctx)
+}
+
+// ClaimTOTPStep records step as the last used TOTP step, reporting false if it (or a later step) was already used
+func (r *UserRepository) ClaimTOTPStep(ctx context.Context, id int, step int64) (bool, error) {
+	n, err := r.client.User.
+		Update().
+		Where(
+			user.ID(id),
+			user.Or(
+				user.TotpLastUsedStepIsNil(),
+				user.TotpLastUsedStepLT(step),
+			),
+		).
+		SetTotpLastUsedStep(step).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// RecordFailedTwoFactorAttempt counts a wrong second-factor code. When limit codes in a row were wrong,
+// the count starts over and codes are refused until lockedUntil; it then reports true.
+func (r *UserRepository) RecordFailedTwoFactorAttempt(ctx context.Context, id int, limit int, lockedUntil time.Time) (bool, error) {
+	// Incremented in the database so that concurrent attempts are all counted
+	err := r.client.User.
+		UpdateOneID(id).
+		AddTwoFactorFailedAttempts(1).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := r.client.User.
+		Update().
+		Where(
+			user.ID(id),
+			user.TwoFactorFailedAttemptsGTE(limit),
+		).
+		SetTwoFactorFailedAttempts(0).
+		SetTwoFactorLockedUntil(lockedUntil).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// ResetTwoFactorAttempts clears the count of wrong second-factor codes and any lock
+func (r *UserRepository) ResetTwoFactorAttempts(ctx context.Context, id int) error {
+	return r.client.User.
+		UpdateOneID(id).
+		SetTwoFactorFailedAttempts(0).
+		ClearTwoFactorLockedUntil().
+		Exec(ctx)
+}
+
 // toModel converts ent.User to domain model User
 func toUserModel(entUser *ent.User) *model.User {
-	return &model.User{
-		ID:           entUser.ID,
-		Email:        entUser.Email,
-		PasswordHash: entUser.PasswordHash,
-		CreatedAt:    entUser.CreatedAt,
-		UpdatedAt:    entUser.UpdatedAt,
+	u := &model.User{
+		ID:                      entUser.ID,
+		Email:                   entUser.Email,
+		PasswordHash:            entUser.PasswordHash,
+		EmailVerifiedAt:         entUser.EmailVerifiedAt,
+		TwoFactorEnabledAt:      entUser.TwoFactorEnabledAt,
+		TwoFactorFailedAttempts: entUser.TwoFactorFailedAttempts,
+		TwoFactorLockedUntil:    entUser.TwoFactorLockedUntil,
+		CreatedAt:               entUser.CreatedAt,
+		UpdatedAt:               entUser.UpdatedAt,
+	}
+	if entUser.TotpSecret != nil {
+		u.TOTPSecret = *entUser.TotpSecret
 	}
+	return u
 }
@@ -0,0 +1,45 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/internal/infrastructure/sqlitetest"
+)
+
+func TestRecordFailedTwoFactorAttempt(t *testing.T) {
+	client := sqlitetest.NewClient(t)
+	ctx := context.Background()
+	id := client.User.Create().SetEmail("ada@example.com").SetPasswordHash("hash").SaveX(ctx).ID
+	repo := NewUserRepository(client)
+	lockedUntil := time.Now().Add(time.Hour)
+
+	for attempt := 1; attempt <= 3; attempt++ {
+		locked, err := repo.RecordFailedTwoFactorAttempt(ctx, id, 3, lockedUntil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if locked != (attempt == 3) {
+			t.Errorf("attempt %d: locked = %v", attempt, locked)
+		}
+	}
+	user, err := repo.GetUserByID(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !user.IsTwoFactorLocked(time.Now()) || user.TwoFactorFailedAttempts != 0 {
+		t.Errorf("after the limit: locked until %v with %d attempts, want locked with the count started over",
+			user.TwoFactorLockedUntil, user.TwoFactorFailedAttempts)
+	}
+
+	if err := repo.ResetTwoFactorAttempts(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	if user, err = repo.GetUserByID(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	if user.IsTwoFactorLocked(time.Now()) {
+		t.Errorf("after reset: locked until %v", user.TwoFactorLockedUntil)
+	}
+}
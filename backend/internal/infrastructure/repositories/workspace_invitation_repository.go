@@ -0,0 +1,110 @@
+package repositories
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/workspaceinvitation"
+)
+
+type WorkspaceInvitationRepository struct {
+	client *ent.Client
+}
+
+func NewWorkspaceInvitationRepository(client *ent.Client) *WorkspaceInvitationRepository {
+	return &WorkspaceInvitationRepository{client: client}
+}
+
+// CreateInvitation stores a new pending invitation
+func (r *WorkspaceInvitationRepository) CreateInvitation(
+	ctx context.Context,
+	workspaceID int,
+	email string,
+	role model.Role,
+	tokenHash string,
+	expiresAt time.Time,
+	invitedByID int,
+) (*model.WorkspaceInvitation, error) {
+	entInvitation, err := r.client.WorkspaceInvitation.
+		Create().
+		SetWorkspaceID(workspaceID).
+		SetEmail(email).
+		SetRole(workspaceinvitation.Role(role)).
+		SetTokenHash(tokenHash).
+		SetExpiresAt(expiresAt).
+		SetInvitedByID(invitedByID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toWorkspaceInvitationModel(entInvitation), nil
+}
+
+// ListInvitationsByWorkspaceID retrieves all invitations of a workspace, newest first
+func (r *WorkspaceInvitationRepository) ListInvitationsByWorkspaceID(ctx context.Context, workspaceID int) ([]*model.WorkspaceInvitation, error) {
+	entInvitations, err := r.client.WorkspaceInvitation.
+		Query().
+		Where(workspaceinvitation.WorkspaceID(workspaceID)).
+		Order(ent.Desc(workspaceinvitation.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations := make([]*model.WorkspaceInvitation, 0, len(entInvitations))
+	for _, entInvitation := range entInvitations {
+		invitations = append(invitations, toWorkspaceInvitationModel(entInvitation))
+	}
+	return invitations, nil
+}
+
+// RevokeInvitation revokes a pending invitation of the workspace and reports whether one was revoked
+func (r *WorkspaceInvitationRepository) RevokeInvitation(ctx context.Context, workspaceID, id int) (bool, error) {
+	n, err := r.client.WorkspaceInvitation.
+		Update().
+		Where(
+			workspaceinvitation.ID(id),
+			workspaceinvitation.WorkspaceID(workspaceID),
+			workspaceinvitation.StatusEQ(workspaceinvitation.StatusPending),
+		).
+		SetStatus(workspaceinvitation.StatusRevoked).
+		SetRespondedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// RevokePendingInvitationsForEmail revokes every pending invitation of the workspace sent to the email
+func (r *WorkspaceInvitationRepository) RevokePendingInvitationsForEmail(ctx context.Context, workspaceID int, email string) error {
+	return r.client.WorkspaceInvitation.
+		Update().
+		Where(
+			workspaceinvitation.WorkspaceID(workspaceID),
+			workspaceinvitation.Email(strings.ToLower(email)),
+			workspaceinvitation.StatusEQ(workspaceinvitation.StatusPending),
+		).
+		SetStatus(workspaceinvitation.StatusRevoked).
+		SetRespondedAt(time.Now()).
+		Exec(ctx)
+}
+
+// toWorkspaceInvitationModel converts ent.WorkspaceInvitation to domain model WorkspaceInvitation
+func toWorkspaceInvitationModel(entInvitation *ent.WorkspaceInvitation) *model.WorkspaceInvitation {
+	return &model.WorkspaceInvitation{
+		ID:           entInvitation.ID,
+		WorkspaceID:  entInvitation.WorkspaceID,
+		Email:        entInvitation.Email,
+		Role:         model.Role(entInvitation.Role),
+		Status:       model.InvitationStatus(entInvitation.Status),
+		ExpiresAt:    entInvitation.ExpiresAt,
+		InvitedByID:  entInvitation.InvitedByID,
+		AcceptedByID: entInvitation.AcceptedByID,
+		RespondedAt:  entInvitation.RespondedAt,
+		CreatedAt:    entInvitation.CreatedAt,
+	}
+}
@@ -5,6 +5,8 @@ import (
 
 	"backend/internal/domain/model"
 	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/user"
+	"backend/internal/infrastructure/ent/workspace"
 )
 
 type WorkspaceRepository struct {
@@ -27,12 +29,72 @@ func (r *WorkspaceRepository) CreateWorkspace(ctx context.Context, name string)
 	return toWorkspaceModel(entWorkspace), nil
 }
 
+// GetWorkspaceByID retrieves a workspace by ID
+func (r *WorkspaceRepository) GetWorkspaceByID(ctx context.Context, id int) (*model.Workspace, error) {
+	entWorkspace, err := r.client.Workspace.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toWorkspaceModel(entWorkspace), nil
+}
+
+// GetWorkspaceForUser retrieves a workspace only if the user is a member of it
+func (r *WorkspaceRepository) GetWorkspaceForUser(ctx context.Context, workspaceID, userID int) (*model.Workspace, error) {
+	entWorkspace, err := r.client.Workspace.
+		Query().
+		Where(
+			workspace.ID(workspaceID),
+			workspace.HasUsersWith(user.ID(userID)),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toWorkspaceModel(entWorkspace), nil
+}
+
+// UpdateRequireVerifiedEmail changes whether members need a verified email to access financial data
+func (r *WorkspaceRepository) UpdateRequireVerifiedEmail(ctx context.Context, id int, require bool) (*model.Workspace, error) {
+	entWorkspace, err := r.client.Workspace.
+		UpdateOneID(id).
+		SetRequireVerifiedEmail(require).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toWorkspaceModel(entWorkspace), nil
+}
+
+// DeleteWorkspace deletes a workspace; memberships are removed by the cascading foreign key
+func (r *WorkspaceRepository) DeleteWorkspace(ctx context.Context, id int) error {
+	return r.client.Workspace.DeleteOneID(id).Exec(ctx)
+}
+
+// ListWorkspacesByUserID retrieves the workspaces a user belongs to, oldest first
+func (r *WorkspaceRepository) ListWorkspacesByUserID(ctx context.Context, userID int) ([]*model.Workspace, error) {
+	entWorkspaces, err := r.client.Workspace.
+		Query().
+		Where(workspace.HasUsersWith(user.ID(userID))).
+		Order(ent.Asc(workspace.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces := make([]*model.Workspace, 0, len(entWorkspaces))
+	for _, entWorkspace := range entWorkspaces {
+		workspaces = append(workspaces, toWorkspaceModel(entWorkspace))
+	}
+	return workspaces, nil
+}
+
 // toModel converts ent.Workspace to domain model Workspace
 func toWorkspaceModel(entWorkspace *ent.Workspace) *model.Workspace {
 	return &model.Workspace{
-		ID:        entWorkspace.ID,
-		Name:      entWorkspace.Name,
-		CreatedAt: entWorkspace.CreatedAt,
-		UpdatedAt: entWorkspace.UpdatedAt,
+		ID:                   entWorkspace.ID,
+		Name:                 entWorkspace.Name,
+		RequireVerifiedEmail: entWorkspace.RequireVerifiedEmail,
+		CreatedAt:            entWorkspace.CreatedAt,
+		UpdatedAt:            entWorkspace.UpdatedAt,
 	}
 }
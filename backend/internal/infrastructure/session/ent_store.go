@@ -0,0 +1,227 @@
+package session
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"time"
+
+	"backend/internal/infrastructure/ent"
+	entsession "backend/internal/infrastructure/ent/session"
+
+	"github.com/gorilla/securecookie"
+	"github.com/gorilla/sessions"
+)
+
+// lastSeenInterval throttles last_seen_at updates so that not every request writes to the database
+const lastSeenInterval = time.Minute
+
+var tokenEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// EntStore is a sessions.Store that keeps session values in the sessions table.
+// The cookie only carries a signed, opaque token, so sessions can be listed and revoked server-side.
+type EntStore struct {
+	Codecs  []securecookie.Codec
+	Options *sessions.Options // default configuration
+
+	client     *ent.Client
+	serializer securecookie.GobEncoder
+}
+
+// NewEntStore returns a new EntStore backed by the given ent client
+func NewEntStore(client *ent.Client, keyPairs ...[]byte) *EntStore {
+	s := &EntStore{
+		Codecs: securecookie.CodecsFromPairs(keyPairs...),
+		Options: &sessions.Options{
+			Path:   "/",
+			MaxAge: 86400 * 30,
+		},
+		client: client,
+	}
+
+	s.MaxAge(s.Options.MaxAge)
+	return s
+}
+
+// Get returns a session for the given name after adding it to the registry.
+func (s *EntStore) Get(r *http.Request, name string) (*sessions.Session, error) {
+	return sessions.GetRegistry(r).Get(s, name)
+}
+
+// New returns a session for the given name without adding it to the registry.
+// A missing, revoked or expired session results in a fresh session with IsNew set.
+func (s *EntStore) New(r *http.Request, name string) (*sessions.Session, error) {
+	session := sessions.NewSession(s, name)
+	opts := *s.Options
+	session.Options = &opts
+	session.IsNew = true
+
+	c, errCookie := r.Cookie(name)
+	if errCookie != nil {
+		return session, nil
+	}
+
+	var token string
+	if err := securecookie.DecodeMulti(name, c.Value, &token, s.Codecs...); err != nil {
+		return session, err
+	}
+
+	row, err := s.find(r.Context(), token)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return session, nil
+		}
+		return session, err
+	}
+
+	if err := s.serializer.Deserialize(row.Data, &session.Values); err != nil {
+		return session, err
+	}
+	session.ID = token
+	session.IsNew = false
+
+	if time.Since(row.LastSeenAt) >= lastSeenInterval {
+		err := row.Update().
+			SetLastSeenAt(time.Now()).
+			SetUserAgent(r.UserAgent()).
+			SetIPAddress(clientIP(r)).
+			Exec(r.Context())
+		if err != nil {
+			return session, err
+		}
+	}
+
+	return session, nil
+}
+
+// Save persists the session values and writes the token cookie.
+// A session with Options.MaxAge <= 0 is deleted from the database and its cookie expired.
+func (s *EntStore) Save(r *http.Request, w http.ResponseWriter, session *sessions.Session) error {
+	ctx := r.Context()
+
+	if session.Options.MaxAge <= 0 {
+		if err := s.Destroy(ctx, session); err != nil {
+			return err
+		}
+		http.SetCookie(w, sessions.NewCookie(session.Name(), "", session.Options))
+		return nil
+	}
+
+	data, err := s.serializer.Serialize(session.Values)
+	if err != nil {
+		return err
+	}
+	expiresAt := time.Now().Add(time.Duration(session.Options.MaxAge) * time.Second)
+
+	var userID *int
+	if id, ok := session.Values[userIDKey].(int); ok {
+		userID = &id
+	}
+
+	row, err := s.findIfPresent(ctx, session.ID)
+	if err != nil {
+		return err
+	}
+	if row != nil {
+		err = row.Update().
+			SetData(data).
+			SetNillableUserID(userID).
+			SetExpiresAt(expiresAt).
+			Exec(ctx)
+	} else {
+		session.ID = tokenEncoding.EncodeToString(securecookie.GenerateRandomKey(32))
+		err = s.client.Session.
+			Create().
+			SetTokenHash(hashToken(session.ID)).
+			SetNillableUserID(userID).
+			SetData(data).
+			SetUserAgent(r.UserAgent()).
+			SetIPAddress(clientIP(r)).
+			SetExpiresAt(expiresAt).
+			Exec(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	encoded, err := securecookie.EncodeMulti(session.Name(), session.ID, s.Codecs...)
+	if err != nil {
+		return err
+	}
+	http.SetCookie(w, sessions.NewCookie(session.Name(), encoded, session.Options))
+	return nil
+}
+
+// Destroy deletes the stored row for the session, if any, and resets its ID
+func (s *EntStore) Destroy(ctx context.Context, session *sessions.Session) error {
+	if session.ID != "" {
+		_, err := s.client.Session.
+			Delete().
+			Where(entsession.TokenHash(hashToken(session.ID))).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	session.ID = ""
+	return nil
+}
+
+// MaxAge sets the maximum age for the store and the underlying cookie implementation.
+func (s *EntStore) MaxAge(age int) {
+	s.Options.MaxAge = age
+
+	for _, codec := range s.Codecs {
+		if sc, ok := codec.(*securecookie.SecureCookie); ok {
+			sc.MaxAge(age)
+		}
+	}
+}
+
+// recordID returns the database ID of the session row, or 0 when the session is not stored
+func (s *EntStore) recordID(ctx context.Context, session *sessions.Session) (int, error) {
+	row, err := s.findIfPresent(ctx, session.ID)
+	if err != nil || row == nil {
+		return 0, err
+	}
+	return row.ID, nil
+}
+
+// find loads the unexpired session row for the token
+func (s *EntStore) find(ctx context.Context, token string) (*ent.Session, error) {
+	return s.client.Session.
+		Query().
+		Where(
+			entsession.TokenHash(hashToken(token)),
+			entsession.ExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+}
+
+// findIfPresent is like find but returns nil instead of a not-found error
+func (s *EntStore) findIfPresent(ctx context.Context, token string) (*ent.Session, error) {
+	if token == "" {
+		return nil, nil
+	}
+	row, err := s.find(ctx, token)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return row, err
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
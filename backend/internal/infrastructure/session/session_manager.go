@@ -2,23 +2,39 @@ package session
 
 import (
 	"net/http"
+	"time"
+
+	"backend/internal/infrastructure/ent"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/sessions"
 )
 
 const (
-	sessionName = "finsight_session"
-	userIDKey   = "user_id"
-	emailKey    = "email"
+	sessionName    = "finsight_session"
+	userIDKey      = "user_id"
+	emailKey       = "email"
 	workspaceIDKey = "workspace_id"
+
+	// Keys for a login that passed the password step but still needs a second factor
+	pendingUserIDKey      = "pending_2fa_user_id"
+	pendingWorkspaceIDKey = "pending_2fa_workspace_id"
+	pendingStartedAtKey   = "pending_2fa_started_at"
+	pendingAttemptsKey    = "pending_2fa_attempts"
+)
+
+const (
+	// pendingTwoFactorTTL is how long a user has to enter the second factor after the password
+	pendingTwoFactorTTL = 5 * time.Minute
+	// maxTwoFactorAttempts is how many wrong codes are accepted before the login has to start over
+	maxTwoFactorAttempts = 5
 )
 
-var store *sessions.CookieStore
+var store *EntStore
 
-// InitStore initializes the session store with the provided secret key
-func InitStore(secretKey string) *sessions.CookieStore {
-	store = sessions.NewCookieStore([]byte(secretKey))
+// InitStore initializes the database-backed session store with the provided secret key
+func InitStore(secretKey string, client *ent.Client) *EntStore {
+	store = NewEntStore(client, []byte(secretKey))
 
 	// Session options
 	store.Options = &sessions.Options{
@@ -28,16 +44,21 @@ func InitStore(secretKey string) *sessions.CookieStore {
 		Secure:   false, // Set to true in production (HTTPS only)
 		SameSite: http.SameSiteLaxMode,
 	}
+	store.MaxAge(store.Options.MaxAge)
 
 	return store
 }
 
-// SetSession sets user session data
+// SetSession sets user session data under a freshly issued session ID
 func SetSession(c *gin.Context, userID int, email string, workspaceID int) error {
-	session, err := store.Get(c.Request, sessionName)
-	if err != nil {
+	// A cookie that cannot be decoded anymore is simply replaced
+	session, _ := store.Get(c.Request, sessionName)
+
+	// Rotate the session ID on login to prevent session fixation
+	if err := store.Destroy(c.Request.Context(), session); err != nil {
 		return err
 	}
+	session.Values = make(map[interface{}]interface{})
 
 	session.Values[userIDKey] = userID
 	session.Values[emailKey] = email
@@ -46,6 +67,114 @@ func SetSession(c *gin.Context, userID int, email string, workspaceID int) error
 	return session.Save(c.Request, c.Writer)
 }
 
+// SwitchWorkspace changes the active workspace of the current session.
+// Callers must have checked that the user is a member of the workspace.
+func SwitchWorkspace(c *gin.Context, workspaceID int) error {
+	session, err := store.Get(c.Request, sessionName)
+	if err != nil {
+		return err
+	}
+	if _, ok := session.Values[userIDKey].(int); !ok {
+		return http.ErrNoCookie
+	}
+
+	session.Values[workspaceIDKey] = workspaceID
+	return session.Save(c.Request, c.Writer)
+}
+
+// SetPendingTwoFactor starts a partially authenticated session that only allows completing the second factor
+func SetPendingTwoFactor(c *gin.Context, userID int, workspaceID int) error {
+	// A cookie that cannot be decoded anymore is simply replaced
+	session, _ := store.Get(c.Request, sessionName)
+
+	if err := store.Destroy(c.Request.Context(), session); err != nil {
+		return err
+	}
+	session.Values = make(map[interface{}]interface{})
+
+	session.Values[pendingUserIDKey] = userID
+	session.Values[pendingWorkspaceIDKey] = workspaceID
+	session.Values[pendingStartedAtKey] = time.Now().Unix()
+	session.Values[pendingAttemptsKey] = 0
+
+	return session.Save(c.Request, c.Writer)
+}
+
+// GetPendingTwoFactor retrieves the partially authenticated login, failing once it has expired
+func GetPendingTwoFactor(c *gin.Context) (userID int, workspaceID int, err error) {
+	session, err := store.Get(c.Request, sessionName)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	userID, ok := session.Values[pendingUserIDKey].(int)
+	if !ok {
+		return 0, 0, http.ErrNoCookie
+	}
+	workspaceID, ok = session.Values[pendingWorkspaceIDKey].(int)
+	if !ok {
+		return 0, 0, http.ErrNoCookie
+	}
+	startedAt, ok := session.Values[pendingStartedAtKey].(int64)
+	if !ok || time.Since(time.Unix(startedAt, 0)) > pendingTwoFactorTTL {
+		return 0, 0, http.ErrNoCookie
+	}
+
+	return userID, workspaceID, nil
+}
+
+// RecordFailedTwoFactorAttempt counts a wrong code and reports whether the pending login may continue.
+// Once the limit is reached the pending login is discarded.
+func RecordFailedTwoFactorAttempt(c *gin.Context) (bool, error) {
+	session, err := store.Get(c.Request, sessionName)
+	if err != nil {
+		return false, err
+	}
+
+	attempts, _ := session.Values[pendingAttemptsKey].(int)
+	attempts++
+	if attempts >= maxTwoFactorAttempts {
+		return false, ClearSession(c)
+	}
+
+	session.Values[pendingAttemptsKey] = attempts
+	return true, session.Save(c.Request, c.Writer)
+}
+
+// ClearSession removes user session data and expires the cookie
+func ClearSession(c *gin.Context) error {
+	// An undecodable cookie still has to be overwritten, so the error is ignored
+	session, _ := store.Get(c.Request, sessionName)
+
+	session.Values = make(map[interface{}]interface{})
+	session.Options = &sessions.Options{
+		Path:     store.Options.Path,
+		MaxAge:   -1,
+		HttpOnly: store.Options.HttpOnly,
+		Secure:   store.Options.Secure,
+		SameSite: store.Options.SameSite,
+	}
+
+	return session.Save(c.Request, c.Writer)
+}
+
+// CurrentSessionID returns the database ID of the session attached to the request
+func CurrentSessionID(c *gin.Context) (int, error) {
+	session, err := store.Get(c.Request, sessionName)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := store.recordID(c.Request.Context(), session)
+	if err != nil {
+		return 0, err
+	}
+	if id == 0 {
+		return 0, http.ErrNoCookie
+	}
+	return id, nil
+}
+
 // GetSession retrieves user session data
 func GetSession(c *gin.Context) (userID int, email string, workspaceID int, err error) {
 	session, err := store.Get(c.Request, sessionName)
@@ -53,20 +182,22 @@ func GetSession(c *gin.Context) (userID int, email string, workspaceID int, err
 		return 0, "", 0, err
 	}
 
-	userIDVal, ok := session.Values[userIDKey]
+	var ok bool
+	// Type assertions are checked so that unexpected cookie contents read as "no session"
+	userID, ok = session.Values[userIDKey].(int)
 	if !ok {
 		return 0, "", 0, http.ErrNoCookie
 	}
 
-	emailVal, ok := session.Values[emailKey]
+	email, ok = session.Values[emailKey].(string)
 	if !ok {
 		return 0, "", 0, http.ErrNoCookie
 	}
 
-	workspaceIDVal, ok := session.Values[workspaceIDKey]
+	workspaceID, ok = session.Values[workspaceIDKey].(int)
 	if !ok {
 		return 0, "", 0, http.ErrNoCookie
 	}
 
-	return userIDVal.(int), emailVal.(string), workspaceIDVal.(int), nil
+	return userID, email, workspaceID, nil
 }
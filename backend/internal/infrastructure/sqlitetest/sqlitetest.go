@@ -0,0 +1,78 @@
+// Package sqlitetest opens ent clients on in-memory SQLite databases for tests.
+package sqlitetest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strconv"
+	"testing"
+
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/enttest"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/mattn/go-sqlite3"
+)
+
+// driverName is a SQLite driver that returns REAL values as text, as Postgres returns numeric
+// columns; SQLite stores numeric columns as floats, which model.Decimal refuses to scan
+const driverName = "sqlite3_text_numerics"
+
+func init() {
+	sql.Register(driverName, textNumericsDriver{})
+}
+
+// NewClient returns a client on a fresh in-memory database with the schema migrated,
+// closed when the test ends. Each test gets its own database, named after it.
+func NewClient(t *testing.T) *ent.Client {
+	t.Helper()
+	db, err := sql.Open(driverName, "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(entsql.OpenDB(dialect.SQLite, db))))
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+type textNumericsDriver struct {
+	sqlite3.SQLiteDriver
+}
+
+func (d textNumericsDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.SQLiteDriver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return textNumericsConn{conn.(*sqlite3.SQLiteConn)}, nil
+}
+
+type textNumericsConn struct {
+	*sqlite3.SQLiteConn
+}
+
+func (c textNumericsConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := c.SQLiteConn.QueryContext(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+	return textNumericsRows{rows}, nil
+}
+
+type textNumericsRows struct {
+	driver.Rows
+}
+
+func (r textNumericsRows) Next(dest []driver.Value) error {
+	if err := r.Rows.Next(dest); err != nil {
+		return err
+	}
+	for i, v := range dest {
+		if f, ok := v.(float64); ok {
+			dest[i] = strconv.FormatFloat(f, 'f', -1, 64)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Package tenant scopes ent queries and mutations of workspace-owned entities
+// to the workspace stored in the request context.
+package tenant
+
+import (
+	"context"
+
+	"backend/internal/infrastructure/ent/privacy"
+
+	"entgo.io/ent"
+	"entgo.io/ent/entql"
+)
+
+type workspaceKey struct{}
+
+// WithWorkspace returns a context in which workspace-owned entities are restricted to the workspace
+func WithWorkspace(ctx context.Context, workspaceID int) context.Context {
+	return context.WithValue(ctx, workspaceKey{}, workspaceID)
+}
+
+// WorkspaceFromContext returns the workspace the context is scoped to
+func WorkspaceFromContext(ctx context.Context) (int, bool) {
+	workspaceID, ok := ctx.Value(workspaceKey{}).(int)
+	return workspaceID, ok
+}
+
+// Bypass returns a context that skips workspace scoping entirely.
+// It is meant for lookups authorized by other means, e.g. an emailed token, and must not be
+// passed on to code that acts on behalf of a user.
+func Bypass(ctx context.Context) context.Context {
+	return privacy.DecisionContext(ctx, privacy.Allow)
+}
+
+// workspaceFilter is implemented by the entql filters of entities with a workspace_id field
+type workspaceFilter interface {
+	WhereWorkspaceID(entql.IntP)
+}
+
+// FilterWorkspaceRule restricts queries, updates and deletes to rows of the context's workspace.
+// Contexts without a workspace are denied rather than left unfiltered.
+func FilterWorkspaceRule() privacy.QueryMutationRule {
+	return privacy.FilterFunc(func(ctx context.Context, f privacy.Filter) error {
+		workspaceID, ok := WorkspaceFromContext(ctx)
+		if !ok {
+			return privacy.Denyf("tenant: missing workspace in context")
+		}
+		wf, ok := f.(workspaceFilter)
+		if !ok {
+			return privacy.Denyf("tenant: unexpected filter type %T", f)
+		}
+		wf.WhereWorkspaceID(entql.IntEQ(workspaceID))
+		return privacy.Skip
+	})
+}
+
+// DenyCrossWorkspaceCreateRule rejects creating rows that belong to a workspace other than the context's.
+// Filters have no effect on inserts, so creates are checked against the workspace_id being set.
+func DenyCrossWorkspaceCreateRule() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		if !m.Op().Is(ent.OpCreate) {
+			return privacy.Skip
+		}
+		workspaceID, ok := WorkspaceFromContext(ctx)
+		if !ok {
+			return privacy.Denyf("tenant: missing workspace in context")
+		}
+		value, exists := m.Field("workspace_id")
+		if !exists {
+			return privacy.Denyf("tenant: %s created without a workspace", m.Type())
+		}
+		if id, ok := value.(int); !ok || id != workspaceID {
+			return privacy.Denyf("tenant: %s cannot be created in another workspace", m.Type())
+		}
+		return privacy.Skip
+	})
+}
+
+// Policy is the privacy policy of every workspace-owned entity
+func Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			DenyCrossWorkspaceCreateRule(),
+			FilterWorkspaceRule(),
+		},
+		Query: privacy.QueryPolicy{
+			FilterWorkspaceRule(),
+		},
+	}
+}
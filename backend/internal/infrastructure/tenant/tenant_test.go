@@ -0,0 +1,189 @@
+package tenant_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"backend/internal/domain/model"
+	"backend/internal/infrastructure/ent"
+	"backend/internal/infrastructure/ent/account"
+	"backend/internal/infrastructure/ent/privacy"
+	"backend/internal/infrastructure/ent/tag"
+	"backend/internal/infrastructure/sqlitetest"
+	"backend/internal/infrastructure/tenant"
+)
+
+// fixture holds two workspaces with a tag and an account each
+type fixture struct {
+	client             *ent.Client
+	workspaceA         int
+	workspaceB         int
+	tagA, tagB         int
+	accountA, accountB int
+}
+
+func newFixture(t *testing.T) fixture {
+	t.Helper()
+	client := sqlitetest.NewClient(t)
+
+	ctx := context.Background()
+	f := fixture{client: client}
+	f.workspaceA = client.Workspace.Create().SetName("A").SaveX(ctx).ID
+	f.workspaceB = client.Workspace.Create().SetName("B").SaveX(ctx).ID
+
+	for _, ws := range []struct {
+		id      int
+		tag     *int
+		account *int
+	}{{f.workspaceA, &f.tagA, &f.accountA}, {f.workspaceB, &f.tagB, &f.accountB}} {
+		wsCtx := tenant.WithWorkspace(ctx, ws.id)
+		*ws.tag = client.Tag.Create().SetWorkspaceID(ws.id).SetName("groceries").SaveX(wsCtx).ID
+		*ws.account = client.Account.Create().
+			SetWorkspaceID(ws.id).
+			SetName("Checking").
+			SetType(account.TypeBank).
+			SetCurrency("USD").
+			SetOpeningBalance(mustDecimal(t, "1234.56")).
+			SaveX(wsCtx).ID
+	}
+	return f
+}
+
+func mustDecimal(t *testing.T, s string) model.Decimal {
+	t.Helper()
+	d, err := model.ParseDecimal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestQueriesAreScopedToWorkspace(t *testing.T) {
+	f := newFixture(t)
+	ctx := tenant.WithWorkspace(context.Background(), f.workspaceA)
+
+	tags, err := f.client.Tag.Query().All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 1 || tags[0].ID != f.tagA {
+		t.Errorf("tags of workspace A = %v, want only tag %d", tags, f.tagA)
+	}
+
+	// Naming the other workspace's rows explicitly finds nothing either
+	if _, err := f.client.Tag.Get(ctx, f.tagB); !ent.IsNotFound(err) {
+		t.Errorf("Get(tag of workspace B) error = %v, want not found", err)
+	}
+	n, err := f.client.Tag.Query().Where(tag.WorkspaceID(f.workspaceB)).Count(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Errorf("tags of workspace B counted from workspace A = %d, want 0", n)
+	}
+
+	// Edges are filtered too
+	accounts, err := f.client.Workspace.GetX(tenant.Bypass(ctx), f.workspaceB).QueryAccounts().All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts) != 0 {
+		t.Errorf("accounts of workspace B through its edge = %d, want 0", len(accounts))
+	}
+}
+
+func TestQueriesWithoutWorkspaceAreDenied(t *testing.T) {
+	f := newFixture(t)
+	if _, err := f.client.Tag.Query().All(context.Background()); !errors.Is(err, privacy.Deny) {
+		t.Errorf("query without workspace error = %v, want privacy.Deny", err)
+	}
+}
+
+func TestCrossWorkspaceMutationsAreRejected(t *testing.T) {
+	f := newFixture(t)
+	ctx := tenant.WithWorkspace(context.Background(), f.workspaceA)
+
+	if err := f.client.Tag.UpdateOneID(f.tagB).SetName("stolen").Exec(ctx); !ent.IsNotFound(err) {
+		t.Errorf("UpdateOneID(tag of workspace B) error = %v, want not found", err)
+	}
+	n, err := f.client.Tag.Update().SetName("stolen").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("bulk update from workspace A changed %d tags, want 1", n)
+	}
+	if err := f.client.Tag.DeleteOneID(f.tagB).Exec(ctx); !ent.IsNotFound(err) {
+		t.Errorf("DeleteOneID(tag of workspace B) error = %v, want not found", err)
+	}
+	if _, err := f.client.Account.Delete().Where(account.WorkspaceID(f.workspaceB)).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	bypass := tenant.Bypass(context.Background())
+	tagB := f.client.Tag.GetX(bypass, f.tagB)
+	if tagB.Name != "groceries" {
+		t.Errorf("tag of workspace B renamed to %q", tagB.Name)
+	}
+	if !f.client.Account.Query().Where(account.ID(f.accountB)).ExistX(bypass) {
+		t.Error("account of workspace B was deleted from workspace A")
+	}
+}
+
+func TestCrossWorkspaceCreateIsRejected(t *testing.T) {
+	f := newFixture(t)
+	ctx := tenant.WithWorkspace(context.Background(), f.workspaceA)
+
+	_, err := f.client.Tag.Create().SetWorkspaceID(f.workspaceB).SetName("planted").Save(ctx)
+	if !errors.Is(err, privacy.Deny) {
+		t.Errorf("create in workspace B error = %v, want privacy.Deny", err)
+	}
+	_, err = f.client.Tag.Create().SetWorkspaceID(f.workspaceA).SetName("planted").Save(context.Background())
+	if !errors.Is(err, privacy.Deny) {
+		t.Errorf("create without workspace error = %v, want privacy.Deny", err)
+	}
+	if _, err := f.client.Tag.Create().SetWorkspaceID(f.workspaceA).SetName("travel").Save(ctx); err != nil {
+		t.Errorf("create in own workspace: %v", err)
+	}
+}
+
+func TestBypass(t *testing.T) {
+	f := newFixture(t)
+
+	// Bypass sees every workspace, with or without a workspace in the context
+	for _, ctx := range []context.Context{
+		tenant.Bypass(context.Background()),
+		tenant.Bypass(tenant.WithWorkspace(context.Background(), f.workspaceA)),
+	} {
+		n, err := f.client.Tag.Query().Count(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != 2 {
+			t.Errorf("tags seen with Bypass = %d, want 2", n)
+		}
+	}
+
+	// Scoping a bypassed context again does not undo the bypass
+	ctx := tenant.WithWorkspace(tenant.Bypass(context.Background()), f.workspaceA)
+	if err := f.client.Tag.UpdateOneID(f.tagB).SetName("renamed").Exec(ctx); err != nil {
+		t.Errorf("update of workspace B with Bypass: %v", err)
+	}
+	if name := f.client.Tag.GetX(ctx, f.tagB).Name; name != "renamed" {
+		t.Errorf("tag of workspace B = %q after update with Bypass, want renamed", name)
+	}
+}
+
+func TestAmountsReadBack(t *testing.T) {
+	f := newFixture(t)
+	ctx := tenant.WithWorkspace(context.Background(), f.workspaceA)
+
+	a, err := f.client.Account.Get(ctx, f.accountA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.OpeningBalance.Cmp(mustDecimal(t, "1234.56")) != 0 {
+		t.Errorf("opening balance = %s, want 1234.56", a.OpeningBalance)
+	}
+}
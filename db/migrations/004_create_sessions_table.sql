@@ -0,0 +1,22 @@
+-- Create sessions table for the server-side session store
+CREATE TABLE IF NOT EXISTS sessions (
+    id SERIAL PRIMARY KEY,
+    token_hash VARCHAR(255) NOT NULL UNIQUE,
+    user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    data BYTEA NOT NULL,
+    user_agent VARCHAR(255) NOT NULL DEFAULT '',
+    ip_address VARCHAR(255) NOT NULL DEFAULT '',
+    last_seen_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for listing a user's sessions and purging expired ones
+CREATE INDEX IF NOT EXISTS session_user_id ON sessions (user_id);
+CREATE INDEX IF NOT EXISTS session_expires_at ON sessions (expires_at);
+
+-- Add comment to table
+COMMENT ON TABLE sessions IS 'Server-side login sessions (one row per signed-in device)';
+COMMENT ON COLUMN sessions.token_hash IS 'SHA-256 hash of the opaque token stored in the finsight_session cookie';
+COMMENT ON COLUMN sessions.data IS 'Gob-encoded session values';
@@ -0,0 +1,17 @@
+-- Create password_reset_tokens table for the forgot-password flow
+CREATE TABLE IF NOT EXISTS password_reset_tokens (
+    id SERIAL PRIMARY KEY,
+    token_hash VARCHAR(255) NOT NULL UNIQUE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create index for invalidating a user's outstanding tokens
+CREATE INDEX IF NOT EXISTS passwordresettoken_user_id ON password_reset_tokens (user_id);
+
+-- Add comment to table
+COMMENT ON TABLE password_reset_tokens IS 'Single-use password reset tokens';
+COMMENT ON COLUMN password_reset_tokens.token_hash IS 'SHA-256 hash of the token sent by email';
+COMMENT ON COLUMN password_reset_tokens.used_at IS 'Set when the token has been consumed';
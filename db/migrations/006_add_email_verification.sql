@@ -0,0 +1,24 @@
+-- Track when a user confirmed their email address
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMP;
+
+-- Let workspaces require verified emails before members can access financial data
+ALTER TABLE workspaces ADD COLUMN IF NOT EXISTS require_verified_email BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Create email_verification_tokens table
+CREATE TABLE IF NOT EXISTS email_verification_tokens (
+    id SERIAL PRIMARY KEY,
+    token_hash VARCHAR(255) NOT NULL UNIQUE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create index for invalidating a user's outstanding tokens
+CREATE INDEX IF NOT EXISTS emailverificationtoken_user_id ON email_verification_tokens (user_id);
+
+-- Add comments
+COMMENT ON COLUMN users.email_verified_at IS 'When the user confirmed their email address (NULL if unverified)';
+COMMENT ON COLUMN workspaces.require_verified_email IS 'Members must verify their email before accessing financial data';
+COMMENT ON TABLE email_verification_tokens IS 'Single-use email verification tokens';
+COMMENT ON COLUMN email_verification_tokens.token_hash IS 'SHA-256 hash of the token sent by email';
@@ -0,0 +1,22 @@
+-- TOTP two-factor authentication state on users
+ALTER TABLE users ADD COLUMN IF NOT EXISTS totp_secret VARCHAR(255);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS totp_last_used_step BIGINT;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS two_factor_enabled_at TIMESTAMP;
+
+-- Create recovery_codes table
+CREATE TABLE IF NOT EXISTS recovery_codes (
+    id SERIAL PRIMARY KEY,
+    code_hash VARCHAR(255) NOT NULL,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create unique index for looking up a user's recovery code
+CREATE UNIQUE INDEX IF NOT EXISTS recoverycode_user_id_code_hash ON recovery_codes (user_id, code_hash);
+
+-- Add comments
+COMMENT ON COLUMN users.totp_secret IS 'Base32 TOTP shared secret (set during enrollment)';
+COMMENT ON COLUMN users.totp_last_used_step IS 'Last accepted TOTP time step, used to reject replayed codes';
+COMMENT ON COLUMN users.two_factor_enabled_at IS 'When two-factor authentication was enabled (NULL if disabled)';
+COMMENT ON TABLE recovery_codes IS 'Hashed one-time two-factor recovery codes';
@@ -0,0 +1,25 @@
+-- Create memberships table (workspace membership with a role)
+CREATE TABLE IF NOT EXISTS memberships (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(255) NOT NULL DEFAULT 'viewer' CHECK (role IN ('owner', 'admin', 'editor', 'viewer')),
+    joined_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    invited_by_id INTEGER REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- Create indexes for efficient lookups
+CREATE UNIQUE INDEX IF NOT EXISTS membership_workspace_id_user_id ON memberships (workspace_id, user_id);
+CREATE INDEX IF NOT EXISTS membership_user_id ON memberships (user_id);
+
+-- Existing members created their workspace at signup, so they become its owners
+INSERT INTO memberships (workspace_id, user_id, role)
+SELECT workspace_id, user_id, 'owner' FROM user_workspaces
+ON CONFLICT (workspace_id, user_id) DO NOTHING;
+
+DROP TABLE IF EXISTS user_workspaces;
+
+-- Add comment to table
+COMMENT ON TABLE memberships IS 'Workspace membership of users, with their role';
+COMMENT ON COLUMN memberships.role IS 'owner, admin, editor or viewer';
+COMMENT ON COLUMN memberships.invited_by_id IS 'User who invited the member (NULL for the workspace creator)';
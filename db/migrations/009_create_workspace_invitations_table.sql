@@ -0,0 +1,23 @@
+-- Create workspace_invitations table
+CREATE TABLE IF NOT EXISTS workspace_invitations (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL,
+    role VARCHAR(255) NOT NULL CHECK (role IN ('admin', 'editor', 'viewer')),
+    token_hash VARCHAR(255) NOT NULL UNIQUE,
+    status VARCHAR(255) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'revoked')),
+    expires_at TIMESTAMP NOT NULL,
+    invited_by_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    accepted_by_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    responded_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create index for listing a workspace's open invitations
+CREATE INDEX IF NOT EXISTS workspaceinvitation_workspace_id_status ON workspace_invitations (workspace_id, status);
+
+-- Add comment to table
+COMMENT ON TABLE workspace_invitations IS 'Email invitations to join a workspace';
+COMMENT ON COLUMN workspace_invitations.email IS 'Invitee email address (lowercase)';
+COMMENT ON COLUMN workspace_invitations.token_hash IS 'SHA-256 hash of the token sent by email';
+COMMENT ON COLUMN workspace_invitations.status IS 'pending, accepted or revoked (expiry is derived from expires_at)';
@@ -0,0 +1,24 @@
+-- Create accounts table (financial accounts owned by a workspace)
+CREATE TABLE IF NOT EXISTS accounts (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    type VARCHAR(255) NOT NULL CHECK (type IN ('bank', 'credit_card', 'cash', 'loan', 'investment')),
+    institution VARCHAR(255),
+    currency VARCHAR(255) NOT NULL,
+    opening_balance BIGINT NOT NULL DEFAULT 0,
+    opened_on DATE,
+    closed_on DATE,
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create index for listing a workspace's active accounts
+CREATE INDEX IF NOT EXISTS account_workspace_id_archived ON accounts (workspace_id, archived);
+
+-- Add comment to table
+COMMENT ON TABLE accounts IS 'Bank, credit card, cash, loan and investment accounts of a workspace';
+COMMENT ON COLUMN accounts.currency IS 'ISO 4217 currency code';
+COMMENT ON COLUMN accounts.opening_balance IS 'Balance before the first recorded transaction, in minor units of the currency';
+COMMENT ON COLUMN accounts.archived IS 'Archived accounts are hidden from pickers but kept for history';
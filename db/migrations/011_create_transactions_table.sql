@@ -0,0 +1,23 @@
+-- Create transactions table (money movements on an account)
+CREATE TABLE IF NOT EXISTS transactions (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    account_id INTEGER NOT NULL REFERENCES accounts(id) ON DELETE NO ACTION,
+    posted_on DATE NOT NULL,
+    amount BIGINT NOT NULL,
+    payee VARCHAR(255),
+    memo VARCHAR(255),
+    category VARCHAR(255),
+    status VARCHAR(255) NOT NULL DEFAULT 'uncleared' CHECK (status IN ('uncleared', 'cleared', 'reconciled')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for listing by date within a workspace or an account
+CREATE INDEX IF NOT EXISTS transaction_workspace_id_posted_on ON transactions (workspace_id, posted_on);
+CREATE INDEX IF NOT EXISTS transaction_account_id_posted_on ON transactions (account_id, posted_on);
+
+-- Add comment to table
+COMMENT ON TABLE transactions IS 'Money movements on accounts';
+COMMENT ON COLUMN transactions.amount IS 'Amount in minor units of the account currency; negative for outflows';
+COMMENT ON COLUMN transactions.status IS 'uncleared, cleared or reconciled';
@@ -0,0 +1,29 @@
+-- Store amounts as exact decimals in major units instead of integer minor units
+
+-- Number of minor unit digits of a currency, following ISO 4217
+CREATE OR REPLACE FUNCTION pg_temp.currency_minor_units(code VARCHAR) RETURNS INTEGER AS $$
+    SELECT CASE
+        WHEN code IN ('BIF', 'CLP', 'DJF', 'GNF', 'ISK', 'JPY', 'KMF', 'KRW', 'PYG',
+                      'RWF', 'UGX', 'UYI', 'VND', 'VUV', 'XAF', 'XOF', 'XPF') THEN 0
+        WHEN code IN ('BHD', 'IQD', 'JOD', 'KWD', 'LYD', 'OMR', 'TND') THEN 3
+        WHEN code IN ('CLF', 'UYW') THEN 4
+        ELSE 2
+    END
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- Transactions carry their currency, copied from the account
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS currency VARCHAR(255);
+UPDATE transactions SET currency = accounts.currency FROM accounts WHERE accounts.id = transactions.account_id;
+ALTER TABLE transactions ALTER COLUMN currency SET NOT NULL;
+
+ALTER TABLE accounts ALTER COLUMN opening_balance DROP DEFAULT;
+ALTER TABLE accounts ALTER COLUMN opening_balance TYPE NUMERIC(24, 4)
+    USING opening_balance / power(10::NUMERIC, pg_temp.currency_minor_units(currency));
+
+ALTER TABLE transactions ALTER COLUMN amount TYPE NUMERIC(24, 4)
+    USING amount / power(10::NUMERIC, pg_temp.currency_minor_units(currency));
+
+-- Update comments
+COMMENT ON COLUMN accounts.opening_balance IS 'Balance before the first recorded transaction, in major units of the currency';
+COMMENT ON COLUMN transactions.amount IS 'Amount in major units of the currency; negative for outflows';
+COMMENT ON COLUMN transactions.currency IS 'ISO 4217 currency code, copied from the account';
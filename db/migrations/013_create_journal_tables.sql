@@ -0,0 +1,71 @@
+-- Allow the income and expense accounts that balance transactions in the journal
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS accounts_type_check;
+ALTER TABLE accounts ADD CONSTRAINT accounts_type_check
+    CHECK (type IN ('bank', 'credit_card', 'cash', 'loan', 'investment', 'income', 'expense'));
+
+-- One income and one expense account per workspace and currency
+CREATE UNIQUE INDEX IF NOT EXISTS account_workspace_id_type_currency ON accounts (workspace_id, type, currency)
+    WHERE type IN ('income', 'expense');
+
+-- Create journal entries table (balanced groups of postings)
+CREATE TABLE IF NOT EXISTS journal_entries (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    transaction_id INTEGER REFERENCES transactions(id) ON DELETE CASCADE,
+    posted_on DATE NOT NULL,
+    memo VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS journalentry_transaction_id ON journal_entries (transaction_id);
+
+-- Create postings table (journal entry lines against an account)
+CREATE TABLE IF NOT EXISTS postings (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    journal_entry_id INTEGER NOT NULL REFERENCES journal_entries(id) ON DELETE CASCADE,
+    account_id INTEGER NOT NULL REFERENCES accounts(id) ON DELETE NO ACTION,
+    amount NUMERIC(24, 4) NOT NULL,
+    currency VARCHAR(255) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS posting_journal_entry_id ON postings (journal_entry_id);
+CREATE INDEX IF NOT EXISTS posting_account_id ON postings (account_id);
+
+-- Post the existing transactions: create the balancing accounts they need
+INSERT INTO accounts (workspace_id, name, type, currency, opening_balance)
+SELECT DISTINCT
+    workspace_id,
+    CASE WHEN amount < 0 THEN 'Expenses' ELSE 'Income' END,
+    CASE WHEN amount < 0 THEN 'expense' ELSE 'income' END,
+    currency,
+    0
+FROM transactions
+ON CONFLICT DO NOTHING;
+
+-- One journal entry per transaction
+INSERT INTO journal_entries (workspace_id, transaction_id, posted_on, memo)
+SELECT t.workspace_id, t.id, t.posted_on, t.memo
+FROM transactions t
+WHERE NOT EXISTS (SELECT 1 FROM journal_entries j WHERE j.transaction_id = t.id);
+
+-- The amount on the transaction's account, balanced on the income or expense account
+INSERT INTO postings (workspace_id, journal_entry_id, account_id, amount, currency)
+SELECT j.workspace_id, j.id, t.account_id, t.amount, t.currency
+FROM journal_entries j
+JOIN transactions t ON t.id = j.transaction_id
+WHERE NOT EXISTS (SELECT 1 FROM postings p WHERE p.journal_entry_id = j.id)
+UNION ALL
+SELECT j.workspace_id, j.id, a.id, -t.amount, t.currency
+FROM journal_entries j
+JOIN transactions t ON t.id = j.transaction_id
+JOIN accounts a ON a.workspace_id = t.workspace_id
+    AND a.currency = t.currency
+    AND a.type = CASE WHEN t.amount < 0 THEN 'expense' ELSE 'income' END
+WHERE NOT EXISTS (SELECT 1 FROM postings p WHERE p.journal_entry_id = j.id);
+
+-- Add comments to tables
+COMMENT ON TABLE journal_entries IS 'Double-entry journal; the postings of an entry sum to zero in each currency';
+COMMENT ON COLUMN journal_entries.transaction_id IS 'Transaction the entry was recorded for';
+COMMENT ON TABLE postings IS 'Journal entry lines; account balances are the opening balance plus the postings';
+COMMENT ON COLUMN postings.amount IS 'Amount in major units of the currency; positive for debits, negative for credits';
@@ -0,0 +1,42 @@
+-- Create categories table (a tree of income, expense and transfer categories per workspace)
+CREATE TABLE IF NOT EXISTS categories (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    parent_id INTEGER REFERENCES categories(id) ON DELETE SET NULL,
+    name VARCHAR(255) NOT NULL,
+    kind VARCHAR(255) NOT NULL CHECK (kind IN ('income', 'expense', 'transfer')),
+    color VARCHAR(255),
+    icon VARCHAR(255),
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create index for walking the tree of a workspace
+CREATE INDEX IF NOT EXISTS category_workspace_id_parent_id ON categories (workspace_id, parent_id);
+
+-- Transactions reference a category instead of carrying its name
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS category_id INTEGER REFERENCES categories(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS transaction_category_id ON transactions (category_id);
+
+-- Turn the existing category names into top-level categories; names used for outflows become expense categories
+INSERT INTO categories (workspace_id, name, kind)
+SELECT workspace_id, category, CASE WHEN bool_or(amount < 0) THEN 'expense' ELSE 'income' END
+FROM transactions
+WHERE category IS NOT NULL AND category <> ''
+GROUP BY workspace_id, category;
+
+UPDATE transactions t
+SET category_id = c.id
+FROM categories c
+WHERE c.workspace_id = t.workspace_id
+    AND c.parent_id IS NULL
+    AND c.name = t.category;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS category;
+
+-- Add comments to table
+COMMENT ON TABLE categories IS 'Category tree of a workspace, e.g. Food > Groceries';
+COMMENT ON COLUMN categories.kind IS 'income, expense or transfer; subcategories have the kind of their parent';
+COMMENT ON COLUMN categories.color IS 'Display color formatted as #RRGGBB';
+COMMENT ON COLUMN transactions.category_id IS 'Category of the transaction, if categorized';
@@ -0,0 +1,19 @@
+-- Create transaction_splits table (divides a transaction across several categories)
+CREATE TABLE IF NOT EXISTS transaction_splits (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    transaction_id INTEGER NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    category_id INTEGER REFERENCES categories(id) ON DELETE SET NULL,
+    amount NUMERIC(24,4) NOT NULL,
+    currency VARCHAR(255) NOT NULL,
+    memo VARCHAR(255)
+);
+
+-- Create indexes for loading the splits of a transaction and reporting by category
+CREATE INDEX IF NOT EXISTS transactionsplit_transaction_id ON transaction_splits (transaction_id);
+CREATE INDEX IF NOT EXISTS transactionsplit_category_id ON transaction_splits (category_id);
+
+-- Add comments to table
+COMMENT ON TABLE transaction_splits IS 'Lines of a split transaction; their amounts sum to the transaction amount';
+COMMENT ON COLUMN transaction_splits.amount IS 'Amount in the transaction currency; negative for outflows';
+COMMENT ON COLUMN transaction_splits.category_id IS 'Category of the split, if categorized';
@@ -0,0 +1,25 @@
+-- Allow the transfer account that holds money in transit between two accounts
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS accounts_type_check;
+ALTER TABLE accounts ADD CONSTRAINT accounts_type_check
+    CHECK (type IN ('bank', 'credit_card', 'cash', 'loan', 'investment', 'income', 'expense', 'transfer'));
+
+-- One income, one expense and one transfer account per workspace and currency
+DROP INDEX IF EXISTS account_workspace_id_type_currency;
+CREATE UNIQUE INDEX IF NOT EXISTS account_workspace_id_type_currency ON accounts (workspace_id, type, currency)
+    WHERE type IN ('income', 'expense', 'transfer');
+
+-- Create transfers table (links the outgoing and incoming transactions of a transfer)
+CREATE TABLE IF NOT EXISTS transfers (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    from_transaction_id INTEGER NOT NULL UNIQUE REFERENCES transactions(id) ON DELETE CASCADE,
+    to_transaction_id INTEGER NOT NULL UNIQUE REFERENCES transactions(id) ON DELETE CASCADE,
+    from_fee NUMERIC(24,4) NOT NULL DEFAULT 0,
+    to_fee NUMERIC(24,4) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Add comments to table
+COMMENT ON TABLE transfers IS 'Transfers between two accounts of a workspace; neither side counts as income or expense';
+COMMENT ON COLUMN transfers.from_fee IS 'Fee charged by the sending account in its currency, included in the outgoing amount';
+COMMENT ON COLUMN transfers.to_fee IS 'Fee charged by the receiving account in its currency, deducted before the incoming amount';
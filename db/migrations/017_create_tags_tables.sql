@@ -0,0 +1,23 @@
+-- Create tags table (free-form labels on transactions, scoped to a workspace)
+CREATE TABLE IF NOT EXISTS tags (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    color VARCHAR(7),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS tag_workspace_id_name ON tags (workspace_id, name);
+
+-- Create tag_transactions table (many-to-many between tags and transactions)
+CREATE TABLE IF NOT EXISTS tag_transactions (
+    tag_id INTEGER NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    transaction_id INTEGER NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    PRIMARY KEY (tag_id, transaction_id)
+);
+
+-- Add comments to table
+COMMENT ON TABLE tags IS 'Workspace-scoped labels that can be attached to any number of transactions';
+COMMENT ON COLUMN tags.color IS 'Display color as #RRGGBB';
+COMMENT ON TABLE tag_transactions IS 'Tags attached to each transaction';
@@ -0,0 +1,25 @@
+-- Create payees table (payee directory of a workspace)
+CREATE TABLE IF NOT EXISTS payees (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    aliases JSONB,
+    default_category_id INTEGER REFERENCES categories(id) ON DELETE SET NULL,
+    logo_url VARCHAR(255),
+    website VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS payee_workspace_id_name ON payees (workspace_id, name);
+
+-- Transactions keep their description and reference the payee it resolves to
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS payee_id INTEGER REFERENCES payees(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS transaction_payee_id ON transactions (payee_id);
+
+-- Add comments to table
+COMMENT ON TABLE payees IS 'Payee directory of a workspace; aliases resolve raw bank descriptions to a payee';
+COMMENT ON COLUMN payees.aliases IS 'Normalized upper-case patterns matched against descriptions; * matches any run of characters';
+COMMENT ON COLUMN payees.default_category_id IS 'Category given to new uncategorized transactions of the payee';
+COMMENT ON COLUMN transactions.payee IS 'Description as entered or imported';
+COMMENT ON COLUMN transactions.payee_id IS 'Payee the description resolves to, if any';
@@ -0,0 +1,44 @@
+-- Create recurrence_rules table (recurring transactions and scheduled bills)
+CREATE TABLE IF NOT EXISTS recurrence_rules (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    account_id INTEGER NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    amount NUMERIC(24, 4) NOT NULL,
+    currency VARCHAR(255) NOT NULL,
+    payee VARCHAR(255),
+    payee_id INTEGER REFERENCES payees(id) ON DELETE SET NULL,
+    memo VARCHAR(255),
+    category_id INTEGER REFERENCES categories(id) ON DELETE SET NULL,
+    frequency VARCHAR(255) NOT NULL CHECK (frequency IN ('daily', 'weekly', 'monthly', 'yearly')),
+    interval BIGINT NOT NULL DEFAULT 1 CHECK (interval > 0),
+    by_day JSONB,
+    starts_on DATE NOT NULL,
+    ends_on DATE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create recurrence_occurrences table (materialized due dates of a rule)
+CREATE TABLE IF NOT EXISTS recurrence_occurrences (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    rule_id INTEGER NOT NULL REFERENCES recurrence_rules(id) ON DELETE CASCADE,
+    due_on DATE NOT NULL,
+    status VARCHAR(255) NOT NULL DEFAULT 'scheduled' CHECK (status IN ('scheduled', 'posted', 'skipped')),
+    transaction_id INTEGER UNIQUE REFERENCES transactions(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS recurrenceoccurrence_rule_id_due_on ON recurrence_occurrences (rule_id, due_on);
+CREATE INDEX IF NOT EXISTS recurrenceoccurrence_workspace_id_status_due_on ON recurrence_occurrences (workspace_id, status, due_on);
+
+-- Add comments to tables
+COMMENT ON TABLE recurrence_rules IS 'Recurring transactions of a workspace, scheduled RRULE-style';
+COMMENT ON COLUMN recurrence_rules.amount IS 'Amount of each occurrence in the account currency; negative for bills';
+COMMENT ON COLUMN recurrence_rules.interval IS 'Number of periods between occurrences';
+COMMENT ON COLUMN recurrence_rules.by_day IS 'RRULE BYDAY values such as MO or -1FR; ordinals only for monthly rules';
+COMMENT ON COLUMN recurrence_rules.ends_on IS 'Last day an occurrence may fall on; NULL repeats forever';
+COMMENT ON TABLE recurrence_occurrences IS 'Due dates of recurrence rules, scheduled ahead by the server and posted when due';
+COMMENT ON COLUMN recurrence_occurrences.status IS 'scheduled, posted or skipped';
+COMMENT ON COLUMN recurrence_occurrences.transaction_id IS 'Transaction the occurrence was posted as';
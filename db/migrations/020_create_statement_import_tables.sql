@@ -0,0 +1,39 @@
+-- Create statement_imports table (uploaded bank statements awaiting or after import)
+CREATE TABLE IF NOT EXISTS statement_imports (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    account_id INTEGER NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    format VARCHAR(255) NOT NULL CHECK (format IN ('csv')),
+    filename VARCHAR(255),
+    content BYTEA NOT NULL,
+    status VARCHAR(255) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'committed')),
+    created_count BIGINT NOT NULL DEFAULT 0,
+    failed_count BIGINT NOT NULL DEFAULT 0,
+    committed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS statementimport_workspace_id_created_at ON statement_imports (workspace_id, created_at);
+
+-- Create import_profiles table (saved CSV column mappings per account)
+CREATE TABLE IF NOT EXISTS import_profiles (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    account_id INTEGER NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    mapping JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS importprofile_account_id_name ON import_profiles (account_id, name);
+
+-- Add comments to tables
+COMMENT ON TABLE statement_imports IS 'Bank statement files uploaded for import into an account';
+COMMENT ON COLUMN statement_imports.content IS 'Raw statement file as uploaded';
+COMMENT ON COLUMN statement_imports.status IS 'pending until the statement is committed as transactions';
+COMMENT ON COLUMN statement_imports.created_count IS 'Transactions created on commit';
+COMMENT ON COLUMN statement_imports.failed_count IS 'Rows rejected on commit';
+COMMENT ON TABLE import_profiles IS 'Saved CSV column mappings, reused for later statements of the same account';
+COMMENT ON COLUMN import_profiles.mapping IS 'Encoding, delimiter, date format and column positions of the statement';
@@ -0,0 +1,15 @@
+-- Accept OFX and QFX statements
+ALTER TABLE statement_imports DROP CONSTRAINT IF EXISTS statement_imports_format_check;
+ALTER TABLE statement_imports ADD CONSTRAINT statement_imports_format_check
+    CHECK (format IN ('csv', 'ofx'));
+
+-- Count rows skipped on commit because they were imported before
+ALTER TABLE statement_imports ADD COLUMN IF NOT EXISTS skipped_count BIGINT NOT NULL DEFAULT 0;
+
+-- Remember the identifier the bank gave imported transactions, so that re-importing a statement creates nothing
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS external_id VARCHAR(255);
+CREATE UNIQUE INDEX IF NOT EXISTS transaction_account_id_external_id ON transactions (account_id, external_id);
+
+-- Add comments to columns
+COMMENT ON COLUMN statement_imports.skipped_count IS 'Rows skipped on commit because the account already has a transaction with their external ID';
+COMMENT ON COLUMN transactions.external_id IS 'Identifier the bank gave the transaction, e.g. the OFX FITID; unique per account';
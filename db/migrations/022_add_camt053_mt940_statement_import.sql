@@ -0,0 +1,14 @@
+-- Accept ISO 20022 camt.053 and SWIFT MT940 statements
+ALTER TABLE statement_imports DROP CONSTRAINT IF EXISTS statement_imports_format_check;
+ALTER TABLE statement_imports ADD CONSTRAINT statement_imports_format_check
+    CHECK (format IN ('csv', 'ofx', 'camt053', 'mt940'));
+
+-- Keep the value date, counterparty account and end-to-end reference banks report for imported transactions
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS value_date DATE;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS counterparty_iban VARCHAR(255);
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS end_to_end_id VARCHAR(255);
+
+-- Add comments to columns
+COMMENT ON COLUMN transactions.value_date IS 'Day the amount started or stopped earning interest, when the bank reports one apart from the booking date';
+COMMENT ON COLUMN transactions.counterparty_iban IS 'IBAN of the account the money came from or went to, as reported by the bank';
+COMMENT ON COLUMN transactions.end_to_end_id IS 'End-to-end reference the payer gave the payment, passed on unchanged by the banks';
@@ -0,0 +1,24 @@
+-- Create import_decisions table (what became of each committed statement row)
+CREATE TABLE IF NOT EXISTS import_decisions (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    account_id INTEGER NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    statement_import_id INTEGER REFERENCES statement_imports(id) ON DELETE SET NULL,
+    fingerprint VARCHAR(255) NOT NULL,
+    action VARCHAR(255) NOT NULL CHECK (action IN ('imported', 'merged')),
+    transaction_id INTEGER NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS importdecision_account_id_fingerprint ON import_decisions (account_id, fingerprint);
+CREATE INDEX IF NOT EXISTS importdecision_transaction_id ON import_decisions (transaction_id);
+
+-- Count rows merged on commit into transactions the account already had
+ALTER TABLE statement_imports ADD COLUMN IF NOT EXISTS merged_count BIGINT NOT NULL DEFAULT 0;
+
+-- Add comments to tables
+COMMENT ON TABLE import_decisions IS 'Statement rows committed into an account, so that importing the same rows again creates nothing';
+COMMENT ON COLUMN import_decisions.fingerprint IS 'Hash of the date, amount, description, memo and external ID of the row and of how many identical rows precede it in its file';
+COMMENT ON COLUMN import_decisions.action IS 'imported as a new transaction, or merged into an existing transaction the user confirmed as its duplicate';
+COMMENT ON COLUMN import_decisions.transaction_id IS 'Transaction the row was imported as or merged into; deleting it lets the row be imported again';
+COMMENT ON COLUMN statement_imports.merged_count IS 'Rows merged on commit into transactions the user confirmed as their duplicates';
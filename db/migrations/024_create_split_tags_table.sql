@@ -0,0 +1,9 @@
+-- Create tag_splits table (many-to-many between tags and the splits of split transactions)
+CREATE TABLE IF NOT EXISTS tag_splits (
+    tag_id INTEGER NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    transaction_split_id INTEGER NOT NULL REFERENCES transaction_splits(id) ON DELETE CASCADE,
+    PRIMARY KEY (tag_id, transaction_split_id)
+);
+
+-- Add comments to table
+COMMENT ON TABLE tag_splits IS 'Tags attached to a single split; they apply to the split amount in addition to the tags of its transaction';
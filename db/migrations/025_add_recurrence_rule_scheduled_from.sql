@@ -0,0 +1,8 @@
+-- Track the day a recurrence rule's schedule was set so that editing it does not backfill past dates
+ALTER TABLE recurrence_rules ADD COLUMN IF NOT EXISTS scheduled_from DATE;
+UPDATE recurrence_rules SET scheduled_from = created_at::date WHERE scheduled_from IS NULL;
+ALTER TABLE recurrence_rules ALTER COLUMN scheduled_from SET DEFAULT CURRENT_DATE;
+ALTER TABLE recurrence_rules ALTER COLUMN scheduled_from SET NOT NULL;
+
+-- Add comments
+COMMENT ON COLUMN recurrence_rules.scheduled_from IS 'Day the rule was created or last edited; no earlier occurrence is scheduled';
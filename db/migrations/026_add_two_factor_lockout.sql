@@ -0,0 +1,7 @@
+-- Count wrong second-factor codes per user so that the limit holds across logins
+ALTER TABLE users ADD COLUMN IF NOT EXISTS two_factor_failed_attempts BIGINT NOT NULL DEFAULT 0 CHECK (two_factor_failed_attempts >= 0);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS two_factor_locked_until TIMESTAMP;
+
+-- Add comments
+COMMENT ON COLUMN users.two_factor_failed_attempts IS 'Wrong second-factor codes in a row, across logins';
+COMMENT ON COLUMN users.two_factor_locked_until IS 'Second-factor codes are refused until then after too many wrong ones';